@@ -37,11 +37,14 @@ type App struct {
 	Gui       *gui.Gui
 }
 
+// Returns the exit code of the process, which is only non-zero if git started
+// lazygit as its mergetool and the conflicts weren't resolved, or if a single
+// action (e.g. `lazygit commit`) didn't succeed
 func Run(
 	config config.AppConfigurer,
 	common *common.Common,
 	startArgs appTypes.StartArgs,
-) {
+) int {
 	app, err := NewApp(config, startArgs.IntegrationTest, common)
 
 	if err == nil {
@@ -58,6 +61,8 @@ func Run(
 
 		log.Fatalf("%s: %s\n\n%s", common.Tr.ErrorOccurred, constants.Links.Issues, stackTrace)
 	}
+
+	return app.Gui.ExitCode()
 }
 
 func NewCommon(config config.AppConfigurer) (*common.Common, error) {
@@ -74,6 +79,7 @@ func NewCommon(config config.AppConfigurer) (*common.Common, error) {
 		AppState: appState,
 		Debug:    config.GetDebug(),
 		Fs:       afero.NewOsFs(),
+		Timings:  common.NewTimings(),
 	}
 	cmn.SetUserConfig(userConfig)
 	return cmn, nil
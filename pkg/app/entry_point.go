@@ -16,6 +16,7 @@ import (
 
 	"github.com/integrii/flaggy"
 	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/app/session"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/env"
@@ -29,13 +30,23 @@ import (
 type cliArgs struct {
 	RepoPath           string
 	FilterPath         string
+	FilterAuthor       string
+	FilterSince        string
+	FilterUntil        string
 	GitArg             string
+	ToolPaths          []string
+	Panel              string
+	Commit             string
+	File               string
 	UseConfigDir       string
 	WorkTree           string
 	GitDir             string
 	CustomConfigFile   string
 	ScreenMode         string
+	OutputSelection    string
 	PrintVersionInfo   bool
+	Attach             bool
+	KillSession        bool
 	Debug              bool
 	TailLogs           bool
 	Profile            bool
@@ -54,6 +65,20 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 	cliArgs := parseCliArgsAndEnvVars()
 	mergeBuildInfo(buildInfo)
 
+	if socketPath := os.Getenv(session.ServerEnvKey); socketPath != "" {
+		if err := session.Serve(socketPath, os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// The server of a session needs to start in the same directory as we did,
+	// since the args may contain relative paths
+	startDir, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if cliArgs.RepoPath != "" {
 		if cliArgs.WorkTree != "" || cliArgs.GitDir != "" {
 			log.Fatal("--path option is incompatible with the --work-tree and --git-dir options")
@@ -125,6 +150,20 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 		os.Exit(0)
 	}
 
+	if cliArgs.Attach || cliArgs.KillSession {
+		handleSession(cliArgs, startDir)
+		return
+	}
+
+	// Registered before any other deferred calls so that it runs after all of
+	// them, since os.Exit doesn't run deferred calls
+	exitCode := 0
+	defer func() {
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
 	tempDirBase := getTempDirBase()
 	tempDir, err := os.MkdirTemp(tempDirBase, "lazygit-*")
 	if err != nil {
@@ -170,11 +209,27 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 				log.Fatal(err)
 			}
 		}()
+
+		tracePath, err := config.TracePath()
+		if err != nil {
+			log.Fatal(err)
+		}
+		traceFile, err := os.Create(tracePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := common.Timings.WriteTraceTo(traceFile); err != nil {
+			log.Fatal(err)
+		}
+		defer common.Timings.Close()
 	}
 
 	parsedGitArg := parseGitArg(cliArgs.GitArg)
+	toolPaths := parseToolPaths(parsedGitArg, cliArgs.ToolPaths)
+	panel, file := parsePanelArgs(cliArgs.Panel, cliArgs.Commit, cliArgs.File)
+	outputSelection := parseOutputSelection(cliArgs.OutputSelection)
 
-	Run(appConfig, common, appTypes.NewStartArgs(cliArgs.FilterPath, parsedGitArg, cliArgs.ScreenMode, integrationTest))
+	exitCode = Run(appConfig, common, appTypes.NewStartArgs(cliArgs.FilterPath, cliArgs.FilterAuthor, cliArgs.FilterSince, cliArgs.FilterUntil, parsedGitArg, toolPaths, panel, cliArgs.Commit, file, cliArgs.ScreenMode, outputSelection, integrationTest))
 }
 
 func parseCliArgsAndEnvVars() *cliArgs {
@@ -186,8 +241,39 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	filterPath := ""
 	flaggy.String(&filterPath, "f", "filter", "Path to filter on in `git log -- <path>`. When in filter mode, the commits, reflog, and stash are filtered based on the given path, and some operations are restricted")
 
+	filterAuthor := ""
+	flaggy.String(&filterAuthor, "", "author", "Only show commits by authors matching the given pattern, like `git log --author=<pattern>`. Puts lazygit in filter mode.")
+
+	filterSince := ""
+	flaggy.String(&filterSince, "", "since", "Only show commits more recent than the given date, like `git log --since=<date>`, e.g. '2 weeks ago' or '2024-01-31'. Puts lazygit in filter mode.")
+
+	filterUntil := ""
+	flaggy.String(&filterUntil, "", "until", "Only show commits older than the given date, like `git log --until=<date>`. Puts lazygit in filter mode.")
+
 	gitArg := ""
-	flaggy.AddPositionalValue(&gitArg, "git-arg", 1, false, "Panel to focus upon opening lazygit. Accepted values (based on git terminology): status, branch, log, stash. Ignored if --filter arg is passed.")
+	flaggy.AddPositionalValue(&gitArg, "git-arg", 1, false, "Panel to focus upon opening lazygit. Accepted values (based on git terminology): status, branch, log, stash. Ignored if --filter arg is passed. Use 'difftool <left> <right>' or 'mergetool <merged>' to have git run lazygit as its difftool or mergetool. Use 'commit', 'pull' or 'branch-picker' to only perform that action and quit when it's done; the exit code is 0 if the action succeeded.")
+
+	toolPaths := []string{"", ""}
+	flaggy.AddPositionalValue(&toolPaths[0], "path", 2, false, "For difftool: the first file to compare. For mergetool: the file with conflicts to resolve.")
+	flaggy.AddPositionalValue(&toolPaths[1], "other-path", 3, false, "For difftool: the second file to compare.")
+
+	panel := ""
+	flaggy.String(&panel, "", "panel", "Panel to focus upon opening lazygit. Accepted values: "+panelNames()+". Takes precedence over git-arg.")
+
+	commit := ""
+	flaggy.String(&commit, "", "commit", "Commit to select in the commits panel upon opening lazygit (a hash or any other revision). Can't be combined with --panel or --file.")
+
+	file := ""
+	flaggy.String(&file, "", "file", "File to select in the files panel upon opening lazygit. Can't be combined with --panel or --commit.")
+
+	outputSelection := ""
+	flaggy.String(&outputSelection, "", "output-selection", "On exit, write the selected items of the focused panel (e.g. branch names, commit hashes or file paths), one per line, to the given file. Use '-' for stdout.")
+
+	attach := false
+	flaggy.Bool(&attach, "", "attach", "Attach to the lazygit session of this repo, starting one if there isn't one yet. A session keeps running in the background when you quit, so that attaching to it again is instant, and several terminals can attach to the same session.")
+
+	killSession := false
+	flaggy.Bool(&killSession, "", "kill-session", "End the lazygit session of this repo, if there is one")
 
 	printVersionInfo := false
 	flaggy.Bool(&printVersionInfo, "v", "version", "Print the current version")
@@ -199,7 +285,7 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	flaggy.Bool(&tailLogs, "l", "logs", "Tail lazygit logs (intended to be used when `lazygit --debug` is called in a separate terminal tab)")
 
 	profile := false
-	flaggy.Bool(&profile, "", "profile", "Start the profiler and serve it on http port 6060. See CONTRIBUTING.md for more info.")
+	flaggy.Bool(&profile, "", "profile", "Start the profiler and serve it on http port 6060, and write the timings of git commands and refreshes to a trace file. See docs/dev/Profiling.md for more info.")
 
 	printDefaultConfig := false
 	flaggy.Bool(&printDefaultConfig, "c", "config", "Print the default config")
@@ -231,7 +317,14 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	return &cliArgs{
 		RepoPath:           repoPath,
 		FilterPath:         filterPath,
+		FilterAuthor:       filterAuthor,
+		FilterSince:        filterSince,
+		FilterUntil:        filterUntil,
 		GitArg:             gitArg,
+		ToolPaths:          lo.Compact(toolPaths),
+		Panel:              panel,
+		Commit:             commit,
+		File:               file,
 		PrintVersionInfo:   printVersionInfo,
 		Debug:              debug,
 		TailLogs:           tailLogs,
@@ -243,6 +336,9 @@ func parseCliArgsAndEnvVars() *cliArgs {
 		GitDir:             gitDir,
 		CustomConfigFile:   customConfigFile,
 		ScreenMode:         screenMode,
+		OutputSelection:    outputSelection,
+		Attach:             attach,
+		KillSession:        killSession,
 	}
 }
 
@@ -251,7 +347,9 @@ func parseGitArg(gitArg string) appTypes.GitArg {
 
 	// using switch so that linter catches when a new git arg value is defined but not handled here
 	switch typedArg {
-	case appTypes.GitArgNone, appTypes.GitArgStatus, appTypes.GitArgBranch, appTypes.GitArgLog, appTypes.GitArgStash:
+	case appTypes.GitArgNone, appTypes.GitArgStatus, appTypes.GitArgBranch, appTypes.GitArgLog, appTypes.GitArgStash,
+		appTypes.GitArgDifftool, appTypes.GitArgMergetool,
+		appTypes.GitArgCommit, appTypes.GitArgPull, appTypes.GitArgBranchPicker:
 		return typedArg
 	}
 
@@ -260,6 +358,11 @@ func parseGitArg(gitArg string) appTypes.GitArg {
 		string(appTypes.GitArgBranch),
 		string(appTypes.GitArgLog),
 		string(appTypes.GitArgStash),
+		string(appTypes.GitArgDifftool),
+		string(appTypes.GitArgMergetool),
+		string(appTypes.GitArgCommit),
+		string(appTypes.GitArgPull),
+		string(appTypes.GitArgBranchPicker),
 	}
 
 	log.Fatalf("Invalid git arg value: '%s'. Must be one of the following values: %s. e.g. 'lazygit status'. See 'lazygit --help'.",
@@ -270,6 +373,81 @@ func parseGitArg(gitArg string) appTypes.GitArg {
 	panic("unreachable")
 }
 
+// Checks that we got as many paths as the git arg needs, and makes them
+// absolute so that they stay valid when we change directories
+func parseToolPaths(gitArg appTypes.GitArg, paths []string) []string {
+	expectedCount := 0
+	usage := ""
+	switch gitArg {
+	case appTypes.GitArgDifftool:
+		expectedCount = 2
+		usage = "lazygit difftool <left> <right>"
+	case appTypes.GitArgMergetool:
+		expectedCount = 1
+		usage = "lazygit mergetool <merged>"
+	}
+
+	if len(paths) != expectedCount {
+		if expectedCount == 0 {
+			log.Fatalf("Unexpected argument: '%s'. See 'lazygit --help'.", paths[0])
+		}
+		log.Fatalf("Expected %d path(s) for '%s'. Usage: %s", expectedCount, gitArg, usage)
+	}
+
+	return lo.Map(paths, func(path string, _ int) string {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return absPath
+	})
+}
+
+// Validates the --panel, --commit and --file args, and makes the file path
+// absolute so that it stays valid when we change directories
+func parsePanelArgs(panel string, commit string, file string) (appTypes.Panel, string) {
+	if len(lo.Compact([]string{panel, commit, file})) > 1 {
+		log.Fatal("Only one of --panel, --commit and --file can be used at a time. See 'lazygit --help'.")
+	}
+
+	typedPanel := appTypes.Panel(panel)
+	if typedPanel != appTypes.PanelNone && !lo.Contains(appTypes.AllPanels, typedPanel) {
+		log.Fatalf("Invalid panel: '%s'. Must be one of the following values: %s. See 'lazygit --help'.",
+			panel,
+			panelNames(),
+		)
+	}
+
+	if file != "" {
+		absFile, err := filepath.Abs(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		file = absFile
+	}
+
+	return typedPanel, file
+}
+
+// Makes the path absolute so that it stays valid when we change directories
+func parseOutputSelection(path string) string {
+	if path == "" || path == "-" {
+		return path
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return absPath
+}
+
+func panelNames() string {
+	return strings.Join(lo.Map(appTypes.AllPanels, func(panel appTypes.Panel, _ int) string {
+		return string(panel)
+	}), ", ")
+}
+
 // the buildInfo struct we get passed in is based on what's baked into the lazygit
 // binary via the LDFLAGS argument. Some lazygit distributions will make use of these
 // arguments and some will not. Go recently started baking in build info
@@ -310,6 +488,23 @@ func mergeBuildInfo(buildInfo *BuildInfo) {
 	}
 }
 
+func handleSession(cliArgs *cliArgs, startDir string) {
+	output, err := exec.Command("git", "rev-parse", "--absolute-git-dir").Output()
+	if err != nil {
+		log.Fatal("lazygit sessions can only be used in a git repository")
+	}
+	socketPath := session.SocketPath(strings.TrimSpace(string(output)))
+
+	if cliArgs.KillSession {
+		err = session.Kill(socketPath)
+	} else {
+		err = session.Attach(socketPath, startDir, lo.Without(os.Args[1:], "--attach"))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 func getGitVersionInfo() string {
 	cmd := exec.Command("git", "--version")
 	stdout, _ := cmd.Output()
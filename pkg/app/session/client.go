@@ -0,0 +1,153 @@
+//go:build !windows
+
+package session
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/term"
+)
+
+// How long we wait for a server that we started to listen on its socket
+const serverStartTimeout = 5 * time.Second
+
+// Attaches the terminal to the session with the given socket, starting a
+// server for it if there isn't one yet. The server runs lazygit with the given
+// args in the given directory. Returns once the client is detached.
+func Attach(socketPath string, dir string, args []string) error {
+	if err := ensureSocketDir(filepath.Dir(socketPath)); err != nil {
+		return err
+	}
+
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		if err := startServer(socketPath, dir, args); err != nil {
+			return err
+		}
+
+		if conn, err = waitForServer(socketPath); err != nil {
+			return err
+		}
+	}
+	defer conn.Close()
+
+	stdinFd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(stdinFd)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = term.Restore(stdinFd, oldState) }()
+
+	if err := sendTerminalSize(conn); err != nil {
+		return err
+	}
+
+	resizes := make(chan os.Signal, 1)
+	signal.Notify(resizes, syscall.SIGWINCH)
+	defer signal.Stop(resizes)
+	go func() {
+		for range resizes {
+			_ = sendTerminalSize(conn)
+		}
+	}()
+
+	go forwardInput(conn)
+
+	// The server closes the connection when we're detached or lazygit exits
+	_, err = io.Copy(os.Stdout, conn)
+	return err
+}
+
+// Ends the session with the given socket, if there is one, and waits until it
+// has ended
+func Kill(socketPath string) error {
+	if err := checkSocketDir(filepath.Dir(socketPath)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	if err := writeMessage(conn, messageKill, nil); err != nil {
+		return err
+	}
+
+	// The server closes the connection when lazygit exits
+	_, err = io.Copy(io.Discard, conn)
+	return err
+}
+
+func startServer(socketPath string, dir string, args []string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(executable, args...)
+	cmd.Dir = dir
+	cmd.Env = append(lo.Filter(os.Environ(), func(envVar string, _ int) bool {
+		return !hasEnvKey(envVar, SessionEnvKey)
+	}), ServerEnvKey+"="+socketPath)
+	// Detach the server from our terminal, so that it keeps running when the
+	// terminal is closed
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	return cmd.Process.Release()
+}
+
+func waitForServer(socketPath string) (net.Conn, error) {
+	deadline := time.Now().Add(serverStartTimeout)
+	for {
+		conn, err := net.Dial("unix", socketPath)
+		if err == nil {
+			return conn, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("lazygit session didn't start: %w", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func sendTerminalSize(conn net.Conn) error {
+	cols, rows, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return err
+	}
+
+	return writeMessage(conn, messageResize, resizePayload(uint16(cols), uint16(rows)))
+}
+
+func forwardInput(conn net.Conn) {
+	buffer := make([]byte, 1024)
+	for {
+		n, err := os.Stdin.Read(buffer)
+		if n > 0 {
+			if writeMessage(conn, messageInput, buffer[:n]) != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
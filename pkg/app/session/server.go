@@ -0,0 +1,286 @@
+//go:build !windows
+
+package session
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/creack/pty"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
+	"golang.org/x/sys/unix"
+)
+
+type server struct {
+	ptmx  *os.File
+	cmd   *exec.Cmd
+	modes *terminalModes
+
+	// guards clients and modes
+	mutex   deadlock.Mutex
+	clients map[*client]bool
+
+	// the goroutines that write to the clients
+	writers sync.WaitGroup
+}
+
+// Each client has a goroutine that writes the output to it, so that a client
+// that doesn't read its output fast enough doesn't hold up the others
+type client struct {
+	conn   net.Conn
+	output chan []byte
+}
+
+// How many chunks of output we queue for a client before we give up on it
+const clientOutputQueueSize = 256
+
+// How long a write to a client may take before we give up on it
+const clientWriteTimeout = 5 * time.Second
+
+// Runs lazygit with the given args in a pseudo terminal, and relays between it
+// and the clients that connect to the socket, until lazygit exits
+func Serve(socketPath string, args []string) error {
+	lockFile, err := lockSocket(socketPath)
+	if err != nil {
+		return err
+	}
+	defer lockFile.Close()
+
+	listener, err := listen(socketPath)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(executable, args...)
+	cmd.Env = append(lo.Filter(os.Environ(), func(envVar string, _ int) bool {
+		return !hasEnvKey(envVar, ServerEnvKey)
+	}), SessionEnvKey+"=1")
+
+	// The size doesn't matter much, the first client that attaches sets it
+	ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{Cols: 80, Rows: 24})
+	if err != nil {
+		return err
+	}
+	defer ptmx.Close()
+
+	server := &server{
+		ptmx:    ptmx,
+		cmd:     cmd,
+		modes:   newTerminalModes(),
+		clients: map[*client]bool{},
+	}
+
+	go server.acceptClients(listener)
+
+	server.relayOutput()
+
+	// Let the clients receive the last of the output before we exit
+	server.writers.Wait()
+
+	return cmd.Wait()
+}
+
+// Two clients that attach at the same time might both start a server, so the
+// server that serves the socket holds an exclusive lock on a file next to it
+// for as long as it runs. The lock is released when the process exits, even if
+// it crashes.
+func lockSocket(socketPath string) (*os.File, error) {
+	if err := ensureSocketDir(filepath.Dir(socketPath)); err != nil {
+		return nil, err
+	}
+
+	lockFile, err := os.OpenFile(socketPath+".lock", os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unix.Flock(int(lockFile.Fd()), unix.LOCK_EX|unix.LOCK_NB); err != nil {
+		lockFile.Close()
+		if errors.Is(err, unix.EWOULDBLOCK) {
+			return nil, fmt.Errorf("a lazygit session is already running for %s", socketPath)
+		}
+		return nil, err
+	}
+
+	return lockFile, nil
+}
+
+// Must only be called while holding the lock from lockSocket
+func listen(socketPath string) (net.Listener, error) {
+	// A socket that's left over from a server that crashed would make listening
+	// fail. We hold the lock, so no other server is listening on it.
+	_ = os.Remove(socketPath)
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+	listener.(*net.UnixListener).SetUnlinkOnClose(true)
+
+	return listener, nil
+}
+
+func (self *server) acceptClients(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go self.handleClient(conn)
+	}
+}
+
+func (self *server) handleClient(conn net.Conn) {
+	client := self.addClient(conn)
+	defer self.removeClient(client)
+
+	for {
+		kind, payload, err := readMessage(conn)
+		if err != nil {
+			return
+		}
+
+		switch kind {
+		case messageInput:
+			_, _ = self.ptmx.Write(payload)
+		case messageResize:
+			if cols, rows, ok := parseResizePayload(payload); ok {
+				self.resize(cols, rows)
+			}
+		case messageKill:
+			_ = self.cmd.Process.Signal(syscall.SIGTERM)
+		}
+	}
+}
+
+func (self *server) addClient(conn net.Conn) *client {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	client := &client{conn: conn, output: make(chan []byte, clientOutputQueueSize)}
+	client.output <- []byte(self.modes.setupSequence())
+	self.clients[client] = true
+
+	self.writers.Add(1)
+	go self.writeToClient(client)
+
+	return client
+}
+
+// Writes the client's output until it's removed, then closes the connection
+func (self *server) writeToClient(client *client) {
+	defer self.writers.Done()
+	defer client.conn.Close()
+
+	failed := false
+	for chunk := range client.output {
+		if failed {
+			continue
+		}
+
+		_ = client.conn.SetWriteDeadline(time.Now().Add(clientWriteTimeout))
+		if _, err := client.conn.Write(chunk); err != nil {
+			failed = true
+			self.removeClient(client)
+		}
+	}
+}
+
+// Lazygit only redraws everything when the size of its terminal changes, so if
+// a client attaches with the size that we already have, we change it briefly
+// to make lazygit draw the whole screen for the client
+func (self *server) resize(cols uint16, rows uint16) {
+	if size, err := pty.GetsizeFull(self.ptmx); err == nil && size.Cols == cols && size.Rows == rows && rows > 1 {
+		_ = pty.Setsize(self.ptmx, &pty.Winsize{Cols: cols, Rows: rows - 1})
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	_ = pty.Setsize(self.ptmx, &pty.Winsize{Cols: cols, Rows: rows})
+}
+
+// Stops sending output to the client. Its connection is closed once the output
+// that's already queued for it has been written.
+func (self *server) removeClient(client *client) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.removeClientLocked(client)
+}
+
+func (self *server) removeClientLocked(client *client) {
+	if !self.clients[client] {
+		return
+	}
+
+	delete(self.clients, client)
+	close(client.output)
+}
+
+func (self *server) relayOutput() {
+	buffer := make([]byte, 32*1024)
+	for {
+		n, err := self.ptmx.Read(buffer)
+		if n > 0 {
+			self.broadcast(buffer[:n])
+		}
+		if err != nil {
+			// Reading from the pty fails once lazygit has exited (with EIO on
+			// Linux), which ends the session
+			self.detachAll(false)
+			return
+		}
+	}
+}
+
+func (self *server) broadcast(chunk []byte) {
+	self.mutex.Lock()
+	output, detach := self.modes.process(chunk)
+	for client := range self.clients {
+		// The chunk is reused for the next read from the pty
+		select {
+		case client.output <- append([]byte(nil), output...):
+		default:
+			// The client has fallen too far behind; drop it rather than
+			// making everyone else wait for it
+			self.removeClientLocked(client)
+			client.conn.Close()
+		}
+	}
+	self.mutex.Unlock()
+
+	if detach {
+		self.detachAll(true)
+	}
+}
+
+// Disconnects all clients. If lazygit keeps running, we restore the clients'
+// terminals, otherwise lazygit has already done that.
+func (self *server) detachAll(restoreTerminals bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for client := range self.clients {
+		if restoreTerminals {
+			select {
+			case client.output <- []byte(self.modes.teardownSequence()):
+			default:
+			}
+		}
+		self.removeClientLocked(client)
+	}
+}
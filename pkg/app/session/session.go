@@ -0,0 +1,155 @@
+package session
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// A session is a lazygit process that keeps running in the background for a
+// repo, so that its state and caches stay warm, and that any number of
+// terminals can attach to (like `tmux attach`), showing the same UI. It is
+// made of three processes:
+//
+//   - The client is what the user runs with `lazygit --attach`. It puts the
+//     terminal into raw mode and forwards input and output to the server,
+//     starting a server first if there isn't one for the repo yet.
+//   - The server runs lazygit in a pseudo terminal and relays between it and
+//     the clients that are connected to its unix socket.
+//   - The lazygit process itself, which knows it's in a session and detaches
+//     the clients when the user quits, rather than exiting.
+
+const (
+	// Tells lazygit to run as a session server listening on the socket with
+	// the path given in this env var
+	ServerEnvKey = "LAZYGIT_SESSION_SERVER"
+
+	// Set for the lazygit process that the server runs
+	SessionEnvKey = "LAZYGIT_SESSION"
+)
+
+// Lazygit writes this to its terminal when the user quits in a session, so that
+// the server detaches the clients. Terminals ignore unknown OSC sequences, but
+// the server strips it anyway.
+var detachSequence = []byte("\x1b]lazygit;detach\x07")
+
+var inSession = os.Getenv(SessionEnvKey) != ""
+
+// Programs that lazygit runs (e.g. a shell, which the user might start another
+// lazygit from) are not part of the session
+func init() {
+	os.Unsetenv(SessionEnvKey)
+}
+
+// Whether this lazygit process was started by a session server
+func InSession() bool {
+	return inSession
+}
+
+// Detaches all clients from the session that this lazygit process runs in
+func RequestDetach() error {
+	_, err := os.Stdout.Write(detachSequence)
+	return err
+}
+
+func hasEnvKey(envVar string, key string) bool {
+	return strings.HasPrefix(envVar, key+"=")
+}
+
+// Returns the path of the socket of the session for the given git dir. We use
+// a hash of the git dir rather than the path itself, because socket paths are
+// limited to about a hundred bytes.
+func SocketPath(gitDir string) string {
+	hash := sha256.Sum256([]byte(gitDir))
+	return filepath.Join(socketDir(), hex.EncodeToString(hash[:8])+".sock")
+}
+
+// Anyone who can connect to a socket can type into the session, so the
+// sockets live in a directory that only the current user can access. We
+// prefer the user's runtime dir, which nobody else can create files in; the
+// temp dir is shared, so there we have to check that the directory is ours
+// before using it (see checkSocketDir).
+func socketDir() string {
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+		return filepath.Join(runtimeDir, "lazygit-sessions")
+	}
+
+	return filepath.Join(os.TempDir(), fmt.Sprintf("lazygit-sessions-%d", os.Getuid()))
+}
+
+// The largest payload that we accept. Clients send input in chunks of at most
+// a kilobyte, so this is plenty; it's only there so that a bogus length
+// doesn't make us allocate gigabytes.
+const maxPayloadSize = 1 << 20
+
+// Clients send messages to the server in frames of a type byte, the length of
+// the payload as a big-endian uint32, and the payload. The server sends the
+// output of lazygit as it is.
+type messageType byte
+
+const (
+	// Keys (or pasted text) that the user typed
+	messageInput messageType = iota
+	// The size of the client's terminal, as two big-endian uint16s: columns
+	// and rows
+	messageResize
+	// Ends the session
+	messageKill
+)
+
+func writeMessage(w io.Writer, kind messageType, payload []byte) error {
+	header := make([]byte, 5)
+	header[0] = byte(kind)
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+	_, err := w.Write(append(header, payload...))
+	return err
+}
+
+func readMessage(r io.Reader) (messageType, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxPayloadSize {
+		return 0, nil, fmt.Errorf("session message too large: %d bytes", size)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+
+	return messageType(header[0]), payload, nil
+}
+
+func resizePayload(cols uint16, rows uint16) []byte {
+	payload := make([]byte, 4)
+	binary.BigEndian.PutUint16(payload, cols)
+	binary.BigEndian.PutUint16(payload[2:], rows)
+	return payload
+}
+
+func parseResizePayload(payload []byte) (uint16, uint16, bool) {
+	if len(payload) != 4 {
+		return 0, 0, false
+	}
+
+	return binary.BigEndian.Uint16(payload), binary.BigEndian.Uint16(payload[2:]), true
+}
+
+// Removes the detach sequence from the output, returning whether it was there
+func stripDetachSequence(output []byte) ([]byte, bool) {
+	if !bytes.Contains(output, detachSequence) {
+		return output, false
+	}
+
+	return bytes.ReplaceAll(output, detachSequence, nil), true
+}
@@ -0,0 +1,28 @@
+package session
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadMessage(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, writeMessage(buf, messageInput, []byte("hello")))
+
+	msgType, payload, err := readMessage(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, messageInput, msgType)
+	assert.Equal(t, []byte("hello"), payload)
+}
+
+func TestReadMessageTooLarge(t *testing.T) {
+	header := make([]byte, 5)
+	header[0] = byte(messageInput)
+	binary.BigEndian.PutUint32(header[1:], maxPayloadSize+1)
+
+	_, _, err := readMessage(bytes.NewReader(header))
+	assert.EqualError(t, err, "session message too large: 1048577 bytes")
+}
@@ -0,0 +1,17 @@
+package session
+
+import "errors"
+
+var errNotSupported = errors.New("lazygit sessions are not supported on Windows")
+
+func Serve(socketPath string, args []string) error {
+	return errNotSupported
+}
+
+func Attach(socketPath string, dir string, args []string) error {
+	return errNotSupported
+}
+
+func Kill(socketPath string) error {
+	return errNotSupported
+}
@@ -0,0 +1,44 @@
+//go:build !windows
+
+package session
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// Creates the directory for the session sockets if it doesn't exist yet, and
+// checks that it's safe to use
+func ensureSocketDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	return checkSocketDir(dir)
+}
+
+// Refuses a socket directory that another user could have created or could
+// write to: it must be a real directory (not a symlink), owned by us, and
+// accessible only to us. Otherwise somebody else could put a socket there that
+// our client connects to, or connect to our server's socket.
+func checkSocketDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("session socket directory %s is not a directory", dir)
+	}
+
+	if stat, ok := info.Sys().(*syscall.Stat_t); !ok || int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("session socket directory %s is not owned by the current user", dir)
+	}
+
+	if info.Mode().Perm() != 0o700 {
+		return fmt.Errorf("session socket directory %s must only be accessible to its owner (mode 0700), but has mode %#o", dir, info.Mode().Perm())
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+//go:build !windows
+
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSocketDir(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		setup         func(dir string) string
+		expectedError string
+	}{
+		{
+			name: "directory created by ensureSocketDir",
+			setup: func(dir string) string {
+				path := filepath.Join(dir, "sessions")
+				assert.NoError(t, ensureSocketDir(path))
+				return path
+			},
+		},
+		{
+			name: "directory accessible to others",
+			setup: func(dir string) string {
+				path := filepath.Join(dir, "sessions")
+				assert.NoError(t, os.Mkdir(path, 0o700))
+				assert.NoError(t, os.Chmod(path, 0o777))
+				return path
+			},
+			expectedError: "must only be accessible to its owner (mode 0700), but has mode 0777",
+		},
+		{
+			name: "symlink to a directory",
+			setup: func(dir string) string {
+				target := filepath.Join(dir, "target")
+				assert.NoError(t, os.Mkdir(target, 0o700))
+				path := filepath.Join(dir, "sessions")
+				assert.NoError(t, os.Symlink(target, path))
+				return path
+			},
+			expectedError: "is not a directory",
+		},
+		{
+			name: "regular file",
+			setup: func(dir string) string {
+				path := filepath.Join(dir, "sessions")
+				assert.NoError(t, os.WriteFile(path, nil, 0o600))
+				return path
+			},
+			expectedError: "is not a directory",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			path := s.setup(t.TempDir())
+			err := checkSocketDir(path)
+			if s.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, s.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Sets or resets DEC private modes, e.g. `ESC [ ? 1049 h` to switch to the
+// alternate screen or `ESC [ ? 1000 ; 1006 h` to enable mouse reporting
+var privateModeRegex = regexp.MustCompile(`\x1b\[\?([0-9;]+)([hl])`)
+
+// Unlike other modes, this one is enabled by default; lazygit resets it to hide
+// the cursor
+const cursorVisibleMode = 25
+
+// The start of an escape sequence that may continue in the next chunk of output
+var incompleteSequenceRegex = regexp.MustCompile(`\x1b(\[\??[0-9;]*)?$`)
+
+// Lazygit sets up the terminal (alternate screen, mouse reporting, etc.) only
+// once when it starts, so we keep track of the modes that it set, to set up the
+// terminals of clients that attach later in the same way.
+type terminalModes struct {
+	enabled map[int]bool
+	// The end of the previous chunk of output, if it may be the start of an
+	// escape sequence that continues in the next chunk
+	pending []byte
+}
+
+func newTerminalModes() *terminalModes {
+	return &terminalModes{enabled: map[int]bool{}}
+}
+
+// Takes the next chunk of lazygit's output and returns what to send to the
+// clients, which may hold back the start of an escape sequence until the rest
+// of it arrives. Also returns whether lazygit asked to detach the clients.
+func (self *terminalModes) process(chunk []byte) ([]byte, bool) {
+	output := append(self.pending, chunk...)
+	self.pending = nil
+
+	output, detach := stripDetachSequence(output)
+
+	for _, match := range privateModeRegex.FindAllSubmatch(output, -1) {
+		for _, mode := range strings.Split(string(match[1]), ";") {
+			if number, err := strconv.Atoi(mode); err == nil {
+				self.enabled[number] = string(match[2]) == "h"
+			}
+		}
+	}
+
+	if index := bytes.LastIndexByte(output, '\x1b'); index >= 0 {
+		tail := output[index:]
+		if incompleteSequenceRegex.Match(tail) || bytes.HasPrefix(detachSequence, tail) {
+			self.pending = slices.Clone(tail)
+			output = output[:index]
+		}
+	}
+
+	return output, detach
+}
+
+// Sets up a newly attached client's terminal. We replay modes that lazygit
+// reset too, e.g. to hide the cursor.
+func (self *terminalModes) setupSequence() string {
+	modes := lo.Keys(self.enabled)
+	slices.Sort(modes)
+	return lo.Reduce(modes, func(acc string, mode int, _ int) string {
+		return acc + modeSequence(mode, self.enabled[mode])
+	}, "")
+}
+
+// Restores a detaching client's terminal, which is what lazygit would do when
+// quitting
+func (self *terminalModes) teardownSequence() string {
+	modes := lo.Filter(lo.Keys(self.enabled), func(mode int, _ int) bool {
+		return self.enabled[mode] && mode != cursorVisibleMode
+	})
+	slices.Sort(modes)
+	slices.Reverse(modes)
+	return lo.Reduce(modes, func(acc string, mode int, _ int) string {
+		return acc + modeSequence(mode, false)
+	}, "\x1b[0m") + modeSequence(cursorVisibleMode, true)
+}
+
+func modeSequence(mode int, enabled bool) string {
+	if enabled {
+		return fmt.Sprintf("\x1b[?%dh", mode)
+	}
+	return fmt.Sprintf("\x1b[?%dl", mode)
+}
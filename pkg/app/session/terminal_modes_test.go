@@ -0,0 +1,71 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerminalModesProcess(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		chunks         []string
+		expectedOutput []string
+		expectedDetach bool
+		expectedSetup  string
+	}{
+		{
+			name:           "no escape sequences",
+			chunks:         []string{"hello"},
+			expectedOutput: []string{"hello"},
+			expectedSetup:  "",
+		},
+		{
+			name:           "modes set and reset",
+			chunks:         []string{"\x1b[?1049h\x1b[?25l\x1b[?1000;1006hfoo\x1b[?1000l"},
+			expectedOutput: []string{"\x1b[?1049h\x1b[?25l\x1b[?1000;1006hfoo\x1b[?1000l"},
+			expectedSetup:  "\x1b[?25l\x1b[?1000l\x1b[?1006h\x1b[?1049h",
+		},
+		{
+			name:           "mode sequence split across chunks",
+			chunks:         []string{"foo\x1b[?10", "49hbar"},
+			expectedOutput: []string{"foo", "\x1b[?1049hbar"},
+			expectedSetup:  "\x1b[?1049h",
+		},
+		{
+			name:           "detach sequence",
+			chunks:         []string{"foo\x1b]lazygit;detach\x07bar"},
+			expectedOutput: []string{"foobar"},
+			expectedDetach: true,
+			expectedSetup:  "",
+		},
+		{
+			name:           "detach sequence split across chunks",
+			chunks:         []string{"foo\x1b]lazy", "git;detach\x07"},
+			expectedOutput: []string{"foo", ""},
+			expectedDetach: true,
+			expectedSetup:  "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			modes := newTerminalModes()
+			detach := false
+			for i, chunk := range s.chunks {
+				output, chunkDetach := modes.process([]byte(chunk))
+				assert.Equal(t, s.expectedOutput[i], string(output))
+				detach = detach || chunkDetach
+			}
+			assert.Equal(t, s.expectedDetach, detach)
+			assert.Equal(t, s.expectedSetup, modes.setupSequence())
+		})
+	}
+}
+
+func TestTerminalModesTeardownSequence(t *testing.T) {
+	modes := newTerminalModes()
+	modes.process([]byte("\x1b[?1049h\x1b[?25l\x1b[?1000h\x1b[?2004l"))
+
+	assert.Equal(t, "\x1b[0m\x1b[?1049l\x1b[?1000l\x1b[?25h", modes.teardownSequence())
+}
@@ -8,12 +8,33 @@ import (
 type StartArgs struct {
 	// GitArg determines what context we open in
 	GitArg GitArg
+	// Panel, Commit and File determine what context we open in and what to
+	// select in it; they take precedence over GitArg. They are meant for
+	// scripts and editor plugins that want to deep-link into lazygit.
+	Panel Panel
+	// A commit to select in the commits panel; can be any revision that git
+	// understands
+	Commit string
+	// The absolute path of a file to select in the files panel
+	File string
 	// integration test (only relevant when invoking lazygit in the context of an integration test)
 	IntegrationTest integrationTypes.IntegrationTest
 	// FilterPath determines which path we're going to filter on so that we only see commits from that file.
 	FilterPath string
+	// FilterAuthor, FilterSince and FilterUntil filter the commits like the
+	// corresponding git log flags
+	FilterAuthor string
+	FilterSince  string
+	FilterUntil  string
 	// ScreenMode determines the initial Screen Mode (normal, half or full) to use
 	ScreenMode string
+	// OutputSelection is the absolute path of a file to write the selected
+	// items to on exit, or "-" for stdout
+	OutputSelection string
+	// ToolPaths are the absolute paths of the files that git passed to us when
+	// GitArg is GitArgDifftool (the two files to compare) or GitArgMergetool
+	// (the file with conflicts)
+	ToolPaths []string
 }
 
 type GitArg string
@@ -24,13 +45,74 @@ const (
 	GitArgBranch GitArg = "branch"
 	GitArgLog    GitArg = "log"
 	GitArgStash  GitArg = "stash"
+	// git starts us with these when lazygit is configured as its difftool or
+	// mergetool
+	GitArgDifftool  GitArg = "difftool"
+	GitArgMergetool GitArg = "mergetool"
+	// these run a single action and quit when it's done, for using lazygit's
+	// UIs from shell workflows
+	GitArgCommit       GitArg = "commit"
+	GitArgPull         GitArg = "pull"
+	GitArgBranchPicker GitArg = "branch-picker"
 )
 
-func NewStartArgs(filterPath string, gitArg GitArg, screenMode string, test integrationTypes.IntegrationTest) StartArgs {
+// Whether lazygit quits once the action for this git arg is done, rather than
+// staying open
+func (self GitArg) IsSingleAction() bool {
+	switch self {
+	case GitArgCommit, GitArgPull, GitArgBranchPicker:
+		return true
+	}
+	return false
+}
+
+type Panel string
+
+const (
+	PanelNone       Panel = ""
+	PanelStatus     Panel = "status"
+	PanelFiles      Panel = "files"
+	PanelWorktrees  Panel = "worktrees"
+	PanelSubmodules Panel = "submodules"
+	PanelBranches   Panel = "branches"
+	PanelRemotes    Panel = "remotes"
+	PanelTags       Panel = "tags"
+	PanelCommits    Panel = "commits"
+	PanelReflog     Panel = "reflog"
+	PanelStash      Panel = "stash"
+)
+
+var AllPanels = []Panel{
+	PanelStatus,
+	PanelFiles,
+	PanelWorktrees,
+	PanelSubmodules,
+	PanelBranches,
+	PanelRemotes,
+	PanelTags,
+	PanelCommits,
+	PanelReflog,
+	PanelStash,
+}
+
+func NewStartArgs(filterPath string, filterAuthor string, filterSince string, filterUntil string, gitArg GitArg, toolPaths []string, panel Panel, commit string, file string, screenMode string, outputSelection string, test integrationTypes.IntegrationTest) StartArgs {
 	return StartArgs{
 		FilterPath:      filterPath,
+		FilterAuthor:    filterAuthor,
+		FilterSince:     filterSince,
+		FilterUntil:     filterUntil,
 		GitArg:          gitArg,
+		Panel:           panel,
+		Commit:          commit,
+		File:            file,
+		ToolPaths:       toolPaths,
 		ScreenMode:      screenMode,
+		OutputSelection: outputSelection,
 		IntegrationTest: test,
 	}
 }
+
+// Whether lazygit starts in filtering mode
+func (self StartArgs) IsFiltering() bool {
+	return self.FilterPath != "" || self.FilterAuthor != "" || self.FilterSince != "" || self.FilterUntil != ""
+}
@@ -11,14 +11,11 @@
 package cheatsheet
 
 import (
-	"cmp"
 	"fmt"
 	"log"
 	"os"
-	"slices"
 	"strings"
 
-	"github.com/jesseduffield/generics/maps"
 	"github.com/jesseduffield/lazycore/pkg/utils"
 	"github.com/jesseduffield/lazygit/pkg/app"
 	"github.com/jesseduffield/lazygit/pkg/config"
@@ -33,17 +30,6 @@ type bindingSection struct {
 	bindings []*types.Binding
 }
 
-type header struct {
-	// priority decides the order of the headers in the cheatsheet (lower means higher)
-	priority int
-	title    string
-}
-
-type headerWithBindings struct {
-	header   header
-	bindings []*types.Binding
-}
-
 func CommandToRun() string {
 	return "go generate ./..."
 }
@@ -97,103 +83,15 @@ func writeString(file *os.File, str string) {
 	}
 }
 
-func localisedTitle(tr *i18n.TranslationSet, str string) string {
-	contextTitleMap := map[string]string{
-		"global":            tr.GlobalTitle,
-		"navigation":        tr.NavigationTitle,
-		"branches":          tr.BranchesTitle,
-		"localBranches":     tr.LocalBranchesTitle,
-		"files":             tr.FilesTitle,
-		"status":            tr.StatusTitle,
-		"submodules":        tr.SubmodulesTitle,
-		"subCommits":        tr.SubCommitsTitle,
-		"remoteBranches":    tr.RemoteBranchesTitle,
-		"remotes":           tr.RemotesTitle,
-		"reflogCommits":     tr.ReflogCommitsTitle,
-		"tags":              tr.TagsTitle,
-		"commitFiles":       tr.CommitFilesTitle,
-		"commitMessage":     tr.CommitSummaryTitle,
-		"commitDescription": tr.CommitDescriptionTitle,
-		"commits":           tr.CommitsTitle,
-		"confirmation":      tr.ConfirmationTitle,
-		"prompt":            tr.PromptTitle,
-		"information":       tr.InformationTitle,
-		"main":              tr.NormalTitle,
-		"patchBuilding":     tr.PatchBuildingTitle,
-		"mergeConflicts":    tr.MergingTitle,
-		"staging":           tr.StagingTitle,
-		"menu":              tr.MenuTitle,
-		"search":            tr.SearchTitle,
-		"secondary":         tr.SecondaryTitle,
-		"stash":             tr.StashTitle,
-		"suggestions":       tr.SuggestionsCheatsheetTitle,
-		"extras":            tr.ExtrasTitle,
-		"worktrees":         tr.WorktreesTitle,
-	}
-
-	title, ok := contextTitleMap[str]
-	if !ok {
-		panic(fmt.Sprintf("title not found for %s", str))
-	}
-
-	return title
-}
-
 func getBindingSections(bindings []*types.Binding, tr *i18n.TranslationSet) []*bindingSection {
-	excludedViews := []string{"stagingSecondary", "patchBuildingSecondary"}
-	bindingsToDisplay := lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
-		if lo.Contains(excludedViews, binding.ViewName) {
-			return false
-		}
-
-		return (binding.Description != "" || binding.Alternative != "") && binding.Key != nil
-	})
-
-	bindingsByHeader := lo.GroupBy(bindingsToDisplay, func(binding *types.Binding) header {
-		return getHeader(binding, tr)
-	})
-
-	bindingGroups := maps.MapToSlice(
-		bindingsByHeader,
-		func(header header, hBindings []*types.Binding) headerWithBindings {
-			uniqBindings := lo.UniqBy(hBindings, func(binding *types.Binding) string {
-				return binding.Description + keybindings.LabelFromKey(binding.Key)
-			})
-
-			return headerWithBindings{
-				header:   header,
-				bindings: uniqBindings,
-			}
-		},
-	)
-
-	slices.SortFunc(bindingGroups, func(a, b headerWithBindings) int {
-		if a.header.priority != b.header.priority {
-			return cmp.Compare(b.header.priority, a.header.priority)
-		}
-		return strings.Compare(a.header.title, b.header.title)
-	})
-
-	return lo.Map(bindingGroups, func(hb headerWithBindings, _ int) *bindingSection {
+	return lo.Map(keybindings.GetBindingSections(bindings, tr), func(section *keybindings.BindingSection, _ int) *bindingSection {
 		return &bindingSection{
-			title:    hb.header.title,
-			bindings: hb.bindings,
+			title:    section.Title,
+			bindings: section.Bindings,
 		}
 	})
 }
 
-func getHeader(binding *types.Binding, tr *i18n.TranslationSet) header {
-	if binding.Tag == "navigation" {
-		return header{priority: 2, title: localisedTitle(tr, "navigation")}
-	}
-
-	if binding.ViewName == "" {
-		return header{priority: 3, title: localisedTitle(tr, "global")}
-	}
-
-	return header{priority: 1, title: localisedTitle(tr, binding.ViewName)}
-}
-
 func formatSections(tr *i18n.TranslationSet, bindingSections []*bindingSection) string {
 	var content strings.Builder
 	content.WriteString(fmt.Sprintf("# Lazygit %s\n", tr.Keybindings))
@@ -32,13 +32,22 @@ type GitCommand struct {
 	Submodule      *git_commands.SubmoduleCommands
 	Sync           *git_commands.SyncCommands
 	Tag            *git_commands.TagCommands
+	Hook           *git_commands.HookCommands
 	WorkingTree    *git_commands.WorkingTreeCommands
 	Bisect         *git_commands.BisectCommands
 	Worktree       *git_commands.WorktreeCommands
 	Version        *git_commands.GitVersion
 	RepoPaths      *git_commands.RepoPaths
 	GitHub         *git_commands.GitHubCommands
+	GitLab         *git_commands.GitLabCommands
+	Bitbucket      *git_commands.BitbucketCommands
 	HostingService *git_commands.HostingService
+	Lfs            *git_commands.LfsCommands
+	Maintenance    *git_commands.MaintenanceCommands
+	PatchSeries    *git_commands.PatchSeriesCommands
+	Absorb         *git_commands.AbsorbCommands
+	Archive        *git_commands.ArchiveCommands
+	SparseCheckout *git_commands.SparseCheckoutCommands
 
 	Loaders Loaders
 }
@@ -49,6 +58,7 @@ type Loaders struct {
 	CommitLoader       *git_commands.CommitLoader
 	FileLoader         *git_commands.FileLoader
 	ReflogCommitLoader *git_commands.ReflogCommitLoader
+	RefsLoader         *git_commands.RefsLoader
 	RemoteLoader       *git_commands.RemoteLoader
 	StashLoader        *git_commands.StashLoader
 	TagLoader          *git_commands.TagLoader
@@ -97,7 +107,7 @@ func NewGitCommandAux(
 	// and allows for better namespacing when compared to having every method living
 	// on the one struct.
 	// common ones are: cmn, osCommand, dotGitDir, configCommands
-	configCommands := git_commands.NewConfigCommands(cmn, gitConfig)
+	configCommands := git_commands.NewConfigCommands(cmn, gitConfig, osCommand)
 
 	gitCommon := git_commands.NewGitCommon(cmn, version, cmd, osCommand, repoPaths, configCommands, pagerConfig)
 
@@ -108,6 +118,7 @@ func NewGitCommandAux(
 	branchCommands := git_commands.NewBranchCommands(gitCommon)
 	syncCommands := git_commands.NewSyncCommands(gitCommon)
 	tagCommands := git_commands.NewTagCommands(gitCommon)
+	hookCommands := git_commands.NewHookCommands(gitCommon)
 	commitCommands := git_commands.NewCommitCommands(gitCommon)
 	customCommands := git_commands.NewCustomCommands(gitCommon)
 	diffCommands := git_commands.NewDiffCommands(gitCommon)
@@ -126,15 +137,24 @@ func NewGitCommandAux(
 	blameCommands := git_commands.NewBlameCommands(gitCommon)
 	gitHubCommands := git_commands.NewGitHubCommands(gitCommon)
 	hostingServiceCommands := git_commands.NewHostingServiceCommand(gitCommon)
-
-	branchLoader := git_commands.NewBranchLoader(cmn, gitCommon, cmd, branchCommands.CurrentBranchInfo, configCommands)
+	gitLabCommands := git_commands.NewGitLabCommands(gitCommon, hostingServiceCommands)
+	bitbucketCommands := git_commands.NewBitbucketCommands(gitCommon, hostingServiceCommands)
+	lfsCommands := git_commands.NewLfsCommands(gitCommon)
+	maintenanceCommands := git_commands.NewMaintenanceCommands(gitCommon)
+	patchSeriesCommands := git_commands.NewPatchSeriesCommands(gitCommon)
+	absorbCommands := git_commands.NewAbsorbCommands(gitCommon)
+	archiveCommands := git_commands.NewArchiveCommands(gitCommon)
+	sparseCheckoutCommands := git_commands.NewSparseCheckoutCommands(gitCommon)
+
+	refsLoader := git_commands.NewRefsLoader(gitCommon)
+	branchLoader := git_commands.NewBranchLoader(cmn, gitCommon, cmd, refsLoader, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
 	commitLoader := git_commands.NewCommitLoader(cmn, cmd, statusCommands.WorkingTreeState, gitCommon)
 	reflogCommitLoader := git_commands.NewReflogCommitLoader(cmn, cmd)
-	remoteLoader := git_commands.NewRemoteLoader(cmn, cmd)
+	remoteLoader := git_commands.NewRemoteLoader(cmn, cmd, refsLoader)
 	worktreeLoader := git_commands.NewWorktreeLoader(gitCommon)
 	stashLoader := git_commands.NewStashLoader(cmn, cmd)
-	tagLoader := git_commands.NewTagLoader(cmn, cmd)
+	tagLoader := git_commands.NewTagLoader(cmn, refsLoader)
 
 	return &GitCommand{
 		Blame:          blameCommands,
@@ -153,18 +173,28 @@ func NewGitCommandAux(
 		Submodule:      submoduleCommands,
 		Sync:           syncCommands,
 		Tag:            tagCommands,
+		Hook:           hookCommands,
 		Bisect:         bisectCommands,
 		WorkingTree:    workingTreeCommands,
 		Worktree:       worktreeCommands,
 		Version:        version,
 		GitHub:         gitHubCommands,
+		GitLab:         gitLabCommands,
+		Bitbucket:      bitbucketCommands,
 		HostingService: hostingServiceCommands,
+		Lfs:            lfsCommands,
+		Maintenance:    maintenanceCommands,
+		PatchSeries:    patchSeriesCommands,
+		Absorb:         absorbCommands,
+		Archive:        archiveCommands,
+		SparseCheckout: sparseCheckoutCommands,
 		Loaders: Loaders{
 			BranchLoader:       branchLoader,
 			CommitFileLoader:   commitFileLoader,
 			CommitLoader:       commitLoader,
 			FileLoader:         fileLoader,
 			ReflogCommitLoader: reflogCommitLoader,
+			RefsLoader:         refsLoader,
 			RemoteLoader:       remoteLoader,
 			Worktrees:          worktreeLoader,
 			StashLoader:        stashLoader,
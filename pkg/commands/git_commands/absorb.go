@@ -0,0 +1,65 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+// Creates the fixup commits for absorbing staged changes into the commits that
+// they belong to, like git absorb does (see FixupHelper for how we find these
+// commits). We build each fixup commit in a temporary index, so the real index
+// and the working tree stay as they are; since HEAD moves on, whatever we
+// didn't absorb is what remains staged afterwards.
+type AbsorbCommands struct {
+	*GitCommon
+}
+
+func NewAbsorbCommands(gitCommon *GitCommon) *AbsorbCommands {
+	return &AbsorbCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns the hash of HEAD, which the patches that we pass to PrepareIndex
+// are relative to
+func (self *AbsorbCommands) BaseHash() (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").Arg("--verify", "HEAD").ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// Returns the path of a new temporary index file
+func (self *AbsorbCommands) NewIndexFile() (string, error) {
+	tempDir := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName())
+	if err := os.MkdirAll(tempDir, 0o755); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(tempDir, time.Now().Format("Jan _2 15.04.05.000000000")+".absorb-index"), nil
+}
+
+// Sets the given index file to the tree of the base commit with the given
+// patch applied. The patch is made without context lines.
+func (self *AbsorbCommands) PrepareIndex(indexFile string, baseHash string, patch string) error {
+	indexEnv := "GIT_INDEX_FILE=" + indexFile
+
+	if err := self.cmd.New(NewGitCmd("read-tree").Arg(baseHash).ToArgv()).
+		AddEnvVars(indexEnv).Run(); err != nil {
+		return err
+	}
+
+	return self.cmd.New(NewGitCmd("apply").Arg("--cached", "--unidiff-zero", "-").ToArgv()).
+		AddEnvVars(indexEnv).SetStdin(patch).Run()
+}
+
+// Commits the content of the given index file as a fixup commit for the given
+// commit
+func (self *AbsorbCommands) CreateFixupCommitCmdObj(indexFile string, hash string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("commit").Arg("--fixup=" + hash).ToArgv()
+
+	return self.cmd.New(cmdArgs).AddEnvVars("GIT_INDEX_FILE=" + indexFile)
+}
@@ -0,0 +1,35 @@
+package git_commands
+
+import "strings"
+
+type ArchiveCommands struct {
+	*GitCommon
+}
+
+func NewArchiveCommands(gitCommon *GitCommon) *ArchiveCommands {
+	return &ArchiveCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// The archive formats that git supports out of the box
+var ArchiveFormats = []string{"tar.gz", "zip", "tar"}
+
+// Writes the tree of the given ref to an archive at outputPath. The prefix is
+// the directory that all files in the archive are put in; a trailing slash is
+// added if it's missing, because otherwise git would just prepend it to the
+// file names.
+func (self *ArchiveCommands) Create(ref string, format string, prefix string, outputPath string) error {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	cmdArgs := NewGitCmd("archive").
+		Arg("--format="+format).
+		ArgIf(prefix != "", "--prefix="+prefix).
+		Arg("-o", outputPath).
+		Arg(ref).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,45 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveCreate(t *testing.T) {
+	type scenario struct {
+		testName     string
+		prefix       string
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "with prefix",
+			prefix:       "repo-v1.0/",
+			expectedArgs: []string{"archive", "--format=tar.gz", "--prefix=repo-v1.0/", "-o", "repo-v1.0.tar.gz", "v1.0"},
+		},
+		{
+			testName:     "prefix without trailing slash",
+			prefix:       "repo-v1.0",
+			expectedArgs: []string{"archive", "--format=tar.gz", "--prefix=repo-v1.0/", "-o", "repo-v1.0.tar.gz", "v1.0"},
+		},
+		{
+			testName:     "without prefix",
+			prefix:       "",
+			expectedArgs: []string{"archive", "--format=tar.gz", "-o", "repo-v1.0.tar.gz", "v1.0"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildArchiveCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Create("v1.0", "tar.gz", s.prefix, "repo-v1.0.tar.gz"))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
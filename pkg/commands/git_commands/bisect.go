@@ -3,6 +3,7 @@ package git_commands
 import (
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -119,6 +120,52 @@ func (self *BisectCommands) Skip(ref string) error {
 	return self.Mark(ref, "skip")
 }
 
+// A commit that was marked during the bisect session, in the order they were
+// marked
+type BisectLogEntry struct {
+	// the new or old term (e.g. "bad" or "good"), or "skip"
+	Term    string
+	Hash    string
+	Subject string
+}
+
+// Returns the commits that were marked in the current bisect session, as
+// recorded by `git bisect log`
+func (self *BisectCommands) GetLog() ([]*BisectLogEntry, error) {
+	cmdArgs := NewGitCmd("bisect").Arg("log").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBisectLog(output), nil
+}
+
+// e.g. "# bad: [d4ae43b3be2a74d0c6cbeeae450be771a3a6ab24] Fix the thing"
+var bisectLogEntryRegexp = regexp.MustCompile(`^# (\S+): \[([0-9a-f]+)\] (.*)$`)
+
+// The log is a replayable script in which each command is preceded by a
+// comment that tells us what it did; we only need the comments. Status
+// comments and the final "first bad commit" comment don't match the regexp.
+func parseBisectLog(output string) []*BisectLogEntry {
+	entries := []*BisectLogEntry{}
+	for _, line := range strings.Split(output, "\n") {
+		match := bisectLogEntryRegexp.FindStringSubmatch(line)
+		if match == nil || match[1] == "status" {
+			continue
+		}
+
+		entries = append(entries, &BisectLogEntry{
+			Term:    match[1],
+			Hash:    match[2],
+			Subject: match[3],
+		})
+	}
+
+	return entries
+}
+
 func (self *BisectCommands) Start() error {
 	cmdArgs := NewGitCmd("bisect").Arg("start").ToArgv()
 
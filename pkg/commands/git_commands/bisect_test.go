@@ -0,0 +1,34 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBisectLog(t *testing.T) {
+	output := `git bisect start '--term-old=fast' '--term-new=slow'
+# status: waiting for both good and bad commits
+# slow: [d4ae43b3be2a74d0c6cbeeae450be771a3a6ab24] commit 06
+git bisect slow d4ae43b3be2a74d0c6cbeeae450be771a3a6ab24
+# status: waiting for good commit(s), bad commit known
+# fast: [2036c010db643ba6a1a5f7368dde60c23e65831c] commit 01
+git bisect fast 2036c010db643ba6a1a5f7368dde60c23e65831c
+# skip: [c5871c0142487d4a981d614a401286fbe8367f53] commit 03: fix [skip ci]
+git bisect skip c5871c0142487d4a981d614a401286fbe8367f53
+# first slow commit: [5da72dee8288fc8dac956a90eece14795eb712b1] commit 04
+`
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"bisect", "log"}, output, nil)
+	instance := buildBisectCommands(commonDeps{runner: runner})
+
+	entries, err := instance.GetLog()
+	assert.NoError(t, err)
+	assert.Equal(t, []*BisectLogEntry{
+		{Term: "slow", Hash: "d4ae43b3be2a74d0c6cbeeae450be771a3a6ab24", Subject: "commit 06"},
+		{Term: "fast", Hash: "2036c010db643ba6a1a5f7368dde60c23e65831c", Subject: "commit 01"},
+		{Term: "skip", Hash: "c5871c0142487d4a981d614a401286fbe8367f53", Subject: "commit 03: fix [skip ci]"},
+	}, entries)
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,231 @@
+package git_commands
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// Talks to the REST API of Bitbucket Cloud (bitbucket.org); Bitbucket Server and
+// Data Center have a different API, which we don't support. The token is taken
+// from the BITBUCKET_TOKEN environment variable and must be an access token of
+// the repository, project or workspace.
+type BitbucketCommands struct {
+	*GitCommon
+	hostingService *HostingService
+}
+
+func NewBitbucketCommands(gitCommon *GitCommon, hostingService *HostingService) *BitbucketCommands {
+	return &BitbucketCommands{
+		GitCommon:      gitCommon,
+		hostingService: hostingService,
+	}
+}
+
+func (self *BitbucketCommands) GetAuthToken() string {
+	return self.os.Getenv("BITBUCKET_TOKEN")
+}
+
+func (self *BitbucketCommands) InBitbucketRepo(remotes []*models.Remote) bool {
+	return self.hostingService.getMainRemoteProvider(remotes) == "bitbucket"
+}
+
+// Returns the remote of the repo that pull requests are made against
+func (self *BitbucketCommands) GetBaseRemote(remotes []*models.Remote) *models.Remote {
+	return getUpstreamOrMainRemote(remotes)
+}
+
+// FetchRecentPRs fetches the pull requests of the given branches. Like for
+// GitLab, we get the most recently updated pull requests and pick the ones of
+// our branches, since the API can't filter by several source branches.
+func (self *BitbucketCommands) FetchRecentPRs(branches []string, baseRemote *models.Remote, token string) ([]*models.GithubPullRequest, error) {
+	api, repoName, err := self.getAPI(baseRemote, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.fetchPullRequests(repoName, branches)
+}
+
+// FetchPullRequest fetches the head of the given pull request into a local
+// branch. Unlike GitHub and GitLab, Bitbucket doesn't expose the heads of pull
+// requests as refs, so we ask the API for the source branch of the pull
+// request and fetch that, from the fork if the pull request comes from one.
+func (self *BitbucketCommands) FetchPullRequest(task gocui.Task, baseRemote *models.Remote, remotes []*models.Remote, number int, branchName string, token string) error {
+	api, repoName, err := self.getAPI(baseRemote, token)
+	if err != nil {
+		return err
+	}
+
+	pullRequest, err := api.fetchPullRequest(repoName, number)
+	if err != nil {
+		return err
+	}
+
+	source := self.remoteNameOrURLForRepo(pullRequest.Source.Repository.FullName, remotes)
+	cmdArgs := NewGitCmd("fetch").
+		Arg(source, fmt.Sprintf("refs/heads/%s:%s", pullRequest.Source.Branch.Name, branchName)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Returns the name of the remote for the repo with the given full name (e.g.
+// "my-workspace/my-repo") if we have one, and the repo's URL otherwise
+func (self *BitbucketCommands) remoteNameOrURLForRepo(repoName string, remotes []*models.Remote) string {
+	remote, ok := lo.Find(remotes, func(remote *models.Remote) bool {
+		if len(remote.Urls) == 0 {
+			return false
+		}
+		remoteRepoName, err := self.hostingService.GetRepoNameFromRemoteURL(remote.Urls[0])
+		return err == nil && strings.EqualFold(remoteRepoName, repoName)
+	})
+	if ok {
+		return remote.Name
+	}
+
+	return "https://bitbucket.org/" + repoName + ".git"
+}
+
+// Returns the API, and the full name of the remote's repo, e.g.
+// "my-workspace/my-repo"
+func (self *BitbucketCommands) getAPI(remote *models.Remote, token string) (*bitbucketAPI, string, error) {
+	if len(remote.Urls) == 0 {
+		return nil, "", fmt.Errorf("No URLs found for remote")
+	}
+
+	repoName, err := self.hostingService.GetRepoNameFromRemoteURL(remote.Urls[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &bitbucketAPI{baseURL: "https://api.bitbucket.org/2.0", token: token}, repoName, nil
+}
+
+type bitbucketAPI struct {
+	baseURL string
+	token   string
+}
+
+func (self *bitbucketAPI) get(path string, query url.Values, result any) error {
+	requestURL := self.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	return getJSON(requestURL, map[string]string{"Authorization": "Bearer " + self.token}, result)
+}
+
+type BitbucketPullRequest struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+	State string `json:"state"` // "OPEN", "MERGED", "DECLINED" or "SUPERSEDED"
+	Draft bool   `json:"draft"`
+	Links struct {
+		Html struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+	Source struct {
+		Branch struct {
+			Name string `json:"name"`
+		} `json:"branch"`
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	} `json:"source"`
+	Participants []BitbucketParticipant `json:"participants"`
+}
+
+type BitbucketParticipant struct {
+	// "approved", "changes_requested", or null if the participant didn't review
+	// the pull request
+	State string `json:"state"`
+}
+
+func (self *bitbucketAPI) fetchPullRequests(repoName string, branches []string) ([]*models.GithubPullRequest, error) {
+	var response struct {
+		Values []BitbucketPullRequest `json:"values"`
+	}
+	query := url.Values{
+		"state":   {"OPEN", "MERGED", "DECLINED", "SUPERSEDED"},
+		"sort":    {"-updated_on"},
+		"pagelen": {"50"},
+		// The participants aren't included in the list by default
+		"fields": {"+values.participants,+values.draft"},
+	}
+	if err := self.get("/repositories/"+repoName+"/pullrequests", query, &response); err != nil {
+		return nil, err
+	}
+
+	branchSet := set.NewFromSlice(branches)
+	return lo.FilterMap(response.Values, func(pullRequest BitbucketPullRequest, _ int) (*models.GithubPullRequest, bool) {
+		// The source repository is null if the fork was deleted
+		if !branchSet.Includes(pullRequest.Source.Branch.Name) || pullRequest.Source.Repository.FullName == "" {
+			return nil, false
+		}
+
+		return &models.GithubPullRequest{
+			HeadRefName: pullRequest.Source.Branch.Name,
+			Number:      pullRequest.Id,
+			Title:       pullRequest.Title,
+			State:       pullRequestStateFromBitbucketPullRequest(pullRequest),
+			Url:         pullRequest.Links.Html.Href,
+			HeadRepositoryOwner: models.GithubRepositoryOwner{
+				Login: path.Dir(pullRequest.Source.Repository.FullName),
+			},
+			ReviewDecision: reviewDecisionFromBitbucketPullRequest(pullRequest),
+		}, true
+	}), nil
+}
+
+func (self *bitbucketAPI) fetchPullRequest(repoName string, number int) (*BitbucketPullRequest, error) {
+	var pullRequest BitbucketPullRequest
+	err := self.get(fmt.Sprintf("/repositories/%s/pullrequests/%d", repoName, number), nil, &pullRequest)
+	if err == errNotFound {
+		return nil, fmt.Errorf("Pull request #%d not found in %s", number, repoName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pullRequest.Source.Repository.FullName == "" {
+		return nil, fmt.Errorf("The repository of pull request #%d no longer exists", number)
+	}
+
+	return &pullRequest, nil
+}
+
+func pullRequestStateFromBitbucketPullRequest(pullRequest BitbucketPullRequest) string {
+	switch pullRequest.State {
+	case "MERGED":
+		return "MERGED"
+	case "DECLINED", "SUPERSEDED":
+		return "CLOSED"
+	default:
+		return lo.Ternary(pullRequest.Draft, "DRAFT", "OPEN")
+	}
+}
+
+// Bitbucket has no required reviews, so unlike on GitHub, a pull request that
+// nobody reviewed yet doesn't count as needing a review
+func reviewDecisionFromBitbucketPullRequest(pullRequest BitbucketPullRequest) string {
+	hasState := func(state string) bool {
+		return lo.SomeBy(pullRequest.Participants, func(participant BitbucketParticipant) bool {
+			return participant.State == state
+		})
+	}
+
+	if hasState("changes_requested") {
+		return "CHANGES_REQUESTED"
+	}
+	if hasState("approved") {
+		return "APPROVED"
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+package git_commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitbucketFetchPullRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "/2.0/repositories/my-workspace/my-repo/pullrequests", r.URL.Path)
+		assert.Equal(t, []string{"OPEN", "MERGED", "DECLINED", "SUPERSEDED"}, r.URL.Query()["state"])
+
+		_, _ = w.Write([]byte(`{"values": [
+			{"id": 7, "title": "Approved feature", "state": "OPEN", "draft": false, "links": {"html": {"href": "https://bitbucket.org/my-workspace/my-repo/pull-requests/7"}}, "source": {"branch": {"name": "feature"}, "repository": {"full_name": "my-workspace/my-repo"}}, "participants": [{"state": "approved"}, {"state": null}]},
+			{"id": 6, "title": "Fix from fork", "state": "OPEN", "draft": true, "links": {"html": {"href": "https://bitbucket.org/my-workspace/my-repo/pull-requests/6"}}, "source": {"branch": {"name": "fix"}, "repository": {"full_name": "someone/my-repo"}}, "participants": [{"state": "approved"}, {"state": "changes_requested"}]},
+			{"id": 5, "title": "Unrelated", "state": "OPEN", "draft": false, "links": {"html": {"href": "https://bitbucket.org/my-workspace/my-repo/pull-requests/5"}}, "source": {"branch": {"name": "other"}, "repository": {"full_name": "my-workspace/my-repo"}}, "participants": []},
+			{"id": 4, "title": "Declined feature", "state": "DECLINED", "draft": false, "links": {"html": {"href": "https://bitbucket.org/my-workspace/my-repo/pull-requests/4"}}, "source": {"branch": {"name": "feature"}, "repository": {"full_name": "my-workspace/my-repo"}}, "participants": []},
+			{"id": 3, "title": "From deleted fork", "state": "MERGED", "draft": false, "links": {"html": {"href": "https://bitbucket.org/my-workspace/my-repo/pull-requests/3"}}, "source": {"branch": {"name": "fix"}, "repository": null}, "participants": []}
+		]}`))
+	}))
+	defer server.Close()
+
+	api := &bitbucketAPI{baseURL: server.URL + "/2.0", token: "my-token"}
+	result, err := api.fetchPullRequests("my-workspace/my-repo", []string{"feature", "fix"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.GithubPullRequest{
+		{
+			HeadRefName:         "feature",
+			Number:              7,
+			Title:               "Approved feature",
+			State:               "OPEN",
+			Url:                 "https://bitbucket.org/my-workspace/my-repo/pull-requests/7",
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: "my-workspace"},
+			ReviewDecision:      "APPROVED",
+		},
+		{
+			HeadRefName:         "fix",
+			Number:              6,
+			Title:               "Fix from fork",
+			State:               "DRAFT",
+			Url:                 "https://bitbucket.org/my-workspace/my-repo/pull-requests/6",
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: "someone"},
+			ReviewDecision:      "CHANGES_REQUESTED",
+		},
+		{
+			HeadRefName:         "feature",
+			Number:              4,
+			Title:               "Declined feature",
+			State:               "CLOSED",
+			Url:                 "https://bitbucket.org/my-workspace/my-repo/pull-requests/4",
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: "my-workspace"},
+			ReviewDecision:      "",
+		},
+	}, result)
+}
+
+func TestBitbucketFetchPullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/repositories/my-workspace/my-repo/pullrequests/6":
+			_, _ = w.Write([]byte(`{"id": 6, "title": "Fix from fork", "state": "OPEN", "source": {"branch": {"name": "fix"}, "repository": {"full_name": "someone/my-repo"}}}`))
+		case "/2.0/repositories/my-workspace/my-repo/pullrequests/3":
+			_, _ = w.Write([]byte(`{"id": 3, "title": "From deleted fork", "state": "OPEN", "source": {"branch": {"name": "fix"}, "repository": null}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type": "error"}`))
+		}
+	}))
+	defer server.Close()
+
+	api := &bitbucketAPI{baseURL: server.URL + "/2.0", token: "my-token"}
+
+	pullRequest, err := api.fetchPullRequest("my-workspace/my-repo", 6)
+	assert.NoError(t, err)
+	assert.Equal(t, "fix", pullRequest.Source.Branch.Name)
+	assert.Equal(t, "someone/my-repo", pullRequest.Source.Repository.FullName)
+
+	_, err = api.fetchPullRequest("my-workspace/my-repo", 3)
+	assert.EqualError(t, err, "The repository of pull request #3 no longer exists")
+
+	_, err = api.fetchPullRequest("my-workspace/my-repo", 99)
+	assert.EqualError(t, err, "Pull request #99 not found in my-workspace/my-repo")
+}
+
+func TestBitbucketRemoteNameOrURLForRepo(t *testing.T) {
+	remotes := []*models.Remote{
+		{Name: "origin", Urls: []string{"git@bitbucket.org:me/my-repo.git"}},
+		{Name: "upstream", Urls: []string{"https://bitbucket.org/my-workspace/my-repo.git"}},
+	}
+
+	gitCommon := buildGitCommon(commonDeps{userConfig: config.GetDefaultConfig()})
+	instance := NewBitbucketCommands(gitCommon, NewHostingServiceCommand(gitCommon))
+
+	assert.Equal(t, "origin", instance.remoteNameOrURLForRepo("me/my-repo", remotes))
+	assert.Equal(t, "upstream", instance.remoteNameOrURLForRepo("My-Workspace/my-repo", remotes))
+	assert.Equal(t, "https://bitbucket.org/someone/my-repo.git", instance.remoteNameOrURLForRepo("someone/my-repo", remotes))
+}
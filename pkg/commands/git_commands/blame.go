@@ -2,6 +2,10 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 )
 
 type BlameCommands struct {
@@ -22,8 +26,20 @@ func NewBlameCommands(gitCommon *GitCommon) *BlameCommands {
 //	ac90ebac688fe8bc2ffd922157a9d2c54681d2aa (Stefan Haller 2023-08-01 14:54:56 +0200 12) 	return &BlameCommands{
 //	ac90ebac688fe8bc2ffd922157a9d2c54681d2aa (Stefan Haller 2023-08-01 14:54:56 +0200 13) 		GitCommon: gitCommon,
 func (self *BlameCommands) BlameLineRange(filename string, commit string, firstLine int, numLines int) (string, error) {
+	return self.blameLineRange(filename, commit, firstLine, numLines, false)
+}
+
+// Like BlameLineRange, but lines from the root commit get its full hash too;
+// otherwise they get a "^" prefix, and their hash is cut short to make room
+// for it.
+func (self *BlameCommands) BlameLineRangeWithRoot(filename string, commit string, firstLine int, numLines int) (string, error) {
+	return self.blameLineRange(filename, commit, firstLine, numLines, true)
+}
+
+func (self *BlameCommands) blameLineRange(filename string, commit string, firstLine int, numLines int, root bool) (string, error) {
 	cmdArgs := NewGitCmd("blame").
 		Arg("-l").
+		ArgIf(root, "--root").
 		Arg(fmt.Sprintf("-L%d,+%d", firstLine, numLines)).
 		Arg(commit).
 		Arg("--").
@@ -31,3 +47,79 @@ func (self *BlameCommands) BlameLineRange(filename string, commit string, firstL
 
 	return self.cmd.New(cmdArgs.ToArgv()).RunWithOutput()
 }
+
+// Blame the whole file as it is in the working tree, so lines that haven't
+// been committed yet are included (see models.BlameLine.IsUncommitted)
+func (self *BlameCommands) BlameFile(filename string) ([]*models.BlameLine, error) {
+	cmdArgs := NewGitCmd("blame").
+		Arg("--porcelain").
+		Arg("--").
+		Arg(filename).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlamePorcelain(output), nil
+}
+
+type blameCommitInfo struct {
+	author     string
+	authorTime int64
+	summary    string
+}
+
+// Parses the output of `git blame --porcelain`. Each line of the file is
+// preceded by a header line of the form
+//
+//	<hash> <original line number> <final line number> [<number of lines in group>]
+//
+// which, the first time a commit appears, is followed by lines with
+// information about the commit like "author Jesse Duffield". The line itself
+// comes last, prefixed with a tab.
+func parseBlamePorcelain(output string) []*models.BlameLine {
+	commitInfos := map[string]*blameCommitInfo{}
+	result := []*models.BlameLine{}
+
+	var current *models.BlameLine
+	for line := range strings.SplitSeq(output, "\n") {
+		if current == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+
+			lineNumber, _ := strconv.Atoi(fields[2])
+			current = &models.BlameLine{Hash: fields[0], LineNumber: lineNumber}
+			if _, ok := commitInfos[current.Hash]; !ok {
+				commitInfos[current.Hash] = &blameCommitInfo{}
+			}
+			continue
+		}
+
+		info := commitInfos[current.Hash]
+		if content, ok := strings.CutPrefix(line, "\t"); ok {
+			current.Author = info.author
+			current.AuthorTime = info.authorTime
+			current.Summary = info.summary
+			current.Content = content
+			result = append(result, current)
+			current = nil
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "author":
+			info.author = value
+		case "author-time":
+			info.authorTime, _ = strconv.ParseInt(value, 10, 64)
+		case "summary":
+			info.summary = value
+		}
+	}
+
+	return result
+}
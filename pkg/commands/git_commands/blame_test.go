@@ -0,0 +1,48 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBlamePorcelain(t *testing.T) {
+	output := `ac90ebac688fe8bc2ffd922157a9d2c54681d2aa 1 1 2
+author Stefan Haller
+author-mail <stefan@haller-berlin.de>
+author-time 1690894496
+author-tz +0200
+committer Stefan Haller
+committer-mail <stefan@haller-berlin.de>
+committer-time 1690894496
+committer-tz +0200
+summary Add blame commands
+filename pkg/commands/git_commands/blame.go
+	package git_commands
+ac90ebac688fe8bc2ffd922157a9d2c54681d2aa 2 2
+	
+0000000000000000000000000000000000000000 3 3 1
+author Not Committed Yet
+author-mail <not.committed.yet>
+author-time 1700000000
+author-tz +0000
+committer Not Committed Yet
+committer-mail <not.committed.yet>
+committer-time 1700000000
+committer-tz +0000
+summary Version of blame.go from blame.go
+previous ac90ebac688fe8bc2ffd922157a9d2c54681d2aa blame.go
+filename pkg/commands/git_commands/blame.go
+	import "fmt"
+ac90ebac688fe8bc2ffd922157a9d2c54681d2aa 4 4
+	// a comment with	a tab
+`
+
+	assert.EqualValues(t, []*models.BlameLine{
+		{Hash: "ac90ebac688fe8bc2ffd922157a9d2c54681d2aa", Author: "Stefan Haller", AuthorTime: 1690894496, Summary: "Add blame commands", LineNumber: 1, Content: "package git_commands"},
+		{Hash: "ac90ebac688fe8bc2ffd922157a9d2c54681d2aa", Author: "Stefan Haller", AuthorTime: 1690894496, Summary: "Add blame commands", LineNumber: 2, Content: ""},
+		{Hash: "0000000000000000000000000000000000000000", Author: "Not Committed Yet", AuthorTime: 1700000000, Summary: "Version of blame.go from blame.go", LineNumber: 3, Content: `import "fmt"`},
+		{Hash: "ac90ebac688fe8bc2ffd922157a9d2c54681d2aa", Author: "Stefan Haller", AuthorTime: 1690894496, Summary: "Add blame commands", LineNumber: 4, Content: "// a comment with\ta tab"},
+	}, parseBlamePorcelain(output))
+}
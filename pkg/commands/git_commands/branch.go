@@ -14,11 +14,13 @@ import (
 type BranchCommands struct {
 	*GitCommon
 	allBranchesLogCmdIndex int // keeps track of current all branches log command
+	refReader              *refReader
 }
 
 func NewBranchCommands(gitCommon *GitCommon) *BranchCommands {
 	return &BranchCommands{
 		GitCommon: gitCommon,
+		refReader: newRefReader(gitCommon),
 	}
 }
 
@@ -62,6 +64,16 @@ func (self *BranchCommands) CreateWithUpstream(name string, upstream string) err
 
 // CurrentBranchInfo get the current branch information.
 func (self *BranchCommands) CurrentBranchInfo() (BranchInfo, error) {
+	if head, err := self.refReader.symbolicHead(); err == nil {
+		if branchName, ok := strings.CutPrefix(head, "refs/heads/"); ok {
+			return BranchInfo{
+				RefName:      branchName,
+				DisplayName:  branchName,
+				DetachedHead: false,
+			}, nil
+		}
+	}
+
 	branchName, err := self.cmd.New(
 		NewGitCmd("symbolic-ref").
 			Arg("--short", "HEAD").
@@ -104,6 +116,15 @@ func (self *BranchCommands) CurrentBranchInfo() (BranchInfo, error) {
 
 // CurrentBranchName get name of current branch. Returns empty string if HEAD is detached.
 func (self *BranchCommands) CurrentBranchName() (string, error) {
+	if head, err := self.refReader.symbolicHead(); err == nil {
+		if head == "" {
+			return "", nil
+		}
+		if branchName, ok := strings.CutPrefix(head, "refs/heads/"); ok {
+			return branchName, nil
+		}
+	}
+
 	cmdArgs := NewGitCmd("branch").
 		Arg("--show-current").
 		ToArgv()
@@ -116,6 +137,13 @@ func (self *BranchCommands) CurrentBranchName() (string, error) {
 	return strings.TrimSpace(output), nil
 }
 
+// Returns the hash of the checked out commit, reading it from the git dir
+// rather than spawning git. Returns an error if that's not possible, e.g.
+// because the repo uses reftables or the checked out branch has no commits yet.
+func (self *BranchCommands) HeadCommitHashInProcess() (string, error) {
+	return self.refReader.resolveRef("HEAD")
+}
+
 // Gets the full ref name of the previously checked out branch. Can return an empty string (but no
 // error) e.g. when the previously checked out thing was a detached head.
 func (self *BranchCommands) PreviousRef() (string, error) {
@@ -132,6 +160,31 @@ func (self *BranchCommands) PreviousRef() (string, error) {
 	return strings.TrimSpace(output), nil
 }
 
+// Gets the name of the remote branch that a plain git push of the given branch
+// updates, taking push.default and the branch's upstream into account. Can
+// return an empty string (but no error) if git wouldn't know where to push the
+// branch.
+func (self *BranchCommands) PushDestinationBranchName(branchName string) (string, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(push:remotename) %(push)").
+		Arg("refs/heads/" + branchName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	// %(push) is the remote-tracking branch of the destination, e.g.
+	// "refs/remotes/origin/main"
+	remoteName, remoteTrackingRef, _ := strings.Cut(strings.TrimSpace(output), " ")
+	branch, found := strings.CutPrefix(remoteTrackingRef, "refs/remotes/"+remoteName+"/")
+	if remoteName == "" || !found {
+		return "", nil
+	}
+	return branch, nil
+}
+
 // LocalDelete delete branch locally
 func (self *BranchCommands) LocalDelete(branches []string, force bool) error {
 	cmdArgs := NewGitCmd("branch").
@@ -151,6 +204,7 @@ type CheckoutOptions struct {
 func (self *BranchCommands) Checkout(branch string, options CheckoutOptions) error {
 	cmdArgs := NewGitCmd("checkout").
 		ArgIf(options.Force, "--force").
+		Arg("--progress").
 		Arg(branch).
 		ToArgv()
 
@@ -159,6 +213,9 @@ func (self *BranchCommands) Checkout(branch string, options CheckoutOptions) err
 		// TODO: see if this is actually needed here
 		AddEnvVars("GIT_TERMINAL_PROMPT=0").
 		AddEnvVars(options.EnvVars...).
+		// large checkouts can take a while
+		ReportProgress().
+		SuppressOutputUnlessError().
 		Run()
 }
 
@@ -277,6 +334,7 @@ func (self *BranchCommands) Merge(branchName string, variant MergeVariant) error
 
 	cmdArgs := NewGitCmd("merge").
 		Arg("--no-edit").
+		ArgIf(self.UserConfig().Git.SkipHooks.Merge, "--no-verify").
 		Arg(strings.Fields(self.UserConfig().Git.Merging.Args)...).
 		Arg(extraArgs...).
 		Arg(branchName).
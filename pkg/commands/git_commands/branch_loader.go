@@ -43,6 +43,7 @@ type BranchLoader struct {
 	*common.Common
 	*GitCommon
 	cmd                  oscommands.ICmdObjBuilder
+	refsLoader           *RefsLoader
 	getCurrentBranchInfo func() (BranchInfo, error)
 	config               BranchLoaderConfigCommands
 }
@@ -51,6 +52,7 @@ func NewBranchLoader(
 	cmn *common.Common,
 	gitCommon *GitCommon,
 	cmd oscommands.ICmdObjBuilder,
+	refsLoader *RefsLoader,
 	getCurrentBranchInfo func() (BranchInfo, error),
 	config BranchLoaderConfigCommands,
 ) *BranchLoader {
@@ -58,6 +60,7 @@ func NewBranchLoader(
 		Common:               cmn,
 		GitCommon:            gitCommon,
 		cmd:                  cmd,
+		refsLoader:           refsLoader,
 		getCurrentBranchInfo: getCurrentBranchInfo,
 		config:               config,
 	}
@@ -85,6 +88,7 @@ func (self *BranchLoader) Load(reflogCommits []*models.Commit,
 				}
 				if strings.EqualFold(reflogBranch.Name, branch.Name) {
 					branch.Recency = reflogBranch.Recency
+					branch.RecencyTimestamp = reflogBranch.RecencyTimestamp
 					branchesWithRecency = append(branchesWithRecency, branch)
 					branches = utils.Remove(branches, j)
 					continue outer
@@ -363,59 +367,23 @@ func (self *BranchLoader) GetBaseBranch(branch *models.Branch, mainBranches *Mai
 }
 
 func (self *BranchLoader) obtainBranches() []*models.Branch {
-	output, err := self.getRawBranches()
+	refs, err := self.refsLoader.getRefs("refs/heads/")
 	if err != nil {
 		panic(err)
 	}
 
-	trimmedOutput := strings.TrimSpace(output)
-	outputLines := strings.Split(trimmedOutput, "\n")
-
-	return lo.FilterMap(outputLines, func(line string, _ int) (*models.Branch, bool) {
-		if line == "" {
-			return nil, false
-		}
-
-		split := strings.Split(line, "\x00")
-		if len(split) != len(branchFields) {
-			// Ignore line if it isn't separated into the expected number of parts
-			// This is probably a warning message, for more info see:
-			// https://github.com/jesseduffield/lazygit/issues/1385#issuecomment-885580439
-			return nil, false
-		}
-
-		storeCommitDateAsRecency := self.UserConfig().Git.LocalBranchSortOrder != "recency"
-		return obtainBranch(split, storeCommitDateAsRecency), true
-	})
-}
-
-func (self *BranchLoader) getRawBranches() (string, error) {
-	format := strings.Join(
-		lo.Map(branchFields, func(thing string, _ int) string {
-			return "%(" + thing + ")"
-		}),
-		"%00",
-	)
-
-	var sortOrder string
 	switch strings.ToLower(self.UserConfig().Git.LocalBranchSortOrder) {
 	case "recency", "date":
-		sortOrder = "-committerdate"
-	case "alphabetical":
-		sortOrder = "refname"
-	default:
-		sortOrder = "refname"
+		sortRefsByDateDescending(refs, func(ref refEntry) int64 { return ref.committerDate })
 	}
 
-	cmdArgs := NewGitCmd("for-each-ref").
-		Arg(fmt.Sprintf("--sort=%s", sortOrder)).
-		Arg(fmt.Sprintf("--format=%s", format)).
-		Arg("refs/heads").
-		ToArgv()
-
-	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	storeCommitDateAsRecency := self.UserConfig().Git.LocalBranchSortOrder != "recency"
+	return lo.Map(refs, func(ref refEntry, _ int) *models.Branch {
+		return obtainBranch(ref.branchFields, storeCommitDateAsRecency)
+	})
 }
 
+// The fields of local branches that we get from for-each-ref (see RefsLoader)
 var branchFields = []string{
 	"HEAD",
 	"refname:short",
@@ -427,7 +395,7 @@ var branchFields = []string{
 	"committerdate:unix",
 }
 
-// Obtain branch information from parsed line output of getRawBranches()
+// Obtain branch information from the branchFields of a local branch
 func obtainBranch(split []string, storeCommitDateAsRecency bool) *models.Branch {
 	headMarker := split[0]
 	fullName := split[1]
@@ -443,23 +411,26 @@ func obtainBranch(split []string, storeCommitDateAsRecency bool) *models.Branch
 	aheadForPush, behindForPush, _ := parseUpstreamInfo(upstreamName, pushTrack)
 
 	recency := ""
+	var recencyTimestamp int64
 	if storeCommitDateAsRecency {
 		if unixTimestamp, err := strconv.ParseInt(commitDate, 10, 64); err == nil {
 			recency = utils.UnixToTimeAgo(unixTimestamp)
+			recencyTimestamp = unixTimestamp
 		}
 	}
 
 	return &models.Branch{
-		Name:          name,
-		Recency:       recency,
-		AheadForPull:  aheadForPull,
-		BehindForPull: behindForPull,
-		AheadForPush:  aheadForPush,
-		BehindForPush: behindForPush,
-		UpstreamGone:  gone,
-		Head:          headMarker == "*",
-		Subject:       subject,
-		CommitHash:    commitHash,
+		Name:             name,
+		Recency:          recency,
+		RecencyTimestamp: recencyTimestamp,
+		AheadForPull:     aheadForPull,
+		BehindForPull:    behindForPull,
+		AheadForPush:     aheadForPush,
+		BehindForPush:    behindForPush,
+		UpstreamGone:     gone,
+		Head:             headMarker == "*",
+		Subject:          subject,
+		CommitHash:       commitHash,
 	}
 }
 
@@ -490,6 +461,34 @@ func parseDifference(track string, regexStr string) string {
 	return "0"
 }
 
+// GetRemoteBranchDivergences returns how far each remote branch has diverged
+// from the local branch tracking it, keyed by the remote branch's full name
+// (e.g. 'origin/master'). This is the other side of the ahead/behind counts we
+// already get for local branches, so it doesn't need any extra git calls.
+// Remote branches that aren't tracked by any local branch are omitted.
+func (self *BranchLoader) GetRemoteBranchDivergences(branches []*models.Branch) map[string]*models.RemoteBranchDivergence {
+	result := make(map[string]*models.RemoteBranchDivergence)
+	for _, branch := range branches {
+		if !branch.RemoteBranchStoredLocally() || branch.UpstreamGone {
+			continue
+		}
+
+		fullName := branch.UpstreamRemote + "/" + branch.UpstreamBranch
+		// If several local branches track the same remote branch, the first one
+		// wins; branches are sorted by recency so that's the most relevant one
+		if _, ok := result[fullName]; ok {
+			continue
+		}
+
+		result[fullName] = &models.RemoteBranchDivergence{
+			LocalBranchName: branch.Name,
+			Ahead:           branch.BehindForPull,
+			Behind:          branch.AheadForPull,
+		}
+	}
+	return result
+}
+
 // TODO: only look at the new reflog commits, and otherwise store the recencies in
 // int form against the branch to recalculate the time ago
 func (self *BranchLoader) obtainReflogBranches(reflogCommits []*models.Commit) []*models.Branch {
@@ -508,8 +507,9 @@ func (self *BranchLoader) obtainReflogBranches(reflogCommits []*models.Commit) [
 			if !foundBranches.Includes(branchName) {
 				foundBranches.Add(branchName)
 				reflogBranches = append(reflogBranches, &models.Branch{
-					Recency: recency,
-					Name:    branchName,
+					Recency:          recency,
+					RecencyTimestamp: commit.UnixTimestamp,
+					Name:             branchName,
 				})
 			}
 		}
@@ -21,7 +21,8 @@ func TestObtainBranch(t *testing.T) {
 
 	// Use a time stamp of 2 1/2 hours ago, resulting in a recency string of "2h"
 	now := time.Now().Unix()
-	timeStamp := strconv.Itoa(int(now - 2.5*60*60))
+	unixTimeStamp := now - 2.5*60*60
+	timeStamp := strconv.Itoa(int(unixTimeStamp))
 
 	scenarios := []scenario{
 		{
@@ -105,15 +106,16 @@ func TestObtainBranch(t *testing.T) {
 			input:                    []string{"", "a_branch", "", "", "", "subject", "123", timeStamp},
 			storeCommitDateAsRecency: true,
 			expectedBranch: &models.Branch{
-				Name:          "a_branch",
-				Recency:       "2h",
-				AheadForPull:  "?",
-				BehindForPull: "?",
-				AheadForPush:  "?",
-				BehindForPush: "?",
-				Head:          false,
-				Subject:       "subject",
-				CommitHash:    "123",
+				Name:             "a_branch",
+				Recency:          "2h",
+				RecencyTimestamp: unixTimeStamp,
+				AheadForPull:     "?",
+				BehindForPull:    "?",
+				AheadForPush:     "?",
+				BehindForPush:    "?",
+				Head:             false,
+				Subject:          "subject",
+				CommitHash:       "123",
 			},
 		},
 	}
@@ -315,6 +317,26 @@ func TestSelectBehindForBranch(t *testing.T) {
 	}
 }
 
+func TestGetRemoteBranchDivergences(t *testing.T) {
+	branches := []*models.Branch{
+		{Name: "feature", UpstreamRemote: "origin", UpstreamBranch: "feature", AheadForPull: "2", BehindForPull: "3"},
+		{Name: "master", UpstreamRemote: "origin", UpstreamBranch: "master", AheadForPull: "0", BehindForPull: "0"},
+		{Name: "old-master", UpstreamRemote: "origin", UpstreamBranch: "master", AheadForPull: "5", BehindForPull: "0"},
+		{Name: "gone", UpstreamRemote: "origin", UpstreamBranch: "gone", UpstreamGone: true},
+		{Name: "not-fetched", UpstreamRemote: "fork", UpstreamBranch: "not-fetched", AheadForPull: "?", BehindForPull: "?"},
+		{Name: "local-only"},
+	}
+	loader := &BranchLoader{}
+
+	assert.Equal(t,
+		map[string]*models.RemoteBranchDivergence{
+			"origin/feature": {LocalBranchName: "feature", Ahead: "3", Behind: "2"},
+			"origin/master":  {LocalBranchName: "master", Ahead: "0", Behind: "0"},
+		},
+		loader.GetRemoteBranchDivergences(branches),
+	)
+}
+
 func TestBuildAheadBehindForEachRefArgs(t *testing.T) {
 	type scenario struct {
 		testName       string
@@ -6,6 +6,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,6 +61,43 @@ func TestBranchNewBranch(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestBranchPushDestinationBranchName(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		output   string
+		expected string
+	}{
+		{
+			testName: "pushing to the upstream branch",
+			output:   "origin refs/remotes/origin/main\n",
+			expected: "main",
+		},
+		{
+			testName: "remote and branch names with slashes",
+			output:   "my/fork refs/remotes/my/fork/release/1.0\n",
+			expected: "release/1.0",
+		},
+		{
+			testName: "no push destination",
+			output:   " \n",
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--format=%(push:remotename) %(push)", "refs/heads/feature"}, s.output, nil)
+			instance := buildBranchCommands(commonDeps{runner: runner})
+
+			branchName, err := instance.PushDestinationBranchName("feature")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, branchName)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestBranchDeleteBranch(t *testing.T) {
 	type scenario struct {
 		testName    string
@@ -205,7 +243,7 @@ func TestBranchCheckout(t *testing.T) {
 	scenarios := []scenario{
 		{
 			"Checkout",
-			oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"checkout", "test"}, "", nil),
+			oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"checkout", "--progress", "test"}, "", nil),
 			func(err error) {
 				assert.NoError(t, err)
 			},
@@ -213,7 +251,7 @@ func TestBranchCheckout(t *testing.T) {
 		},
 		{
 			"Checkout forced",
-			oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"checkout", "--force", "test"}, "", nil),
+			oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"checkout", "--force", "--progress", "test"}, "", nil),
 			func(err error) {
 				assert.NoError(t, err)
 			},
@@ -317,3 +355,71 @@ func TestBranchCurrentBranchInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchCurrentBranchInfoReadingHeadInProcess(t *testing.T) {
+	type scenario struct {
+		testName string
+		head     string
+		setup    func(fs afero.Fs, userConfig *config.UserConfig)
+		runner   *oscommands.FakeCmdObjRunner
+		expected BranchInfo
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "reads the checked out branch from the HEAD file",
+			head:     "ref: refs/heads/feature/foo\n",
+			runner:   oscommands.NewFakeRunner(t),
+			expected: BranchInfo{RefName: "feature/foo", DisplayName: "feature/foo", DetachedHead: false},
+		},
+		{
+			testName: "uses git for a detached head",
+			head:     "6f71c57a8d4bd6c11399c3f55f42c815527a73a4\n",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"symbolic-ref", "--short", "HEAD"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"branch", "--points-at=HEAD", "--format=%(HEAD)%00%(objectname)%00%(refname)"},
+					"*\x006f71c57a8d4bd6c11399c3f55f42c815527a73a4\x00(HEAD detached at 6f71c57a)\n", nil),
+			expected: BranchInfo{RefName: "6f71c57a8d4bd6c11399c3f55f42c815527a73a4", DisplayName: "(HEAD detached at 6f71c57a)", DetachedHead: true},
+		},
+		{
+			testName: "uses git for repos with reftables",
+			head:     "ref: refs/heads/.invalid\n",
+			setup: func(fs afero.Fs, userConfig *config.UserConfig) {
+				_ = fs.MkdirAll("/repo/.git/reftable", 0o755)
+			},
+			runner:   oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"symbolic-ref", "--short", "HEAD"}, "master", nil),
+			expected: BranchInfo{RefName: "master", DisplayName: "master", DetachedHead: false},
+		},
+		{
+			testName: "uses git if readRefsWithCli is set",
+			head:     "ref: refs/heads/feature\n",
+			setup: func(fs afero.Fs, userConfig *config.UserConfig) {
+				userConfig.Git.ReadRefsWithCli = true
+			},
+			runner:   oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"symbolic-ref", "--short", "HEAD"}, "feature", nil),
+			expected: BranchInfo{RefName: "feature", DisplayName: "feature", DetachedHead: false},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			_ = afero.WriteFile(fs, "/repo/.git/HEAD", []byte(s.head), 0o644)
+			userConfig := config.GetDefaultConfig()
+			if s.setup != nil {
+				s.setup(fs, userConfig)
+			}
+
+			instance := buildBranchCommands(commonDeps{
+				runner:     s.runner,
+				fs:         fs,
+				repoPaths:  MockRepoPaths("/repo"),
+				userConfig: userConfig,
+			})
+			info, err := instance.CurrentBranchInfo()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, info)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -2,14 +2,40 @@ package git_commands
 
 import (
 	"fmt"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 )
 
 var ErrInvalidCommitIndex = errors.New("invalid commit index")
 
+// Whether to sign a new commit. CommitSigningDefault leaves it to git's
+// commit.gpgSign config; the other two override it.
+type CommitSigning int
+
+const (
+	CommitSigningDefault CommitSigning = iota
+	CommitSigningOn
+	CommitSigningOff
+)
+
+func (self CommitSigning) flag() string {
+	switch self {
+	case CommitSigningOn:
+		return "-S"
+	case CommitSigningOff:
+		return "--no-gpg-sign"
+	default:
+		return ""
+	}
+}
+
 type CommitCommands struct {
 	*GitCommon
 }
@@ -38,6 +64,16 @@ func (self *CommitCommands) SetAuthor(value string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Sets the commit's author date to the supplied value. Value can be in any
+// format that git's --date option understands
+func (self *CommitCommands) SetAuthorDate(value string) error {
+	cmdArgs := NewGitCmd("commit").
+		Arg("--allow-empty", "--allow-empty-message", "--only", "--no-edit", "--amend", "--date="+value).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // Add a commit's coauthor using Github/Gitlab Co-authored-by metadata. Value is expected to be of the form 'Name <Email>'
 func (self *CommitCommands) AddCoAuthor(hash string, author string) error {
 	message, err := self.GetCommitMessage(hash)
@@ -86,12 +122,13 @@ func (self *CommitCommands) ResetToCommit(hash string, strength string, envVars
 		Run()
 }
 
-func (self *CommitCommands) CommitCmdObj(summary string, description string, forceSkipHooks bool) *oscommands.CmdObj {
+func (self *CommitCommands) CommitCmdObj(summary string, description string, forceSkipHooks bool, signing CommitSigning) *oscommands.CmdObj {
 	messageArgs := self.commitMessageArgs(summary, description)
 	skipHookPrefix := self.UserConfig().Git.SkipHookPrefix
 	cmdArgs := NewGitCmd("commit").
 		ArgIf(forceSkipHooks || (skipHookPrefix != "" && strings.HasPrefix(summary, skipHookPrefix)), "--no-verify").
 		ArgIf(self.signoffFlag() != "", self.signoffFlag()).
+		ArgIf(signing.flag() != "", signing.flag()).
 		Arg(messageArgs...).
 		ToArgv()
 
@@ -107,12 +144,13 @@ func (self *CommitCommands) RewordLastCommitInEditorWithMessageFileCmdObj(tmpMes
 		Arg("--allow-empty", "--amend", "--only", "--edit", "--file="+tmpMessageFile).ToArgv())
 }
 
-func (self *CommitCommands) CommitInEditorWithMessageFileCmdObj(tmpMessageFile string, forceSkipHooks bool) *oscommands.CmdObj {
+func (self *CommitCommands) CommitInEditorWithMessageFileCmdObj(tmpMessageFile string, forceSkipHooks bool, signing CommitSigning) *oscommands.CmdObj {
 	return self.cmd.New(NewGitCmd("commit").
 		ArgIf(forceSkipHooks, "--no-verify").
 		Arg("--edit").
 		Arg("--file="+tmpMessageFile).
 		ArgIf(self.signoffFlag() != "", self.signoffFlag()).
+		ArgIf(signing.flag() != "", signing.flag()).
 		ToArgv())
 }
 
@@ -139,9 +177,10 @@ func (self *CommitCommands) commitMessageArgs(summary string, description string
 }
 
 // runs git commit without the -m argument meaning it will invoke the user's editor
-func (self *CommitCommands) CommitEditorCmdObj() *oscommands.CmdObj {
+func (self *CommitCommands) CommitEditorCmdObj(signing CommitSigning) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("commit").
 		ArgIf(self.signoffFlag() != "", self.signoffFlag()).
+		ArgIf(signing.flag() != "", signing.flag()).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs)
@@ -174,6 +213,17 @@ func (self *CommitCommands) GetCommitSubject(commitHash string) (string, error)
 	return strings.TrimSpace(subject), err
 }
 
+// Returns the full hash of the commit that the given revision (e.g. a short
+// hash, a branch name, or HEAD~2) points to
+func (self *CommitCommands) ResolveCommitHash(revision string) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").
+		Arg("--verify", "--quiet", "--end-of-options", revision+"^{commit}").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
 func (self *CommitCommands) GetCommitDiff(commitHash string) (string, error) {
 	cmdArgs := NewGitCmd("show").Arg("--no-color", commitHash).ToArgv()
 
@@ -240,7 +290,7 @@ func (self *CommitCommands) AmendHeadCmdObj() *oscommands.CmdObj {
 	return self.cmd.New(cmdArgs)
 }
 
-func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscommands.CmdObj {
+func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string, contentFilter ContentFilter) *oscommands.CmdObj {
 	contextSize := self.UserConfig().Git.DiffContextSize
 
 	extDiffCmd := self.pagerConfig.GetExternalDiffCommand()
@@ -248,6 +298,7 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 	cmdArgs := NewGitCmd("show").
 		Config("diff.noprefix=false").
 		ConfigIf(extDiffCmd != "", "diff.external="+extDiffCmd).
+		Configs(self.pagerConfig.GetDiffColorConfigs()).
 		ArgIfElse(extDiffCmd != "" || useExtDiffGitConfig, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
 		Arg("--color="+self.pagerConfig.GetColorArg()).
@@ -258,6 +309,7 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 		Arg(hash).
 		ArgIf(self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
+		ArgIf(contentFilter.Active(), contentFilter.Arg()).
 		Arg("--").
 		Arg(filterPaths...).
 		Dir(self.repoPaths.worktreePath).
@@ -266,6 +318,46 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// The format of the commit details view; similar to git's "fuller" format,
+// but also showing the parents of the commit
+const commitDetailsFormat = "%C(yellow)commit %H%C(auto)%d%Creset%n" +
+	"Parents:    %p%n" +
+	"Author:     %an <%ae>%n" +
+	"AuthorDate: %ad%n" +
+	"Commit:     %cn <%ce>%n" +
+	"CommitDate: %cd%n" +
+	"%n" +
+	"%w(0,4,4)%B"
+
+// Verifies the signature of the given commit. Returns the output of gpg (or
+// ssh-keygen, depending on gpg.format), which has details like the key and how
+// much it is trusted. If the commit isn't signed or the signature isn't good,
+// the error has that output.
+func (self *CommitCommands) VerifySignature(hash string) (string, error) {
+	cmdArgs := NewGitCmd("verify-commit").Arg(hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
+// Shows the metadata (including the signature), the message and the diffstat
+// of the given commit, but not its patch
+func (self *CommitCommands) ShowDetailsCmdObj(hash string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("show").
+		Arg("--no-ext-diff").
+		Arg("--color=" + self.pagerConfig.GetColorArg()).
+		Arg("--stat").
+		Arg("--decorate").
+		Arg("--show-signature").
+		Arg("--format=" + commitDetailsFormat).
+		Arg(hash).
+		Arg("--").
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 func (self *CommitCommands) ShowFileContentCmdObj(hash string, filePath string) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("show").
 		Arg(fmt.Sprintf("%s:%s", hash, filePath)).
@@ -273,13 +365,14 @@ func (self *CommitCommands) ShowFileContentCmdObj(hash string, filePath string)
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
-// Revert reverts the selected commits by hash. If isMerge is true, we'll pass -m 1
-// to say we want to revert the first parent of the merge commit, which is the one
-// people want in 99.9% of cases. In current git versions we could unconditionally
-// pass -m 1 even for non-merge commits, but older versions of git choke on it.
-func (self *CommitCommands) Revert(hashes []string, isMerge bool) error {
+// Revert reverts the selected commits by hash. Merge commits can only be
+// reverted relative to one of their parents, so for these the caller passes the
+// parent number (starting at 1) as mainline; git ignores it for the non-merge
+// commits among the hashes. Pass 0 if there are no merge commits at all, since
+// then there's no parent to choose and we leave out -m.
+func (self *CommitCommands) Revert(hashes []string, mainline int) error {
 	cmdArgs := NewGitCmd("revert").
-		ArgIf(isMerge, "-m", "1").
+		ArgIf(mainline > 0, "-m", strconv.Itoa(mainline)).
 		Arg(hashes...).
 		ToArgv()
 
@@ -320,3 +413,97 @@ func (self *CommitCommands) GetCommitMessageFromHistory(value int) (string, erro
 	}
 	return self.GetCommitMessage(formattedHash)
 }
+
+// Returns whether the repo has a commit-graph file, which speeds up walking
+// the history considerably, e.g. for computing ahead/behind counts
+func (self *CommitCommands) HasCommitGraph() bool {
+	infoDir := filepath.Join(self.repoPaths.RepoGitDirPath(), "objects", "info")
+	for _, path := range []string{"commit-graph", "commit-graphs"} {
+		if _, err := self.Fs.Stat(filepath.Join(infoDir, path)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the message that a new commit should start with: the
+// git.commit.messageTemplate config if it's set, and otherwise the content of
+// the file that git's commit.template config points to. Comment lines are
+// removed, because we don't strip them when committing with a message.
+func (self *CommitCommands) GetCommitMessageTemplate() (string, error) {
+	if template := self.UserConfig().Git.Commit.MessageTemplate; template != "" {
+		return template, nil
+	}
+
+	path := self.config.GetCommitTemplatePath()
+	if path == "" {
+		return "", nil
+	}
+	if !filepath.IsAbs(path) {
+		// git resolves it relative to the directory it runs in, which for us is
+		// the top of the worktree
+		path = filepath.Join(self.repoPaths.WorktreePath(), path)
+	}
+
+	content, err := afero.ReadFile(self.Fs, path)
+	if err != nil {
+		return "", err
+	}
+	return self.stripCommentLines(string(content)), nil
+}
+
+// Runs the prepare-commit-msg hook on the given message like `git commit` does
+// before it opens the editor, and returns the message as the hook left it. The
+// source is passed on to the hook; it's "template" if the message came from a
+// commit template, or "" otherwise.
+//
+// Running hooks needs `git hook run`, which was added in git 2.36; with older
+// versions, the message is returned unchanged.
+func (self *CommitCommands) RunPrepareCommitMsgHook(message string, source string) (string, error) {
+	if !self.version.IsAtLeast(2, 36, 0) {
+		return message, nil
+	}
+
+	dir := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName())
+	if err := self.Fs.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, time.Now().Format("Jan _2 15.04.05.000000000")+".msg")
+	if err := afero.WriteFile(self.Fs, path, []byte(message), 0o644); err != nil {
+		return "", err
+	}
+	defer func() { _ = self.Fs.Remove(path) }()
+
+	cmdArgs := NewGitCmd("hook").
+		Arg("run", "--ignore-missing", "prepare-commit-msg", "--", path).
+		ArgIf(source != "", source).
+		ToArgv()
+	if err := self.cmd.New(cmdArgs).DontLog().Run(); err != nil {
+		return "", err
+	}
+
+	content, err := afero.ReadFile(self.Fs, path)
+	if err != nil {
+		return "", err
+	}
+	return self.stripCommentLines(string(content)), nil
+}
+
+// Removes the lines that git would remove from a message that it opened in an
+// editor, as well as trailing blank lines
+func (self *CommitCommands) stripCommentLines(message string) string {
+	commentChar := self.config.GetCoreCommentChar()
+	lines := lo.Filter(strings.Split(message, "\n"), func(line string, _ int) bool {
+		return len(line) == 0 || line[0] != commentChar
+	})
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+func (self *CommitCommands) WriteCommitGraph() error {
+	cmdArgs := NewGitCmd("commit-graph").
+		Arg("write", "--reachable", "--changed-paths").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -2,10 +2,12 @@ package git_commands
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -59,6 +61,9 @@ type GetCommitsOptions struct {
 	Limit                bool
 	FilterPath           string
 	FilterAuthor         string
+	FilterSince          string // passed to git log's --since, e.g. "2 weeks ago"
+	FilterUntil          string // passed to git log's --until
+	FilterContent        ContentFilter
 	IncludeRebaseCommits bool
 	RefName              string     // e.g. "HEAD" or "my_branch"
 	RefForPushedStatus   models.Ref // the ref to use for determining pushed/unpushed status
@@ -68,6 +73,17 @@ type GetCommitsOptions struct {
 	RefToShowDivergenceFrom string
 	MainBranches            *MainBranches
 	HashPool                *utils.StringPool
+	// If set, called from a background goroutine with the commits loaded so
+	// far while the log is still streaming in, so that the first commits of a
+	// huge history can be shown without waiting for all of them. The commits
+	// are copies that aren't modified afterwards, so they are safe to publish;
+	// their statuses aren't set yet at that point. Not supported together with
+	// RefToShowDivergenceFrom, because the commits are reordered at the end in
+	// that case.
+	OnProgress func(commits []*models.Commit)
+	// If set, the git processes are killed when the context is done, e.g.
+	// because a newer load superseded this one
+	Ctx context.Context
 }
 
 // GetCommits obtains the commits of the current branch
@@ -90,10 +106,23 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	go utils.Safe(func() {
 		defer wg.Done()
 
+		var onProgress func([]*models.Commit)
+		if opts.OnProgress != nil && opts.RefToShowDivergenceFrom == "" {
+			rebasingCommits := slices.Clip(commits)
+			onProgress = func(realCommits []*models.Commit) {
+				// We set the statuses of the commits below while the caller
+				// might already be rendering them, so give it copies
+				opts.OnProgress(lo.Map(append(rebasingCommits, realCommits...), func(commit *models.Commit, _ int) *models.Commit {
+					copied := *commit
+					return &copied
+				}))
+			}
+		}
+
 		var realCommits []*models.Commit
 		realCommits, logErr = loadCommits(self.getLogCmd(opts), opts.FilterPath, func(line string) (*models.Commit, bool) {
 			return self.extractCommitFromLine(opts.HashPool, line, opts.RefToShowDivergenceFrom != ""), false
-		})
+		}, onProgress)
 		if logErr == nil {
 			commits = append(commits, realCommits...)
 		}
@@ -107,16 +136,16 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		defer wg.Done()
 
 		if len(mainBranches) > 0 {
-			unmergedCommitHashes = self.getReachableHashes(opts.RefName, mainBranches)
+			unmergedCommitHashes = self.getReachableHashes(opts.Ctx, opts.RefName, mainBranches)
 			if opts.RefToShowDivergenceFrom != "" {
-				remoteUnmergedCommitHashes = self.getReachableHashes(opts.RefToShowDivergenceFrom, mainBranches)
+				remoteUnmergedCommitHashes = self.getReachableHashes(opts.Ctx, opts.RefToShowDivergenceFrom, mainBranches)
 			}
 		}
 	})
 
 	var unpushedCommitHashes *set.Set[string]
 	if opts.RefForPushedStatus != nil {
-		unpushedCommitHashes = self.getReachableHashes(opts.RefForPushedStatus.FullRefName(),
+		unpushedCommitHashes = self.getReachableHashes(opts.Ctx, opts.RefForPushedStatus.FullRefName(),
 			append([]string{opts.RefForPushedStatus.RefName() + "@{u}"}, mainBranches...))
 	}
 
@@ -152,6 +181,20 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	return commits, nil
 }
 
+// Returns the commits reachable from refName that touched the given file,
+// newest first, following the file across renames. For the commits in which the
+// file had a different path, FilterPaths is set to the paths it had there.
+func (self *CommitLoader) GetFileHistory(refName string, path string, hashPool *utils.StringPool) ([]*models.Commit, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg(refName, "--oneline", prettyFormat, "--abbrev=40", "--follow", "--name-status", "--no-show-signature").
+		Arg("--", path).
+		ToArgv()
+
+	return loadCommits(self.cmd.New(cmdArgs).DontLog(), path, func(line string) (*models.Commit, bool) {
+		return self.extractCommitFromLine(hashPool, line, false), false
+	}, nil)
+}
+
 func (self *CommitLoader) MergeRebasingCommits(hashPool *utils.StringPool, commits []*models.Commit) ([]*models.Commit, error) {
 	// chances are we have as many commits as last time so we'll set the capacity to be the old length
 	result := make([]*models.Commit, 0, len(commits))
@@ -559,7 +602,7 @@ func setCommitStatuses(unpushedCommitHashes *set.Set[string], unmergedCommitHash
 	}
 }
 
-func (self *CommitLoader) getReachableHashes(refName string, notRefNames []string) *set.Set[string] {
+func (self *CommitLoader) getReachableHashes(ctx context.Context, refName string, notRefNames []string) *set.Set[string] {
 	output, _, err := self.cmd.New(
 		NewGitCmd("rev-list").
 			Arg(refName).
@@ -568,6 +611,7 @@ func (self *CommitLoader) getReachableHashes(refName string, notRefNames []strin
 			})...).
 			ToArgv(),
 	).
+		WithContext(ctx).
 		DontLog().
 		RunWithOutputs()
 	if err != nil {
@@ -594,6 +638,9 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 		Arg(prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
+		ArgIf(opts.FilterSince != "", "--since="+opts.FilterSince).
+		ArgIf(opts.FilterUntil != "", "--until="+opts.FilterUntil).
+		ArgIf(opts.FilterContent.Active(), opts.FilterContent.Arg()).
 		ArgIf(opts.Limit, "-300").
 		ArgIf(opts.FilterPath != "", "--follow", "--name-status").
 		Arg("--no-show-signature").
@@ -602,7 +649,23 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 		ArgIf(opts.FilterPath != "", opts.FilterPath).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).DontLog()
+	return self.cmd.New(cmdArgs).WithContext(opts.Ctx).DontLog()
+}
+
+// Restricts a log to the commits that add or remove Content (git's -S), or, if
+// IsRegex is set, whose added or removed lines match it (-G). The same option
+// restricts a diff to the files in which that happens.
+type ContentFilter struct {
+	Content string
+	IsRegex bool
+}
+
+func (self ContentFilter) Active() bool {
+	return self.Content != ""
+}
+
+func (self ContentFilter) Arg() string {
+	return lo.Ternary(self.IsRegex, "-G", "-S") + self.Content
 }
 
 const prettyFormat = `--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s`
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -294,6 +295,28 @@ func TestGetCommits(t *testing.T) {
 			expectedCommitOpts: []models.NewCommitOpts{},
 			expectedError:      nil,
 		},
+		{
+			testName: "should set content filter",
+			logOrder: "default",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, FilterContent: ContentFilter{Content: "someFunc"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "-SsomeFunc", "--no-show-signature", "--"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
+		{
+			testName: "should set regex content filter",
+			logOrder: "default",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, FilterContent: ContentFilter{Content: "some.*Func", IsRegex: true}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "-Gsome.*Func", "--no-show-signature", "--"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -794,3 +817,121 @@ func TestCommitLoader_extractCommitFromLine(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadCommitsReportsProgress(t *testing.T) {
+	lines := make([]string, 0, 1000)
+	for i := range 1000 {
+		lines = append(lines, fmt.Sprintf("+%040d", i))
+	}
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log"}, strings.Join(lines, "\n"), nil)
+	cmdObj := oscommands.NewDummyCmdObjBuilder(runner).New([]string{"git", "log"})
+
+	hashPool := &utils.StringPool{}
+	progressCounts := []int{}
+	commits, err := loadCommits(cmdObj, "", func(line string) (*models.Commit, bool) {
+		return models.NewCommit(hashPool, models.NewCommitOpts{Hash: line}), false
+	}, func(commits []*models.Commit) {
+		progressCounts = append(progressCounts, len(commits))
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, commits, 1000)
+	assert.Equal(t, []int{300, 600}, progressCounts)
+	runner.CheckForMissingCalls()
+}
+
+func TestGetFileHistory(t *testing.T) {
+	output := strings.Join([]string{
+		"+0eea75e8c631fba6b58135697835d58ba4c18dbc\x001640826609\x00Jesse Duffield\x00jessedduffield@gmail.com\x00b21997d6b4cbdf84b149\x00>\x00HEAD -> master\x00modify new",
+		"",
+		"M\tnew.txt",
+		"+b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164\x001640826609\x00Jesse Duffield\x00jessedduffield@gmail.com\x00e94e8fc5b6fab4cb755f\x00>\x00\x00rename",
+		"",
+		"R100\told.txt\tnew.txt",
+		"+e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c\x001640826609\x00Jesse Duffield\x00jessedduffield@gmail.com\x00\x00>\x00\x00add old",
+		"",
+		"A\told.txt",
+	}, "\n")
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--follow", "--name-status", "--no-show-signature", "--", "new.txt"}, output, nil)
+
+	loader := &CommitLoader{
+		Common: common.NewDummyCommon(),
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+	}
+
+	commits, err := loader.GetFileHistory("HEAD", "new.txt", &utils.StringPool{})
+	assert.NoError(t, err)
+	runner.CheckForMissingCalls()
+
+	assert.Equal(t, []string{"modify new", "rename", "add old"}, lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Name }))
+	assert.Equal(t, [][]string{nil, {"old.txt", "new.txt"}, {"old.txt"}}, lo.Map(commits, func(commit *models.Commit, _ int) []string { return commit.FilterPaths }))
+}
+
+func TestGetCommitsReportsProgressWithCopies(t *testing.T) {
+	hashes := make([]string, 0, 400)
+	lines := make([]string, 0, 400)
+	for i := range 400 {
+		hash := fmt.Sprintf("%040d", i)
+		hashes = append(hashes, hash)
+		lines = append(lines, "+"+hash+"\x001640826609\x00Jesse Duffield\x00jessedduffield@gmail.com\x00\x00>\x00\x00commit")
+	}
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, strings.Join(hashes, "\n"), nil).
+		ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--no-show-signature", "--"}, strings.Join(lines, "\n"), nil)
+
+	common := common.NewDummyCommon()
+	common.UserConfig().Git.Log.Order = "default"
+	common.UserConfig().Git.MainBranches = nil
+	cmd := oscommands.NewDummyCmdObjBuilder(runner)
+	builder := &CommitLoader{
+		Common:              common,
+		cmd:                 cmd,
+		getWorkingTreeState: func() models.WorkingTreeState { return models.WorkingTreeState{} },
+		dotGitDir:           ".git",
+	}
+
+	// Read the published commits concurrently, like the UI would while
+	// rendering them, so that the race detector complains if they are still
+	// being modified
+	var published []*models.Commit
+	done := make(chan struct{})
+	readerDone := make(chan struct{})
+	commits, err := builder.GetCommits(GetCommitsOptions{
+		RefName:            "HEAD",
+		RefForPushedStatus: &models.Branch{Name: "mybranch"},
+		MainBranches:       NewMainBranches(common, cmd),
+		HashPool:           &utils.StringPool{},
+		OnProgress: func(commits []*models.Commit) {
+			published = commits
+			go func() {
+				defer close(readerDone)
+				for {
+					for _, commit := range commits {
+						_ = commit.Status
+					}
+					select {
+					case <-done:
+						return
+					default:
+					}
+				}
+			}()
+		},
+	})
+	close(done)
+	<-readerDone
+
+	assert.NoError(t, err)
+	assert.Len(t, published, 300)
+	for _, commit := range published {
+		assert.Equal(t, models.StatusNone, commit.Status)
+	}
+	assert.Len(t, commits, 400)
+	for _, commit := range commits {
+		assert.Equal(t, models.StatusUnpushed, commit.Status)
+	}
+	runner.CheckForMissingCalls()
+}
@@ -8,12 +8,24 @@ import (
 	"github.com/samber/lo"
 )
 
+// The number of commits after which loadCommits reports its first progress;
+// this is enough to fill the screen. After that, we report progress whenever
+// the number of loaded commits has doubled, so that the total cost of
+// rendering the partial lists stays proportional to the number of commits.
+const firstCommitsProgressCount = 300
+
+// Parses the output of cmd line by line as it streams in. If onProgress is
+// non-nil, it is called with the commits loaded so far every now and then while
+// loading, so that callers can show them before the whole history has been
+// read; it must not modify the slice.
 func loadCommits(
 	cmd *oscommands.CmdObj,
 	filterPath string,
 	parseLogLine func(string) (*models.Commit, bool),
+	onProgress func(commits []*models.Commit),
 ) ([]*models.Commit, error) {
 	commits := []*models.Commit{}
+	nextProgressCount := firstCommitsProgressCount
 
 	var commit *models.Commit
 	var filterPaths []string
@@ -42,6 +54,11 @@ func loadCommits(
 			commits = append(commits, commit)
 			commit = nil
 			filterPaths = nil
+
+			if onProgress != nil && len(commits) == nextProgressCount {
+				onProgress(commits)
+				nextProgressCount *= 2
+			}
 		}
 	}
 	err := cmd.RunAndProcessLines(func(line string) (bool, error) {
@@ -1,10 +1,14 @@
 package git_commands
 
 import (
+	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,6 +53,16 @@ func TestCommitResetToCommit(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestCommitSetAuthorDate(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"commit", "--allow-empty", "--allow-empty-message", "--only", "--no-edit", "--amend", "--date=2024-01-31 12:00:00 +0100"}, "", nil)
+
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetAuthorDate("2024-01-31 12:00:00 +0100"))
+	runner.CheckForMissingCalls()
+}
+
 func TestCommitCommitCmdObj(t *testing.T) {
 	type scenario struct {
 		testName             string
@@ -57,6 +71,7 @@ func TestCommitCommitCmdObj(t *testing.T) {
 		description          string
 		configSignoff        bool
 		configSkipHookPrefix string
+		signing              CommitSigning
 		expectedArgs         []string
 	}
 
@@ -118,6 +133,19 @@ func TestCommitCommitCmdObj(t *testing.T) {
 			configSkipHookPrefix: "WIP",
 			expectedArgs:         []string{"commit", "--no-verify", "--signoff", "-m", "WIP: test"},
 		},
+		{
+			testName:     "Commit with signing forced on",
+			summary:      "test",
+			signing:      CommitSigningOn,
+			expectedArgs: []string{"commit", "-S", "-m", "test"},
+		},
+		{
+			testName:      "Commit with signoff and signing forced off",
+			summary:       "test",
+			configSignoff: true,
+			signing:       CommitSigningOff,
+			expectedArgs:  []string{"commit", "--signoff", "--no-gpg-sign", "-m", "test"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -129,7 +157,7 @@ func TestCommitCommitCmdObj(t *testing.T) {
 			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig, runner: runner})
 
-			assert.NoError(t, instance.CommitCmdObj(s.summary, s.description, s.forceSkipHooks).Run())
+			assert.NoError(t, instance.CommitCmdObj(s.summary, s.description, s.forceSkipHooks, s.signing).Run())
 			runner.CheckForMissingCalls()
 		})
 	}
@@ -163,7 +191,7 @@ func TestCommitCommitEditorCmdObj(t *testing.T) {
 			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig, runner: runner})
 
-			assert.NoError(t, instance.CommitEditorCmdObj().Run())
+			assert.NoError(t, instance.CommitEditorCmdObj(CommitSigningDefault).Run())
 			runner.CheckForMissingCalls()
 		})
 	}
@@ -198,6 +226,47 @@ func TestCommitCreateFixupCommit(t *testing.T) {
 	}
 }
 
+func TestCommitRevert(t *testing.T) {
+	type scenario struct {
+		testName string
+		hashes   []string
+		mainline int
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "single commit",
+			hashes:   []string{"12345"},
+			mainline: 0,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"revert", "12345"}, "", nil),
+		},
+		{
+			testName: "range of commits",
+			hashes:   []string{"12345", "67890"},
+			mainline: 0,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"revert", "12345", "67890"}, "", nil),
+		},
+		{
+			testName: "merge commit relative to its second parent",
+			hashes:   []string{"12345"},
+			mainline: 2,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"revert", "-m", "2", "12345"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.Revert(s.hashes, s.mainline))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestCommitCreateAmendCommit(t *testing.T) {
 	type scenario struct {
 		testName           string
@@ -252,6 +321,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 	type scenario struct {
 		testName            string
 		filterPaths         []string
+		contentFilter       ContentFilter
 		contextSize         uint64
 		similarityThreshold int
 		ignoreWhitespace    bool
@@ -278,6 +348,16 @@ func TestCommitShowCmdObj(t *testing.T) {
 			pagerConfig:         nil,
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--find-renames=50%", "--", "file.txt"},
 		},
+		{
+			testName:            "Default case with content filter",
+			filterPaths:         []string{},
+			contentFilter:       ContentFilter{Content: "someFunc"},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			pagerConfig:         nil,
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--find-renames=50%", "-SsomeFunc", "--"},
+		},
 		{
 			testName:            "Show diff with custom context size",
 			filterPaths:         []string{},
@@ -341,12 +421,27 @@ func TestCommitShowCmdObj(t *testing.T) {
 			}
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig, appState: &config.AppState{}, runner: runner, repoPaths: &repoPaths})
 
-			assert.NoError(t, instance.ShowCmdObj("1234567890", s.filterPaths).Run())
+			assert.NoError(t, instance.ShowCmdObj("1234567890", s.filterPaths, s.contentFilter).Run())
 			runner.CheckForMissingCalls()
 		})
 	}
 }
 
+func TestCommitShowDetailsCmdObj(t *testing.T) {
+	expected := []string{
+		"-C", "/path/to/worktree", "show", "--no-ext-diff", "--color=always", "--stat", "--decorate", "--show-signature",
+		"--format=" + commitDetailsFormat, "1234567890", "--",
+	}
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs(expected, "", nil)
+	repoPaths := RepoPaths{
+		worktreePath: "/path/to/worktree",
+	}
+	instance := buildCommitCommands(commonDeps{userConfig: config.GetDefaultConfig(), appState: &config.AppState{}, runner: runner, repoPaths: &repoPaths})
+
+	assert.NoError(t, instance.ShowDetailsCmdObj("1234567890").Run())
+	runner.CheckForMissingCalls()
+}
+
 func TestGetCommitMsg(t *testing.T) {
 	type scenario struct {
 		testName       string
@@ -496,3 +591,174 @@ func TestAddCoAuthorToDescription(t *testing.T) {
 		})
 	}
 }
+
+func TestCommitHasCommitGraph(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		path     string
+		expected bool
+	}{
+		{
+			testName: "no commit-graph",
+			path:     "",
+			expected: false,
+		},
+		{
+			testName: "single commit-graph file",
+			path:     "/repo/.git/objects/info/commit-graph",
+			expected: true,
+		},
+		{
+			testName: "split commit-graph",
+			path:     "/repo/.git/objects/info/commit-graphs/commit-graph-chain",
+			expected: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			_ = fs.MkdirAll("/repo/.git/objects/info", 0o755)
+			if s.path != "" {
+				_ = afero.WriteFile(fs, s.path, []byte{}, 0o644)
+			}
+
+			instance := buildCommitCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+			assert.Equal(t, s.expected, instance.HasCommitGraph())
+		})
+	}
+}
+
+func TestCommitWriteCommitGraph(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"commit-graph", "write", "--reachable", "--changed-paths"}, "", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.WriteCommitGraph())
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitGetCommitMessageTemplate(t *testing.T) {
+	scenarios := []struct {
+		testName        string
+		messageTemplate string
+		templatePath    string
+		templateContent string
+		expected        string
+		expectedErr     bool
+	}{
+		{
+			testName: "no template",
+			expected: "",
+		},
+		{
+			testName:        "lazygit config takes precedence",
+			messageTemplate: "feat: \n\nDescription",
+			templatePath:    "/home/user/.gitmessage",
+			templateContent: "fix: ",
+			expected:        "feat: \n\nDescription",
+		},
+		{
+			testName:        "git's commit template without comments",
+			templatePath:    "/home/user/.gitmessage",
+			templateContent: "Summary\n\n# Explain why\nWhy:\n\n",
+			expected:        "Summary\n\nWhy:",
+		},
+		{
+			testName:        "relative path is relative to the worktree",
+			templatePath:    "templates/commit.txt",
+			templateContent: "Summary",
+			expected:        "Summary",
+		},
+		{
+			testName:     "missing template file",
+			templatePath: "/home/user/.gitmessage",
+			expectedErr:  true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.templateContent != "" {
+				path := s.templatePath
+				if !filepath.IsAbs(path) {
+					path = filepath.Join("/repo", path)
+				}
+				_ = afero.WriteFile(fs, path, []byte(s.templateContent), 0o644)
+			}
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Commit.MessageTemplate = s.messageTemplate
+			gitConfig := git_config.NewFakeGitConfig(map[string]string{"--path --get commit.template": s.templatePath})
+
+			instance := buildCommitCommands(commonDeps{fs: fs, userConfig: userConfig, gitConfig: gitConfig, repoPaths: MockRepoPaths("/repo")})
+			result, err := instance.GetCommitMessageTemplate()
+			if s.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, result)
+			}
+		})
+	}
+}
+
+func TestCommitRunPrepareCommitMsgHook(t *testing.T) {
+	scenarios := []struct {
+		testName     string
+		version      *GitVersion
+		source       string
+		hook         func(message string) string
+		expectedArgs []string
+		expected     string
+	}{
+		{
+			testName: "not supported by old git versions",
+			version:  &GitVersion{2, 35, 0, ""},
+			expected: "Summary",
+		},
+		{
+			testName:     "hook edits the message",
+			version:      &GitVersion{2, 36, 0, ""},
+			source:       "template",
+			hook:         func(message string) string { return "[ABC-1] " + message + "\n# a comment\n" },
+			expectedArgs: []string{"hook", "run", "--ignore-missing", "prepare-commit-msg", "--", "<path>", "template"},
+			expected:     "[ABC-1] Summary",
+		},
+		{
+			testName:     "no source",
+			version:      &GitVersion{2, 36, 0, ""},
+			hook:         func(message string) string { return message },
+			expectedArgs: []string{"hook", "run", "--ignore-missing", "prepare-commit-msg", "--", "<path>"},
+			expected:     "Summary",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			runner := oscommands.NewFakeRunner(t)
+			if s.hook != nil {
+				runner.ExpectFunc("git hook run", func(cmdObj *oscommands.CmdObj) bool {
+					args := slices.Clone(cmdObj.Args())
+					path := args[6]
+					args[6] = "<path>"
+					if !assert.Equal(t, append([]string{"git"}, s.expectedArgs...), args) {
+						return false
+					}
+
+					content, err := afero.ReadFile(fs, path)
+					assert.NoError(t, err)
+					_ = afero.WriteFile(fs, path, []byte(s.hook(string(content))), 0o644)
+					return true
+				}, "", nil)
+			}
+
+			instance := buildCommitCommands(commonDeps{fs: fs, runner: runner, gitVersion: s.version})
+			result, err := instance.RunPrepareCommitMsgHook("Summary", s.source)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package git_commands
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 )
 
 // BranchConfig holds the tracking configuration for a branch.
@@ -18,15 +21,18 @@ type ConfigCommands struct {
 	*common.Common
 
 	gitConfig git_config.IGitConfig
+	os        *oscommands.OSCommand
 }
 
 func NewConfigCommands(
 	common *common.Common,
 	gitConfig git_config.IGitConfig,
+	osCommand *oscommands.OSCommand,
 ) *ConfigCommands {
 	return &ConfigCommands{
 		Common:    common,
 		gitConfig: gitConfig,
+		os:        osCommand,
 	}
 }
 
@@ -41,16 +47,86 @@ const (
 // and needs a subprocess because they have a process where they manually
 // enter their password every time a GPG action is taken
 func (self *ConfigCommands) NeedsGpgSubprocess(key GpgConfigKey) bool {
-	overrideGpg := self.UserConfig().Git.OverrideGpg
-	if overrideGpg {
+	return self.needsSubprocessForSigning(self.gitConfig.GetBool(string(key)))
+}
+
+func (self *ConfigCommands) NeedsGpgSubprocessForCommit() bool {
+	return self.NeedsGpgSubprocess(CommitGpgSign)
+}
+
+// Like NeedsGpgSubprocessForCommit, but for a new commit whose signing may
+// have been overridden by the user
+func (self *ConfigCommands) NeedsGpgSubprocessForNewCommit(signing CommitSigning) bool {
+	return self.needsSubprocessForSigning(self.WillSignNewCommit(signing))
+}
+
+// Whether a new commit made with the given signing option will be signed
+func (self *ConfigCommands) WillSignNewCommit(signing CommitSigning) bool {
+	switch signing {
+	case CommitSigningOn:
+		return true
+	case CommitSigningOff:
+		return false
+	default:
+		return self.gitConfig.GetBool(string(CommitGpgSign))
+	}
+}
+
+func (self *ConfigCommands) needsSubprocessForSigning(sign bool) bool {
+	if !sign || self.UserConfig().Git.OverrideGpg {
 		return false
 	}
 
-	return self.gitConfig.GetBool(string(key))
+	// If gpg asks for the passphrase in a window of its own, there is no need
+	// to suspend lazygit for it
+	return !self.usesGraphicalPinentry()
 }
 
-func (self *ConfigCommands) NeedsGpgSubprocessForCommit() bool {
-	return self.NeedsGpgSubprocess(CommitGpgSign)
+// Whether signing uses gpg with a pinentry program that doesn't prompt in the
+// terminal. This is only the case if the user configured one explicitly in
+// gpg-agent.conf; the default one may well be a terminal one, so we don't
+// make any assumptions about it. For ssh and x509 signing we always assume
+// that they might prompt in the terminal.
+func (self *ConfigCommands) usesGraphicalPinentry() bool {
+	format := self.gitConfig.Get("gpg.format")
+	if format != "" && format != "openpgp" {
+		return false
+	}
+
+	gnupgHome := self.os.Getenv("GNUPGHOME")
+	if gnupgHome == "" {
+		home, err := self.os.UserHomeDir()
+		if err != nil {
+			return false
+		}
+		gnupgHome = filepath.Join(home, ".gnupg")
+	}
+
+	content, err := afero.ReadFile(self.Fs, filepath.Join(gnupgHome, "gpg-agent.conf"))
+	if err != nil {
+		return false
+	}
+
+	return isGraphicalPinentry(string(content))
+}
+
+var graphicalPinentryNames = []string{"pinentry-mac", "pinentry-gnome3", "pinentry-gtk", "pinentry-qt", "pinentry-fltk", "pinentry-w32", "pinentry-x2go"}
+
+// Looks at the pinentry-program option of the given gpg-agent.conf content
+func isGraphicalPinentry(agentConf string) bool {
+	for _, line := range strings.Split(agentConf, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "pinentry-program" {
+			continue
+		}
+
+		name := filepath.Base(strings.Join(fields[1:], " "))
+		return lo.SomeBy(graphicalPinentryNames, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		})
+	}
+
+	return false
 }
 
 func (self *ConfigCommands) GetGpgTagSign() bool {
@@ -66,6 +142,40 @@ func (self *ConfigCommands) GetRemoteURL() string {
 	return self.gitConfig.Get("remote.origin.url")
 }
 
+// Whether `git fetch --all` skips the given remote
+func (self *ConfigCommands) RemoteSkipsFetchAll(remoteName string) bool {
+	return self.gitConfig.GetBool("remote." + remoteName + ".skipFetchAll")
+}
+
+func (self *ConfigCommands) UsesSparseCheckout() bool {
+	return self.gitConfig.GetBool("core.sparseCheckout")
+}
+
+func (self *ConfigCommands) UsesSparseCheckoutConeMode() bool {
+	return self.gitConfig.GetBool("core.sparseCheckoutCone")
+}
+
+// Whether the repo uses a sparse index, i.e. an index that stores directories
+// outside of the sparse-checkout definition as single entries. Some commands
+// need extra flags to avoid expanding it to a full index, which is slow.
+func (self *ConfigCommands) UsesSparseIndex() bool {
+	return self.gitConfig.GetBool("core.sparseCheckout") && self.gitConfig.GetBool("index.sparse")
+}
+
+// Whether the repo is a partial clone (e.g. one cloned with
+// `--filter=blob:none`), in which case git downloads missing objects from the
+// promisor remote whenever a command needs them.
+func (self *ConfigCommands) IsPartialClone() bool {
+	return self.gitConfig.Get("extensions.partialClone") != ""
+}
+
+// Whether git-lfs is set up for the repo (usually with `git lfs install`), so
+// that files with the `filter=lfs` attribute are stored in lfs. If it isn't,
+// they are stored in git like any other file.
+func (self *ConfigCommands) IsLfsFilterConfigured() bool {
+	return self.gitConfig.Get("filter.lfs.process") != "" || self.gitConfig.Get("filter.lfs.clean") != ""
+}
+
 func (self *ConfigCommands) GetShowUntrackedFiles() string {
 	return self.gitConfig.Get("status.showUntrackedFiles")
 }
@@ -124,6 +234,12 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 	return '#'
 }
 
+// Returns the path of the file that git's commit.template config points to
+// (with a leading ~ expanded), or "" if it isn't set
+func (self *ConfigCommands) GetCommitTemplatePath() string {
+	return self.gitConfig.GetGeneral("--path --get commit.template")
+}
+
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
@@ -132,6 +248,28 @@ func (self *ConfigCommands) GetMergeFF() string {
 	return self.gitConfig.Get("merge.ff")
 }
 
+// Returns how `git pull` integrates the upstream branch according to the git
+// config, in terms of the values of git.pullMode: "rebase", "merge" or
+// "ff-only". Returns "" if neither pull.rebase nor pull.ff is set, in which
+// case git refuses to pull if the branches have diverged.
+func (self *ConfigCommands) GetPullStrategy() string {
+	switch self.gitConfig.Get("pull.rebase") {
+	case "true", "merges", "interactive", "i", "m":
+		return "rebase"
+	case "false":
+		return "merge"
+	}
+
+	switch self.gitConfig.Get("pull.ff") {
+	case "only":
+		return "ff-only"
+	case "true", "false":
+		return "merge"
+	}
+
+	return ""
+}
+
 func (self *ConfigCommands) DropConfigCache() {
 	self.gitConfig.DropCache()
 }
@@ -0,0 +1,218 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigNeedsGpgSubprocessForNewCommit(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		configGpgSign string
+		overrideGpg   bool
+		signing       CommitSigning
+		expected      bool
+	}{
+		{
+			testName:      "signing according to git config",
+			configGpgSign: "true",
+			signing:       CommitSigningDefault,
+			expected:      true,
+		},
+		{
+			testName:      "not signing according to git config",
+			configGpgSign: "false",
+			signing:       CommitSigningDefault,
+			expected:      false,
+		},
+		{
+			testName:      "signing forced on",
+			configGpgSign: "false",
+			signing:       CommitSigningOn,
+			expected:      true,
+		},
+		{
+			testName:      "signing forced off",
+			configGpgSign: "true",
+			signing:       CommitSigningOff,
+			expected:      false,
+		},
+		{
+			testName:      "overrideGpg",
+			configGpgSign: "true",
+			overrideGpg:   true,
+			signing:       CommitSigningOn,
+			expected:      false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.OverrideGpg = s.overrideGpg
+			gitConfig := git_config.NewFakeGitConfig(map[string]string{
+				"commit.gpgSign": s.configGpgSign,
+			})
+			instance := buildGitCommon(commonDeps{userConfig: userConfig, gitConfig: gitConfig}).config
+
+			assert.Equal(t, s.expected, instance.NeedsGpgSubprocessForNewCommit(s.signing))
+		})
+	}
+}
+
+func TestConfigNeedsGpgSubprocessWithGraphicalPinentry(t *testing.T) {
+	graphicalAgentConf := "pinentry-program /usr/bin/pinentry-gnome3\n"
+	defaultGnupgHome := filepath.Join("/home/user", ".gnupg")
+
+	scenarios := []struct {
+		testName  string
+		gpgFormat string
+		gnupgHome string
+		// gpg-agent.conf contents by gnupg home directory
+		agentConf map[string]string
+		expected  bool
+	}{
+		{
+			testName:  "graphical pinentry",
+			agentConf: map[string]string{defaultGnupgHome: graphicalAgentConf},
+			expected:  false,
+		},
+		{
+			testName:  "graphical pinentry with explicit openpgp format",
+			gpgFormat: "openpgp",
+			agentConf: map[string]string{defaultGnupgHome: graphicalAgentConf},
+			expected:  false,
+		},
+		{
+			testName:  "terminal pinentry",
+			agentConf: map[string]string{defaultGnupgHome: "pinentry-program /usr/bin/pinentry-curses\n"},
+			expected:  true,
+		},
+		{
+			testName:  "no gpg-agent.conf",
+			agentConf: map[string]string{},
+			expected:  true,
+		},
+		{
+			testName:  "graphical pinentry in GNUPGHOME",
+			gnupgHome: "/custom/gnupg",
+			agentConf: map[string]string{
+				"/custom/gnupg":  graphicalAgentConf,
+				defaultGnupgHome: "pinentry-program /usr/bin/pinentry-curses\n",
+			},
+			expected: false,
+		},
+		{
+			// the gpg-agent.conf is irrelevant when signing with ssh
+			testName:  "graphical pinentry with ssh format",
+			gpgFormat: "ssh",
+			agentConf: map[string]string{defaultGnupgHome: graphicalAgentConf},
+			expected:  true,
+		},
+		{
+			testName:  "graphical pinentry with x509 format",
+			gpgFormat: "x509",
+			agentConf: map[string]string{defaultGnupgHome: graphicalAgentConf},
+			expected:  true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for dir, content := range s.agentConf {
+				assert.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "gpg-agent.conf"), []byte(content), 0o644))
+			}
+			gitConfig := git_config.NewFakeGitConfig(map[string]string{
+				"commit.gpgSign": "true",
+				"gpg.format":     s.gpgFormat,
+			})
+			instance := buildGitCommon(commonDeps{
+				gitConfig: gitConfig,
+				fs:        fs,
+				getenv: func(key string) string {
+					if key == "GNUPGHOME" {
+						return s.gnupgHome
+					}
+					return ""
+				},
+				homeDir: func() (string, error) { return "/home/user", nil },
+			}).config
+
+			assert.Equal(t, s.expected, instance.NeedsGpgSubprocessForCommit())
+		})
+	}
+}
+
+func TestIsGraphicalPinentry(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		agentConf string
+		expected  bool
+	}{
+		{
+			testName:  "no pinentry-program",
+			agentConf: "default-cache-ttl 600\n",
+			expected:  false,
+		},
+		{
+			testName:  "pinentry-mac",
+			agentConf: "default-cache-ttl 600\npinentry-program /opt/homebrew/bin/pinentry-mac\n",
+			expected:  true,
+		},
+		{
+			testName:  "pinentry-gnome3",
+			agentConf: "pinentry-program /usr/bin/pinentry-gnome3",
+			expected:  true,
+		},
+		{
+			testName:  "pinentry-curses",
+			agentConf: "pinentry-program /usr/bin/pinentry-curses\n",
+			expected:  false,
+		},
+		{
+			testName:  "path with spaces",
+			agentConf: "pinentry-program /Applications/GPG Keychain.app/Contents/bin/pinentry-mac\n",
+			expected:  true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, isGraphicalPinentry(s.agentConf))
+		})
+	}
+}
+
+func TestIsPartialClone(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		gitConfig map[string]string
+		expected  bool
+	}{
+		{
+			testName:  "full clone",
+			gitConfig: map[string]string{},
+			expected:  false,
+		},
+		{
+			testName:  "partial clone",
+			gitConfig: map[string]string{"extensions.partialClone": "origin"},
+			expected:  true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			gitConfig := git_config.NewFakeGitConfig(s.gitConfig)
+			instance := buildGitCommon(commonDeps{gitConfig: gitConfig}).config
+
+			assert.Equal(t, s.expected, instance.IsPartialClone())
+		})
+	}
+}
@@ -18,6 +18,7 @@ type commonDeps struct {
 	gitVersion *GitVersion
 	gitConfig  *git_config.FakeGitConfig
 	getenv     func(string) string
+	homeDir    func() (string, error)
 	removeFile func(string) error
 	isDirEmpty func(string) (bool, error)
 	removeDir  func(string) error
@@ -25,6 +26,7 @@ type commonDeps struct {
 	cmd        *oscommands.CmdObjBuilder
 	fs         afero.Fs
 	repoPaths  *RepoPaths
+	platform   *oscommands.Platform
 }
 
 func buildGitCommon(deps commonDeps) *GitCommon {
@@ -76,13 +78,16 @@ func buildGitCommon(deps commonDeps) *GitCommon {
 		gitConfig = git_config.NewFakeGitConfig(nil)
 	}
 
-	gitCommon.config = NewConfigCommands(gitCommon.Common, gitConfig)
-
 	getenv := deps.getenv
 	if getenv == nil {
 		getenv = func(string) string { return "" }
 	}
 
+	homeDir := deps.homeDir
+	if homeDir == nil {
+		homeDir = func() (string, error) { return "", errors.New("no home directory") }
+	}
+
 	removeFile := deps.removeFile
 	if removeFile == nil {
 		removeFile = func(string) error { return errors.New("unexpected call to removeFile") }
@@ -99,15 +104,19 @@ func buildGitCommon(deps commonDeps) *GitCommon {
 	}
 
 	gitCommon.os = oscommands.NewDummyOSCommandWithDeps(oscommands.OSCommandDeps{
-		Common:       gitCommon.Common,
-		GetenvFn:     getenv,
-		Cmd:          cmd,
-		RemoveFileFn: removeFile,
-		IsDirEmptyFn: isDirEmpty,
-		RemoveDirFn:  removeDir,
-		TempDir:      os.TempDir(),
+		Common:        gitCommon.Common,
+		Platform:      deps.platform,
+		GetenvFn:      getenv,
+		UserHomeDirFn: homeDir,
+		Cmd:           cmd,
+		RemoveFileFn:  removeFile,
+		IsDirEmptyFn:  isDirEmpty,
+		RemoveDirFn:   removeDir,
+		TempDir:       os.TempDir(),
 	})
 
+	gitCommon.config = NewConfigCommands(gitCommon.Common, gitConfig, gitCommon.os)
+
 	return gitCommon
 }
 
@@ -168,8 +177,56 @@ func buildBranchCommands(deps commonDeps) *BranchCommands {
 	return NewBranchCommands(gitCommon)
 }
 
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
+
+func buildHookCommands(deps commonDeps) *HookCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewHookCommands(gitCommon)
+}
+
 func buildFlowCommands(deps commonDeps) *FlowCommands {
 	gitCommon := buildGitCommon(deps)
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildPatchSeriesCommands(deps commonDeps) *PatchSeriesCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewPatchSeriesCommands(gitCommon)
+}
+
+func buildAbsorbCommands(deps commonDeps) *AbsorbCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewAbsorbCommands(gitCommon)
+}
+
+func buildArchiveCommands(deps commonDeps) *ArchiveCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewArchiveCommands(gitCommon)
+}
+
+func buildBisectCommands(deps commonDeps) *BisectCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewBisectCommands(gitCommon)
+}
+
+func buildSparseCheckoutCommands(deps commonDeps) *SparseCheckoutCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewSparseCheckoutCommands(gitCommon)
+}
+
+func buildWorktreeCommands(deps commonDeps) *WorktreeCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewWorktreeCommands(gitCommon)
+}
@@ -28,6 +28,7 @@ func (self *DiffCommands) DiffCmdObj(diffArgs []string) *oscommands.CmdObj {
 		NewGitCmd("diff").
 			Config("diff.noprefix=false").
 			ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
+			Configs(self.pagerConfig.GetDiffColorConfigs()).
 			ArgIfElse(useExtDiff || useExtDiffGitConfig, "--ext-diff", "--no-ext-diff").
 			Arg("--submodule").
 			Arg(fmt.Sprintf("--color=%s", self.pagerConfig.GetColorArg())).
@@ -1,13 +1,16 @@
 package git_commands
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
@@ -36,18 +39,28 @@ type GetStatusFileOptions struct {
 	// This is useful for users with bare repos for dotfiles who default to hiding untracked files,
 	// but want to occasionally see them to `git add` a new file.
 	ForceShowUntracked bool
+	// If true, don't look for untracked files at all (unless
+	// ForceShowUntracked is set), because that can take a long time in huge
+	// repos. See git.skipUntrackedFiles.
+	SkipUntracked bool
+	// If set, git status is killed when the context is done
+	Ctx context.Context
 }
 
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
 	// check if config wants us ignoring untracked files
 	untrackedFilesSetting := self.config.GetShowUntrackedFiles()
 
-	if opts.ForceShowUntracked || untrackedFilesSetting == "" {
+	if opts.ForceShowUntracked {
+		untrackedFilesSetting = "all"
+	} else if opts.SkipUntracked {
+		untrackedFilesSetting = "no"
+	} else if untrackedFilesSetting == "" {
 		untrackedFilesSetting = "all"
 	}
 	untrackedFilesArg := fmt.Sprintf("--untracked-files=%s", untrackedFilesSetting)
 
-	statuses, err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg})
+	statuses, err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg, Ctx: opts.Ctx})
 	if err != nil {
 		self.Log.Error(err)
 	}
@@ -62,11 +75,6 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 	}
 
 	for _, status := range statuses {
-		if strings.HasPrefix(status.StatusString, "warning") {
-			self.Log.Warningf("warning when calling git status: %s", status.StatusString)
-			continue
-		}
-
 		file := &models.File{
 			Path:          status.Path,
 			PreviousPath:  status.PreviousPath,
@@ -102,9 +110,74 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	if self.usesLfs() {
+		if err := self.setLfsFields(files); err != nil {
+			self.Log.Error(err)
+		}
+	}
+
 	return files
 }
 
+// We only ask git which files are stored in lfs if the .gitattributes file at
+// the root of the worktree mentions it, to save running a command on every
+// refresh in repos that don't use lfs
+func (self *FileLoader) usesLfs() bool {
+	return repoUsesLfs(self.Fs, self.repoPaths.WorktreePath())
+}
+
+func (self *FileLoader) setLfsFields(files []*models.File) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	cmdArgs := NewGitCmd("check-attr").Arg("--stdin", "-z", "filter").
+		ToArgv()
+
+	paths := lo.Map(files, func(file *models.File, _ int) string { return file.Path })
+	output, err := self.cmd.New(cmdArgs).SetStdin(strings.Join(paths, "\x00")).DontLog().RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	lfsPaths := parseLfsCheckAttrOutput(output)
+	for _, file := range files {
+		file.IsLfs = lfsPaths[file.Path]
+		file.IsLfsPointer = file.IsLfs && !file.Deleted && self.isLfsPointer(file.Path)
+	}
+
+	return nil
+}
+
+// The first line of an lfs pointer file; see
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md
+const lfsPointerFirstLine = "version https://git-lfs.github.com/spec/v1\n"
+
+func (self *FileLoader) isLfsPointer(path string) bool {
+	f, err := self.Fs.Open(filepath.Join(self.repoPaths.WorktreePath(), path))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	buf := make([]byte, len(lfsPointerFirstLine))
+	n, _ := io.ReadFull(f, buf)
+	return string(buf[:n]) == lfsPointerFirstLine
+}
+
+// The output of `git check-attr -z filter` is a sequence of
+// <path> NUL filter NUL <value> NUL
+func parseLfsCheckAttrOutput(output string) map[string]bool {
+	fields := strings.Split(output, "\x00")
+	result := map[string]bool{}
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result[fields[i]] = true
+		}
+	}
+	return result
+}
+
 type FileDiff struct {
 	LinesAdded   int
 	LinesDeleted int
@@ -148,6 +221,7 @@ func (self *FileLoader) getFileDiffs() (map[string]FileDiff, error) {
 type GitStatusOptions struct {
 	NoRenames         bool
 	UntrackedFilesArg string
+	Ctx               context.Context
 }
 
 type FileStatus struct {
@@ -168,9 +242,12 @@ func (self *FileLoader) gitDiffNumStat() (string, error) {
 }
 
 func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
+	useFsmonitor := self.UserConfig().Git.UseFsmonitor
 	cmdArgs := NewGitCmd("status").
+		ConfigIf(useFsmonitor && self.supportsBuiltinFsmonitor(), "core.fsmonitor=true").
+		ConfigIf(useFsmonitor, "core.untrackedCache=true").
 		Arg(opts.UntrackedFilesArg).
-		Arg("--porcelain").
+		Arg("--porcelain=v2").
 		Arg("-z").
 		ArgIfElse(
 			opts.NoRenames,
@@ -179,7 +256,7 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 		).
 		ToArgv()
 
-	statusLines, _, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	statusLines, _, err := self.cmd.New(cmdArgs).WithContext(opts.Ctx).DontLog().RunWithOutputs()
 	if err != nil {
 		return []FileStatus{}, err
 	}
@@ -187,25 +264,58 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 	splitLines := strings.Split(statusLines, "\x00")
 	response := []FileStatus{}
 
+	// See the "Porcelain Format Version 2" section of `git help status` for the
+	// format of these lines
 	for i := 0; i < len(splitLines); i++ {
-		original := splitLines[i]
-
-		if len(original) < 3 {
+		line := splitLines[i]
+		if line == "" {
 			continue
 		}
 
-		status := FileStatus{
-			StatusString: original,
-			Change:       original[:2],
-			Path:         original[3:],
-			PreviousPath: "",
+		status := FileStatus{}
+		switch line[0] {
+		case '1':
+			// 1 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <path>
+			fields := strings.SplitN(line, " ", 9)
+			if len(fields) < 9 {
+				continue
+			}
+			status.Change = fields[1]
+			status.Path = fields[8]
+		case '2':
+			// 2 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <X><score> <path>, followed
+			// by the original path as a separate entry
+			fields := strings.SplitN(line, " ", 10)
+			if len(fields) < 10 || i+1 >= len(splitLines) {
+				continue
+			}
+			status.Change = fields[1]
+			status.Path = fields[9]
+			status.PreviousPath = splitLines[i+1]
+			i++
+		case 'u':
+			// u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>
+			fields := strings.SplitN(line, " ", 11)
+			if len(fields) < 11 {
+				continue
+			}
+			status.Change = fields[1]
+			status.Path = fields[10]
+		case '?':
+			status.Change = "??"
+			status.Path = line[2:]
+		default:
+			// ignored files and headers, which we don't ask for
+			self.Log.Warningf("unexpected line in git status output: %s", line)
+			continue
 		}
 
-		if strings.HasPrefix(status.Change, "R") || strings.HasPrefix(status.Change, "C") {
-			// if a line starts with 'R' (rename) or 'C' (copy) then the next line is the original file.
-			status.PreviousPath = splitLines[i+1]
+		// porcelain v2 uses '.' for unchanged, where v1 uses a space
+		status.Change = strings.ReplaceAll(status.Change, ".", " ")
+		if status.PreviousPath != "" {
 			status.StatusString = fmt.Sprintf("%s %s -> %s", status.Change, status.PreviousPath, status.Path)
-			i++
+		} else {
+			status.StatusString = status.Change + " " + status.Path
 		}
 
 		response = append(response, status)
@@ -213,3 +323,9 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 
 	return response, nil
 }
+
+// git's builtin file system monitor daemon is only available on macOS and
+// Windows
+func (self *FileLoader) supportsBuiltinFsmonitor() bool {
+	return (self.os.Platform.OS == "darwin" || self.os.Platform.OS == "windows") && self.version.IsAtLeast(2, 37, 0)
+}
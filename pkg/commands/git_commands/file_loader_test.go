@@ -1,11 +1,13 @@
 package git_commands
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,6 +17,11 @@ func TestFileGetStatusFiles(t *testing.T) {
 		similarityThreshold    int
 		runner                 oscommands.ICmdObjRunner
 		showNumstatInFilesView bool
+		useFsmonitor           bool
+		platform               *oscommands.Platform
+		gitAttributes          string
+		worktreeFiles          map[string]string
+		opts                   GetStatusFileOptions
 		expectedFiles          []*models.File
 	}
 
@@ -23,15 +30,19 @@ func TestFileGetStatusFiles(t *testing.T) {
 			testName:            "No files found",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "", nil),
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"}, "", nil),
 			expectedFiles: []*models.File{},
 		},
 		{
 			testName:            "Several files found",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
-					"MM file1.txt\x00A  file3.txt\x00AM file2.txt\x00?? file4.txt\x00UU file5.txt",
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"1 MM N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 file1.txt\x00"+
+						"1 A. N... 000000 100644 100644 0000000000000000000000000000000000000000 2222222222222222222222222222222222222222 file3.txt\x00"+
+						"1 AM N... 000000 100644 100644 0000000000000000000000000000000000000000 2222222222222222222222222222222222222222 file2.txt\x00"+
+						"? file4.txt\x00"+
+						"u UU N... 100644 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 3333333333333333333333333333333333333333 file5.txt\x00",
 					nil,
 				).
 				ExpectGitArgs([]string{"diff", "--numstat", "-z", "HEAD"},
@@ -116,7 +127,7 @@ func TestFileGetStatusFiles(t *testing.T) {
 			testName:            "File with new line char",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "MM a\nb.txt", nil),
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"}, "1 MM N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 a\nb.txt\x00", nil),
 			expectedFiles: []*models.File{
 				{
 					Path:                    "a\nb.txt",
@@ -136,8 +147,9 @@ func TestFileGetStatusFiles(t *testing.T) {
 			testName:            "Renamed files",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
-					"R  after1.txt\x00before1.txt\x00RM after2.txt\x00before2.txt",
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"2 R. N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 R100 after1.txt\x00before1.txt\x00"+
+						"2 RM N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 R87 after2.txt\x00before2.txt\x00",
 					nil,
 				),
 			expectedFiles: []*models.File{
@@ -173,8 +185,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 			testName:            "File with arrow in name",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
-					`?? a -> b.txt`,
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"? a -> b.txt\x00",
 					nil,
 				),
 			expectedFiles: []*models.File{
@@ -196,8 +208,9 @@ func TestFileGetStatusFiles(t *testing.T) {
 			testName:            "Copied files",
 			similarityThreshold: 50,
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
-					"C  copy1.txt\x00original.txt\x00CM copy2.txt\x00original.txt",
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"2 C. N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 C100 copy1.txt\x00original.txt\x00"+
+						"2 CM N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 C75 copy2.txt\x00original.txt\x00",
 					nil,
 				),
 			expectedFiles: []*models.File{
@@ -229,6 +242,149 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:            "File with spaces in name and submodule",
+			similarityThreshold: 50,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"1 .M N... 100644 100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 my file.txt\x00"+
+						"1 .M SC.. 160000 160000 160000 1111111111111111111111111111111111111111 1111111111111111111111111111111111111111 my submodule\x00",
+					nil,
+				),
+			expectedFiles: []*models.File{
+				{
+					Path:                    "my file.txt",
+					HasStagedChanges:        false,
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					Added:                   false,
+					Deleted:                 false,
+					HasMergeConflicts:       false,
+					HasInlineMergeConflicts: false,
+					DisplayString:           " M my file.txt",
+					ShortStatus:             " M",
+				},
+				{
+					Path:                    "my submodule",
+					HasStagedChanges:        false,
+					HasUnstagedChanges:      true,
+					Tracked:                 true,
+					Added:                   false,
+					Deleted:                 false,
+					HasMergeConflicts:       false,
+					HasInlineMergeConflicts: false,
+					DisplayString:           " M my submodule",
+					ShortStatus:             " M",
+				},
+			},
+		},
+		{
+			testName:            "Untracked cache enabled on linux",
+			similarityThreshold: 50,
+			useFsmonitor:        true,
+			platform:            &oscommands.Platform{OS: "linux"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "core.untrackedCache=true", "status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "Untracked cache and fsmonitor enabled on macOS",
+			similarityThreshold: 50,
+			useFsmonitor:        true,
+			platform:            &oscommands.Platform{OS: "darwin"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "core.untrackedCache=true", "-c", "core.fsmonitor=true", "status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "Untracked cache and fsmonitor enabled on windows",
+			similarityThreshold: 50,
+			useFsmonitor:        true,
+			platform:            &oscommands.Platform{OS: "windows"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "core.untrackedCache=true", "-c", "core.fsmonitor=true", "status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "Files stored in lfs",
+			similarityThreshold: 50,
+			gitAttributes:       "*.psd filter=lfs diff=lfs merge=lfs -text\n",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"1 .M N... 100644 100644 100644 1111111111111111111111111111111111111111 1111111111111111111111111111111111111111 image.psd\x00"+
+						"? notes.txt\x00",
+					nil,
+				).
+				ExpectGitArgs([]string{"check-attr", "--stdin", "-z", "filter"},
+					"image.psd\x00filter\x00lfs\x00notes.txt\x00filter\x00unspecified\x00",
+					nil,
+				),
+			worktreeFiles: map[string]string{"image.psd": "\x89PNG"},
+			expectedFiles: []*models.File{
+				{
+					Path:               "image.psd",
+					HasUnstagedChanges: true,
+					Tracked:            true,
+					DisplayString:      " M image.psd",
+					ShortStatus:        " M",
+					IsLfs:              true,
+				},
+				{
+					Path:               "notes.txt",
+					HasUnstagedChanges: true,
+					Added:              true,
+					DisplayString:      "?? notes.txt",
+					ShortStatus:        "??",
+				},
+			},
+		},
+		{
+			testName:            "Lfs pointer in the working copy",
+			similarityThreshold: 50,
+			gitAttributes:       "*.psd filter=lfs diff=lfs merge=lfs -text\n",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain=v2", "-z", "--find-renames=50%"},
+					"1 .M N... 100644 100644 100644 1111111111111111111111111111111111111111 1111111111111111111111111111111111111111 image.psd\x00",
+					nil,
+				).
+				ExpectGitArgs([]string{"check-attr", "--stdin", "-z", "filter"},
+					"image.psd\x00filter\x00lfs\x00",
+					nil,
+				),
+			worktreeFiles: map[string]string{
+				"image.psd": "version https://git-lfs.github.com/spec/v1\noid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393\nsize 12345\n",
+			},
+			expectedFiles: []*models.File{
+				{
+					Path:               "image.psd",
+					HasUnstagedChanges: true,
+					Tracked:            true,
+					DisplayString:      " M image.psd",
+					ShortStatus:        " M",
+					IsLfs:              true,
+					IsLfsPointer:       true,
+				},
+			},
+		},
+		{
+			testName:            "Skipping untracked files",
+			similarityThreshold: 50,
+			opts:                GetStatusFileOptions{SkipUntracked: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=no", "--porcelain=v2", "-z", "--find-renames=50%"}, "", nil),
+			expectedFiles: []*models.File{},
+		},
+		{
+			testName:            "Forcing untracked files to be shown while skipping them",
+			similarityThreshold: 50,
+			opts:                GetStatusFileOptions{SkipUntracked: true, ForceShowUntracked: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=all", "--porcelain=v2", "-z", "--find-renames=50%"}, "", nil),
+			expectedFiles: []*models.File{},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -238,15 +394,24 @@ func TestFileGetStatusFiles(t *testing.T) {
 			userConfig := &config.UserConfig{}
 			userConfig.Gui.ShowNumstatInFilesView = s.showNumstatInFilesView
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			userConfig.Git.UseFsmonitor = s.useFsmonitor
+
+			fs := afero.NewMemMapFs()
+			if s.gitAttributes != "" {
+				_ = afero.WriteFile(fs, filepath.Join(".git", ".gitattributes"), []byte(s.gitAttributes), 0o644)
+			}
+			for path, content := range s.worktreeFiles {
+				_ = afero.WriteFile(fs, filepath.Join(".git", path), []byte(content), 0o644)
+			}
 
 			loader := &FileLoader{
-				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig}),
+				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig, gitVersion: &GitVersion{2, 37, 0, ""}, fs: fs, platform: s.platform}),
 				cmd:         cmd,
 				config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
 				getFileType: func(string) string { return "file" },
 			}
 
-			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(GetStatusFileOptions{}))
+			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(s.opts))
 		})
 	}
 }
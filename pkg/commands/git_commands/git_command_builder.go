@@ -45,6 +45,14 @@ func (self *GitCommandBuilder) Config(value string) *GitCommandBuilder {
 	return self
 }
 
+func (self *GitCommandBuilder) Configs(values []string) *GitCommandBuilder {
+	for _, value := range values {
+		self.Config(value)
+	}
+
+	return self
+}
+
 func (self *GitCommandBuilder) ConfigIf(condition bool, ifTrue string) *GitCommandBuilder {
 	if condition {
 		self.Config(ifTrue)
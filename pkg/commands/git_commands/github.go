@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/cli/go-gh/v2/pkg/auth"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/samber/lo"
@@ -61,6 +63,17 @@ func (self *GitHubCommands) SetConfiguredBaseRemoteName(remoteName string) error
 	return self.cmd.New(cmdArgs).DontLog().Run()
 }
 
+// FetchPullRequest fetches the head of the given pull request into a local
+// branch. GitHub exposes the head of every pull request as refs/pull/<n>/head,
+// so this also works for pull requests from forks.
+func (self *GitHubCommands) FetchPullRequest(task gocui.Task, remoteName string, number int, branchName string) error {
+	cmdArgs := NewGitCmd("fetch").
+		Arg(remoteName, fmt.Sprintf("pull/%d/head:%s", number, branchName)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
 type Response struct {
 	Data RepositoryQuery `json:"data"`
 }
@@ -85,6 +98,7 @@ type PullRequestNode struct {
 	HeadRepositoryOwner GithubRepositoryOwner `json:"headRepositoryOwner"`
 	State               string                `json:"state"`
 	IsDraft             bool                  `json:"isDraft"`
+	ReviewDecision      string                `json:"reviewDecision"`
 }
 
 type GithubRepositoryOwner struct {
@@ -121,6 +135,7 @@ func fetchPullRequestsQuery(branches []string, owner string, repo string) (strin
           number
           url
           isDraft
+          reviewDecision
           headRepositoryOwner {
             login
           }
@@ -201,13 +216,41 @@ func (self *GitHubCommands) FetchRecentPRs(branches []string, baseRemote *models
 func (self *GitHubCommands) fetchRecentPRsAux(repoOwner string, repoName string, branches []string, token string) ([]*models.GithubPullRequest, error) {
 	queryString, variables := fetchPullRequestsQuery(branches, repoOwner, repoName)
 
+	var result Response
+	if err := postGraphQLQuery(queryString, variables, token, &result); err != nil {
+		return nil, err
+	}
+
+	prs := []*models.GithubPullRequest{}
+	for _, repoQuery := range result.Data.Repository {
+		for _, edge := range repoQuery.Edges {
+			node := edge.Node
+			pr := &models.GithubPullRequest{
+				HeadRefName:    node.HeadRefName,
+				Number:         node.Number,
+				Title:          node.Title,
+				State:          lo.Ternary(node.IsDraft && node.State != "CLOSED", "DRAFT", node.State),
+				Url:            node.Url,
+				ReviewDecision: node.ReviewDecision,
+				HeadRepositoryOwner: models.GithubRepositoryOwner{
+					Login: node.HeadRepositoryOwner.Login,
+				},
+			}
+			prs = append(prs, pr)
+		}
+	}
+
+	return prs, nil
+}
+
+func postGraphQLQuery(queryString string, variables map[string]string, token string, result any) error {
 	bodyBytes, err := json.Marshal(graphQLRequest{Query: queryString, Variables: variables})
 	if err != nil {
-		return nil, err
+		return err
 	}
 	req, err := http.NewRequest("POST", "https://api.github.com/graphql", bytes.NewBuffer(bodyBytes))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	req.Header.Set("Authorization", "token "+token)
@@ -216,46 +259,178 @@ func (self *GitHubCommands) fetchRecentPRsAux(repoOwner string, repoName string,
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		bodyStr := new(bytes.Buffer)
 		_, _ = bodyStr.ReadFrom(resp.Body)
-		return nil, fmt.Errorf("GraphQL query failed with status: %s. Body: %s", resp.Status, bodyStr.String())
+		return fmt.Errorf("GraphQL query failed with status: %s. Body: %s", resp.Status, bodyStr.String())
 	}
 
 	respBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var result Response
-	err = json.Unmarshal(respBytes, &result)
+	return json.Unmarshal(respBytes, result)
+}
+
+type CommitStatusResponse struct {
+	Data struct {
+		Repository map[string]*CommitStatusNode `json:"repository"`
+	} `json:"data"`
+}
+
+type CommitStatusNode struct {
+	Oid               string `json:"oid"`
+	StatusCheckRollup *struct {
+		State    string `json:"state"`
+		Contexts struct {
+			Nodes []CheckNode `json:"nodes"`
+		} `json:"contexts"`
+	} `json:"statusCheckRollup"`
+}
+
+// Either a check run (e.g. a GitHub Actions job) or a legacy commit status; we
+// get the fields of whichever type it is
+type CheckNode struct {
+	Typename string `json:"__typename"`
+
+	// CheckRun
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	DetailsUrl string `json:"detailsUrl"`
+
+	// StatusContext
+	Context   string `json:"context"`
+	State     string `json:"state"`
+	TargetUrl string `json:"targetUrl"`
+}
+
+func fetchCommitStatusesQuery(hashes []string, owner string, repo string) (string, map[string]string) {
+	variables := make(map[string]string, len(hashes)+2)
+	variables["owner"] = owner
+	variables["repo"] = repo
+	varDecls := make([]string, 0, len(hashes)+2)
+	varDecls = append(varDecls, "$owner: String!", "$repo: String!")
+	queries := make([]string, 0, len(hashes))
+	for i, hash := range hashes {
+		fieldName := fmt.Sprintf("c%d", i+1)
+		varName := fmt.Sprintf("oid%d", i+1)
+		variables[varName] = hash
+		varDecls = append(varDecls, fmt.Sprintf("$%s: GitObjectID!", varName))
+		queries = append(queries, fmt.Sprintf(`%s: object(oid: $%s) {
+      ... on Commit {
+        oid
+        statusCheckRollup {
+          state
+          contexts(first: 50) {
+            nodes {
+              __typename
+              ... on CheckRun {
+                name
+                status
+                conclusion
+                detailsUrl
+              }
+              ... on StatusContext {
+                context
+                state
+                targetUrl
+              }
+            }
+          }
+        }
+      }
+    }`, fieldName, varName))
+	}
+
+	queryString := fmt.Sprintf(`query(%s) {
+  repository(owner: $owner, name: $repo) {
+    %s
+  }
+}`, strings.Join(varDecls, ", "), strings.Join(queries, "\n"))
+
+	return queryString, variables
+}
+
+// FetchCommitStatuses fetches the CI status of the given commits. Commits that
+// GitHub doesn't know about, or that have no checks, are not in the result.
+func (self *GitHubCommands) FetchCommitStatuses(hashes []string, baseRemote *models.Remote, token string) (map[string]*models.GithubCommitStatus, error) {
+	repoOwner, repoName, err := self.GetBaseRepoOwnerAndName(baseRemote)
 	if err != nil {
 		return nil, err
 	}
 
-	prs := []*models.GithubPullRequest{}
-	for _, repoQuery := range result.Data.Repository {
-		for _, edge := range repoQuery.Edges {
-			node := edge.Node
-			pr := &models.GithubPullRequest{
-				HeadRefName: node.HeadRefName,
-				Number:      node.Number,
-				Title:       node.Title,
-				State:       lo.Ternary(node.IsDraft && node.State != "CLOSED", "DRAFT", node.State),
-				Url:         node.Url,
-				HeadRepositoryOwner: models.GithubRepositoryOwner{
-					Login: node.HeadRepositoryOwner.Login,
-				},
-			}
-			prs = append(prs, pr)
+	result := map[string]*models.GithubCommitStatus{}
+	for _, chunk := range lo.Chunk(hashes, 25) {
+		queryString, variables := fetchCommitStatusesQuery(chunk, repoOwner, repoName)
+
+		var response CommitStatusResponse
+		if err := postGraphQLQuery(queryString, variables, token, &response); err != nil {
+			return nil, err
 		}
+
+		maps.Copy(result, commitStatusesFromResponse(&response))
 	}
 
-	return prs, nil
+	return result, nil
+}
+
+func commitStatusesFromResponse(response *CommitStatusResponse) map[string]*models.GithubCommitStatus {
+	result := map[string]*models.GithubCommitStatus{}
+	for _, node := range response.Data.Repository {
+		// The node is null if GitHub doesn't know the commit, e.g. because it
+		// hasn't been pushed, and the rollup is null if there are no checks
+		if node == nil || node.StatusCheckRollup == nil {
+			continue
+		}
+		result[node.Oid] = &models.GithubCommitStatus{
+			State: ciStateFromStatusState(node.StatusCheckRollup.State),
+			Checks: lo.Map(node.StatusCheckRollup.Contexts.Nodes, func(check CheckNode, _ int) *models.GithubCheck {
+				return githubCheckFromNode(check)
+			}),
+		}
+	}
+	return result
+}
+
+func githubCheckFromNode(node CheckNode) *models.GithubCheck {
+	if node.Typename == "StatusContext" {
+		return &models.GithubCheck{
+			Name:  node.Context,
+			State: ciStateFromStatusState(node.State),
+			Url:   node.TargetUrl,
+		}
+	}
+
+	state := models.CIStateFailure
+	if node.Status != "COMPLETED" {
+		state = models.CIStatePending
+	} else if node.Conclusion == "SUCCESS" || node.Conclusion == "NEUTRAL" || node.Conclusion == "SKIPPED" {
+		state = models.CIStateSuccess
+	}
+	return &models.GithubCheck{
+		Name:  node.Name,
+		State: state,
+		Url:   node.DetailsUrl,
+	}
+}
+
+// Maps GitHub's StatusState (used both for the combined state of a commit and
+// for legacy commit statuses) to ours
+func ciStateFromStatusState(state string) models.CIState {
+	switch state {
+	case "SUCCESS":
+		return models.CIStateSuccess
+	case "PENDING", "EXPECTED":
+		return models.CIStatePending
+	default:
+		return models.CIStateFailure
+	}
 }
 
 // returns a map from branch name to pull request
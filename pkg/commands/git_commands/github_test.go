@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
@@ -361,3 +362,73 @@ func TestGenerateGithubPullRequestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestCommitStatusesFromResponse(t *testing.T) {
+	responseJson := `{
+  "data": {
+    "repository": {
+      "c1": {
+        "oid": "abc123",
+        "statusCheckRollup": {
+          "state": "FAILURE",
+          "contexts": {
+            "nodes": [
+              {"__typename": "CheckRun", "name": "build", "status": "COMPLETED", "conclusion": "SUCCESS", "detailsUrl": "https://example.com/build"},
+              {"__typename": "CheckRun", "name": "lint", "status": "COMPLETED", "conclusion": "SKIPPED", "detailsUrl": "https://example.com/lint"},
+              {"__typename": "CheckRun", "name": "test", "status": "COMPLETED", "conclusion": "TIMED_OUT", "detailsUrl": "https://example.com/test"},
+              {"__typename": "CheckRun", "name": "deploy", "status": "IN_PROGRESS", "conclusion": null, "detailsUrl": "https://example.com/deploy"},
+              {"__typename": "StatusContext", "context": "ci/legacy", "state": "ERROR", "targetUrl": "https://example.com/legacy"}
+            ]
+          }
+        }
+      },
+      "c2": {
+        "oid": "def456",
+        "statusCheckRollup": {
+          "state": "EXPECTED",
+          "contexts": {"nodes": []}
+        }
+      },
+      "c3": {
+        "oid": "no-checks",
+        "statusCheckRollup": null
+      },
+      "c4": null
+    }
+  }
+}`
+
+	var response CommitStatusResponse
+	assert.NoError(t, json.Unmarshal([]byte(responseJson), &response))
+
+	assert.Equal(t, map[string]*models.GithubCommitStatus{
+		"abc123": {
+			State: models.CIStateFailure,
+			Checks: []*models.GithubCheck{
+				{Name: "build", State: models.CIStateSuccess, Url: "https://example.com/build"},
+				{Name: "lint", State: models.CIStateSuccess, Url: "https://example.com/lint"},
+				{Name: "test", State: models.CIStateFailure, Url: "https://example.com/test"},
+				{Name: "deploy", State: models.CIStatePending, Url: "https://example.com/deploy"},
+				{Name: "ci/legacy", State: models.CIStateFailure, Url: "https://example.com/legacy"},
+			},
+		},
+		"def456": {
+			State:  models.CIStatePending,
+			Checks: []*models.GithubCheck{},
+		},
+	}, commitStatusesFromResponse(&response))
+}
+
+func TestFetchCommitStatusesQuery(t *testing.T) {
+	query, variables := fetchCommitStatusesQuery([]string{"abc123", "def456"}, "jesseduffield", "lazygit")
+
+	assert.Contains(t, query, "query($owner: String!, $repo: String!, $oid1: GitObjectID!, $oid2: GitObjectID!)")
+	assert.Contains(t, query, "c1: object(oid: $oid1)")
+	assert.Contains(t, query, "c2: object(oid: $oid2)")
+	assert.Equal(t, map[string]string{
+		"owner": "jesseduffield",
+		"repo":  "lazygit",
+		"oid1":  "abc123",
+		"oid2":  "def456",
+	}, variables)
+}
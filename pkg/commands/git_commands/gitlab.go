@@ -0,0 +1,315 @@
+package git_commands
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+)
+
+// Talks to GitLab's REST API, both for gitlab.com and for self-hosted instances
+// that are configured in the `services` config. Like glab, we take the access
+// token from the GITLAB_TOKEN environment variable.
+type GitLabCommands struct {
+	*GitCommon
+	hostingService *HostingService
+}
+
+func NewGitLabCommands(gitCommon *GitCommon, hostingService *HostingService) *GitLabCommands {
+	return &GitLabCommands{
+		GitCommon:      gitCommon,
+		hostingService: hostingService,
+	}
+}
+
+func (self *GitLabCommands) GetAuthToken() string {
+	return self.os.Getenv("GITLAB_TOKEN")
+}
+
+func (self *GitLabCommands) InGitlabRepo(remotes []*models.Remote) bool {
+	return self.hostingService.getMainRemoteProvider(remotes) == "gitlab"
+}
+
+// Returns the remote of the project that merge requests are made against.
+// Unlike gh, glab doesn't store this in the git config.
+func (self *GitLabCommands) GetBaseRemote(remotes []*models.Remote) *models.Remote {
+	return getUpstreamOrMainRemote(remotes)
+}
+
+// FetchRecentMergeRequests fetches the merge requests of the given branches.
+// GitLab can only filter merge requests by a single source branch, so rather
+// than sending a request per branch, we get the most recently updated merge
+// requests and pick the ones of our branches.
+func (self *GitLabCommands) FetchRecentMergeRequests(branches []string, baseRemote *models.Remote, token string) ([]*models.GithubPullRequest, error) {
+	api, projectPath, err := self.getAPI(baseRemote, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.fetchMergeRequests(projectPath, branches)
+}
+
+// FetchMergeRequest fetches the head of the given merge request into a local
+// branch. GitLab exposes the head of every merge request as
+// refs/merge-requests/<n>/head, so this also works for merge requests from
+// forks.
+func (self *GitLabCommands) FetchMergeRequest(task gocui.Task, remoteName string, number int, branchName string) error {
+	cmdArgs := NewGitCmd("fetch").
+		Arg(remoteName, fmt.Sprintf("merge-requests/%d/head:%s", number, branchName)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// FetchCommitStatuses fetches the CI status of the given commits, which GitLab
+// makes up of the jobs of their pipelines and of statuses set by external
+// services. Commits that GitLab doesn't know about, or that have no statuses,
+// are not in the result.
+func (self *GitLabCommands) FetchCommitStatuses(hashes []string, baseRemote *models.Remote, token string) (map[string]*models.GithubCommitStatus, error) {
+	api, projectPath, err := self.getAPI(baseRemote, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.fetchCommitStatuses(projectPath, hashes)
+}
+
+// Returns the API of the GitLab instance that the remote belongs to, and the
+// path of the remote's project, e.g. "my-group/my-project"
+func (self *GitLabCommands) getAPI(remote *models.Remote, token string) (*gitlabAPI, string, error) {
+	if len(remote.Urls) == 0 {
+		return nil, "", fmt.Errorf("No URLs found for remote")
+	}
+
+	_, webDomain, err := self.hostingService.GetProviderAndWebDomain(remote.Urls[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	projectPath, err := self.hostingService.GetRepoNameFromRemoteURL(remote.Urls[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &gitlabAPI{baseURL: "https://" + webDomain + "/api/v4", token: token}, projectPath, nil
+}
+
+type gitlabAPI struct {
+	// e.g. "https://gitlab.com/api/v4"
+	baseURL string
+	token   string
+}
+
+func (self *gitlabAPI) get(path string, query url.Values, result any) error {
+	requestURL := self.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	return getJSON(requestURL, map[string]string{"PRIVATE-TOKEN": self.token}, result)
+}
+
+// Projects can be addressed by their URL-encoded path instead of their ID
+func gitlabProjectPath(projectPath string) string {
+	return "/projects/" + url.PathEscape(projectPath)
+}
+
+type GitlabMergeRequest struct {
+	Iid             int    `json:"iid"`
+	Title           string `json:"title"`
+	State           string `json:"state"` // "opened", "closed", "locked" or "merged"
+	Draft           bool   `json:"draft"`
+	WebUrl          string `json:"web_url"`
+	SourceBranch    string `json:"source_branch"`
+	SourceProjectId int    `json:"source_project_id"`
+	TargetProjectId int    `json:"target_project_id"`
+	// See https://docs.gitlab.com/api/merge_requests/#merge-status
+	DetailedMergeStatus string `json:"detailed_merge_status"`
+}
+
+type GitlabProject struct {
+	Namespace struct {
+		FullPath string `json:"full_path"`
+	} `json:"namespace"`
+}
+
+func (self *gitlabAPI) fetchMergeRequests(projectPath string, branches []string) ([]*models.GithubPullRequest, error) {
+	var mergeRequests []GitlabMergeRequest
+	query := url.Values{
+		"state":    {"all"},
+		"order_by": {"updated_at"},
+		"sort":     {"desc"},
+		"per_page": {"100"},
+	}
+	if err := self.get(gitlabProjectPath(projectPath)+"/merge_requests", query, &mergeRequests); err != nil {
+		return nil, err
+	}
+
+	branchSet := set.NewFromSlice(branches)
+	mergeRequests = lo.Filter(mergeRequests, func(mergeRequest GitlabMergeRequest, _ int) bool {
+		return branchSet.Includes(mergeRequest.SourceBranch)
+	})
+
+	// To match a merge request to a branch, we need the namespace of the
+	// project that it comes from, which differs from ours for forks
+	namespaces := map[int]string{}
+	for _, mergeRequest := range mergeRequests {
+		if _, ok := namespaces[mergeRequest.SourceProjectId]; ok {
+			continue
+		}
+
+		if mergeRequest.SourceProjectId == mergeRequest.TargetProjectId {
+			namespaces[mergeRequest.SourceProjectId] = path.Dir(projectPath)
+			continue
+		}
+
+		var project GitlabProject
+		err := self.get(fmt.Sprintf("/projects/%d", mergeRequest.SourceProjectId), nil, &project)
+		if err == errNotFound {
+			// The fork was deleted, or we don't have access to it
+			namespaces[mergeRequest.SourceProjectId] = ""
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		namespaces[mergeRequest.SourceProjectId] = project.Namespace.FullPath
+	}
+
+	return lo.FilterMap(mergeRequests, func(mergeRequest GitlabMergeRequest, _ int) (*models.GithubPullRequest, bool) {
+		namespace := namespaces[mergeRequest.SourceProjectId]
+		if namespace == "" {
+			return nil, false
+		}
+
+		return &models.GithubPullRequest{
+			HeadRefName:         mergeRequest.SourceBranch,
+			Number:              mergeRequest.Iid,
+			Title:               mergeRequest.Title,
+			State:               pullRequestStateFromGitlabMergeRequest(mergeRequest),
+			Url:                 mergeRequest.WebUrl,
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: namespace},
+			ReviewDecision:      reviewDecisionFromGitlabMergeStatus(mergeRequest.DetailedMergeStatus),
+		}, true
+	}), nil
+}
+
+func pullRequestStateFromGitlabMergeRequest(mergeRequest GitlabMergeRequest) string {
+	switch mergeRequest.State {
+	case "merged":
+		return "MERGED"
+	case "closed":
+		return "CLOSED"
+	default:
+		// A merge request is locked only briefly while it's being merged
+		return lo.Ternary(mergeRequest.Draft, "DRAFT", "OPEN")
+	}
+}
+
+// The list of merge requests doesn't tell us whether a merge request was
+// approved, only whether it still needs approvals or has requested changes
+func reviewDecisionFromGitlabMergeStatus(status string) string {
+	switch status {
+	case "not_approved":
+		return "REVIEW_REQUIRED"
+	case "requested_changes":
+		return "CHANGES_REQUESTED"
+	default:
+		return ""
+	}
+}
+
+type GitlabCommitStatus struct {
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	TargetUrl    string `json:"target_url"`
+	AllowFailure bool   `json:"allow_failure"`
+}
+
+func (self *gitlabAPI) fetchCommitStatuses(projectPath string, hashes []string) (map[string]*models.GithubCommitStatus, error) {
+	// There's no endpoint for getting the statuses of several commits at once,
+	// so we need a request per commit
+	statuses := make([]*models.GithubCommitStatus, len(hashes))
+	var g errgroup.Group
+	g.SetLimit(5)
+	for i, hash := range hashes {
+		g.Go(func() error {
+			var response []GitlabCommitStatus
+			path := fmt.Sprintf("%s/repository/commits/%s/statuses", gitlabProjectPath(projectPath), hash)
+			err := self.get(path, url.Values{"per_page": {"100"}}, &response)
+			if err == errNotFound {
+				// GitLab doesn't know the commit, e.g. because it hasn't been
+				// pushed
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			statuses[i] = commitStatusFromGitlabStatuses(response)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := map[string]*models.GithubCommitStatus{}
+	for i, status := range statuses {
+		if status != nil {
+			result[hashes[i]] = status
+		}
+	}
+	return result, nil
+}
+
+func commitStatusFromGitlabStatuses(statuses []GitlabCommitStatus) *models.GithubCommitStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	checks := lo.Map(statuses, func(status GitlabCommitStatus, _ int) *models.GithubCheck {
+		return &models.GithubCheck{
+			Name:  status.Name,
+			State: ciStateFromGitlabStatus(status),
+			Url:   status.TargetUrl,
+		}
+	})
+	hasState := func(state models.CIState) bool {
+		return lo.SomeBy(checks, func(check *models.GithubCheck) bool { return check.State == state })
+	}
+
+	state := models.CIStateSuccess
+	if hasState(models.CIStateFailure) {
+		state = models.CIStateFailure
+	} else if hasState(models.CIStatePending) {
+		state = models.CIStatePending
+	}
+
+	return &models.GithubCommitStatus{State: state, Checks: checks}
+}
+
+// See https://docs.gitlab.com/api/commits/#list-the-statuses-of-a-commit for
+// the possible values
+func ciStateFromGitlabStatus(status GitlabCommitStatus) models.CIState {
+	switch status.Status {
+	case "success", "skipped":
+		return models.CIStateSuccess
+	case "manual":
+		// Nobody might ever start a manual job, so we don't want the commit to
+		// be pending forever
+		return models.CIStateSuccess
+	case "failed", "canceled":
+		// Jobs that are allowed to fail only produce a warning in GitLab
+		return lo.Ternary(status.AllowFailure, models.CIStateSuccess, models.CIStateFailure)
+	default:
+		// created, waiting_for_resource, preparing, pending, running, scheduled
+		return models.CIStatePending
+	}
+}
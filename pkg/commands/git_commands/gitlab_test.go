@@ -0,0 +1,194 @@
+package git_commands
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInGitlabRepo(t *testing.T) {
+	cases := []struct {
+		name     string
+		remotes  []*models.Remote
+		services map[string]string
+		expected bool
+	}{
+		{
+			name:     "no remotes",
+			remotes:  []*models.Remote{},
+			expected: false,
+		},
+		{
+			name:     "gitlab.com",
+			remotes:  []*models.Remote{{Name: "origin", Urls: []string{"git@gitlab.com:my-group/my-project.git"}}},
+			expected: true,
+		},
+		{
+			name:     "github.com",
+			remotes:  []*models.Remote{{Name: "origin", Urls: []string{"git@github.com:jesseduffield/lazygit.git"}}},
+			expected: false,
+		},
+		{
+			name:     "self-hosted instance configured as a service",
+			remotes:  []*models.Remote{{Name: "origin", Urls: []string{"git@git.mycompany.com:my-group/my-project.git"}}},
+			services: map[string]string{"git.mycompany.com": "gitlab:gitlab.mycompany.com"},
+			expected: true,
+		},
+		{
+			name: "uses origin if there are several remotes",
+			remotes: []*models.Remote{
+				{Name: "fork", Urls: []string{"git@github.com:someone/my-project.git"}},
+				{Name: "origin", Urls: []string{"git@gitlab.com:my-group/my-project.git"}},
+			},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Services = c.services
+			gitCommon := buildGitCommon(commonDeps{userConfig: userConfig})
+			instance := NewGitLabCommands(gitCommon, NewHostingServiceCommand(gitCommon))
+
+			assert.Equal(t, c.expected, instance.InGitlabRepo(c.remotes))
+		})
+	}
+}
+
+func TestGitlabFetchCommitStatuses(t *testing.T) {
+	statusesByHash := map[string][]GitlabCommitStatus{
+		"passed": {
+			{Name: "build", Status: "success", TargetUrl: "https://gitlab.com/jobs/1"},
+			{Name: "deploy", Status: "manual", TargetUrl: "https://gitlab.com/jobs/2"},
+			{Name: "lint", Status: "failed", TargetUrl: "https://gitlab.com/jobs/3", AllowFailure: true},
+		},
+		"running": {
+			{Name: "build", Status: "success", TargetUrl: "https://gitlab.com/jobs/4"},
+			{Name: "test", Status: "running", TargetUrl: "https://gitlab.com/jobs/5"},
+		},
+		"failed": {
+			{Name: "build", Status: "failed", TargetUrl: "https://gitlab.com/jobs/6"},
+			{Name: "test", Status: "running", TargetUrl: "https://gitlab.com/jobs/7"},
+		},
+		"no-pipeline": {},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-token", r.Header.Get("PRIVATE-TOKEN"))
+
+		for hash, statuses := range statusesByHash {
+			if r.URL.EscapedPath() == "/api/v4/projects/my-group%2Fsub-group%2Fmy-project/repository/commits/"+hash+"/statuses" {
+				_ = json.NewEncoder(w).Encode(statuses)
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Commit Not Found"}`))
+	}))
+	defer server.Close()
+
+	api := &gitlabAPI{baseURL: server.URL + "/api/v4", token: "my-token"}
+	result, err := api.fetchCommitStatuses("my-group/sub-group/my-project", []string{"passed", "running", "failed", "no-pipeline", "unpushed"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*models.GithubCommitStatus{
+		"passed": {
+			State: models.CIStateSuccess,
+			Checks: []*models.GithubCheck{
+				{Name: "build", State: models.CIStateSuccess, Url: "https://gitlab.com/jobs/1"},
+				{Name: "deploy", State: models.CIStateSuccess, Url: "https://gitlab.com/jobs/2"},
+				{Name: "lint", State: models.CIStateSuccess, Url: "https://gitlab.com/jobs/3"},
+			},
+		},
+		"running": {
+			State: models.CIStatePending,
+			Checks: []*models.GithubCheck{
+				{Name: "build", State: models.CIStateSuccess, Url: "https://gitlab.com/jobs/4"},
+				{Name: "test", State: models.CIStatePending, Url: "https://gitlab.com/jobs/5"},
+			},
+		},
+		"failed": {
+			State: models.CIStateFailure,
+			Checks: []*models.GithubCheck{
+				{Name: "build", State: models.CIStateFailure, Url: "https://gitlab.com/jobs/6"},
+				{Name: "test", State: models.CIStatePending, Url: "https://gitlab.com/jobs/7"},
+			},
+		},
+	}, result)
+}
+
+func TestGitlabFetchCommitStatusesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"401 Unauthorized"}`))
+	}))
+	defer server.Close()
+
+	api := &gitlabAPI{baseURL: server.URL + "/api/v4", token: "bad-token"}
+	_, err := api.fetchCommitStatuses("my-group/my-project", []string{"abc"})
+	assert.EqualError(t, err, `Request failed with status: 401 Unauthorized. Body: {"message":"401 Unauthorized"}`)
+}
+
+func TestGitlabFetchMergeRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-token", r.Header.Get("PRIVATE-TOKEN"))
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/my-group%2Fmy-project/merge_requests":
+			assert.Equal(t, "updated_at", r.URL.Query().Get("order_by"))
+			assert.Equal(t, "all", r.URL.Query().Get("state"))
+			_, _ = w.Write([]byte(`[
+				{"iid": 5, "title": "Draft feature", "state": "opened", "draft": true, "web_url": "https://gitlab.com/my-group/my-project/-/merge_requests/5", "source_branch": "feature", "source_project_id": 1, "target_project_id": 1, "detailed_merge_status": "not_approved"},
+				{"iid": 4, "title": "Fix from fork", "state": "opened", "draft": false, "web_url": "https://gitlab.com/my-group/my-project/-/merge_requests/4", "source_branch": "fix", "source_project_id": 2, "target_project_id": 1, "detailed_merge_status": "requested_changes"},
+				{"iid": 3, "title": "Unrelated", "state": "opened", "draft": false, "web_url": "https://gitlab.com/my-group/my-project/-/merge_requests/3", "source_branch": "other", "source_project_id": 1, "target_project_id": 1, "detailed_merge_status": "mergeable"},
+				{"iid": 2, "title": "Old feature", "state": "merged", "draft": false, "web_url": "https://gitlab.com/my-group/my-project/-/merge_requests/2", "source_branch": "feature", "source_project_id": 1, "target_project_id": 1, "detailed_merge_status": "not_open"},
+				{"iid": 1, "title": "From deleted fork", "state": "closed", "draft": false, "web_url": "https://gitlab.com/my-group/my-project/-/merge_requests/1", "source_branch": "fix", "source_project_id": 3, "target_project_id": 1, "detailed_merge_status": "not_open"}
+			]`))
+		case "/api/v4/projects/2":
+			_, _ = w.Write([]byte(`{"id": 2, "namespace": {"full_path": "someone/sub-group"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"404 Project Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	api := &gitlabAPI{baseURL: server.URL + "/api/v4", token: "my-token"}
+	result, err := api.fetchMergeRequests("my-group/my-project", []string{"feature", "fix"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.GithubPullRequest{
+		{
+			HeadRefName:         "feature",
+			Number:              5,
+			Title:               "Draft feature",
+			State:               "DRAFT",
+			Url:                 "https://gitlab.com/my-group/my-project/-/merge_requests/5",
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: "my-group"},
+			ReviewDecision:      "REVIEW_REQUIRED",
+		},
+		{
+			HeadRefName:         "fix",
+			Number:              4,
+			Title:               "Fix from fork",
+			State:               "OPEN",
+			Url:                 "https://gitlab.com/my-group/my-project/-/merge_requests/4",
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: "someone/sub-group"},
+			ReviewDecision:      "CHANGES_REQUESTED",
+		},
+		{
+			HeadRefName:         "feature",
+			Number:              2,
+			Title:               "Old feature",
+			State:               "MERGED",
+			Url:                 "https://gitlab.com/my-group/my-project/-/merge_requests/2",
+			HeadRepositoryOwner: models.GithubRepositoryOwner{Login: "my-group"},
+			ReviewDecision:      "",
+		},
+	}, result)
+}
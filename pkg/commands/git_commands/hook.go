@@ -0,0 +1,99 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
+
+type HookCommands struct {
+	*GitCommon
+}
+
+func NewHookCommands(gitCommon *GitCommon) *HookCommands {
+	return &HookCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns the directory that git looks for hooks in. This takes core.hooksPath
+// into account.
+func (self *HookCommands) HooksDir() (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").
+		Arg("--git-path", "hooks").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	dir := strings.TrimSpace(output)
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(self.repoPaths.WorktreePath(), dir)
+	}
+
+	return dir, nil
+}
+
+// Returns the hooks in the given directory, ignoring the sample hooks that git
+// creates when initializing a repo
+func (self *HookCommands) GetHooks(hooksDir string) ([]*models.Hook, error) {
+	entries, err := os.ReadDir(hooksDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	hooks := []*models.Hook{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), ".sample") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		hooks = append(hooks, &models.Hook{
+			Name:       entry.Name(),
+			Path:       filepath.Join(hooksDir, entry.Name()),
+			Executable: info.Mode()&0o111 != 0,
+		})
+	}
+
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].Name < hooks[j].Name })
+
+	return hooks, nil
+}
+
+// Enables or disables a hook by setting or clearing its executable bits
+func (self *HookCommands) SetExecutable(hook *models.Hook, executable bool) error {
+	info, err := os.Stat(hook.Path)
+	if err != nil {
+		return err
+	}
+
+	mode := info.Mode()
+	if executable {
+		// Only make it executable for those who can read it
+		mode |= (mode & 0o444) >> 2
+	} else {
+		mode &^= 0o111
+	}
+
+	return os.Chmod(hook.Path, mode)
+}
+
+// Runs the hook without any arguments, from the root of the worktree like git
+// does
+func (self *HookCommands) RunCmdObj(hook *models.Hook) *oscommands.CmdObj {
+	return self.cmd.New([]string{hook.Path}).SetWd(self.repoPaths.WorktreePath())
+}
@@ -0,0 +1,85 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookHooksDir(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "relative to the worktree",
+			output:   ".git/hooks\n",
+			expected: filepath.Join("/path/to/worktree", ".git/hooks"),
+		},
+		{
+			testName: "absolute core.hooksPath",
+			output:   "/path/to/hooks\n",
+			expected: "/path/to/hooks",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"rev-parse", "--git-path", "hooks"}, s.output, nil)
+			repoPaths := RepoPaths{
+				worktreePath: "/path/to/worktree",
+			}
+			instance := buildHookCommands(commonDeps{runner: runner, repoPaths: &repoPaths})
+
+			dir, err := instance.HooksDir()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, dir)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestHookGetHooksAndSetExecutable(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bits are not supported on windows")
+	}
+
+	hooksDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(hooksDir, "pre-push"), []byte("#!/bin/sh\n"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(hooksDir, "pre-commit"), []byte("#!/bin/sh\n"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(hooksDir, "commit-msg.sample"), []byte("#!/bin/sh\n"), 0o755))
+	assert.NoError(t, os.Mkdir(filepath.Join(hooksDir, "pre-commit.d"), 0o755))
+
+	instance := buildHookCommands(commonDeps{})
+
+	hooks, err := instance.GetHooks(hooksDir)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Hook{
+		{Name: "pre-commit", Path: filepath.Join(hooksDir, "pre-commit"), Executable: true},
+		{Name: "pre-push", Path: filepath.Join(hooksDir, "pre-push"), Executable: false},
+	}, hooks)
+
+	assert.NoError(t, instance.SetExecutable(hooks[0], false))
+	assert.NoError(t, instance.SetExecutable(hooks[1], true))
+
+	hooks, err = instance.GetHooks(hooksDir)
+	assert.NoError(t, err)
+	assert.False(t, hooks[0].Executable)
+	assert.True(t, hooks[1].Executable)
+
+	info, err := os.Stat(hooks[1].Path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	hooks, err = instance.GetHooks(filepath.Join(hooksDir, "does-not-exist"))
+	assert.NoError(t, err)
+	assert.Empty(t, hooks)
+}
@@ -1,6 +1,17 @@
 package git_commands
 
-import "github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
 
 // a hosting service is something like github, gitlab, bitbucket etc
 type HostingService struct {
@@ -25,10 +36,92 @@ func (self *HostingService) GetRepoNameFromRemoteURL(remoteURL string) (string,
 	return self.getHostingServiceMgr(remoteURL).GetRepoName()
 }
 
+func (self *HostingService) GetProviderAndWebDomain(remoteURL string) (string, string, error) {
+	return self.getHostingServiceMgr(remoteURL).GetProviderAndWebDomain()
+}
+
+// Returns the provider of the hosting service that the main remote is on (e.g.
+// "gitlab"), or an empty string if we don't know it
+func (self *HostingService) getMainRemoteProvider(remotes []*models.Remote) string {
+	if len(remotes) == 0 {
+		return ""
+	}
+
+	remote := getMainRemote(remotes)
+
+	if len(remote.Urls) == 0 {
+		return ""
+	}
+
+	provider, _, err := self.GetProviderAndWebDomain(remote.Urls[0])
+	if err != nil {
+		return ""
+	}
+
+	return provider
+}
+
+// For hosting services other than GitHub, we don't know which remote is the
+// one that pull requests are made against, so we go by the common convention of
+// calling the remote of the parent repo of a fork "upstream"
+func getUpstreamOrMainRemote(remotes []*models.Remote) *models.Remote {
+	if upstream, ok := lo.Find(remotes, func(remote *models.Remote) bool {
+		return remote.Name == "upstream"
+	}); ok {
+		return upstream
+	}
+
+	if len(remotes) == 0 {
+		return nil
+	}
+
+	return getMainRemote(remotes)
+}
+
 // getting this on every request rather than storing it in state in case our remoteURL changes
 // from one invocation to the next. Note however that we're currently caching config
 // results so we might want to invalidate the cache here if it becomes a problem.
 func (self *HostingService) getHostingServiceMgr(remoteURL string) *hosting_service.HostingServiceMgr {
 	configServices := self.UserConfig().Services
-	return hosting_service.NewHostingServiceMgr(self.Log, self.Tr, remoteURL, configServices)
+	return hosting_service.NewHostingServiceMgr(self.Log, self.Tr, remoteURL, configServices, self.UserConfig().ServiceTypes)
+}
+
+var errNotFound = errors.New("not found")
+
+// Sends a GET request to a hosting service's REST API and decodes the JSON
+// response into result. Returns errNotFound for a 404 response, which e.g.
+// GitLab sends for commits that it doesn't know about.
+func getJSON(url string, headers map[string]string, result any) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyStr := new(bytes.Buffer)
+		_, _ = bodyStr.ReadFrom(resp.Body)
+		return fmt.Errorf("Request failed with status: %s. Body: %s", resp.Status, bodyStr.String())
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(respBytes, result)
 }
@@ -0,0 +1,82 @@
+package git_commands
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// These need the git-lfs extension to be installed. Note that they talk to the
+// lfs server of the remote, so they can be slow.
+type LfsCommands struct {
+	*GitCommon
+}
+
+func NewLfsCommands(gitCommon *GitCommon) *LfsCommands {
+	return &LfsCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Whether the .gitattributes file at the root of the worktree stores any files
+// in lfs
+func (self *LfsCommands) RepoUsesLfs() bool {
+	return repoUsesLfs(self.Fs, self.repoPaths.WorktreePath())
+}
+
+func repoUsesLfs(fs afero.Fs, worktreePath string) bool {
+	content, err := afero.ReadFile(fs, filepath.Join(worktreePath, ".gitattributes"))
+	return err == nil && strings.Contains(string(content), "filter=lfs")
+}
+
+type lfsLockJson struct {
+	Id    string `json:"id"`
+	Path  string `json:"path"`
+	Owner struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+}
+
+func (self *LfsCommands) GetLocks() ([]*models.LfsLock, error) {
+	cmdArgs := NewGitCmd("lfs").Arg("locks", "--json").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLfsLocks(output)
+}
+
+func parseLfsLocks(output string) ([]*models.LfsLock, error) {
+	var locks []lfsLockJson
+	if err := json.Unmarshal([]byte(output), &locks); err != nil {
+		return nil, err
+	}
+
+	return lo.Map(locks, func(lock lfsLockJson, _ int) *models.LfsLock {
+		return &models.LfsLock{Id: lock.Id, Path: lock.Path, Owner: lock.Owner.Name}
+	}), nil
+}
+
+func (self *LfsCommands) Lock(path string) error {
+	cmdArgs := NewGitCmd("lfs").Arg("lock", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Unlocking a file that someone else has locked needs force
+func (self *LfsCommands) Unlock(path string, force bool) error {
+	cmdArgs := NewGitCmd("lfs").Arg("unlock").
+		ArgIf(force, "--force").
+		Arg("--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,20 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLfsLocks(t *testing.T) {
+	output := `[{"id":"3","path":"assets/logo.psd","owner":{"name":"Jesse"},"locked_at":"2024-01-01T12:00:00Z"},` +
+		`{"id":"7","path":"video.mp4","owner":{"name":"Stefan"},"locked_at":"2024-01-02T12:00:00Z"}]`
+
+	locks, err := parseLfsLocks(output)
+	assert.NoError(t, err)
+	assert.EqualValues(t, []*models.LfsLock{
+		{Id: "3", Path: "assets/logo.psd", Owner: "Jesse"},
+		{Id: "7", Path: "video.mp4", Owner: "Stefan"},
+	}, locks)
+}
@@ -0,0 +1,155 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type MaintenanceCommands struct {
+	*GitCommon
+}
+
+func NewMaintenanceCommands(gitCommon *GitCommon) *MaintenanceCommands {
+	return &MaintenanceCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// What the object database of the repo looks like, which tells whether it
+// could do with some maintenance
+type RepoHealth struct {
+	LooseObjects  int
+	LooseSize     string // human readable, e.g. "1.50 MiB"
+	PackedObjects int
+	Packs         int
+	PackSize      string
+	// Whether there's a commit-graph file, which speeds up walking the history
+	HasCommitGraph bool
+	// Whether the repo is registered for scheduled maintenance with
+	// `git maintenance register` (or `start`)
+	IsRegistered bool
+}
+
+func (self *MaintenanceCommands) GetRepoHealth() (*RepoHealth, error) {
+	cmdArgs := NewGitCmd("count-objects").Arg("-v", "-H").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	health := parseCountObjectsOutput(output)
+	health.HasCommitGraph = self.hasCommitGraph()
+	health.IsRegistered = self.isRegistered()
+	return health, nil
+}
+
+// Parses the output of `git count-objects -v -H`, which has lines like
+// `count: 12` or `size-pack: 1.50 MiB`
+func parseCountObjectsOutput(output string) *RepoHealth {
+	health := &RepoHealth{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		number, _ := strconv.Atoi(value)
+		switch key {
+		case "count":
+			health.LooseObjects = number
+		case "size":
+			health.LooseSize = value
+		case "in-pack":
+			health.PackedObjects = number
+		case "packs":
+			health.Packs = number
+		case "size-pack":
+			health.PackSize = value
+		}
+	}
+	return health
+}
+
+func (self *MaintenanceCommands) hasCommitGraph() bool {
+	infoDir := filepath.Join(self.repoPaths.RepoGitDirPath(), "objects", "info")
+	for _, path := range []string{
+		filepath.Join(infoDir, "commit-graph"),
+		filepath.Join(infoDir, "commit-graphs", "commit-graph-chain"),
+	} {
+		if exists, _ := self.os.FileExists(path); exists {
+			return true
+		}
+	}
+	return false
+}
+
+// `git maintenance register` adds the real path of the repo (or of the
+// worktree it's run in) to the global maintenance.repo config
+func (self *MaintenanceCommands) isRegistered() bool {
+	cmdArgs := NewGitCmd("config").Arg("--global", "--get-all", "maintenance.repo").
+		ToArgv()
+
+	// This fails if there are no registered repos
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return false
+	}
+
+	ourPaths := lo.Map([]string{self.repoPaths.RepoPath(), self.repoPaths.WorktreePath()}, func(path string, _ int) string {
+		if realPath, err := filepath.EvalSymlinks(path); err == nil {
+			return realPath
+		}
+		return path
+	})
+
+	return lo.SomeBy(strings.Split(strings.TrimSpace(output), "\n"), func(path string) bool {
+		return lo.Contains(ourPaths, path)
+	})
+}
+
+// Runs the maintenance tasks that are enabled for the repo (by default only
+// gc)
+func (self *MaintenanceCommands) Run() error {
+	cmdArgs := NewGitCmd("maintenance").Arg("run").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *MaintenanceCommands) Gc() error {
+	cmdArgs := NewGitCmd("gc").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Packs all objects into a single pack, dropping the packs that this makes
+// redundant
+func (self *MaintenanceCommands) Repack() error {
+	cmdArgs := NewGitCmd("repack").Arg("-a", "-d").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Registers the repo for scheduled maintenance, and sets up the scheduler of
+// the OS (e.g. cron or launchd) to run it, if that hasn't been done yet
+func (self *MaintenanceCommands) Start() error {
+	cmdArgs := NewGitCmd("maintenance").Arg("start").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *MaintenanceCommands) Unregister() error {
+	cmdArgs := NewGitCmd("maintenance").Arg("unregister").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
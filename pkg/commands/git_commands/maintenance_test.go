@@ -0,0 +1,19 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCountObjectsOutput(t *testing.T) {
+	output := "count: 12\nsize: 48.00 KiB\nin-pack: 3456\npacks: 2\nsize-pack: 1.50 MiB\nprune-packable: 0\ngarbage: 0\nsize-garbage: 0 bytes\n"
+
+	assert.Equal(t, &RepoHealth{
+		LooseObjects:  12,
+		LooseSize:     "48.00 KiB",
+		PackedObjects: 3456,
+		Packs:         2,
+		PackSize:      "1.50 MiB",
+	}, parseCountObjectsOutput(output))
+}
@@ -303,7 +303,7 @@ func (self *PatchCommands) PullPatchIntoNewCommit(
 		return err
 	}
 
-	if err := self.commit.CommitCmdObj(commitSummary, commitDescription, false).Run(); err != nil {
+	if err := self.commit.CommitCmdObj(commitSummary, commitDescription, false, CommitSigningDefault).Run(); err != nil {
 		return err
 	}
 
@@ -330,7 +330,7 @@ func (self *PatchCommands) PullPatchIntoNewCommitBefore(
 		return err
 	}
 
-	if err := self.commit.CommitCmdObj(commitSummary, commitDescription, false).Run(); err != nil {
+	if err := self.commit.CommitCmdObj(commitSummary, commitDescription, false, CommitSigningDefault).Run(); err != nil {
 		return err
 	}
 
@@ -0,0 +1,146 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+)
+
+// Exports commits as a series of patch files that can be sent to a mailing
+// list, the way e.g. the linux kernel takes contributions
+type PatchSeriesCommands struct {
+	*GitCommon
+}
+
+func NewPatchSeriesCommands(gitCommon *GitCommon) *PatchSeriesCommands {
+	return &PatchSeriesCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+type FormatPatchOpts struct {
+	// The oldest and the newest commit of the series; both are included
+	OldestHash string
+	NewestHash string
+	// Whether the oldest commit is the root commit, in which case there is no
+	// parent to start the range from
+	OldestIsRoot bool
+	OutputDir    string
+	// If non-empty, a cover letter (0000-cover-letter.patch) is generated with
+	// this subject and blurb
+	CoverLetterSubject string
+	CoverLetterBlurb   string
+}
+
+// Placeholders that git format-patch puts into the cover letter for the user
+// to fill in
+const (
+	coverLetterSubjectPlaceholder = "*** SUBJECT HERE ***"
+	coverLetterBlurbPlaceholder   = "*** BLURB HERE ***"
+)
+
+// Writes the patch files and returns their paths, cover letter first
+func (self *PatchSeriesCommands) FormatPatch(opts FormatPatchOpts) ([]string, error) {
+	cmdArgs := NewGitCmd("format-patch").
+		Arg("-o", opts.OutputDir).
+		ArgIf(opts.CoverLetterSubject != "", "--cover-letter").
+		ArgIf(opts.OldestIsRoot, "--root", opts.NewestHash).
+		ArgIf(!opts.OldestIsRoot, opts.OldestHash+"^.."+opts.NewestHash).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := lo.Filter(strings.Split(strings.TrimSpace(output), "\n"), func(path string, _ int) bool {
+		return path != ""
+	})
+
+	if opts.CoverLetterSubject != "" && len(paths) > 0 {
+		if err := self.fillInCoverLetter(paths[0], opts.CoverLetterSubject, opts.CoverLetterBlurb); err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
+
+func (self *PatchSeriesCommands) fillInCoverLetter(path string, subject string, blurb string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return self.os.CreateFileWithContent(path, fillInCoverLetter(string(content), subject, blurb))
+}
+
+func fillInCoverLetter(content string, subject string, blurb string) string {
+	content = strings.Replace(content, coverLetterSubjectPlaceholder, subject, 1)
+	return strings.Replace(content, coverLetterBlurbPlaceholder, blurb, 1)
+}
+
+// A fresh directory to export patches to when they're only needed for sending
+func (self *PatchSeriesCommands) TemporaryOutputDir() string {
+	return filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName(), "patches-"+time.Now().Format("Jan _2 15.04.05.000000000"))
+}
+
+// Runs interactively, because git send-email asks for the recipients (unless
+// sendemail.to is configured) and for confirmation before sending each mail
+func (self *PatchSeriesCommands) SendEmailCmdObj(paths []string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("send-email").Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
+// Returns the files to pass to git am for the given path, which can be a patch
+// file, an mbox, or a directory of patch files as written by git format-patch.
+// Cover letters are left out, because they have no patch to apply.
+func (self *PatchSeriesCommands) MailboxPaths(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(path, "*.patch"))
+	if err != nil {
+		return nil, err
+	}
+
+	paths = lo.Reject(paths, func(path string, _ int) bool {
+		return filepath.Base(path) == "0000-cover-letter.patch"
+	})
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// Applies the patches in the given mailboxes as commits on top of HEAD. When a
+// patch doesn't apply cleanly, git falls back to a three-way merge if it can,
+// and stops with conflicts so that the user can continue, skip or abort.
+func (self *PatchSeriesCommands) ApplyMailbox(paths []string) error {
+	cmdArgs := NewGitCmd("am").Arg("--3way").Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Applies the patch file to the working tree and the index without committing
+// it. If it doesn't apply cleanly, git falls back to a three-way merge and
+// leaves the conflicted files unmerged, so that they can be resolved like the
+// conflicts of a merge.
+func (self *PatchSeriesCommands) ApplyPatchFile(path string) error {
+	cmdArgs := NewGitCmd("apply").Arg("--3way", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,87 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchSeriesFormatPatch(t *testing.T) {
+	type scenario struct {
+		testName     string
+		opts         FormatPatchOpts
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "range of commits",
+			opts: FormatPatchOpts{
+				OldestHash: "1234",
+				NewestHash: "5678",
+				OutputDir:  "outgoing",
+			},
+			expectedArgs: []string{"format-patch", "-o", "outgoing", "1234^..5678"},
+		},
+		{
+			testName: "range starting at the root commit",
+			opts: FormatPatchOpts{
+				OldestHash:   "1234",
+				NewestHash:   "5678",
+				OldestIsRoot: true,
+				OutputDir:    "outgoing",
+			},
+			expectedArgs: []string{"format-patch", "-o", "outgoing", "--root", "5678"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expectedArgs, "outgoing/0001-first.patch\noutgoing/0002-second.patch\n", nil)
+			instance := buildPatchSeriesCommands(commonDeps{runner: runner})
+
+			paths, err := instance.FormatPatch(s.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"outgoing/0001-first.patch", "outgoing/0002-second.patch"}, paths)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestFillInCoverLetter(t *testing.T) {
+	content := "Subject: [PATCH 0/2] *** SUBJECT HERE ***\n\n*** BLURB HERE ***\n\nJohn Doe (2):\n"
+
+	assert.Equal(t,
+		"Subject: [PATCH 0/2] Add frobnication\n\nThis adds frobnication.\n\nJohn Doe (2):\n",
+		fillInCoverLetter(content, "Add frobnication", "This adds frobnication."),
+	)
+}
+
+func TestPatchSeriesSendEmailCmdObj(t *testing.T) {
+	instance := buildPatchSeriesCommands(commonDeps{})
+
+	assert.Equal(t,
+		[]string{"git", "send-email", "outgoing/0001-first.patch", "outgoing/0002-second.patch"},
+		instance.SendEmailCmdObj([]string{"outgoing/0001-first.patch", "outgoing/0002-second.patch"}).Args(),
+	)
+}
+
+func TestPatchSeriesApplyMailbox(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"am", "--3way", "incoming/0001-first.patch", "incoming/0002-second.patch"}, "", nil)
+	instance := buildPatchSeriesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ApplyMailbox([]string{"incoming/0001-first.patch", "incoming/0002-second.patch"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestPatchSeriesApplyPatchFile(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"apply", "--3way", "fix.patch"}, "", nil)
+	instance := buildPatchSeriesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ApplyPatchFile("fix.patch"))
+	runner.CheckForMissingCalls()
+}
@@ -80,6 +80,12 @@ func (self *RebaseCommands) SetCommitAuthor(commits []*models.Commit, start, end
 	})
 }
 
+func (self *RebaseCommands) SetCommitAuthorDate(commits []*models.Commit, start, end int, value string) error {
+	return self.GenericAmend(commits, start, end, func(_ *models.Commit) error {
+		return self.commit.SetAuthorDate(value)
+	})
+}
+
 func (self *RebaseCommands) AddCommitCoAuthor(commits []*models.Commit, start, end int, value string) error {
 	return self.GenericAmend(commits, start, end, func(commit *models.Commit) error {
 		return self.commit.AddCoAuthor(commit.Hash(), value)
@@ -111,34 +117,36 @@ func (self *RebaseCommands) GenericAmend(commits []*models.Commit, start, end in
 	return nil
 }
 
-func (self *RebaseCommands) MoveCommitsDown(commits []*models.Commit, startIdx int, endIdx int) error {
-	baseHashOrRoot := getBaseHashOrRoot(commits, endIdx+2)
-
-	hashes := lo.Map(commits[startIdx:endIdx+1], func(commit *models.Commit, _ int) string {
-		return commit.Hash()
-	})
+// MoveCommitsDown moves the commits at the given indices down by one as a
+// group. The indices must be in ascending order, but don't need to be
+// contiguous; each commit swaps places with the first unmoved commit below it.
+func (self *RebaseCommands) MoveCommitsDown(commits []*models.Commit, indices []int) error {
+	baseHashOrRoot := getBaseHashOrRoot(commits, indices[len(indices)-1]+2)
 
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseHashOrRoot: baseHashOrRoot,
-		instruction:    daemon.NewMoveTodosDownInstruction(hashes),
+		instruction:    daemon.NewMoveTodosDownInstruction(commitHashesAt(commits, indices)),
 		overrideEditor: true,
 	}).Run()
 }
 
-func (self *RebaseCommands) MoveCommitsUp(commits []*models.Commit, startIdx int, endIdx int) error {
-	baseHashOrRoot := getBaseHashOrRoot(commits, endIdx+1)
-
-	hashes := lo.Map(commits[startIdx:endIdx+1], func(commit *models.Commit, _ int) string {
-		return commit.Hash()
-	})
+// MoveCommitsUp is the counterpart of MoveCommitsDown
+func (self *RebaseCommands) MoveCommitsUp(commits []*models.Commit, indices []int) error {
+	baseHashOrRoot := getBaseHashOrRoot(commits, indices[len(indices)-1]+1)
 
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseHashOrRoot: baseHashOrRoot,
-		instruction:    daemon.NewMoveTodosUpInstruction(hashes),
+		instruction:    daemon.NewMoveTodosUpInstruction(commitHashesAt(commits, indices)),
 		overrideEditor: true,
 	}).Run()
 }
 
+func commitHashesAt(commits []*models.Commit, indices []int) []string {
+	return lo.Map(indices, func(idx int, _ int) string {
+		return commits[idx].Hash()
+	})
+}
+
 func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, startIdx int, endIdx int, action todo.TodoCommand, flag string) error {
 	baseIndex := endIdx + 1
 	if action == todo.Squash || action == todo.Fixup {
@@ -222,6 +230,7 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 		ArgIf(opts.keepCommitsThatBecomeEmpty, "--empty=keep").
 		Arg("--no-autosquash").
 		Arg("--rebase-merges").
+		ArgIf(self.UserConfig().Git.SkipHooks.Rebase, "--no-verify").
 		ArgIf(opts.onto != "", "--onto", opts.onto).
 		Arg(opts.baseHashOrRoot).
 		ToArgv()
@@ -394,7 +403,9 @@ func (self *RebaseCommands) SquashAllAboveFixupCommits(commit *models.Commit) er
 	}
 
 	cmdArgs := NewGitCmd("rebase").
-		Arg("--interactive", "--rebase-merges", "--autostash", "--autosquash", hashOrRoot).
+		Arg("--interactive", "--rebase-merges", "--autostash", "--autosquash").
+		ArgIf(self.UserConfig().Git.SkipHooks.Rebase, "--no-verify").
+		Arg(hashOrRoot).
 		ToArgv()
 
 	return self.runSkipEditorCommand(self.cmd.New(cmdArgs))
@@ -555,13 +566,25 @@ func (self *RebaseCommands) DiscardOldFileChanges(commits []*models.Commit, comm
 	return self.ContinueRebase()
 }
 
+type CherryPickOpts struct {
+	// Apply the changes to the index and working tree without committing them
+	NoCommit bool
+	// Append a "(cherry picked from commit ...)" line to the commit messages
+	RecordOrigin bool
+	// The parent of merge commits to diff against; 0 means the first one
+	Mainline int
+}
+
 // CherryPickCommits begins an interactive rebase with the given hashes being cherry picked onto HEAD
-func (self *RebaseCommands) CherryPickCommits(commits []*models.Commit) error {
+func (self *RebaseCommands) CherryPickCommits(commits []*models.Commit, opts CherryPickOpts) error {
 	hasMergeCommit := lo.SomeBy(commits, func(c *models.Commit) bool { return c.IsMerge() })
 	cmdArgs := NewGitCmd("cherry-pick").
-		Arg("--allow-empty").
-		ArgIf(self.version.IsAtLeast(2, 45, 0), "--empty=keep", "--keep-redundant-commits").
-		ArgIf(hasMergeCommit, "-m1").
+		// Keeping empty commits is only relevant when we commit
+		ArgIf(!opts.NoCommit, "--allow-empty").
+		ArgIf(!opts.NoCommit && self.version.IsAtLeast(2, 45, 0), "--empty=keep", "--keep-redundant-commits").
+		ArgIf(opts.NoCommit, "--no-commit").
+		ArgIf(opts.RecordOrigin, "-x").
+		ArgIf(hasMergeCommit, fmt.Sprintf("-m%d", max(opts.Mainline, 1))).
 		Arg(lo.Reverse(lo.Map(commits, func(c *models.Commit, _ int) string { return c.Hash() }))...).
 		ToArgv()
 
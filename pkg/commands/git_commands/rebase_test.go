@@ -168,3 +168,67 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestRebaseCherryPickCommits(t *testing.T) {
+	hashPool := &utils.StringPool{}
+	commit := models.NewCommit(hashPool, models.NewCommitOpts{Hash: "1234", Parents: []string{"abcd"}})
+	mergeCommit := models.NewCommit(hashPool, models.NewCommitOpts{Hash: "5678", Parents: []string{"abcd", "efgh"}})
+
+	scenarios := []struct {
+		testName     string
+		commits      []*models.Commit
+		opts         CherryPickOpts
+		gitVersion   *GitVersion
+		expectedArgs []string
+	}{
+		{
+			testName:     "default options",
+			commits:      []*models.Commit{commit},
+			gitVersion:   &GitVersion{2, 45, 0, ""},
+			expectedArgs: []string{"cherry-pick", "--allow-empty", "--empty=keep", "--keep-redundant-commits", "1234"},
+		},
+		{
+			testName:     "older git version",
+			commits:      []*models.Commit{commit},
+			gitVersion:   &GitVersion{2, 44, 0, ""},
+			expectedArgs: []string{"cherry-pick", "--allow-empty", "1234"},
+		},
+		{
+			testName:     "without committing",
+			commits:      []*models.Commit{commit},
+			opts:         CherryPickOpts{NoCommit: true},
+			gitVersion:   &GitVersion{2, 45, 0, ""},
+			expectedArgs: []string{"cherry-pick", "--no-commit", "1234"},
+		},
+		{
+			testName:     "recording the origin",
+			commits:      []*models.Commit{commit},
+			opts:         CherryPickOpts{RecordOrigin: true},
+			gitVersion:   &GitVersion{2, 44, 0, ""},
+			expectedArgs: []string{"cherry-pick", "--allow-empty", "-x", "1234"},
+		},
+		{
+			testName:     "merge commit defaults to the first parent",
+			commits:      []*models.Commit{mergeCommit, commit},
+			gitVersion:   &GitVersion{2, 44, 0, ""},
+			expectedArgs: []string{"cherry-pick", "--allow-empty", "-m1", "1234", "5678"},
+		},
+		{
+			testName:     "merge commit with a different mainline",
+			commits:      []*models.Commit{mergeCommit},
+			opts:         CherryPickOpts{Mainline: 2},
+			gitVersion:   &GitVersion{2, 44, 0, ""},
+			expectedArgs: []string{"cherry-pick", "--allow-empty", "-m2", "5678"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildRebaseCommands(commonDeps{runner: runner, gitVersion: s.gitVersion})
+
+			assert.NoError(t, instance.CherryPickCommits(s.commits, s.opts))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
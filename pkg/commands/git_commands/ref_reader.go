@@ -0,0 +1,240 @@
+package git_commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// Reads refs directly from the git dir rather than spawning a git process.
+// Some refs are read on every refresh, and process creation is expensive,
+// especially on Windows. Only the "files" ref backend is supported; for repos
+// using reftables, or if the user set git.readRefsWithCli, the methods return
+// errNoInProcessRefs and callers fall back to the git CLI.
+//
+// Reading commits and blobs would need a full implementation of git's object
+// storage (including pack files), so these are always read with git.
+type refReader struct {
+	*GitCommon
+}
+
+var errNoInProcessRefs = errors.New("refs can't be read in-process")
+
+// git gives up after this many levels of symbolic refs too
+const maxSymrefDepth = 5
+
+// A ref as stored in the git dir
+type storedRef struct {
+	// Full name, e.g. "refs/heads/master"
+	name string
+	// Either a hash, or for symbolic refs (like refs/remotes/origin/HEAD)
+	// "ref: " followed by the full name of the ref that it points to
+	target string
+}
+
+func newRefReader(gitCommon *GitCommon) *refReader {
+	return &refReader{GitCommon: gitCommon}
+}
+
+func (self *refReader) enabled() bool {
+	if self.UserConfig().Git.ReadRefsWithCli {
+		return false
+	}
+
+	_, err := self.Fs.Stat(filepath.Join(self.repoPaths.RepoGitDirPath(), "reftable"))
+	return err != nil
+}
+
+// Returns the full name of the ref that HEAD points to (e.g.
+// "refs/heads/master"), or an empty string if HEAD is detached
+func (self *refReader) symbolicHead() (string, error) {
+	if !self.enabled() {
+		return "", errNoInProcessRefs
+	}
+
+	target, err := self.readRef("HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	name, isSymref := strings.CutPrefix(target, "ref: ")
+	if !isSymref {
+		return "", nil
+	}
+
+	return name, nil
+}
+
+// Returns the hash that the ref with the given full name (or HEAD) points to,
+// following symbolic refs
+func (self *refReader) resolveRef(name string) (string, error) {
+	if !self.enabled() {
+		return "", errNoInProcessRefs
+	}
+
+	for range maxSymrefDepth {
+		target, err := self.readRef(name)
+		if err != nil {
+			return "", err
+		}
+
+		next, isSymref := strings.CutPrefix(target, "ref: ")
+		if !isSymref {
+			return target, nil
+		}
+		name = next
+	}
+
+	return "", errors.New("too many levels of symbolic refs")
+}
+
+// Returns the target of the ref with the given full name (or HEAD); see
+// storedRef
+func (self *refReader) readRef(name string) (string, error) {
+	// HEAD is per worktree, so it lives in the worktree's git dir
+	dir := self.repoPaths.RepoGitDirPath()
+	if name == "HEAD" {
+		dir = self.repoPaths.WorktreeGitDirPath()
+	}
+
+	content, err := afero.ReadFile(self.Fs, filepath.Join(dir, filepath.FromSlash(name)))
+	if err == nil {
+		return strings.TrimSpace(string(content)), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	packedRefs, err := self.packedRefs()
+	if err != nil {
+		return "", err
+	}
+	if hash, ok := packedRefs[name]; ok {
+		return hash, nil
+	}
+
+	return "", fmt.Errorf("ref %s not found", name)
+}
+
+// Returns the refs whose full name starts with one of the given prefixes
+// (e.g. "refs/heads/"), sorted by name
+func (self *refReader) listRefs(prefixes ...string) ([]storedRef, error) {
+	if !self.enabled() {
+		return nil, errNoInProcessRefs
+	}
+
+	packedRefs, err := self.packedRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	targets := lo.PickBy(packedRefs, func(name string, _ string) bool {
+		return lo.SomeBy(prefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) })
+	})
+
+	// Loose refs take precedence over packed ones
+	gitDir := self.repoPaths.RepoGitDirPath()
+	for _, prefix := range prefixes {
+		err := afero.Walk(self.Fs, filepath.Join(gitDir, filepath.FromSlash(prefix)), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				// The directory doesn't exist, or a ref was deleted while we
+				// were reading the refs
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			// git locks a ref by creating a file next to it while updating it
+			if info.IsDir() || strings.HasSuffix(path, ".lock") {
+				return nil
+			}
+
+			content, err := afero.ReadFile(self.Fs, path)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			relativePath, err := filepath.Rel(gitDir, path)
+			if err != nil {
+				return err
+			}
+			targets[filepath.ToSlash(relativePath)] = strings.TrimSpace(string(content))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	names := lo.Keys(targets)
+	slices.Sort(names)
+	return lo.Map(names, func(name string, _ int) storedRef {
+		return storedRef{name: name, target: targets[name]}
+	}), nil
+}
+
+// Returns the refs in the packed-refs file, by full name
+func (self *refReader) packedRefs() (map[string]string, error) {
+	content, err := afero.ReadFile(self.Fs, filepath.Join(self.repoPaths.RepoGitDirPath(), "packed-refs"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, line := range strings.Split(string(content), "\n") {
+		// Skip the header, and the peeled hashes of annotated tags, which
+		// start with '^'
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "^") {
+			continue
+		}
+
+		hash, name, found := strings.Cut(strings.TrimSpace(line), " ")
+		if found {
+			result[name] = hash
+		}
+	}
+
+	return result, nil
+}
+
+// Returns a string that changes whenever the output of for-each-ref for the
+// local branches, remote branches and tags may have changed, i.e. when one of
+// these refs, HEAD, or the repo's config (which has the upstreams of the
+// branches) changes. Changes to the global git config (e.g. to push.default)
+// are only picked up once one of the others changes too.
+func (self *refReader) refsFingerprint() (string, error) {
+	refs, err := self.listRefs("refs/heads/", "refs/remotes/", "refs/tags/")
+	if err != nil {
+		return "", err
+	}
+
+	head, err := self.readRef("HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	config, err := self.Fs.Stat(filepath.Join(self.repoPaths.RepoGitDirPath(), "config"))
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "HEAD %s\nconfig %d %d\n", head, config.ModTime().UnixNano(), config.Size())
+	for _, ref := range refs {
+		fmt.Fprintf(&builder, "%s %s\n", ref.name, ref.target)
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,117 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+const packedRefs = `# pack-refs with: peeled fully-peeled sorted 
+1111111111111111111111111111111111111111 refs/heads/master
+2222222222222222222222222222222222222222 refs/heads/packed
+3333333333333333333333333333333333333333 refs/tags/v1.0
+^4444444444444444444444444444444444444444
+`
+
+func buildRefReader(fs afero.Fs, userConfig *config.UserConfig) *refReader {
+	return newRefReader(buildGitCommon(commonDeps{
+		fs:         fs,
+		repoPaths:  MockRepoPaths("/repo"),
+		userConfig: userConfig,
+	}))
+}
+
+func setupRefsFs() afero.Fs {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "/repo/.git/HEAD", []byte("ref: refs/heads/feature/foo\n"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/.git/packed-refs", []byte(packedRefs), 0o644)
+	_ = afero.WriteFile(fs, "/repo/.git/refs/heads/master", []byte("5555555555555555555555555555555555555555\n"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/.git/refs/heads/feature/foo", []byte("6666666666666666666666666666666666666666\n"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/.git/refs/heads/feature/foo.lock", []byte("7777777777777777777777777777777777777777\n"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/.git/refs/remotes/origin/HEAD", []byte("ref: refs/remotes/origin/master\n"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/.git/refs/remotes/origin/master", []byte("1111111111111111111111111111111111111111\n"), 0o644)
+	return fs
+}
+
+func TestRefReaderListRefs(t *testing.T) {
+	reader := buildRefReader(setupRefsFs(), config.GetDefaultConfig())
+
+	refs, err := reader.listRefs("refs/heads/", "refs/tags/")
+	assert.NoError(t, err)
+	assert.Equal(t, []storedRef{
+		{name: "refs/heads/feature/foo", target: "6666666666666666666666666666666666666666"},
+		// the loose ref takes precedence over the packed one
+		{name: "refs/heads/master", target: "5555555555555555555555555555555555555555"},
+		{name: "refs/heads/packed", target: "2222222222222222222222222222222222222222"},
+		{name: "refs/tags/v1.0", target: "3333333333333333333333333333333333333333"},
+	}, refs)
+
+	refs, err = reader.listRefs("refs/remotes/")
+	assert.NoError(t, err)
+	assert.Equal(t, []storedRef{
+		{name: "refs/remotes/origin/HEAD", target: "ref: refs/remotes/origin/master"},
+		{name: "refs/remotes/origin/master", target: "1111111111111111111111111111111111111111"},
+	}, refs)
+}
+
+func TestRefReaderResolveRef(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		name          string
+		expectedHash  string
+		expectedError string
+	}{
+		{
+			testName:     "HEAD pointing to a loose ref",
+			name:         "HEAD",
+			expectedHash: "6666666666666666666666666666666666666666",
+		},
+		{
+			testName:     "packed ref",
+			name:         "refs/heads/packed",
+			expectedHash: "2222222222222222222222222222222222222222",
+		},
+		{
+			testName:     "symbolic ref",
+			name:         "refs/remotes/origin/HEAD",
+			expectedHash: "1111111111111111111111111111111111111111",
+		},
+		{
+			testName:      "missing ref",
+			name:          "refs/heads/missing",
+			expectedError: "ref refs/heads/missing not found",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			reader := buildRefReader(setupRefsFs(), config.GetDefaultConfig())
+
+			hash, err := reader.resolveRef(s.name)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, s.expectedHash, hash)
+		})
+	}
+}
+
+func TestRefReaderDisabled(t *testing.T) {
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.ReadRefsWithCli = true
+	reader := buildRefReader(setupRefsFs(), userConfig)
+
+	_, err := reader.listRefs("refs/heads/")
+	assert.ErrorIs(t, err, errNoInProcessRefs)
+
+	fs := setupRefsFs()
+	_ = fs.MkdirAll("/repo/.git/reftable", 0o755)
+	reader = buildRefReader(fs, config.GetDefaultConfig())
+
+	_, err = reader.resolveRef("HEAD")
+	assert.ErrorIs(t, err, errNoInProcessRefs)
+}
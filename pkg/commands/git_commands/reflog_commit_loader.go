@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -23,17 +24,20 @@ func NewReflogCommitLoader(common *common.Common, cmd oscommands.ICmdObjBuilder)
 }
 
 // GetReflogCommits only returns the new reflog commits since the given lastReflogCommit
-// if none is passed (i.e. it's value is nil) then we get all the reflog commits
-func (self *ReflogCommitLoader) GetReflogCommits(hashPool *utils.StringPool, lastReflogCommit *models.Commit, filterPath string, filterAuthor string) ([]*models.Commit, bool, error) {
+// if none is passed (i.e. it's value is nil) then we get all the reflog commits.
+// If ref is empty, we get the reflog of HEAD, otherwise the one of the given
+// ref (e.g. a branch)
+func (self *ReflogCommitLoader) GetReflogCommits(ctx context.Context, hashPool *utils.StringPool, lastReflogCommit *models.Commit, ref string, filterPath string, filterAuthor string) ([]*models.Commit, bool, error) {
 	cmdArgs := NewGitCmd("log").
 		Config("log.showSignature=false").
 		Arg("-g").
 		Arg("--format=+%H%x00%ct%x00%gs%x00%P").
+		ArgIf(ref != "", ref).
 		ArgIf(filterAuthor != "", "--author="+filterAuthor).
 		ArgIf(filterPath != "", "--follow", "--name-status", "--", filterPath).
 		ToArgv()
 
-	cmdObj := self.cmd.New(cmdArgs).DontLog()
+	cmdObj := self.cmd.New(cmdArgs).WithContext(ctx).DontLog()
 
 	onlyObtainedNewReflogCommits := false
 
@@ -54,7 +58,7 @@ func (self *ReflogCommitLoader) GetReflogCommits(hashPool *utils.StringPool, las
 		}
 
 		return commit, false
-	})
+	}, nil)
 	if err != nil {
 		return nil, false, err
 	}
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -26,6 +27,7 @@ func TestGetReflogCommits(t *testing.T) {
 		testName                string
 		runner                  *oscommands.FakeCmdObjRunner
 		lastReflogCommit        *models.Commit
+		ref                     string
 		filterPath              string
 		filterAuthor            string
 		expectedCommitOpts      []models.NewCommitOpts
@@ -116,6 +118,31 @@ func TestGetReflogCommits(t *testing.T) {
 			expectedOnlyObtainedNew: true,
 			expectedError:           nil,
 		},
+		{
+			testName: "when passing a ref",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--format=+%H%x00%ct%x00%gs%x00%P", "mybranch"}, reflogOutput, nil),
+
+			lastReflogCommit: models.NewCommit(hashPool, models.NewCommitOpts{
+				Hash:          "c3c4b66b64c97ffeecde",
+				Name:          "checkout: moving from B to A",
+				Status:        models.StatusReflog,
+				UnixTimestamp: 1643150483,
+				Parents:       []string{"51baa8c1"},
+			}),
+			ref: "mybranch",
+			expectedCommitOpts: []models.NewCommitOpts{
+				{
+					Hash:          "c3c4b66b64c97ffeecde",
+					Name:          "checkout: moving from A to B",
+					Status:        models.StatusReflog,
+					UnixTimestamp: 1643150483,
+					Parents:       []string{"51baa8c1"},
+				},
+			},
+			expectedOnlyObtainedNew: true,
+			expectedError:           nil,
+		},
 		{
 			testName: "when passing filterPath",
 			runner: oscommands.NewFakeRunner(t).
@@ -186,7 +213,7 @@ func TestGetReflogCommits(t *testing.T) {
 				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
 			}
 
-			commits, onlyObtainednew, err := builder.GetReflogCommits(hashPool, scenario.lastReflogCommit, scenario.filterPath, scenario.filterAuthor)
+			commits, onlyObtainednew, err := builder.GetReflogCommits(context.Background(), hashPool, scenario.lastReflogCommit, scenario.ref, scenario.filterPath, scenario.filterAuthor)
 			assert.Equal(t, scenario.expectedOnlyObtainedNew, onlyObtainednew)
 			assert.Equal(t, scenario.expectedError, err)
 			t.Logf("actual commits: \n%s", litter.Sdump(commits))
@@ -0,0 +1,205 @@
+package git_commands
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// Loads local branches, remote branches and tags with a single `git
+// for-each-ref` call, so that refreshing all of them only needs one git
+// process rather than one per kind of ref. The result is shared by the branch,
+// remote and tag loaders until Invalidate is called, which the refresh helper
+// does at the start of each refresh of any of these scopes. Even then, we only
+// run git again if the refs have changed since the last load, which we check
+// by reading them from the git dir (see refReader).
+type RefsLoader struct {
+	*common.Common
+	cmd       oscommands.ICmdObjBuilder
+	refReader *refReader
+
+	// Incremented by Invalidate
+	generation atomic.Int64
+
+	mutex deadlock.Mutex
+	// The refs from the most recent load, in refname order, and the generation
+	// they were loaded in
+	refs           []refEntry
+	refsGeneration int64
+	loaded         bool
+	// See refReader.refsFingerprint; empty if it couldn't be determined
+	refsFingerprint string
+}
+
+type refEntry struct {
+	// Full ref name, e.g. "refs/heads/master" or "refs/tags/v1.0"
+	refName string
+	// Only set for local branches; in the order of branchFields
+	branchFields []string
+	// Only set for tags. For annotated tags this is the subject of the tag
+	// message, otherwise the subject of the commit
+	subject string
+	// Only set for local and remote branches
+	committerDate int64
+	// Only set for tags. For annotated tags this is the tagger date, otherwise
+	// the committer date
+	creatorDate int64
+}
+
+// The fields that we ask for-each-ref for after the full ref name. They
+// depend on the kind of ref, so that we don't make git output (and us parse)
+// fields for remote branches and tags that only local branches need; local
+// branches get the branchFields.
+var (
+	remoteBranchFields = []string{"committerdate:unix"}
+	tagFields          = []string{"subject", "creatordate:unix"}
+)
+
+var branchCommitterDateFieldIndex = slices.Index(branchFields, "committerdate:unix")
+
+func NewRefsLoader(gitCommon *GitCommon) *RefsLoader {
+	return &RefsLoader{
+		Common:    gitCommon.Common,
+		cmd:       gitCommon.cmd,
+		refReader: newRefReader(gitCommon),
+	}
+}
+
+// Makes the next request for refs load them again
+func (self *RefsLoader) Invalidate() {
+	self.generation.Add(1)
+}
+
+// Returns the refs whose full name starts with the given prefix (e.g.
+// "refs/tags/"), in refname order
+func (self *RefsLoader) getRefs(prefix string) ([]refEntry, error) {
+	refs, err := self.getAllRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(refs, func(ref refEntry, _ int) bool {
+		return strings.HasPrefix(ref.refName, prefix)
+	}), nil
+}
+
+func (self *RefsLoader) getAllRefs() ([]refEntry, error) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	// If Invalidate is called while we're loading, the generation we store
+	// will be outdated, so the next call loads again
+	generation := self.generation.Load()
+	if self.loaded && self.refsGeneration == generation {
+		return self.refs, nil
+	}
+
+	// Determining the fingerprint before loading, so that if the refs change
+	// while we're loading, the next call loads again
+	fingerprint, err := self.refReader.refsFingerprint()
+	if err != nil {
+		fingerprint = ""
+	}
+	if self.loaded && fingerprint != "" && fingerprint == self.refsFingerprint {
+		self.refsGeneration = generation
+		return self.refs, nil
+	}
+
+	refs, err := self.load()
+	if err != nil {
+		return nil, err
+	}
+
+	self.refs = refs
+	self.refsGeneration = generation
+	self.refsFingerprint = fingerprint
+	self.loaded = true
+	return refs, nil
+}
+
+func (self *RefsLoader) load() ([]refEntry, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format="+refsFormat()).
+		Arg("refs/heads", "refs/remotes", "refs/tags").
+		ToArgv()
+
+	refs := []refEntry{}
+	err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+		if ref, ok := parseRefLine(line); ok {
+			refs = append(refs, ref)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+// Picks the fields to output by the kind of ref, which is what the first two
+// components of the ref name (e.g. "refs/heads") tell us
+func refsFormat() string {
+	formatFields := func(fields []string) string {
+		return strings.Join(
+			lo.Map(fields, func(field string, _ int) string {
+				return "%(" + field + ")"
+			}),
+			"%00",
+		)
+	}
+
+	return "%(refname)%00" +
+		"%(if:equals=refs/heads)%(refname:rstrip=-2)%(then)" + formatFields(branchFields) +
+		"%(else)%(if:equals=refs/tags)%(refname:rstrip=-2)%(then)" + formatFields(tagFields) +
+		"%(else)" + formatFields(remoteBranchFields) +
+		"%(end)%(end)"
+}
+
+func parseRefLine(line string) (refEntry, bool) {
+	// Ignore lines that aren't separated into the expected number of parts.
+	// These are probably warning messages, for more info see:
+	// https://github.com/jesseduffield/lazygit/issues/1385#issuecomment-885580439
+	refName, rest, found := strings.Cut(line, "\x00")
+	if !found {
+		return refEntry{}, false
+	}
+	split := strings.Split(rest, "\x00")
+
+	switch {
+	case strings.HasPrefix(refName, "refs/heads/"):
+		if len(split) != len(branchFields) {
+			return refEntry{}, false
+		}
+		committerDate, _ := strconv.ParseInt(split[branchCommitterDateFieldIndex], 10, 64)
+		return refEntry{refName: refName, branchFields: split, committerDate: committerDate}, true
+	case strings.HasPrefix(refName, "refs/tags/"):
+		if len(split) != len(tagFields) {
+			return refEntry{}, false
+		}
+		creatorDate, _ := strconv.ParseInt(split[1], 10, 64)
+		return refEntry{refName: refName, subject: split[0], creatorDate: creatorDate}, true
+	default:
+		if len(split) != len(remoteBranchFields) {
+			return refEntry{}, false
+		}
+		committerDate, _ := strconv.ParseInt(split[0], 10, 64)
+		return refEntry{refName: refName, committerDate: committerDate}, true
+	}
+}
+
+// Sorts the refs by the given date, newest first. Since the refs come in
+// refname order and the sort is stable, refs with the same date stay in
+// refname order, which is what `git for-each-ref --sort=-<date>` does too.
+func sortRefsByDateDescending(refs []refEntry, getDate func(refEntry) int64) {
+	slices.SortStableFunc(refs, func(a, b refEntry) int {
+		return cmp.Compare(getDate(b), getDate(a))
+	})
+}
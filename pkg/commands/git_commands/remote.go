@@ -88,3 +88,16 @@ func (self *RemoteCommands) GetRemoteURL(remoteName string) (string, error) {
 	url, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 	return strings.TrimSpace(url), err
 }
+
+func (self *RemoteCommands) GetRemoteNames() ([]string, error) {
+	cmdArgs := NewGitCmd("remote").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(strings.Split(output, "\n"), func(name string, _ int) bool {
+		return name != ""
+	}), nil
+}
@@ -1,7 +1,6 @@
 package git_commands
 
 import (
-	"fmt"
 	"maps"
 	"slices"
 	"strings"
@@ -15,16 +14,19 @@ import (
 
 type RemoteLoader struct {
 	*common.Common
-	cmd oscommands.ICmdObjBuilder
+	cmd        oscommands.ICmdObjBuilder
+	refsLoader *RefsLoader
 }
 
 func NewRemoteLoader(
 	common *common.Common,
 	cmd oscommands.ICmdObjBuilder,
+	refsLoader *RefsLoader,
 ) *RemoteLoader {
 	return &RemoteLoader{
-		Common: common,
-		cmd:    cmd,
+		Common:     common,
+		cmd:        cmd,
+		refsLoader: refsLoader,
 	}
 }
 
@@ -97,34 +99,26 @@ func (self *RemoteLoader) getRemotesFromConfig() []*models.Remote {
 func (self *RemoteLoader) getRemoteBranchesByRemoteName() (map[string][]*models.RemoteBranch, error) {
 	remoteBranchesByRemoteName := make(map[string][]*models.RemoteBranch)
 
-	var sortOrder string
+	refs, err := self.refsLoader.getRefs("refs/remotes/")
+	if err != nil {
+		return nil, err
+	}
+
 	switch strings.ToLower(self.UserConfig().Git.RemoteBranchSortOrder) {
-	case "alphabetical":
-		sortOrder = "refname"
 	case "date":
-		sortOrder = "-committerdate"
-	default:
-		sortOrder = "refname"
+		sortRefsByDateDescending(refs, func(ref refEntry) int64 { return ref.committerDate })
 	}
 
-	cmdArgs := NewGitCmd("for-each-ref").
-		Arg(fmt.Sprintf("--sort=%s", sortOrder)).
-		Arg("--format=%(refname)").
-		Arg("refs/remotes").
-		ToArgv()
-
-	err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
-		line = strings.TrimSpace(line)
-
-		split := strings.SplitN(line, "/", 4)
+	for _, ref := range refs {
+		split := strings.SplitN(ref.refName, "/", 4)
 		if len(split) != 4 {
-			return false, nil
+			continue
 		}
 		remoteName := split[2]
 		name := split[3]
 
 		if name == "HEAD" {
-			return false, nil
+			continue
 		}
 
 		_, ok := remoteBranchesByRemoteName[remoteName]
@@ -137,10 +131,6 @@ func (self *RemoteLoader) getRemoteBranchesByRemoteName() (map[string][]*models.
 				Name:       name,
 				RemoteName: remoteName,
 			})
-		return false, nil
-	})
-	if err != nil {
-		return nil, err
 	}
 
 	return remoteBranchesByRemoteName, nil
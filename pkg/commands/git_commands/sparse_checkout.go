@@ -0,0 +1,98 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// A sparse checkout only has some of the files of the repo in the working
+// tree. In cone mode (the default since git 2.37) its definition is a list of
+// directories; otherwise it's a list of gitignore-style patterns.
+type SparseCheckoutCommands struct {
+	*GitCommon
+}
+
+func NewSparseCheckoutCommands(gitCommon *GitCommon) *SparseCheckoutCommands {
+	return &SparseCheckoutCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+type SparseCheckoutInfo struct {
+	Enabled  bool
+	ConeMode bool
+	// The directories in cone mode, or the patterns otherwise
+	Patterns []string
+}
+
+func (self *SparseCheckoutCommands) GetInfo() (*SparseCheckoutInfo, error) {
+	info := &SparseCheckoutInfo{
+		Enabled:  self.config.UsesSparseCheckout(),
+		ConeMode: self.config.UsesSparseCheckoutConeMode(),
+	}
+	if !info.Enabled {
+		return info, nil
+	}
+
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("list").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	info.Patterns = parseSparseCheckoutList(output)
+	return info, nil
+}
+
+func parseSparseCheckoutList(output string) []string {
+	return lo.Filter(strings.Split(output, "\n"), func(line string, _ int) bool {
+		return line != ""
+	})
+}
+
+// Replaces the sparse-checkout definition with the given directories or
+// patterns. This also turns the repo into a sparse checkout if it isn't one.
+func (self *SparseCheckoutCommands) Set(patterns []string, coneMode bool) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("set").
+		ArgIfElse(coneMode, "--cone", "--no-cone").
+		Arg("--").
+		Arg(patterns...).
+		ToArgv()
+
+	return self.runAndDropConfigCache(cmdArgs)
+}
+
+func (self *SparseCheckoutCommands) Add(pattern string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("add", "--", pattern).
+		ToArgv()
+
+	return self.runAndDropConfigCache(cmdArgs)
+}
+
+// Switches between cone mode and pattern mode, keeping the current definition
+func (self *SparseCheckoutCommands) SetConeMode(coneMode bool) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("reapply").
+		ArgIfElse(coneMode, "--cone", "--no-cone").
+		ToArgv()
+
+	return self.runAndDropConfigCache(cmdArgs)
+}
+
+// Checks out all files of the repo again
+func (self *SparseCheckoutCommands) Disable() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("disable").
+		ToArgv()
+
+	return self.runAndDropConfigCache(cmdArgs)
+}
+
+// All of these commands change the sparse-checkout config of the repo, which we
+// cache
+func (self *SparseCheckoutCommands) runAndDropConfigCache(cmdArgs []string) error {
+	err := self.cmd.New(cmdArgs).Run()
+	self.config.DropConfigCache()
+	return err
+}
@@ -0,0 +1,93 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseCheckoutGetInfo(t *testing.T) {
+	scenarios := []struct {
+		testName     string
+		gitConfig    map[string]string
+		runner       *oscommands.FakeCmdObjRunner
+		expectedInfo *SparseCheckoutInfo
+	}{
+		{
+			testName:     "not a sparse checkout",
+			gitConfig:    map[string]string{},
+			runner:       oscommands.NewFakeRunner(t),
+			expectedInfo: &SparseCheckoutInfo{},
+		},
+		{
+			testName:  "cone mode",
+			gitConfig: map[string]string{"core.sparseCheckout": "true", "core.sparseCheckoutCone": "true"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "docs\npkg/gui\n", nil),
+			expectedInfo: &SparseCheckoutInfo{Enabled: true, ConeMode: true, Patterns: []string{"docs", "pkg/gui"}},
+		},
+		{
+			testName:  "pattern mode",
+			gitConfig: map[string]string{"core.sparseCheckout": "true"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "/*\n!/*/\n/docs/\n", nil),
+			expectedInfo: &SparseCheckoutInfo{Enabled: true, ConeMode: false, Patterns: []string{"/*", "!/*/", "/docs/"}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{
+				runner:    s.runner,
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfig),
+			})
+
+			info, err := instance.GetInfo()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedInfo, info)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSparseCheckoutSet(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		patterns []string
+		coneMode bool
+		runner   *oscommands.FakeCmdObjRunner
+	}{
+		{
+			testName: "cone mode",
+			patterns: []string{"docs", "pkg/gui"},
+			coneMode: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--cone", "--", "docs", "pkg/gui"}, "", nil),
+		},
+		{
+			testName: "pattern mode",
+			patterns: []string{"/*", "!/*/"},
+			coneMode: false,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--no-cone", "--", "/*", "!/*/"}, "", nil),
+		},
+		{
+			testName: "no directories",
+			patterns: []string{},
+			coneMode: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--cone", "--"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{runner: s.runner})
+
+			assert.NoError(t, instance.Set(s.patterns, s.coneMode))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -90,6 +90,7 @@ func (self *StashCommands) ShowStashEntryCmdObj(index int) *oscommands.CmdObj {
 		Arg("--stat").
 		Arg("-u").
 		ConfigIf(extDiffCmd != "", "diff.external="+extDiffCmd).
+		Configs(self.pagerConfig.GetDiffColorConfigs()).
 		ArgIfElse(extDiffCmd != "" || useExtDiffGitConfig, "--ext-diff", "--no-ext-diff").
 		Arg(fmt.Sprintf("--color=%s", self.pagerConfig.GetColorArg())).
 		Arg(fmt.Sprintf("--unified=%d", self.UserConfig().Git.DiffContextSize)).
@@ -199,6 +200,27 @@ func (self *StashCommands) StashIncludeUntrackedChanges(message string) error {
 	).Run()
 }
 
+// Stashes the changes of the given files only, including untracked ones
+func (self *StashCommands) StashFiles(paths []string, message string) error {
+	cmdArgs := NewGitCmd("stash").Arg("push", "--include-untracked", "-m", message).
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Lets the user pick the hunks of the given files to stash. This needs a
+// terminal, and git doesn't support untracked files in this mode.
+func (self *StashCommands) StashFilesInteractivelyCmdObj(paths []string, message string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("stash").Arg("push", "--patch", "-m", message).
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 func (self *StashCommands) Rename(index int, message string) error {
 	hash, err := self.Hash(index)
 	if err != nil {
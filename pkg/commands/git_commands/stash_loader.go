@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"context"
 	"regexp"
 	"strconv"
 	"strings"
@@ -27,15 +28,15 @@ func NewStashLoader(
 	}
 }
 
-func (self *StashLoader) GetStashEntries(filterPath string) []*models.StashEntry {
+func (self *StashLoader) GetStashEntries(ctx context.Context, filterPath string) []*models.StashEntry {
 	if filterPath == "" {
-		return self.getUnfilteredStashEntries()
+		return self.getUnfilteredStashEntries(ctx)
 	}
 
 	cmdArgs := NewGitCmd("stash").Arg("list", "--name-only", "--pretty=%gd:%H|%ct|%gs").ToArgv()
-	rawString, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	rawString, err := self.cmd.New(cmdArgs).WithContext(ctx).DontLog().RunWithOutput()
 	if err != nil {
-		return self.getUnfilteredStashEntries()
+		return self.getUnfilteredStashEntries(ctx)
 	}
 	stashEntries := []*models.StashEntry{}
 	var currentStashEntry *models.StashEntry
@@ -51,7 +52,7 @@ outer:
 		}
 		idx, err := strconv.Atoi(match[1])
 		if err != nil {
-			return self.getUnfilteredStashEntries()
+			return self.getUnfilteredStashEntries(ctx)
 		}
 		currentStashEntry = stashEntryFromLine(match[2], idx)
 		for i+1 < len(lines) && !isAStash(lines[i+1]) {
@@ -65,10 +66,10 @@ outer:
 	return stashEntries
 }
 
-func (self *StashLoader) getUnfilteredStashEntries() []*models.StashEntry {
+func (self *StashLoader) getUnfilteredStashEntries(ctx context.Context) []*models.StashEntry {
 	cmdArgs := NewGitCmd("stash").Arg("list", "-z", "--pretty=%H|%ct|%gs").ToArgv()
 
-	rawString, _ := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	rawString, _ := self.cmd.New(cmdArgs).WithContext(ctx).DontLog().RunWithOutput()
 	return lo.Map(utils.SplitNul(rawString), func(line string, index int) *models.StashEntry {
 		return stashEntryFromLine(line, index)
 	})
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -62,7 +63,7 @@ func TestGetStashEntries(t *testing.T) {
 
 			loader := NewStashLoader(common.NewDummyCommon(), cmd)
 
-			assert.EqualValues(t, s.expectedStashEntries, loader.GetStashEntries(s.filterPath))
+			assert.EqualValues(t, s.expectedStashEntries, loader.GetStashEntries(context.Background(), s.filterPath))
 		})
 	}
 }
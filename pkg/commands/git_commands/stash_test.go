@@ -44,6 +44,22 @@ func TestStashSave(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"stash", "push", "--include-untracked", "-m", "A stash message", "--", "file1", "dir/file2"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashFiles([]string{"file1", "dir/file2"}, "A stash message"))
+	runner.CheckForMissingCalls()
+}
+
+func TestStashFilesInteractivelyCmdObj(t *testing.T) {
+	instance := buildStashCommands(commonDeps{})
+
+	cmdObj := instance.StashFilesInteractivelyCmdObj([]string{"file1"}, "A stash message")
+	assert.Equal(t, []string{"git", "stash", "push", "--patch", "-m", "A stash message", "--", "file1"}, cmdObj.Args())
+}
+
 func TestStashStore(t *testing.T) {
 	type scenario struct {
 		testName string
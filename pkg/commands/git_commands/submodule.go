@@ -230,10 +230,10 @@ func (self *SubmoduleCommands) Init(path string) error {
 }
 
 func (self *SubmoduleCommands) Update(path string) error {
-	cmdArgs := NewGitCmd("submodule").Arg("update", "--init", "--", path).
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--init", "--progress", "--", path).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	return self.cmd.New(cmdArgs).ReportProgress().Run()
 }
 
 func (self *SubmoduleCommands) BulkInitCmdObj() *oscommands.CmdObj {
@@ -244,24 +244,24 @@ func (self *SubmoduleCommands) BulkInitCmdObj() *oscommands.CmdObj {
 }
 
 func (self *SubmoduleCommands) BulkUpdateCmdObj() *oscommands.CmdObj {
-	cmdArgs := NewGitCmd("submodule").Arg("update").
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--progress").
 		ToArgv()
 
-	return self.cmd.New(cmdArgs)
+	return self.cmd.New(cmdArgs).ReportProgress()
 }
 
 func (self *SubmoduleCommands) ForceBulkUpdateCmdObj() *oscommands.CmdObj {
-	cmdArgs := NewGitCmd("submodule").Arg("update", "--force").
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--force", "--progress").
 		ToArgv()
 
-	return self.cmd.New(cmdArgs)
+	return self.cmd.New(cmdArgs).ReportProgress()
 }
 
 func (self *SubmoduleCommands) BulkUpdateRecursivelyCmdObj() *oscommands.CmdObj {
-	cmdArgs := NewGitCmd("submodule").Arg("update", "--init", "--recursive").
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--init", "--recursive", "--progress").
 		ToArgv()
 
-	return self.cmd.New(cmdArgs)
+	return self.cmd.New(cmdArgs).ReportProgress()
 }
 
 func (self *SubmoduleCommands) BulkDeinitCmdObj() *oscommands.CmdObj {
@@ -22,26 +22,38 @@ func NewSyncCommands(gitCommon *GitCommon) *SyncCommands {
 type PushOpts struct {
 	Force          bool
 	ForceWithLease bool
-	CurrentBranch  string
-	UpstreamRemote string
-	UpstreamBranch string
-	SetUpstream    bool
+	// Only has an effect together with ForceWithLease
+	ForceIfIncludes bool
+	CurrentBranch   string
+	UpstreamRemote  string
+	UpstreamBranch  string
+	SetUpstream     bool
+	// Skips the pre-push hook, even if git.skipHooks.push is off
+	NoVerify   bool
+	FollowTags bool
+	// Pushed instead of the current branch; requires UpstreamRemote
+	Refspec string
 }
 
 func (self *SyncCommands) PushCmdObj(task gocui.Task, opts PushOpts) (*oscommands.CmdObj, error) {
-	if opts.UpstreamBranch != "" && opts.UpstreamRemote == "" {
+	if (opts.UpstreamBranch != "" || opts.Refspec != "") && opts.UpstreamRemote == "" {
 		return nil, errors.New(self.Tr.MustSpecifyOriginError)
 	}
 
 	cmdArgs := NewGitCmd("push").
 		ArgIf(opts.Force, "--force").
 		ArgIf(opts.ForceWithLease, "--force-with-lease").
+		ArgIf(opts.ForceWithLease && opts.ForceIfIncludes, "--force-if-includes").
 		ArgIf(opts.SetUpstream, "--set-upstream").
+		ArgIf(opts.NoVerify || self.UserConfig().Git.SkipHooks.Push, "--no-verify").
+		ArgIf(opts.FollowTags, "--follow-tags").
+		Arg("--progress").
 		ArgIf(opts.UpstreamRemote != "", opts.UpstreamRemote).
-		ArgIf(opts.UpstreamBranch != "", fmt.Sprintf("refs/heads/%s:%s", opts.CurrentBranch, opts.UpstreamBranch)).
+		ArgIf(opts.Refspec != "", opts.Refspec).
+		ArgIf(opts.Refspec == "" && opts.UpstreamBranch != "", fmt.Sprintf("refs/heads/%s:%s", opts.CurrentBranch, opts.UpstreamBranch)).
 		ToArgv()
 
-	cmdObj := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task)
+	cmdObj := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).ReportProgress()
 	return cmdObj, nil
 }
 
@@ -63,10 +75,10 @@ func (self *SyncCommands) fetchCommandBuilder(fetchAll bool) *GitCommandBuilder
 }
 
 func (self *SyncCommands) FetchCmdObj(task gocui.Task) *oscommands.CmdObj {
-	cmdArgs := self.fetchCommandBuilder(self.UserConfig().Git.FetchAll).ToArgv()
+	cmdArgs := self.fetchCommandBuilder(self.UserConfig().Git.FetchAll).Arg("--progress").ToArgv()
 
 	cmdObj := self.cmd.New(cmdArgs)
-	cmdObj.PromptOnCredentialRequest(task)
+	cmdObj.PromptOnCredentialRequest(task).ReportProgress().Cancellable()
 	return cmdObj
 }
 
@@ -87,18 +99,44 @@ func (self *SyncCommands) FetchBackground() error {
 	return self.FetchBackgroundCmdObj().Run()
 }
 
+func (self *SyncCommands) FetchRemoteBackgroundCmdObj(remoteName string) *oscommands.CmdObj {
+	cmdArgs := self.fetchCommandBuilder(false).Arg(remoteName).ToArgv()
+
+	cmdObj := self.cmd.New(cmdArgs)
+	cmdObj.DontLog().FailOnCredentialRequest()
+	cmdObj.SuppressOutputUnlessError()
+	return cmdObj
+}
+
+func (self *SyncCommands) FetchRemoteBackground(remoteName string) error {
+	return self.FetchRemoteBackgroundCmdObj(remoteName).Run()
+}
+
 type PullOptions struct {
 	RemoteName      string
 	BranchName      string
 	FastForwardOnly bool
-	WorktreeGitDir  string
-	WorktreePath    string
+	// How to integrate the upstream branch; one of the values of git.pullMode,
+	// which is used if this is empty. FastForwardOnly takes precedence.
+	Strategy       string
+	WorktreeGitDir string
+	WorktreePath   string
 }
 
 func (self *SyncCommands) Pull(task gocui.Task, opts PullOptions) error {
+	strategy := opts.Strategy
+	if strategy == "" {
+		strategy = self.UserConfig().Git.PullMode
+	}
+	if opts.FastForwardOnly {
+		strategy = "ff-only"
+	}
+
 	cmdArgs := NewGitCmd("pull").
-		Arg("--no-edit").
-		ArgIf(opts.FastForwardOnly, "--ff-only").
+		Arg("--no-edit", "--progress").
+		ArgIf(strategy == "ff-only", "--ff-only").
+		ArgIf(strategy == "merge", "--no-rebase").
+		ArgIf(strategy == "rebase", "--rebase").
 		ArgIf(opts.RemoteName != "", opts.RemoteName).
 		ArgIf(opts.BranchName != "", "refs/heads/"+opts.BranchName).
 		GitDirIf(opts.WorktreeGitDir != "", opts.WorktreeGitDir).
@@ -107,7 +145,7 @@ func (self *SyncCommands) Pull(task gocui.Task, opts PullOptions) error {
 
 	// setting GIT_SEQUENCE_EDITOR to ':' as a way of skipping it, in case the user
 	// has 'pull.rebase = interactive' configured.
-	return self.cmd.New(cmdArgs).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest(task).Run()
+	return self.cmd.New(cmdArgs).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest(task).ReportProgress().Run()
 }
 
 func (self *SyncCommands) FastForward(
@@ -117,17 +155,19 @@ func (self *SyncCommands) FastForward(
 	remoteBranchName string,
 ) error {
 	cmdArgs := self.fetchCommandBuilder(false).
+		Arg("--progress").
 		Arg(remoteName).
 		Arg("refs/heads/" + remoteBranchName + ":" + branchName).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).ReportProgress().Cancellable().Run()
 }
 
 func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error {
 	cmdArgs := self.fetchCommandBuilder(false).
+		Arg("--progress").
 		Arg(remoteName).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).ReportProgress().Cancellable().Run()
 }
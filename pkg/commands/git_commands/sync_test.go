@@ -5,14 +5,16 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestSyncPush(t *testing.T) {
 	type scenario struct {
-		testName string
-		opts     PushOpts
-		test     func(*oscommands.CmdObj, error)
+		testName  string
+		opts      PushOpts
+		skipHooks bool
+		test      func(*oscommands.CmdObj, error)
 	}
 
 	scenarios := []scenario{
@@ -20,7 +22,7 @@ func TestSyncPush(t *testing.T) {
 			testName: "Push with force disabled",
 			opts:     PushOpts{ForceWithLease: false},
 			test: func(cmdObj *oscommands.CmdObj, err error) {
-				assert.Equal(t, cmdObj.Args(), []string{"git", "push"})
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--progress"})
 				assert.NoError(t, err)
 			},
 		},
@@ -28,7 +30,7 @@ func TestSyncPush(t *testing.T) {
 			testName: "Push with force-with-lease enabled",
 			opts:     PushOpts{ForceWithLease: true},
 			test: func(cmdObj *oscommands.CmdObj, err error) {
-				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease"})
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease", "--progress"})
 				assert.NoError(t, err)
 			},
 		},
@@ -36,7 +38,7 @@ func TestSyncPush(t *testing.T) {
 			testName: "Push with force enabled",
 			opts:     PushOpts{Force: true},
 			test: func(cmdObj *oscommands.CmdObj, err error) {
-				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force"})
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force", "--progress"})
 				assert.NoError(t, err)
 			},
 		},
@@ -49,7 +51,7 @@ func TestSyncPush(t *testing.T) {
 				UpstreamBranch: "master",
 			},
 			test: func(cmdObj *oscommands.CmdObj, err error) {
-				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "origin", "refs/heads/master:master"})
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--progress", "origin", "refs/heads/master:master"})
 				assert.NoError(t, err)
 			},
 		},
@@ -63,7 +65,7 @@ func TestSyncPush(t *testing.T) {
 				SetUpstream:    true,
 			},
 			test: func(cmdObj *oscommands.CmdObj, err error) {
-				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--set-upstream", "origin", "refs/heads/master-local:master"})
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--set-upstream", "--progress", "origin", "refs/heads/master-local:master"})
 				assert.NoError(t, err)
 			},
 		},
@@ -77,10 +79,65 @@ func TestSyncPush(t *testing.T) {
 				SetUpstream:    true,
 			},
 			test: func(cmdObj *oscommands.CmdObj, err error) {
-				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease", "--set-upstream", "origin", "refs/heads/master:master"})
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease", "--set-upstream", "--progress", "origin", "refs/heads/master:master"})
 				assert.NoError(t, err)
 			},
 		},
+		{
+			testName:  "Push skipping hooks",
+			opts:      PushOpts{},
+			skipHooks: true,
+			test: func(cmdObj *oscommands.CmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--no-verify", "--progress"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with force-if-includes",
+			opts:     PushOpts{ForceWithLease: true, ForceIfIncludes: true},
+			test: func(cmdObj *oscommands.CmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease", "--force-if-includes", "--progress"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with force-if-includes but without force-with-lease",
+			opts:     PushOpts{ForceIfIncludes: true},
+			test: func(cmdObj *oscommands.CmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--progress"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with no-verify and follow-tags",
+			opts:     PushOpts{NoVerify: true, FollowTags: true},
+			test: func(cmdObj *oscommands.CmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--no-verify", "--follow-tags", "--progress"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with custom refspec",
+			opts: PushOpts{
+				CurrentBranch:  "master",
+				UpstreamRemote: "origin",
+				UpstreamBranch: "master",
+				Refspec:        "HEAD~1:refs/heads/review",
+			},
+			test: func(cmdObj *oscommands.CmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--progress", "origin", "HEAD~1:refs/heads/review"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with custom refspec but no origin",
+			opts: PushOpts{
+				Refspec: "HEAD:refs/heads/review",
+			},
+			test: func(cmdObj *oscommands.CmdObj, err error) {
+				assert.Error(t, err)
+			},
+		},
 		{
 			testName: "Push with remote branch but no origin",
 			opts: PushOpts{
@@ -98,7 +155,9 @@ func TestSyncPush(t *testing.T) {
 
 	for _, s := range scenarios {
 		t.Run(s.testName, func(t *testing.T) {
-			instance := buildSyncCommands(commonDeps{})
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.SkipHooks.Push = s.skipHooks
+			instance := buildSyncCommands(commonDeps{userConfig: userConfig})
 			task := gocui.NewFakeTask()
 			cmdObj, err := instance.PushCmdObj(task, s.opts)
 			if err == nil {
@@ -126,7 +185,9 @@ func TestSyncFetch(t *testing.T) {
 				assert.True(t, cmdObj.ShouldLog())
 				assert.Equal(t, cmdObj.GetCredentialStrategy(), oscommands.PROMPT)
 				assert.False(t, cmdObj.ShouldSuppressOutputUnlessError())
-				assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--no-write-fetch-head"})
+				assert.True(t, cmdObj.ShouldReportProgress())
+				assert.True(t, cmdObj.IsCancellable())
+				assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--no-write-fetch-head", "--progress"})
 			},
 		},
 		{
@@ -136,7 +197,9 @@ func TestSyncFetch(t *testing.T) {
 				assert.True(t, cmdObj.ShouldLog())
 				assert.Equal(t, cmdObj.GetCredentialStrategy(), oscommands.PROMPT)
 				assert.False(t, cmdObj.ShouldSuppressOutputUnlessError())
-				assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--all", "--no-write-fetch-head"})
+				assert.True(t, cmdObj.ShouldReportProgress())
+				assert.True(t, cmdObj.IsCancellable())
+				assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--all", "--no-write-fetch-head", "--progress"})
 			},
 		},
 	}
@@ -189,3 +252,77 @@ func TestSyncFetchBackground(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncFetchRemoteBackground(t *testing.T) {
+	instance := buildSyncCommands(commonDeps{})
+	cmdObj := instance.FetchRemoteBackgroundCmdObj("upstream")
+
+	assert.False(t, cmdObj.ShouldLog())
+	assert.Equal(t, cmdObj.GetCredentialStrategy(), oscommands.FAIL)
+	assert.True(t, cmdObj.ShouldSuppressOutputUnlessError())
+	assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--no-write-fetch-head", "upstream"})
+}
+
+func TestSyncPull(t *testing.T) {
+	type scenario struct {
+		testName     string
+		pullMode     string
+		opts         PullOptions
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "Pull with pull mode auto",
+			pullMode:     "auto",
+			opts:         PullOptions{},
+			expectedArgs: []string{"pull", "--no-edit", "--progress"},
+		},
+		{
+			testName:     "Pull with pull mode merge",
+			pullMode:     "merge",
+			opts:         PullOptions{},
+			expectedArgs: []string{"pull", "--no-edit", "--progress", "--no-rebase"},
+		},
+		{
+			testName:     "Pull with pull mode rebase",
+			pullMode:     "rebase",
+			opts:         PullOptions{RemoteName: "origin", BranchName: "master"},
+			expectedArgs: []string{"pull", "--no-edit", "--progress", "--rebase", "origin", "refs/heads/master"},
+		},
+		{
+			testName:     "Pull with pull mode ff-only",
+			pullMode:     "ff-only",
+			opts:         PullOptions{},
+			expectedArgs: []string{"pull", "--no-edit", "--progress", "--ff-only"},
+		},
+		{
+			testName:     "Fast-forward only takes precedence over pull mode",
+			pullMode:     "rebase",
+			opts:         PullOptions{FastForwardOnly: true},
+			expectedArgs: []string{"pull", "--no-edit", "--progress", "--ff-only"},
+		},
+		{
+			testName:     "Strategy overrides pull mode",
+			pullMode:     "merge",
+			opts:         PullOptions{Strategy: "rebase"},
+			expectedArgs: []string{"pull", "--no-edit", "--progress", "--rebase"},
+		},
+		{
+			testName:     "Strategy auto overrides pull mode",
+			pullMode:     "ff-only",
+			opts:         PullOptions{Strategy: "auto"},
+			expectedArgs: []string{"pull", "--no-edit", "--progress"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildSyncCommands(commonDeps{runner: runner})
+			instance.UserConfig().Git.PullMode = s.pullMode
+			assert.NoError(t, instance.Pull(gocui.NewFakeTask(), s.opts))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
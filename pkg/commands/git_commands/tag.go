@@ -46,6 +46,19 @@ func (self *TagCommands) HasTag(tagName string) bool {
 	return self.cmd.New(cmdArgs).Run() == nil
 }
 
+// LatestReachable returns the most recent tag that is reachable from HEAD, or
+// an empty string if there is none.
+func (self *TagCommands) LatestReachable() string {
+	cmdArgs := NewGitCmd("describe").Arg("--tags", "--abbrev=0").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(output)
+}
+
 func (self *TagCommands) LocalDelete(tagName string) error {
 	cmdArgs := NewGitCmd("tag").Arg("-d", tagName).
 		ToArgv()
@@ -54,7 +67,9 @@ func (self *TagCommands) LocalDelete(tagName string) error {
 }
 
 func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string) error {
-	cmdArgs := NewGitCmd("push").Arg(remoteName, "tag", tagName).
+	cmdArgs := NewGitCmd("push").
+		ArgIf(self.UserConfig().Git.SkipHooks.Push, "--no-verify").
+		Arg(remoteName, "tag", tagName).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
@@ -77,6 +92,14 @@ func (self *TagCommands) ShowAnnotationInfo(tagName string) (string, error) {
 	return self.cmd.New(cmdArgs).RunWithOutput()
 }
 
+// Like CommitCommands.VerifySignature, but for an annotated tag
+func (self *TagCommands) VerifySignature(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("verify-tag").Arg("refs/tags/" + tagName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
 func (self *TagCommands) IsTagAnnotated(tagName string) (bool, error) {
 	cmdArgs := NewGitCmd("cat-file").
 		Arg("-t").
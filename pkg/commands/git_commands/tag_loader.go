@@ -1,54 +1,42 @@
 package git_commands
 
 import (
-	"regexp"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
-	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
-	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
 type TagLoader struct {
 	*common.Common
-	cmd oscommands.ICmdObjBuilder
+	refsLoader *RefsLoader
 }
 
 func NewTagLoader(
 	common *common.Common,
-	cmd oscommands.ICmdObjBuilder,
+	refsLoader *RefsLoader,
 ) *TagLoader {
 	return &TagLoader{
-		Common: common,
-		cmd:    cmd,
+		Common:     common,
+		refsLoader: refsLoader,
 	}
 }
 
 func (self *TagLoader) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
-	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	cmdArgs := NewGitCmd("tag").Arg("--list", "-n", "--sort=-creatordate").ToArgv()
-	tagsOutput, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	refs, err := self.refsLoader.getRefs("refs/tags/")
 	if err != nil {
 		return nil, err
 	}
 
-	split := utils.SplitLines(tagsOutput)
-
-	lineRegex := regexp.MustCompile(`^([^\s]+)(\s+)?(.*)$`)
-
-	tags := lo.Map(split, func(line string, _ int) *models.Tag {
-		matches := lineRegex.FindStringSubmatch(line)
-		tagName := matches[1]
-		message := ""
-		if len(matches) > 3 {
-			message = matches[3]
-		}
+	// sorted by creation date (descending), like `git tag --sort=-creatordate`
+	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
+	sortRefsByDateDescending(refs, func(ref refEntry) int64 { return ref.creatorDate })
 
+	tags := lo.Map(refs, func(ref refEntry, _ int) *models.Tag {
 		return &models.Tag{
-			Name:    tagName,
-			Message: message,
+			Name:    strings.TrimPrefix(ref.refName, "refs/tags/"),
+			Message: ref.subject,
 		}
 	})
 
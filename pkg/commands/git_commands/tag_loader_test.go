@@ -1,18 +1,23 @@
 package git_commands
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
-	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `tag1 this is my message
-tag2
-tag3 this is my other message
-`
+var forEachRefArgs = []string{"for-each-ref", "--format=%(refname)%00%(if:equals=refs/heads)%(refname:rstrip=-2)%(then)%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(upstream:track)%00%(push:track)%00%(subject)%00%(objectname)%00%(committerdate:unix)%(else)%(if:equals=refs/tags)%(refname:rstrip=-2)%(then)%(subject)%00%(creatordate:unix)%(else)%(committerdate:unix)%(end)%(end)", "refs/heads", "refs/remotes", "refs/tags"}
+
+var tagsOutput = strings.ReplaceAll(`refs/heads/master||master||||a commit|123|1000
+refs/remotes/origin/master|1000
+refs/tags/tag1|this is my message|3000
+refs/tags/tag2||1000
+refs/tags/tag3|this is my other message|2000
+`, "|", "\x00")
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
@@ -26,18 +31,18 @@ func TestGetTags(t *testing.T) {
 		{
 			testName: "should return no tags if there are none",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, "", nil),
+				ExpectGitArgs(forEachRefArgs, "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
-			testName: "should return tags if present",
+			testName: "should return tags sorted by creation date if present",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, tagsOutput, nil),
+				ExpectGitArgs(forEachRefArgs, tagsOutput, nil),
 			expectedTags: []*models.Tag{
 				{Name: "tag1", Message: "this is my message"},
-				{Name: "tag2", Message: ""},
 				{Name: "tag3", Message: "this is my other message"},
+				{Name: "tag2", Message: ""},
 			},
 			expectedError: nil,
 		},
@@ -45,10 +50,8 @@ func TestGetTags(t *testing.T) {
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.testName, func(t *testing.T) {
-			loader := &TagLoader{
-				Common: common.NewDummyCommon(),
-				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
-			}
+			gitCommon := buildGitCommon(commonDeps{runner: scenario.runner})
+			loader := NewTagLoader(gitCommon.Common, NewRefsLoader(gitCommon))
 
 			tags, err := loader.GetTags()
 
@@ -59,3 +62,107 @@ func TestGetTags(t *testing.T) {
 		})
 	}
 }
+
+func TestRefsLoaderSharesRefsUntilInvalidated(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs(forEachRefArgs, tagsOutput, nil).
+		ExpectGitArgs(forEachRefArgs, "", nil)
+	gitCommon := buildGitCommon(commonDeps{runner: runner})
+	refsLoader := NewRefsLoader(gitCommon)
+	tagLoader := NewTagLoader(gitCommon.Common, refsLoader)
+
+	tags, err := tagLoader.GetTags()
+	assert.NoError(t, err)
+	assert.Len(t, tags, 3)
+
+	// Served from the previous load
+	branches, err := refsLoader.getRefs("refs/heads/")
+	assert.NoError(t, err)
+	assert.Len(t, branches, 1)
+
+	refsLoader.Invalidate()
+	tags, err = tagLoader.GetTags()
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	runner.CheckForMissingCalls()
+}
+
+func TestRefsLoaderOnlyLoadsAgainIfRefsChanged(t *testing.T) {
+	fs := setupRefsFs()
+	_ = afero.WriteFile(fs, "/repo/.git/config", []byte(""), 0o644)
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs(forEachRefArgs, tagsOutput, nil).
+		ExpectGitArgs(forEachRefArgs, "", nil)
+	gitCommon := buildGitCommon(commonDeps{runner: runner, fs: fs, repoPaths: MockRepoPaths("/repo")})
+	refsLoader := NewRefsLoader(gitCommon)
+	tagLoader := NewTagLoader(gitCommon.Common, refsLoader)
+
+	tags, err := tagLoader.GetTags()
+	assert.NoError(t, err)
+	assert.Len(t, tags, 3)
+
+	// The refs haven't changed, so there's no need to run git
+	refsLoader.Invalidate()
+	tags, err = tagLoader.GetTags()
+	assert.NoError(t, err)
+	assert.Len(t, tags, 3)
+
+	_ = afero.WriteFile(fs, "/repo/.git/refs/tags/v2.0", []byte("8888888888888888888888888888888888888888\n"), 0o644)
+	refsLoader.Invalidate()
+	tags, err = tagLoader.GetTags()
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	runner.CheckForMissingCalls()
+}
+
+func TestParseRefLine(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		line          string
+		expectedRef   refEntry
+		expectedFound bool
+	}{
+		{
+			testName: "local branch",
+			line:     "refs/heads/feat/x|*|feat/x|origin/feat/x|[ahead 1]|[ahead 1]|subject|abc|1000",
+			expectedRef: refEntry{
+				refName:       "refs/heads/feat/x",
+				branchFields:  []string{"*", "feat/x", "origin/feat/x", "[ahead 1]", "[ahead 1]", "subject", "abc", "1000"},
+				committerDate: 1000,
+			},
+			expectedFound: true,
+		},
+		{
+			testName:      "remote branch",
+			line:          "refs/remotes/origin/feat/x|2000",
+			expectedRef:   refEntry{refName: "refs/remotes/origin/feat/x", committerDate: 2000},
+			expectedFound: true,
+		},
+		{
+			testName:      "tag",
+			line:          "refs/tags/v1.0|message|3000",
+			expectedRef:   refEntry{refName: "refs/tags/v1.0", subject: "message", creatorDate: 3000},
+			expectedFound: true,
+		},
+		{
+			testName:      "tag with the fields of a remote branch",
+			line:          "refs/tags/v1.0|3000",
+			expectedFound: false,
+		},
+		{
+			testName:      "warning",
+			line:          "warning: ignoring broken ref refs/heads/foo",
+			expectedFound: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			ref, found := parseRefLine(strings.ReplaceAll(s.line, "|", "\x00"))
+			assert.Equal(t, s.expectedFound, found)
+			assert.Equal(t, s.expectedRef, ref)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagLatestReachable(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "returns the latest tag",
+			runner:   oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"describe", "--tags", "--abbrev=0"}, "v1.2.3\n", nil),
+			expected: "v1.2.3",
+		},
+		{
+			testName: "returns an empty string if there are no tags",
+			runner: oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"describe", "--tags", "--abbrev=0"}, "",
+				errors.New("fatal: No names found, cannot describe anything.")),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildTagCommands(commonDeps{runner: s.runner})
+			assert.Equal(t, s.expected, instance.LatestReachable())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/samber/lo"
+	"github.com/spf13/afero"
 )
 
 type WorkingTreeCommands struct {
@@ -44,6 +46,7 @@ func (self *WorkingTreeCommands) StageFile(path string) error {
 
 func (self *WorkingTreeCommands) StageFiles(paths []string, extraArgs []string) error {
 	cmdArgs := NewGitCmd("add").
+		ArgIf(self.stageOutsideSparseCheckout(), "--sparse").
 		Arg(extraArgs...).
 		Arg("--").
 		Arg(paths...).
@@ -55,17 +58,93 @@ func (self *WorkingTreeCommands) StageFiles(paths []string, extraArgs []string)
 // StageAll stages all files
 func (self *WorkingTreeCommands) StageAll(onlyTrackedFiles bool) error {
 	cmdArgs := NewGitCmd("add").
+		ArgIf(self.stageOutsideSparseCheckout(), "--sparse").
 		ArgIfElse(onlyTrackedFiles, "-u", "-A").
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// In a sparse checkout, git refuses to stage files outside of the
+// sparse-checkout definition unless we pass --sparse. Files can only show up
+// there if the user created them anyway, so staging them is what they want.
+// For files inside the definition, --sparse makes no difference, and in
+// particular doesn't expand a sparse index.
+func (self *WorkingTreeCommands) stageOutsideSparseCheckout() bool {
+	return self.version.IsAtLeast(2, 35, 0) && self.config.UsesSparseCheckout()
+}
+
 // UnstageAll unstages all files
 func (self *WorkingTreeCommands) UnstageAll() error {
 	return self.cmd.New(NewGitCmd("reset").ToArgv()).Run()
 }
 
+// WriteTree writes the current index to a tree object and returns its hash.
+// Fails if the index contains unmerged entries.
+func (self *WorkingTreeCommands) WriteTree() (string, error) {
+	output, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// WriteTreeOfIndexCopy is like WriteTree, but for a copy of the index made
+// with SaveIndexCopy rather than for the repo's index.
+func (self *WorkingTreeCommands) WriteTreeOfIndexCopy(path string) (string, error) {
+	output, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).
+		AddEnvVars("GIT_INDEX_FILE=" + path).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// SaveIndexCopy copies the index file to a new temporary file and returns its
+// path. Unlike writing the index to a tree, this keeps everything that's in
+// the index, such as intent-to-add entries and the cached stat info of files.
+func (self *WorkingTreeCommands) SaveIndexCopy() (string, error) {
+	content, err := afero.ReadFile(self.Fs, self.indexPath())
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(self.os.GetTempDir(), self.repoPaths.RepoName())
+	if err := self.Fs.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, time.Now().Format("Jan _2 15.04.05.000000000")+".index")
+	if err := afero.WriteFile(self.Fs, path, content, 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// RestoreIndexCopy replaces the index with a copy made by SaveIndexCopy,
+// leaving the working tree untouched. Like git, we write the new index to
+// index.lock and then rename it, so that this fails rather than clobbering the
+// index if a git command is writing it at the same time.
+func (self *WorkingTreeCommands) RestoreIndexCopy(path string) error {
+	content, err := afero.ReadFile(self.Fs, path)
+	if err != nil {
+		return err
+	}
+
+	lockPath := self.indexPath() + ".lock"
+	lockFile, err := self.Fs.OpenFile(lockPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = lockFile.Write(content)
+	if closeErr := lockFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = self.Fs.Remove(lockPath)
+		return err
+	}
+
+	return self.Fs.Rename(lockPath, self.indexPath())
+}
+
+func (self *WorkingTreeCommands) indexPath() string {
+	return filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index")
+}
+
 // UnStageFile unstages a file
 // we accept an array of filenames for the cases where a file has been renamed i.e.
 // we accept the current name and the previous name
@@ -361,6 +440,15 @@ func (self *WorkingTreeCommands) DiscardUnstagedFileChanges(file *models.File) e
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// DiscardFileHunk discards unstaged changes by reverse-applying the given
+// patch to the working tree, which is usually a single hunk of the file's
+// unstaged diff. If the file has changed since that diff was made so that the
+// patch no longer applies, nothing is discarded and an error is returned.
+func (self *WorkingTreeCommands) DiscardFileHunk(hunkPatch string) error {
+	cmdArgs := NewGitCmd("apply").Arg("--reverse").ToArgv()
+	return self.cmd.New(cmdArgs).SetStdin(hunkPatch).Run()
+}
+
 // Escapes special characters in a filename for gitignore and exclude files, and prepends `/`
 func escapeFilename(filename string) string {
 	re := regexp.MustCompile(`^[!#]|[\[\]*]`)
@@ -394,8 +482,10 @@ func (self *WorkingTreeCommands) WorktreeFileDiff(file *models.File, plain bool,
 // the node's path (used to diff only filtered/visible files within a directory).
 func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain bool, cached bool, pathOverrides []string) *oscommands.CmdObj {
 	colorArg := self.pagerConfig.GetColorArg()
+	diffColorConfigs := self.pagerConfig.GetDiffColorConfigs()
 	if plain {
 		colorArg = "never"
+		diffColorConfigs = nil
 	}
 
 	contextSize := self.UserConfig().Git.DiffContextSize
@@ -412,6 +502,7 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 
 	cmdArgs := NewGitCmd("diff").
 		ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
+		Configs(diffColorConfigs).
 		ArgIfElse(useExtDiff || useExtDiffGitConfig, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
@@ -440,8 +531,10 @@ func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reve
 	contextSize := self.UserConfig().Git.DiffContextSize
 
 	colorArg := self.pagerConfig.GetColorArg()
+	diffColorConfigs := self.pagerConfig.GetDiffColorConfigs()
 	if plain {
 		colorArg = "never"
+		diffColorConfigs = nil
 	}
 
 	extDiffCmd := self.pagerConfig.GetExternalDiffCommand()
@@ -451,6 +544,7 @@ func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reve
 	cmdArgs := NewGitCmd("diff").
 		Config("diff.noprefix=false").
 		ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
+		Configs(diffColorConfigs).
 		ArgIfElse(useExtDiff || useExtDiffGitConfig, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
@@ -476,6 +570,29 @@ func (self *WorkingTreeCommands) CheckoutFile(commitHash, fileName string) error
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Like CheckoutFile, for a file that had a different path in the given commit,
+// e.g. because it has been renamed since. The old content is written to (and
+// staged at) the file's current path.
+func (self *WorkingTreeCommands) CheckoutFileFromPath(commitHash, pathInCommit, path string) error {
+	if pathInCommit == path {
+		return self.CheckoutFile(commitHash, path)
+	}
+
+	cmdArgs := NewGitCmd("cat-file").Arg("blob", commitHash+":"+pathInCommit).
+		ToArgv()
+
+	content, err := self.cmd.New(cmdArgs).RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	if err := self.os.CreateFileWithContent(path, content); err != nil {
+		return err
+	}
+
+	return self.cmd.New(NewGitCmd("add").Arg("--", path).ToArgv()).Run()
+}
+
 // DiscardAnyUnstagedFileChanges discards any unstaged file changes via `git checkout -- .`
 func (self *WorkingTreeCommands) DiscardAnyUnstagedFileChanges() error {
 	cmdArgs := NewGitCmd("checkout").Arg("--", ".").
@@ -594,10 +711,15 @@ func (self *WorkingTreeCommands) MergeFileForObjectIDs(strategy string, oursID s
 
 // Returns all tracked files in the repo (not in the working tree). The returned entries are
 // relative paths to the repo root, using '/' as the path separator on all platforms.
+// With a sparse index, directories outside of the sparse-checkout definition are returned
+// as a single entry rather than the files in them, so that we don't expand the index.
 // Does not really belong in WorkingTreeCommands, but it's close enough, and we don't seem to have a
 // better place for it right now.
 func (self *WorkingTreeCommands) AllRepoFiles() ([]string, error) {
-	cmdArgs := NewGitCmd("ls-files").Arg("-z").ToArgv()
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("-z").
+		ArgIf(self.version.IsAtLeast(2, 35, 0) && self.config.UsesSparseIndex(), "--sparse").
+		ToArgv()
 	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 	if err != nil {
 		return nil, err
@@ -605,5 +727,8 @@ func (self *WorkingTreeCommands) AllRepoFiles() ([]string, error) {
 	if output == "" {
 		return []string{}, nil
 	}
-	return strings.Split(strings.TrimRight(output, "\x00"), "\x00"), nil
+	return lo.Map(strings.Split(strings.TrimRight(output, "\x00"), "\x00"), func(path string, _ int) string {
+		// sparse directories are listed with a trailing slash
+		return strings.TrimSuffix(path, "/")
+	}), nil
 }
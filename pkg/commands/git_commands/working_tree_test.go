@@ -1,13 +1,16 @@
 package git_commands
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,6 +34,86 @@ func TestWorkingTreeStageFiles(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestWorkingTreeStageFilesInSparseCheckout(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"add", "--sparse", "--", "outside/test.txt"}, "", nil).
+		ExpectGitArgs([]string{"add", "--sparse", "-A"}, "", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{
+		runner:     runner,
+		gitConfig:  git_config.NewFakeGitConfig(map[string]string{"core.sparseCheckout": "true"}),
+		gitVersion: &GitVersion{2, 35, 0, ""},
+	})
+
+	assert.NoError(t, instance.StageFiles([]string{"outside/test.txt"}, nil))
+	assert.NoError(t, instance.StageAll(false))
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeWriteTree(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"write-tree"}, "4b825dc642cb6eb9a060e54bf8d69288fbee4904\n", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	treeHash, err := instance.WriteTree()
+	assert.NoError(t, err)
+	assert.Equal(t, "4b825dc642cb6eb9a060e54bf8d69288fbee4904", treeHash)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeWriteTreeOfIndexCopy(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"write-tree"}, "4b825dc642cb6eb9a060e54bf8d69288fbee4904\n", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	treeHash, err := instance.WriteTreeOfIndexCopy("/tmp/lazygit/index-copy")
+	assert.NoError(t, err)
+	assert.Equal(t, "4b825dc642cb6eb9a060e54bf8d69288fbee4904", treeHash)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeSaveAndRestoreIndexCopy(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	indexPath := filepath.Join(".git", ".git", "index")
+	assert.NoError(t, afero.WriteFile(fs, indexPath, []byte("original index"), 0o644))
+
+	instance := buildWorkingTreeCommands(commonDeps{fs: fs})
+
+	copyPath, err := instance.SaveIndexCopy()
+	assert.NoError(t, err)
+
+	assert.NoError(t, afero.WriteFile(fs, indexPath, []byte("changed index"), 0o644))
+	assert.NoError(t, instance.RestoreIndexCopy(copyPath))
+
+	content, err := afero.ReadFile(fs, indexPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "original index", string(content))
+	exists, err := afero.Exists(fs, indexPath+".lock")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestWorkingTreeRestoreIndexCopyWhileIndexIsLocked(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	indexPath := filepath.Join(".git", ".git", "index")
+	assert.NoError(t, afero.WriteFile(fs, indexPath, []byte("original index"), 0o644))
+
+	instance := buildWorkingTreeCommands(commonDeps{fs: fs})
+
+	copyPath, err := instance.SaveIndexCopy()
+	assert.NoError(t, err)
+
+	assert.NoError(t, afero.WriteFile(fs, indexPath, []byte("changed index"), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, indexPath+".lock", []byte("index being written"), 0o644))
+	assert.Error(t, instance.RestoreIndexCopy(copyPath))
+
+	content, err := afero.ReadFile(fs, indexPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "changed index", string(content))
+}
+
 func TestWorkingTreeUnstageFile(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -202,6 +285,7 @@ func TestWorkingTreeDiff(t *testing.T) {
 		ignoreWhitespace    bool
 		contextSize         uint64
 		similarityThreshold int
+		diffAddedColor      []string
 		runner              *oscommands.FakeCmdObjRunner
 	}
 
@@ -312,6 +396,37 @@ func TestWorkingTreeDiff(t *testing.T) {
 			similarityThreshold: 33,
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--find-renames=33%", "--", "test.txt"}, expectedResult, nil),
+		}, {
+			testName: "Show diff with custom diff colors",
+			file: &models.File{
+				Path:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			plain:               false,
+			cached:              false,
+			ignoreWhitespace:    false,
+			contextSize:         3,
+			similarityThreshold: 50,
+			diffAddedColor:      []string{"#56b4e9", "underline"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "color.diff.new=#56b4e9 ul", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--find-renames=50%", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName: "Custom diff colors are not used for plain diffs",
+			file: &models.File{
+				Path:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			plain:               true,
+			cached:              false,
+			ignoreWhitespace:    false,
+			contextSize:         3,
+			similarityThreshold: 50,
+			diffAddedColor:      []string{"blue"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=never", "--find-renames=50%", "--", "test.txt"}, expectedResult, nil),
 		},
 	}
 
@@ -321,6 +436,9 @@ func TestWorkingTreeDiff(t *testing.T) {
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			userConfig.Git.DiffContextSize = s.contextSize
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			if s.diffAddedColor != nil {
+				userConfig.Gui.Theme.DiffAddedColor = s.diffAddedColor
+			}
 			repoPaths := RepoPaths{
 				worktreePath: "/path/to/worktree",
 			}
@@ -473,6 +591,41 @@ func TestWorkingTreeDiscardUnstagedFileChanges(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeDiscardFileHunk(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		test     func(error)
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "patch applies",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"apply", "--reverse"}, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "patch doesn't apply",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"apply", "--reverse"}, "", errors.New("error: patch failed: test.txt:8")),
+			test: func(err error) {
+				assert.EqualError(t, err, "error: patch failed: test.txt:8")
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			s.test(instance.DiscardFileHunk("--- a/test.txt\n+++ b/test.txt\n@@ -8,3 +8,4 @@\n pear\n lemon\n+lime\n melon\n"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 // testNode implements IFileNode for unit tests.
 type testNode struct {
 	children []*testNode
@@ -865,9 +1018,10 @@ func TestWorkingTreeResetHard(t *testing.T) {
 
 func TestWorkingTreeCommands_AllRepoFiles(t *testing.T) {
 	scenarios := []struct {
-		name     string
-		runner   *oscommands.FakeCmdObjRunner
-		expected []string
+		name      string
+		gitConfig map[string]string
+		runner    *oscommands.FakeCmdObjRunner
+		expected  []string
 	}{
 		{
 			name: "no files",
@@ -881,10 +1035,21 @@ func TestWorkingTreeCommands_AllRepoFiles(t *testing.T) {
 				ExpectGitArgs([]string{"ls-files", "-z"}, "dir/file1.txt\x00dir2/file2.go\x00", nil),
 			expected: []string{"dir/file1.txt", "dir2/file2.go"},
 		},
+		{
+			name:      "sparse index",
+			gitConfig: map[string]string{"core.sparseCheckout": "true", "index.sparse": "true"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"ls-files", "-z", "--sparse"}, "dir/file1.txt\x00outside/\x00", nil),
+			expected: []string{"dir/file1.txt", "outside"},
+		},
 	}
 	for _, s := range scenarios {
 		t.Run(s.name, func(t *testing.T) {
-			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			instance := buildWorkingTreeCommands(commonDeps{
+				runner:     s.runner,
+				gitConfig:  git_config.NewFakeGitConfig(s.gitConfig),
+				gitVersion: &GitVersion{2, 35, 0, ""},
+			})
 			result, err := instance.AllRepoFiles()
 			assert.NoError(t, err)
 			assert.Equal(t, s.expected, result)
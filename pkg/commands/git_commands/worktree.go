@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"errors"
 	"path/filepath"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -16,28 +17,29 @@ func NewWorktreeCommands(gitCommon *GitCommon) *WorktreeCommands {
 	}
 }
 
-type NewWorktreeOpts struct {
+type AddWorktreeOpts struct {
 	// required. The path of the new worktree.
 	Path string
-	// required. The base branch/ref.
+	// required. The branch, tag, or commit that the new worktree starts at.
 	Base string
 
 	// if true, ends up with a detached head
 	Detach bool
 
-	// optional. if empty, and if detach is false, we will checkout the base
+	// optional. The name of a new branch to create at the base. If empty, and if
+	// detach is false, we will checkout the base
 	Branch string
 }
 
-func (self *WorktreeCommands) New(opts NewWorktreeOpts) error {
+func (self *WorktreeCommands) AddWithOptions(opts AddWorktreeOpts) error {
 	if opts.Detach && opts.Branch != "" {
-		panic("cannot specify branch when detaching")
+		return errors.New("cannot specify branch when detaching")
 	}
 
 	cmdArgs := NewGitCmd("worktree").Arg("add").
 		ArgIf(opts.Detach, "--detach").
 		ArgIf(opts.Branch != "", "-b", opts.Branch).
-		Arg(opts.Path, opts.Base)
+		Arg("--", opts.Path, opts.Base)
 
 	return self.cmd.New(cmdArgs.ToArgv()).Run()
 }
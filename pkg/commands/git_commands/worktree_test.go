@@ -0,0 +1,56 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorktreeAddWithOptions(t *testing.T) {
+	scenarios := []struct {
+		testName    string
+		opts        AddWorktreeOpts
+		runner      *oscommands.FakeCmdObjRunner
+		expectedErr string
+	}{
+		{
+			testName: "check out the base",
+			opts:     AddWorktreeOpts{Path: "../linked", Base: "mybranch"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"worktree", "add", "--", "../linked", "mybranch"}, "", nil),
+		},
+		{
+			testName: "new branch",
+			opts:     AddWorktreeOpts{Path: "../linked", Base: "mybranch", Branch: "feature/x"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"worktree", "add", "-b", "feature/x", "--", "../linked", "mybranch"}, "", nil),
+		},
+		{
+			testName: "detached",
+			opts:     AddWorktreeOpts{Path: "../linked", Base: "1234567", Detach: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"worktree", "add", "--detach", "--", "../linked", "1234567"}, "", nil),
+		},
+		{
+			testName:    "detached with a branch",
+			opts:        AddWorktreeOpts{Path: "../linked", Base: "mybranch", Detach: true, Branch: "feature/x"},
+			runner:      oscommands.NewFakeRunner(t),
+			expectedErr: "cannot specify branch when detaching",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorktreeCommands(commonDeps{runner: s.runner})
+
+			err := instance.AddWithOptions(s.opts)
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
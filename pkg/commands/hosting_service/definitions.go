@@ -54,6 +54,7 @@ var azdoServiceDef = ServiceDefinition{
 	commitURL:                       "/commit/{{.CommitHash}}",
 	regexStrings: []string{
 		`^.+@vs-ssh\.visualstudio\.com[:/](?:v3/)?(?P<org>[^/]+)/(?P<project>[^/]+)/(?P<repo>[^/]+?)(?:\.git)?$`,
+		`^https://(?:.*@)?(?P<org>[^/.@]+)\.visualstudio\.com/(?:DefaultCollection/)?(?P<project>[^/]+)/_git/(?P<repo>[^/]+?)(?:\.git)?$`,
 		`^git@ssh.dev.azure.com.*/(?P<org>.*)/(?P<project>.*)/(?P<repo>.*?)(?:\.git)?$`,
 		`^https://.*@dev.azure.com/(?P<org>.*?)/(?P<project>.*?)/_git/(?P<repo>.*?)(?:\.git)?$`,
 		`^https://.*/(?P<org>.*?)/(?P<project>.*?)/_git/(?P<repo>.*?)(?:\.git)?$`,
@@ -93,6 +94,26 @@ var codebergServiceDef = ServiceDefinition{
 	repoURLTemplate:                 defaultRepoURLTemplate,
 }
 
+var forgejoServiceDef = ServiceDefinition{
+	provider:                        "forgejo",
+	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}",
+	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}",
+	commitURL:                       "/commit/{{.CommitHash}}",
+	regexStrings:                    defaultUrlRegexStrings,
+	repoURLTemplate:                 defaultRepoURLTemplate,
+	repoNameTemplate:                defaultRepoNameTemplate,
+}
+
+// sourcehut has no pull requests; patches are sent to a mailing list instead.
+// The owner includes the leading '~', e.g. '~sircmpwn/scdoc'.
+var sourcehutServiceDef = ServiceDefinition{
+	provider:         "sourcehut",
+	commitURL:        "/commit/{{.CommitHash}}",
+	regexStrings:     defaultUrlRegexStrings,
+	repoURLTemplate:  defaultRepoURLTemplate,
+	repoNameTemplate: defaultRepoNameTemplate,
+}
+
 var serviceDefinitions = []ServiceDefinition{
 	githubServiceDef,
 	bitbucketServiceDef,
@@ -101,6 +122,8 @@ var serviceDefinitions = []ServiceDefinition{
 	bitbucketServerServiceDef,
 	giteaServiceDef,
 	codebergServiceDef,
+	forgejoServiceDef,
+	sourcehutServiceDef,
 }
 
 var defaultServiceDomains = []ServiceDomain{
@@ -124,6 +147,12 @@ var defaultServiceDomains = []ServiceDomain{
 		gitDomain:         "dev.azure.com",
 		webDomain:         "dev.azure.com",
 	},
+	{
+		// the legacy domain of Azure DevOps, e.g. https://org.visualstudio.com/project/_git/repo
+		serviceDefinition: azdoServiceDef,
+		gitDomain:         "visualstudio.com",
+		webDomain:         "dev.azure.com",
+	},
 	{
 		serviceDefinition: giteaServiceDef,
 		gitDomain:         "try.gitea.io",
@@ -134,4 +163,9 @@ var defaultServiceDomains = []ServiceDomain{
 		gitDomain:         "codeberg.org",
 		webDomain:         "codeberg.org",
 	},
+	{
+		serviceDefinition: sourcehutServiceDef,
+		gitDomain:         "git.sr.ht",
+		webDomain:         "git.sr.ht",
+	},
 }
@@ -1,17 +1,18 @@
 package hosting_service
 
 import (
+	"maps"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
-
-	"golang.org/x/exp/slices"
 )
 
 // This package is for handling logic specific to a git hosting service like github, gitlab, bitbucket, gitea, etc.
@@ -26,15 +27,18 @@ type HostingServiceMgr struct {
 
 	// see https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	configServiceDomains map[string]string
+	// see https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-service-types
+	configServiceTypes map[string]config.ServiceTypeConfig
 }
 
 // NewHostingServiceMgr creates new instance of PullRequest
-func NewHostingServiceMgr(log logrus.FieldLogger, tr *i18n.TranslationSet, remoteURL string, configServiceDomains map[string]string) *HostingServiceMgr {
+func NewHostingServiceMgr(log logrus.FieldLogger, tr *i18n.TranslationSet, remoteURL string, configServiceDomains map[string]string, configServiceTypes map[string]config.ServiceTypeConfig) *HostingServiceMgr {
 	return &HostingServiceMgr{
 		log:                  log,
 		tr:                   tr,
 		remoteURL:            remoteURL,
 		configServiceDomains: configServiceDomains,
+		configServiceTypes:   configServiceTypes,
 	}
 }
 
@@ -44,6 +48,10 @@ func (self *HostingServiceMgr) GetPullRequestURL(from string, to string) (string
 		return "", err
 	}
 
+	if gitService.pullRequestURLIntoDefaultBranch == "" {
+		return "", errors.New(self.tr.UnsupportedPullRequestsForGitService)
+	}
+
 	if to == "" {
 		return gitService.getPullRequestURLIntoDefaultBranch(url.QueryEscape(from)), nil
 	}
@@ -73,6 +81,17 @@ func (self *HostingServiceMgr) GetRepoName() (string, error) {
 	return repoName, nil
 }
 
+// Returns the provider of the hosting service (e.g. "gitlab") and the domain
+// of its web interface (e.g. "gitlab.com")
+func (self *HostingServiceMgr) GetProviderAndWebDomain() (string, string, error) {
+	serviceDomain, err := self.getServiceDomain(self.remoteURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	return serviceDomain.serviceDefinition.provider, serviceDomain.webDomain, nil
+}
+
 func (self *HostingServiceMgr) getService() (*Service, error) {
 	serviceDomain, err := self.getServiceDomain(self.remoteURL)
 	if err != nil {
@@ -109,8 +128,13 @@ func (self *HostingServiceMgr) getServiceDomain(repoURL string) (*ServiceDomain,
 }
 
 func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
+	allServiceDefinitions := slices.Clone(serviceDefinitions)
+	for _, provider := range slices.Sorted(maps.Keys(self.configServiceTypes)) {
+		allServiceDefinitions = append(allServiceDefinitions, serviceDefinitionFromConfig(provider, self.configServiceTypes[provider]))
+	}
+
 	serviceDefinitionByProvider := map[string]ServiceDefinition{}
-	for _, serviceDefinition := range serviceDefinitions {
+	for _, serviceDefinition := range allServiceDefinitions {
 		serviceDefinitionByProvider[serviceDefinition.provider] = serviceDefinition
 	}
 
@@ -127,7 +151,7 @@ func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
 
 		serviceDefinition, ok := serviceDefinitionByProvider[provider]
 		if !ok {
-			providerNames := lo.Map(serviceDefinitions, func(serviceDefinition ServiceDefinition, _ int) string {
+			providerNames := lo.Map(allServiceDefinitions, func(serviceDefinition ServiceDefinition, _ int) string {
 				return serviceDefinition.provider
 			})
 
@@ -145,6 +169,18 @@ func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
 	return serviceDomains
 }
 
+func serviceDefinitionFromConfig(provider string, serviceType config.ServiceTypeConfig) ServiceDefinition {
+	return ServiceDefinition{
+		provider:                        provider,
+		pullRequestURLIntoDefaultBranch: serviceType.PullRequestURL,
+		pullRequestURLIntoTargetBranch:  lo.Ternary(serviceType.PullRequestURLIntoTargetBranch != "", serviceType.PullRequestURLIntoTargetBranch, serviceType.PullRequestURL),
+		commitURL:                       serviceType.CommitURL,
+		regexStrings:                    lo.Ternary(len(serviceType.RemoteURLPatterns) > 0, serviceType.RemoteURLPatterns, defaultUrlRegexStrings),
+		repoURLTemplate:                 lo.Ternary(serviceType.RepoURL != "", serviceType.RepoURL, defaultRepoURLTemplate),
+		repoNameTemplate:                defaultRepoNameTemplate,
+	}
+}
+
 // a service domains pairs a service definition with the actual domain it's being served from.
 // Sometimes the git service is hosted in a custom domains so although it'll use say
 // the github service definition, it'll actually be served from e.g. my-custom-github.com
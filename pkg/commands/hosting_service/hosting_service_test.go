@@ -3,6 +3,7 @@ package hosting_service
 import (
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/fakes"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,7 @@ func TestGetPullRequestURL(t *testing.T) {
 		to                   string
 		remoteUrl            string
 		configServiceDomains map[string]string
+		configServiceTypes   map[string]config.ServiceTypeConfig
 		test                 func(url string, err error)
 		expectedLoggedErrors []string
 	}
@@ -459,6 +461,81 @@ func TestGetPullRequestURL(t *testing.T) {
 				assert.Equal(t, "https://codeberg.org/johndoe/myrepo/compare/dev...feature%2Fnew", url)
 			},
 		},
+		{
+			testName:  "Opens a link to new pull request on Azure DevOps (legacy HTTP domain)",
+			from:      "feature/new",
+			remoteUrl: "https://myorg.visualstudio.com/DefaultCollection/myproject/_git/myrepo",
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://dev.azure.com/myorg/myproject/_git/myrepo/pullrequestcreate?sourceRef=feature%2Fnew", url)
+			},
+		},
+		{
+			testName:             "Opens a link to new pull request on Forgejo",
+			from:                 "feature/new",
+			to:                   "dev",
+			remoteUrl:            "git@git.work.com:johndoe/myrepo.git",
+			configServiceDomains: map[string]string{"git.work.com": "forgejo:forgejo.work.com"},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://forgejo.work.com/johndoe/myrepo/compare/dev...feature%2Fnew", url)
+			},
+		},
+		{
+			testName:  "Throws an error for pull requests on sourcehut",
+			from:      "feature/new",
+			remoteUrl: "git@git.sr.ht:~johndoe/myrepo",
+			test: func(url string, err error) {
+				assert.EqualError(t, err, "Creating pull requests is not supported for this git service")
+			},
+		},
+		{
+			testName:             "Opens a link to new pull request on a custom service type",
+			from:                 "feature/new",
+			remoteUrl:            "ssh://git@git.work.com:2222/team/myrepo.git",
+			configServiceDomains: map[string]string{"git.work.com": "myforge:code.work.com"},
+			configServiceTypes: map[string]config.ServiceTypeConfig{
+				"myforge": {
+					RepoURL:        "https://{{.webDomain}}/repos/{{.owner}}/{{.repo}}",
+					PullRequestURL: "/reviews/new?branch={{.From}}",
+				},
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://code.work.com/repos/team/myrepo/reviews/new?branch=feature%2Fnew", url)
+			},
+		},
+		{
+			testName:             "Falls back to the default branch URL on a custom service type without target branch URL",
+			from:                 "feature/new",
+			to:                   "dev",
+			remoteUrl:            "git@git.work.com:team/myrepo.git",
+			configServiceDomains: map[string]string{"git.work.com": "myforge:code.work.com"},
+			configServiceTypes: map[string]config.ServiceTypeConfig{
+				"myforge": {PullRequestURL: "/reviews/new?branch={{.From}}"},
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://code.work.com/team/myrepo/reviews/new?branch=feature%2Fnew", url)
+			},
+		},
+		{
+			testName:             "Uses custom remote URL patterns of a custom service type",
+			from:                 "feature/new",
+			remoteUrl:            "https://git.work.com/git/team/sub/myrepo.git",
+			configServiceDomains: map[string]string{"git.work.com": "myforge:code.work.com"},
+			configServiceTypes: map[string]config.ServiceTypeConfig{
+				"myforge": {
+					RemoteURLPatterns: []string{`^https://[^/]+/git/(?P<group>.*)/(?P<repo>[^/]*?)(?:\.git)?$`},
+					RepoURL:           "https://{{.webDomain}}/{{.group}}/-/{{.repo}}",
+					PullRequestURL:    "/pulls/new/{{.From}}",
+				},
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://code.work.com/team/sub/-/myrepo/pulls/new/feature%2Fnew", url)
+			},
+		},
 		{
 			testName:  "Throws an error if git service is unsupported",
 			from:      "feature/divide-operation",
@@ -543,7 +620,7 @@ func TestGetPullRequestURL(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, "https://bitbucket.org/johndoe/social_network/pull-requests/new?source=feature%2Fprofile-page&t=1", url)
 			},
-			expectedLoggedErrors: []string{"Unknown git service type: 'noservice'. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer, gitea, codeberg"},
+			expectedLoggedErrors: []string{"Unknown git service type: 'noservice'. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer, gitea, codeberg, forgejo, sourcehut"},
 		},
 		{
 			testName:  "Escapes reserved URL characters in from branch name",
@@ -571,7 +648,7 @@ func TestGetPullRequestURL(t *testing.T) {
 		t.Run(s.testName, func(t *testing.T) {
 			tr := i18n.EnglishTranslationSet()
 			log := &fakes.FakeFieldLogger{}
-			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains)
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains, s.configServiceTypes)
 			s.test(hostingServiceMgr.GetPullRequestURL(s.from, s.to))
 			log.AssertErrors(t, s.expectedLoggedErrors)
 		})
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// A line of a file as shown by `git blame`, together with the commit that
+// last changed it
+type BlameLine struct {
+	Hash       string
+	Author     string
+	AuthorTime int64 // unix timestamp
+	Summary    string
+	// 1-based line number in the blamed version of the file
+	LineNumber int
+	Content    string
+}
+
+// The hash that git blame uses for lines that haven't been committed yet
+const uncommittedBlameHash = "0000000000000000000000000000000000000000"
+
+func (self *BlameLine) ShortHash() string {
+	return utils.ShortHash(self.Hash)
+}
+
+func (self *BlameLine) IsUncommitted() bool {
+	return self.Hash == uncommittedBlameHash
+}
+
+func (self *BlameLine) ID() string {
+	return strconv.Itoa(self.LineNumber)
+}
+
+func (self *BlameLine) URN() string {
+	return "blame-line-" + self.ID()
+}
+
+func (self *BlameLine) Description() string {
+	return self.Content
+}
@@ -13,6 +13,8 @@ type Branch struct {
 	DisplayName string
 	// indicator of when the branch was last checked out e.g. '2d', '3m'
 	Recency string
+	// unix timestamp that the recency was derived from; 0 if unknown
+	RecencyTimestamp int64
 	// how many commits ahead we are from the remote branch (how many commits we can push, assuming we push to our tracked remote branch)
 	AheadForPull string
 	// how many commits behind we are from the remote branch (how many commits we can pull)
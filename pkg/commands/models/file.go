@@ -25,6 +25,14 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// Whether the file matches a `filter=lfs` pattern in .gitattributes, i.e.
+	// is meant to be stored in git-lfs
+	IsLfs bool
+
+	// Whether the working copy of an lfs file is just the lfs pointer rather
+	// than the actual content, e.g. because the object hasn't been downloaded
+	IsLfsPointer bool
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
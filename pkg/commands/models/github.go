@@ -7,6 +7,7 @@ type GithubPullRequest struct {
 	State               string                `json:"state"` // "MERGED", "OPEN", "CLOSED", "DRAFT"
 	Url                 string                `json:"url"`
 	HeadRepositoryOwner GithubRepositoryOwner `json:"headRepositoryOwner"`
+	ReviewDecision      string                `json:"reviewDecision"` // "APPROVED", "CHANGES_REQUESTED", "REVIEW_REQUIRED", or "" if no review is required
 }
 
 func (pr *GithubPullRequest) UserName() string {
@@ -19,6 +20,10 @@ func (pr *GithubPullRequest) BranchName() string {
 	return pr.HeadRefName
 }
 
+func (pr *GithubPullRequest) IsOpen() bool {
+	return pr.State == "OPEN" || pr.State == "DRAFT"
+}
+
 type GithubRepositoryOwner struct {
 	Login string `json:"login"`
 }
@@ -0,0 +1,36 @@
+package models
+
+import "github.com/samber/lo"
+
+// The combined state of the CI checks of a commit, or of a single check.
+// GitHub has more states than these, but these are the ones we care about.
+type CIState string
+
+const (
+	CIStatePending CIState = "PENDING"
+	CIStateSuccess CIState = "SUCCESS"
+	CIStateFailure CIState = "FAILURE"
+)
+
+// GithubCommitStatus is the result of the checks (check runs of GitHub Actions
+// and the like, as well as legacy commit statuses) that ran on a commit
+type GithubCommitStatus struct {
+	State  CIState
+	Checks []*GithubCheck
+}
+
+type GithubCheck struct {
+	Name  string
+	State CIState
+	// Link to the job's page, e.g. the GitHub Actions log
+	Url string
+}
+
+// FailingCheck returns the first failed check that has a URL we can open, or
+// nil if there isn't one.
+func (self *GithubCommitStatus) FailingCheck() *GithubCheck {
+	check, _ := lo.Find(self.Checks, func(check *GithubCheck) bool {
+		return check.State == CIStateFailure && check.Url != ""
+	})
+	return check
+}
@@ -0,0 +1,13 @@
+package models
+
+// Hook : A script in the repo's hooks directory
+type Hook struct {
+	Name string
+	Path string
+	// Git only runs hooks that are executable
+	Executable bool
+}
+
+func (h *Hook) ID() string {
+	return h.Name
+}
@@ -0,0 +1,9 @@
+package models
+
+// A lock on a file that is stored in git-lfs, which stops others from pushing
+// changes to it
+type LfsLock struct {
+	Id    string
+	Path  string
+	Owner string
+}
@@ -6,6 +6,20 @@ type RemoteBranch struct {
 	RemoteName string
 }
 
+// RemoteBranchDivergence describes how far a remote branch has diverged from
+// the local branch that tracks it
+type RemoteBranchDivergence struct {
+	LocalBranchName string
+	// number of commits on the remote branch that the local branch doesn't have
+	Ahead string
+	// number of commits on the local branch that the remote branch doesn't have
+	Behind string
+}
+
+func (d *RemoteBranchDivergence) InSync() bool {
+	return d.Ahead == "0" && d.Behind == "0"
+}
+
 func (r *RemoteBranch) FullName() string {
 	return r.RemoteName + "/" + r.Name
 }
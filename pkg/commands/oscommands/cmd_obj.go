@@ -1,6 +1,7 @@
 package oscommands
 
 import (
+	"context"
 	"os/exec"
 	"strings"
 
@@ -31,6 +32,12 @@ type CmdObj struct {
 	// see IgnoreEmptyError()
 	ignoreEmptyError bool
 
+	// see ReportProgress()
+	reportProgress bool
+
+	// see Cancellable()
+	cancellable bool
+
 	// if set to true, it means we might be asked to enter a username/password by this command.
 	credentialStrategy CredentialStrategy
 	task               gocui.Task
@@ -170,6 +177,59 @@ func (self *CmdObj) ShouldIgnoreEmptyError() bool {
 	return self.ignoreEmptyError
 }
 
+// when you call this, then call Run(), we'll parse progress lines from the
+// command's stderr (e.g. 'Receiving objects:  45% (450/1000)') and report
+// them to the gui, which shows them as a progress bar. The command needs to
+// be told to output progress, e.g. with git's --progress flag. Progress lines
+// are not written to the command log.
+func (self *CmdObj) ReportProgress() *CmdObj {
+	self.reportProgress = true
+
+	return self
+}
+
+// returns true if ReportProgress() was called
+func (self *CmdObj) ShouldReportProgress() bool {
+	return self.reportProgress
+}
+
+// when you call this as well as ReportProgress(), the user can cancel the
+// command while its progress is shown. We interrupt it with SIGINT, like
+// pressing ctrl-c would, and wait for it to exit. Only use this for commands
+// that can be interrupted at any point without leaving the repo in a bad state
+// (e.g. fetch, but not checkout or pull, which update the working tree).
+func (self *CmdObj) Cancellable() *CmdObj {
+	self.cancellable = true
+
+	return self
+}
+
+// returns true if Cancellable() was called
+func (self *CmdObj) IsCancellable() bool {
+	return self.cancellable
+}
+
+// Kills the command if the context is done before the command finishes. A nil
+// context is ignored, so that callers can pass along an optional one.
+func (self *CmdObj) WithContext(ctx context.Context) *CmdObj {
+	if ctx == nil {
+		return self
+	}
+
+	cmd := exec.CommandContext(ctx, self.cmd.Path)
+	cmd.Args = self.cmd.Args
+	cmd.Err = self.cmd.Err
+	cmd.Env = self.cmd.Env
+	cmd.Dir = self.cmd.Dir
+	cmd.Stdin = self.cmd.Stdin
+	cmd.Stdout = self.cmd.Stdout
+	cmd.Stderr = self.cmd.Stderr
+	cmd.SysProcAttr = self.cmd.SysProcAttr
+	self.cmd = cmd
+
+	return self
+}
+
 func (self *CmdObj) Mutex() *deadlock.Mutex {
 	return self.mutex
 }
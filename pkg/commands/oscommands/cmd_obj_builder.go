@@ -1,6 +1,7 @@
 package oscommands
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -33,6 +34,12 @@ func (self *CmdObjBuilder) New(args []string) *CmdObj {
 	return cmdObj
 }
 
+// Like New, but the command is killed if the context is done before it
+// finishes
+func (self *CmdObjBuilder) NewWithContext(ctx context.Context, args []string) *CmdObj {
+	return self.New(args).WithContext(ctx)
+}
+
 // A command with explicit environment from env
 func (self *CmdObjBuilder) NewWithEnviron(args []string, env []string) *CmdObj {
 	cmd := exec.Command(args[0], args[1:]...)
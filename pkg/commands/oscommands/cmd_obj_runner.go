@@ -4,12 +4,15 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"os"
 	"os/exec"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
 	"github.com/sirupsen/logrus"
@@ -23,8 +26,9 @@ type ICmdObjRunner interface {
 }
 
 type cmdObjRunner struct {
-	log   *logrus.Entry
-	guiIO *guiIO
+	log     *logrus.Entry
+	guiIO   *guiIO
+	timings *common.Timings
 }
 
 var _ ICmdObjRunner = &cmdObjRunner{}
@@ -39,7 +43,7 @@ func (self *cmdObjRunner) Run(cmdObj *CmdObj) error {
 		return self.runWithCredentialHandling(cmdObj)
 	}
 
-	if cmdObj.ShouldStreamOutput() {
+	if cmdObj.ShouldStreamOutput() || cmdObj.ShouldReportProgress() {
 		return self.runAndStream(cmdObj)
 	}
 
@@ -105,12 +109,13 @@ func (self *cmdObjRunner) RunWithOutputAux(cmdObj *CmdObj) (string, error) {
 	}
 
 	t := time.Now()
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	rawOutput, err := cmdObj.GetCmd().CombinedOutput()
+	output, err := sanitisedCommandOutput(self.handleSparseIndexExpansion(cmdObj, rawOutput), err)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
 	}
 
-	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	self.logDuration(cmdObj, t)
 
 	return output, err
 }
@@ -129,10 +134,10 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj *CmdObj) (string, string, err
 	cmd.Stderr = &errBuffer
 	err := cmd.Run()
 
-	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	self.logDuration(cmdObj, t)
 
 	stdout := outBuffer.String()
-	stderr, err := sanitisedCommandOutput(errBuffer.Bytes(), err)
+	stderr, err := sanitisedCommandOutput(self.handleSparseIndexExpansion(cmdObj, errBuffer.Bytes()), err)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(stderr)
 	}
@@ -187,15 +192,50 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj *CmdObj, onLine func(line st
 
 	_ = cmd.Wait()
 
-	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	self.logDuration(cmdObj, t)
 
 	return nil
 }
 
+func (self *cmdObjRunner) logDuration(cmdObj *CmdObj, start time.Time) {
+	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(start))
+	self.timings.Record(common.TimingGitCommand, cmdObj.ToString(), start)
+}
+
 func (self *cmdObjRunner) logCmdObj(cmdObj *CmdObj) {
 	self.guiIO.logCommandFn(cmdObj.ToString(), true)
 }
 
+// The start of the advice that git prints to stderr when a command has to expand
+// a sparse index to a full one
+var sparseIndexExpandedAdvice = []byte("The sparse index is expanding to a full index")
+
+// Tells the GUI if the command expanded the sparse index, and removes git's
+// advice about it from the output so that it doesn't get in the way of callers
+// that parse the output
+func (self *cmdObjRunner) handleSparseIndexExpansion(cmdObj *CmdObj, output []byte) []byte {
+	if !bytes.Contains(output, sparseIndexExpandedAdvice) {
+		return output
+	}
+
+	self.guiIO.sparseIndexExpandedFn(cmdObj.ToString())
+
+	result := make([]byte, 0, len(output))
+	inAdvice := false
+	for _, line := range bytes.SplitAfter(output, []byte("\n")) {
+		if bytes.Contains(line, sparseIndexExpandedAdvice) {
+			inAdvice = true
+			continue
+		}
+		if inAdvice && bytes.HasPrefix(line, []byte("hint:")) {
+			continue
+		}
+		inAdvice = false
+		result = append(result, line...)
+	}
+	return result
+}
+
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
 	outputString := string(output)
 	if err != nil {
@@ -244,6 +284,35 @@ func (self *cmdObjRunner) runAndStreamAux(
 	var stderr bytes.Buffer
 	cmd.Stderr = io.MultiWriter(cmdWriter, &stderr)
 
+	var cancelled atomic.Bool
+	var progressWriters []*progressWriter
+	if cmdObj.ShouldReportProgress() {
+		progressReporter := self.guiIO.newProgressReporterFn()
+		var cancel func()
+		if cmdObj.IsCancellable() {
+			cancel = func() {
+				cancelled.Store(true)
+				// Interrupting lets git clean up, e.g. remove its lock files;
+				// on Windows, where that isn't supported, we have to kill it
+				if err := cmd.Process.Signal(os.Interrupt); err != nil {
+					_ = cmd.Process.Kill()
+				}
+			}
+		}
+		progressWriters = []*progressWriter{
+			newProgressWriter(cmdWriter, func(phase string, percent int) {
+				progressReporter.SetProgress(&Progress{
+					Phase:   phase,
+					Percent: percent,
+					Cancel:  cancel,
+				})
+			}),
+			newProgressFilter(&stderr),
+		}
+		cmd.Stderr = io.MultiWriter(progressWriters[0], progressWriters[1])
+		defer progressReporter.Done()
+	}
+
 	var handler *cmdHandler
 	var err error
 	if cmdObj.ShouldUsePty() {
@@ -270,7 +339,15 @@ func (self *cmdObjRunner) runAndStreamAux(
 
 	err = cmd.Wait()
 
-	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
+	for _, progressWriter := range progressWriters {
+		_ = progressWriter.Flush()
+	}
+
+	self.logDuration(cmdObj, t)
+
+	if err != nil && cancelled.Load() {
+		return ErrCommandCancelled
+	}
 
 	if err != nil {
 		if cmdObj.suppressOutputUnlessError {
@@ -5,14 +5,17 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
 )
 
 func getRunner() *cmdObjRunner {
 	log := utils.NewDummyLog()
 	return &cmdObjRunner{
-		log:   log,
-		guiIO: NewNullGuiIO(log),
+		log:     log,
+		guiIO:   NewNullGuiIO(log),
+		timings: common.NewTimings(),
 	}
 }
 
@@ -135,3 +138,23 @@ func TestProcessOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleSparseIndexExpansion(t *testing.T) {
+	runner := getRunner()
+	reportedCmds := []string{}
+	runner.guiIO.sparseIndexExpandedFn = func(cmdStr string) {
+		reportedCmds = append(reportedCmds, cmdStr)
+	}
+	cmdObj := NewDummyCmdObjBuilder(runner).New([]string{"git", "ls-files"})
+
+	output := "file1\n" +
+		"hint: The sparse index is expanding to a full index, a slow operation.\n" +
+		"hint: Your working directory likely has contents that are outside of\n" +
+		"hint: your sparse-checkout patterns.\n" +
+		"file2\n"
+	assert.Equal(t, "file1\nfile2\n", string(runner.handleSparseIndexExpansion(cmdObj, []byte(output))))
+	assert.Equal(t, []string{"git ls-files"}, reportedCmds)
+
+	assert.Equal(t, "file1\n", string(runner.handleSparseIndexExpansion(cmdObj, []byte("file1\n"))))
+	assert.Len(t, reportedCmds, 1)
+}
@@ -1,10 +1,14 @@
 package oscommands
 
 import (
+	"context"
+	"os"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCmdObjToString(t *testing.T) {
@@ -52,3 +56,26 @@ func TestClone(t *testing.T) {
 		t.Errorf("Clone should have the same task")
 	}
 }
+
+func TestCmdObjWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).
+		New([]string{"sleep", "10"}).
+		SetWd(os.TempDir()).
+		WithContext(ctx)
+	assert.Equal(t, []string{"sleep", "10"}, cmdObj.Args())
+	assert.Equal(t, os.TempDir(), cmdObj.GetCmd().Dir)
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := cmdObj.RunWithOutput()
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestCmdObjWithNilContext(t *testing.T) {
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).New([]string{"echo", "hi"})
+	cmd := cmdObj.GetCmd()
+	var ctx context.Context
+	assert.Same(t, cmd, cmdObj.WithContext(ctx).GetCmd())
+}
@@ -14,14 +14,15 @@ func NewDummyOSCommand() *OSCommand {
 }
 
 type OSCommandDeps struct {
-	Common       *common.Common
-	Platform     *Platform
-	GetenvFn     func(string) string
-	RemoveFileFn func(string) error
-	IsDirEmptyFn func(string) (bool, error)
-	RemoveDirFn  func(string) error
-	Cmd          *CmdObjBuilder
-	TempDir      string
+	Common        *common.Common
+	Platform      *Platform
+	GetenvFn      func(string) string
+	UserHomeDirFn func() (string, error)
+	RemoveFileFn  func(string) error
+	IsDirEmptyFn  func(string) (bool, error)
+	RemoveDirFn   func(string) error
+	Cmd           *CmdObjBuilder
+	TempDir       string
 }
 
 func NewDummyOSCommandWithDeps(deps OSCommandDeps) *OSCommand {
@@ -36,14 +37,15 @@ func NewDummyOSCommandWithDeps(deps OSCommandDeps) *OSCommand {
 	}
 
 	return &OSCommand{
-		Common:       cmn,
-		Platform:     platform,
-		getenvFn:     deps.GetenvFn,
-		removeFileFn: deps.RemoveFileFn,
-		isDirEmptyFn: deps.IsDirEmptyFn,
-		removeDirFn:  deps.RemoveDirFn,
-		guiIO:        NewNullGuiIO(utils.NewDummyLog()),
-		tempDir:      deps.TempDir,
+		Common:        cmn,
+		Platform:      platform,
+		getenvFn:      deps.GetenvFn,
+		userHomeDirFn: deps.UserHomeDirFn,
+		removeFileFn:  deps.RemoveFileFn,
+		isDirEmptyFn:  deps.IsDirEmptyFn,
+		removeDirFn:   deps.RemoveDirFn,
+		guiIO:         NewNullGuiIO(utils.NewDummyLog()),
+		tempDir:       deps.TempDir,
 	}
 }
 
@@ -27,6 +27,14 @@ type guiIO struct {
 	// that a command requests it.
 	// the 'credential' arg is something like 'username' or 'password'
 	promptForCredentialFn func(credential CredentialType) <-chan string
+	// this is for reporting the progress of commands that were run with
+	// ReportProgress(), so that the GUI can show a progress bar. We need a new
+	// reporter per command, hence it being a function.
+	newProgressReporterFn func() ProgressReporter
+	// this is for telling the user that a command expanded the repo's sparse
+	// index to a full index, which can be very slow in the huge repos that use
+	// sparse indexes.
+	sparseIndexExpandedFn func(cmdStr string)
 }
 
 func NewGuiIO(
@@ -34,12 +42,16 @@ func NewGuiIO(
 	logCommandFn func(string, bool),
 	newCmdWriterFn func() io.Writer,
 	promptForCredentialFn func(CredentialType) <-chan string,
+	newProgressReporterFn func() ProgressReporter,
+	sparseIndexExpandedFn func(string),
 ) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
+		newProgressReporterFn: newProgressReporterFn,
+		sparseIndexExpandedFn: sparseIndexExpandedFn,
 	}
 }
 
@@ -51,5 +63,7 @@ func NewNullGuiIO(log *logrus.Entry) *guiIO {
 		logCommandFn:          func(string, bool) {},
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
+		newProgressReporterFn: func() ProgressReporter { return nullProgressReporter{} },
+		sparseIndexExpandedFn: func(string) {},
 	}
 }
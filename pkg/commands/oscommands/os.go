@@ -20,13 +20,16 @@ import (
 // OSCommand holds all the os commands
 type OSCommand struct {
 	*common.Common
-	Platform *Platform
-	getenvFn func(string) string
-	guiIO    *guiIO
+	Platform      *Platform
+	getenvFn      func(string) string
+	userHomeDirFn func() (string, error)
+	guiIO         *guiIO
 
 	removeFileFn func(string) error
 	isDirEmptyFn func(string) (bool, error)
 	removeDirFn  func(string) error
+	// for writing escape sequences straight to the terminal
+	openTerminalFn func() (io.WriteCloser, error)
 
 	Cmd *CmdObjBuilder
 
@@ -46,17 +49,19 @@ type Platform struct {
 // NewOSCommand os command runner
 func NewOSCommand(common *common.Common, config config.AppConfigurer, platform *Platform, guiIO *guiIO) *OSCommand {
 	c := &OSCommand{
-		Common:       common,
-		Platform:     platform,
-		getenvFn:     os.Getenv,
-		removeFileFn: os.RemoveAll,
-		isDirEmptyFn: isDirEmpty,
-		removeDirFn:  os.Remove,
-		guiIO:        guiIO,
-		tempDir:      config.GetTempDir(),
+		Common:         common,
+		Platform:       platform,
+		getenvFn:       os.Getenv,
+		userHomeDirFn:  os.UserHomeDir,
+		removeFileFn:   os.RemoveAll,
+		isDirEmptyFn:   isDirEmpty,
+		removeDirFn:    os.Remove,
+		openTerminalFn: openTerminal,
+		guiIO:          guiIO,
+		tempDir:        config.GetTempDir(),
 	}
 
-	runner := &cmdObjRunner{log: common.Log, guiIO: guiIO}
+	runner := &cmdObjRunner{log: common.Log, guiIO: guiIO, timings: common.Timings}
 	c.Cmd = &CmdObjBuilder{runner: runner, platform: platform}
 
 	return c
@@ -277,6 +282,9 @@ func (c *OSCommand) CopyToClipboard(str string) error {
 		},
 	)
 	c.LogCommand(msg, false)
+	if c.shouldCopyWithOSC52() {
+		return c.copyWithOSC52(str)
+	}
 	if c.UserConfig().OS.CopyToClipboardCmd != "" {
 		cmdStr := utils.ResolvePlaceholderString(c.UserConfig().OS.CopyToClipboardCmd, map[string]string{
 			"text": c.Cmd.Quote(str),
@@ -349,6 +357,10 @@ func (c *OSCommand) Getenv(key string) string {
 	return c.getenvFn(key)
 }
 
+func (c *OSCommand) UserHomeDir() (string, error) {
+	return c.userHomeDirFn()
+}
+
 func (c *OSCommand) GetTempDir() string {
 	return c.tempDir
 }
@@ -3,6 +3,7 @@
 package oscommands
 
 import (
+	"io"
 	"os"
 	"os/exec"
 	"runtime"
@@ -36,6 +37,10 @@ func getUserShell() string {
 	return "bash"
 }
 
+func openTerminal() (io.WriteCloser, error) {
+	return os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+}
+
 func (c *OSCommand) UpdateWindowTitle() error {
 	return nil
 }
@@ -1,6 +1,8 @@
 package oscommands
 
 import (
+	"bytes"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -194,3 +196,64 @@ func TestOSCommandAppendLineToFile(t *testing.T) {
 		_ = os.RemoveAll(s.path)
 	}
 }
+
+type nopCloseBuffer struct {
+	bytes.Buffer
+}
+
+func (b *nopCloseBuffer) Close() error { return nil }
+
+func TestOSCommandCopyToClipboardWithOSC52(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setting  string
+		env      map[string]string
+		expected string
+	}{
+		{
+			name:     "never",
+			setting:  "never",
+			env:      map[string]string{"SSH_TTY": "/dev/pts/1"},
+			expected: "",
+		},
+		{
+			name:     "always",
+			setting:  "always",
+			env:      map[string]string{},
+			expected: "\x1b]52;c;aGVsbG8=\a",
+		},
+		{
+			name:     "always, inside tmux",
+			setting:  "always",
+			env:      map[string]string{"TMUX": "/tmp/tmux-1000/default,1,0"},
+			expected: "\x1bPtmux;\x1b\x1b]52;c;aGVsbG8=\a\x1b\\",
+		},
+		{
+			name:     "ssh, in an SSH session",
+			setting:  "ssh",
+			env:      map[string]string{"SSH_CONNECTION": "10.0.0.1 5000 10.0.0.2 22"},
+			expected: "\x1b]52;c;aGVsbG8=\a",
+		},
+		{
+			name:     "ssh, not in an SSH session",
+			setting:  "ssh",
+			env:      map[string]string{},
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			osCommand := NewDummyOSCommand()
+			// so that we don't touch the system clipboard when OSC52 isn't used
+			osCommand.UserConfig().OS.CopyToClipboardCmd = "true"
+			osCommand.UserConfig().OS.CopyToClipboardWithOSC52 = s.setting
+			osCommand.getenvFn = func(key string) string { return s.env[key] }
+			terminal := &nopCloseBuffer{}
+			osCommand.openTerminalFn = func() (io.WriteCloser, error) { return terminal, nil }
+
+			assert.NoError(t, osCommand.CopyToClipboard("hello"))
+			assert.Equal(t, s.expected, terminal.String())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package oscommands
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -15,6 +16,10 @@ func GetPlatform() *Platform {
 	}
 }
 
+func openTerminal() (io.WriteCloser, error) {
+	return os.OpenFile("CONOUT$", os.O_WRONLY, 0)
+}
+
 func (c *OSCommand) UpdateWindowTitle() error {
 	path, getWdErr := os.Getwd()
 	if getWdErr != nil {
@@ -0,0 +1,42 @@
+package oscommands
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// OSC52 is an escape sequence that asks the terminal to put some text into the
+// system clipboard. Since it travels along with the rest of our output, it
+// works over SSH without needing a clipboard tool on the remote machine.
+
+func (c *OSCommand) shouldCopyWithOSC52() bool {
+	switch c.UserConfig().OS.CopyToClipboardWithOSC52 {
+	case "always":
+		return true
+	case "ssh":
+		return c.getenvFn("SSH_TTY") != "" || c.getenvFn("SSH_CONNECTION") != ""
+	}
+	return false
+}
+
+func (c *OSCommand) copyWithOSC52(str string) error {
+	terminal, err := c.openTerminalFn()
+	if err != nil {
+		return err
+	}
+	defer terminal.Close()
+
+	_, err = terminal.Write([]byte(osc52Sequence(str, c.getenvFn("TMUX") != "")))
+	return err
+}
+
+// Inside tmux, the sequence needs to be wrapped in tmux's passthrough sequence
+// so that tmux forwards it to the outer terminal (this requires tmux's
+// allow-passthrough option to be on)
+func osc52Sequence(str string, inTmux bool) string {
+	sequence := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(str)) + "\a"
+	if inTmux {
+		return "\x1bPtmux;" + strings.ReplaceAll(sequence, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	return sequence
+}
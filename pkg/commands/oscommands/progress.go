@@ -0,0 +1,162 @@
+package oscommands
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/go-errors/errors"
+)
+
+// Progress is the state of a long-running command that was run with
+// ReportProgress()
+type Progress struct {
+	// The phase that the command is in, e.g. 'Receiving objects'
+	Phase   string
+	Percent int
+	// Aborts the command; it then returns ErrCommandCancelled. Nil if the
+	// command can't be cancelled (see CmdObj.Cancellable).
+	Cancel func()
+}
+
+// ProgressReporter shows the progress of a single command in the gui. We get a
+// new one for every command, so that commands that run concurrently don't get
+// in each other's way.
+type ProgressReporter interface {
+	SetProgress(progress *Progress)
+	// Called when the command is done, to remove its progress again
+	Done()
+}
+
+type nullProgressReporter struct{}
+
+func (nullProgressReporter) SetProgress(*Progress) {}
+func (nullProgressReporter) Done()                 {}
+
+// ErrCommandCancelled is returned when a command was aborted via the Cancel
+// func of its Progress.
+var ErrCommandCancelled = errors.New("Command cancelled")
+
+// matches git's progress lines, e.g. 'Receiving objects:  45% (450/1000)' or
+// 'remote: Counting objects: 100% (12/12), done.'
+var progressLineRegex = regexp.MustCompile(`^(?:remote: )?([A-Za-z][A-Za-z ]*[A-Za-z]):\s+(\d{1,3})%`)
+
+func parseProgressLine(line []byte) (string, int, bool) {
+	match := progressLineRegex.FindSubmatch(line)
+	if match == nil {
+		return "", 0, false
+	}
+
+	percent, err := strconv.Atoi(string(match[2]))
+	if err != nil || percent > 100 {
+		return "", 0, false
+	}
+
+	return string(match[1]), percent, true
+}
+
+// progressWriter sits between a command's stderr and the writer that it would
+// otherwise be written to. It reports progress lines to onProgress and passes
+// everything else on. Git terminates intermediate progress lines with '\r'
+// and the final one of each phase with '\n'; we drop the former so that they
+// don't flood the command log, but keep the latter as a summary.
+type progressWriter struct {
+	writer     io.Writer
+	onProgress func(phase string, percent int)
+	// if true, the final progress lines are dropped too
+	dropSummaries bool
+	// an incomplete line from the previous write
+	pending []byte
+	// whether we already reported the progress of the pending line
+	pendingReported bool
+}
+
+func newProgressWriter(writer io.Writer, onProgress func(phase string, percent int)) *progressWriter {
+	return &progressWriter{writer: writer, onProgress: onProgress}
+}
+
+// returns a writer that passes on everything except progress lines; we use
+// this for the output that ends up in error messages
+func newProgressFilter(writer io.Writer) *progressWriter {
+	return &progressWriter{writer: writer, onProgress: func(string, int) {}, dropSummaries: true}
+}
+
+func (self *progressWriter) Write(p []byte) (int, error) {
+	self.pending = append(self.pending, p...)
+
+	for {
+		index := bytes.IndexAny(self.pending, "\r\n")
+		if index == -1 {
+			break
+		}
+
+		// treat '\r\n' as a single line terminator; if the '\r' is the last
+		// byte we got so far, we have to wait for the next write to know. We
+		// report the progress right away though, since git waits before
+		// writing the next line.
+		end := index + 1
+		isIntermediate := self.pending[index] == '\r'
+		if isIntermediate && end == len(self.pending) {
+			self.reportPending()
+			break
+		}
+		if isIntermediate && self.pending[end] == '\n' {
+			end++
+			isIntermediate = false
+		}
+
+		line := self.pending[:end]
+		self.pending = self.pending[end:]
+		alreadyReported := self.pendingReported
+		self.pendingReported = false
+
+		phase, percent, ok := parseProgressLine(line)
+		if ok {
+			if !alreadyReported {
+				self.onProgress(phase, percent)
+			}
+			if isIntermediate || self.dropSummaries {
+				continue
+			}
+		}
+
+		if _, err := self.writer.Write(line); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (self *progressWriter) reportPending() {
+	if self.pendingReported {
+		return
+	}
+
+	if phase, percent, ok := parseProgressLine(self.pending); ok {
+		self.onProgress(phase, percent)
+	}
+	self.pendingReported = true
+}
+
+// Flush writes what is left at the end of the output, unless it's a progress
+// line
+func (self *progressWriter) Flush() error {
+	self.reportPending()
+	pending := self.pending
+	self.pending = nil
+	self.pendingReported = false
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if _, _, ok := parseProgressLine(pending); ok {
+		if bytes.HasSuffix(pending, []byte("\r")) || self.dropSummaries {
+			return nil
+		}
+	}
+
+	_, err := self.writer.Write(pending)
+	return err
+}
@@ -0,0 +1,134 @@
+package oscommands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressWriter(t *testing.T) {
+	scenarios := []struct {
+		name             string
+		writes           []string
+		dropSummaries    bool
+		expectedOutput   string
+		expectedProgress []string
+	}{
+		{
+			name:             "no progress",
+			writes:           []string{"To github.com:foo/bar.git\n", "   abc..def  main -> main\n"},
+			expectedOutput:   "To github.com:foo/bar.git\n   abc..def  main -> main\n",
+			expectedProgress: []string{},
+		},
+		{
+			name: "intermediate progress lines are dropped, summaries are kept",
+			writes: []string{
+				"remote: Counting objects:  50% (1/2)\r",
+				"remote: Counting objects: 100% (2/2), done.\n",
+				"Receiving objects:  10% (1/10)\rReceiving objects:  60% (6/10)\r",
+				"Receiving objects: 100% (10/10), done.\n",
+				"From github.com:foo/bar\n",
+			},
+			expectedOutput: "remote: Counting objects: 100% (2/2), done.\nReceiving objects: 100% (10/10), done.\nFrom github.com:foo/bar\n",
+			expectedProgress: []string{
+				"Counting objects 50", "Counting objects 100", "Receiving objects 10",
+				"Receiving objects 60", "Receiving objects 100",
+			},
+		},
+		{
+			name:             "summaries are dropped too if requested",
+			writes:           []string{"Updating files:  50% (1/2)\rUpdating files: 100% (2/2), done.\n", "Switched to branch 'foo'\n"},
+			dropSummaries:    true,
+			expectedOutput:   "Switched to branch 'foo'\n",
+			expectedProgress: []string{"Updating files 50", "Updating files 100"},
+		},
+		{
+			name:             "lines split across writes",
+			writes:           []string{"Writing obj", "ects:  50% (1/2)\r", "\n", "fatal: boom", "\n"},
+			expectedOutput:   "Writing objects:  50% (1/2)\r\nfatal: boom\n",
+			expectedProgress: []string{"Writing objects 50"},
+		},
+		{
+			name:             "incomplete lines are flushed",
+			writes:           []string{"Resolving deltas:  50% (1/2)\r", "error: no newline"},
+			expectedOutput:   "error: no newline",
+			expectedProgress: []string{"Resolving deltas 50"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			progress := []string{}
+			writer := newProgressWriter(output, func(phase string, percent int) {
+				progress = append(progress, fmt.Sprintf("%s %d", phase, percent))
+			})
+			writer.dropSummaries = s.dropSummaries
+
+			for _, write := range s.writes {
+				n, err := writer.Write([]byte(write))
+				assert.NoError(t, err)
+				assert.Equal(t, len(write), n)
+			}
+			assert.NoError(t, writer.Flush())
+
+			assert.Equal(t, s.expectedOutput, output.String())
+			assert.Equal(t, s.expectedProgress, progress)
+		})
+	}
+}
+
+func TestRunWithProgressCanBeCancelled(t *testing.T) {
+	runner := getRunner()
+	runner.guiIO.newCmdWriterFn = func() io.Writer { return io.Discard }
+
+	reporter := &fakeProgressReporter{}
+	runner.guiIO.newProgressReporterFn = func() ProgressReporter { return reporter }
+
+	cmdObj := NewDummyCmdObjBuilder(runner).
+		New([]string{"sh", "-c", `printf 'Receiving objects:  50%% (1/2)\r' >&2; sleep 10`}).
+		ReportProgress().
+		Cancellable()
+
+	err := cmdObj.Run()
+	assert.ErrorIs(t, err, ErrCommandCancelled)
+	assert.Len(t, reporter.reports, 1)
+	assert.Equal(t, "Receiving objects", reporter.reports[0].Phase)
+	assert.Equal(t, 50, reporter.reports[0].Percent)
+	assert.True(t, reporter.done)
+}
+
+func TestRunWithProgressIsNotCancellableByDefault(t *testing.T) {
+	runner := getRunner()
+	runner.guiIO.newCmdWriterFn = func() io.Writer { return io.Discard }
+
+	reporter := &fakeProgressReporter{}
+	runner.guiIO.newProgressReporterFn = func() ProgressReporter { return reporter }
+
+	cmdObj := NewDummyCmdObjBuilder(runner).
+		New([]string{"sh", "-c", `printf 'Updating files:  50%% (1/2)\r' >&2`}).
+		ReportProgress()
+
+	assert.NoError(t, cmdObj.Run())
+	assert.Len(t, reporter.reports, 1)
+	assert.Nil(t, reporter.reports[0].Cancel)
+}
+
+type fakeProgressReporter struct {
+	reports []*Progress
+	done    bool
+}
+
+func (self *fakeProgressReporter) SetProgress(progress *Progress) {
+	self.reports = append(self.reports, progress)
+	if progress.Cancel != nil {
+		progress.Cancel()
+	}
+}
+
+func (self *fakeProgressReporter) Done() {
+	self.done = true
+}
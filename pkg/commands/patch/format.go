@@ -16,14 +16,17 @@ type patchPresenter struct {
 
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+	// line indices for lines the user has marked for a subsequent action
+	markedLineIndices *set.Set[int]
 }
 
 // formats the patch as a plain string
 func formatPlain(patch *Patch) string {
 	presenter := &patchPresenter{
-		patch:          patch,
-		plain:          true,
-		incLineIndices: set.New[int](),
+		patch:             patch,
+		plain:             true,
+		incLineIndices:    set.New[int](),
+		markedLineIndices: set.New[int](),
 	}
 	return presenter.format()
 }
@@ -41,6 +44,8 @@ func formatRangePlain(patch *Patch, startIdx int, endIdx int) string {
 type FormatViewOpts struct {
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+	// line indices for lines the user has marked (e.g. to stage them together)
+	MarkedLineIndices *set.Set[int]
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
@@ -50,10 +55,15 @@ func formatView(patch *Patch, opts FormatViewOpts) string {
 	if includedLineIndices == nil {
 		includedLineIndices = set.New[int]()
 	}
+	markedLineIndices := opts.MarkedLineIndices
+	if markedLineIndices == nil {
+		markedLineIndices = set.New[int]()
+	}
 	presenter := &patchPresenter{
-		patch:          patch,
-		plain:          false,
-		incLineIndices: includedLineIndices,
+		patch:             patch,
+		plain:             false,
+		incLineIndices:    includedLineIndices,
+		markedLineIndices: markedLineIndices,
 	}
 	return presenter.format()
 }
@@ -75,7 +85,7 @@ func (self *patchPresenter) format() string {
 
 	for _, line := range self.patch.header {
 		// always passing false for 'included' here because header lines are not part of the patch
-		appendLine(self.formatLineAux(line, theme.DefaultTextColor.SetBold(), false))
+		appendLine(self.formatLineAux(line, theme.DefaultTextColor.SetBold(), false, false))
 	}
 
 	for _, hunk := range self.patch.hunks {
@@ -84,6 +94,7 @@ func (self *patchPresenter) format() string {
 				hunk.formatHeaderStart(),
 				style.FgCyan,
 				false,
+				false,
 			) +
 				// we're splitting the line into two parts: the diff header and the context
 				// We explicitly pass 'included' as false for both because these are not part
@@ -92,6 +103,7 @@ func (self *patchPresenter) format() string {
 					hunk.headerContext,
 					theme.DefaultTextColor,
 					false,
+					false,
 				),
 		)
 
@@ -100,7 +112,7 @@ func (self *patchPresenter) format() string {
 			if line.IsChange() {
 				appendLine(self.formatLine(line.Content, style, lineIdx))
 			} else {
-				appendLine(self.formatLineAux(line.Content, style, false))
+				appendLine(self.formatLineAux(line.Content, style, false, false))
 			}
 		}
 	}
@@ -111,9 +123,9 @@ func (self *patchPresenter) format() string {
 func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle {
 	switch patchLine.Kind {
 	case ADDITION:
-		return style.FgGreen
+		return theme.DiffAddedColor
 	case DELETION:
-		return style.FgRed
+		return theme.DiffRemovedColor
 	default:
 		return theme.DefaultTextColor
 	}
@@ -121,14 +133,16 @@ func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle
 
 func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int) string {
 	included := self.incLineIndices.Includes(index)
+	marked := self.markedLineIndices.Includes(index)
 
-	return self.formatLineAux(str, textStyle, included)
+	return self.formatLineAux(str, textStyle, included, marked)
 }
 
 // 'selected' means you've got it highlighted with your cursor
 // 'included' means the line has been included in the patch (only applicable when
 // building a patch)
-func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle, included bool) string {
+// 'marked' means the user has marked the line (only applicable when staging)
+func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle, included bool, marked bool) string {
 	if self.plain {
 		return str
 	}
@@ -136,6 +150,8 @@ func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle,
 	firstCharStyle := textStyle
 	if included {
 		firstCharStyle = firstCharStyle.MergeStyle(style.BgGreen)
+	} else if marked {
+		firstCharStyle = firstCharStyle.MergeStyle(style.BgYellow)
 	}
 
 	if len(str) < 2 {
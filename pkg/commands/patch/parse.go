@@ -2,9 +2,8 @@ package patch
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
-
-	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 var hunkHeaderRegexp = regexp.MustCompile(`(?m)^@@ -(\d+)[^\+]+\+(\d+)[^@]+@@(.*)$`)
@@ -18,9 +17,7 @@ func Parse(patchStr string) *Patch {
 
 	var currentHunk *Hunk
 	for _, line := range lines {
-		if strings.HasPrefix(line, "@@") {
-			oldStart, newStart, headerContext := headerInfo(line)
-
+		if oldStart, newStart, headerContext, ok := headerInfo(line); ok {
 			currentHunk = &Hunk{
 				oldStart:      oldStart,
 				newStart:      newStart,
@@ -41,14 +38,28 @@ func Parse(patchStr string) *Patch {
 	}
 }
 
-func headerInfo(header string) (int, int, string) {
-	match := hunkHeaderRegexp.FindStringSubmatch(header)
+// Returns false if the line is not a valid hunk header (e.g. because the
+// numbers are out of range), in which case we treat it like any other line
+func headerInfo(line string) (int, int, string, bool) {
+	if !strings.HasPrefix(line, "@@") {
+		return 0, 0, "", false
+	}
 
-	oldStart := utils.MustConvertToInt(match[1])
-	newStart := utils.MustConvertToInt(match[2])
-	headerContext := match[3]
+	match := hunkHeaderRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return 0, 0, "", false
+	}
+
+	oldStart, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, 0, "", false
+	}
+	newStart, err := strconv.Atoi(match[2])
+	if err != nil {
+		return 0, 0, "", false
+	}
 
-	return oldStart, newStart, headerContext
+	return oldStart, newStart, match[3], true
 }
 
 func newHunkLine(line string) *PatchLine {
@@ -114,6 +114,30 @@ func (self *Patch) LineNumberOfLine(idx int) int {
 	return hunk.newStart + offset
 }
 
+// Takes a line number in the new file and returns the index of the patch line
+// showing it. If the line is not part of the patch (e.g. because it lies
+// between two hunks), returns the index of the next line that is, or of the
+// last line of the patch if there is none.
+func (self *Patch) LineIdxOfLineNumber(lineNumber int) int {
+	idx := len(self.header)
+	for _, hunk := range self.hunks {
+		// skip the hunk header
+		idx++
+		fileLineNumber := hunk.newStart
+		for _, line := range hunk.bodyLines {
+			if line.Kind == ADDITION || line.Kind == CONTEXT {
+				if fileLineNumber >= lineNumber {
+					return idx
+				}
+				fileLineNumber++
+			}
+			idx++
+		}
+	}
+
+	return max(idx-1, 0)
+}
+
 // Returns hunk index containing the line at the given patch line index
 func (self *Patch) HunkContainingLine(idx int) int {
 	for hunkIdx, hunk := range self.hunks {
@@ -0,0 +1,223 @@
+package patch
+
+import (
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// These tests check the invariants of parsing and transforming patches against
+// inputs that nobody thought of writing a test for. Run them for longer with
+// e.g. `go test ./pkg/commands/patch -fuzz FuzzTransform`; any input that fails
+// is saved to testdata/fuzz and becomes part of the regular tests.
+
+func FuzzParse(f *testing.F) {
+	for _, patchStr := range []string{
+		simpleDiff,
+		addNewlineToEndOfFile,
+		removeNewlinefromEndOfFile,
+		twoHunks,
+		twoChangesInOneHunk,
+		newFile,
+		deletedFile,
+		addNewlineToPreviouslyEmptyFile,
+		exampleHunk,
+		"@@ -1 +1 @@\n-a\n+b\n",
+		"@@ not a hunk header @@\n",
+		"@@ -99999999999999999999,1 +1 @@\n-a\n",
+	} {
+		f.Add(patchStr)
+	}
+
+	f.Fuzz(func(t *testing.T, patchStr string) {
+		patch := Parse(patchStr)
+
+		// none of these may panic, whatever the input
+		lineCount := patch.LineCount()
+		assert.Equal(t, lineCount, len(patch.Lines()))
+		for idx := range lineCount + 1 {
+			patch.LineNumberOfLine(idx)
+			patch.HunkContainingLine(idx)
+			patch.HunkOldStartForLine(idx)
+			patch.GetNextChangeIdx(idx)
+			patch.LineIdxOfLineNumber(idx)
+			patch.AdjustLineNumber(idx)
+		}
+		patch.FormatView(FormatViewOpts{})
+		patch.IsSingleHunkForWholeFile()
+
+		// formatting a parsed patch must give us something that parses to the
+		// same patch again
+		formatted := patch.FormatPlain()
+		assert.Equal(t, formatted, Parse(formatted).FormatPlain())
+
+		for _, reverse := range []bool{false, true} {
+			transformed := patch.Transform(TransformOpts{
+				Reverse:             reverse,
+				FileNameOverride:    "file",
+				IncludedLineIndices: ExpandRange(0, lineCount),
+			}).FormatPlain()
+			assert.Equal(t, transformed, Parse(transformed).FormatPlain())
+		}
+	})
+}
+
+func FuzzTransform(f *testing.F) {
+	f.Add("a\nb\nc\n", "a\nx\nc\n", uint64(0b1010), false)
+	f.Add("a\nb\nc\n", "a\nx\nc\n", uint64(0b1010), true)
+	f.Add("a\nb", "a\nb\n", uint64(0b110), false)
+	f.Add("a\nb\n", "a\nb", uint64(0b100), true)
+	f.Add("", "a\nb\n", uint64(0b10), false)
+	f.Add("a\nb\n", "", uint64(0b10), true)
+	f.Add("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n", "a\nx\nc\nd\ne\nf\ng\nh\ny\nj\nk\n", uint64(0xf0f0), false)
+
+	f.Fuzz(func(t *testing.T, oldContent string, newContent string, selection uint64, reverse bool) {
+		// git treats these as binary files or normalizes them, which isn't what
+		// we want to test here
+		if !utf8.ValidString(oldContent+newContent) || strings.ContainsAny(oldContent+newContent, "\x00\r") {
+			t.Skip()
+		}
+
+		checkTransformedPatchApplies(t, oldContent, newContent, selection, reverse)
+	})
+}
+
+// Generates pairs of files from a small alphabet so that there are plenty of
+// repeated lines, which is where diffs get interesting, and checks that staging
+// and unstaging any selection of lines yields a patch that git can apply.
+func TestTransformProperties(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for range 50 {
+		oldLines := randomLines(random, random.Intn(12))
+		newLines := editLines(random, oldLines)
+
+		oldContent := joinLines(oldLines, random.Intn(5) != 0)
+		newContent := joinLines(newLines, random.Intn(5) != 0)
+
+		checkTransformedPatchApplies(t, oldContent, newContent, random.Uint64(), random.Intn(2) == 0)
+	}
+}
+
+func randomLines(random *rand.Rand, count int) []string {
+	lines := make([]string, count)
+	for i := range lines {
+		lines[i] = string(rune('a' + random.Intn(5)))
+	}
+	return lines
+}
+
+func editLines(random *rand.Rand, lines []string) []string {
+	result := []string{}
+	for _, line := range lines {
+		switch random.Intn(6) {
+		case 0: // delete
+		case 1: // replace
+			result = append(result, randomLines(random, 1)...)
+		case 2: // insert
+			result = append(result, randomLines(random, 1+random.Intn(2))...)
+			result = append(result, line)
+		default:
+			result = append(result, line)
+		}
+	}
+	if random.Intn(3) == 0 {
+		result = append(result, randomLines(random, 1+random.Intn(3))...)
+	}
+	return result
+}
+
+func joinLines(lines []string, trailingNewline bool) string {
+	result := strings.Join(lines, "\n")
+	if trailingNewline && len(lines) > 0 {
+		result += "\n"
+	}
+	return result
+}
+
+// Diffs the two contents with git, selects the lines of the patch given by the
+// bits of selection (repeating for patches longer than 64 lines), and checks
+// that git accepts the transformed patch. When staging, the patch is applied to
+// the old content; when unstaging (reverse), it is applied in reverse to the new
+// content, like we do when staging and unstaging lines. If all lines are
+// selected, applying the patch must give us the other side of the diff.
+func checkTransformedPatchApplies(t *testing.T, oldContent string, newContent string, selection uint64, reverse bool) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "old"), oldContent)
+	writeFile(t, filepath.Join(dir, "new"), newContent)
+
+	// exits with 1 if there are differences
+	diff, _ := runGit(dir, "diff", "--no-index", "--no-color", "--no-ext-diff", "--", "old", "new")
+	patch := Parse(diff)
+	if !patch.ContainsChanges() {
+		return
+	}
+
+	includedLineIndices := []int{}
+	for idx := range patch.LineCount() {
+		if selection&(1<<(idx%64)) != 0 {
+			includedLineIndices = append(includedLineIndices, idx)
+		}
+	}
+	allSelected := len(includedLineIndices) == patch.LineCount()
+
+	transformed := patch.Transform(TransformOpts{
+		Reverse:             reverse,
+		FileNameOverride:    "file",
+		IncludedLineIndices: includedLineIndices,
+	}).FormatPlain()
+	if transformed == "" {
+		return
+	}
+
+	fileContent, expectedContent := oldContent, newContent
+	applyArgs := []string{"apply"}
+	if reverse {
+		fileContent, expectedContent = newContent, oldContent
+		applyArgs = append(applyArgs, "--reverse")
+	}
+	writeFile(t, filepath.Join(dir, "file"), fileContent)
+	writeFile(t, filepath.Join(dir, "patch"), transformed)
+
+	if output, err := runGit(dir, append(applyArgs, "--check", "patch")...); err != nil {
+		t.Fatalf("git apply --check failed: %s\n\noriginal patch:\n%s\ntransformed patch:\n%s", output, diff, transformed)
+	}
+
+	if allSelected {
+		if output, err := runGit(dir, append(applyArgs, "patch")...); err != nil {
+			t.Fatalf("git apply failed: %s\n\ntransformed patch:\n%s", output, transformed)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, "file"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expectedContent, string(content), "transformed patch:\n%s", transformed)
+	}
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	// don't let the user's config (e.g. diff.noprefix) change the output
+	cmd.Env = append(os.Environ(), "GIT_CONFIG_NOSYSTEM=1", "GIT_CONFIG_GLOBAL=/dev/null")
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
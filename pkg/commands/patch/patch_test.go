@@ -135,6 +135,18 @@ index e69de29..c6568ea 100644
 \ No newline at end of file
 `
 
+const addLineAndNewlineToEndOfFile = `diff --git a/filename b/filename
+index 3ae1ab5..9b2a8b0 100644
+--- a/filename
++++ b/filename
+@@ -1,2 +1,3 @@
+ apple
+-grape
+\ No newline at end of file
++orange
++grape
+`
+
 const exampleHunk = `@@ -1,5 +1,5 @@
  apple
 -grape
@@ -515,6 +527,21 @@ func TestTransform(t *testing.T) {
  orange
  banana
  lemon
+`,
+		},
+		{
+			testName:       "adding a line before a line that gets a newline, reverse",
+			filename:       "filename",
+			firstLineIndex: 8,
+			lastLineIndex:  8,
+			reverse:        true,
+			diffText:       addLineAndNewlineToEndOfFile,
+			expected: `--- a/filename
++++ b/filename
+@@ -1,2 +1,3 @@
+ apple
++orange
+ grape
 `,
 		},
 	}
@@ -627,6 +654,35 @@ func TestLineNumberOfLine(t *testing.T) {
 	}
 }
 
+func TestLineIdxOfLineNumber(t *testing.T) {
+	type scenario struct {
+		testName    string
+		patchStr    string
+		lineNumbers []int
+		expecteds   []int
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "twoHunks",
+			patchStr: twoHunks,
+			// lines 6 and 7 are between the hunks, so we get the first line of
+			// the second hunk
+			lineNumbers: []int{0, 1, 2, 3, 5, 6, 7, 8, 11, 12, 15, 1000},
+			expecteds:   []int{5, 5, 7, 8, 10, 12, 12, 12, 15, 16, 19, 19},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(s.patchStr)
+			for i, lineNumber := range s.lineNumbers {
+				assert.Equal(t, s.expecteds[i], patch.LineIdxOfLineNumber(lineNumber))
+			}
+		})
+	}
+}
+
 func TestGetNextStageableLineIndex(t *testing.T) {
 	type scenario struct {
 		testName  string
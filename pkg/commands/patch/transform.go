@@ -193,10 +193,10 @@ func (self *patchTransformer) transformHunkLines(hunk *Hunk, firstLineIdx int) [
 
 		didSeeUnselectedNewFileLine = true
 
-		if line.Kind == ADDITION {
-			// we don't want to include the 'newline at end of file' line if it involves an addition we're not including
-			skippedNewlineMessageIndex = lineIdx + 1
-		}
+		// we don't want to include the 'newline at end of file' line if it
+		// involves a line we're not including (an addition, or a deletion when
+		// reversing)
+		skippedNewlineMessageIndex = lineIdx + 1
 	}
 
 	flushPendingContext()
@@ -219,7 +219,8 @@ func (self *patchTransformer) transformHunkHeader(newBodyLines []*PatchLine, old
 		newStartOffset = 0
 	}
 
-	newStart := oldStart + startOffset + newStartOffset
+	// line numbers can't be negative, even for malformed hunks
+	newStart := max(oldStart+startOffset+newStartOffset, 0)
 
 	newStartOffset = startOffset + newLength - oldLength
 
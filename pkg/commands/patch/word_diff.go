@@ -0,0 +1,239 @@
+package patch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A WordSegment is a part of a changed line. If Old and New are equal, the
+// segment is unchanged; otherwise it describes one change between the old
+// and the new version of the line (either of them may be empty for pure
+// deletions/insertions).
+type WordSegment struct {
+	Old string
+	New string
+}
+
+func (self WordSegment) IsChange() bool {
+	return self.Old != self.New
+}
+
+// If the lines are longer than this (in tokens multiplied), we don't try to
+// find a minimal diff and treat the whole line as a single change instead
+const maxWordDiffComplexity = 1_000_000
+
+// WordDiff splits the given lines into words and returns the segments that
+// make up the difference between them. Concatenating the Old parts of the
+// segments gives oldLine, and concatenating the New parts gives newLine.
+func WordDiff(oldLine string, newLine string) []WordSegment {
+	oldTokens := tokenizeWords(oldLine)
+	newTokens := tokenizeWords(newLine)
+
+	if len(oldTokens)*len(newTokens) > maxWordDiffComplexity {
+		return mergeSegments([]WordSegment{{Old: oldLine, New: newLine}})
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// oldTokens[i:] and newTokens[j:]
+	lcs := make([][]int, len(oldTokens)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newTokens)+1)
+	}
+	for i := len(oldTokens) - 1; i >= 0; i-- {
+		for j := len(newTokens) - 1; j >= 0; j-- {
+			if oldTokens[i] == newTokens[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	segments := []WordSegment{}
+	i, j := 0, 0
+	for i < len(oldTokens) || j < len(newTokens) {
+		switch {
+		case i < len(oldTokens) && j < len(newTokens) && oldTokens[i] == newTokens[j]:
+			segments = append(segments, WordSegment{Old: oldTokens[i], New: newTokens[j]})
+			i++
+			j++
+		case j < len(newTokens) && (i == len(oldTokens) || lcs[i][j+1] >= lcs[i+1][j]):
+			segments = append(segments, WordSegment{New: newTokens[j]})
+			j++
+		default:
+			segments = append(segments, WordSegment{Old: oldTokens[i]})
+			i++
+		}
+	}
+
+	return mergeSegments(segments)
+}
+
+// Merges adjacent unchanged segments, and adjacent changed segments, so that
+// changes alternate with unchanged text
+func mergeSegments(segments []WordSegment) []WordSegment {
+	result := []WordSegment{}
+	for _, segment := range segments {
+		if len(result) > 0 && result[len(result)-1].IsChange() == segment.IsChange() {
+			last := &result[len(result)-1]
+			last.Old += segment.Old
+			last.New += segment.New
+			continue
+		}
+		result = append(result, segment)
+	}
+	return result
+}
+
+// ApplyWordChanges returns the line that results from applying only some of
+// the changes in the given segments to the old line. shouldApply is called
+// with the index of each changed segment.
+func ApplyWordChanges(segments []WordSegment, shouldApply func(segmentIdx int) bool) string {
+	var builder strings.Builder
+	for i, segment := range segments {
+		if segment.IsChange() && shouldApply(i) {
+			builder.WriteString(segment.New)
+		} else {
+			builder.WriteString(segment.Old)
+		}
+	}
+	return builder.String()
+}
+
+// Splits a line into runs of word characters, runs of whitespace, and
+// individual other characters
+func tokenizeWords(line string) []string {
+	tokens := []string{}
+	runes := []rune(line)
+	start := 0
+	for start < len(runes) {
+		end := start + 1
+		switch {
+		case isWordRune(runes[start]):
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+		case unicode.IsSpace(runes[start]):
+			for end < len(runes) && unicode.IsSpace(runes[end]) {
+				end++
+			}
+		}
+		tokens = append(tokens, string(runes[start:end]))
+		start = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// PairedChangeLine returns the index of the line that the given changed line
+// corresponds to on the other side of the diff: within a block of consecutive
+// changes, the n-th deleted line is paired with the n-th added line. Returns
+// false if the line isn't a change or has no counterpart.
+func (self *Patch) PairedChangeLine(lineIdx int) (int, bool) {
+	lines := self.Lines()
+	if lineIdx < 0 || lineIdx >= len(lines) || !lines[lineIdx].IsChange() {
+		return 0, false
+	}
+
+	blockStart := lineIdx
+	for blockStart > 0 && lines[blockStart-1].IsChange() {
+		blockStart--
+	}
+	blockEnd := lineIdx
+	for blockEnd < len(lines)-1 && lines[blockEnd+1].IsChange() {
+		blockEnd++
+	}
+
+	deletions := []int{}
+	additions := []int{}
+	for i := blockStart; i <= blockEnd; i++ {
+		if lines[i].IsDeletion() {
+			deletions = append(deletions, i)
+		} else {
+			additions = append(additions, i)
+		}
+	}
+
+	own, other := deletions, additions
+	if lines[lineIdx].IsAddition() {
+		own, other = additions, deletions
+	}
+	for n, idx := range own {
+		if idx == lineIdx {
+			if n < len(other) {
+				return other[n], true
+			}
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// ReplaceLine returns a patch with a single hunk that replaces the given line
+// with the given content (without the leading '+'/'-'/' ' character). The
+// patch is relative to one side of the diff: the old side if the line is a
+// deletion, or the new side if it's an addition. This allows applying it to
+// the index when staging parts of a line from the unstaged changes (where
+// the index is the old side) or unstaging parts of a line from the staged
+// changes (where the index is the new side).
+func (self *Patch) ReplaceLine(lineIdx int, replacement string, fileName string) *Patch {
+	hunkIdx := self.HunkContainingLine(lineIdx)
+	if hunkIdx == -1 {
+		return &Patch{}
+	}
+	hunk := self.hunks[hunkIdx]
+	firstLineIdx := self.HunkStartIdx(hunkIdx)
+
+	baseKind := DELETION
+	start := hunk.oldStart
+	if self.Lines()[lineIdx].IsAddition() {
+		baseKind = ADDITION
+		start = hunk.newStart
+	}
+
+	newLines := []*PatchLine{}
+	// whether the last line we looked at is part of the new hunk; a newline
+	// message only applies if it is
+	lastLineIncluded := false
+	for i, line := range hunk.bodyLines {
+		idx := i + firstLineIdx + 1 // plus one for the header line
+		switch {
+		case line.Kind == NEWLINE_MESSAGE:
+			if lastLineIncluded {
+				newLines = append(newLines, line)
+			}
+		case idx == lineIdx:
+			newLines = append(newLines, &PatchLine{Kind: DELETION, Content: "-" + line.Content[1:]})
+			// If the line is the last one in the file and has no newline, the
+			// message needs to follow both the removed and the added line
+			if i+1 < len(hunk.bodyLines) && hunk.bodyLines[i+1].Kind == NEWLINE_MESSAGE {
+				newLines = append(newLines, hunk.bodyLines[i+1])
+			}
+			newLines = append(newLines, &PatchLine{Kind: ADDITION, Content: "+" + replacement})
+			lastLineIncluded = true
+		case line.Kind == CONTEXT || line.Kind == baseKind:
+			newLines = append(newLines, &PatchLine{Kind: CONTEXT, Content: " " + line.Content[1:]})
+			lastLineIncluded = true
+		default:
+			lastLineIncluded = false
+		}
+	}
+
+	return &Patch{
+		header: []string{
+			"--- a/" + fileName,
+			"+++ b/" + fileName,
+		},
+		hunks: []*Hunk{
+			{
+				oldStart:      start,
+				newStart:      start,
+				headerContext: hunk.headerContext,
+				bodyLines:     newLines,
+			},
+		},
+	}
+}
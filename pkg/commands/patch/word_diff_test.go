@@ -0,0 +1,184 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDiff(t *testing.T) {
+	type scenario struct {
+		testName string
+		oldLine  string
+		newLine  string
+		expected []WordSegment
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "identical lines",
+			oldLine:  "foo bar",
+			newLine:  "foo bar",
+			expected: []WordSegment{{Old: "foo bar", New: "foo bar"}},
+		},
+		{
+			testName: "one word changed",
+			oldLine:  "the quick fox",
+			newLine:  "the slow fox",
+			expected: []WordSegment{
+				{Old: "the ", New: "the "},
+				{Old: "quick", New: "slow"},
+				{Old: " fox", New: " fox"},
+			},
+		},
+		{
+			testName: "two separate changes",
+			oldLine:  "a := foo(b)",
+			newLine:  "x := foo(c)",
+			expected: []WordSegment{
+				{Old: "a", New: "x"},
+				{Old: " := foo(", New: " := foo("},
+				{Old: "b", New: "c"},
+				{Old: ")", New: ")"},
+			},
+		},
+		{
+			testName: "insertion",
+			oldLine:  "foo(a)",
+			newLine:  "foo(a, b)",
+			expected: []WordSegment{
+				{Old: "foo(a", New: "foo(a"},
+				{Old: "", New: ", b"},
+				{Old: ")", New: ")"},
+			},
+		},
+		{
+			testName: "deletion",
+			oldLine:  "return nil, err",
+			newLine:  "return err",
+			expected: []WordSegment{
+				{Old: "return ", New: "return "},
+				{Old: "nil, ", New: ""},
+				{Old: "err", New: "err"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, WordDiff(s.oldLine, s.newLine))
+		})
+	}
+}
+
+func TestApplyWordChanges(t *testing.T) {
+	segments := WordDiff("a := foo(b)", "x := foo(c)")
+
+	assert.Equal(t, "a := foo(b)", ApplyWordChanges(segments, func(int) bool { return false }))
+	assert.Equal(t, "x := foo(c)", ApplyWordChanges(segments, func(int) bool { return true }))
+	assert.Equal(t, "a := foo(c)", ApplyWordChanges(segments, func(i int) bool { return i == 2 }))
+}
+
+func TestPairedChangeLine(t *testing.T) {
+	const diff = `diff --git a/filename b/filename
+index e48a11c..b2ab81b 100644
+--- a/filename
++++ b/filename
+@@ -1,5 +1,4 @@
+ apple
+-grape
+-pear
++orange
+ ...
+ ...
+`
+
+	type scenario struct {
+		lineIdx       int
+		expectedIdx   int
+		expectedFound bool
+	}
+
+	scenarios := []scenario{
+		{lineIdx: 5, expectedIdx: 0, expectedFound: false}, // context
+		{lineIdx: 6, expectedIdx: 8, expectedFound: true},
+		{lineIdx: 7, expectedIdx: 0, expectedFound: false}, // no counterpart
+		{lineIdx: 8, expectedIdx: 6, expectedFound: true},
+	}
+
+	patch := Parse(diff)
+	for _, s := range scenarios {
+		idx, found := patch.PairedChangeLine(s.lineIdx)
+		assert.Equal(t, s.expectedIdx, idx)
+		assert.Equal(t, s.expectedFound, found)
+	}
+}
+
+func TestReplaceLine(t *testing.T) {
+	type scenario struct {
+		testName    string
+		patchStr    string
+		lineIdx     int
+		replacement string
+		expected    string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "replace deletion",
+			patchStr:    simpleDiff,
+			lineIdx:     6,
+			replacement: "grapefruit",
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-orange
++grapefruit
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:    "replace addition",
+			patchStr:    simpleDiff,
+			lineIdx:     7,
+			replacement: "grapefruit",
+			expected: `--- a/filename
++++ b/filename
+@@ -1,5 +1,5 @@
+ apple
+-grape
++grapefruit
+ ...
+ ...
+ ...
+`,
+		},
+		{
+			testName:    "replace last line without newline",
+			patchStr:    addNewlineToEndOfFile,
+			lineIdx:     8,
+			replacement: "last word",
+			expected: `--- a/filename
++++ b/filename
+@@ -60,4 +60,4 @@ grape
+ ...
+ ...
+ ...
+-last line
+\ No newline at end of file
++last word
+\ No newline at end of file
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			result := Parse(s.patchStr).ReplaceLine(s.lineIdx, s.replacement, "filename").FormatPlain()
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
@@ -19,6 +19,8 @@ type Common struct {
 	// for interacting with the filesystem. We use afero rather than the default
 	// `os` package for the sake of mocking the filesystem in tests
 	Fs afero.Fs
+	// How long git commands and refreshes take; see Timings
+	Timings *Timings
 }
 
 func (c *Common) UserConfig() *config.UserConfig {
@@ -10,9 +10,10 @@ import (
 func NewDummyCommon() *Common {
 	tr := i18n.EnglishTranslationSet()
 	cmn := &Common{
-		Log: utils.NewDummyLog(),
-		Tr:  tr,
-		Fs:  afero.NewOsFs(),
+		Log:     utils.NewDummyLog(),
+		Tr:      tr,
+		Fs:      afero.NewOsFs(),
+		Timings: NewTimings(),
 	}
 	cmn.SetUserConfig(config.GetDefaultConfig())
 	return cmn
@@ -26,7 +27,8 @@ func NewDummyCommonWithUserConfigAndAppState(userConfig *config.UserConfig, appS
 		AppState: appState,
 		// TODO: remove dependency on actual filesystem in tests and switch to using
 		// in-memory for everything
-		Fs: afero.NewOsFs(),
+		Fs:      afero.NewOsFs(),
+		Timings: NewTimings(),
 	}
 	cmn.SetUserConfig(userConfig)
 	return cmn
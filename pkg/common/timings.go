@@ -0,0 +1,141 @@
+package common
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/sasha-s/go-deadlock"
+)
+
+type TimingCategory string
+
+const (
+	TimingGitCommand TimingCategory = "git"
+	TimingRefresh    TimingCategory = "refresh"
+)
+
+// How many of the most recent timings we keep for showing in the performance
+// HUD
+const maxRecentTimings = 100
+
+type Timing struct {
+	Category TimingCategory
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+}
+
+// Records how long git commands and refreshes take, so that we can show them
+// in the performance HUD, and write them to a trace file when running with
+// --profile, so that users can report what exactly is slow in their repo.
+type Timings struct {
+	mutex deadlock.Mutex
+	// Ring buffer of the most recent timings; next is the index of the oldest
+	// one once the buffer is full
+	recent []Timing
+	next   int
+
+	trace      io.WriteCloser
+	wroteEvent bool
+
+	onRecord func()
+}
+
+func NewTimings() *Timings {
+	return &Timings{}
+}
+
+// Makes Record write each timing to the given writer as an event in the Chrome
+// trace event format, which can be viewed with chrome://tracing or
+// https://ui.perfetto.dev
+func (self *Timings) WriteTraceTo(w io.WriteCloser) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.trace = w
+	_, err := io.WriteString(w, "[\n")
+	return err
+}
+
+// Called (outside of the lock) whenever a timing is recorded
+func (self *Timings) SetOnRecord(f func()) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.onRecord = f
+}
+
+// Records that something with the given name has been running since start
+func (self *Timings) Record(category TimingCategory, name string, start time.Time) {
+	timing := Timing{Category: category, Name: name, Start: start, Duration: time.Since(start)}
+
+	self.mutex.Lock()
+	if len(self.recent) < maxRecentTimings {
+		self.recent = append(self.recent, timing)
+	} else {
+		self.recent[self.next] = timing
+		self.next = (self.next + 1) % maxRecentTimings
+	}
+
+	if self.trace != nil {
+		self.writeTraceEvent(timing)
+	}
+
+	onRecord := self.onRecord
+	self.mutex.Unlock()
+
+	if onRecord != nil {
+		onRecord()
+	}
+}
+
+func (self *Timings) writeTraceEvent(timing Timing) {
+	event, err := json.Marshal(map[string]any{
+		"name": timing.Name,
+		"cat":  timing.Category,
+		"ph":   "X",
+		"ts":   timing.Start.UnixMicro(),
+		"dur":  timing.Duration.Microseconds(),
+		"pid":  os.Getpid(),
+		"tid":  0,
+	})
+	if err != nil {
+		return
+	}
+
+	if self.wroteEvent {
+		_, _ = io.WriteString(self.trace, ",\n")
+	}
+	_, _ = self.trace.Write(event)
+	self.wroteEvent = true
+}
+
+// Returns the most recent timings, newest first
+func (self *Timings) Recent() []Timing {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	result := make([]Timing, 0, len(self.recent))
+	for i := range self.recent {
+		idx := (self.next - 1 - i + 2*len(self.recent)) % len(self.recent)
+		result = append(result, self.recent[idx])
+	}
+	return result
+}
+
+// Finishes the trace file, if any
+func (self *Timings) Close() error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.trace == nil {
+		return nil
+	}
+
+	_, _ = io.WriteString(self.trace, "\n]\n")
+	err := self.trace.Close()
+	self.trace = nil
+	return err
+}
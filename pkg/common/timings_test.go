@@ -0,0 +1,47 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type nopCloser struct {
+	*bytes.Buffer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func TestTimingsRecentReturnsNewestFirst(t *testing.T) {
+	timings := NewTimings()
+	for i := range maxRecentTimings + 3 {
+		timings.Record(TimingGitCommand, fmt.Sprintf("cmd %d", i), time.Now())
+	}
+
+	recent := timings.Recent()
+	assert.Len(t, recent, maxRecentTimings)
+	assert.Equal(t, fmt.Sprintf("cmd %d", maxRecentTimings+2), recent[0].Name)
+	assert.Equal(t, "cmd 3", recent[len(recent)-1].Name)
+}
+
+func TestTimingsWriteTrace(t *testing.T) {
+	timings := NewTimings()
+	buffer := nopCloser{&bytes.Buffer{}}
+	assert.NoError(t, timings.WriteTraceTo(buffer))
+
+	timings.Record(TimingGitCommand, "git status", time.Now())
+	timings.Record(TimingRefresh, "files", time.Now())
+	assert.NoError(t, timings.Close())
+
+	var events []map[string]any
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), &events))
+	assert.Len(t, events, 2)
+	assert.Equal(t, "git status", events[0]["name"])
+	assert.Equal(t, "git", events[0]["cat"])
+	assert.Equal(t, "files", events[1]["name"])
+	assert.Equal(t, "refresh", events[1]["cat"])
+}
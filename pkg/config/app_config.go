@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -46,6 +47,9 @@ type AppConfigurer interface {
 	GetUserConfigDir() string
 	ReloadUserConfigForRepo(repoConfigFiles []*ConfigFile) error
 	ReloadChangedUserConfigFiles() (error, bool)
+	SetGlobalUserConfigValue(path []string, value string) (string, error)
+	IsGlobalUserConfigEmpty() bool
+	WriteGlobalUserConfig(content string) (string, error)
 	GetTempDir() string
 
 	GetAppState() *AppState
@@ -140,6 +144,9 @@ func loadUserConfigWithDefaults(configFiles []*ConfigFile, isGuiInitialized bool
 }
 
 func loadUserConfig(configFiles []*ConfigFile, base *UserConfig, isGuiInitialized bool) (*UserConfig, error) {
+	configContents := [][]byte{}
+	themeFileDir := ""
+
 	for _, configFile := range configFiles {
 		path := configFile.Path
 		statInfo, err := os.Stat(path)
@@ -190,11 +197,17 @@ func loadUserConfig(configFiles []*ConfigFile, base *UserConfig, isGuiInitialize
 		}
 
 		existingCustomCommands := base.CustomCommands
+		existingThemeFile := base.Gui.ThemeFile
 
 		if err := yaml.Unmarshal(content, base); err != nil {
 			return nil, fmt.Errorf("The config at `%s` couldn't be parsed, please inspect it before opening up an issue.\n%w", path, err)
 		}
 
+		configContents = append(configContents, content)
+		if base.Gui.ThemeFile != existingThemeFile {
+			themeFileDir = filepath.Dir(path)
+		}
+
 		base.CustomCommands = append(base.CustomCommands, existingCustomCommands...)
 
 		if err := base.Validate(); err != nil {
@@ -202,6 +215,17 @@ func loadUserConfig(configFiles []*ConfigFile, base *UserConfig, isGuiInitialize
 		}
 	}
 
+	// The theme file is the base layer of the theme, so we apply it only now
+	// that we know which file it is, and then re-apply the explicit theme keys
+	// of all config files on top of it, including those of config files that
+	// come before the one that chose the theme file (e.g. the global config
+	// when a repo config chooses it)
+	if base.Gui.ThemeFile != "" && themeFileDir != "" {
+		if err := applyThemeFile(base, themeFileDir, configContents); err != nil {
+			return nil, err
+		}
+	}
+
 	return base, nil
 }
 
@@ -315,6 +339,11 @@ func computeMigratedConfig(path string, content []byte, changes *ChangesSet) ([]
 		return nil, false, fmt.Errorf("Couldn't migrate config file at `%s`: %w", path, err)
 	}
 
+	err = migrateConfirmationFlags(&rootNode, changes)
+	if err != nil {
+		return nil, false, fmt.Errorf("Couldn't migrate config file at `%s`: %w", path, err)
+	}
+
 	// Add more migrations here...
 
 	if reflect.DeepEqual(rootNode, originalCopy) {
@@ -505,6 +534,79 @@ func migratePagers(rootNode *yaml.Node, changes *ChangesSet) error {
 	})
 }
 
+// The flags for skipping individual confirmations have been replaced by the
+// confirmations section; note that the skip flags have the opposite meaning
+func migrateConfirmationFlags(rootNode *yaml.Node, changes *ChangesSet) error {
+	return yaml_utils.TransformNode(rootNode, []string{}, func(node *yaml.Node) error {
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		flags := []struct {
+			oldPath []string
+			newName string
+			negate  bool
+		}{
+			{[]string{"gui", "skipAmendWarning"}, "amend", true},
+			{[]string{"gui", "skipDiscardChangeWarning"}, "discardChange", true},
+			{[]string{"gui", "skipStashWarning"}, "applyStash", true},
+			{[]string{"gui", "skipNoStagedFilesWarning"}, "commitWithoutStagedFiles", true},
+			{[]string{"gui", "skipRewordInEditorWarning"}, "rewordInEditor", true},
+			{[]string{"gui", "skipSwitchWorktreeOnCheckoutWarning"}, "switchWorktreeOnCheckout", true},
+			{[]string{"confirmOnQuit"}, "quit", false},
+		}
+
+		for _, flag := range flags {
+			parentNode := node
+			if len(flag.oldPath) == 2 {
+				_, parentNode = yaml_utils.LookupKey(node, flag.oldPath[0])
+				if parentNode == nil || parentNode.Kind != yaml.MappingNode {
+					continue
+				}
+			}
+
+			oldKey := flag.oldPath[len(flag.oldPath)-1]
+			keyNode, valueNode := yaml_utils.LookupKey(parentNode, oldKey)
+			if keyNode == nil {
+				continue
+			}
+
+			var value bool
+			if err := valueNode.Decode(&value); err != nil {
+				return fmt.Errorf("Expected a boolean for %s: %w", strings.Join(flag.oldPath, "."), err)
+			}
+			if flag.negate {
+				value = !value
+			}
+
+			_, confirmationsNode := yaml_utils.LookupKey(node, "confirmations")
+			if confirmationsNode == nil {
+				confirmationsNode = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+				node.Content = append(node.Content,
+					&yaml.Node{Kind: yaml.ScalarNode, Value: "confirmations"},
+					confirmationsNode,
+				)
+			}
+			if confirmationsNode.Kind != yaml.MappingNode {
+				return errors.New("You should have confirmations defined as an object!")
+			}
+
+			// If the new setting exists already, it wins
+			if newKeyNode, _ := yaml_utils.LookupKey(confirmationsNode, flag.newName); newKeyNode == nil {
+				confirmationsNode.Content = append(confirmationsNode.Content,
+					&yaml.Node{Kind: yaml.ScalarNode, Value: flag.newName},
+					&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(value)},
+				)
+			}
+
+			_, _ = yaml_utils.RemoveKey(parentNode, oldKey)
+			changes.Add(fmt.Sprintf("Replaced '%s' by 'confirmations.%s'", strings.Join(flag.oldPath, "."), flag.newName))
+		}
+
+		return nil
+	})
+}
+
 func (c *AppConfig) GetDebug() bool {
 	return c.debug
 }
@@ -579,6 +681,67 @@ func (c *AppConfig) ReloadChangedUserConfigFiles() (error, bool) {
 	return nil, true
 }
 
+// SetGlobalUserConfigValue sets the key at the given path (e.g.
+// ["keybinding", "files", "commitChanges"]) in the global user config file to
+// the given value, keeping the rest of the file as it is. Returns the path of
+// the file that was written. Note that the in-memory config is not updated;
+// call ReloadChangedUserConfigFiles for that.
+//
+// If LG_CONFIG_FILE lists several files, we write to the last one: files
+// later in the list take precedence over earlier ones, so that's the only
+// file where the value is guaranteed to take effect.
+func (c *AppConfig) SetGlobalUserConfigValue(path []string, value string) (string, error) {
+	configPath := c.globalUserConfigFiles[len(c.globalUserConfigFiles)-1].Path
+	if err := os.MkdirAll(filepath.Dir(configPath), 0o755); err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+
+	var rootNode yaml.Node
+	if err := yaml.Unmarshal(content, &rootNode); err != nil {
+		return "", fmt.Errorf("The config at `%s` couldn't be parsed.\n%w", configPath, err)
+	}
+
+	if err := yaml_utils.SetStringValue(&rootNode, path, value); err != nil {
+		return "", fmt.Errorf("Couldn't set `%s` in the config at `%s`: %w", strings.Join(path, "."), configPath, err)
+	}
+
+	newContent, err := yaml_utils.YamlMarshal(&rootNode)
+	if err != nil {
+		return "", err
+	}
+
+	return configPath, os.WriteFile(configPath, newContent, 0o644)
+}
+
+// IsGlobalUserConfigEmpty returns true if the global user config file doesn't
+// exist or has no content, which is the case on the first start of lazygit
+// (we create an empty file if there isn't one).
+func (c *AppConfig) IsGlobalUserConfigEmpty() bool {
+	if len(c.globalUserConfigFiles) != 1 {
+		return false
+	}
+
+	content, err := os.ReadFile(c.globalUserConfigFiles[0].Path)
+	if err != nil {
+		return os.IsNotExist(err)
+	}
+
+	return strings.TrimSpace(string(content)) == ""
+}
+
+// WriteGlobalUserConfig replaces the content of the global user config file.
+// Returns the path of the file that was written. As with
+// SetGlobalUserConfigValue, call ReloadChangedUserConfigFiles afterwards.
+func (c *AppConfig) WriteGlobalUserConfig(content string) (string, error) {
+	configPath := c.globalUserConfigFiles[0].Path
+	return configPath, os.WriteFile(configPath, []byte(content), 0o644)
+}
+
 func (c *AppConfig) GetTempDir() string {
 	return c.tempDir
 }
@@ -705,9 +868,98 @@ type AppState struct {
 
 	HideCommandLog bool
 
+	// Language chosen at runtime via the language menu. When set, this takes
+	// precedence over gui.language.
+	Language string
+
+	// Side panel layout adjusted at runtime via the side panel layout menu.
+	// When set, these take precedence over gui.sidePanels and
+	// gui.sidePanelWidth respectively.
+	SidePanels     []string
+	SidePanelWidth float64
+
+	// Panel sizes per repo (keyed by worktree path), adjusted at runtime by
+	// dragging the borders between panels or with keybindings. These take
+	// precedence over SidePanelWidth.
+	PanelSizes map[string]PanelSizes `yaml:"panelSizes"`
+
+	// Options for searching in views, toggled in the search prompt.
+	// SearchCaseSensitivity is one of '' (case-sensitive only if the search
+	// string contains uppercase characters), 'sensitive', or 'insensitive'.
+	SearchRegex           bool
+	SearchCaseSensitivity string
+
+	// The last search string per context (e.g. 'main' or 'commits'), so that
+	// it can be recalled with the up arrow after a restart.
+	LastSearches map[string]string
+
 	// Cache of GitHub pull requests per repo path, so that PR info can be
 	// shown instantly on startup before the async refresh completes.
 	GithubPullRequests map[string][]CachedPullRequest `yaml:"githubPullRequests"`
+
+	// The UI state of each repo (keyed by worktree path) at the time lazygit
+	// was last quit, so that it can be restored when reopening the repo.
+	RepoSessions map[string]RepoSession `yaml:"repoSessions"`
+
+	// Bookmarked branches, files and commits per repo (keyed by worktree path)
+	Bookmarks map[string][]Bookmark `yaml:"bookmarks"`
+}
+
+// Bookmark is a branch, file or commit that the user bookmarked so that they
+// can quickly jump back to it from the bookmarks menu.
+type Bookmark struct {
+	// One of 'branch', 'file', or 'commit'
+	Kind string `yaml:"kind"`
+	// The branch name, file path, or commit hash
+	Id string `yaml:"id"`
+	// The text shown in the bookmarks menu, e.g. the subject of a commit
+	Label string `yaml:"label"`
+}
+
+const (
+	BookmarkKindBranch = "branch"
+	BookmarkKindFile   = "file"
+	BookmarkKindCommit = "commit"
+)
+
+// PanelSizes stores the sizes of the panels of a repo. Zero values mean that
+// the default applies.
+type PanelSizes struct {
+	// Fraction of the screen width taken up by the side panels
+	SidePanelWidth float64 `yaml:"sidePanelWidth,omitempty"`
+	// Fraction of the main section taken up by the main view when it is split,
+	// e.g. when staging a file that has both staged and unstaged changes
+	MainViewSplit float64 `yaml:"mainViewSplit,omitempty"`
+}
+
+// RepoSession stores the parts of the UI state of a repo that are restored
+// when the repo is opened again.
+type RepoSession struct {
+	// The key of the focused side panel context, e.g. 'localBranches'
+	FocusedContext string `yaml:"focusedContext"`
+	// Selection and filter of the side panel lists, keyed by context key
+	Lists map[string]ListSession `yaml:"lists,omitempty"`
+
+	FilterPath     string `yaml:"filterPath,omitempty"`
+	FilterAuthor   string `yaml:"filterAuthor,omitempty"`
+	FilterSince    string `yaml:"filterSince,omitempty"`
+	FilterUntil    string `yaml:"filterUntil,omitempty"`
+	FilterContent  string `yaml:"filterContent,omitempty"` // passed to git log's -S, or -G if FilterRegex is set
+	FilterRegex    bool   `yaml:"filterRegex,omitempty"`
+	DiffingRef     string `yaml:"diffingRef,omitempty"`
+	DiffingReverse bool   `yaml:"diffingReverse,omitempty"`
+}
+
+type ListSession struct {
+	// The id of the selected item (e.g. the branch name or commit hash), which
+	// is used to find the item again even if the list has changed in the meantime
+	SelectedItemId string `yaml:"selectedItemId"`
+	// Used as a fallback if the selected item no longer exists
+	SelectedIdx int `yaml:"selectedIdx"`
+	// The distance of the selected line from the top of the view, so that we
+	// can restore the scroll position
+	ScrollOffset int    `yaml:"scrollOffset"`
+	Filter       string `yaml:"filter,omitempty"`
 }
 
 // CachedPullRequest stores the essential fields of a GitHub pull request
@@ -719,11 +971,13 @@ type CachedPullRequest struct {
 	State               string `yaml:"state"`
 	Url                 string `yaml:"url"`
 	HeadRepositoryOwner string `yaml:"headRepositoryOwner"`
+	ReviewDecision      string `yaml:"reviewDecision,omitempty"`
 }
 
 func getDefaultAppState() *AppState {
 	return &AppState{
 		GithubPullRequests: make(map[string][]CachedPullRequest),
+		RepoSessions:       make(map[string]RepoSession),
 	}
 }
 
@@ -734,3 +988,13 @@ func LogPath() (string, error) {
 
 	return stateFilePath("development.log")
 }
+
+// TracePath returns the path of the file that lazygit writes the timings of git
+// commands and refreshes to when running with --profile
+func TracePath() (string, error) {
+	if os.Getenv("LAZYGIT_TRACE_PATH") != "" {
+		return os.Getenv("LAZYGIT_TRACE_PATH"), nil
+	}
+
+	return stateFilePath("trace.json")
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,11 +35,17 @@ func TestMigrationOfRenamedKeys(t *testing.T) {
 			input: `gui:
   skipUnstageLineWarning: true
 `,
-			expected: `gui:
-  skipDiscardChangeWarning: true
+			// skipDiscardChangeWarning has been replaced by confirmations.discardChange
+			// in the meantime, so it is migrated further
+			expected: `gui: {}
+confirmations:
+  discardChange: false
 `,
 			expectedDidChange: true,
-			expectedChanges:   []string{"Renamed 'gui.skipUnstageLineWarning' to 'skipDiscardChangeWarning'"},
+			expectedChanges: []string{
+				"Renamed 'gui.skipUnstageLineWarning' to 'skipDiscardChangeWarning'",
+				"Replaced 'gui.skipDiscardChangeWarning' by 'confirmations.discardChange'",
+			},
 		},
 		{
 			name: "Rename several",
@@ -50,16 +58,18 @@ keybinding:
 `,
 			expected: `gui:
   screenMode: half
-  skipDiscardChangeWarning: true
 keybinding:
   universal:
     executeShellCommand: a
+confirmations:
+  discardChange: false
 `,
 			expectedDidChange: true,
 			expectedChanges: []string{
 				"Renamed 'gui.skipUnstageLineWarning' to 'skipDiscardChangeWarning'",
 				"Renamed 'keybinding.universal.executeCustomCommand' to 'executeShellCommand'",
 				"Renamed 'gui.windowSize' to 'screenMode'",
+				"Replaced 'gui.skipDiscardChangeWarning' by 'confirmations.discardChange'",
 			},
 		},
 	}
@@ -1184,3 +1194,112 @@ func TestPagerMigration(t *testing.T) {
 		})
 	}
 }
+
+func TestConfirmationFlagsMigration(t *testing.T) {
+	scenarios := []struct {
+		name              string
+		input             string
+		expected          string
+		expectedDidChange bool
+		expectedChanges   []string
+	}{
+		{
+			name:              "Incomplete Configuration Passes uneventfully",
+			input:             "confirmations:",
+			expectedDidChange: false,
+			expectedChanges:   []string{},
+		},
+		{
+			name: "No skip flags",
+			input: `gui:
+  showIcons: true
+`,
+			expected: `gui:
+  showIcons: true
+`,
+			expectedDidChange: false,
+			expectedChanges:   []string{},
+		},
+		{
+			name: "Skip flags are negated and moved to confirmations",
+			input: `gui:
+  skipAmendWarning: true
+  showIcons: true
+  skipStashWarning: false
+confirmOnQuit: true
+`,
+			expected: `gui:
+  showIcons: true
+confirmations:
+  amend: false
+  applyStash: true
+  quit: true
+`,
+			expectedDidChange: true,
+			expectedChanges: []string{
+				"Replaced 'gui.skipAmendWarning' by 'confirmations.amend'",
+				"Replaced 'gui.skipStashWarning' by 'confirmations.applyStash'",
+				"Replaced 'confirmOnQuit' by 'confirmations.quit'",
+			},
+		},
+		{
+			name: "Existing confirmations are kept",
+			input: `gui:
+  skipNoStagedFilesWarning: true
+  skipRewordInEditorWarning: true
+confirmations:
+  rewordInEditor: true
+`,
+			expected: `gui: {}
+confirmations:
+  rewordInEditor: true
+  commitWithoutStagedFiles: false
+`,
+			expectedDidChange: true,
+			expectedChanges: []string{
+				"Replaced 'gui.skipNoStagedFilesWarning' by 'confirmations.commitWithoutStagedFiles'",
+				"Replaced 'gui.skipRewordInEditorWarning' by 'confirmations.rewordInEditor'",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			changes := NewChangesSet()
+			actual, didChange, err := computeMigratedConfig("path doesn't matter", []byte(s.input), changes)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedDidChange, didChange)
+			if didChange {
+				assert.Equal(t, s.expected, string(actual))
+			}
+			assert.Equal(t, s.expectedChanges, changes.ToSliceFromOldest())
+		})
+	}
+}
+
+func TestSetGlobalUserConfigValue(t *testing.T) {
+	dir := t.TempDir()
+	firstPath := filepath.Join(dir, "first.yml")
+	// The directory of the last file doesn't exist yet
+	lastPath := filepath.Join(dir, "sub", "last.yml")
+	assert.NoError(t, os.WriteFile(firstPath, []byte("gui:\n  showIcons: true\n"), 0o644))
+
+	appConfig := &AppConfig{
+		globalUserConfigFiles: []*ConfigFile{
+			{Path: firstPath, Policy: ConfigFilePolicyErrorIfMissing},
+			{Path: lastPath, Policy: ConfigFilePolicySkipIfMissing},
+		},
+	}
+
+	path, err := appConfig.SetGlobalUserConfigValue([]string{"keybinding", "files", "commitChanges"}, "<disabled>")
+	assert.NoError(t, err)
+	assert.Equal(t, lastPath, path)
+
+	content, err := os.ReadFile(lastPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "keybinding:\n  files:\n    commitChanges: <disabled>\n", string(content))
+
+	content, err = os.ReadFile(firstPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "gui:\n  showIcons: true\n", string(content))
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/utils/yaml_utils"
+	"gopkg.in/yaml.v3"
+)
+
+// ExportUserConfig returns the given config as yaml. Since the config that
+// lazygit uses is the defaults merged with all config files, this shows the
+// values that are actually in effect, which is useful for sharing a config or
+// attaching it to a bug report.
+func ExportUserConfig(userConfig *UserConfig, version string) ([]byte, error) {
+	var node yaml.Node
+	if err := node.Encode(userConfig); err != nil {
+		return nil, err
+	}
+
+	content, err := yaml_utils.YamlMarshal(&node)
+	if err != nil {
+		return nil, err
+	}
+
+	header := fmt.Sprintf("# Effective config of lazygit %s (the defaults merged with all config files)\n\n", version)
+	return append([]byte(header), content...), nil
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExportUserConfig(t *testing.T) {
+	userConfig := GetDefaultConfig()
+	userConfig.Gui.ThemeFile = "dracula"
+	userConfig.Keybinding.Universal.Quit = "Q"
+	userConfig.CustomCommands = []CustomCommand{{Key: "X", Context: "files", Command: "echo hi"}}
+
+	content, err := ExportUserConfig(userConfig, "1.2.3")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "# Effective config of lazygit 1.2.3"))
+	assert.Contains(t, string(content), "\n  themeFile: dracula\n")
+
+	// Loading the exported config on top of the defaults gives back the same
+	// config (we compare the yaml because empty lists come back as non-nil)
+	loaded := GetDefaultConfig()
+	assert.NoError(t, yaml.Unmarshal(content, loaded))
+	reexported, err := ExportUserConfig(loaded, "1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), string(reexported))
+	assert.Equal(t, "Q", loaded.Keybinding.Universal.Quit)
+}
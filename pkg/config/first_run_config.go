@@ -0,0 +1,116 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FirstRunChoices are the answers given in the setup wizard that we offer on
+// the very first start of lazygit
+type FirstRunChoices struct {
+	// one of the bundled themes, or empty for the default theme
+	ThemeFile string
+	// '2' or '3', or empty for no icons
+	NerdFontsVersion string
+	// one of the editor presets, or empty to use $GIT_EDITOR/$VISUAL/$EDITOR
+	EditPreset string
+	// one of the values of git.pullMode
+	PullMode string
+}
+
+type firstRunConfigKey struct {
+	comment string
+	key     string
+	value   string
+	// the key is written commented out if it has its default value, so that
+	// users can see where to change it later
+	isDefault bool
+}
+
+// GenerateFirstRunConfig returns the content of a config file for the given
+// choices, with a comment explaining each key.
+func GenerateFirstRunConfig(choices FirstRunChoices) string {
+	pullMode := choices.PullMode
+	if pullMode == "" {
+		pullMode = "auto"
+	}
+
+	sections := []struct {
+		name string
+		keys []firstRunConfigKey
+	}{
+		{
+			name: "gui",
+			keys: []firstRunConfigKey{
+				{
+					comment:   "Theme to use; one of the bundled themes (" + strings.Join(BundledThemeNames(), ", ") + ") or the path of a theme file.\nSee https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#theme-files",
+					key:       "themeFile",
+					value:     choices.ThemeFile,
+					isDefault: choices.ThemeFile == "",
+				},
+				{
+					comment:   "Nerd fonts version to use for icons; '2' or '3', or empty for no icons.\nSee https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#display-nerd-fonts-icons",
+					key:       "nerdFontsVersion",
+					value:     choices.NerdFontsVersion,
+					isDefault: choices.NerdFontsVersion == "",
+				},
+			},
+		},
+		{
+			name: "git",
+			keys: []firstRunConfigKey{
+				{
+					comment:   "How to integrate the upstream branch when pulling; 'auto' leaves it to your git config.\nPossible values: 'auto' | 'merge' | 'rebase' | 'ff-only'",
+					key:       "pullMode",
+					value:     pullMode,
+					isDefault: pullMode == "auto",
+				},
+			},
+		},
+		{
+			name: "os",
+			keys: []firstRunConfigKey{
+				{
+					comment:   "Editor preset to use for editing files; if empty, lazygit uses $GIT_EDITOR, $VISUAL or $EDITOR.\nSee https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#configuring-file-editing",
+					key:       "editPreset",
+					value:     choices.EditPreset,
+					isDefault: choices.EditPreset == "",
+				},
+			},
+		},
+	}
+
+	var builder strings.Builder
+	builder.WriteString("# Config file for lazygit, written by the setup wizard on first start.\n")
+	builder.WriteString("# For all available options, see\n")
+	builder.WriteString("# https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md\n")
+
+	for _, section := range sections {
+		hasActiveKeys := false
+		for _, key := range section.keys {
+			hasActiveKeys = hasActiveKeys || !key.isDefault
+		}
+
+		builder.WriteString("\n")
+		// A section without any active keys would be parsed as null, so we
+		// comment out its header too
+		if hasActiveKeys {
+			builder.WriteString(section.name + ":\n")
+		} else {
+			builder.WriteString("# " + section.name + ":\n")
+		}
+
+		for _, key := range section.keys {
+			for _, line := range strings.Split(key.comment, "\n") {
+				builder.WriteString("  # " + line + "\n")
+			}
+			if key.isDefault {
+				builder.WriteString(fmt.Sprintf("  # %s: %q\n", key.key, key.value))
+			} else {
+				builder.WriteString(fmt.Sprintf("  %s: %q\n", key.key, key.value))
+			}
+		}
+	}
+
+	return builder.String()
+}
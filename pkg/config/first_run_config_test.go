@@ -0,0 +1,66 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerateFirstRunConfig(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		choices  FirstRunChoices
+		expected func(config *UserConfig, content string)
+	}{
+		{
+			name:    "all defaults",
+			choices: FirstRunChoices{PullMode: "auto"},
+			expected: func(config *UserConfig, content string) {
+				assert.Equal(t, GetDefaultConfig(), config)
+				assert.Contains(t, content, "# gui:\n")
+				assert.Contains(t, content, "  # themeFile: \"\"\n")
+				assert.Contains(t, content, "  # pullMode: \"auto\"\n")
+			},
+		},
+		{
+			name: "everything chosen",
+			choices: FirstRunChoices{
+				ThemeFile:        "dracula",
+				NerdFontsVersion: "3",
+				EditPreset:       "nvim",
+				PullMode:         "rebase",
+			},
+			expected: func(config *UserConfig, content string) {
+				assert.Equal(t, "dracula", config.Gui.ThemeFile)
+				assert.Equal(t, "3", config.Gui.NerdFontsVersion)
+				assert.Equal(t, "nvim", config.OS.EditPreset)
+				assert.Equal(t, "rebase", config.Git.PullMode)
+				assert.True(t, strings.HasPrefix(content, "# Config file for lazygit"))
+			},
+		},
+		{
+			name:    "only some chosen",
+			choices: FirstRunChoices{NerdFontsVersion: "2", PullMode: "auto"},
+			expected: func(config *UserConfig, content string) {
+				assert.Equal(t, "", config.Gui.ThemeFile)
+				assert.Equal(t, "2", config.Gui.NerdFontsVersion)
+				assert.Equal(t, "auto", config.Git.PullMode)
+				assert.Contains(t, content, "gui:\n")
+				assert.Contains(t, content, "  # themeFile: \"\"\n")
+				assert.Contains(t, content, "# git:\n")
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			content := GenerateFirstRunConfig(s.choices)
+
+			config := GetDefaultConfig()
+			assert.NoError(t, yaml.Unmarshal([]byte(content), config))
+			s.expected(config, content)
+		})
+	}
+}
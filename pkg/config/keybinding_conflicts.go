@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// KeybindingEntry is a single key from the keybinding config, e.g. the key of
+// keybinding.files.commitChanges
+type KeybindingEntry struct {
+	// the section of the keybinding config, e.g. 'files'
+	Section string
+	// the name of the key within its section, e.g. 'commitChanges'; for list
+	// values the index is appended, e.g. 'jumpToBlock[2]'
+	Name string
+	Key  string
+	// whether the user changed the key from its default value
+	IsOverride bool
+	// whether the entry is an element of a list value, in which case it can't
+	// be changed on its own
+	IsListElement bool
+}
+
+func (self KeybindingEntry) Path() string {
+	return self.Section + "." + self.Name
+}
+
+// KeybindingConflict is a pair of keybindings that use the same key in places
+// where they are active at the same time, at least one of which was changed
+// by the user
+type KeybindingConflict struct {
+	Override KeybindingEntry
+	Other    KeybindingEntry
+}
+
+// GetKeybindingEntries returns all keys of the given keybinding config, in the
+// order in which they appear in the config, marking the ones that differ from
+// the defaults.
+func GetKeybindingEntries(keybindingConfig KeybindingConfig, defaults KeybindingConfig) []KeybindingEntry {
+	entries := []KeybindingEntry{}
+
+	sections := reflect.ValueOf(keybindingConfig)
+	defaultSections := reflect.ValueOf(defaults)
+	for i, sectionField := range reflect.VisibleFields(sections.Type()) {
+		sectionName := yamlName(sectionField)
+		section := sections.Field(i)
+		defaultSection := defaultSections.Field(i)
+
+		for j, field := range reflect.VisibleFields(section.Type()) {
+			name := yamlName(field)
+			value := section.Field(j)
+			defaultValue := defaultSection.Field(j)
+
+			switch value.Kind() {
+			case reflect.String:
+				entries = append(entries, KeybindingEntry{
+					Section:    sectionName,
+					Name:       name,
+					Key:        value.String(),
+					IsOverride: value.String() != defaultValue.String(),
+				})
+			case reflect.Slice:
+				for k := range value.Len() {
+					key := value.Index(k).String()
+					entries = append(entries, KeybindingEntry{
+						Section:       sectionName,
+						Name:          fmt.Sprintf("%s[%d]", name, k),
+						Key:           key,
+						IsOverride:    k >= defaultValue.Len() || key != defaultValue.Index(k).String(),
+						IsListElement: true,
+					})
+				}
+			}
+		}
+	}
+
+	return entries
+}
+
+// FindKeybindingConflicts returns the pairs of entries that use the same key,
+// where at least one of them was changed by the user. Built-in keybindings
+// that share a key by default are not reported, since that's intentional.
+// Keys of the universal section are active everywhere, so they can conflict
+// with keys of any section; the other sections only conflict with themselves.
+func FindKeybindingConflicts(entries []KeybindingEntry) []KeybindingConflict {
+	conflicts := []KeybindingConflict{}
+
+	for i, entry := range entries {
+		if !entry.IsOverride || !isActiveKey(entry.Key) {
+			continue
+		}
+
+		for j, other := range entries {
+			if i == j || other.Key != entry.Key {
+				continue
+			}
+			if entry.Section != other.Section && entry.Section != "universal" && other.Section != "universal" {
+				continue
+			}
+			// Report conflicts between two overrides only once
+			if other.IsOverride && j < i {
+				continue
+			}
+
+			conflicts = append(conflicts, KeybindingConflict{Override: entry, Other: other})
+		}
+	}
+
+	return conflicts
+}
+
+func isActiveKey(key string) bool {
+	return key != "" && key != "<disabled>"
+}
+
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	return lo.Ternary(name != "", name, field.Name)
+}
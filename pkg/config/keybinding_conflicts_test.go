@@ -0,0 +1,114 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetKeybindingEntries(t *testing.T) {
+	defaults := GetDefaultConfig().Keybinding
+	keybindings := GetDefaultConfig().Keybinding
+	keybindings.Files.CommitChanges = "C"
+	keybindings.Universal.JumpToBlock = []string{"1", "2", "3", "4", "6"}
+
+	entries := GetKeybindingEntries(keybindings, defaults)
+
+	commitChanges, _ := lo.Find(entries, func(entry KeybindingEntry) bool {
+		return entry.Path() == "files.commitChanges"
+	})
+	assert.Equal(t, KeybindingEntry{Section: "files", Name: "commitChanges", Key: "C", IsOverride: true}, commitChanges)
+
+	quit, _ := lo.Find(entries, func(entry KeybindingEntry) bool {
+		return entry.Path() == "universal.quit-alt1"
+	})
+	assert.Equal(t, KeybindingEntry{Section: "universal", Name: "quit-alt1", Key: "<c-c>"}, quit)
+
+	jumpToBlock := lo.Filter(entries, func(entry KeybindingEntry, _ int) bool {
+		return entry.IsListElement
+	})
+	assert.Len(t, jumpToBlock, 5)
+	assert.Equal(t, "universal.jumpToBlock[4]", jumpToBlock[4].Path())
+	assert.True(t, jumpToBlock[4].IsOverride)
+	assert.False(t, jumpToBlock[3].IsOverride)
+
+	assert.Empty(t, lo.Filter(entries, func(entry KeybindingEntry, _ int) bool {
+		return entry.IsOverride && entry.Path() != "files.commitChanges" && entry.Path() != "universal.jumpToBlock[4]"
+	}))
+}
+
+func TestFindKeybindingConflicts(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		change   func(keybindings *KeybindingConfig)
+		expected []string
+	}{
+		{
+			name:     "defaults have no conflicts",
+			change:   func(keybindings *KeybindingConfig) {},
+			expected: []string{},
+		},
+		{
+			name: "override clashes with key of the same section",
+			change: func(keybindings *KeybindingConfig) {
+				keybindings.Files.CommitChanges = "a"
+			},
+			expected: []string{"files.commitChanges -> files.toggleStagedAll"},
+		},
+		{
+			name: "override clashes with universal key",
+			change: func(keybindings *KeybindingConfig) {
+				keybindings.Stash.PopStash = "q"
+			},
+			expected: []string{"stash.popStash -> universal.quit"},
+		},
+		{
+			name: "universal override clashes with keys of other sections",
+			change: func(keybindings *KeybindingConfig) {
+				keybindings.Universal.Refresh = "P"
+			},
+			expected: []string{
+				"universal.refresh -> universal.pushFiles",
+				"universal.refresh -> branches.pushTag",
+			},
+		},
+		{
+			name: "keys of unrelated sections don't clash",
+			change: func(keybindings *KeybindingConfig) {
+				// 'f' is used in the files, branches and commits sections
+				keybindings.Stash.RenameStash = "f"
+			},
+			expected: []string{},
+		},
+		{
+			name: "two overrides clashing are reported once",
+			change: func(keybindings *KeybindingConfig) {
+				keybindings.Files.CommitChanges = "X"
+				keybindings.Files.IgnoreFile = "X"
+			},
+			expected: []string{"files.commitChanges -> files.ignoreFile"},
+		},
+		{
+			name: "disabled keys don't clash",
+			change: func(keybindings *KeybindingConfig) {
+				keybindings.Files.CommitChanges = "<disabled>"
+				keybindings.Files.IgnoreFile = "<disabled>"
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			defaults := GetDefaultConfig().Keybinding
+			keybindings := GetDefaultConfig().Keybinding
+			s.change(&keybindings)
+
+			conflicts := FindKeybindingConflicts(GetKeybindingEntries(keybindings, defaults))
+			assert.Equal(t, s.expected, lo.Map(conflicts, func(conflict KeybindingConflict, _ int) string {
+				return conflict.Override.Path() + " -> " + conflict.Other.Path()
+			}))
+		})
+	}
+}
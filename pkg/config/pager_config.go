@@ -1,7 +1,9 @@
 package config
 
 import (
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -73,6 +75,40 @@ func (self *PagerConfig) GetUseExternalDiffGitConfig() bool {
 	return currentPagerConfig.UseExternalDiffGitConfig
 }
 
+// GetDiffColorConfigs returns the git config settings that make git use the
+// theme's diff colors, so that diffs in the main view match the staging view.
+// Colors that are left at their defaults are not passed on, so that the user's
+// own git color config still applies.
+func (self *PagerConfig) GetDiffColorConfigs() []string {
+	theme := self.getUserConfig().Gui.Theme
+	defaultTheme := GetDefaultConfig().Gui.Theme
+
+	result := []string{}
+	if !slices.Equal(theme.DiffAddedColor, defaultTheme.DiffAddedColor) {
+		result = append(result, "color.diff.new="+gitColor(theme.DiffAddedColor))
+	}
+	if !slices.Equal(theme.DiffRemovedColor, defaultTheme.DiffRemovedColor) {
+		result = append(result, "color.diff.old="+gitColor(theme.DiffRemovedColor))
+	}
+	return result
+}
+
+// Converts a theme color to git's color syntax. Color names and hex values
+// are the same in both; only some of the attribute names differ.
+func gitColor(keys []string) string {
+	gitKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		switch key {
+		case "underline":
+			key = "ul"
+		case "strikethrough":
+			key = "strike"
+		}
+		gitKeys = append(gitKeys, key)
+	}
+	return strings.Join(gitKeys, " ")
+}
+
 func (self *PagerConfig) CyclePagers() {
 	self.pagerIndex = (self.pagerIndex + 1) % len(self.getUserConfig().Git.Pagers)
 }
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed themes/*.yml
+var bundledThemes embed.FS
+
+// BundledThemeNames returns the names of the themes that ship with lazygit,
+// which can be used as the value of gui.themeFile
+func BundledThemeNames() []string {
+	entries, err := bundledThemes.ReadDir("themes")
+	if err != nil {
+		return nil
+	}
+
+	return lo.Map(entries, func(entry os.DirEntry, _ int) string {
+		return strings.TrimSuffix(entry.Name(), ".yml")
+	})
+}
+
+func readThemeFile(themeFile string, baseDir string) ([]byte, error) {
+	if lo.Contains(BundledThemeNames(), themeFile) {
+		return bundledThemes.ReadFile("themes/" + themeFile + ".yml")
+	}
+
+	path := themeFile
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, path[2:])
+	} else if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+
+	return os.ReadFile(path)
+}
+
+// applyThemeFile loads the theme file named by gui.themeFile into the given
+// config. Theme keys that the config files set explicitly take precedence, so
+// we re-apply the `gui.theme` section of each config file on top of the theme.
+func applyThemeFile(base *UserConfig, themeFileDir string, configContents [][]byte) error {
+	themeContent, err := readThemeFile(base.Gui.ThemeFile, themeFileDir)
+	if err != nil {
+		return fmt.Errorf("The theme file `%s` couldn't be read.\n%w", base.Gui.ThemeFile, err)
+	}
+
+	theme := GetDefaultConfig().Gui.Theme
+	if err := yaml.Unmarshal(themeContent, &theme); err != nil {
+		return fmt.Errorf("The theme file `%s` couldn't be parsed.\n%w", base.Gui.ThemeFile, err)
+	}
+
+	var themeOverrides struct {
+		Gui struct {
+			Theme *ThemeConfig `yaml:"theme"`
+		} `yaml:"gui"`
+	}
+	themeOverrides.Gui.Theme = &theme
+	for _, content := range configContents {
+		if err := yaml.Unmarshal(content, &themeOverrides); err != nil {
+			return err
+		}
+	}
+
+	base.Gui.Theme = theme
+	return nil
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyThemeFile(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		themeFile      string
+		themeContent   string
+		configContents []string
+		expectedErr    string
+		expected       func(theme ThemeConfig)
+	}{
+		{
+			name:      "bundled theme",
+			themeFile: "dracula",
+			expected: func(theme ThemeConfig) {
+				assert.Equal(t, []string{"#bd93f9", "bold"}, theme.ActiveBorderColor)
+				assert.Equal(t, []string{"#50fa7b"}, theme.DiffAddedColor)
+			},
+		},
+		{
+			name:         "theme file relative to config dir",
+			themeFile:    "mytheme.yml",
+			themeContent: "activeBorderColor:\n  - '#123456'\n",
+			expected: func(theme ThemeConfig) {
+				assert.Equal(t, []string{"#123456"}, theme.ActiveBorderColor)
+				// keys missing from the theme file keep their defaults
+				assert.Equal(t, []string{"blue"}, theme.OptionsTextColor)
+			},
+		},
+		{
+			name:      "explicit theme keys in config take precedence",
+			themeFile: "dracula",
+			configContents: []string{
+				"gui:\n  themeFile: dracula\n  theme:\n    activeBorderColor:\n      - red\n",
+			},
+			expected: func(theme ThemeConfig) {
+				assert.Equal(t, []string{"red"}, theme.ActiveBorderColor)
+				assert.Equal(t, []string{"#6272a4"}, theme.InactiveBorderColor)
+			},
+		},
+		{
+			name:      "color-blind friendly theme",
+			themeFile: "deuteranopia",
+			configContents: []string{
+				"gui:\n  themeFile: deuteranopia\n  theme:\n    diffAddedColor:\n      - cyan\n",
+			},
+			expected: func(theme ThemeConfig) {
+				assert.Equal(t, []string{"cyan"}, theme.DiffAddedColor)
+				assert.Equal(t, []string{"#e69f00"}, theme.DiffRemovedColor)
+				assert.Equal(t, []string{"#cc79a7", "bold"}, theme.ConflictColor)
+				// keys that the theme doesn't set keep their defaults
+				assert.Equal(t, []string{"green", "bold"}, theme.ActiveBorderColor)
+			},
+		},
+		{
+			name:        "missing theme file",
+			themeFile:   "does-not-exist.yml",
+			expectedErr: "The theme file `does-not-exist.yml` couldn't be read.",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if s.themeContent != "" {
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, s.themeFile), []byte(s.themeContent), 0o644))
+			}
+
+			config := GetDefaultConfig()
+			config.Gui.ThemeFile = s.themeFile
+			configContents := [][]byte{}
+			for _, content := range s.configContents {
+				configContents = append(configContents, []byte(content))
+			}
+
+			err := applyThemeFile(config, dir, configContents)
+			if s.expectedErr != "" {
+				assert.ErrorContains(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			s.expected(config.Gui.Theme)
+		})
+	}
+}
+
+func TestThemeFileFromRepoConfigKeepsExplicitGlobalThemeKeys(t *testing.T) {
+	dir := t.TempDir()
+	globalConfig := filepath.Join(dir, "config.yml")
+	repoConfig := filepath.Join(dir, "repo-config.yml")
+	assert.NoError(t, os.WriteFile(globalConfig, []byte("gui:\n  theme:\n    activeBorderColor:\n      - red\n"), 0o644))
+	assert.NoError(t, os.WriteFile(repoConfig, []byte("gui:\n  themeFile: dracula\n  theme:\n    diffAddedColor:\n      - cyan\n"), 0o644))
+
+	config, err := loadUserConfigWithDefaults([]*ConfigFile{
+		{Path: globalConfig, Policy: ConfigFilePolicyErrorIfMissing},
+		{Path: repoConfig, Policy: ConfigFilePolicyErrorIfMissing},
+	}, true)
+	assert.NoError(t, err)
+
+	// explicit keys of both the global and the repo config win over the theme
+	// file, even though the global config comes before the one that chose it
+	assert.Equal(t, []string{"red"}, config.Gui.Theme.ActiveBorderColor)
+	assert.Equal(t, []string{"cyan"}, config.Gui.Theme.DiffAddedColor)
+	// everything else comes from the theme file
+	assert.Equal(t, []string{"#6272a4"}, config.Gui.Theme.InactiveBorderColor)
+}
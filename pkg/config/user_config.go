@@ -1,8 +1,11 @@
 package config
 
 import (
+	"path"
+	"slices"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/karimkhaleel/jsonschema"
 )
 
@@ -15,8 +18,8 @@ type UserConfig struct {
 	Update UpdateConfig `yaml:"update"`
 	// Background refreshes
 	Refresher RefresherConfig `yaml:"refresher"`
-	// If true, show a confirmation popup before quitting Lazygit
-	ConfirmOnQuit bool `yaml:"confirmOnQuit"`
+	// Which actions ask for confirmation before they are performed
+	Confirmations ConfirmationsConfig `yaml:"confirmations"`
 	// If true, exit Lazygit when the user presses escape in a context where there is nothing to cancel/close
 	QuitOnTopLevelReturn bool `yaml:"quitOnTopLevelReturn"`
 	// Config relating to things outside of Lazygit like how files are opened, copying to clipboard, etc
@@ -28,12 +31,18 @@ type UserConfig struct {
 	CustomCommands []CustomCommand `yaml:"customCommands" jsonschema:"uniqueItems=true"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	Services map[string]string `yaml:"services"`
+	// Additional service types for self-hosted forges that none of the built-in providers fit. The keys can be used as the provider in 'services'.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-service-types
+	ServiceTypes map[string]ServiceTypeConfig `yaml:"serviceTypes"`
 	// What to do when opening Lazygit outside of a git repo.
 	// - 'prompt': (default) ask whether to initialize a new repo or open in the most recent repo
 	// - 'create': initialize a new repo
 	// - 'skip': open most recent repo
 	// - 'quit': exit Lazygit
 	NotARepository string `yaml:"notARepository" jsonschema:"enum=prompt,enum=create,enum=skip,enum=quit"`
+	// Directories in which to look for git repos to offer in the recent repos menu, in addition to the recent repos, e.g. ['~/src'].
+	// Repos are searched for up to three levels below these directories.
+	RepoSearchRoots []string `yaml:"repoSearchRoots"`
 	// If true, display a confirmation when subprocess terminates. This allows you to view the output of the subprocess before returning to Lazygit.
 	PromptToReturnFromSubprocess bool `yaml:"promptToReturnFromSubprocess"`
 	// Keybindings
@@ -57,6 +66,27 @@ func (c *RefresherConfig) FetchIntervalDuration() time.Duration {
 	return time.Second * time.Duration(c.FetchInterval)
 }
 
+type ConfirmationsConfig struct {
+	// Confirm amending the last commit with the staged changes
+	Amend bool `yaml:"amend"`
+	// Confirm discarding unstaged changes in the staging view
+	DiscardChange bool `yaml:"discardChange"`
+	// Confirm applying or popping a stash entry
+	ApplyStash bool `yaml:"applyStash"`
+	// When committing without any staged files, ask whether to stage all files. If false, stage all files without asking.
+	CommitWithoutStagedFiles bool `yaml:"commitWithoutStagedFiles"`
+	// Confirm rewording a commit in an external editor
+	RewordInEditor bool `yaml:"rewordInEditor"`
+	// When checking out a branch that is checked out in a different worktree, ask whether to switch to that worktree. If false, switch without asking.
+	SwitchWorktreeOnCheckout bool `yaml:"switchWorktreeOnCheckout"`
+	// Confirm force pushing a branch that has diverged from its upstream. If the push is rejected by the remote, lazygit always asks whether to force push, regardless of this setting.
+	ForcePush bool `yaml:"forcePush"`
+	// Confirm deleting a local branch that is fully merged. Deleting an unmerged branch is always confirmed, since its commits would be lost.
+	DeleteMergedBranch bool `yaml:"deleteMergedBranch"`
+	// Confirm quitting lazygit
+	Quit bool `yaml:"quit"`
+}
+
 type GuiConfig struct {
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-author-color
 	AuthorColors map[string]string `yaml:"authorColors"`
@@ -82,21 +112,17 @@ type GuiConfig struct {
 	// If true, capture mouse events.
 	// When mouse events are captured, it's a little harder to select text: e.g. requiring you to hold the option key when on macOS.
 	MouseEvents bool `yaml:"mouseEvents"`
-	// If true, do not show a warning when amending a commit.
-	SkipAmendWarning bool `yaml:"skipAmendWarning"`
-	// If true, do not show a warning when discarding changes in the staging view.
-	SkipDiscardChangeWarning bool `yaml:"skipDiscardChangeWarning"`
-	// If true, do not show warning when applying/popping the stash
-	SkipStashWarning bool `yaml:"skipStashWarning"`
-	// If true, do not show a warning when attempting to commit without any staged files; instead stage all unstaged files.
-	SkipNoStagedFilesWarning bool `yaml:"skipNoStagedFilesWarning"`
-	// If true, do not show a warning when rewording a commit via an external editor
-	SkipRewordInEditorWarning bool `yaml:"skipRewordInEditorWarning"`
-	// If true, switch to a different worktree without confirmation when checking out a branch that is checked out in that worktree
-	SkipSwitchWorktreeOnCheckoutWarning bool `yaml:"skipSwitchWorktreeOnCheckoutWarning"`
+	// Config relating to mouse gestures. Only relevant if mouseEvents is true.
+	Mouse MouseConfig `yaml:"mouse"`
 	// Fraction of the total screen width to use for the left side section. You may want to pick a small number (e.g. 0.2) if you're using a narrow screen, so that you can see more of the main section.
 	// Number from 0 to 1.0.
 	SidePanelWidth float64 `yaml:"sidePanelWidth" jsonschema:"maximum=1,minimum=0"`
+	// If greater than 0, the left side section has this fixed width (in columns) instead of taking up the fraction of the screen given by `sidePanelWidth`.
+	SidePanelFixedWidth int `yaml:"sidePanelFixedWidth" jsonschema:"minimum=0"`
+	// The side panels to show, in top-to-bottom order. Panels that are omitted from the list are hidden.
+	// Possible values are 'status', 'files', 'branches', 'commits', and 'stash'.
+	// The order and visibility can also be changed at runtime from the side panel layout menu; those changes are remembered across restarts until you reset them.
+	SidePanels []string `yaml:"sidePanels" jsonschema:"uniqueItems=true,minItems=1"`
 	// If true, increase the height of the focused side window; creating an accordion effect.
 	ExpandFocusedSidePanel bool `yaml:"expandFocusedSidePanel"`
 	// The weight of the expanded side panel, relative to the other panels. 2 means twice as tall as the other panels. Only relevant if `expandFocusedSidePanel` is true.
@@ -114,23 +140,48 @@ type GuiConfig struct {
 	EnlargedSideViewLocation string `yaml:"enlargedSideViewLocation"`
 	// If true, wrap lines in the staging view to the width of the view. This makes it much easier to work with diffs that have long lines, e.g. paragraphs of markdown text.
 	WrapLinesInStagingView bool `yaml:"wrapLinesInStagingView"`
+	// If true, wrap long lines in the main view (e.g. diffs and file contents) to the width of the view. If false, long lines are cut off and you can scroll horizontally instead.
+	// Both this and wrapLinesInStagingView can be toggled at runtime with the toggleLineWrap keybinding.
+	WrapLinesInMainView bool `yaml:"wrapLinesInMainView"`
+	// If true, show tabs as '→', and highlight trailing spaces ('·') and carriage returns ('␍') in the main, staging and merge conflicts views. This helps with diagnosing whitespace-only changes and conflicts. Has no effect on the output of a custom pager.
+	// Can be toggled at runtime with the toggleShowWhitespaceInDiffView keybinding.
+	ShowWhitespaceInDiffView bool `yaml:"showWhitespaceInDiffView"`
 	// If true, hunk selection mode will be enabled by default when entering the staging view.
 	UseHunkModeInStagingView bool `yaml:"useHunkModeInStagingView"`
 	// One of 'auto' (default) | 'en' | 'zh-CN' | 'zh-TW' | 'pl' | 'nl' | 'ja' | 'ko' | 'ru' | 'pt'
+	// A regional variant like 'pt-BR' uses the translation of its base language, and texts that aren't translated are shown in English.
 	Language string `yaml:"language" jsonschema:"enum=auto,enum=en,enum=zh-TW,enum=zh-CN,enum=pl,enum=nl,enum=ja,enum=ko,enum=ru"`
+	// Whether to reorder text in right-to-left scripts like Arabic or Hebrew for display, for terminals that don't do this themselves. Applies to panel titles, lists, prompts and menus, but not to the main view or to text being edited.
+	// One of 'auto' (default; on if the language is a right-to-left one) | 'on' | 'off'
+	RightToLeftReordering string `yaml:"rightToLeftReordering" jsonschema:"enum=auto,enum=on,enum=off"`
 	// Format used when displaying time e.g. commit time.
 	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
 	TimeFormat string `yaml:"timeFormat"`
 	// Format used when displaying time if the time is less than 24 hours ago.
 	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
 	ShortTimeFormat string `yaml:"shortTimeFormat"`
+	// Time formats to use in individual panels. Each value is one of:
+	// - 'relative': show how long ago it was, e.g. '2d'
+	// - 'absolute': use `timeFormat` and `shortTimeFormat`
+	// - a custom format using Go's time format syntax
+	// You can switch between relative and absolute dates at runtime with the `toggleRelativeDates` keybinding.
+	PanelTimeFormats PanelTimeFormatsConfig `yaml:"panelTimeFormats"`
 	// Config relating to colors and styles.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#color-attributes
 	Theme ThemeConfig `yaml:"theme"`
+	// Theme to load the colors and styles from. Either the name of a bundled theme ('dracula', 'gruvbox-dark', 'solarized-light', or one of the color-blind friendly themes 'deuteranopia' and 'protanopia') or the path to a YAML file containing the same keys as `theme`. Relative paths are resolved against the directory of the config file that sets this option.
+	// Keys that you set explicitly under `theme` take precedence over the ones from the theme file.
+	ThemeFile string `yaml:"themeFile"`
 	// Config relating to the commit length indicator
 	CommitLength CommitLengthConfig `yaml:"commitLength"`
-	// If true, show the '5 of 20' footer at the bottom of list views
+	// If true, show the '5 of 20' footer at the bottom of list views, and the 'line 21/400 (10%)' footer at the bottom of the main view when its content is longer than the view
 	ShowListFooter bool `yaml:"showListFooter"`
+	// If true, keep the file and hunk headers of a diff pinned to the top of the main view when scrolling through it, so that you can see which file the visible lines belong to
+	StickyDiffHeaders bool `yaml:"stickyDiffHeaders"`
+	// If true, replace the scrollbar of the main view by a minimap showing where the additions and deletions of a diff are, and which part of it is visible
+	ShowDiffMinimap bool `yaml:"showDiffMinimap"`
+	// How to preview image files in the main view. With 'auto', images are drawn using the kitty graphics protocol or iTerm2's inline images protocol if the terminal is known to support one of them. With 'none', or if the terminal isn't supported, only a description of the image (format, size in pixels and file size) is shown.
+	ImagePreviewProtocol string `yaml:"imagePreviewProtocol" jsonschema:"enum=auto,enum=kitty,enum=iterm2,enum=none"`
 	// If true, display the files in the file views as a tree. If false, display the files as a flat list.
 	// This can be toggled from within Lazygit with the '`' key, but that will not change the default.
 	ShowFileTree bool `yaml:"showFileTree"`
@@ -157,7 +208,12 @@ type GuiConfig struct {
 	// One of: '2' | '3' | empty string (default)
 	// If empty, do not show icons.
 	NerdFontsVersion string `yaml:"nerdFontsVersion" jsonschema:"enum=2,enum=3,enum="`
-	// If true (default), file icons are shown in the file views. Only relevant if NerdFontsVersion is not empty.
+	// Icon set to use. Takes precedence over nerdFontsVersion if set.
+	// One of: 'nerdFontsV2' | 'nerdFontsV3' | 'ascii' | 'emoji' | empty string (default)
+	// If empty, use the icons of nerdFontsVersion. File type icons are only available with the nerd fonts sets.
+	// Individual icons can be overridden using customIcons.icons.
+	IconSet string `yaml:"iconSet" jsonschema:"enum=,enum=nerdFontsV2,enum=nerdFontsV3,enum=ascii,enum=emoji"`
+	// If true (default), file icons are shown in the file views. Only relevant if icons are enabled.
 	ShowFileIcons bool `yaml:"showFileIcons"`
 	// Length of author name in (non-expanded) commits view. 2 means show initials only.
 	CommitAuthorShortLength int `yaml:"commitAuthorShortLength"`
@@ -165,17 +221,40 @@ type GuiConfig struct {
 	CommitAuthorLongLength int `yaml:"commitAuthorLongLength"`
 	// Length of commit hash in commits view. 0 shows '*' if NF icons aren't on.
 	CommitHashLength int `yaml:"commitHashLength" jsonschema:"minimum=0"`
+	// Characters used to draw the commit graph.
+	// One of: 'rounded' (default) | 'compact' | 'ascii'
+	// 'compact' uses a single column per branch, which is useful for histories with many parallel branches.
+	// 'ascii' avoids box-drawing characters, for fonts that don't support them.
+	CommitGraphStyle string `yaml:"commitGraphStyle" jsonschema:"enum=rounded,enum=compact,enum=ascii"`
 	// If true, show commit hashes alongside branch names in the branches view.
 	ShowBranchCommitHash bool `yaml:"showBranchCommitHash"`
 	// Whether to show the divergence from the base branch in the branches view.
 	// One of: 'none' | 'onlyArrow'  | 'arrowAndNumber'
 	ShowDivergenceFromBaseBranch string `yaml:"showDivergenceFromBaseBranch" jsonschema:"enum=none,enum=onlyArrow,enum=arrowAndNumber"`
+	// If true, separate the commits that haven't been pushed yet from the ones
+	// that have with section headers in the commits view. The pushed commits can
+	// be collapsed with the `collapsePushedCommits` key.
+	SeparatePushedCommits bool `yaml:"separatePushedCommits"`
+	// If true, show the status of CI checks next to branch heads and recently
+	// pushed commits. Supported for GitHub repos if you are logged in with the
+	// `gh` CLI or have set GITHUB_TOKEN, and for GitLab repos if you have set
+	// GITLAB_TOKEN.
+	ShowCIStatus bool `yaml:"showCIStatus"`
+	// If true, remember the focused panel, the selected items, active filters
+	// and diffing mode of each repo when quitting, and restore them the next
+	// time the repo is opened.
+	RestoreSession bool `yaml:"restoreSession"`
 	// Height of the command log view
 	CommandLogSize int `yaml:"commandLogSize" jsonschema:"minimum=0"`
 	// Whether to split the main window when viewing file changes.
 	// One of: 'auto' | 'always'
 	// If 'auto', only split the main window when a file has both staged and unstaged changes
 	SplitDiff string `yaml:"splitDiff" jsonschema:"enum=auto,enum=always"`
+	// How diffs are shown in the main view.
+	// One of: 'unified' (default) | 'side-by-side'
+	// If 'side-by-side', the old version of each hunk is shown on the left and the new one on the right. Has no effect when using a custom pager or external diff command.
+	// Can be toggled at runtime with the toggleDiffMode keybinding.
+	DiffMode string `yaml:"diffMode" jsonschema:"enum=unified,enum=side-by-side"`
 	// Default size for focused window. Can be changed from within Lazygit with '+' and '_' (but this won't change the default).
 	// One of: 'normal' (default) | 'half' | 'full'
 	ScreenMode string `yaml:"screenMode" jsonschema:"enum=normal,enum=half,enum=full"`
@@ -191,26 +270,66 @@ type GuiConfig struct {
 	PortraitModeAutoMaxWidth int `yaml:"portraitModeAutoMaxWidth"`
 	// In 'auto' mode, portrait mode will be used if the window width is less than or equal to portraitModeAutoMaxWidth and the window height is greater than or equal to portraitModeAutoMinHeight. Unused when portraitMode is not 'auto'.
 	PortraitModeAutoMinHeight int `yaml:"portraitModeAutoMinHeight"`
+	// Where to put the main view relative to the side panels.
+	// One of 'auto' (default) | 'right' | 'left' | 'bottom'
+	// 'auto' puts the main view on the right, or at the bottom when portrait mode is in effect (see `portraitMode`). The other values override portrait mode.
+	MainPanelPosition string `yaml:"mainPanelPosition" jsonschema:"enum=auto,enum=right,enum=left,enum=bottom"`
+	// How to stack the side panels.
+	// One of 'vertical' (default) | 'horizontal'
+	// 'horizontal' places the side panels next to each other, which can be a good use of space on ultra-wide monitors, particularly with `mainPanelPosition: bottom`.
+	SidePanelsStacking string `yaml:"sidePanelsStacking" jsonschema:"enum=vertical,enum=horizontal"`
 	// How things are filtered when typing '/'.
 	// One of 'substring' (default) | 'fuzzy'
 	FilterMode string `yaml:"filterMode" jsonschema:"enum=substring,enum=fuzzy"`
 	// Config relating to the spinner.
 	Spinner SpinnerConfig `yaml:"spinner"`
 	// Status panel view.
-	// One of 'dashboard' (default) | 'allBranchesLog'
-	StatusPanelView string `yaml:"statusPanelView" jsonschema:"enum=dashboard,enum=allBranchesLog"`
+	// One of 'dashboard' (default) | 'allBranchesLog' | 'repoOverview'
+	// 'repoOverview' shows a summary of the repo's state, which makes for a good
+	// start screen when launching lazygit with `lazygit status`.
+	StatusPanelView string `yaml:"statusPanelView" jsonschema:"enum=dashboard,enum=allBranchesLog,enum=repoOverview"`
 	// If true, jump to the Files panel after popping a stash
 	SwitchToFilesAfterStashPop bool `yaml:"switchToFilesAfterStashPop"`
 	// If true, jump to the Files panel after applying a stash
 	SwitchToFilesAfterStashApply bool `yaml:"switchToFilesAfterStashApply"`
 	// If true, when using the panel jump keys (default 1 through 5) and target panel is already active, go to next tab instead
 	SwitchTabsWithPanelJumpKeys bool `yaml:"switchTabsWithPanelJumpKeys"`
+	// Config relating to using lazygit with a screen reader
+	Accessibility AccessibilityConfig `yaml:"accessibility"`
 }
 
 func (c *GuiConfig) UseFuzzySearch() bool {
 	return c.FilterMode == "fuzzy"
 }
 
+// The number of lines to scroll per mouse wheel tick
+func (c *GuiConfig) MouseWheelScrollHeight() int {
+	if c.Mouse.WheelScrollHeight > 0 {
+		return c.Mouse.WheelScrollHeight
+	}
+	return c.ScrollHeight
+}
+
+type AccessibilityConfig struct {
+	// If true, use only ASCII characters for frames and scrollbars, don't show icons or the commit graph, and put the terminal cursor on the selected line so that screen readers can follow it
+	Enabled bool `yaml:"enabled"`
+	// If set (and accessibility mode is enabled), lazygit appends a line to this file whenever the focused view or its selection changes, and for each status message, e.g. 'Local branches: 2 of 5: feature'. This can be fed to a screen reader or text-to-speech tool, e.g. with 'tail -f'.
+	AnnouncementsFile string `yaml:"announcementsFile"`
+}
+
+type MouseConfig struct {
+	// The number of lines to scroll per mouse wheel tick. If 0 (default), the value of 'scrollHeight' is used.
+	WheelScrollHeight int `yaml:"wheelScrollHeight" jsonschema:"minimum=0"`
+	// What to do when double-clicking a file in the Files view.
+	// One of: 'stage' (default) | 'edit' | 'open'
+	// 'stage' toggles the staged status of the file, 'edit' opens it in your editor, and 'open' opens it with the default application for its file type. Double-clicking a directory always toggles its staged status.
+	DoubleClickFileAction string `yaml:"doubleClickFileAction" jsonschema:"enum=stage,enum=edit,enum=open"`
+	// If true, clicking the middle mouse button in a text input (e.g. the commit message panel) pastes the contents of the clipboard at the clicked position.
+	MiddleClickPaste bool `yaml:"middleClickPaste"`
+	// If true, dragging the mouse in the staging view selects a range of lines.
+	DragToSelectInStagingView bool `yaml:"dragToSelectInStagingView"`
+}
+
 type ThemeConfig struct {
 	// Border color of focused window
 	ActiveBorderColor []string `yaml:"activeBorderColor" jsonschema:"minItems=1,uniqueItems=true"`
@@ -237,6 +356,71 @@ type ThemeConfig struct {
 	UnstagedChangesColor []string `yaml:"unstagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Default text color
 	DefaultFgColor []string `yaml:"defaultFgColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of added lines in the staging and patch building views, and of the added line counts in the files panel.
+	// When set to something other than the default, it is also used for the diffs that git shows in the main view (unless you use an external pager).
+	DiffAddedColor []string `yaml:"diffAddedColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of removed lines in the staging and patch building views, and of the removed line counts in the files panel.
+	// When set to something other than the default, it is also used for the diffs that git shows in the main view (unless you use an external pager).
+	DiffRemovedColor []string `yaml:"diffRemovedColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of conflict markers in the merge conflicts view, and of the conflict label in the commits panel
+	ConflictColor []string `yaml:"conflictColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Colors to use for the commit graph. Each commit's graph lines get one of these colors, picked based on the commit's author.
+	// If empty (the default), a color is derived from each author's name, unless overridden by `authorColors`.
+	GraphColors []string `yaml:"graphColors"`
+	// Color of the status text shown in the bottom left while an operation is in progress (e.g. 'Pushing')
+	AppStatusColor []string `yaml:"appStatusColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the information shown in the bottom right (e.g. the version number or the current mode)
+	InformationColor []string `yaml:"informationColor" jsonschema:"minItems=1,uniqueItems=true"`
+}
+
+type PanelTimeFormatsConfig struct {
+	// Time format for the commits panel (and other lists of commits, e.g. when viewing a branch's commits)
+	Commits string `yaml:"commits"`
+	// Time format for the reflog panel
+	Reflog string `yaml:"reflog"`
+	// Time format for the branches panel, which shows when each branch was last checked out (or committed to, when sorting by date)
+	Branches string `yaml:"branches"`
+}
+
+// GetIconSet returns the icon set to use, taking into account the older
+// nerdFontsVersion and showIcons configs. Returns an empty string if icons are
+// disabled.
+func (c *GuiConfig) GetIconSet() string {
+	if c.Accessibility.Enabled {
+		return ""
+	}
+	if c.IconSet != "" {
+		return c.IconSet
+	}
+	if c.NerdFontsVersion != "" {
+		return "nerdFontsV" + c.NerdFontsVersion
+	}
+	if c.ShowIcons {
+		return "nerdFontsV2"
+	}
+	return ""
+}
+
+// TimeFormatsForPanel returns the long and short time formats to use for a
+// panel, given its entry in gui.panelTimeFormats. If toggled is true, relative
+// dates are shown as absolute ones and vice versa.
+func (c *GuiConfig) TimeFormatsForPanel(panelTimeFormat string, toggled bool) (string, string) {
+	isRelative := panelTimeFormat == utils.RELATIVE_TIME_FORMAT
+	if toggled {
+		if isRelative {
+			return c.TimeFormat, c.ShortTimeFormat
+		}
+		return utils.RELATIVE_TIME_FORMAT, utils.RELATIVE_TIME_FORMAT
+	}
+
+	switch panelTimeFormat {
+	case utils.RELATIVE_TIME_FORMAT:
+		return utils.RELATIVE_TIME_FORMAT, utils.RELATIVE_TIME_FORMAT
+	case "absolute", "":
+		return c.TimeFormat, c.ShortTimeFormat
+	default:
+		return panelTimeFormat, panelTimeFormat
+	}
 }
 
 type CommitLengthConfig struct {
@@ -282,17 +466,43 @@ type GitConfig struct {
 	Merging MergingConfig `yaml:"merging"`
 	// list of branches that are considered 'main' branches, used when displaying commits
 	MainBranches []string `yaml:"mainBranches" jsonschema:"uniqueItems=true"`
+	// Branches that are protected against force-pushing, hard-resetting, deleting, and rebasing from within lazygit.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#protected-branches
+	ProtectedBranches ProtectedBranchesConfig `yaml:"protectedBranches"`
 	// Prefix to use when skipping hooks. E.g. if set to 'WIP', then pre-commit hooks will be skipped when the commit message starts with 'WIP'
 	SkipHookPrefix string `yaml:"skipHookPrefix"`
+	// Which operations other than committing should skip git hooks (by passing --no-verify). Can be toggled from within Lazygit in the hooks menu (`H` in the status panel).
+	SkipHooks SkipHooksConfig `yaml:"skipHooks"`
 	// If true, periodically fetch from remote
 	AutoFetch bool `yaml:"autoFetch"`
+	// Per-remote settings for auto-fetch. Only used if `fetchAll` is true. If any are set, each remote is fetched with its own `git fetch` command, one after the other, skipping remotes that have `remote.<name>.skipFetchAll` set in the git config; otherwise all remotes are fetched with a single `git fetch --all`.
+	AutoFetchRemotes AutoFetchRemotesConfig `yaml:"autoFetchRemotes"`
 	// If true, periodically refresh files and submodules
 	AutoRefresh bool `yaml:"autoRefresh"`
+	// If true, run `git status` with git's builtin file system monitor and the untracked cache enabled (`core.fsmonitor` and `core.untrackedCache`), which makes refreshing the files panel much faster in large repos.
+	// The file system monitor requires git 2.37 or later on macOS or Windows; on other platforms only the untracked cache is used.
+	// Not needed if you have already enabled these settings in your git config.
+	UseFsmonitor bool `yaml:"useFsmonitor"`
+	// If true, run `git status` with `--untracked-files=no`, so that untracked files are not shown in the files panel. Scanning for untracked files can dominate the time it takes to refresh the files panel in huge repos.
+	// Use the `files.toggleSkipUntrackedFiles` key to temporarily show them anyway (or to temporarily hide them if this is false). Filtering the files panel by untracked files also shows them.
+	SkipUntrackedFiles bool `yaml:"skipUntrackedFiles"`
+	// What to do about file contents that are missing locally because the repo is a partial clone (e.g. one cloned with `--filter=blob:none`), when showing diffs or files in the main view.
+	// 'onDemand' lets git download them while rendering, like it does on the command line; this can take a while, so lazygit warns about it the first time.
+	// 'background' renders without downloading them (showing an error where content is missing), downloads them in the background, and renders again once they are there.
+	// 'never' renders without downloading them.
+	// Possible values: 'onDemand' | 'background' | 'never'
+	FetchMissingBlobs string `yaml:"fetchMissingBlobs" jsonschema:"enum=onDemand,enum=background,enum=never"`
+	// If true, always run git to read refs (e.g. to find out which branch is checked out, or whether any branches or tags have changed when refreshing), instead of reading them directly from the .git directory, which is faster (especially on Windows).
+	// Only needed if reading the .git directory causes problems with your repo setup.
+	ReadRefsWithCli bool `yaml:"readRefsWithCli"`
 	// If not "none", lazygit will automatically fast-forward local branches to match their upstream after fetching. Applies to branches that are not the currently checked out branch, and only to those that are strictly behind their upstream (as opposed to diverged).
 	// Possible values: 'none' | 'onlyMainBranches' | 'allBranches'
 	AutoForwardBranches string `yaml:"autoForwardBranches" jsonschema:"enum=none,enum=onlyMainBranches,enum=allBranches"`
 	// If true, pass the --all arg to git fetch
 	FetchAll bool `yaml:"fetchAll"`
+	// How to integrate the upstream branch when pulling. 'auto' leaves it to your git config (pull.rebase, pull.ff).
+	// Possible values: 'auto' | 'merge' | 'rebase' | 'ff-only'
+	PullMode string `yaml:"pullMode" jsonschema:"enum=auto,enum=merge,enum=rebase,enum=ff-only"`
 	// If true, lazygit will automatically stage files that used to have merge conflicts but no longer do; and it will also ask you if you want to continue a merge or rebase if you've resolved all conflicts. If false, it won't do either of these things.
 	AutoStageResolvedConflicts bool `yaml:"autoStageResolvedConflicts"`
 	// Command used when displaying the current branch git log in the main window
@@ -330,6 +540,9 @@ type GitConfig struct {
 	RemoteBranchSortOrder string `yaml:"remoteBranchSortOrder" jsonschema:"enum=date,enum=alphabetical"`
 	// When copying commit hashes to the clipboard, truncate them to this length. Set to 40 to disable truncation.
 	TruncateCopiedCommitHashesTo int `yaml:"truncateCopiedCommitHashesTo"`
+	// The path that is suggested when creating a new worktree, relative to the current worktree. Set to an empty string to not suggest a path.
+	// Available placeholders: {{repoName}} (the name of the repo), {{branchName}} (the name of the new branch, or of the branch, tag, or commit that the worktree starts at if no new branch is created). Slashes in the branch name are replaced with dashes.
+	WorktreePathTemplate string `yaml:"worktreePathTemplate"`
 }
 
 type PagerType string
@@ -364,6 +577,23 @@ type CommitConfig struct {
 	AutoWrapCommitMessage bool `yaml:"autoWrapCommitMessage"`
 	// If autoWrapCommitMessage is true, the width to wrap to
 	AutoWrapWidth int `yaml:"autoWrapWidth"`
+	// The message that the commit message panel starts with when making a new commit; the first line is the summary, and everything after the first blank line is the description. If empty, the content of the file that git's `commit.template` config points to is used instead, if set.
+	// Either way, the prepare-commit-msg hook (if there is one) gets to edit the message before it's shown, like with `git commit`. Note that git runs the hook again when committing.
+	MessageTemplate string `yaml:"messageTemplate"`
+	// Config for composing a commit message header like 'feat(ui): add a button' following https://www.conventionalcommits.org, which you can do with the conventionalCommit keybinding in the files panel
+	ConventionalCommits ConventionalCommitsConfig `yaml:"conventionalCommits"`
+}
+
+type ConventionalCommitsConfig struct {
+	// The commit types to choose from, in the order that they are shown
+	Types []ConventionalCommitType `yaml:"types"`
+}
+
+type ConventionalCommitType struct {
+	// E.g. 'feat'
+	Name string `yaml:"name"`
+	// Shown next to the name when choosing the type
+	Description string `yaml:"description"`
 }
 
 type MergingConfig struct {
@@ -376,6 +606,59 @@ type MergingConfig struct {
 	SquashMergeMessage string `yaml:"squashMergeMessage"`
 }
 
+type AutoFetchRemotesConfig struct {
+	// Names of remotes that are never fetched in the background, e.g. slow remotes or ones that require a VPN. They can still be fetched manually.
+	Exclude []string `yaml:"exclude" jsonschema:"uniqueItems=true"`
+	// Background fetch interval in seconds for individual remotes, keyed by remote name. Remotes that aren't listed here use `refresher.fetchInterval`.
+	Intervals map[string]int `yaml:"intervals"`
+}
+
+// Whether any per-remote settings are configured; if not, there's no need to
+// fetch the remotes individually
+func (c *AutoFetchRemotesConfig) IsConfigured() bool {
+	return len(c.Exclude) > 0 || len(c.Intervals) > 0
+}
+
+// Returns how often the given remote should be fetched in the background, or
+// false if it shouldn't be fetched in the background at all
+func (c *AutoFetchRemotesConfig) IntervalForRemote(remoteName string, defaultInterval time.Duration) (time.Duration, bool) {
+	if slices.Contains(c.Exclude, remoteName) {
+		return 0, false
+	}
+
+	if interval, ok := c.Intervals[remoteName]; ok {
+		return time.Second * time.Duration(interval), true
+	}
+
+	return defaultInterval, true
+}
+
+type ProtectedBranchesConfig struct {
+	// Glob patterns of the names of the protected branches, e.g. 'main' or 'release/*'. A '*' doesn't match a '/'.
+	Patterns []string `yaml:"patterns" jsonschema:"uniqueItems=true"`
+	// What to do when you try to force-push, hard-reset, delete, or rebase a protected branch, or drop, squash, fixup, reword or move commits of it.
+	// For force-pushing, it's the name of the remote branch being overwritten that counts.
+	// 'confirm' asks you to confirm once more; 'block' refuses to do it.
+	// Possible values: 'confirm' | 'block'
+	Mode string `yaml:"mode" jsonschema:"enum=confirm,enum=block"`
+}
+
+func (c *ProtectedBranchesConfig) IsProtected(branchName string) bool {
+	return slices.ContainsFunc(c.Patterns, func(pattern string) bool {
+		matched, _ := path.Match(pattern, branchName)
+		return matched
+	})
+}
+
+type SkipHooksConfig struct {
+	// If true, skip the pre-push hook when pushing
+	Push bool `yaml:"push"`
+	// If true, skip the pre-merge-commit and commit-msg hooks when merging
+	Merge bool `yaml:"merge"`
+	// If true, skip the pre-rebase hook when rebasing
+	Rebase bool `yaml:"rebase"`
+}
+
 type LogConfig struct {
 	// One of: 'date-order' | 'author-date-order' | 'topo-order' | 'default'
 	// 'topo-order' makes it easier to read the git log graph, but commits may not appear chronologically. See https://git-scm.com/docs/
@@ -443,6 +726,7 @@ type KeybindingUniversalConfig struct {
 	ToggleRangeSelect                 string   `yaml:"toggleRangeSelect"`
 	RangeSelectDown                   string   `yaml:"rangeSelectDown"`
 	RangeSelectUp                     string   `yaml:"rangeSelectUp"`
+	ToggleMark                        string   `yaml:"toggleMark"`
 	PrevBlock                         string   `yaml:"prevBlock"`
 	NextBlock                         string   `yaml:"nextBlock"`
 	PrevBlockAlt                      string   `yaml:"prevBlock-alt"`
@@ -477,12 +761,15 @@ type KeybindingUniversalConfig struct {
 	CreateRebaseOptionsMenu           string   `yaml:"createRebaseOptionsMenu"`
 	Push                              string   `yaml:"pushFiles"` // 'Files' appended for legacy reasons
 	Pull                              string   `yaml:"pullFiles"` // 'Files' appended for legacy reasons
+	PushWithOptions                   string   `yaml:"pushWithOptions"`
+	PullWithStrategy                  string   `yaml:"pullWithStrategy"`
 	Refresh                           string   `yaml:"refresh"`
 	CreatePatchOptionsMenu            string   `yaml:"createPatchOptionsMenu"`
 	NextTab                           string   `yaml:"nextTab"`
 	PrevTab                           string   `yaml:"prevTab"`
 	NextScreenMode                    string   `yaml:"nextScreenMode"`
 	PrevScreenMode                    string   `yaml:"prevScreenMode"`
+	ToggleZoom                        string   `yaml:"toggleZoom"`
 	CyclePagers                       string   `yaml:"cyclePagers"`
 	Undo                              string   `yaml:"undo"`
 	Redo                              string   `yaml:"redo"`
@@ -491,14 +778,31 @@ type KeybindingUniversalConfig struct {
 	DiffingMenuAlt                    string   `yaml:"diffingMenu-alt"`
 	CopyToClipboard                   string   `yaml:"copyToClipboard"`
 	OpenRecentRepos                   string   `yaml:"openRecentRepos"`
+	OpenRepoTabs                      string   `yaml:"openRepoTabs"`
 	SubmitEditorText                  string   `yaml:"submitEditorText"`
 	ExtrasMenu                        string   `yaml:"extrasMenu"`
 	ToggleWhitespaceInDiffView        string   `yaml:"toggleWhitespaceInDiffView"`
+	ToggleLineWrap                    string   `yaml:"toggleLineWrap"`
+	ToggleShowWhitespaceInDiffView    string   `yaml:"toggleShowWhitespaceInDiffView"`
+	ToggleDiffMode                    string   `yaml:"toggleDiffMode"`
 	IncreaseContextInDiffView         string   `yaml:"increaseContextInDiffView"`
 	DecreaseContextInDiffView         string   `yaml:"decreaseContextInDiffView"`
 	IncreaseRenameSimilarityThreshold string   `yaml:"increaseRenameSimilarityThreshold"`
 	DecreaseRenameSimilarityThreshold string   `yaml:"decreaseRenameSimilarityThreshold"`
 	OpenDiffTool                      string   `yaml:"openDiffTool"`
+	SidePanelLayoutMenu               string   `yaml:"sidePanelLayoutMenu"`
+	ToggleRelativeDates               string   `yaml:"toggleRelativeDates"`
+	OpenKeybindingsOverview           string   `yaml:"openKeybindingsOverview"`
+	OpenCheatsheet                    string   `yaml:"openCheatsheet"`
+	OpenNotifications                 string   `yaml:"openNotifications"`
+	GoToAnything                      string   `yaml:"goToAnything"`
+	ToggleBookmark                    string   `yaml:"toggleBookmark"`
+	OpenBookmarksMenu                 string   `yaml:"openBookmarksMenu"`
+	LanguageMenu                      string   `yaml:"languageMenu"`
+	CancelOperation                   string   `yaml:"cancelOperation"`
+	ToggleSearchRegex                 string   `yaml:"toggleSearchRegex"`
+	ToggleSearchCaseSensitivity       string   `yaml:"toggleSearchCaseSensitivity"`
+	TogglePerformanceHud              string   `yaml:"togglePerformanceHud"`
 }
 
 type KeybindingStatusConfig struct {
@@ -506,6 +810,8 @@ type KeybindingStatusConfig struct {
 	RecentRepos                string `yaml:"recentRepos"`
 	AllBranchesLogGraph        string `yaml:"allBranchesLogGraph"`
 	AllBranchesLogGraphReverse string `yaml:"allBranchesLogGraphReverse"`
+	ViewHooks                  string `yaml:"viewHooks"`
+	ViewMaintenanceOptions     string `yaml:"viewMaintenanceOptions"`
 }
 
 type KeybindingFilesConfig struct {
@@ -513,9 +819,13 @@ type KeybindingFilesConfig struct {
 	CommitChangesWithoutHook string `yaml:"commitChangesWithoutHook"`
 	AmendLastCommit          string `yaml:"amendLastCommit"`
 	CommitChangesWithEditor  string `yaml:"commitChangesWithEditor"`
+	ConventionalCommit       string `yaml:"conventionalCommit"`
 	FindBaseCommitForFixup   string `yaml:"findBaseCommitForFixup"`
+	AbsorbStagedChanges      string `yaml:"absorbStagedChanges"`
 	ConfirmDiscard           string `yaml:"confirmDiscard"`
 	IgnoreFile               string `yaml:"ignoreFile"`
+	Blame                    string `yaml:"blame"`
+	OpenFileHistory          string `yaml:"openFileHistory"`
 	RefreshFiles             string `yaml:"refreshFiles"`
 	StashAllChanges          string `yaml:"stashAllChanges"`
 	ViewStashOptions         string `yaml:"viewStashOptions"`
@@ -525,31 +835,37 @@ type KeybindingFilesConfig struct {
 	ToggleTreeView           string `yaml:"toggleTreeView"`
 	OpenMergeOptions         string `yaml:"openMergeOptions"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
+	OpenLfsLocksMenu         string `yaml:"openLfsLocksMenu"`
+	OpenSparseCheckoutMenu   string `yaml:"openSparseCheckoutMenu"`
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
 	CollapseAll              string `yaml:"collapseAll"`
 	ExpandAll                string `yaml:"expandAll"`
+	ToggleSkipUntrackedFiles string `yaml:"toggleSkipUntrackedFiles"`
+	ApplyPatchFile           string `yaml:"applyPatchFile"`
+	ToggleCommitSigning      string `yaml:"toggleCommitSigning"`
 }
 
 type KeybindingBranchesConfig struct {
-	CreatePullRequest        string `yaml:"createPullRequest"`
-	ViewPullRequestOptions   string `yaml:"viewPullRequestOptions"`
-	OpenPullRequestInBrowser string `yaml:"openPullRequestInBrowser"`
-	CopyPullRequestURL       string `yaml:"copyPullRequestURL"`
-	CheckoutBranchByName     string `yaml:"checkoutBranchByName"`
-	ForceCheckoutBranch      string `yaml:"forceCheckoutBranch"`
-	CheckoutPreviousBranch   string `yaml:"checkoutPreviousBranch"`
-	RebaseBranch             string `yaml:"rebaseBranch"`
-	RenameBranch             string `yaml:"renameBranch"`
-	MergeIntoCurrentBranch   string `yaml:"mergeIntoCurrentBranch"`
-	MoveCommitsToNewBranch   string `yaml:"moveCommitsToNewBranch"`
-	ViewGitFlowOptions       string `yaml:"viewGitFlowOptions"`
-	FastForward              string `yaml:"fastForward"`
-	CreateTag                string `yaml:"createTag"`
-	PushTag                  string `yaml:"pushTag"`
-	SetUpstream              string `yaml:"setUpstream"`
-	FetchRemote              string `yaml:"fetchRemote"`
-	AddForkRemote            string `yaml:"addForkRemote"`
-	SortOrder                string `yaml:"sortOrder"`
+	CreatePullRequest         string `yaml:"createPullRequest"`
+	ViewPullRequestOptions    string `yaml:"viewPullRequestOptions"`
+	OpenPullRequestInBrowser  string `yaml:"openPullRequestInBrowser"`
+	OpenFailingCheckInBrowser string `yaml:"openFailingCheckInBrowser"`
+	CopyPullRequestURL        string `yaml:"copyPullRequestURL"`
+	CheckoutBranchByName      string `yaml:"checkoutBranchByName"`
+	ForceCheckoutBranch       string `yaml:"forceCheckoutBranch"`
+	CheckoutPreviousBranch    string `yaml:"checkoutPreviousBranch"`
+	RebaseBranch              string `yaml:"rebaseBranch"`
+	RenameBranch              string `yaml:"renameBranch"`
+	MergeIntoCurrentBranch    string `yaml:"mergeIntoCurrentBranch"`
+	MoveCommitsToNewBranch    string `yaml:"moveCommitsToNewBranch"`
+	ViewGitFlowOptions        string `yaml:"viewGitFlowOptions"`
+	FastForward               string `yaml:"fastForward"`
+	CreateTag                 string `yaml:"createTag"`
+	PushTag                   string `yaml:"pushTag"`
+	SetUpstream               string `yaml:"setUpstream"`
+	FetchRemote               string `yaml:"fetchRemote"`
+	AddForkRemote             string `yaml:"addForkRemote"`
+	SortOrder                 string `yaml:"sortOrder"`
 }
 
 type KeybindingWorktreesConfig struct {
@@ -573,6 +889,7 @@ type KeybindingCommitsConfig struct {
 	RevertCommit                   string `yaml:"revertCommit"`
 	CherryPickCopy                 string `yaml:"cherryPickCopy"`
 	PasteCommits                   string `yaml:"pasteCommits"`
+	PasteCommitsWithOptions        string `yaml:"pasteCommitsWithOptions"`
 	MarkCommitAsBaseForRebase      string `yaml:"markCommitAsBaseForRebase"`
 	CreateTag                      string `yaml:"tagCommit"`
 	CheckoutCommit                 string `yaml:"checkoutCommit"`
@@ -584,12 +901,23 @@ type KeybindingCommitsConfig struct {
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
 	SelectCommitsOfCurrentBranch   string `yaml:"selectCommitsOfCurrentBranch"`
+	CollapsePushedCommits          string `yaml:"collapsePushedCommits"`
+	OpenFailingCheckInBrowser      string `yaml:"openFailingCheckInBrowser"`
+	ToggleCommitDetails            string `yaml:"toggleCommitDetails"`
+	GoToParentCommit               string `yaml:"goToParentCommit"`
+	GoToCommit                     string `yaml:"goToCommit"`
+	VerifySignature                string `yaml:"verifySignature"`
+	ExportPatches                  string `yaml:"exportPatches"`
+	ApplyPatches                   string `yaml:"applyPatches"`
+	CreateArchive                  string `yaml:"createArchive"`
+	ShowReflogOfRef                string `yaml:"showReflogOfRef"`
 }
 
 type KeybindingAmendAttributeConfig struct {
-	ResetAuthor string `yaml:"resetAuthor"`
-	SetAuthor   string `yaml:"setAuthor"`
-	AddCoAuthor string `yaml:"addCoAuthor"`
+	ResetAuthor   string `yaml:"resetAuthor"`
+	SetAuthor     string `yaml:"setAuthor"`
+	SetAuthorDate string `yaml:"setAuthorDate"`
+	AddCoAuthor   string `yaml:"addCoAuthor"`
 }
 
 type KeybindingStashConfig struct {
@@ -605,6 +933,8 @@ type KeybindingMainConfig struct {
 	ToggleSelectHunk string `yaml:"toggleSelectHunk"`
 	PickBothHunks    string `yaml:"pickBothHunks"`
 	EditSelectHunk   string `yaml:"editSelectHunk"`
+	StageWords       string `yaml:"stageWords"`
+	GoToLine         string `yaml:"goToLine"`
 }
 
 type KeybindingSubmodulesConfig struct {
@@ -649,6 +979,10 @@ type OSConfig struct {
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-and-pasting-from-clipboard
 	CopyToClipboardCmd string `yaml:"copyToClipboardCmd,omitempty"`
 
+	// Whether to copy to the clipboard by sending an OSC52 escape sequence to the terminal instead of using the system clipboard, which works over SSH without any clipboard tool on the remote machine. Can be 'never' (the default), 'ssh' (only when running in an SSH session), or 'always'. Takes precedence over CopyToClipboardCmd when active.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-and-pasting-from-clipboard
+	CopyToClipboardWithOSC52 string `yaml:"copyToClipboardWithOsc52,omitempty" jsonschema:"enum=never,enum=ssh,enum=always"`
+
 	// ReadFromClipboardCmd is the command for reading the clipboard.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-and-pasting-from-clipboard
 	ReadFromClipboardCmd string `yaml:"readFromClipboardCmd,omitempty"`
@@ -658,6 +992,20 @@ type OSConfig struct {
 	ShellFunctionsFile string `yaml:"shellFunctionsFile"`
 }
 
+type ServiceTypeConfig struct {
+	// Regexes for parsing the remote URL. Their named groups can be used as placeholders in repoURL. If empty, the remote URL is parsed into 'owner' and 'repo'.
+	RemoteURLPatterns []string `yaml:"remoteURLPatterns"`
+	// Template for the web URL of the repo. The placeholder {{.webDomain}} is replaced by the web domain configured in 'services'.
+	// Default: 'https://{{.webDomain}}/{{.owner}}/{{.repo}}'
+	RepoURL string `yaml:"repoURL"`
+	// Path appended to the repo URL for creating a pull request into the default branch, e.g. '/compare/{{.From}}'. If empty, creating pull requests is not supported.
+	PullRequestURL string `yaml:"pullRequestURL"`
+	// Path appended to the repo URL for creating a pull request into a given branch, e.g. '/compare/{{.To}}...{{.From}}'. If empty, pullRequestURL is used.
+	PullRequestURLIntoTargetBranch string `yaml:"pullRequestURLIntoTargetBranch"`
+	// Path appended to the repo URL for viewing a commit, e.g. '/commit/{{.CommitHash}}'
+	CommitURL string `yaml:"commitURL"`
+}
+
 type CustomCommandAfterHook struct {
 	CheckForConflicts bool `yaml:"checkForConflicts"`
 }
@@ -758,6 +1106,28 @@ type CustomIconsConfig struct {
 	Filenames map[string]IconProperties `yaml:"filenames"`
 	// Map of file extensions (including the dot) to icon properties (icon and color)
 	Extensions map[string]IconProperties `yaml:"extensions"`
+	// Map of icon names to icons, overriding the ones of the current icon set.
+	// Valid names: 'branch', 'detachedHead', 'tag', 'commit', 'mergeCommit', 'remote', 'stash', 'linkedWorktree', 'missingLinkedWorktree', 'file', 'directory', 'submodule', 'untrackedFile'.
+	// 'untrackedFile' is shown for untracked files instead of their file type icon.
+	Icons map[string]string `yaml:"icons"`
+}
+
+// CustomIconNames lists the icons that can be overridden via
+// gui.customIcons.icons
+var CustomIconNames = []string{
+	"branch",
+	"detachedHead",
+	"tag",
+	"commit",
+	"mergeCommit",
+	"remote",
+	"stash",
+	"linkedWorktree",
+	"missingLinkedWorktree",
+	"file",
+	"directory",
+	"submodule",
+	"untrackedFile",
 }
 
 type IconProperties struct {
@@ -765,6 +1135,10 @@ type IconProperties struct {
 	Color string `yaml:"color"`
 }
 
+// SidePanelNames lists the side panels that can be configured via
+// gui.sidePanels, in their default order
+var SidePanelNames = []string{"status", "files", "branches", "commits", "stash"}
+
 func GetDefaultConfig() *UserConfig {
 	return &UserConfig{
 		Gui: GuiConfig{
@@ -774,19 +1148,25 @@ func GetDefaultConfig() *UserConfig {
 			ScrollOffBehavior:        "margin",
 			TabWidth:                 4,
 			MouseEvents:              true,
-			SkipAmendWarning:         false,
-			SkipDiscardChangeWarning: false,
-			SkipStashWarning:         false,
 			SidePanelWidth:           0.3333,
 			ExpandFocusedSidePanel:   false,
 			ExpandedSidePanelWeight:  2,
+			SidePanels:               []string{"status", "files", "branches", "commits", "stash"},
 			MainPanelSplitMode:       "flexible",
 			EnlargedSideViewLocation: "left",
 			WrapLinesInStagingView:   true,
+			WrapLinesInMainView:      true,
+			ShowWhitespaceInDiffView: false,
 			UseHunkModeInStagingView: true,
 			Language:                 "auto",
+			RightToLeftReordering:    "auto",
 			TimeFormat:               "02 Jan 06",
 			ShortTimeFormat:          time.Kitchen,
+			PanelTimeFormats: PanelTimeFormatsConfig{
+				Commits:  "absolute",
+				Reflog:   "absolute",
+				Branches: "relative",
+			},
 			Theme: ThemeConfig{
 				ActiveBorderColor:               []string{"green", "bold"},
 				SearchingActiveBorderColor:      []string{"cyan", "bold"},
@@ -800,38 +1180,58 @@ func GetDefaultConfig() *UserConfig {
 				MarkedBaseCommitFgColor:         []string{"blue"},
 				UnstagedChangesColor:            []string{"red"},
 				DefaultFgColor:                  []string{"default"},
+				DiffAddedColor:                  []string{"green"},
+				DiffRemovedColor:                []string{"red"},
+				ConflictColor:                   []string{"red"},
+				GraphColors:                     []string{},
+				AppStatusColor:                  []string{"cyan"},
+				InformationColor:                []string{"green"},
+			},
+			Mouse: MouseConfig{
+				WheelScrollHeight:         0,
+				DoubleClickFileAction:     "stage",
+				MiddleClickPaste:          false,
+				DragToSelectInStagingView: true,
 			},
-			CommitLength:                        CommitLengthConfig{Show: true},
-			SkipNoStagedFilesWarning:            false,
-			ShowListFooter:                      true,
-			ShowCommandLog:                      true,
-			ShowBottomLine:                      true,
-			ShowPanelJumps:                      true,
-			ShowFileTree:                        true,
-			ShowRootItemInFileTree:              true,
-			FileTreeSortOrder:                   "mixed",
-			FileTreeSortCaseSensitive:           true,
-			ShowNumstatInFilesView:              false,
-			ShowRandomTip:                       true,
-			ShowIcons:                           false,
-			NerdFontsVersion:                    "",
-			ShowFileIcons:                       true,
-			CommitAuthorShortLength:             2,
-			CommitAuthorLongLength:              17,
-			CommitHashLength:                    8,
-			ShowBranchCommitHash:                false,
-			ShowDivergenceFromBaseBranch:        "none",
-			CommandLogSize:                      8,
-			SplitDiff:                           "auto",
-			SkipRewordInEditorWarning:           false,
-			SkipSwitchWorktreeOnCheckoutWarning: false,
-			ScreenMode:                          "normal",
-			Border:                              "rounded",
-			AnimateExplosion:                    true,
-			PortraitMode:                        "auto",
-			PortraitModeAutoMaxWidth:            84,
-			PortraitModeAutoMinHeight:           46,
-			FilterMode:                          "substring",
+			CommitLength:                 CommitLengthConfig{Show: true},
+			ShowListFooter:               true,
+			StickyDiffHeaders:            true,
+			ShowDiffMinimap:              false,
+			ImagePreviewProtocol:         "auto",
+			ShowCommandLog:               true,
+			ShowBottomLine:               true,
+			ShowPanelJumps:               true,
+			ShowFileTree:                 true,
+			ShowRootItemInFileTree:       true,
+			FileTreeSortOrder:            "mixed",
+			FileTreeSortCaseSensitive:    true,
+			ShowNumstatInFilesView:       false,
+			ShowRandomTip:                true,
+			ShowIcons:                    false,
+			NerdFontsVersion:             "",
+			IconSet:                      "",
+			ShowFileIcons:                true,
+			CommitAuthorShortLength:      2,
+			CommitAuthorLongLength:       17,
+			CommitHashLength:             8,
+			CommitGraphStyle:             "rounded",
+			ShowBranchCommitHash:         false,
+			ShowDivergenceFromBaseBranch: "none",
+			SeparatePushedCommits:        false,
+			ShowCIStatus:                 true,
+			RestoreSession:               true,
+			CommandLogSize:               8,
+			SplitDiff:                    "auto",
+			DiffMode:                     "unified",
+			ScreenMode:                   "normal",
+			Border:                       "rounded",
+			AnimateExplosion:             true,
+			PortraitMode:                 "auto",
+			PortraitModeAutoMaxWidth:     84,
+			PortraitModeAutoMinHeight:    46,
+			MainPanelPosition:            "auto",
+			SidePanelsStacking:           "vertical",
+			FilterMode:                   "substring",
 			Spinner: SpinnerConfig{
 				Frames: []string{"|", "/", "-", "\\"},
 				Rate:   50,
@@ -840,12 +1240,31 @@ func GetDefaultConfig() *UserConfig {
 			SwitchToFilesAfterStashPop:   true,
 			SwitchToFilesAfterStashApply: true,
 			SwitchTabsWithPanelJumpKeys:  false,
+			Accessibility: AccessibilityConfig{
+				Enabled:           false,
+				AnnouncementsFile: "",
+			},
 		},
 		Git: GitConfig{
 			Commit: CommitConfig{
 				SignOff:               false,
 				AutoWrapCommitMessage: true,
 				AutoWrapWidth:         72,
+				ConventionalCommits: ConventionalCommitsConfig{
+					Types: []ConventionalCommitType{
+						{Name: "feat", Description: "A new feature"},
+						{Name: "fix", Description: "A bug fix"},
+						{Name: "docs", Description: "Documentation only changes"},
+						{Name: "style", Description: "Changes that don't affect the meaning of the code (formatting etc.)"},
+						{Name: "refactor", Description: "A code change that neither fixes a bug nor adds a feature"},
+						{Name: "perf", Description: "A code change that improves performance"},
+						{Name: "test", Description: "Adding missing tests or correcting existing tests"},
+						{Name: "build", Description: "Changes to the build system or external dependencies"},
+						{Name: "ci", Description: "Changes to the CI configuration"},
+						{Name: "chore", Description: "Other changes that don't modify source or test files"},
+						{Name: "revert", Description: "Reverts a previous commit"},
+					},
+				},
 			},
 			Merging: MergingConfig{
 				ManualCommit:       false,
@@ -857,14 +1276,23 @@ func GetDefaultConfig() *UserConfig {
 				ShowGraph:      "always",
 				ShowWholeGraph: false,
 			},
+			ProtectedBranches: ProtectedBranchesConfig{
+				Patterns: []string{},
+				Mode:     "confirm",
+			},
 			LocalBranchSortOrder:         "date",
 			RemoteBranchSortOrder:        "date",
 			SkipHookPrefix:               "WIP",
 			MainBranches:                 []string{"master", "main"},
 			AutoFetch:                    true,
 			AutoRefresh:                  true,
+			UseFsmonitor:                 false,
+			SkipUntrackedFiles:           false,
+			FetchMissingBlobs:            "onDemand",
+			ReadRefsWithCli:              false,
 			AutoForwardBranches:          "onlyMainBranches",
 			FetchAll:                     true,
+			PullMode:                     "auto",
 			AutoStageResolvedConflicts:   true,
 			BranchLogCmd:                 "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
 			AllBranchesLogCmds:           []string{"git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium"},
@@ -876,6 +1304,7 @@ func GetDefaultConfig() *UserConfig {
 			BranchPrefix:                 "",
 			ParseEmoji:                   false,
 			TruncateCopiedCommitHashesTo: 12,
+			WorktreePathTemplate:         "../{{repoName}}-{{branchName}}",
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
@@ -885,13 +1314,25 @@ func GetDefaultConfig() *UserConfig {
 			Method: "prompt",
 			Days:   14,
 		},
-		ConfirmOnQuit:                false,
+		Confirmations: ConfirmationsConfig{
+			Amend:                    true,
+			DiscardChange:            true,
+			ApplyStash:               true,
+			CommitWithoutStagedFiles: true,
+			RewordInEditor:           true,
+			SwitchWorktreeOnCheckout: true,
+			ForcePush:                true,
+			DeleteMergedBranch:       false,
+			Quit:                     false,
+		},
 		QuitOnTopLevelReturn:         false,
 		OS:                           OSConfig{},
 		DisableStartupPopups:         false,
 		CustomCommands:               []CustomCommand(nil),
 		Services:                     map[string]string(nil),
+		ServiceTypes:                 map[string]ServiceTypeConfig(nil),
 		NotARepository:               "prompt",
+		RepoSearchRoots:              []string{},
 		PromptToReturnFromSubprocess: true,
 		Keybinding: KeybindingConfig{
 			Universal: KeybindingUniversalConfig{
@@ -916,6 +1357,7 @@ func GetDefaultConfig() *UserConfig {
 				ToggleRangeSelect:                 "v",
 				RangeSelectDown:                   "<s-down>",
 				RangeSelectUp:                     "<s-up>",
+				ToggleMark:                        "<c-v>",
 				PrevBlock:                         "<left>",
 				NextBlock:                         "<right>",
 				PrevBlockAlt:                      "h",
@@ -941,6 +1383,7 @@ func GetDefaultConfig() *UserConfig {
 				Edit:                              "e",
 				OpenFile:                          "o",
 				OpenRecentRepos:                   "<c-r>",
+				OpenRepoTabs:                      "<c-a>",
 				ScrollUpMain:                      "<pgup>",
 				ScrollDownMain:                    "<pgdown>",
 				ScrollUpMainAlt1:                  "K",
@@ -951,12 +1394,15 @@ func GetDefaultConfig() *UserConfig {
 				CreateRebaseOptionsMenu:           "m",
 				Push:                              "P",
 				Pull:                              "p",
+				PushWithOptions:                   "<f6>",
+				PullWithStrategy:                  "<f7>",
 				Refresh:                           "R",
 				CreatePatchOptionsMenu:            "<c-p>",
 				NextTab:                           "]",
 				PrevTab:                           "[",
 				NextScreenMode:                    "+",
 				PrevScreenMode:                    "_",
+				ToggleZoom:                        "\\",
 				CyclePagers:                       "|",
 				Undo:                              "z",
 				Redo:                              "Z",
@@ -967,25 +1413,47 @@ func GetDefaultConfig() *UserConfig {
 				SubmitEditorText:                  "<enter>",
 				ExtrasMenu:                        "@",
 				ToggleWhitespaceInDiffView:        "<c-w>",
+				ToggleLineWrap:                    "~",
+				ToggleShowWhitespaceInDiffView:    "<c-/>",
+				ToggleDiffMode:                    "%",
 				IncreaseContextInDiffView:         "}",
 				DecreaseContextInDiffView:         "{",
 				IncreaseRenameSimilarityThreshold: ")",
 				DecreaseRenameSimilarityThreshold: "(",
 				OpenDiffTool:                      "<c-t>",
+				SidePanelLayoutMenu:               "<c-g>",
+				ToggleRelativeDates:               "<c-x>",
+				OpenKeybindingsOverview:           "<c-n>",
+				OpenCheatsheet:                    "<f1>",
+				OpenNotifications:                 "<f2>",
+				GoToAnything:                      "<f3>",
+				ToggleBookmark:                    "#",
+				OpenBookmarksMenu:                 "<f4>",
+				LanguageMenu:                      "<f5>",
+				CancelOperation:                   "<c-q>",
+				ToggleSearchRegex:                 "<c-r>",
+				ToggleSearchCaseSensitivity:       "<c-t>",
+				TogglePerformanceHud:              "<f12>",
 			},
 			Status: KeybindingStatusConfig{
 				CheckForUpdate:             "u",
 				RecentRepos:                "<enter>",
 				AllBranchesLogGraph:        "a",
 				AllBranchesLogGraphReverse: "A",
+				ViewHooks:                  "H",
+				ViewMaintenanceOptions:     "M",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
 				CommitChangesWithoutHook: "w",
 				AmendLastCommit:          "A",
 				CommitChangesWithEditor:  "C",
+				ConventionalCommit:       "T",
 				FindBaseCommitForFixup:   "<c-f>",
+				AbsorbStagedChanges:      "F",
 				IgnoreFile:               "i",
+				Blame:                    "b",
+				OpenFileHistory:          "t",
 				RefreshFiles:             "r",
 				StashAllChanges:          "s",
 				ViewStashOptions:         "S",
@@ -995,31 +1463,37 @@ func GetDefaultConfig() *UserConfig {
 				ToggleTreeView:           "`",
 				OpenMergeOptions:         "M",
 				OpenStatusFilter:         "<c-b>",
+				OpenLfsLocksMenu:         "<c-l>",
+				OpenSparseCheckoutMenu:   "<c-k>",
 				ConfirmDiscard:           "x",
 				CopyFileInfoToClipboard:  "y",
 				CollapseAll:              "-",
 				ExpandAll:                "=",
+				ToggleSkipUntrackedFiles: "U",
+				ApplyPatchFile:           "I",
+				ToggleCommitSigning:      "G",
 			},
 			Branches: KeybindingBranchesConfig{
-				CopyPullRequestURL:       "<c-y>",
-				CreatePullRequest:        "o",
-				ViewPullRequestOptions:   "O",
-				OpenPullRequestInBrowser: "G",
-				CheckoutBranchByName:     "c",
-				ForceCheckoutBranch:      "F",
-				CheckoutPreviousBranch:   "-",
-				RebaseBranch:             "r",
-				RenameBranch:             "R",
-				MergeIntoCurrentBranch:   "M",
-				MoveCommitsToNewBranch:   "N",
-				ViewGitFlowOptions:       "i",
-				FastForward:              "f",
-				CreateTag:                "T",
-				PushTag:                  "P",
-				SetUpstream:              "u",
-				FetchRemote:              "f",
-				AddForkRemote:            "F",
-				SortOrder:                "s",
+				CopyPullRequestURL:        "<c-y>",
+				CreatePullRequest:         "o",
+				ViewPullRequestOptions:    "O",
+				OpenPullRequestInBrowser:  "G",
+				OpenFailingCheckInBrowser: "I",
+				CheckoutBranchByName:      "c",
+				ForceCheckoutBranch:       "F",
+				CheckoutPreviousBranch:    "-",
+				RebaseBranch:              "r",
+				RenameBranch:              "R",
+				MergeIntoCurrentBranch:    "M",
+				MoveCommitsToNewBranch:    "N",
+				ViewGitFlowOptions:        "i",
+				FastForward:               "f",
+				CreateTag:                 "T",
+				PushTag:                   "P",
+				SetUpstream:               "u",
+				FetchRemote:               "f",
+				AddForkRemote:             "F",
+				SortOrder:                 "s",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
@@ -1041,6 +1515,7 @@ func GetDefaultConfig() *UserConfig {
 				RevertCommit:                   "t",
 				CherryPickCopy:                 "C",
 				PasteCommits:                   "V",
+				PasteCommitsWithOptions:        "O",
 				MarkCommitAsBaseForRebase:      "B",
 				CreateTag:                      "T",
 				CheckoutCommit:                 "<space>",
@@ -1052,11 +1527,22 @@ func GetDefaultConfig() *UserConfig {
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
 				SelectCommitsOfCurrentBranch:   "*",
+				CollapsePushedCommits:          "-",
+				OpenFailingCheckInBrowser:      "I",
+				ToggleCommitDetails:            "D",
+				GoToParentCommit:               "^",
+				GoToCommit:                     "<c-f>",
+				VerifySignature:                "<f8>",
+				ExportPatches:                  "E",
+				ApplyPatches:                   "M",
+				CreateArchive:                  "U",
+				ShowReflogOfRef:                "r",
 			},
 			AmendAttribute: KeybindingAmendAttributeConfig{
-				ResetAuthor: "a",
-				SetAuthor:   "A",
-				AddCoAuthor: "c",
+				ResetAuthor:   "a",
+				SetAuthor:     "A",
+				SetAuthorDate: "d",
+				AddCoAuthor:   "c",
 			},
 			Stash: KeybindingStashConfig{
 				PopStash:    "g",
@@ -1069,6 +1555,8 @@ func GetDefaultConfig() *UserConfig {
 				ToggleSelectHunk: "a",
 				PickBothHunks:    "b",
 				EditSelectHunk:   "E",
+				StageWords:       "S",
+				GoToLine:         "g",
 			},
 			Submodules: KeybindingSubmodulesConfig{
 				Init:     "i",
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeFormatsForPanel(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		panelTimeFormat string
+		toggled         bool
+		expectedLong    string
+		expectedShort   string
+	}{
+		{
+			name:            "relative",
+			panelTimeFormat: "relative",
+			expectedLong:    "relative",
+			expectedShort:   "relative",
+		},
+		{
+			name:            "absolute",
+			panelTimeFormat: "absolute",
+			expectedLong:    "02 Jan 06",
+			expectedShort:   "3:04PM",
+		},
+		{
+			name:            "empty means absolute",
+			panelTimeFormat: "",
+			expectedLong:    "02 Jan 06",
+			expectedShort:   "3:04PM",
+		},
+		{
+			name:            "custom format",
+			panelTimeFormat: "2006-01-02 15:04",
+			expectedLong:    "2006-01-02 15:04",
+			expectedShort:   "2006-01-02 15:04",
+		},
+		{
+			name:            "toggled relative",
+			panelTimeFormat: "relative",
+			toggled:         true,
+			expectedLong:    "02 Jan 06",
+			expectedShort:   "3:04PM",
+		},
+		{
+			name:            "toggled custom format",
+			panelTimeFormat: "2006-01-02 15:04",
+			toggled:         true,
+			expectedLong:    "relative",
+			expectedShort:   "relative",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			guiConfig := GetDefaultConfig().Gui
+			long, short := guiConfig.TimeFormatsForPanel(s.panelTimeFormat, s.toggled)
+			assert.Equal(t, s.expectedLong, long)
+			assert.Equal(t, s.expectedShort, short)
+		})
+	}
+}
+
+func TestAutoFetchIntervalForRemote(t *testing.T) {
+	config := AutoFetchRemotesConfig{
+		Exclude:   []string{"corporate"},
+		Intervals: map[string]int{"upstream": 600},
+	}
+
+	scenarios := []struct {
+		remoteName       string
+		expectedInterval time.Duration
+		expectedOk       bool
+	}{
+		{remoteName: "origin", expectedInterval: time.Minute, expectedOk: true},
+		{remoteName: "upstream", expectedInterval: 10 * time.Minute, expectedOk: true},
+		{remoteName: "corporate", expectedInterval: 0, expectedOk: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.remoteName, func(t *testing.T) {
+			interval, ok := config.IntervalForRemote(s.remoteName, time.Minute)
+			assert.Equal(t, s.expectedInterval, interval)
+			assert.Equal(t, s.expectedOk, ok)
+		})
+	}
+}
+
+func TestAutoFetchRemotesIsConfigured(t *testing.T) {
+	assert.False(t, GetDefaultConfig().Git.AutoFetchRemotes.IsConfigured())
+	assert.True(t, (&AutoFetchRemotesConfig{Exclude: []string{"corporate"}}).IsConfigured())
+	assert.True(t, (&AutoFetchRemotesConfig{Intervals: map[string]int{"upstream": 600}}).IsConfigured())
+}
+
+func TestProtectedBranchesIsProtected(t *testing.T) {
+	config := ProtectedBranchesConfig{
+		Patterns: []string{"main", "release/*"},
+	}
+
+	scenarios := []struct {
+		branchName string
+		expected   bool
+	}{
+		{branchName: "main", expected: true},
+		{branchName: "main2", expected: false},
+		{branchName: "release/1.0", expected: true},
+		{branchName: "release/1.0/hotfix", expected: false},
+		{branchName: "feature", expected: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.branchName, func(t *testing.T) {
+			assert.Equal(t, s.expected, config.IsProtected(s.branchName))
+		})
+	}
+}
@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"log"
+	"path"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -12,7 +14,7 @@ import (
 
 func (config *UserConfig) Validate() error {
 	if err := validateEnum("gui.statusPanelView", config.Gui.StatusPanelView,
-		[]string{"dashboard", "allBranchesLog"}); err != nil {
+		[]string{"dashboard", "allBranchesLog", "repoOverview"}); err != nil {
 		return err
 	}
 	if err := validateEnum("gui.showDivergenceFromBaseBranch", config.Gui.ShowDivergenceFromBaseBranch,
@@ -23,10 +25,58 @@ func (config *UserConfig) Validate() error {
 		[]string{"mixed", "filesFirst", "foldersFirst"}); err != nil {
 		return err
 	}
+	if err := validateEnum("gui.mouse.doubleClickFileAction", config.Gui.Mouse.DoubleClickFileAction,
+		[]string{"stage", "edit", "open"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.mainPanelPosition", config.Gui.MainPanelPosition,
+		[]string{"auto", "right", "left", "bottom"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.sidePanelsStacking", config.Gui.SidePanelsStacking,
+		[]string{"vertical", "horizontal"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.iconSet", config.Gui.IconSet,
+		[]string{"", "nerdFontsV2", "nerdFontsV3", "ascii", "emoji"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.commitGraphStyle", config.Gui.CommitGraphStyle,
+		[]string{"rounded", "compact", "ascii"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.imagePreviewProtocol", config.Gui.ImagePreviewProtocol,
+		[]string{"auto", "kitty", "iterm2", "none"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.rightToLeftReordering", config.Gui.RightToLeftReordering,
+		[]string{"auto", "on", "off"}); err != nil {
+		return err
+	}
+	for name := range config.Gui.CustomIcons.Icons {
+		if err := validateEnum("gui.customIcons.icons", name, CustomIconNames); err != nil {
+			return err
+		}
+	}
+	if err := ValidateSidePanels("gui.sidePanels", config.Gui.SidePanels); err != nil {
+		return err
+	}
+	if err := validateEnum("os.copyToClipboardWithOsc52", config.OS.CopyToClipboardWithOSC52,
+		[]string{"", "never", "ssh", "always"}); err != nil {
+		return err
+	}
 	if err := validateEnum("git.autoForwardBranches", config.Git.AutoForwardBranches,
 		[]string{"none", "onlyMainBranches", "allBranches"}); err != nil {
 		return err
 	}
+	if err := validateEnum("git.fetchMissingBlobs", config.Git.FetchMissingBlobs,
+		[]string{"onDemand", "background", "never"}); err != nil {
+		return err
+	}
+	if err := validateEnum("git.pullMode", config.Git.PullMode,
+		[]string{"auto", "merge", "rebase", "ff-only"}); err != nil {
+		return err
+	}
 	if err := validateEnum("git.localBranchSortOrder", config.Git.LocalBranchSortOrder,
 		[]string{"date", "recency", "alphabetical"}); err != nil {
 		return err
@@ -35,6 +85,13 @@ func (config *UserConfig) Validate() error {
 		[]string{"date", "alphabetical"}); err != nil {
 		return err
 	}
+	if err := validateEnum("git.protectedBranches.mode", config.Git.ProtectedBranches.Mode,
+		[]string{"confirm", "block"}); err != nil {
+		return err
+	}
+	if err := validateProtectedBranchPatterns(config.Git.ProtectedBranches.Patterns); err != nil {
+		return err
+	}
 	if err := validateEnum("git.log.order", config.Git.Log.Order,
 		[]string{"date-order", "author-date-order", "topo-order", "default"}); err != nil {
 		return err
@@ -49,6 +106,12 @@ func (config *UserConfig) Validate() error {
 	if err := validateCustomCommands(config.CustomCommands); err != nil {
 		return err
 	}
+	if err := validateServiceTypes(config.ServiceTypes); err != nil {
+		return err
+	}
+	if err := validateAutoFetchRemoteIntervals(config.Git.AutoFetchRemotes.Intervals); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -60,6 +123,25 @@ func validateEnum(name string, value string, allowedValues []string) error {
 	return fmt.Errorf("Unexpected value '%s' for '%s'. Allowed values: %s", value, name, allowedValuesStr)
 }
 
+// ValidateSidePanels checks that the given list of side panels is non-empty,
+// contains only known panels, and mentions each panel at most once.
+func ValidateSidePanels(name string, sidePanels []string) error {
+	if len(sidePanels) == 0 {
+		return fmt.Errorf("'%s' must contain at least one panel", name)
+	}
+
+	for i, panel := range sidePanels {
+		if err := validateEnum(name, panel, SidePanelNames); err != nil {
+			return err
+		}
+		if slices.Contains(sidePanels[:i], panel) {
+			return fmt.Errorf("Panel '%s' appears more than once in '%s'", panel, name)
+		}
+	}
+
+	return nil
+}
+
 func validateKeybindingsRecurse(path string, node any) error {
 	value := reflect.ValueOf(node)
 	if value.Kind() == reflect.Struct {
@@ -165,3 +247,32 @@ func validateCustomCommandPrompt(prompt CustomCommandPrompt) error {
 
 	return nil
 }
+
+func validateAutoFetchRemoteIntervals(intervals map[string]int) error {
+	for remoteName, interval := range intervals {
+		if interval <= 0 {
+			return fmt.Errorf("Invalid value %d for 'git.autoFetchRemotes.intervals.%s'; must be greater than 0. To skip the remote, add it to 'git.autoFetchRemotes.exclude' instead", interval, remoteName)
+		}
+	}
+	return nil
+}
+
+func validateProtectedBranchPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("Invalid pattern '%s' in git.protectedBranches.patterns: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func validateServiceTypes(serviceTypes map[string]ServiceTypeConfig) error {
+	for name, serviceType := range serviceTypes {
+		for _, pattern := range serviceType.RemoteURLPatterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("Invalid remote URL pattern '%s' for service type '%s': %w", pattern, name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strconv"
 	"strings"
 	"testing"
 
@@ -26,6 +27,7 @@ func TestUserConfigValidate_enums(t *testing.T) {
 			testCases: []testCase{
 				{value: "dashboard", valid: true},
 				{value: "allBranchesLog", valid: true},
+				{value: "repoOverview", valid: true},
 				{value: "", valid: false},
 				{value: "invalid_value", valid: false},
 			},
@@ -43,6 +45,97 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Gui.Mouse.DoubleClickFileAction",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.Mouse.DoubleClickFileAction = value
+			},
+			testCases: []testCase{
+				{value: "stage", valid: true},
+				{value: "edit", valid: true},
+				{value: "open", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.MainPanelPosition",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.MainPanelPosition = value
+			},
+			testCases: []testCase{
+				{value: "auto", valid: true},
+				{value: "right", valid: true},
+				{value: "left", valid: true},
+				{value: "bottom", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.SidePanelsStacking",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.SidePanelsStacking = value
+			},
+			testCases: []testCase{
+				{value: "vertical", valid: true},
+				{value: "horizontal", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.IconSet",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.IconSet = value
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "nerdFontsV2", valid: true},
+				{value: "nerdFontsV3", valid: true},
+				{value: "ascii", valid: true},
+				{value: "emoji", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.CommitGraphStyle",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.CommitGraphStyle = value
+			},
+			testCases: []testCase{
+				{value: "rounded", valid: true},
+				{value: "compact", valid: true},
+				{value: "ascii", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.CustomIcons.Icons",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.CustomIcons.Icons = map[string]string{value: "x"}
+			},
+			testCases: []testCase{
+				{value: "branch", valid: true},
+				{value: "untrackedFile", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Gui.SidePanels",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.SidePanels = strings.Split(value, ",")
+			},
+			testCases: []testCase{
+				{value: "status,files,branches,commits,stash", valid: true},
+				{value: "branches,files,commits", valid: true},
+				{value: "files", valid: true},
+				{value: "", valid: false},
+				{value: "files,files", valid: false},
+				{value: "files,invalid_value", valid: false},
+			},
+		},
 		{
 			name: "Git.AutoForwardBranches",
 			setup: func(config *UserConfig, value string) {
@@ -56,6 +149,20 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Git.PullMode",
+			setup: func(config *UserConfig, value string) {
+				config.Git.PullMode = value
+			},
+			testCases: []testCase{
+				{value: "auto", valid: true},
+				{value: "merge", valid: true},
+				{value: "rebase", valid: true},
+				{value: "ff-only", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
 		{
 			name: "Git.LocalBranchSortOrder",
 			setup: func(config *UserConfig, value string) {
@@ -111,6 +218,30 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Git.ProtectedBranches.Mode",
+			setup: func(config *UserConfig, value string) {
+				config.Git.ProtectedBranches.Mode = value
+			},
+			testCases: []testCase{
+				{value: "confirm", valid: true},
+				{value: "block", valid: true},
+
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Git.ProtectedBranches.Patterns",
+			setup: func(config *UserConfig, value string) {
+				config.Git.ProtectedBranches.Patterns = []string{value}
+			},
+			testCases: []testCase{
+				{value: "main", valid: true},
+				{value: "release/*", valid: true},
+				{value: "release/[", valid: false},
+			},
+		},
 		{
 			name: "Keybindings",
 			setup: func(config *UserConfig, value string) {
@@ -271,6 +402,30 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "", valid: false},
 			},
 		},
+		{
+			name: "Service type remote URL patterns",
+			setup: func(config *UserConfig, value string) {
+				config.ServiceTypes = map[string]ServiceTypeConfig{
+					"myforge": {RemoteURLPatterns: []string{value}},
+				}
+			},
+			testCases: []testCase{
+				{value: `^https://[^/]+/(?P<owner>.*)/(?P<repo>.*?)(?:\.git)?$`, valid: true},
+				{value: `^https://(?P<owner>.*`, valid: false},
+			},
+		},
+		{
+			name: "Auto-fetch remote intervals",
+			setup: func(config *UserConfig, value string) {
+				interval, _ := strconv.Atoi(value)
+				config.Git.AutoFetchRemotes.Intervals = map[string]int{"upstream": interval}
+			},
+			testCases: []testCase{
+				{value: "300", valid: true},
+				{value: "0", valid: false},
+				{value: "-1", valid: false},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
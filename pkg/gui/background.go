@@ -1,13 +1,17 @@
 package gui
 
 import (
+	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type BackgroundRoutineMgr struct {
@@ -20,6 +24,21 @@ type BackgroundRoutineMgr struct {
 
 	// a channel to trigger an immediate background fetch; we use this when switching repos
 	triggerFetch chan struct{}
+
+	// the error of the last background fetch of each remote (keyed by remote
+	// name, or the empty string when fetching with a single command), so that
+	// we only show a toast when it fails for a new reason rather than every
+	// time it runs
+	lastFetchErrors map[string]string
+
+	// when each remote was last fetched in the background; only used when
+	// fetching remotes individually
+	lastRemoteFetchTimes map[remoteFetchKey]time.Time
+}
+
+type remoteFetchKey struct {
+	repoPath   string
+	remoteName string
 }
 
 func (self *BackgroundRoutineMgr) PauseBackgroundRefreshes(pause bool) {
@@ -79,6 +98,15 @@ func (self *BackgroundRoutineMgr) startBackgroundFetch() {
 	self.gui.waitForIntro.Wait()
 
 	fetch := func(firstTimeOrRetriggered bool) error {
+		// nil means fetching with a single command
+		var remoteNames []string
+		if self.fetchRemotesIndividually() {
+			remoteNames = self.remotesDueForFetch(firstTimeOrRetriggered)
+			if len(remoteNames) == 0 {
+				return nil
+			}
+		}
+
 		// Do this on the UI thread so that we don't have to deal with synchronization around the
 		// access of the repo state.
 		self.gui.onUIThread(func() error {
@@ -91,19 +119,79 @@ func (self *BackgroundRoutineMgr) startBackgroundFetch() {
 
 		if self.gui.UserConfig().Gui.ShowBottomLine || firstTimeOrRetriggered {
 			return self.gui.helpers.AppStatus.WithWaitingStatusImpl(self.gui.Tr.FetchingStatus, func(gocui.Task) error {
-				return self.backgroundFetch()
+				return self.backgroundFetch(remoteNames)
 			}, nil)
 		}
 
-		return self.backgroundFetch()
+		return self.backgroundFetch(remoteNames)
 	}
 
 	// We want an immediate fetch at startup, and since goEvery starts by
 	// waiting for the interval, we need to trigger one manually first
 	_ = fetch(true)
 
+	self.triggerFetch = self.goEvery(self.backgroundFetchTickInterval(), self.gui.stopChan, fetch)
+}
+
+// When fetching all remotes and there are per-remote settings, we fetch each
+// remote with its own command so that they can have different intervals, and
+// so that a failing remote doesn't break fetching the others. Otherwise a
+// single `git fetch --all` does the job, and respects the user's git config
+// for it (e.g. fetch.parallel).
+func (self *BackgroundRoutineMgr) fetchRemotesIndividually() bool {
+	gitConfig := self.gui.UserConfig().Git
+	return gitConfig.FetchAll && gitConfig.AutoFetchRemotes.IsConfigured()
+}
+
+// The shortest of the configured fetch intervals; this is how often we check
+// which remotes are due for fetching
+func (self *BackgroundRoutineMgr) backgroundFetchTickInterval() time.Duration {
+	userConfig := self.gui.UserConfig()
+	interval := userConfig.Refresher.FetchIntervalDuration()
+	if self.fetchRemotesIndividually() {
+		for _, remoteInterval := range userConfig.Git.AutoFetchRemotes.Intervals {
+			interval = min(interval, time.Second*time.Duration(remoteInterval))
+		}
+	}
+	return interval
+}
+
+func (self *BackgroundRoutineMgr) remotesDueForFetch(fetchAll bool) []string {
+	remoteNames, err := self.gui.git.Remote.GetRemoteNames()
+	if err != nil {
+		self.gui.c.Log.Error(err)
+		return nil
+	}
+
 	userConfig := self.gui.UserConfig()
-	self.triggerFetch = self.goEvery(userConfig.Refresher.FetchIntervalDuration(), self.gui.stopChan, fetch)
+	repoPath := self.gui.git.RepoPaths.WorktreePath()
+	tickInterval := self.backgroundFetchTickInterval()
+	now := time.Now()
+
+	return lo.Filter(remoteNames, func(remoteName string, _ int) bool {
+		// `git fetch --all` wouldn't fetch these either
+		if self.gui.git.Config.RemoteSkipsFetchAll(remoteName) {
+			return false
+		}
+
+		interval, ok := userConfig.Git.AutoFetchRemotes.IntervalForRemote(
+			remoteName, userConfig.Refresher.FetchIntervalDuration())
+		if !ok {
+			return false
+		}
+
+		key := remoteFetchKey{repoPath: repoPath, remoteName: remoteName}
+		lastFetchTime, fetchedBefore := self.lastRemoteFetchTimes[key]
+		// The ticker doesn't fire at exactly the same interval each time, so
+		// allow for some slack; otherwise a remote whose interval is the tick
+		// interval might only be fetched every other tick
+		if !fetchAll && fetchedBefore && now.Sub(lastFetchTime)+tickInterval/2 < interval {
+			return false
+		}
+
+		self.lastRemoteFetchTimes[key] = now
+		return true
+	})
 }
 
 func (self *BackgroundRoutineMgr) startBackgroundFilesRefresh() {
@@ -152,16 +240,114 @@ func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan stru
 	return retrigger
 }
 
-func (self *BackgroundRoutineMgr) backgroundFetch() (err error) {
-	err = self.gui.git.Sync.FetchBackground()
+// Fetches the given remotes concurrently, or, if remoteNames is nil, fetches
+// with a single command
+func (self *BackgroundRoutineMgr) backgroundFetch(remoteNames []string) (err error) {
+	repoPath := self.gui.git.RepoPaths.WorktreePath()
+	behindCounts := self.behindCountsForPull()
+
+	var fetchErr error
+	anySucceeded := false
+	if remoteNames == nil {
+		fetchErr = self.gui.git.Sync.FetchBackground()
+		anySucceeded = fetchErr == nil
+	} else {
+		errs := self.fetchRemotes(remoteNames)
+		for i, remoteName := range remoteNames {
+			self.notifyAboutFetchError(remoteName, errs[i])
+		}
+		anySucceeded = lo.Contains(errs, nil)
+		err = errors.Join(errs...)
+	}
 
 	self.gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS, types.PULL_REQUESTS}, Mode: types.SYNC})
 
+	if anySucceeded {
+		// If the user switched repos while we were fetching, the branches
+		// are not comparable
+		if repoPath == self.gui.git.RepoPaths.WorktreePath() {
+			self.notifyAboutNewCommits(behindCounts)
+		}
+		fetchErr = self.gui.helpers.BranchesHelper.AutoForwardBranches()
+
+		if self.gui.git.Lfs.RepoUsesLfs() && self.gui.git.Config.IsLfsFilterConfigured() {
+			self.refreshLfsLocks()
+		}
+	}
+
+	// Failures of fetching individual remotes have been reported above
+	self.notifyAboutFetchError("", fetchErr)
+
+	return errors.Join(err, fetchErr)
+}
+
+// Since we're talking to the remote anyway, we also update the lfs locks so
+// that they are shown in the files panel without having to open the locks menu
+func (self *BackgroundRoutineMgr) refreshLfsLocks() {
+	locks, err := self.gui.git.Lfs.GetLocks()
+	if err != nil {
+		// Not all lfs servers support locking, so this isn't worth a toast
+		self.gui.c.Log.Error(err)
+		return
+	}
+
+	self.gui.onUIThread(func() error {
+		self.gui.State.Model.LfsLocks = locks
+		self.gui.c.PostRefreshUpdate(self.gui.State.Contexts.Files)
+		return nil
+	})
+}
+
+// Returns the error of fetching each remote, in the same order as remoteNames.
+// We fetch them one after the other because concurrent fetches into the same
+// repo can fail to take the lock on a ref or on packed-refs (e.g. when pruning).
+func (self *BackgroundRoutineMgr) fetchRemotes(remoteNames []string) []error {
+	return lo.Map(remoteNames, func(remoteName string, _ int) error {
+		return self.gui.git.Sync.FetchRemoteBackground(remoteName)
+	})
+}
+
+func (self *BackgroundRoutineMgr) behindCountsForPull() map[string]string {
+	return lo.SliceToMap(self.gui.State.Model.Branches, func(branch *models.Branch) (string, string) {
+		return branch.Name, branch.BehindForPull
+	})
+}
+
+func (self *BackgroundRoutineMgr) notifyAboutNewCommits(behindCountsBeforeFetch map[string]string) {
+	branchNames := []string{}
+	for _, branch := range self.gui.State.Model.Branches {
+		before, ok := behindCountsBeforeFetch[branch.Name]
+		if ok && branch.IsBehindForPull() && branch.BehindForPull != before {
+			branchNames = append(branchNames, branch.Name)
+		}
+	}
+
+	if len(branchNames) > 0 {
+		self.gui.c.Toast(utils.ResolvePlaceholderString(self.gui.Tr.BackgroundFetchNewCommits,
+			map[string]string{"branches": strings.Join(branchNames, ", ")}))
+	}
+}
+
+// remoteName is empty if we fetched with a single command
+func (self *BackgroundRoutineMgr) notifyAboutFetchError(remoteName string, err error) {
 	if err == nil {
-		err = self.gui.helpers.BranchesHelper.AutoForwardBranches()
+		delete(self.lastFetchErrors, remoteName)
+		return
 	}
 
-	return err
+	if err.Error() != self.lastFetchErrors[remoteName] {
+		self.lastFetchErrors[remoteName] = err.Error()
+		// git's error output can span several lines, but a toast only has room
+		// for one
+		firstLine, _, _ := strings.Cut(strings.TrimSpace(err.Error()), "\n")
+		if remoteName == "" {
+			self.gui.c.WarningToast(utils.ResolvePlaceholderString(self.gui.Tr.BackgroundFetchFailed,
+				map[string]string{"error": firstLine}))
+		} else {
+			self.gui.c.WarningToast(utils.ResolvePlaceholderString(self.gui.Tr.BackgroundFetchOfRemoteFailed,
+				map[string]string{"remote": remoteName, "error": firstLine}))
+		}
+	}
 }
 
 func (self *BackgroundRoutineMgr) triggerImmediateFetch() {
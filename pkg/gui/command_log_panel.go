@@ -24,6 +24,8 @@ import (
 // We pass logCommand to our OSCommand struct so that it can handle logging commands
 // for us.
 func (gui *Gui) LogAction(action string) {
+	gui.onSingleActionLogged(action)
+
 	if gui.Views.Extras == nil {
 		return
 	}
@@ -196,7 +196,9 @@ func (self *ContextMgr) Activate(c types.Context, opts types.OnFocusOpts) {
 
 	v.Visible = true
 
-	self.gui.c.GocuiGui().Cursor = v.Editable && v.Mask == ""
+	// In accessibility mode we always show the cursor, so that screen readers
+	// can follow the selection
+	self.gui.c.GocuiGui().Cursor = (v.Editable && v.Mask == "") || self.gui.c.UserConfig().Gui.Accessibility.Enabled
 
 	c.HandleFocus(opts)
 }
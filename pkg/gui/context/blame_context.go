@@ -0,0 +1,64 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Shows the output of `git blame` for a file in the main window, one list item
+// per line of the file
+type BlameContext struct {
+	*ListViewModel[*models.BlameLine]
+	*ListContextTrait
+
+	path       string
+	blameLines []*models.BlameLine
+}
+
+var _ types.IListContext = (*BlameContext)(nil)
+
+func NewBlameContext(
+	c *ContextCommon,
+) *BlameContext {
+	self := &BlameContext{}
+
+	viewModel := NewListViewModel(func() []*models.BlameLine { return self.blameLines })
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetBlameLineListDisplayStrings(self.blameLines)
+	}
+
+	self.ListViewModel = viewModel
+	self.ListContextTrait = &ListContextTrait{
+		Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+			View:       c.Views().Blame,
+			WindowName: "main",
+			Key:        BLAME_CONTEXT_KEY,
+			Kind:       types.MAIN_CONTEXT,
+			Focusable:  true,
+		})),
+		ListRenderer: ListRenderer{
+			list:              viewModel,
+			getDisplayStrings: getDisplayStrings,
+		},
+		c: c,
+	}
+
+	return self
+}
+
+func (self *BlameContext) SetBlame(path string, blameLines []*models.BlameLine) {
+	self.path = path
+	self.blameLines = blameLines
+	self.GetView().Title = self.c.Tr.BlameTitle + ": " + path
+}
+
+func (self *BlameContext) GetPath() string {
+	return self.path
+}
+
+// There is no use for range-select in the blame view
+func (self *BlameContext) RangeSelectEnabled() bool {
+	return false
+}
@@ -25,16 +25,21 @@ func NewBranchesContext(c *ContextCommon) *BranchesContext {
 	)
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
+		timeFormat, shortTimeFormat := c.UserConfig().Gui.TimeFormatsForPanel(
+			c.UserConfig().Gui.PanelTimeFormats.Branches, c.State().GetDateFormatToggled())
 		return presentation.GetBranchListDisplayStrings(
 			viewModel.GetItems(),
 			c.State().GetItemOperation,
 			c.Model().PullRequestsMap,
+			c.Model().CommitStatuses,
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().Diffing.Ref,
 			c.Views().Branches.InnerWidth()+c.Views().Branches.OriginX(),
 			c.Tr,
 			c.UserConfig(),
 			c.Model().Worktrees,
+			timeFormat,
+			shortTimeFormat,
 		)
 	}
 
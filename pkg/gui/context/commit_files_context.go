@@ -45,7 +45,7 @@ func NewCommitFilesContext(c *ContextCommon) *CommitFilesContext {
 
 	ctx := &CommitFilesContext{
 		CommitFileTreeViewModel: viewModel,
-		DynamicTitleBuilder:     NewDynamicTitleBuilder(c.Tr.CommitFilesDynamicTitle),
+		DynamicTitleBuilder:     NewDynamicTitleBuilder(func() string { return c.Tr.CommitFilesDynamicTitle }),
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(
 				NewBaseContext(NewBaseContextOpts{
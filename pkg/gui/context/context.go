@@ -21,6 +21,7 @@ const (
 	REFLOG_COMMITS_CONTEXT_KEY           types.ContextKey = "reflogCommits"
 	SUB_COMMITS_CONTEXT_KEY              types.ContextKey = "subCommits"
 	COMMIT_FILES_CONTEXT_KEY             types.ContextKey = "commitFiles"
+	FILE_HISTORY_CONTEXT_KEY             types.ContextKey = "fileHistory"
 	STASH_CONTEXT_KEY                    types.ContextKey = "stash"
 	NORMAL_MAIN_CONTEXT_KEY              types.ContextKey = "normal"
 	NORMAL_SECONDARY_CONTEXT_KEY         types.ContextKey = "normalSecondary"
@@ -29,6 +30,7 @@ const (
 	PATCH_BUILDING_MAIN_CONTEXT_KEY      types.ContextKey = "patchBuilding"
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY types.ContextKey = "patchBuildingSecondary"
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
+	BLAME_CONTEXT_KEY                    types.ContextKey = "blame"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
 	OPTIONS_CONTEXT_KEY        types.ContextKey = "options"
@@ -63,6 +65,7 @@ var AllContextKeys = []types.ContextKey{
 	REFLOG_COMMITS_CONTEXT_KEY,
 	SUB_COMMITS_CONTEXT_KEY,
 	COMMIT_FILES_CONTEXT_KEY,
+	FILE_HISTORY_CONTEXT_KEY,
 	STASH_CONTEXT_KEY,
 	NORMAL_MAIN_CONTEXT_KEY,
 	NORMAL_SECONDARY_CONTEXT_KEY,
@@ -71,6 +74,7 @@ var AllContextKeys = []types.ContextKey{
 	PATCH_BUILDING_MAIN_CONTEXT_KEY,
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY,
 	MERGE_CONFLICTS_CONTEXT_KEY,
+	BLAME_CONTEXT_KEY,
 
 	MENU_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
@@ -98,6 +102,7 @@ type ContextTree struct {
 	RemoteBranches              *RemoteBranchesContext
 	ReflogCommits               *ReflogCommitsContext
 	SubCommits                  *SubCommitsContext
+	FileHistory                 *FileHistoryContext
 	Stash                       *StashContext
 	Suggestions                 *SuggestionsContext
 	Normal                      *MainContext
@@ -107,6 +112,7 @@ type ContextTree struct {
 	CustomPatchBuilder          *PatchExplorerContext
 	CustomPatchBuilderSecondary types.Context
 	MergeConflicts              *MergeConflictsContext
+	Blame                       *BlameContext
 	Confirmation                *ConfirmationContext
 	Prompt                      *PromptContext
 	CommitMessage               *CommitMessageContext
@@ -132,6 +138,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Snake,
 		self.Submodules,
 		self.Worktrees,
+		self.FileHistory,
 		self.Files,
 		self.SubCommits,
 		self.Remotes,
@@ -149,6 +156,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.CommitDescription,
 
 		self.MergeConflicts,
+		self.Blame,
 		self.StagingSecondary,
 		self.Staging,
 		self.CustomPatchBuilderSecondary,
@@ -3,14 +3,16 @@ package context
 import "fmt"
 
 type DynamicTitleBuilder struct {
-	formatStr string // e.g. 'remote branches for %s'
+	// Returns e.g. 'remote branches for %s'. This is a function rather than a
+	// string so that the title follows when the language is changed at runtime.
+	getFormatStr func() string
 
 	titleRef string // e.g. 'origin'
 }
 
-func NewDynamicTitleBuilder(formatStr string) *DynamicTitleBuilder {
+func NewDynamicTitleBuilder(getFormatStr func() string) *DynamicTitleBuilder {
 	return &DynamicTitleBuilder{
-		formatStr: formatStr,
+		getFormatStr: getFormatStr,
 	}
 }
 
@@ -19,5 +21,5 @@ func (self *DynamicTitleBuilder) SetTitleRef(titleRef string) {
 }
 
 func (self *DynamicTitleBuilder) Title() string {
-	return fmt.Sprintf(self.formatStr, self.titleRef)
+	return fmt.Sprintf(self.getFormatStr(), self.titleRef)
 }
@@ -0,0 +1,83 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Lists the commits that touched a file, following it across renames. It is
+// shown in place of the panel that it was opened from.
+type FileHistoryContext struct {
+	*ListViewModel[*models.Commit]
+	*ListContextTrait
+
+	path    string
+	commits []*models.Commit
+}
+
+var _ types.IListContext = (*FileHistoryContext)(nil)
+
+func NewFileHistoryContext(
+	c *ContextCommon,
+) *FileHistoryContext {
+	self := &FileHistoryContext{}
+
+	viewModel := NewListViewModel(func() []*models.Commit { return self.commits })
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetFileHistoryListDisplayStrings(self.commits, self.path, self.PathInCommit)
+	}
+
+	self.ListViewModel = viewModel
+	self.ListContextTrait = &ListContextTrait{
+		Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+			View:       c.Views().FileHistory,
+			WindowName: "files",
+			Key:        FILE_HISTORY_CONTEXT_KEY,
+			Kind:       types.SIDE_CONTEXT,
+			Focusable:  true,
+			Transient:  true,
+		})),
+		ListRenderer: ListRenderer{
+			list:              viewModel,
+			getDisplayStrings: getDisplayStrings,
+		},
+		c: c,
+	}
+
+	return self
+}
+
+func (self *FileHistoryContext) SetFileHistory(path string, commits []*models.Commit) {
+	self.path = path
+	self.commits = commits
+	self.GetView().Title = self.c.Tr.FileHistoryTitle + ": " + path
+}
+
+func (self *FileHistoryContext) GetPath() string {
+	return self.path
+}
+
+// The path that the file had in the given commit. This differs from GetPath
+// for the commits before the file was last renamed.
+func (self *FileHistoryContext) PathInCommit(commit *models.Commit) string {
+	if len(commit.FilterPaths) == 0 {
+		return self.path
+	}
+
+	// For a rename, these are the old and the new path
+	return commit.FilterPaths[len(commit.FilterPaths)-1]
+}
+
+// The paths to pass to git when diffing the given commits, so that the diff
+// covers the file under all the names it had in these commits
+func (self *FileHistoryContext) PathsForDiff(commits ...*models.Commit) []string {
+	return lo.Uniq(lo.FlatMap(commits, func(commit *models.Commit, _ int) []string {
+		if len(commit.FilterPaths) == 0 {
+			return []string{self.path}
+		}
+		return commit.FilterPaths
+	}))
+}
@@ -28,6 +28,15 @@ type ListContextTrait struct {
 	// true if we're inside the OnSearchSelect call; in that case we don't want to update the search
 	// result index.
 	inOnSearchSelect bool
+
+	// a selection to restore once the list has been loaded; see RestoreSelectionWhenLoaded
+	pendingSelection *pendingSelection
+}
+
+type pendingSelection struct {
+	itemId       string
+	idx          int
+	scrollOffset int
 }
 
 func (self *ListContextTrait) IsListContext() {}
@@ -106,8 +115,37 @@ func (self *ListContextTrait) HandleFocusLost(opts types.OnFocusLostOpts) {
 	self.Context.HandleFocusLost(opts)
 }
 
+// RestoreSelectionWhenLoaded selects the item with the given id (or, if it no
+// longer exists, the given index) the next time the list is rendered with
+// items in it, and scrolls so that the selection is scrollOffset lines below
+// the top of the view. This is used for restoring the selection from the
+// previous session, when the models haven't been loaded yet.
+func (self *ListContextTrait) RestoreSelectionWhenLoaded(itemId string, idx int, scrollOffset int) {
+	self.pendingSelection = &pendingSelection{itemId: itemId, idx: idx, scrollOffset: scrollOffset}
+}
+
+func (self *ListContextTrait) applyPendingSelection() {
+	if self.pendingSelection == nil || self.list.Len() == 0 {
+		return
+	}
+
+	selection := self.pendingSelection
+	self.pendingSelection = nil
+
+	idx := selection.idx
+	for i := range self.list.Len() {
+		if self.list.GetItemId(i) == selection.itemId {
+			idx = i
+			break
+		}
+	}
+	self.list.SetSelection(idx)
+	self.GetView().SetOriginY(max(0, self.ModelIndexToViewIndex(self.list.GetSelectedLineIdx())-selection.scrollOffset))
+}
+
 // OnFocus assumes that the content of the context has already been rendered to the view. OnRender is the function which actually renders the content to the view
 func (self *ListContextTrait) HandleRender() {
+	self.applyPendingSelection()
 	self.list.ClampSelection()
 	if self.renderOnlyVisibleLines {
 		// Rendering only the visible area can save a lot of cell memory for
@@ -3,6 +3,7 @@ package context
 import (
 	"strings"
 
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -89,14 +90,38 @@ func (self *ListRenderer) renderLines(startIdx int, endIdx int) string {
 	} else {
 		endModelIdx = self.ViewIndexToModelIndex(endIdx)
 	}
-	lines, columnPositions := utils.RenderDisplayStrings(
-		self.getDisplayStrings(startModelIdx, endModelIdx),
-		columnAlignments)
+	displayStrings := self.getDisplayStrings(startModelIdx, endModelIdx)
+	hasMarks := self.list.HasMarks()
+	if hasMarks {
+		displayStrings, columnAlignments = self.prependMarkColumn(displayStrings, columnAlignments, startModelIdx)
+	}
+	lines, columnPositions := utils.RenderDisplayStrings(displayStrings, columnAlignments)
+	if hasMarks && columnPositions != nil {
+		// Hide the mark column from clients, so that they can keep using the
+		// column indices of their own display strings
+		columnPositions = columnPositions[1:]
+	}
 	self.columnPositions = columnPositions
 	lines = self.insertNonModelItems(nonModelItems, endIdx, startIdx, lines, columnPositions)
 	return strings.Join(lines, "\n")
 }
 
+func (self *ListRenderer) prependMarkColumn(
+	displayStrings [][]string, columnAlignments []utils.Alignment, startModelIdx int,
+) ([][]string, []utils.Alignment) {
+	for i, row := range displayStrings {
+		mark := " "
+		if self.list.IsMarked(startModelIdx + i) {
+			mark = style.FgYellow.Sprint("*")
+		}
+		displayStrings[i] = append([]string{mark}, row...)
+	}
+	if columnAlignments != nil {
+		columnAlignments = append([]utils.Alignment{utils.AlignLeft}, columnAlignments...)
+	}
+	return displayStrings, columnAlignments
+}
+
 func (self *ListRenderer) prepareConversionArrays(nonModelItems []*NonModelItem) {
 	self.numNonModelItems = len(nonModelItems)
 	viewIndicesByModelIndex := lo.Range(self.list.Len() + 1)
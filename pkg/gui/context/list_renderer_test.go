@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
@@ -145,6 +146,39 @@ func TestListRenderer_renderLines(t *testing.T) {
 	}
 }
 
+func TestListRenderer_renderLinesWithMarks(t *testing.T) {
+	modelStrings := []mystring{"a", "b", "c", "d"}
+	viewModel := NewListViewModel(func() []mystring { return modelStrings })
+	self := &ListRenderer{
+		list: viewModel,
+		getDisplayStrings: func(startIdx int, endIdx int) [][]string {
+			return lo.Map(modelStrings[startIdx:endIdx],
+				func(s mystring, _ int) []string { return []string{string(s), "x"} })
+		},
+		getNonModelItems: func() []*NonModelItem {
+			return []*NonModelItem{{Index: 2, Content: "---", Column: 1}}
+		},
+	}
+
+	assert.Equal(t, "a x\nb x\n  ---\nc x\nd x", self.renderLines(-1, -1))
+
+	viewModel.ToggleMark(1)
+	viewModel.ToggleMark(3)
+	assert.Equal(t, "  a x\n* b x\n    ---\n  c x\n* d x", utils.Decolorise(self.renderLines(-1, -1)))
+	// The mark column is not visible to clients
+	assert.Equal(t, []int{2, 4}, self.ColumnPositions())
+
+	items, startIdx, endIdx := viewModel.GetSelectedItems()
+	assert.Equal(t, []mystring{"b", "d"}, items)
+	assert.Equal(t, 1, startIdx)
+	assert.Equal(t, 3, endIdx)
+	assert.True(t, viewModel.AreMultipleItemsSelected())
+
+	viewModel.ClearMarks()
+	assert.Equal(t, "a x\nb x\n  ---\nc x\nd x", self.renderLines(-1, -1))
+	assert.False(t, viewModel.AreMultipleItemsSelected())
+}
+
 type myint int
 
 func (self myint) ID() string {
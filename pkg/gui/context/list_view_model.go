@@ -12,6 +12,7 @@ type HasID interface {
 
 type ListViewModel[T HasID] struct {
 	*traits.ListCursor
+	*traits.ListMarks
 	getModel func() []T
 }
 
@@ -21,6 +22,7 @@ func NewListViewModel[T HasID](getModel func() []T) *ListViewModel[T] {
 	}
 
 	self.ListCursor = traits.NewListCursor(func() int { return len(getModel()) })
+	self.ListMarks = traits.NewListMarks(func() int { return len(getModel()) }, self.GetItemId)
 
 	return self
 }
@@ -46,11 +48,29 @@ func (self *ListViewModel[T]) GetSelectedItems() ([]T, int, int) {
 		return nil, -1, -1
 	}
 
+	// If the user has marked items, these take precedence over the selection.
+	// Note that the marked items aren't necessarily contiguous, so startIdx
+	// and endIdx only give the bounds of the marked items in this case.
+	if markedIndices := self.MarkedIndices(); len(markedIndices) > 0 {
+		items := lo.Map(markedIndices, func(idx int, _ int) T {
+			return self.getModel()[idx]
+		})
+		return items, markedIndices[0], markedIndices[len(markedIndices)-1]
+	}
+
 	startIdx, endIdx := self.GetSelectionRange()
 
 	return self.getModel()[startIdx : endIdx+1], startIdx, endIdx
 }
 
+func (self *ListViewModel[T]) AreMultipleItemsSelected() bool {
+	if markedIndices := self.MarkedIndices(); len(markedIndices) > 0 {
+		return len(markedIndices) > 1 || markedIndices[0] != self.GetSelectedLineIdx()
+	}
+
+	return self.ListCursor.AreMultipleItemsSelected()
+}
+
 func (self *ListViewModel[T]) GetSelectedItemIds() ([]string, int, int) {
 	selectedItems, startIdx, endIdx := self.GetSelectedItems()
 
@@ -69,6 +89,10 @@ func Zero[T any]() T {
 	return *new(T)
 }
 
+func (self *ListViewModel[T]) GetItemId(index int) string {
+	return self.getModel()[index].ID()
+}
+
 func (self *ListViewModel[T]) GetItem(index int) types.HasUrn {
 	item := self.getModel()[index]
 	return any(item).(types.HasUrn)
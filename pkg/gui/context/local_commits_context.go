@@ -3,11 +3,11 @@ package context
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
@@ -42,6 +42,8 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 		}
 
 		hasRebaseUpdateRefsConfig := c.Git().Config.GetRebaseUpdateRefs()
+		timeFormat, shortTimeFormat := c.UserConfig().Gui.TimeFormatsForPanel(
+			c.UserConfig().Gui.PanelTimeFormats.Commits, c.State().GetDateFormatToggled())
 
 		return presentation.GetCommitListDisplayStrings(
 			c.Common,
@@ -53,8 +55,8 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			c.Modes().CherryPicking.SelectedHashSet(),
 			c.Modes().Diffing.Ref,
 			c.Modes().MarkedBaseCommit.GetHash(),
-			c.UserConfig().Gui.TimeFormat,
-			c.UserConfig().Gui.ShortTimeFormat,
+			timeFormat,
+			shortTimeFormat,
 			time.Now(),
 			c.UserConfig().Git.ParseEmoji,
 			selectedCommitHashPtr,
@@ -62,6 +64,8 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			endIdx,
 			shouldShowGraph(c),
 			c.Model().BisectInfo,
+			c.Model().CommitStatuses,
+			func() { rerenderOnGraphProgress(c, LOCAL_COMMITS_CONTEXT_KEY) },
 		)
 	}
 
@@ -107,6 +111,10 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			})
 		}
 
+		if c.UserConfig().Gui.SeparatePushedCommits || viewModel.GetPushedCommitsCollapsed() {
+			result = append(result, pushedCommitsSectionHeaders(c, viewModel)...)
+		}
+
 		return result
 	}
 
@@ -137,23 +145,75 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 	return ctx
 }
 
+// Returns the headers separating the unpushed commits from the pushed ones. If
+// we are showing rebase todos, there's already a header above the unpushed
+// commits, so we only add the one for the pushed commits.
+func pushedCommitsSectionHeaders(c *ContextCommon, viewModel *LocalCommitsViewModel) []*NonModelItem {
+	firstPushedCommit, found := viewModel.FirstPushedCommitIdx()
+	if !found {
+		return nil
+	}
+
+	result := []*NonModelItem{}
+	if !c.Model().WorkingTreeStateAtLastCommitRefresh.CanShowTodos() {
+		unpushedCount := lo.CountBy(c.Model().Commits[:firstPushedCommit], func(commit *models.Commit) bool {
+			return commit.Status == models.StatusUnpushed
+		})
+		if unpushedCount > 0 {
+			result = append(result, &NonModelItem{
+				Index:   0,
+				Content: fmt.Sprintf("--- %s ---", fmt.Sprintf(c.Tr.UnpushedCommitsSectionHeader, unpushedCount)),
+			})
+		}
+	}
+
+	label := c.Tr.PushedCommitsSectionHeader
+	if viewModel.GetPushedCommitsCollapsed() {
+		label = fmt.Sprintf(c.Tr.PushedCommitsCollapsedSectionHeader,
+			keybindings.Label(c.UserConfig().Keybinding.Commits.CollapsePushedCommits))
+	}
+	result = append(result, &NonModelItem{
+		Index:   firstPushedCommit,
+		Content: fmt.Sprintf("--- %s ---", label),
+	})
+
+	return result
+}
+
 type LocalCommitsViewModel struct {
 	*ListViewModel[*models.Commit]
 
+	// All loaded commits, including the pushed ones when they are collapsed
+	getAllCommits func() []*models.Commit
+
 	// If this is true we limit the amount of commits we load, for the sake of keeping things fast.
 	// If the user attempts to scroll past the end of the list, we will load more commits.
 	limitCommits bool
 
 	// If this is true we'll use git log --all when fetching the commits.
 	showWholeGitGraph bool
+
+	// If this is true we only show the commits above the first pushed commit.
+	// Since these come first in the model, the indices of the visible commits
+	// are the same as in the model.
+	pushedCommitsCollapsed bool
 }
 
 func NewLocalCommitsViewModel(getModel func() []*models.Commit, c *ContextCommon) *LocalCommitsViewModel {
 	self := &LocalCommitsViewModel{
-		ListViewModel:     NewListViewModel(getModel),
+		getAllCommits:     getModel,
 		limitCommits:      true,
 		showWholeGitGraph: c.UserConfig().Git.Log.ShowWholeGraph,
 	}
+	self.ListViewModel = NewListViewModel(func() []*models.Commit {
+		commits := getModel()
+		if self.pushedCommitsCollapsed {
+			if idx, found := self.FirstPushedCommitIdx(); found {
+				return commits[:idx]
+			}
+		}
+		return commits
+	})
 
 	return self
 }
@@ -220,8 +280,8 @@ func (self *LocalCommitsContext) RefForAdjustingLineNumberInDiff() string {
 	return commits[0].Hash()
 }
 
-func (self *LocalCommitsContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
-	return searchModelCommits(caseSensitive, self.GetCommits(), self.ColumnPositions(), self.ModelIndexToViewIndex, searchStr)
+func (self *LocalCommitsContext) ModelSearchResults(matches func(string) bool) []gocui.SearchPosition {
+	return searchModelCommits(self.GetCommits(), self.ColumnPositions(), self.ModelIndexToViewIndex, matches)
 }
 
 func (self *LocalCommitsViewModel) SetLimitCommits(value bool) {
@@ -240,12 +300,38 @@ func (self *LocalCommitsViewModel) GetShowWholeGitGraph() bool {
 	return self.showWholeGitGraph
 }
 
+func (self *LocalCommitsViewModel) SetPushedCommitsCollapsed(value bool) {
+	self.pushedCommitsCollapsed = value
+}
+
+func (self *LocalCommitsViewModel) GetPushedCommitsCollapsed() bool {
+	return self.pushedCommitsCollapsed
+}
+
+// Returns the index of the first commit that is already on the upstream (or on
+// a main branch), regardless of whether the pushed commits are collapsed
+func (self *LocalCommitsViewModel) FirstPushedCommitIdx() (int, bool) {
+	_, idx, found := lo.FindIndexOf(self.getAllCommits(), func(commit *models.Commit) bool {
+		return commit.Status == models.StatusPushed || commit.Status == models.StatusMerged
+	})
+	return idx, found
+}
+
 func (self *LocalCommitsViewModel) GetCommits() []*models.Commit {
 	return self.getModel()
 }
 
+// Called from a background goroutine when more of the commit graph has been
+// computed
+func rerenderOnGraphProgress(c *ContextCommon, key types.ContextKey) {
+	c.OnUIThread(func() error {
+		c.ContextForKey(key).HandleRender()
+		return nil
+	})
+}
+
 func shouldShowGraph(c *ContextCommon) bool {
-	if c.Modes().Filtering.Active() {
+	if c.Modes().Filtering.Active() || c.UserConfig().Gui.Accessibility.Enabled {
 		return false
 	}
 
@@ -264,8 +350,8 @@ func shouldShowGraph(c *ContextCommon) bool {
 	return false
 }
 
-func searchModelCommits(caseSensitive bool, commits []*models.Commit, columnPositions []int,
-	modelToViewIndex func(int) int, searchStr string,
+func searchModelCommits(commits []*models.Commit, columnPositions []int,
+	modelToViewIndex func(int) int, matches func(string) bool,
 ) []gocui.SearchPosition {
 	if columnPositions == nil {
 		// This should never happen. We are being called at a time where our
@@ -276,7 +362,6 @@ func searchModelCommits(caseSensitive bool, commits []*models.Commit, columnPosi
 		return []gocui.SearchPosition{}
 	}
 
-	normalize := lo.Ternary(caseSensitive, func(s string) string { return s }, strings.ToLower)
 	return lo.FilterMap(commits, func(commit *models.Commit, idx int) (gocui.SearchPosition, bool) {
 		// The XStart and XEnd values are only used if the search string can't
 		// be found in the view. This can really only happen if the user is
@@ -284,9 +369,9 @@ func searchModelCommits(caseSensitive bool, commits []*models.Commit, columnPosi
 		// that we render. So we just set the XStart and XEnd values to the
 		// start and end of the commit hash column, which is the second one.
 		result := gocui.SearchPosition{XStart: columnPositions[1], XEnd: columnPositions[2] - 1, Y: modelToViewIndex(idx)}
-		return result, strings.Contains(normalize(commit.Hash()), searchStr) ||
-			strings.Contains(normalize(commit.Name), searchStr) ||
-			strings.Contains(normalize(commit.ExtraInfo), searchStr) // allow searching for tags
+		return result, matches(commit.Hash()) ||
+			matches(commit.Name) ||
+			matches(commit.ExtraInfo) // allow searching for tags
 	})
 }
 
@@ -34,9 +34,13 @@ func NewMainContext(
 	return ctx
 }
 
-func (self *MainContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+func (self *MainContext) ModelSearchResults(matches func(string) bool) []gocui.SearchPosition {
 	return nil
 }
 
 func (self *MainContext) OnSearchSelect(int) {
 }
+
+func (self *MainContext) SearchMatchLineNumber(lineIdx int) int {
+	return lineIdx + 1
+}
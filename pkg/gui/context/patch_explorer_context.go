@@ -133,7 +133,7 @@ func (self *PatchExplorerContext) GetMutex() *deadlock.Mutex {
 	return &self.mutex
 }
 
-func (self *PatchExplorerContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+func (self *PatchExplorerContext) ModelSearchResults(matches func(string) bool) []gocui.SearchPosition {
 	return nil
 }
 
@@ -145,9 +145,18 @@ func (self *PatchExplorerContext) OnSearchSelect(selectedLineIdx int) {
 	self.inOnSelectItemCallback = false
 }
 
+func (self *PatchExplorerContext) SearchMatchLineNumber(lineIdx int) int {
+	state := self.GetState()
+	if state == nil {
+		return 0
+	}
+
+	return state.LineNumberOfPatchLine(lineIdx)
+}
+
 func (self *PatchExplorerContext) OnViewWidthChanged() {
 	if state := self.GetState(); state != nil {
-		state.OnViewWidthChanged(self.GetView())
+		state.UpdateWrapping(self.GetView())
 		self.setContent()
 		self.RenderAndFocus()
 	}
@@ -11,6 +11,9 @@ import (
 type ReflogCommitsContext struct {
 	*FilteredListViewModel[*models.Commit]
 	*ListContextTrait
+
+	// The ref whose reflog we show (e.g. a branch name); empty means HEAD
+	ref string
 }
 
 var (
@@ -32,14 +35,16 @@ func NewReflogCommitsContext(c *ContextCommon) *ReflogCommitsContext {
 			return nil
 		}
 
+		timeFormat, shortTimeFormat := c.UserConfig().Gui.TimeFormatsForPanel(
+			c.UserConfig().Gui.PanelTimeFormats.Reflog, c.State().GetDateFormatToggled())
 		return presentation.GetReflogCommitListDisplayStrings(
 			commits[startIdx:endIdx],
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedHashSet(),
 			c.Modes().Diffing.Ref,
 			time.Now(),
-			c.UserConfig().Gui.TimeFormat,
-			c.UserConfig().Gui.ShortTimeFormat,
+			timeFormat,
+			shortTimeFormat,
 			c.UserConfig().Git.ParseEmoji,
 		)
 	}
@@ -66,6 +71,18 @@ func NewReflogCommitsContext(c *ContextCommon) *ReflogCommitsContext {
 	}
 }
 
+func (self *ReflogCommitsContext) GetRef() string {
+	return self.ref
+}
+
+// Shows the reflog of the given ref instead of the one of HEAD; pass an empty
+// string to go back to HEAD. The ref is shown in the view's subtitle so that
+// you don't forget that you're not looking at the usual reflog.
+func (self *ReflogCommitsContext) SetRef(ref string) {
+	self.ref = ref
+	self.GetView().Subtitle = ref
+}
+
 func (self *ReflogCommitsContext) CanRebase() bool {
 	return false
 }
@@ -29,12 +29,12 @@ func NewRemoteBranchesContext(
 	)
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
-		return presentation.GetRemoteBranchListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref)
+		return presentation.GetRemoteBranchListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref, c.Model().RemoteBranchDivergences)
 	}
 
 	return &RemoteBranchesContext{
 		FilteredListViewModel: viewModel,
-		DynamicTitleBuilder:   NewDynamicTitleBuilder(c.Tr.RemoteBranchesDynamicTitle),
+		DynamicTitleBuilder:   NewDynamicTitleBuilder(func() string { return c.Tr.RemoteBranchesDynamicTitle }),
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
 				View:                        c.Views().RemoteBranches,
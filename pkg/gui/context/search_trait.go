@@ -36,7 +36,11 @@ func (self *SearchTrait) ClearSearchString() {
 // used for type switch
 func (self *SearchTrait) IsSearchableContext() {}
 
-func (self *SearchTrait) RenderSearchStatus(index int, total int) {
+func (self *SearchTrait) SearchMatchLineNumber(lineIdx int) int {
+	return 0
+}
+
+func (self *SearchTrait) RenderSearchStatus(index int, total int, lineNumber int) {
 	keybindingConfig := self.c.UserConfig().Keybinding
 
 	if total == 0 {
@@ -49,13 +53,16 @@ func (self *SearchTrait) RenderSearchStatus(index int, total int) {
 			),
 		)
 	} else {
+		position := fmt.Sprintf(self.c.Tr.MatchXOfY, index+1, total)
+		if lineNumber > 0 {
+			position = fmt.Sprintf(self.c.Tr.MatchXOfYAtLine, index+1, total, lineNumber)
+		}
 		self.c.SetViewContent(
 			self.c.Views().Search,
 			fmt.Sprintf(
 				self.c.Tr.MatchesFor,
 				self.searchString,
-				index+1,
-				total,
+				position,
 				theme.OptionsFgColor.Sprintf(
 					self.c.Tr.SearchKeybindings,
 					keybindings.Label(keybindingConfig.Universal.NextMatch),
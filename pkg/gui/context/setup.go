@@ -35,6 +35,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		CommitFiles:     commitFilesContext,
 		ReflogCommits:   NewReflogCommitsContext(c),
 		SubCommits:      NewSubCommitsContext(c),
+		FileHistory:     NewFileHistoryContext(c),
 		Branches:        NewBranchesContext(c),
 		Tags:            NewTagsContext(c),
 		Stash:           NewStashContext(c),
@@ -83,6 +84,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		MergeConflicts: NewMergeConflictsContext(
 			c,
 		),
+		Blame:         NewBlameContext(c),
 		Confirmation:  NewConfirmationContext(c),
 		Prompt:        NewPromptContext(c),
 		CommitMessage: NewCommitMessageContext(c),
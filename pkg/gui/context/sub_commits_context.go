@@ -58,6 +58,8 @@ func NewSubCommitsContext(
 			branches = c.Model().Branches
 		}
 		hasRebaseUpdateRefsConfig := c.Git().Config.GetRebaseUpdateRefs()
+		timeFormat, shortTimeFormat := c.UserConfig().Gui.TimeFormatsForPanel(
+			c.UserConfig().Gui.PanelTimeFormats.Commits, c.State().GetDateFormatToggled())
 		return presentation.GetCommitListDisplayStrings(
 			c.Common,
 			c.Model().SubCommits,
@@ -68,8 +70,8 @@ func NewSubCommitsContext(
 			c.Modes().CherryPicking.SelectedHashSet(),
 			c.Modes().Diffing.Ref,
 			"",
-			c.UserConfig().Gui.TimeFormat,
-			c.UserConfig().Gui.ShortTimeFormat,
+			timeFormat,
+			shortTimeFormat,
 			time.Now(),
 			c.UserConfig().Git.ParseEmoji,
 			selectedCommitHashPtr,
@@ -77,6 +79,8 @@ func NewSubCommitsContext(
 			endIdx,
 			shouldShowGraph(c),
 			git_commands.NewNullBisectInfo(),
+			c.Model().CommitStatuses,
+			func() { rerenderOnGraphProgress(c, SUB_COMMITS_CONTEXT_KEY) },
 		)
 	}
 
@@ -111,7 +115,7 @@ func NewSubCommitsContext(
 		c:                   c,
 		SubCommitsViewModel: viewModel,
 		SearchTrait:         NewSearchTrait(c),
-		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.SubCommitsDynamicTitle),
+		DynamicTitleBuilder: NewDynamicTitleBuilder(func() string { return c.Tr.SubCommitsDynamicTitle }),
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
 				View:                        c.Views().SubCommits,
@@ -222,8 +226,8 @@ func (self *SubCommitsContext) RefForAdjustingLineNumberInDiff() string {
 	return commits[0].Hash()
 }
 
-func (self *SubCommitsContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
-	return searchModelCommits(caseSensitive, self.GetCommits(), self.ColumnPositions(), self.ModelIndexToViewIndex, searchStr)
+func (self *SubCommitsContext) ModelSearchResults(matches func(string) bool) []gocui.SearchPosition {
+	return searchModelCommits(self.GetCommits(), self.ColumnPositions(), self.ModelIndexToViewIndex, matches)
 }
 
 func (self *SubCommitsContext) IndexForGotoBottom() int {
@@ -0,0 +1,93 @@
+package traits
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// ListMarks keeps track of the items that the user has marked in a list, so
+// that actions can operate on an arbitrary (not necessarily contiguous) set of
+// items. Items are identified by their id rather than their index, so that the
+// marks survive refreshes of the list.
+type ListMarks struct {
+	markedIds map[string]struct{}
+
+	getLength func() int
+	getItemId func(int) string
+}
+
+func NewListMarks(getLength func() int, getItemId func(int) string) *ListMarks {
+	return &ListMarks{
+		markedIds: map[string]struct{}{},
+		getLength: getLength,
+		getItemId: getItemId,
+	}
+}
+
+var _ types.IListMarks = (*ListMarks)(nil)
+
+func (self *ListMarks) ToggleMark(index int) {
+	id := self.getItemId(index)
+	if _, ok := self.markedIds[id]; ok {
+		delete(self.markedIds, id)
+	} else {
+		self.markedIds[id] = struct{}{}
+	}
+}
+
+// Marks all items in the given range, or unmarks them if they are all marked
+// already
+func (self *ListMarks) ToggleMarkRange(startIdx int, endIdx int) {
+	allMarked := true
+	for i := startIdx; i <= endIdx; i++ {
+		if !self.IsMarked(i) {
+			allMarked = false
+			break
+		}
+	}
+
+	for i := startIdx; i <= endIdx; i++ {
+		if allMarked {
+			delete(self.markedIds, self.getItemId(i))
+		} else {
+			self.markedIds[self.getItemId(i)] = struct{}{}
+		}
+	}
+}
+
+func (self *ListMarks) IsMarked(index int) bool {
+	if len(self.markedIds) == 0 {
+		return false
+	}
+
+	_, ok := self.markedIds[self.getItemId(index)]
+	return ok
+}
+
+// Returns the indices of the marked items in list order. Marks of items that
+// are no longer in the list are forgotten.
+func (self *ListMarks) MarkedIndices() []int {
+	if len(self.markedIds) == 0 {
+		return nil
+	}
+
+	indices := []int{}
+	stillPresent := map[string]struct{}{}
+	for i := range self.getLength() {
+		id := self.getItemId(i)
+		if _, ok := self.markedIds[id]; ok {
+			indices = append(indices, i)
+			stillPresent[id] = struct{}{}
+		}
+	}
+	self.markedIds = stillPresent
+
+	return indices
+}
+
+func (self *ListMarks) HasMarks() bool {
+	return len(self.MarkedIndices()) > 0
+}
+
+func (self *ListMarks) ClearMarks() {
+	self.markedIds = map[string]struct{}{}
+}
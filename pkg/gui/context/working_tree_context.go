@@ -1,6 +1,9 @@
 package context
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
@@ -12,6 +15,9 @@ import (
 type WorkingTreeContext struct {
 	*filetree.FileTreeViewModel
 	*ListContextTrait
+
+	// Set when the user temporarily inverted the git.skipUntrackedFiles config
+	skipUntrackedFilesToggled bool
 }
 
 var (
@@ -29,7 +35,7 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 	getDisplayStrings := func(_ int, _ int) [][]string {
 		showFileIcons := icons.IsIconEnabled() && c.UserConfig().Gui.ShowFileIcons
 		showNumstat := c.UserConfig().Gui.ShowNumstatInFilesView
-		lines := presentation.RenderFileTree(viewModel, c.Model().Submodules, showFileIcons, showNumstat, &c.UserConfig().Gui.CustomIcons, c.UserConfig().Gui.ShowRootItemInFileTree)
+		lines := presentation.RenderFileTree(viewModel, c.Model().Submodules, c.Model().LfsLocks, showFileIcons, showNumstat, &c.UserConfig().Gui.CustomIcons, c.UserConfig().Gui.ShowRootItemInFileTree)
 		return lo.Map(lines, func(line string, _ int) []string {
 			return []string{line}
 		})
@@ -55,3 +61,50 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 
 	return ctx
 }
+
+// Whether to run `git status` without looking for untracked files, which can
+// take a long time in huge repos
+func (self *WorkingTreeContext) SkipUntrackedFiles() bool {
+	return self.c.UserConfig().Git.SkipUntrackedFiles != self.skipUntrackedFilesToggled
+}
+
+func (self *WorkingTreeContext) ToggleSkipUntrackedFiles() {
+	self.skipUntrackedFilesToggled = !self.skipUntrackedFilesToggled
+}
+
+// Shows the status filter in the view's subtitle, a hint if untracked files
+// are hidden because we're not scanning for them, and whether the working tree
+// is a sparse checkout, i.e. doesn't have all of the repo's files
+func (self *WorkingTreeContext) UpdateSubtitle() {
+	labels := []string{}
+	if label := self.statusFilterLabel(); label != "" {
+		labels = append(labels, label)
+	}
+	if self.SkipUntrackedFiles() && !self.ForceShowUntracked() {
+		labels = append(labels, self.c.Tr.UntrackedFilesHidden)
+	}
+	if self.c.Git().Config.UsesSparseCheckout() {
+		labels = append(labels, self.c.Tr.SparseCheckoutLabel)
+	}
+
+	self.GetView().Subtitle = strings.Join(labels, " ")
+}
+
+func (self *WorkingTreeContext) statusFilterLabel() string {
+	switch filter := self.GetStatusFilter(); filter {
+	case filetree.DisplayAll:
+		return ""
+	case filetree.DisplayStaged:
+		return self.c.Tr.FilterLabelStagedFiles
+	case filetree.DisplayUnstaged:
+		return self.c.Tr.FilterLabelUnstagedFiles
+	case filetree.DisplayTracked:
+		return self.c.Tr.FilterLabelTrackedFiles
+	case filetree.DisplayUntracked:
+		return self.c.Tr.FilterLabelUntrackedFiles
+	case filetree.DisplayConflicted:
+		return self.c.Tr.FilterLabelConflictingFiles
+	default:
+		panic(fmt.Sprintf("Unexpected files display filter: %d", filter))
+	}
+}
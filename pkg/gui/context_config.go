@@ -2,7 +2,9 @@ package gui
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 func (gui *Gui) contextTree() *context.ContextTree {
@@ -18,5 +20,17 @@ func (gui *Gui) defaultSideContext() types.Context {
 		return gui.State.Contexts.LocalCommits
 	}
 
-	return gui.State.Contexts.Files
+	sideWindows := helpers.GetSideWindows(gui.c.UserConfig(), gui.c.GetAppState())
+	if lo.Contains(sideWindows, "files") {
+		return gui.State.Contexts.Files
+	}
+
+	// The files panel has been hidden, so start in whichever panel is at the top
+	contextsByWindow := map[string]types.Context{
+		"status":   gui.State.Contexts.Status,
+		"branches": gui.State.Contexts.Branches,
+		"commits":  gui.State.Contexts.LocalCommits,
+		"stash":    gui.State.Contexts.Stash,
+	}
+	return contextsByWindow[sideWindows[0]]
 }
@@ -3,6 +3,7 @@ package gui
 import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
@@ -25,10 +26,12 @@ func (gui *Gui) resetHelpersAndControllers() {
 	helperCommon := gui.c
 	recordDirectoryHelper := helpers.NewRecordDirectoryHelper(helperCommon)
 	reposHelper := helpers.NewRecentReposHelper(helperCommon, recordDirectoryHelper, gui.onSwitchToNewRepo)
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon)
-	refsHelper := helpers.NewRefsHelper(helperCommon, rebaseHelper)
+	protectedBranchesHelper := helpers.NewProtectedBranchesHelper(helperCommon)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, protectedBranchesHelper)
+	refsHelper := helpers.NewRefsHelper(helperCommon, rebaseHelper, protectedBranchesHelper)
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon)
 	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper)
+	operationJournalHelper := helpers.NewOperationJournalHelper(helperCommon)
 
 	setCommitSummary := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
 	setCommitDescription := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitDescription })
@@ -82,10 +85,12 @@ func (gui *Gui) resetHelpersAndControllers() {
 		rebaseHelper,
 		bisectHelper,
 	)
+	accessibilityHelper := helpers.NewAccessibilityHelper(helperCommon)
 	appStatusHelper := helpers.NewAppStatusHelper(
 		helperCommon,
 		func() *status.StatusManager { return gui.statusManager },
 		modeHelper,
+		accessibilityHelper,
 	)
 
 	gui.helpers = &helpers.Helpers{
@@ -98,14 +103,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Files:           helpers.NewFilesHelper(helperCommon),
 		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper, rebaseHelper),
 		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper, gpgHelper),
-		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper),
+		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper, operationJournalHelper, protectedBranchesHelper),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
 		CherryPick:      cherryPickHelper,
 		Upstream:        helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
 		AmendHelper:     helpers.NewAmendHelper(helperCommon, gpgHelper),
-		FixupHelper:     helpers.NewFixupHelper(helperCommon),
+		FixupHelper:     helpers.NewFixupHelper(helperCommon, gpgHelper),
 		Commits:         commitsHelper,
 		SuspendResume:   helpers.NewSuspendResumeHelper(helperCommon),
 		Snake:           helpers.NewSnakeHelper(helperCommon),
@@ -126,9 +131,16 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper),
+		PanelResize:       helpers.NewPanelResizeHelper(helperCommon, windowHelper),
+		Bookmarks:         helpers.NewBookmarksHelper(helperCommon),
+		Search:            searchHelper,
+		Worktree:          worktreeHelper,
+		SubCommits:        helpers.NewSubCommitsHelper(helperCommon, refreshHelper),
+		SetupWizard:       helpers.NewSetupWizardHelper(helperCommon),
+		Accessibility:     accessibilityHelper,
+		ImagePreview:      helpers.NewImagePreviewHelper(helperCommon),
+		OperationJournal:  operationJournalHelper,
+		ProtectedBranches: protectedBranchesHelper,
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -141,6 +153,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 	syncController := controllers.NewSyncController(
 		common,
 	)
+	gui.handlePull = syncController.HandlePull
 
 	submodulesController := controllers.NewSubmodulesController(common)
 
@@ -163,6 +176,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 		common,
 	)
 	mergeConflictsController := controllers.NewMergeConflictsController(common)
+	blameController := controllers.NewBlameController(common)
+	fileHistoryController := controllers.NewFileHistoryController(common)
 	remotesController := controllers.NewRemotesController(
 		common,
 		func(branches []*models.RemoteBranch) { gui.State.Model.RemoteBranches = branches },
@@ -233,6 +248,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.CommitFiles,
 		gui.State.Contexts.SubCommits,
+		gui.State.Contexts.FileHistory,
 		gui.State.Contexts.Stash,
 	} {
 		controllers.AttachControllers(context, sideWindowControllerFactory.Create(context))
@@ -276,6 +292,37 @@ func (gui *Gui) resetHelpersAndControllers() {
 		))
 	}
 
+	controllers.AttachControllers(gui.State.Contexts.Branches, controllers.NewBookmarkController(
+		common, gui.State.Contexts.Branches,
+		func() *config.Bookmark {
+			branch := gui.State.Contexts.Branches.GetSelected()
+			if branch == nil {
+				return nil
+			}
+			return &config.Bookmark{Kind: config.BookmarkKindBranch, Id: branch.Name, Label: branch.Name}
+		},
+	))
+	controllers.AttachControllers(gui.State.Contexts.Files, controllers.NewBookmarkController(
+		common, gui.State.Contexts.Files,
+		func() *config.Bookmark {
+			file := gui.State.Contexts.Files.GetSelectedFile()
+			if file == nil {
+				return nil
+			}
+			return &config.Bookmark{Kind: config.BookmarkKindFile, Id: file.Path, Label: file.Path}
+		},
+	))
+	controllers.AttachControllers(gui.State.Contexts.LocalCommits, controllers.NewBookmarkController(
+		common, gui.State.Contexts.LocalCommits,
+		func() *config.Bookmark {
+			commit := gui.State.Contexts.LocalCommits.GetSelected()
+			if commit == nil || commit.Hash() == "" {
+				return nil
+			}
+			return &config.Bookmark{Kind: config.BookmarkKindCommit, Id: commit.Hash(), Label: commit.Name}
+		},
+	))
+
 	for _, context := range []controllers.ContainsCommits{
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.ReflogCommits,
@@ -319,6 +366,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.Blame,
+		blameController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.FileHistory,
+		fileHistoryController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Normal,
 		mainViewController,
 		verticalScrollControllerFactory.Create(gui.State.Contexts.Normal),
@@ -375,11 +430,13 @@ func (gui *Gui) resetHelpersAndControllers() {
 
 	controllers.AttachControllers(gui.State.Contexts.CommitMessage,
 		commitMessageController,
+		controllers.NewMiddleClickPasteController(common, gui.State.Contexts.CommitMessage, false),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.CommitDescription,
 		commitDescriptionController,
 		verticalScrollControllerFactory.Create(gui.State.Contexts.CommitDescription),
+		controllers.NewMiddleClickPasteController(common, gui.State.Contexts.CommitDescription, true),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.RemoteBranches,
@@ -400,6 +457,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 
 	controllers.AttachControllers(gui.State.Contexts.Prompt,
 		promptController,
+		controllers.NewMiddleClickPasteController(common, gui.State.Contexts.Prompt, false),
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.Suggestions,
@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Creates a tar or zip archive of a branch, tag or commit
+type ArchiveMenuAction struct {
+	c *ControllerCommon
+}
+
+// name is what we show to the user, and use for the suggested file name
+func (self *ArchiveMenuAction) Call(ref string, name string) error {
+	// e.g. "lazygit-feature-x" for the branch feature/x
+	baseName := self.c.Git().RepoPaths.RepoName() + "-" + strings.ReplaceAll(name, "/", "-")
+
+	menuItems := lo.Map(git_commands.ArchiveFormats, func(format string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{format, style.FgYellow.Sprintf("git archive --format=%s", format)},
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title:           self.c.Tr.ArchivePrefixPrompt,
+					InitialContent:  baseName + "/",
+					AllowEmptyInput: true,
+					HandleConfirm: func(prefix string) error {
+						self.c.Prompt(types.PromptOpts{
+							Title:          self.c.Tr.ArchiveOutputPathPrompt,
+							InitialContent: baseName + "." + format,
+							HandleConfirm: func(outputPath string) error {
+								return self.create(ref, format, prefix, outputPath)
+							},
+						})
+						return nil
+					},
+				})
+				return nil
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.CreateArchiveTitle, map[string]string{"name": name}),
+		Items: menuItems,
+	})
+}
+
+func (self *ArchiveMenuAction) create(ref string, format string, prefix string, outputPath string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.CreatingArchiveStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CreateArchive)
+		if err := self.c.Git().Archive.Create(ref, format, prefix, outputPath); err != nil {
+			return err
+		}
+
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.CreatedArchive, map[string]string{"path": outputPath}))
+		return nil
+	})
+}
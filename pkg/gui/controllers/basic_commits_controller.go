@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -73,6 +75,34 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenCommitInBrowser,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ToggleCommitDetails),
+			Handler:     self.toggleCommitDetails,
+			Description: self.c.Tr.ToggleCommitDetails,
+			Tooltip:     self.c.Tr.ToggleCommitDetailsTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.GoToParentCommit),
+			Handler:           self.withItem(self.goToParentCommit),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.GoToParentCommit,
+			Tooltip:           self.c.Tr.GoToParentCommitTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.VerifySignature),
+			Handler:           self.withItem(self.verifySignature),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.VerifySignature,
+			Tooltip:           self.c.Tr.VerifySignatureTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.CreateArchive),
+			Handler:           self.withItem(self.createArchive),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CreateArchive,
+			Tooltip:           self.c.Tr.CreateArchiveTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.New),
 			Handler:           self.withItem(self.newBranch),
@@ -102,7 +132,7 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		{
 			Key:               opts.GetKey(opts.Config.Commits.CherryPickCopy),
 			Handler:           self.withItem(self.copyRange),
-			GetDisabledReason: self.require(self.itemRangeSelected(self.canCopyCommits)),
+			GetDisabledReason: self.require(self.itemsSelected(self.canCopyCommits)),
 			Description:       self.c.Tr.CherryPickCopy,
 			Tooltip: utils.ResolvePlaceholderString(self.c.Tr.CherryPickCopyTooltip,
 				map[string]string{
@@ -123,6 +153,13 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.OpenFailingCheckInBrowser),
+			Handler:           self.withItem(self.openFailingCheckInBrowser),
+			GetDisabledReason: self.require(self.singleItemSelected(self.hasFailingCheck)),
+			Description:       self.c.Tr.OpenFailingCheckInBrowser,
+			Tooltip:           self.c.Tr.OpenFailingCheckInBrowserTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.SelectCommitsOfCurrentBranch),
 			Handler:           self.selectCommitsOfCurrentBranch,
@@ -134,6 +171,66 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 	return bindings
 }
 
+func (self *BasicCommitsController) hasFailingCheck(commit *models.Commit) *types.DisabledReason {
+	return failingCheckDisabledReason(self.c, commit.Hash())
+}
+
+func (self *BasicCommitsController) openFailingCheckInBrowser(commit *models.Commit) error {
+	return openFailingCheckInBrowser(self.c, commit.Hash())
+}
+
+func (self *BasicCommitsController) verifySignature(commit *models.Commit) error {
+	showSignatureVerification(self.c, func() (string, error) {
+		return self.c.Git().Commit.VerifySignature(commit.Hash())
+	})
+	return nil
+}
+
+// Shows the result of verifying the signature of a commit or tag in a popup.
+// Shared with the tags controller.
+func showSignatureVerification(c *ControllerCommon, verify func() (string, error)) {
+	output, err := verify()
+	if err != nil {
+		// git doesn't say anything if a commit isn't signed
+		message := lo.Ternary(output == "", c.Tr.NoSignatureFound, strings.TrimSpace(output))
+		c.Alert(c.Tr.BadSignatureTitle, style.FgRed.Sprint(message))
+		return
+	}
+
+	c.Alert(c.Tr.GoodSignatureTitle, strings.TrimSpace(output))
+}
+
+// Returns the first failed CI check of the given commit. This and the
+// functions below are shared with the branches controller, which uses the
+// commit at the head of the selected branch.
+func failingCheck(c *ControllerCommon, hash string) *models.GithubCheck {
+	status, ok := c.Model().CommitStatuses[hash]
+	if !ok {
+		return nil
+	}
+	return status.FailingCheck()
+}
+
+func failingCheckDisabledReason(c *ControllerCommon, hash string) *types.DisabledReason {
+	if failingCheck(c, hash) == nil {
+		return &types.DisabledReason{Text: c.Tr.NoFailingCheckForCommit}
+	}
+
+	return nil
+}
+
+func openFailingCheckInBrowser(c *ControllerCommon, hash string) error {
+	check := failingCheck(c, hash)
+	if check == nil {
+		// The statuses might have been refreshed in the background since we
+		// checked the disabled reason
+		return errors.New(c.Tr.NoFailingCheckForCommit)
+	}
+
+	c.LogAction(c.Tr.Actions.OpenFailingCheck)
+	return c.OS().OpenLink(check.Url)
+}
+
 func (self *BasicCommitsController) getCommitMessageBody(hash string) string {
 	commitMessageBody, err := self.c.Git().Commit.GetCommitMessage(hash)
 	if err != nil {
@@ -347,10 +444,64 @@ func (self *BasicCommitsController) openInBrowser(commit *models.Commit) error {
 	return nil
 }
 
+func (self *BasicCommitsController) toggleCommitDetails() error {
+	self.c.State().SetShowCommitDetails(!self.c.State().GetShowCommitDetails())
+	self.context.HandleRenderToMain()
+	return nil
+}
+
+func (self *BasicCommitsController) goToParentCommit(commit *models.Commit) error {
+	parents := commit.Parents()
+	if len(parents) == 0 {
+		return errors.New(self.c.Tr.CommitHasNoParents)
+	}
+
+	if len(parents) == 1 {
+		return self.selectCommitByHash(parents[0])
+	}
+
+	menuItems := lo.Map(parents, func(parent string, _ int) *types.MenuItem {
+		label := utils.ShortHash(parent)
+		if parentCommit, ok := lo.Find(self.context.GetCommits(), func(c *models.Commit) bool {
+			return c.Hash() == parent
+		}); ok {
+			label += " " + parentCommit.Name
+		}
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				return self.selectCommitByHash(parent)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SelectParentCommit,
+		Items: menuItems,
+	})
+}
+
+func (self *BasicCommitsController) selectCommitByHash(hash string) error {
+	_, idx, found := lo.FindIndexOf(self.context.GetCommits(), func(c *models.Commit) bool {
+		return c.Hash() == hash
+	})
+	if !found {
+		return errors.New(self.c.Tr.ParentCommitNotInList)
+	}
+
+	self.context.GetList().SetSelection(idx)
+	self.context.HandleFocus(types.OnFocusOpts{ScrollSelectionIntoView: true})
+	return nil
+}
+
 func (self *BasicCommitsController) newBranch(commit *models.Commit) error {
 	return self.c.Helpers().Refs.NewBranch(commit.RefName(), commit.Description(), "")
 }
 
+func (self *BasicCommitsController) createArchive(commit *models.Commit) error {
+	return (&ArchiveMenuAction{c: self.c}).Call(commit.Hash(), commit.ShortHash())
+}
+
 func (self *BasicCommitsController) createResetMenu(commit *models.Commit) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(commit.Hash(), commit.Hash())
 }
@@ -363,7 +514,7 @@ func (self *BasicCommitsController) copyRange(*models.Commit) error {
 	return self.c.Helpers().CherryPick.CopyRange(self.context.GetCommits(), self.context)
 }
 
-func (self *BasicCommitsController) canCopyCommits(selectedCommits []*models.Commit, startIdx int, endIdx int) *types.DisabledReason {
+func (self *BasicCommitsController) canCopyCommits(selectedCommits []*models.Commit) *types.DisabledReason {
 	for _, commit := range selectedCommits {
 		if commit.Hash() == "" {
 			return &types.DisabledReason{Text: self.c.Tr.CannotCherryPickNonCommit, ShowErrorInPanel: true}
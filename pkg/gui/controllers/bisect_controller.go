@@ -1,12 +1,14 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -145,6 +147,15 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			Key:            'S',
 		}))
 	}
+	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
+		Label: self.c.Tr.Bisect.ViewLog,
+		OnPress: func() error {
+			return self.openLogMenu(info)
+		},
+		Key:       'l',
+		OpensMenu: true,
+		Tooltip:   self.c.Tr.Bisect.ViewLogTooltip,
+	}))
 	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
 		Label: self.c.Tr.Bisect.ResetOption,
 		OnPress: func() error {
@@ -159,6 +170,48 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 	})
 }
 
+func (self *BisectController) openLogMenu(info *git_commands.BisectInfo) error {
+	entries, err := self.c.Git().Bisect.GetLog()
+	if err != nil {
+		return err
+	}
+
+	menuItems := lo.Map(entries, func(entry *git_commands.BisectLogEntry, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				bisectTermStyle(entry.Term, info).Sprint(entry.Term),
+				style.FgYellow.Sprint(utils.ShortHash(entry.Hash)),
+				entry.Subject,
+			},
+			OnPress: func() error {
+				if !self.context().SelectCommitByHash(entry.Hash) {
+					return errors.New(fmt.Sprintf(self.c.Tr.Bisect.LogCommitNotInList, utils.ShortHash(entry.Hash)))
+				}
+
+				self.context().HandleFocus(types.OnFocusOpts{})
+				return nil
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.Bisect.LogTitle,
+		Items: menuItems,
+	})
+}
+
+// Uses the same colors as the bisect markers in the commits panel
+func bisectTermStyle(term string, info *git_commands.BisectInfo) style.TextStyle {
+	switch term {
+	case info.NewTerm():
+		return style.FgRed
+	case info.OldTerm():
+		return style.FgGreen
+	default:
+		return style.FgYellow
+	}
+}
+
 func (self *BisectController) openStartBisectMenu(info *git_commands.BisectInfo, commit *models.Commit) error {
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Bisect.BisectMenuTitle,
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type BlameController struct {
+	baseController
+	*ListControllerTrait[*models.BlameLine]
+	c *ControllerCommon
+}
+
+var _ types.IController = &BlameController{}
+
+func NewBlameController(
+	c *ControllerCommon,
+) *BlameController {
+	return &BlameController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().Blame,
+			c.Contexts().Blame.GetSelected,
+			c.Contexts().Blame.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *BlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.goToCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isCommitted)),
+			Description:       self.c.Tr.GoToBlamedCommit,
+			Tooltip:           self.c.Tr.GoToBlamedCommitTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Edit),
+			Handler:           self.withItem(self.edit),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.EditFile,
+			Tooltip:           self.c.Tr.EditFileTooltip,
+		},
+		{
+			Key:             opts.GetKey(opts.Config.Universal.Return),
+			Handler:         self.escape,
+			Description:     self.c.Tr.ExitBlame,
+			DisplayOnScreen: true,
+		},
+	}
+}
+
+func (self *BlameController) Context() types.Context {
+	return self.context()
+}
+
+func (self *BlameController) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
+
+func (self *BlameController) GetOnDoubleClick() func() error {
+	return self.withItemGraceful(self.goToCommit)
+}
+
+func (self *BlameController) isCommitted(blameLine *models.BlameLine) *types.DisabledReason {
+	if blameLine.IsUncommitted() {
+		return &types.DisabledReason{Text: self.c.Tr.BlameLineNotCommittedYet}
+	}
+
+	return nil
+}
+
+func (self *BlameController) goToCommit(blameLine *models.BlameLine) error {
+	if blameLine.IsUncommitted() {
+		return nil
+	}
+
+	return goToCommitLoadingMoreIfNeeded(self.c, blameLine.Hash, self.c.Tr.BlamedCommitNotFound)
+}
+
+func (self *BlameController) edit(blameLine *models.BlameLine) error {
+	return self.c.Helpers().Files.EditFileAtLine(self.context().GetPath(), blameLine.LineNumber)
+}
+
+func (self *BlameController) escape() error {
+	self.c.Context().Pop()
+	return nil
+}
+
+// Shows the blame view for the given file as it is in the working tree
+func openBlame(c *ControllerCommon, path string) error {
+	return c.WithWaitingStatus(c.Tr.LoadingBlame, func(gocui.Task) error {
+		blameLines, err := c.Git().Blame.BlameFile(path)
+		if err != nil {
+			return err
+		}
+
+		c.OnUIThread(func() error {
+			blameContext := c.Contexts().Blame
+			blameContext.SetBlame(path, blameLines)
+			blameContext.SetSelection(0)
+			c.ResetViewOrigin(blameContext.GetView())
+			blameContext.HandleRender()
+			c.Context().Push(blameContext, types.OnFocusOpts{})
+			return nil
+		})
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Lets the user bookmark the selected item of the branches, files, and commits
+// panels. The bookmarks are shown in the bookmarks menu; see BookmarksMenuAction.
+type BookmarkController struct {
+	baseController
+	c *ControllerCommon
+
+	context types.IListContext
+	// Returns nil if there is no item selected
+	getSelectedBookmark func() *config.Bookmark
+}
+
+var _ types.IController = &BookmarkController{}
+
+func NewBookmarkController(
+	c *ControllerCommon,
+	context types.IListContext,
+	getSelectedBookmark func() *config.Bookmark,
+) *BookmarkController {
+	return &BookmarkController{
+		baseController:      baseController{},
+		c:                   c,
+		context:             context,
+		getSelectedBookmark: getSelectedBookmark,
+	}
+}
+
+func (self *BookmarkController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.ToggleBookmark),
+			Handler:           self.toggleBookmark,
+			GetDisabledReason: self.canToggleBookmark,
+			Description:       self.c.Tr.ToggleBookmark,
+			Tooltip:           self.c.Tr.ToggleBookmarkTooltip,
+		},
+	}
+}
+
+func (self *BookmarkController) Context() types.Context {
+	return self.context
+}
+
+func (self *BookmarkController) canToggleBookmark() *types.DisabledReason {
+	if self.context.GetList().AreMultipleItemsSelected() {
+		return &types.DisabledReason{Text: self.c.Tr.RangeSelectNotSupported}
+	}
+
+	if self.getSelectedBookmark() == nil {
+		return &types.DisabledReason{Text: self.c.Tr.NoItemSelected}
+	}
+
+	return nil
+}
+
+func (self *BookmarkController) toggleBookmark() error {
+	if self.c.Helpers().Bookmarks.Toggle(*self.getSelectedBookmark()) {
+		self.c.Toast(self.c.Tr.BookmarkAdded)
+	} else {
+		self.c.Toast(self.c.Tr.BookmarkRemoved)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Shows the bookmarks of the current repo, and jumps to the selected one
+type BookmarksMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *BookmarksMenuAction) Call() error {
+	bookmarks := self.c.Helpers().Bookmarks.Bookmarks()
+
+	menuItems := lo.Map(bookmarks, func(bookmark config.Bookmark, _ int) *types.MenuItem {
+		return self.bookmarkMenuItem(bookmark)
+	})
+
+	if len(menuItems) == 0 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          self.c.Tr.NoBookmarks,
+			OnPress:        func() error { return nil },
+			DisabledReason: &types.DisabledReason{Text: self.c.Tr.NoBookmarks},
+		})
+	} else {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.ClearBookmarks,
+			OnPress: func() error {
+				self.c.Helpers().Bookmarks.Clear()
+				return nil
+			},
+			Key: 'c',
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.Bookmarks,
+		Items: menuItems,
+	})
+}
+
+func (self *BookmarksMenuAction) bookmarkMenuItem(bookmark config.Bookmark) *types.MenuItem {
+	model := self.c.Model()
+
+	var kind string
+	var found bool
+	var onPress func() error
+	extraColumn := ""
+	switch bookmark.Kind {
+	case config.BookmarkKindBranch:
+		kind = style.FgGreen.Sprint(self.c.Tr.GoToAnythingBranch)
+		found = lo.ContainsBy(model.Branches, func(branch *models.Branch) bool { return branch.Name == bookmark.Id })
+		onPress = func() error { return goToBranch(self.c, bookmark.Id) }
+	case config.BookmarkKindFile:
+		kind = style.FgCyan.Sprint(self.c.Tr.GoToAnythingFile)
+		found = lo.ContainsBy(model.Files, func(file *models.File) bool { return file.Path == bookmark.Id })
+		onPress = func() error { return goToFile(self.c, bookmark.Id) }
+	case config.BookmarkKindCommit:
+		kind = style.FgYellow.Sprint(self.c.Tr.GoToAnythingCommit)
+		found = lo.ContainsBy(model.Commits, func(commit *models.Commit) bool { return commit.Hash() == bookmark.Id })
+		onPress = func() error { return goToCommit(self.c, bookmark.Id) }
+		extraColumn = style.FgBlue.Sprint(utils.ShortHash(bookmark.Id))
+	}
+
+	var disabledReason *types.DisabledReason
+	if !found {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.BookmarkNotFound}
+	}
+
+	return &types.MenuItem{
+		LabelColumns:   []string{kind, bookmark.Label, extraColumn},
+		OnPress:        onPress,
+		DisabledReason: disabledReason,
+	}
+}
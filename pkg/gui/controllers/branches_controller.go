@@ -3,6 +3,8 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/gookit/color"
@@ -88,6 +90,13 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			GetDisabledReason: self.require(self.singleItemSelected(self.branchHasPR)),
 			Description:       self.c.Tr.OpenPullRequestInBrowser,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.OpenFailingCheckInBrowser),
+			Handler:           self.withItem(self.openFailingCheckInBrowser),
+			GetDisabledReason: self.require(self.singleItemSelected(self.hasFailingCheck)),
+			Description:       self.c.Tr.OpenFailingCheckInBrowser,
+			Tooltip:           self.c.Tr.OpenFailingCheckInBrowserTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.CopyPullRequestURL),
 			Handler:           self.copyPullRequestURL,
@@ -115,7 +124,7 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItems(self.delete),
-			GetDisabledReason: self.require(self.itemRangeSelected(self.branchesAreReal)),
+			GetDisabledReason: self.require(self.itemsSelected(self.branchesAreReal)),
 			Description:       self.c.Tr.Delete,
 			Tooltip:           self.c.Tr.BranchDeleteTooltip,
 			OpensMenu:         true,
@@ -166,6 +175,14 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.CreateArchive),
+			Handler:           self.withItem(self.createArchive),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CreateArchive,
+			Tooltip:           self.c.Tr.CreateArchiveTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.RenameBranch),
 			Handler:           self.withItem(self.rename),
@@ -209,11 +226,16 @@ func (self *BranchesController) GetOnRenderToMain() func() {
 				pr, ok := self.c.Model().PullRequestsMap[branch.Name]
 				if ok && presentation.ShouldShowPrForBranch(pr, branch.Name, self.c.UserConfig()) {
 					icon := lo.Ternary(icons.IsIconEnabled(), icons.IconForRemoteUrl(pr.Url)+"  ", "")
-					ptyTask.Prefix = style.PrintHyperlink(fmt.Sprintf("%s%s  %s  %s\n",
+					reviewText := ""
+					if pr.IsOpen() && pr.ReviewDecision != "" {
+						reviewText = "  " + presentation.PullRequestReviewText(pr.ReviewDecision, self.c.Tr)
+					}
+					ptyTask.Prefix = style.PrintHyperlink(fmt.Sprintf("%s%s  %s  %s%s\n",
 						icon,
 						coloredStateText(pr.State),
 						pr.Title,
-						style.FgCyan.Sprintf("#%d", pr.Number)),
+						style.FgCyan.Sprintf("#%d", pr.Number),
+						reviewText),
 						pr.Url)
 					ptyTask.Prefix += strings.Repeat("─", self.c.Contexts().Normal.GetView().InnerWidth()) + "\n"
 				}
@@ -475,7 +497,7 @@ func (self *BranchesController) promptToCheckoutWorktree(worktree *models.Worktr
 		"worktreeName": worktree.Name,
 	})
 
-	return self.c.ConfirmIf(!self.c.UserConfig().Gui.SkipSwitchWorktreeOnCheckoutWarning, types.ConfirmOpts{
+	return self.c.ConfirmIf(self.c.UserConfig().Confirmations.SwitchWorktreeOnCheckout, types.ConfirmOpts{
 		Title:  self.c.Tr.SwitchToWorktree,
 		Prompt: prompt,
 		HandleConfirm: func() error {
@@ -553,12 +575,19 @@ func (self *BranchesController) checkoutPreviousBranch() error {
 	return self.c.Helpers().Refs.CheckoutPreviousRef()
 }
 
+// Entering e.g. '#123' in the checkout-by-name prompt checks out pull request 123
+var pullRequestNumberRegexp = regexp.MustCompile(`^#(\d+)$`)
+
 func (self *BranchesController) checkoutByName() error {
 	self.c.Prompt(types.PromptOpts{
 		Title:               self.c.Tr.BranchName + ":",
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
 		HandleConfirm: func(response string) error {
 			self.c.LogAction("Checkout branch")
+			if match := pullRequestNumberRegexp.FindStringSubmatch(response); match != nil {
+				number, _ := strconv.Atoi(match[1])
+				return self.c.Helpers().Refs.CheckoutPullRequest(number)
+			}
 			_, branchName, found := self.c.Helpers().Refs.ParseRemoteBranchName(response)
 			if found {
 				return self.c.Helpers().Refs.CheckoutRemoteBranch(response, branchName)
@@ -758,6 +787,10 @@ func (self *BranchesController) createSortMenu() error {
 		self.c.UserConfig().Git.LocalBranchSortOrder)
 }
 
+func (self *BranchesController) createArchive(branch *models.Branch) error {
+	return (&ArchiveMenuAction{c: self.c}).Call(branch.FullRefName(), branch.Name)
+}
+
 func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.Name, selectedBranch.FullRefName())
 }
@@ -936,7 +969,15 @@ func (self *BranchesController) openPRInBrowser(branch *models.Branch) error {
 	return self.c.OS().OpenLink(pr.Url)
 }
 
-func (self *BranchesController) branchesAreReal(selectedBranches []*models.Branch, startIdx int, endIdx int) *types.DisabledReason {
+func (self *BranchesController) hasFailingCheck(branch *models.Branch) *types.DisabledReason {
+	return failingCheckDisabledReason(self.c, branch.CommitHash)
+}
+
+func (self *BranchesController) openFailingCheckInBrowser(branch *models.Branch) error {
+	return openFailingCheckInBrowser(self.c, branch.CommitHash)
+}
+
+func (self *BranchesController) branchesAreReal(selectedBranches []*models.Branch) *types.DisabledReason {
 	if !lo.EveryBy(selectedBranches, func(branch *models.Branch) bool {
 		return branch.IsRealBranch()
 	}) {
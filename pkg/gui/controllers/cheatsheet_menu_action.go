@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Shows the same content as the generated keybindings cheatsheet, but for the
+// user's current config, so it includes changed keys and custom commands.
+type CheatsheetMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *CheatsheetMenuAction) Call() error {
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.ExportEffectiveConfig,
+			Tooltip: self.c.Tr.ExportEffectiveConfigTooltip,
+			OnPress: self.exportConfig,
+		},
+	}
+
+	bindings, _ := self.c.GetInitialKeybindingsWithCustomCommands()
+	for _, section := range keybindings.GetBindingSections(bindings, self.c.Tr) {
+		menuSection := &types.MenuSection{Title: section.Title, Column: 1}
+		for _, binding := range section.Bindings {
+			key := keybindings.LabelFromKey(binding.Key)
+			if binding.Alternative != "" {
+				key += " (" + binding.Alternative + ")"
+			}
+
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: []string{key, binding.Description},
+				Tooltip:      binding.Tooltip,
+				// This is only for looking things up, so selecting an item
+				// just closes the menu
+				OnPress: func() error { return nil },
+				Section: menuSection,
+			})
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:           self.c.Tr.Cheatsheet,
+		Items:           menuItems,
+		HideCancel:      true,
+		ColumnAlignment: []utils.Alignment{utils.AlignRight, utils.AlignLeft},
+	})
+}
+
+func (self *CheatsheetMenuAction) exportConfig() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.ExportEffectiveConfigPrompt,
+		InitialContent: filepath.Join(self.c.GetConfig().GetUserConfigDir(), "effective-config.yml"),
+		HandleConfirm: func(path string) error {
+			content, err := config.ExportUserConfig(self.c.UserConfig(), self.c.GetConfig().GetVersion())
+			if err != nil {
+				return err
+			}
+
+			if err := self.c.OS().CreateFileWithContent(path, string(content)); err != nil {
+				return err
+			}
+
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.EffectiveConfigExported, map[string]string{"path": path}))
+			return nil
+		},
+	})
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/imagepreview"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -106,6 +107,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 				map[string]string{"doc": constants.Links.Docs.CustomPatchDemo},
 			),
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenFileHistory),
+			Handler:           self.withItem(self.openFileHistory),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canOpenFileHistory)),
+			Description:       self.c.Tr.OpenFileHistory,
+			Tooltip:           self.c.Tr.OpenFileHistoryTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.GoInto),
 			Handler:           self.withItem(self.enter),
@@ -176,9 +184,14 @@ func (self *CommitFilesController) GetOnRenderToMain() func() {
 		from, to := self.context().GetFromAndToForDiff()
 		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(from)
 
-		paths := self.pathsForDiff(node)
-		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, paths, false)
-		task := types.NewRunPtyTask(cmdObj.GetCmd())
+		var task types.UpdateTask
+		if node.File != nil && imagepreview.IsImagePath(node.File.Path) {
+			task = self.c.Helpers().ImagePreview.RefsTask(from, to, reverse, node.File.Path)
+		} else {
+			paths := self.pathsForDiff(node)
+			cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, paths, false)
+			task = types.NewRunPtyTask(cmdObj.GetCmd()).AsDiff()
+		}
 
 		self.c.RenderToMainViews(types.RefreshMainOpts{
 			Pair: self.c.MainViewPairs().Normal,
@@ -329,6 +342,19 @@ func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error
 	return nil
 }
 
+func (self *CommitFilesController) openFileHistory(node *filetree.CommitFileNode) error {
+	_, to := self.context().GetFromAndToForDiff()
+	return openFileHistory(self.c, self.context(), to, node.GetPath())
+}
+
+func (self *CommitFilesController) canOpenFileHistory(node *filetree.CommitFileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.FileHistoryOnlyForFiles}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) discard(selectedNodes []*filetree.CommitFileNode) error {
 	prompt := lo.Ternary(self.c.Git().Patch.PatchBuilder.Active(),
 		self.c.Tr.DiscardFileChangesPromptResetPatch,
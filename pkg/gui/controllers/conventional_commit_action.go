@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Asks for the type, scope and subject of a conventional commit
+// (https://www.conventionalcommits.org), and opens the commit message panel
+// with the header composed from them, so that the user can still add a
+// description before committing
+type ConventionalCommitAction struct {
+	c *ControllerCommon
+}
+
+func (self *ConventionalCommitAction) Call() error {
+	commitTypes := self.c.UserConfig().Git.Commit.ConventionalCommits.Types
+	if len(commitTypes) == 0 {
+		return errors.New(self.c.Tr.NoConventionalCommitTypes)
+	}
+
+	return self.c.Helpers().WorkingTree.WithEnsureCommittableFiles(func() error {
+		menuItems := lo.Map(commitTypes, func(commitType config.ConventionalCommitType, _ int) *types.MenuItem {
+			return &types.MenuItem{
+				LabelColumns: []string{commitType.Name, style.FgBlue.Sprint(commitType.Description)},
+				OnPress: func() error {
+					self.promptForScope(commitType.Name)
+					return nil
+				},
+			}
+		})
+
+		return self.c.Menu(types.CreateMenuOptions{
+			Title: self.c.Tr.ConventionalCommitType,
+			Items: menuItems,
+		})
+	})
+}
+
+func (self *ConventionalCommitAction) promptForScope(commitType string) {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ConventionalCommitScope,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetConventionalCommitScopesSuggestionsFunc(),
+		AllowEmptyInput:     true,
+		HandleConfirm: func(scope string) error {
+			self.promptForSubject(commitType, scope)
+			return nil
+		},
+	})
+}
+
+func (self *ConventionalCommitAction) promptForSubject(commitType string, scope string) {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ConventionalCommitSubject,
+		HandleConfirm: func(subject string) error {
+			return self.c.Helpers().WorkingTree.HandleCommitPressWithMessage(
+				conventionalCommitHeader(commitType, scope, subject), false)
+		},
+	})
+}
+
+// E.g. "feat(ui): add a button", or "feat: add a button" without a scope
+func conventionalCommitHeader(commitType string, scope string, subject string) string {
+	if scope != "" {
+		commitType += "(" + scope + ")"
+	}
+	return commitType + ": " + subject
+}
@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type FileHistoryController struct {
+	baseController
+	*ListControllerTrait[*models.Commit]
+	c *ControllerCommon
+}
+
+var _ types.IController = &FileHistoryController{}
+
+func NewFileHistoryController(
+	c *ControllerCommon,
+) *FileHistoryController {
+	return &FileHistoryController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().FileHistory,
+			c.Contexts().FileHistory.GetSelected,
+			c.Contexts().FileHistory.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *FileHistoryController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.CheckoutCommitFile),
+			Handler:           self.withItem(self.checkout),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.Checkout,
+			Tooltip:           self.c.Tr.CheckoutFileVersionTooltip,
+			DisplayOnScreen:   true,
+		},
+	}
+}
+
+func (self *FileHistoryController) Context() types.Context {
+	return self.context()
+}
+
+func (self *FileHistoryController) context() *context.FileHistoryContext {
+	return self.c.Contexts().FileHistory
+}
+
+func (self *FileHistoryController) GetOnRenderToMain() func() {
+	return func() {
+		commits, startIdx, endIdx := self.context().GetSelectedItems()
+		var task types.UpdateTask
+		if len(commits) == 0 {
+			task = types.NewRenderStringTask("No commits")
+		} else if startIdx != endIdx {
+			// We diff the whole range rather than showing the individual
+			// commits, so that you can see how the file changed between two
+			// of its versions
+			from, to := commits[len(commits)-1], commits[0]
+			args := []string{from.ParentRefName(), to.RefName(), "--stat", "-p", "--"}
+			args = append(args, self.context().PathsForDiff(from, to)...)
+			cmdObj := self.c.Git().Diff.DiffCmdObj(args)
+			prefix := style.FgYellow.Sprintf("%s %s-%s\n\n", self.c.Tr.ShowingDiffForRange, from.ShortRefName(), to.ShortRefName())
+			task = types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix).AsDiff()
+		} else {
+			commit := commits[0]
+			cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.context().PathsForDiff(commit), git_commands.ContentFilter{})
+			task = types.NewRunPtyTask(cmdObj.GetCmd()).AsDiff()
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title:    "Commit",
+				SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+				Task:     task,
+			},
+		})
+	}
+}
+
+// Restores the file as it was in the given commit, under its current path
+func (self *FileHistoryController) checkout(commit *models.Commit) error {
+	path := self.context().GetPath()
+	if helpers.AnyTrackedFilesInPathExceptSubmodules(path, self.c.Model().Files, self.c.Model().Submodules) {
+		return errors.New(self.c.Tr.CannotCheckoutWithModifiedFilesErr)
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.CheckoutFile)
+	if err := self.c.Git().WorkingTree.CheckoutFileFromPath(commit.Hash(), self.context().PathInCommit(commit), path); err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return nil
+}
+
+// Shows the history of the given file, starting at refName, in place of the
+// given context. Escape returns to that context.
+func openFileHistory(c *ControllerCommon, parentContext types.Context, refName string, path string) error {
+	return c.WithWaitingStatus(c.Tr.LoadingFileHistory, func(gocui.Task) error {
+		commits, err := c.Git().Loaders.CommitLoader.GetFileHistory(refName, path, c.Model().HashPool)
+		if err != nil {
+			return err
+		}
+
+		c.OnUIThread(func() error {
+			fileHistoryContext := c.Contexts().FileHistory
+			fileHistoryContext.SetFileHistory(path, commits)
+			fileHistoryContext.SetSelection(0)
+			fileHistoryContext.SetParentContext(parentContext)
+			fileHistoryContext.SetWindowName(parentContext.GetWindowName())
+			fileHistoryContext.GetView().TitlePrefix = parentContext.GetView().TitlePrefix
+			c.ResetViewOrigin(fileHistoryContext.GetView())
+			c.PostRefreshUpdate(fileHistoryContext)
+			c.Context().Push(fileHistoryContext, types.OnFocusOpts{})
+			return nil
+		})
+		return nil
+	})
+}
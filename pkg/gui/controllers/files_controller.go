@@ -1,8 +1,10 @@
 package controllers
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -10,8 +12,11 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/imagepreview"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -54,6 +59,20 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.handleStatusFilterPressed,
 			Description: self.c.Tr.FileFilter,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.OpenLfsLocksMenu),
+			Handler:     (&LfsLocksMenuAction{c: self.c}).Call,
+			Description: self.c.Tr.LfsLocks,
+			Tooltip:     self.c.Tr.LfsLocksTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.OpenSparseCheckoutMenu),
+			Handler:     (&SparseCheckoutMenuAction{c: self.c}).Call,
+			Description: self.c.Tr.SparseCheckout,
+			Tooltip:     self.c.Tr.SparseCheckoutTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.CopyFileInfoToClipboard),
 			Handler:     self.openCopyMenu,
@@ -82,12 +101,31 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitEditorPress,
 			Description: self.c.Tr.CommitChangesWithEditor,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ConventionalCommit),
+			Handler:     self.handleConventionalCommitPress,
+			Description: self.c.Tr.ConventionalCommit,
+			Tooltip:     self.c.Tr.ConventionalCommitTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.FindBaseCommitForFixup),
 			Handler:     self.c.Helpers().FixupHelper.HandleFindBaseCommitForFixupPress,
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.AbsorbStagedChanges),
+			Handler:           opts.Guards.OutsideFilterMode(self.c.Helpers().FixupHelper.HandleAbsorbStagedChangesPress),
+			GetDisabledReason: self.require(self.anyStagedFiles),
+			Description:       self.c.Tr.AbsorbStagedChanges,
+			Tooltip: utils.ResolvePlaceholderString(
+				self.c.Tr.AbsorbStagedChangesTooltip,
+				map[string]string{
+					"squashAbove": keybindings.Label(opts.Config.Commits.SquashAboveCommits),
+				},
+			),
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Edit),
 			Handler:           self.withItems(self.edit),
@@ -110,6 +148,20 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description:       self.c.Tr.Actions.IgnoreExcludeFile,
 			OpensMenu:         true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.Blame),
+			Handler:           self.withItem(self.blame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.Blame,
+			Tooltip:           self.c.Tr.BlameTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenFileHistory),
+			Handler:           self.withItem(self.openFileHistory),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canOpenFileHistory)),
+			Description:       self.c.Tr.OpenFileHistory,
+			Tooltip:           self.c.Tr.OpenFileHistoryTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.RefreshFiles),
 			Handler:     self.refresh,
@@ -206,6 +258,24 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Tooltip:           self.c.Tr.ExpandAllTooltip,
 			GetDisabledReason: self.require(self.isInTreeMode),
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ToggleSkipUntrackedFiles),
+			Handler:     self.toggleSkipUntrackedFiles,
+			Description: self.c.Tr.ToggleSkipUntrackedFiles,
+			Tooltip:     self.c.Tr.ToggleSkipUntrackedFilesTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ApplyPatchFile),
+			Handler:     self.applyPatchFile,
+			Description: self.c.Tr.ApplyPatchFile,
+			Tooltip:     self.c.Tr.ApplyPatchFileTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ToggleCommitSigning),
+			Handler:     self.c.Helpers().WorkingTree.ToggleNextCommitSigning,
+			Description: self.c.Tr.ToggleCommitSigning,
+			Tooltip:     self.c.Tr.ToggleCommitSigningTooltip,
+		},
 	}
 }
 
@@ -315,10 +385,22 @@ func (self *FilesController) GetOnRenderToMain() func() {
 
 			self.c.Helpers().MergeConflicts.ResetMergeState()
 
+			if node.File != nil && imagepreview.IsImagePath(node.File.Path) {
+				self.c.RenderToMainViews(types.RefreshMainOpts{
+					Pair: self.c.MainViewPairs().Normal,
+					Main: &types.ViewUpdateOpts{
+						Title: self.c.Tr.DiffTitle,
+						Task:  self.c.Helpers().ImagePreview.WorkingTreeTask(node.File.Path, node.File.PreviousPath),
+					},
+				})
+				return
+			}
+
 			split := self.c.UserConfig().Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
 			pathOverrides := self.pathOverridesForDiff(node)
+			cacheKey := self.diffCacheKey(node)
 			cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged, pathOverrides)
 			title := self.c.Tr.UnstagedChanges
 			if mainShowsStaged {
@@ -327,7 +409,7 @@ func (self *FilesController) GetOnRenderToMain() func() {
 			refreshOpts := types.RefreshMainOpts{
 				Pair: self.c.MainViewPairs().Normal,
 				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     types.NewCachedRunPtyTask(cmdObj.GetCmd(), cacheKey).AsDiff(),
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Title:    title,
 				},
@@ -344,7 +426,7 @@ func (self *FilesController) GetOnRenderToMain() func() {
 				refreshOpts.Secondary = &types.ViewUpdateOpts{
 					Title:    title,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     types.NewCachedRunPtyTask(cmdObj.GetCmd(), cacheKey).AsDiff(),
 				}
 			}
 
@@ -355,6 +437,15 @@ func (self *FilesController) GetOnRenderToMain() func() {
 
 func (self *FilesController) GetOnDoubleClick() func() error {
 	return self.withItemGraceful(func(node *filetree.FileNode) error {
+		if node.IsFile() {
+			switch self.c.UserConfig().Gui.Mouse.DoubleClickFileAction {
+			case "edit":
+				return self.edit([]*filetree.FileNode{node})
+			case "open":
+				return self.c.Helpers().Files.OpenFile(node.GetPath())
+			}
+		}
+
 		return self.press([]*filetree.FileNode{node})
 	})
 }
@@ -537,16 +628,110 @@ func (self *FilesController) pressWithLock(selectedNodes []*filetree.FileNode) e
 }
 
 func (self *FilesController) press(nodes []*filetree.FileNode) error {
-	if err := self.pressWithLock(nodes); err != nil {
-		return err
+	return self.confirmStagingLfsFiles(nodes, func() error {
+		if err := self.c.Helpers().OperationJournal.RecordIndexChange(self.c.Tr.JournalStageFiles, func() error {
+			return self.pressWithLock(nodes)
+		}); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+
+		self.context().HandleFocus(types.OnFocusOpts{})
+		return nil
+	})
+}
+
+// Binary files at least this big that match an lfs pattern get a warning when
+// staging them while lfs isn't set up
+const lfsWarningFileSize = 1024 * 1024
+
+// If git-lfs isn't set up for the repo, a file that matches an lfs pattern in
+// .gitattributes is stored in git like any other file, which is hard to undo
+// once it's pushed. So we ask for confirmation before staging a large binary
+// one.
+func (self *FilesController) confirmStagingLfsFiles(nodes []*filetree.FileNode, stage func() error) error {
+	path := ""
+	for _, node := range nodes {
+		_ = node.ForEachFile(func(file *models.File) error {
+			if path == "" && file.IsLfs && file.HasUnstagedChanges && !file.Deleted && isLargeBinaryFile(file.Path) {
+				path = file.Path
+			}
+			return nil
+		})
 	}
 
-	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+	if path == "" || self.c.Git().Config.IsLfsFilterConfigured() {
+		return stage()
+	}
 
-	self.context().HandleFocus(types.OnFocusOpts{})
+	self.c.Confirm(types.ConfirmOpts{
+		Title:         self.c.Tr.LfsNotSetUpTitle,
+		Prompt:        utils.ResolvePlaceholderString(self.c.Tr.LfsNotSetUpPrompt, map[string]string{"path": path}),
+		HandleConfirm: stage,
+	})
 	return nil
 }
 
+// Uses the same heuristic as git: a file is binary if it has a NUL byte in its
+// first 8000 bytes
+func isLargeBinaryFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() || info.Size() < lfsWarningFileSize {
+		return false
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	buffer := make([]byte, 8000)
+	n, _ := file.Read(buffer)
+	return bytes.IndexByte(buffer[:n], 0) >= 0
+}
+
+// Returns a key describing the state of HEAD, of the index and of the given
+// file in the working tree, so that we don't need to rerun git diff when moving
+// the cursor back to a file that hasn't changed. Returns an empty string
+// (meaning: don't cache) for directories and submodules, since their
+// modification time doesn't tell us whether their contents changed.
+func (self *FilesController) diffCacheKey(node *filetree.FileNode) string {
+	if node.File == nil {
+		return ""
+	}
+
+	head, ok := self.headCacheKey()
+	if !ok {
+		return ""
+	}
+
+	index, err := os.Stat(filepath.Join(self.c.Git().RepoPaths.WorktreeGitDirPath(), "index"))
+	if err != nil {
+		return ""
+	}
+
+	fileState := "deleted"
+	if file, err := os.Stat(node.File.Path); err == nil {
+		if file.IsDir() {
+			return ""
+		}
+		fileState = fmt.Sprintf("%d-%d", file.ModTime().UnixNano(), file.Size())
+	}
+
+	return fmt.Sprintf("head-%s-index-%d-%d-%s", head, index.ModTime().UnixNano(), index.Size(), fileState)
+}
+
+// Staged changes are shown relative to HEAD, which can move without the index
+// changing (e.g. with git reset --soft), so HEAD needs to be part of the cache
+// key. Spawning git to resolve it would defeat the purpose of the cache, so we
+// only cache if it can be read from the git dir.
+func (self *FilesController) headCacheKey() (string, bool) {
+	hash, err := self.c.Git().Branch.HeadCommitHashInProcess()
+	return hash, err == nil
+}
+
 // pathOverridesForDiff returns file paths to override the node's path in diff
 // commands when a text filter is active and the node is a directory. This
 // ensures the diff only shows filtered/visible files.
@@ -625,6 +810,31 @@ func (self *FilesController) expandAll() error {
 	return nil
 }
 
+func (self *FilesController) toggleSkipUntrackedFiles() error {
+	self.context().ToggleSkipUntrackedFiles()
+	self.context().UpdateSubtitle()
+
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+	return nil
+}
+
+func (self *FilesController) applyPatchFile() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ApplyPatchFilePrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFileSystemPathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.ApplyPatchFile)
+			err := self.c.Git().PatchSeries.ApplyPatchFile(path)
+			// refreshing even if it failed, so that any conflicts that the
+			// three-way merge left behind show up in the files panel
+			self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+			return err
+		},
+	})
+
+	return nil
+}
+
 func (self *FilesController) EnterFile(opts types.OnFocusOpts) error {
 	node := self.context().GetSelected()
 	if node == nil {
@@ -705,14 +915,17 @@ func (self *FilesController) handleNonInlineConflict(file *models.File) error {
 }
 
 func (self *FilesController) toggleStagedAll() error {
-	if err := self.toggleStagedAllWithLock(); err != nil {
-		return err
-	}
+	root := self.context().FileTreeViewModel.GetRoot()
+	return self.confirmStagingLfsFiles([]*filetree.FileNode{root}, func() error {
+		if err := self.c.Helpers().OperationJournal.RecordIndexChange(self.c.Tr.JournalStageFiles, self.toggleStagedAllWithLock); err != nil {
+			return err
+		}
 
-	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
 
-	self.context().HandleFocus(types.OnFocusOpts{})
-	return nil
+		self.context().HandleFocus(types.OnFocusOpts{})
+		return nil
+	})
 }
 
 func (self *FilesController) toggleStagedAllWithLock() error {
@@ -877,6 +1090,42 @@ func (self *FilesController) refresh() error {
 	return nil
 }
 
+func (self *FilesController) blame(node *filetree.FileNode) error {
+	return openBlame(self.c, node.GetPath())
+}
+
+func (self *FilesController) openFileHistory(node *filetree.FileNode) error {
+	return openFileHistory(self.c, self.context(), "HEAD", node.GetPath())
+}
+
+func (self *FilesController) canOpenFileHistory(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.FileHistoryOnlyForFiles}
+	}
+
+	if !node.File.Tracked {
+		return &types.DisabledReason{Text: self.c.Tr.FileHistoryFileNotTracked}
+	}
+
+	return nil
+}
+
+func (self *FilesController) canBlame(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.CanOnlyBlameFiles}
+	}
+
+	if !node.File.Tracked || node.File.Added || node.File.Deleted {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameFileNotInHead}
+	}
+
+	return nil
+}
+
+func (self *FilesController) handleConventionalCommitPress() error {
+	return (&ConventionalCommitAction{c: self.c}).Call()
+}
+
 func (self *FilesController) handleAmendCommitPress() error {
 	doAmend := func() error {
 		return self.c.Helpers().WorkingTree.WithEnsureCommittableFiles(func() error {
@@ -916,7 +1165,7 @@ func (self *FilesController) handleAmendCommitPress() error {
 		})
 	}
 
-	return self.c.ConfirmIf(!self.c.UserConfig().Gui.SkipAmendWarning,
+	return self.c.ConfirmIf(self.c.UserConfig().Confirmations.Amend,
 		types.ConfirmOpts{
 			Title:  self.c.Tr.AmendLastCommitTitle,
 			Prompt: self.c.Tr.SureToAmend,
@@ -989,30 +1238,11 @@ func (self *FilesController) handleStatusFilterPressed() error {
 	})
 }
 
-func (self *FilesController) filteringLabel(filter filetree.FileTreeDisplayFilter) string {
-	switch filter {
-	case filetree.DisplayAll:
-		return ""
-	case filetree.DisplayStaged:
-		return self.c.Tr.FilterLabelStagedFiles
-	case filetree.DisplayUnstaged:
-		return self.c.Tr.FilterLabelUnstagedFiles
-	case filetree.DisplayTracked:
-		return self.c.Tr.FilterLabelTrackedFiles
-	case filetree.DisplayUntracked:
-		return self.c.Tr.FilterLabelUntrackedFiles
-	case filetree.DisplayConflicted:
-		return self.c.Tr.FilterLabelConflictingFiles
-	}
-
-	panic(fmt.Sprintf("Unexpected files display filter: %d", filter))
-}
-
 func (self *FilesController) setStatusFiltering(filter filetree.FileTreeDisplayFilter) error {
 	previousFilter := self.context().GetStatusFilter()
 
 	self.context().FileTreeViewModel.SetStatusFilter(filter)
-	self.c.Contexts().Files.GetView().Subtitle = self.filteringLabel(filter)
+	self.context().UpdateSubtitle()
 
 	// Whenever we switch between untracked and other filters, we need to refresh the files view
 	// because the untracked files filter applies when running `git status`.
@@ -1031,6 +1261,14 @@ func (self *FilesController) edit(nodes []*filetree.FileNode) error {
 		}))
 }
 
+func (self *FilesController) anyStagedFiles() *types.DisabledReason {
+	if !self.c.Helpers().WorkingTree.AnyStagedFiles() {
+		return &types.DisabledReason{Text: self.c.Tr.NoFilesStagedTitle}
+	}
+
+	return nil
+}
+
 func (self *FilesController) canEditFiles(nodes []*filetree.FileNode) *types.DisabledReason {
 	if lo.NoneBy(nodes, func(node *filetree.FileNode) bool { return node.IsFile() }) {
 		return &types.DisabledReason{
@@ -1081,6 +1319,13 @@ func (self *FilesController) switchToMerge() error {
 }
 
 func (self *FilesController) createStashMenu() error {
+	selectedNodes, _, _ := self.context().GetSelectedItems()
+	selectedPaths := pathsToStash(selectedNodes)
+	var noSelectionReason *types.DisabledReason
+	if len(selectedPaths) == 0 {
+		noSelectionReason = &types.DisabledReason{Text: self.c.Tr.NoItemSelected}
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.StashOptions,
 		Items: []*types.MenuItem{
@@ -1137,8 +1382,51 @@ func (self *FilesController) createStashMenu() error {
 				},
 				Key: 'u',
 			},
+			{
+				Label: self.c.Tr.StashSelectedFiles,
+				OnPress: func() error {
+					return self.handleStashSave(func(message string) error {
+						return self.c.Git().Stash.StashFiles(selectedPaths, message)
+					}, self.c.Tr.Actions.StashSelectedFiles)
+				},
+				DisabledReason: noSelectionReason,
+				Key:            'f',
+			},
+			{
+				Label:   self.c.Tr.StashFileHunks,
+				Tooltip: self.c.Tr.StashFileHunksTooltip,
+				OnPress: func() error {
+					return self.stashFilesInteractively(selectedPaths)
+				},
+				DisabledReason: noSelectionReason,
+				Key:            'p',
+			},
+		},
+	})
+}
+
+// Renamed files need both their old and new path, otherwise only half of the
+// rename would be stashed
+func pathsToStash(nodes []*filetree.FileNode) []string {
+	return lo.Uniq(lo.FlatMap(nodes, func(node *filetree.FileNode, _ int) []string {
+		if node.File != nil {
+			return node.File.Names()
+		}
+		return []string{node.GetPath()}
+	}))
+}
+
+func (self *FilesController) stashFilesInteractively(paths []string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.StashChanges,
+		HandleConfirm: func(stashComment string) error {
+			self.c.LogAction(self.c.Tr.Actions.StashFileHunks)
+			return self.c.RunSubprocessAndRefresh(self.c.Git().Stash.StashFilesInteractivelyCmdObj(paths, stashComment))
 		},
+		AllowEmptyInput: true,
 	})
+
+	return nil
 }
 
 func (self *FilesController) openMergeConflictMenu(nodes []*filetree.FileNode) error {
@@ -1547,14 +1835,91 @@ func (self *FilesController) remove(selectedNodes []*filetree.FileNode) error {
 		discardUnstagedChangesItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.DiscardUnstagedDisabled}
 	}
 
+	discardHunkItem := types.MenuItem{
+		Label: self.c.Tr.DiscardHunk,
+		OnPress: func() error {
+			return self.discardHunk(selectedNodes[0].File)
+		},
+		Key:       'h',
+		OpensMenu: true,
+		Tooltip: utils.ResolvePlaceholderString(
+			self.c.Tr.DiscardHunkTooltip,
+			map[string]string{
+				"path": self.formattedPaths(selectedNodes),
+			},
+		),
+	}
+
+	if !canDiscardHunk(selectedNodes) {
+		discardHunkItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.DiscardHunkDisabled}
+	}
+
 	menuItems := []*types.MenuItem{
 		&discardAllChangesItem,
 		&discardUnstagedChangesItem,
+		&discardHunkItem,
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.DiscardChangesTitle, Items: menuItems})
 }
 
+// Hunks are taken from the unstaged diff, which for deleted or conflicted files
+// is not something we can apply in reverse
+func canDiscardHunk(selectedNodes []*filetree.FileNode) bool {
+	if len(selectedNodes) != 1 || selectedNodes[0].File == nil {
+		return false
+	}
+
+	file := selectedNodes[0].File
+	return file.Tracked && file.HasUnstagedChanges && !file.Deleted && !file.HasMergeConflicts
+}
+
+// Shows the hunks of the file's unstaged changes, so that the user can pick
+// one to discard without going to the staging view
+func (self *FilesController) discardHunk(file *models.File) error {
+	filePatch := patch.Parse(self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false))
+	lines := filePatch.Lines()
+
+	menuItems := lo.Times(filePatch.HunkCount(), func(hunkIndex int) *types.MenuItem {
+		startIdx := filePatch.HunkStartIdx(hunkIndex)
+		endIdx := filePatch.HunkEndIdx(hunkIndex)
+
+		firstChange := ""
+		if line, ok := lo.Find(lines[startIdx:endIdx+1], func(line *patch.PatchLine) bool {
+			return line.IsChange()
+		}); ok {
+			firstChange = line.Content
+		}
+
+		// We discard exactly the hunk that we show, rather than diffing again
+		// when it's picked; if the file changed in the meantime, git apply
+		// fails instead of discarding whatever hunk is now at this index
+		hunkPatch := filePatch.
+			Transform(patch.TransformOpts{
+				Reverse:             true,
+				IncludedLineIndices: patch.ExpandRange(startIdx, endIdx),
+				FileNameOverride:    file.Path,
+			}).
+			FormatPlain()
+
+		return &types.MenuItem{
+			LabelColumns: []string{lines[startIdx].Content, firstChange},
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.DiscardFileHunk)
+				if err := self.c.Git().WorkingTree.DiscardFileHunk(hunkPatch); err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+				return nil
+			},
+			Tooltip: filePatch.FormatRangePlain(startIdx+1, endIdx),
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.DiscardHunkTitle, Items: menuItems})
+}
+
 func (self *FilesController) ResetSubmodule(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.ResettingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.ResetSubmodule)
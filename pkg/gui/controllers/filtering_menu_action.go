@@ -5,6 +5,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type FilteringMenuAction struct {
@@ -90,6 +91,28 @@ func (self *FilteringMenuAction) Call() error {
 		Tooltip: tooltip,
 	})
 
+	for _, isRegex := range []bool{false, true} {
+		itemTooltip := lo.Ternary(isRegex, self.c.Tr.FilterRegexOptionTooltip, self.c.Tr.FilterContentOptionTooltip)
+		if tooltip != "" {
+			itemTooltip += "\n\n" + tooltip
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: lo.Ternary(isRegex, self.c.Tr.FilterRegexOption, self.c.Tr.FilterContentOption),
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title: lo.Ternary(isRegex, self.c.Tr.EnterRegex, self.c.Tr.EnterContent),
+					HandleConfirm: func(response string) error {
+						return self.setFilteringContent(response, isRegex)
+					},
+				})
+
+				return nil
+			},
+			Tooltip: itemTooltip,
+		})
+	}
+
 	if self.c.Modes().Filtering.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label:   self.c.Tr.ExitFilterMode,
@@ -112,6 +135,12 @@ func (self *FilteringMenuAction) setFilteringAuthor(author string) error {
 	return self.setFiltering()
 }
 
+func (self *FilteringMenuAction) setFilteringContent(content string, isRegex bool) error {
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetContent(content, isRegex)
+	return self.setFiltering()
+}
+
 func (self *FilteringMenuAction) setFiltering() error {
 	self.c.Modes().Filtering.SetSelectedCommitHash(self.c.Contexts().LocalCommits.GetSelectedCommitHash())
 
@@ -60,6 +60,12 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     opts.Guards.NoPopupPanel(self.prevScreenMode),
 			Description: self.c.Tr.PrevScreenMode,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleZoom),
+			Handler:     opts.Guards.NoPopupPanel(self.toggleZoom),
+			Description: self.c.Tr.ToggleZoom,
+			Tooltip:     self.c.Tr.ToggleZoomTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.CyclePagers),
 			Handler:           opts.Guards.NoPopupPanel(self.cyclePagers),
@@ -116,6 +122,13 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Tooltip:     self.c.Tr.ViewDiffingOptionsTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.SidePanelLayoutMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.createSidePanelLayoutMenu),
+			Description: self.c.Tr.ViewSidePanelLayoutOptions,
+			Tooltip:     self.c.Tr.SidePanelLayoutOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Quit),
 			Modifier:    gocui.ModNone,
@@ -152,6 +165,79 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Description: self.c.Tr.ToggleWhitespaceInDiffView,
 			Tooltip:     self.c.Tr.ToggleWhitespaceInDiffViewTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleShowWhitespaceInDiffView),
+			Handler:     self.toggleShowWhitespace,
+			Description: self.c.Tr.ToggleShowWhitespaceInDiffView,
+			Tooltip:     self.c.Tr.ToggleShowWhitespaceInDiffViewTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleDiffMode),
+			Handler:     self.toggleDiffMode,
+			Description: self.c.Tr.ToggleDiffMode,
+			Tooltip:     self.c.Tr.ToggleDiffModeTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleLineWrap),
+			Handler:     self.toggleLineWrap,
+			Description: self.c.Tr.ToggleLineWrap,
+			Tooltip:     self.c.Tr.ToggleLineWrapTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleRelativeDates),
+			Handler:     opts.Guards.NoPopupPanel(self.toggleRelativeDates),
+			Description: self.c.Tr.ToggleRelativeDates,
+			Tooltip:     self.c.Tr.ToggleRelativeDatesTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenKeybindingsOverview),
+			Handler:     opts.Guards.NoPopupPanel(self.openKeybindingsOverview),
+			Description: self.c.Tr.KeybindingsOverview,
+			Tooltip:     self.c.Tr.KeybindingsOverviewTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenCheatsheet),
+			Handler:     opts.Guards.NoPopupPanel(self.openCheatsheet),
+			Description: self.c.Tr.Cheatsheet,
+			Tooltip:     self.c.Tr.CheatsheetTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenNotifications),
+			Handler:     opts.Guards.NoPopupPanel(self.openNotifications),
+			Description: self.c.Tr.Notifications,
+			Tooltip:     self.c.Tr.NotificationsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.GoToAnything),
+			Handler:     opts.Guards.NoPopupPanel(self.goToAnything),
+			Description: self.c.Tr.GoToAnything,
+			Tooltip:     self.c.Tr.GoToAnythingTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenBookmarksMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.openBookmarks),
+			Description: self.c.Tr.Bookmarks,
+			Tooltip:     self.c.Tr.BookmarksTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.LanguageMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.openLanguageMenu),
+			Description: self.c.Tr.Language,
+			Tooltip:     self.c.Tr.LanguageTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.CancelOperation),
+			Handler:           self.cancelOperation,
+			GetDisabledReason: self.cancelOperationDisabledReason,
+			Description:       self.c.Tr.CancelOperation,
+			Tooltip:           self.c.Tr.CancelOperationTooltip,
+		},
 	}
 }
 
@@ -180,6 +266,10 @@ func (self *GlobalController) prevScreenMode() error {
 	return (&ScreenModeActions{c: self.c}).Prev()
 }
 
+func (self *GlobalController) toggleZoom() error {
+	return (&ScreenModeActions{c: self.c}).ToggleZoom()
+}
+
 func (self *GlobalController) cyclePagers() error {
 	self.c.State().GetPagerConfig().CyclePagers()
 	if self.c.Context().CurrentSide().GetKey() == self.c.Context().Current().GetKey() {
@@ -224,6 +314,47 @@ func (self *GlobalController) createDiffingMenu() error {
 	return (&DiffingMenuAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) createSidePanelLayoutMenu() error {
+	return (&SidePanelLayoutMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openKeybindingsOverview() error {
+	return (&KeybindingsOverviewMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openCheatsheet() error {
+	return (&CheatsheetMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openNotifications() error {
+	return (&NotificationsMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) goToAnything() error {
+	return (&GoToAnythingMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openBookmarks() error {
+	return (&BookmarksMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openLanguageMenu() error {
+	return (&LanguageMenuAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) cancelOperation() error {
+	self.c.Helpers().AppStatus.CancelOperation()
+	return nil
+}
+
+func (self *GlobalController) cancelOperationDisabledReason() *types.DisabledReason {
+	if !self.c.Helpers().AppStatus.HasCancellableOperation() {
+		return &types.DisabledReason{Text: self.c.Tr.NoOperationToCancel}
+	}
+
+	return nil
+}
+
 func (self *GlobalController) quit() error {
 	return (&QuitActions{c: self.c}).Quit()
 }
@@ -254,6 +385,32 @@ func (self *GlobalController) toggleWhitespace() error {
 	return (&ToggleWhitespaceAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) toggleShowWhitespace() error {
+	return (&ToggleShowWhitespaceAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) toggleDiffMode() error {
+	return (&ToggleDiffModeAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) toggleLineWrap() error {
+	return (&ToggleLineWrapAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) toggleRelativeDates() error {
+	self.c.State().SetDateFormatToggled(!self.c.State().GetDateFormatToggled())
+
+	for _, context := range []types.Context{
+		self.c.Contexts().LocalCommits,
+		self.c.Contexts().SubCommits,
+		self.c.Contexts().ReflogCommits,
+		self.c.Contexts().Branches,
+	} {
+		context.HandleRender()
+	}
+	return nil
+}
+
 func (self *GlobalController) canShowRebaseOptions() *types.DisabledReason {
 	if self.c.Model().WorkingTreeStateAtLastCommitRefresh.None() {
 		return &types.DisabledReason{
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Lists branches, files, commits and stashes in a single menu, with the filter
+// prompt already open, so that users can jump to any of them without having
+// to navigate to the right panel first.
+type GoToAnythingMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *GoToAnythingMenuAction) Call() error {
+	menuItems := []*types.MenuItem{}
+	model := self.c.Model()
+
+	for _, branch := range model.Branches {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{style.FgGreen.Sprint(self.c.Tr.GoToAnythingBranch), branch.Name, ""},
+			OnPress:      func() error { return goToBranch(self.c, branch.Name) },
+		})
+	}
+
+	for _, file := range model.Files {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{style.FgCyan.Sprint(self.c.Tr.GoToAnythingFile), file.Path, ""},
+			OnPress:      func() error { return goToFile(self.c, file.Path) },
+		})
+	}
+
+	for _, commit := range model.Commits {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{style.FgYellow.Sprint(self.c.Tr.GoToAnythingCommit), commit.Name, style.FgBlue.Sprint(commit.ShortHash())},
+			OnPress:      func() error { return goToCommit(self.c, commit.Hash()) },
+		})
+	}
+
+	for index, stashEntry := range model.StashEntries {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{style.FgMagenta.Sprint(self.c.Tr.GoToAnythingStash), stashEntry.Name, style.FgBlue.Sprint(stashEntry.RefName())},
+			OnPress:      func() error { return self.goToStashEntry(index) },
+		})
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title:      self.c.Tr.GoToAnything,
+		Items:      menuItems,
+		HideCancel: true,
+	}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Search.OpenFilterPrompt(self.c.Contexts().Menu)
+}
+
+// The following functions are also used for jumping to bookmarks
+
+func goToBranch(c *ControllerCommon, name string) error {
+	branchesContext := c.Contexts().Branches
+	c.Helpers().Search.CancelSearchIfSearching(branchesContext)
+
+	for index, item := range branchesContext.GetItems() {
+		if item.Name == name {
+			branchesContext.SetSelection(index)
+			break
+		}
+	}
+
+	c.Context().Push(branchesContext, types.OnFocusOpts{})
+	return nil
+}
+
+func goToFile(c *ControllerCommon, path string) error {
+	filesContext := c.Contexts().Files
+	c.Helpers().Search.CancelSearchIfSearching(filesContext)
+
+	filesContext.FileTreeViewModel.SelectPath(path, c.UserConfig().Gui.ShowRootItemInFileTree)
+
+	c.Context().Push(filesContext, types.OnFocusOpts{})
+	return nil
+}
+
+func goToCommit(c *ControllerCommon, hash string) error {
+	commitsContext := c.Contexts().LocalCommits
+	c.Helpers().Search.CancelSearchIfSearching(commitsContext)
+
+	commitsContext.SelectCommitByHash(hash)
+
+	if c.Context().Current().GetKey() == commitsContext.GetKey() {
+		// Pushing the context again would be a no-op, so we need to show the
+		// new selection ourselves
+		commitsContext.HandleFocus(types.OnFocusOpts{ScrollSelectionIntoView: true})
+		return nil
+	}
+
+	c.Context().Push(commitsContext, types.OnFocusOpts{})
+	return nil
+}
+
+// Like goToCommit, for a commit that might not have been loaded yet because
+// it's further down than the first few hundred commits; in that case we load
+// the whole history first. notFoundErr is returned if the commit isn't in the
+// current branch at all.
+func goToCommitLoadingMoreIfNeeded(c *ControllerCommon, hash string, notFoundErr string) error {
+	commitsContext := c.Contexts().LocalCommits
+	if commitsContext.SelectCommitByHash(hash) {
+		return goToCommit(c, hash)
+	}
+
+	if !commitsContext.GetLimitCommits() {
+		return errors.New(notFoundErr)
+	}
+
+	return c.WithWaitingStatus(c.Tr.LoadingCommits, func(gocui.Task) error {
+		commitsContext.SetLimitCommits(false)
+		c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}})
+
+		c.OnUIThread(func() error {
+			if !commitsContext.SelectCommitByHash(hash) {
+				return errors.New(notFoundErr)
+			}
+			return goToCommit(c, hash)
+		})
+		return nil
+	})
+}
+
+func (self *GoToAnythingMenuAction) goToStashEntry(index int) error {
+	stashContext := self.c.Contexts().Stash
+	self.c.Helpers().Search.CancelSearchIfSearching(stashContext)
+
+	stashContext.SetSelection(index)
+
+	self.c.Context().Push(stashContext, types.OnFocusOpts{})
+	return nil
+}
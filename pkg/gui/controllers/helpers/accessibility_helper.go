@@ -0,0 +1,121 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// In accessibility mode, we announce what is focused and selected, as well as
+// the status messages that we show, as plain lines of text. Screen readers
+// have a hard time making sense of a full-screen terminal UI, so this gives
+// them a predictable, linear description of what's going on.
+
+type AccessibilityHelper struct {
+	c *HelperCommon
+
+	mutex            sync.Mutex
+	lastFocusMessage string
+}
+
+func NewAccessibilityHelper(c *HelperCommon) *AccessibilityHelper {
+	return &AccessibilityHelper{
+		c: c,
+	}
+}
+
+func (self *AccessibilityHelper) enabled() bool {
+	return self.c.UserConfig().Gui.Accessibility.Enabled
+}
+
+// AnnounceFocus announces the focused view and its selected line if either of
+// them changed since the last call. It is called after every layout, so that
+// we don't need to hook into all the places that change the focus or the
+// selection.
+func (self *AccessibilityHelper) AnnounceFocus() {
+	if !self.enabled() {
+		return
+	}
+
+	message, ok := self.describeFocus(self.c.Context().Current())
+	if !ok {
+		return
+	}
+
+	self.mutex.Lock()
+	changed := message != self.lastFocusMessage
+	self.lastFocusMessage = message
+	self.mutex.Unlock()
+
+	if changed {
+		self.Announce(message)
+	}
+}
+
+// Returns false if the view hasn't been rendered yet, in which case we wait for
+// the next layout to announce it
+func (self *AccessibilityHelper) describeFocus(context types.Context) (string, bool) {
+	view := context.GetView()
+	if view == nil {
+		return "", false
+	}
+
+	parts := []string{view.Title}
+	if view.Title == "" {
+		parts = []string{string(context.GetKey())}
+	}
+
+	if listContext, ok := context.(types.IListContext); ok {
+		list := listContext.GetList()
+		if list.Len() == 0 {
+			parts = append(parts, self.c.Tr.AccessibilityEmptyList)
+		} else {
+			selectedLineIdx := list.GetSelectedLineIdx()
+			if view.SelectedLineIdx() != listContext.ModelIndexToViewIndex(selectedLineIdx) {
+				return "", false
+			}
+			selectedLine := view.SelectedLine()
+			if selectedLine == "" {
+				return "", false
+			}
+			parts = append(parts,
+				fmt.Sprintf("%d of %d", selectedLineIdx+1, list.Len()),
+				selectedLine)
+		}
+	} else if kind := context.GetKind(); kind == types.TEMPORARY_POPUP || kind == types.PERSISTENT_POPUP {
+		parts = append(parts, view.Buffer())
+	}
+
+	return strings.Join(parts, ": "), true
+}
+
+// Announce appends the given message to the announcements file, if one is
+// configured. The message is collapsed to a single line.
+func (self *AccessibilityHelper) Announce(message string) {
+	path := self.c.UserConfig().Gui.Accessibility.AnnouncementsFile
+	if !self.enabled() || path == "" {
+		return
+	}
+
+	line := strings.Join(strings.Fields(message), " ")
+	if line == "" {
+		return
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		self.c.Log.Errorf("Failed to open announcements file: %v", err)
+		return
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(line + "\n"); err != nil {
+		self.c.Log.Errorf("Failed to write to announcements file: %v", err)
+	}
+}
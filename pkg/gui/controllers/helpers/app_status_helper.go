@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -11,19 +12,23 @@ import (
 type AppStatusHelper struct {
 	c *HelperCommon
 
-	statusMgr  func() *status.StatusManager
-	modeHelper *ModeHelper
+	statusMgr           func() *status.StatusManager
+	modeHelper          *ModeHelper
+	accessibilityHelper *AccessibilityHelper
 }
 
-func NewAppStatusHelper(c *HelperCommon, statusMgr func() *status.StatusManager, modeHelper *ModeHelper) *AppStatusHelper {
+func NewAppStatusHelper(c *HelperCommon, statusMgr func() *status.StatusManager, modeHelper *ModeHelper, accessibilityHelper *AccessibilityHelper) *AppStatusHelper {
 	return &AppStatusHelper{
-		c:          c,
-		statusMgr:  statusMgr,
-		modeHelper: modeHelper,
+		c:                   c,
+		statusMgr:           statusMgr,
+		modeHelper:          modeHelper,
+		accessibilityHelper: accessibilityHelper,
 	}
 }
 
 func (self *AppStatusHelper) Toast(message string, kind types.ToastKind) {
+	self.accessibilityHelper.Announce(message)
+
 	if self.c.RunningIntegrationTest() {
 		// Don't bother showing toasts in integration tests. You can't check for
 		// them anyway, and they would only slow down the test unnecessarily by
@@ -65,12 +70,16 @@ func (self *AppStatusHelper) WithWaitingStatus(message string, f func(gocui.Task
 }
 
 func (self *AppStatusHelper) WithWaitingStatusImpl(message string, f func(gocui.Task) error, task gocui.Task) error {
+	self.accessibilityHelper.Announce(message)
+
 	return self.statusMgr().WithWaitingStatus(message, self.renderAppStatus, func(waitingStatusHandle *status.WaitingStatusHandle) error {
 		return f(appStatusHelperTask{task, waitingStatusHandle})
 	})
 }
 
 func (self *AppStatusHelper) WithWaitingStatusSync(message string, f func() error) error {
+	self.accessibilityHelper.Announce(message)
+
 	return self.statusMgr().WithWaitingStatus(message, func() {}, func(*status.WaitingStatusHandle) error {
 		stop := make(chan struct{})
 		defer func() { close(stop) }()
@@ -80,6 +89,26 @@ func (self *AppStatusHelper) WithWaitingStatusSync(message string, f func() erro
 	})
 }
 
+func (self *AppStatusHelper) NewProgressReporter() oscommands.ProgressReporter {
+	return self.statusMgr().NewProgressHandle()
+}
+
+// CancelOperation aborts the most recent running command that reports its
+// progress, if any
+func (self *AppStatusHelper) CancelOperation() {
+	if progress := self.statusMgr().GetCancellableProgress(); progress != nil {
+		progress.Cancel()
+	}
+}
+
+func (self *AppStatusHelper) HasCancellableOperation() bool {
+	return self.statusMgr().GetCancellableProgress() != nil
+}
+
+func (self *AppStatusHelper) GetToastHistory() []status.ToastHistoryEntry {
+	return self.statusMgr().GetToastHistory()
+}
+
 func (self *AppStatusHelper) HasStatus() bool {
 	return self.statusMgr().HasStatus()
 }
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+)
+
+// Bookmarks are stored per repo in the app state, so that they survive
+// restarts. See config.Bookmark.
+type BookmarksHelper struct {
+	c *HelperCommon
+}
+
+func NewBookmarksHelper(c *HelperCommon) *BookmarksHelper {
+	return &BookmarksHelper{
+		c: c,
+	}
+}
+
+func (self *BookmarksHelper) Bookmarks() []config.Bookmark {
+	return self.c.GetAppState().Bookmarks[self.c.Git().RepoPaths.WorktreePath()]
+}
+
+func (self *BookmarksHelper) IsBookmarked(kind string, id string) bool {
+	return self.indexOf(kind, id) != -1
+}
+
+// Adds the bookmark if it doesn't exist yet, otherwise removes it. Returns
+// whether the bookmark was added.
+func (self *BookmarksHelper) Toggle(bookmark config.Bookmark) bool {
+	bookmarks := self.Bookmarks()
+	added := false
+	if idx := self.indexOf(bookmark.Kind, bookmark.Id); idx != -1 {
+		bookmarks = slices.Delete(slices.Clone(bookmarks), idx, idx+1)
+	} else {
+		bookmarks = append(slices.Clone(bookmarks), bookmark)
+		added = true
+	}
+
+	self.setBookmarks(bookmarks)
+	return added
+}
+
+func (self *BookmarksHelper) Clear() {
+	self.setBookmarks(nil)
+}
+
+func (self *BookmarksHelper) indexOf(kind string, id string) int {
+	return slices.IndexFunc(self.Bookmarks(), func(bookmark config.Bookmark) bool {
+		return bookmark.Kind == kind && bookmark.Id == id
+	})
+}
+
+func (self *BookmarksHelper) setBookmarks(bookmarks []config.Bookmark) {
+	appState := self.c.GetAppState()
+	path := self.c.Git().RepoPaths.WorktreePath()
+	if len(bookmarks) == 0 {
+		delete(appState.Bookmarks, path)
+	} else {
+		if appState.Bookmarks == nil {
+			appState.Bookmarks = map[string][]config.Bookmark{}
+		}
+		appState.Bookmarks[path] = bookmarks
+	}
+	self.c.SaveAppStateAndLogError()
+}
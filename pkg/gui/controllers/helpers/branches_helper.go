@@ -15,18 +15,28 @@ import (
 )
 
 type BranchesHelper struct {
-	c              *HelperCommon
-	worktreeHelper *WorktreeHelper
+	c                       *HelperCommon
+	worktreeHelper          *WorktreeHelper
+	operationJournalHelper  *OperationJournalHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
-func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper) *BranchesHelper {
+func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper, operationJournalHelper *OperationJournalHelper, protectedBranchesHelper *ProtectedBranchesHelper) *BranchesHelper {
 	return &BranchesHelper{
-		c:              c,
-		worktreeHelper: worktreeHelper,
+		c:                       c,
+		worktreeHelper:          worktreeHelper,
+		operationJournalHelper:  operationJournalHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
 func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error {
+	return self.protectedBranchesHelper.Guard(localBranchNames(branches), self.c.Tr.ProtectedBranchActionDelete, func() error {
+		return self.confirmLocalDelete(branches)
+	})
+}
+
+func (self *BranchesHelper) confirmLocalDelete(branches []*models.Branch) error {
 	if len(branches) > 1 {
 		if lo.SomeBy(branches, func(branch *models.Branch) bool { return self.checkedOutByOtherWorktree(branch) }) {
 			return errors.New(self.c.Tr.SomeBranchesCheckedOutByWorktreeError)
@@ -48,15 +58,36 @@ func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error
 			if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
 				return err
 			}
+			self.operationJournalHelper.RecordBranchDeletion(branches, true)
 
 			self.c.Contexts().Branches.CollapseRangeSelectionToTop()
+			self.c.Contexts().Branches.ClearMarks()
 			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
 			return nil
 		})
 	}
 
 	if allBranchesMerged {
-		return doDelete()
+		if !self.c.UserConfig().Confirmations.DeleteMergedBranch {
+			return doDelete()
+		}
+
+		title := self.c.Tr.DeleteBranchesTitle
+		prompt := self.c.Tr.DeleteLocalBranchesPrompt
+		if len(branches) == 1 {
+			placeholders := map[string]string{"selectedBranchName": branches[0].Name}
+			title = utils.ResolvePlaceholderString(self.c.Tr.DeleteBranchTitle, placeholders)
+			prompt = utils.ResolvePlaceholderString(self.c.Tr.DeleteLocalBranchPrompt, placeholders)
+		}
+
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  title,
+			Prompt: prompt,
+			HandleConfirm: func() error {
+				return doDelete()
+			},
+		})
+		return nil
 	}
 
 	title := self.c.Tr.ForceDeleteBranchTitle
@@ -84,6 +115,13 @@ func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error
 }
 
 func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteBranch, resetRemoteBranchesSelection bool) error {
+	remoteBranchNames := lo.Map(remoteBranches, func(branch *models.RemoteBranch, _ int) string { return branch.Name })
+	return self.protectedBranchesHelper.Guard(remoteBranchNames, self.c.Tr.ProtectedBranchActionDelete, func() error {
+		return self.confirmDeleteRemote(remoteBranches, resetRemoteBranchesSelection)
+	})
+}
+
+func (self *BranchesHelper) confirmDeleteRemote(remoteBranches []*models.RemoteBranch, resetRemoteBranchesSelection bool) error {
 	var title string
 	if len(remoteBranches) == 1 {
 		title = utils.ResolvePlaceholderString(
@@ -118,6 +156,7 @@ func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteB
 				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
 				if resetRemoteBranchesSelection {
 					self.c.Contexts().RemoteBranches.CollapseRangeSelectionToTop()
+					self.c.Contexts().RemoteBranches.ClearMarks()
 				}
 				return nil
 			})
@@ -128,6 +167,12 @@ func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteB
 }
 
 func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branch) error {
+	return self.protectedBranchesHelper.Guard(localBranchNames(branches), self.c.Tr.ProtectedBranchActionDelete, func() error {
+		return self.confirmLocalAndRemoteDelete(branches)
+	})
+}
+
+func (self *BranchesHelper) confirmLocalAndRemoteDelete(branches []*models.Branch) error {
 	if lo.SomeBy(branches, func(branch *models.Branch) bool { return self.checkedOutByOtherWorktree(branch) }) {
 		return errors.New(self.c.Tr.SomeBranchesCheckedOutByWorktreeError)
 	}
@@ -184,8 +229,11 @@ func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branc
 				if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
 					return err
 				}
+				// The upstream branches are gone, so we can only restore the local ones
+				self.operationJournalHelper.RecordBranchDeletion(branches, false)
 
 				self.c.Contexts().Branches.CollapseRangeSelectionToTop()
+				self.c.Contexts().Branches.ClearMarks()
 				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
 				return nil
 			})
@@ -195,6 +243,10 @@ func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branc
 	return nil
 }
 
+func localBranchNames(branches []*models.Branch) []string {
+	return lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+}
+
 func ShortBranchName(fullBranchName string) string {
 	return strings.TrimPrefix(strings.TrimPrefix(fullBranchName, "refs/heads/"), "refs/remotes/")
 }
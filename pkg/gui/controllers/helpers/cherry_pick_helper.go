@@ -3,10 +3,10 @@ package helpers
 import (
 	"strconv"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
-	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -34,7 +34,12 @@ func (self *CherryPickHelper) getData() *cherrypicking.CherryPicking {
 }
 
 func (self *CherryPickHelper) CopyRange(commitsList []*models.Commit, context types.IListContext) error {
-	startIdx, endIdx := context.GetList().GetSelectionRange()
+	list := context.GetList()
+	indices := list.MarkedIndices()
+	if len(indices) == 0 {
+		startIdx, endIdx := list.GetSelectionRange()
+		indices = lo.RangeFrom(startIdx, endIdx-startIdx+1)
+	}
 
 	if err := self.resetIfNecessary(context); err != nil {
 		return err
@@ -42,23 +47,24 @@ func (self *CherryPickHelper) CopyRange(commitsList []*models.Commit, context ty
 
 	commitSet := self.getData().SelectedHashSet()
 
-	allCommitsCopied := lo.EveryBy(commitsList[startIdx:endIdx+1], func(commit *models.Commit) bool {
-		return commitSet.Includes(commit.Hash())
+	allCommitsCopied := lo.EveryBy(indices, func(index int) bool {
+		return commitSet.Includes(commitsList[index].Hash())
 	})
 
 	// if all selected commits are already copied, we'll uncopy them
 	if allCommitsCopied {
-		for index := startIdx; index <= endIdx; index++ {
-			commit := commitsList[index]
-			self.getData().Remove(commit, commitsList)
+		for _, index := range indices {
+			self.getData().Remove(commitsList[index], commitsList)
 		}
 	} else {
-		for index := startIdx; index <= endIdx; index++ {
-			commit := commitsList[index]
-			self.getData().Add(commit, commitsList)
+		for _, index := range indices {
+			self.getData().Add(commitsList[index], commitsList)
 		}
 	}
 
+	// The copied commits are highlighted now, so the marks are no longer needed
+	list.ClearMarks()
+
 	self.getData().DidPaste = false
 
 	self.rerender()
@@ -70,68 +76,154 @@ func (self *CherryPickHelper) CopyRange(commitsList []*models.Commit, context ty
 func (self *CherryPickHelper) Paste() error {
 	self.c.Confirm(types.ConfirmOpts{
 		Title: self.c.Tr.CherryPick,
-		Prompt: utils.ResolvePlaceholderString(
+		Prompt: self.c.Tr.FormatMessage(
 			self.c.Tr.SureCherryPick,
 			map[string]string{
 				"numCommits": strconv.Itoa(len(self.getData().CherryPickedCommits)),
 			}),
 		HandleConfirm: func() error {
-			return self.c.WithWaitingStatusSync(self.c.Tr.CherryPickingStatus, func() error {
-				mustStash := IsWorkingTreeDirtyExceptSubmodules(self.c.Model().Files, self.c.Model().Submodules)
+			return self.paste(git_commands.CherryPickOpts{})
+		},
+	})
 
-				self.c.LogAction(self.c.Tr.Actions.CherryPick)
+	return nil
+}
 
-				if mustStash {
-					if err := self.c.Git().Stash.Push(self.c.Tr.AutoStashForCherryPicking); err != nil {
-						return err
-					}
-				}
+// Like Paste, but shows a menu for choosing options for git cherry-pick first.
+// Toggling an option reopens the menu, so that several can be combined.
+func (self *CherryPickHelper) PasteWithOptions() error {
+	return self.pasteOptionsMenu(git_commands.CherryPickOpts{})
+}
 
-				cherryPickedCommits := self.getData().CherryPickedCommits
-				result := self.c.Git().Rebase.CherryPickCommits(cherryPickedCommits)
-				err := self.rebaseHelper.CheckMergeOrRebaseWithRefreshOptions(result, types.RefreshOptions{Mode: types.SYNC})
-				if err != nil {
-					return result
-				}
+func (self *CherryPickHelper) pasteOptionsMenu(opts git_commands.CherryPickOpts) error {
+	cherryPickedCommits := self.getData().CherryPickedCommits
 
-				// Move the selection down by the number of commits we just
-				// cherry-picked, to keep the same commit selected as before.
-				// Don't do this if a rebase todo is selected, because in this
-				// case we are in a rebase and the cherry-picked commits end up
-				// below the selection.
-				if commit := self.c.Contexts().LocalCommits.GetSelected(); commit != nil && !commit.IsTODO() {
-					self.c.Contexts().LocalCommits.MoveSelection(len(cherryPickedCommits))
-					self.c.Contexts().LocalCommits.FocusLine(true)
-				}
+	reopenWith := func(change func(opts *git_commands.CherryPickOpts)) func() error {
+		return func() error {
+			newOpts := opts
+			change(&newOpts)
+			return self.pasteOptionsMenu(newOpts)
+		}
+	}
 
-				// If we're in the cherry-picking state at this point, it must
-				// be because there were conflicts. Don't clear the copied
-				// commits in this case, since we might want to abort and try
-				// pasting them again.
-				isInCherryPick, result := self.c.Git().Status.IsInCherryPick()
-				if result != nil {
-					return result
-				}
-				if !isInCherryPick {
-					self.getData().DidPaste = true
-					self.rerender()
-
-					if mustStash {
-						if err := self.c.Git().Stash.Pop(0); err != nil {
-							return err
-						}
-						self.c.Refresh(types.RefreshOptions{
-							Scope: []types.RefreshableView{types.STASH, types.FILES},
-						})
-					}
-				}
+	optionsSection := &types.MenuSection{Title: self.c.Tr.CherryPickOptions, Column: 0}
+	menuItems := []*types.MenuItem{
+		{
+			Label: self.c.Tr.FormatMessage(self.c.Tr.CherryPickCommits, map[string]string{
+				"numCommits": strconv.Itoa(len(cherryPickedCommits)),
+			}),
+			OnPress: func() error { return self.paste(opts) },
+			Key:     'c',
+		},
+		{
+			Label:   self.c.Tr.CherryPickNoCommit,
+			Widget:  types.MakeMenuCheckBox(opts.NoCommit),
+			OnPress: reopenWith(func(opts *git_commands.CherryPickOpts) { opts.NoCommit = !opts.NoCommit }),
+			Key:     'n',
+			Tooltip: self.c.Tr.CherryPickNoCommitTooltip,
+			Section: optionsSection,
+		},
+		{
+			Label:   self.c.Tr.CherryPickRecordOrigin,
+			Widget:  types.MakeMenuCheckBox(opts.RecordOrigin),
+			OnPress: reopenWith(func(opts *git_commands.CherryPickOpts) { opts.RecordOrigin = !opts.RecordOrigin }),
+			Key:     'x',
+			Tooltip: self.c.Tr.CherryPickRecordOriginTooltip,
+			Section: optionsSection,
+		},
+	}
 
-				return nil
+	// Only offer to choose the mainline if there are merge commits to pick.
+	// Since the same -m is passed for all of them, only offer the parent
+	// numbers that every one of them has a parent for.
+	mergeCommits := lo.Filter(cherryPickedCommits, func(commit *models.Commit, _ int) bool {
+		return commit.IsMerge()
+	})
+	if len(mergeCommits) > 0 {
+		numParents := lo.Min(lo.Map(mergeCommits, func(commit *models.Commit, _ int) int {
+			return len(commit.Parents())
+		}))
+		mainlineSection := &types.MenuSection{Title: self.c.Tr.CherryPickMainline, Column: 0}
+		for parent := 1; parent <= numParents; parent++ {
+			var key types.Key
+			if parent <= 9 {
+				key = rune('0' + parent)
+			}
+			menuItems = append(menuItems, &types.MenuItem{
+				Label: self.c.Tr.FormatMessage(self.c.Tr.CherryPickMainlineParent, map[string]string{
+					"number": strconv.Itoa(parent),
+				}),
+				Widget:  types.MakeMenuRadioButton(max(opts.Mainline, 1) == parent),
+				OnPress: reopenWith(func(opts *git_commands.CherryPickOpts) { opts.Mainline = parent }),
+				Key:     key,
+				Tooltip: self.c.Tr.CherryPickMainlineTooltip,
+				Section: mainlineSection,
 			})
-		},
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CherryPickOptionsTitle,
+		Items: menuItems,
 	})
+}
 
-	return nil
+func (self *CherryPickHelper) paste(opts git_commands.CherryPickOpts) error {
+	return self.c.WithWaitingStatusSync(self.c.Tr.CherryPickingStatus, func() error {
+		// Without committing, the picked changes end up in the working tree
+		// along with the existing ones, so there's no need to stash those
+		mustStash := !opts.NoCommit && IsWorkingTreeDirtyExceptSubmodules(self.c.Model().Files, self.c.Model().Submodules)
+
+		self.c.LogAction(self.c.Tr.Actions.CherryPick)
+
+		if mustStash {
+			if err := self.c.Git().Stash.Push(self.c.Tr.AutoStashForCherryPicking); err != nil {
+				return err
+			}
+		}
+
+		cherryPickedCommits := self.getData().CherryPickedCommits
+		result := self.c.Git().Rebase.CherryPickCommits(cherryPickedCommits, opts)
+		err := self.rebaseHelper.CheckMergeOrRebaseWithRefreshOptions(result, types.RefreshOptions{Mode: types.SYNC})
+		if err != nil {
+			return result
+		}
+
+		// Move the selection down by the number of commits we just
+		// cherry-picked, to keep the same commit selected as before.
+		// Don't do this if a rebase todo is selected, because in this
+		// case we are in a rebase and the cherry-picked commits end up
+		// below the selection. Without committing, there are no new
+		// commits.
+		if commit := self.c.Contexts().LocalCommits.GetSelected(); commit != nil && !commit.IsTODO() && !opts.NoCommit {
+			self.c.Contexts().LocalCommits.MoveSelection(len(cherryPickedCommits))
+			self.c.Contexts().LocalCommits.FocusLine(true)
+		}
+
+		// If we're in the cherry-picking state at this point, it must
+		// be because there were conflicts. Don't clear the copied
+		// commits in this case, since we might want to abort and try
+		// pasting them again.
+		isInCherryPick, result := self.c.Git().Status.IsInCherryPick()
+		if result != nil {
+			return result
+		}
+		if !isInCherryPick {
+			self.getData().DidPaste = true
+			self.rerender()
+
+			if mustStash {
+				if err := self.c.Git().Stash.Pop(0); err != nil {
+					return err
+				}
+				self.c.Refresh(types.RefreshOptions{
+					Scope: []types.RefreshableView{types.STASH, types.FILES},
+				})
+			}
+		}
+
+		return nil
+	})
 }
 
 func (self *CherryPickHelper) CanPaste() bool {
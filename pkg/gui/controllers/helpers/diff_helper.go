@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
@@ -11,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
+	"github.com/stefanhaller/git-todo-parser/todo"
 )
 
 type DiffHelper struct {
@@ -56,6 +58,9 @@ func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Comm
 	if refRange != nil {
 		from, to := refRange.From, refRange.To
 		args := []string{from.ParentRefName(), to.RefName(), "--stat", "-p"}
+		if contentFilter := self.c.Modes().Filtering.ContentFilter(); contentFilter.Active() {
+			args = append(args, contentFilter.Arg())
+		}
 		args = append(args, "--")
 		if filterPath := self.c.Modes().Filtering.GetPath(); filterPath != "" {
 			// If both refs are commits, filter by the union of their paths. This is useful for
@@ -74,11 +79,47 @@ func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Comm
 		}
 		cmdObj := self.c.Git().Diff.DiffCmdObj(args)
 		prefix := style.FgYellow.Sprintf("%s %s-%s\n\n", self.c.Tr.ShowingDiffForRange, from.ShortRefName(), to.ShortRefName())
-		return types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
+		return types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix).AsDiff()
 	}
 
-	cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.FilterPathsForCommit(commit))
-	return types.NewRunPtyTask(cmdObj.GetCmd())
+	cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.FilterPathsForCommit(commit), self.c.Modes().Filtering.ContentFilter())
+	return types.NewCachedRunPtyTask(cmdObj.GetCmd(), self.commitDiffCacheKey()).AsDiff()
+}
+
+// How many commits above and below the selected one we prefetch diffs for
+const commitDiffPrefetchDistance = 3
+
+// Makes the given task (as returned by GetUpdateTaskForRenderingCommitsDiff)
+// prefetch the diffs of the commits around the selected one, so that moving
+// the selection up or down shows them instantly
+func (self *DiffHelper) PrefetchAdjacentCommitDiffs(task types.UpdateTask, commits []*models.Commit, selectedIdx int) {
+	ptyTask, ok := task.(*types.RunPtyTask)
+	if !ok || ptyTask.CacheKey == "" {
+		return
+	}
+
+	for distance := 1; distance <= commitDiffPrefetchDistance; distance++ {
+		// The one below first, because scrolling down is more common
+		for _, idx := range []int{selectedIdx + distance, selectedIdx - distance} {
+			if idx < 0 || idx >= len(commits) {
+				continue
+			}
+
+			commit := commits[idx]
+			if commit.Hash() == "" || commit.Action == todo.UpdateRef || commit.Action == todo.Exec {
+				continue
+			}
+
+			cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.FilterPathsForCommit(commit), self.c.Modes().Filtering.ContentFilter())
+			ptyTask.Prefetch = append(ptyTask.Prefetch, cmdObj.GetCmd())
+		}
+	}
+}
+
+// The diff of a commit never changes, but its header shows the refs pointing
+// at it, so we invalidate cached diffs whenever the refs are reloaded
+func (self *DiffHelper) commitDiffCacheKey() string {
+	return fmt.Sprintf("refs-%d", self.c.Model().RefsVersion.Load())
 }
 
 func (self *DiffHelper) FilterPathsForCommit(commit *models.Commit) []string {
@@ -106,7 +147,7 @@ func (self *DiffHelper) RenderDiff() {
 		self.c.Tr.ShowingGitDiff,
 		"git diff "+strings.Join(args, " "),
 	)
-	task := types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
+	task := types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix).AsDiff()
 
 	self.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: self.c.MainViewPairs().Normal,
@@ -166,6 +207,19 @@ func (self *DiffHelper) WithDiffModeCheck(f func()) {
 	}
 }
 
+// Adds a "Details" tab to the given options for rendering a single commit to
+// the main view, and renders the commit's details instead of its patch if
+// that tab is active
+func (self *DiffHelper) AddCommitDetailsTab(opts *types.ViewUpdateOpts, commit *models.Commit) {
+	opts.Tabs = []string{opts.Title, self.c.Tr.CommitDetailsTab}
+	if self.c.State().GetShowCommitDetails() {
+		opts.TabIndex = 1
+		opts.SubTitle = ""
+		cmdObj := self.c.Git().Commit.ShowDetailsCmdObj(commit.Hash())
+		opts.Task = types.NewRunPtyTask(cmdObj.GetCmd())
+	}
+}
+
 func (self *DiffHelper) IgnoringWhitespaceSubTitle() string {
 	if self.c.UserConfig().Git.IgnoreWhitespaceInDiffView {
 		return self.c.Tr.IgnoreWhitespaceDiffViewSubTitle
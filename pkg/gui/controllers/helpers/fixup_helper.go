@@ -3,11 +3,17 @@ package helpers
 import (
 	"errors"
 	"fmt"
+	"iter"
 	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -15,14 +21,17 @@ import (
 )
 
 type FixupHelper struct {
-	c *HelperCommon
+	c   *HelperCommon
+	gpg *GpgHelper
 }
 
 func NewFixupHelper(
 	c *HelperCommon,
+	gpg *GpgHelper,
 ) *FixupHelper {
 	return &FixupHelper{
-		c: c,
+		c:   c,
+		gpg: gpg,
 	}
 }
 
@@ -148,6 +157,212 @@ func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
 	})
 }
 
+// The result of working out which commits the staged hunks belong to, for
+// absorbing them into these commits
+type absorbPlan struct {
+	// The staged diff, without context lines, and the hash of the commit that
+	// it's relative to
+	diff     string
+	baseHash string
+	// Ordered from the oldest commit to the newest one, which is the order in
+	// which we create the fixup commits
+	targets []*absorbTarget
+	// The number of staged hunks that we couldn't find a commit for; these
+	// stay staged
+	numUnassignedHunks int
+}
+
+type absorbTarget struct {
+	commit *models.Commit
+	hunks  []*hunk
+}
+
+// Distributes the staged changes over the commits that they belong to and
+// creates a fixup commit for each of these, like git absorb does. A hunk
+// belongs to a commit if that commit last touched all the lines that the hunk
+// deletes or modifies; hunks that only add lines, or whose lines come from
+// more than one commit, are left alone.
+func (self *FixupHelper) HandleAbsorbStagedChangesPress() error {
+	// Only commits of the current branch that aren't on the main branch yet
+	// can be fixed up
+	candidates := lo.Filter(self.c.Model().Commits, func(commit *models.Commit, _ int) bool {
+		return commit.Status != models.StatusMerged && !commit.IsTODO()
+	})
+
+	var plan *absorbPlan
+	if err := self.c.WithWaitingStatusSync(self.c.Tr.FindingAbsorbTargetsStatus, func() error {
+		var err error
+		plan, err = self.findAbsorbTargets(candidates)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if len(plan.targets) == 0 {
+		return errors.New(self.c.Tr.NoAbsorbTargetsFound)
+	}
+
+	// Show the commits newest first, like in the commits panel
+	targetCommits := lo.Reverse(lo.Map(plan.targets, func(target *absorbTarget, _ int) *models.Commit {
+		return target.commit
+	}))
+	prompt := self.c.Tr.AbsorbStagedChangesPrompt + "\n\n" + getHashesAndSubjects(targetCommits)
+	if plan.numUnassignedHunks > 0 {
+		prompt += "\n\n" + self.c.Tr.FormatMessage(self.c.Tr.AbsorbUnassignedHunks, map[string]string{
+			"count": strconv.Itoa(plan.numUnassignedHunks),
+		})
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.AbsorbStagedChanges,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.AbsorbStagedChanges)
+			indexFile, err := self.c.Git().Absorb.NewIndexFile()
+			if err != nil {
+				return err
+			}
+			return self.createFixupCommits(plan, indexFile, 0)
+		},
+	})
+
+	return nil
+}
+
+func (self *FixupHelper) findAbsorbTargets(candidates []*models.Commit) (*absorbPlan, error) {
+	baseHash, err := self.c.Git().Absorb.BaseHash()
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := self.c.Git().Diff.DiffIndexCmdObj("--cached", "-U0", "--no-renames", "--ignore-submodules=all", baseHash, "--").
+		DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	deletedLineHunks, addedLineHunks := parseDiff(diff)
+	hashesPerHunk, err := self.blameHunks(deletedLineHunks, self.c.Git().Blame.BlameLineRangeWithRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &absorbPlan{diff: diff, baseHash: baseHash, numUnassignedHunks: len(addedLineHunks)}
+	hunksByHash := map[string][]*hunk{}
+	for i, h := range deletedLineHunks {
+		if hashesPerHunk[i].Len() != 1 {
+			plan.numUnassignedHunks++
+			continue
+		}
+		hash := hashesPerHunk[i].ToSlice()[0]
+		hunksByHash[hash] = append(hunksByHash[hash], h)
+	}
+
+	for _, commit := range slices.Backward(candidates) {
+		if hunks, ok := hunksByHash[commit.Hash()]; ok {
+			plan.targets = append(plan.targets, &absorbTarget{commit: commit, hunks: hunks})
+			delete(hunksByHash, commit.Hash())
+		}
+	}
+	// Whatever is left belongs to commits that we can't fix up
+	for _, hunks := range hunksByHash {
+		plan.numUnassignedHunks += len(hunks)
+	}
+
+	return plan, nil
+}
+
+// Creates the fixup commits for the plan's targets, starting with the one at
+// the given index. Each fixup commit's tree is the base commit with the hunks
+// of that target and of all the ones before it applied, so the line numbers of
+// the hunks are always relative to the base commit. We create the commits one
+// after the other because each of them might need to be signed.
+func (self *FixupHelper) createFixupCommits(plan *absorbPlan, indexFile string, targetIdx int) error {
+	if targetIdx == len(plan.targets) {
+		return nil
+	}
+
+	patch := absorbPatch(plan.diff, lo.FlatMap(plan.targets[:targetIdx+1], func(target *absorbTarget, _ int) []*hunk {
+		return target.hunks
+	}))
+	if err := self.c.Git().Absorb.PrepareIndex(indexFile, plan.baseHash, patch); err != nil {
+		// some of the fixup commits may have been created already
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		return err
+	}
+
+	return self.gpg.WithGpgHandling(
+		self.c.Git().Absorb.CreateFixupCommitCmdObj(indexFile, plan.targets[targetIdx].commit.Hash()),
+		git_commands.CommitGpgSign,
+		self.c.Tr.CreatingFixupCommitsStatus,
+		func() error {
+			return self.createFixupCommits(plan, indexFile, targetIdx+1)
+		},
+		nil,
+	)
+}
+
+// Returns the patch of the given hunks of the diff, which must have been made
+// without context lines. Mode changes don't belong to any hunk, so we leave
+// them out; they stay staged.
+func absorbPatch(diff string, hunks []*hunk) string {
+	var result strings.Builder
+	for filename, fileDiff := range splitDiffByFile(diff) {
+		fileDiff = strings.Join(lo.Reject(strings.Split(fileDiff, "\n"), func(line string, _ int) bool {
+			return strings.HasPrefix(line, "old mode ") || strings.HasPrefix(line, "new mode ")
+		}), "\n")
+
+		oldStarts := set.NewFromSlice(lo.FilterMap(hunks, func(h *hunk, _ int) (int, bool) {
+			return h.startLineIdx, h.filename == filename
+		}))
+		if oldStarts.Len() == 0 {
+			continue
+		}
+
+		filePatch := patch.Parse(fileDiff)
+		includedLineIndices := []int{}
+		for hunkIdx := range filePatch.HunkCount() {
+			startIdx := filePatch.HunkStartIdx(hunkIdx)
+			if oldStarts.Includes(filePatch.HunkOldStartForLine(startIdx)) {
+				includedLineIndices = append(includedLineIndices, patch.ExpandRange(startIdx, filePatch.HunkEndIdx(hunkIdx))...)
+			}
+		}
+
+		result.WriteString(filePatch.Transform(patch.TransformOpts{IncludedLineIndices: includedLineIndices}).FormatPlain())
+	}
+
+	return result.String()
+}
+
+// Yields the diff of each file in the given diff, along with the file's name
+// as parseDiff determines it
+func splitDiffByFile(diff string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+		start := 0
+		for i := 1; i <= len(lines); i++ {
+			if i < len(lines) && !strings.HasPrefix(lines[i], "diff --git") {
+				continue
+			}
+
+			fileLines := lines[start:i]
+			start = i
+			filename := ""
+			for _, line := range fileLines {
+				if strings.HasPrefix(line, "@@ ") {
+					break
+				}
+				if strings.HasPrefix(line, "--- ") {
+					filename = strings.TrimRight(line[6:], "\t")
+				}
+			}
+			if !yield(filename, strings.Join(fileLines, "\n")+"\n") {
+				return
+			}
+		}
+	}
+}
+
 func getCommitsForHashes(commits []*models.Commit, hashes []string) []*models.Commit {
 	// This is called only for the NOT_MERGED commits, and we know that all of them are contained in
 	// the commits slice.
@@ -264,37 +479,46 @@ func parseDiff(diff string) ([]*hunk, []*hunk) {
 
 // returns the list of commit hashes that introduced the lines which have now been deleted
 func (self *FixupHelper) blameDeletedLines(deletedLineHunks []*hunk) ([]string, error) {
+	hashesPerHunk, err := self.blameHunks(deletedLineHunks, self.c.Git().Blame.BlameLineRange)
+	if err != nil {
+		return nil, err
+	}
+
+	result := set.New[string]()
+	for _, hashes := range hashesPerHunk {
+		result.Add(hashes.ToSlice()...)
+	}
+
+	return result.ToSlice(), nil
+}
+
+// Returns, for each of the given hunks, the hashes of the commits that
+// introduced the lines that it deletes
+func (self *FixupHelper) blameHunks(
+	deletedLineHunks []*hunk,
+	blameLineRange func(filename string, commit string, firstLine int, numLines int) (string, error),
+) ([]*set.Set[string], error) {
+	result := make([]*set.Set[string], len(deletedLineHunks))
 	errg := errgroup.Group{}
-	hashChan := make(chan string)
+	// There can be many hunks, and each blame is a git process of its own
+	errg.SetLimit(runtime.NumCPU())
 
-	for _, h := range deletedLineHunks {
+	for i, h := range deletedLineHunks {
 		errg.Go(func() error {
-			blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, h.numLines)
+			blameOutput, err := blameLineRange(h.filename, "HEAD", h.startLineIdx, h.numLines)
 			if err != nil {
 				return err
 			}
-			blameLines := strings.SplitSeq(strings.TrimSuffix(blameOutput, "\n"), "\n")
-			for line := range blameLines {
-				hashChan <- strings.Split(line, " ")[0]
+			hashes := set.New[string]()
+			for line := range strings.SplitSeq(strings.TrimSuffix(blameOutput, "\n"), "\n") {
+				hashes.Add(strings.Split(line, " ")[0])
 			}
+			result[i] = hashes
 			return nil
 		})
 	}
 
-	go func() {
-		// We don't care about the error here, we'll check it later (in the
-		// return statement below). Here we only wait for all the goroutines to
-		// finish so that we can close the channel.
-		_ = errg.Wait()
-		close(hashChan)
-	}()
-
-	result := set.New[string]()
-	for hash := range hashChan {
-		result.Add(hash)
-	}
-
-	return result.ToSlice(), errg.Wait()
+	return result, errg.Wait()
 }
 
 func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunks []*hunk) ([]string, error) {
@@ -339,3 +339,89 @@ func TestFixupHelper_removeFixupCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestFixupHelper_absorbPatch(t *testing.T) {
+	diff := `diff --git a/file1 b/file1
+old mode 100644
+new mode 100755
+index 1234567..89abcde
+--- a/file1
++++ b/file1
+@@ -2 +2 @@
+-two
++TWO
+@@ -5,2 +4,0 @@
+-five
+-six
+@@ -10 +8,2 @@
+-ten
++TEN
++TEN AND A HALF
+diff --git a/file2 b/file2
+index 1234567..89abcde 100644
+--- a/file2
++++ b/file2
+@@ -1 +1 @@
+-one
++ONE
+`
+
+	scenarios := []struct {
+		name     string
+		hunks    []*hunk
+		expected string
+	}{
+		{
+			name:     "no hunks",
+			hunks:    []*hunk{},
+			expected: "",
+		},
+		{
+			name: "some hunks of one file",
+			hunks: []*hunk{
+				{filename: "file1", startLineIdx: 5, numLines: 2},
+				{filename: "file1", startLineIdx: 10, numLines: 1},
+			},
+			expected: `diff --git a/file1 b/file1
+index 1234567..89abcde
+--- a/file1
++++ b/file1
+@@ -5,2 +4,0 @@
+-five
+-six
+@@ -10,1 +8,2 @@
+-ten
++TEN
++TEN AND A HALF
+`,
+		},
+		{
+			name: "hunks of several files",
+			hunks: []*hunk{
+				{filename: "file1", startLineIdx: 2, numLines: 1},
+				{filename: "file2", startLineIdx: 1, numLines: 1},
+			},
+			expected: `diff --git a/file1 b/file1
+index 1234567..89abcde
+--- a/file1
++++ b/file1
+@@ -2,1 +2 @@
+-two
++TWO
+diff --git a/file2 b/file2
+index 1234567..89abcde 100644
+--- a/file2
++++ b/file2
+@@ -1,1 +1 @@
+-one
++ONE
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, absorbPatch(diff, s.hunks))
+		})
+	}
+}
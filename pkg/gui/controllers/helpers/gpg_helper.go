@@ -25,6 +25,17 @@ func NewGpgHelper(c *HelperCommon) *GpgHelper {
 // we don't need to see a loading status if we're in a subprocess.
 func (self *GpgHelper) WithGpgHandling(cmdObj *oscommands.CmdObj, configKey git_commands.GpgConfigKey, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
 	useSubprocess := self.c.Git().Config.NeedsGpgSubprocess(configKey)
+	return self.withGpgHandling(cmdObj, useSubprocess, waitingStatus, onSuccess, refreshScope)
+}
+
+// Like WithGpgHandling, for a new commit whose signing the user may have
+// toggled
+func (self *GpgHelper) WithNewCommitGpgHandling(cmdObj *oscommands.CmdObj, signing git_commands.CommitSigning, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
+	useSubprocess := self.c.Git().Config.NeedsGpgSubprocessForNewCommit(signing)
+	return self.withGpgHandling(cmdObj, useSubprocess, waitingStatus, onSuccess, refreshScope)
+}
+
+func (self *GpgHelper) withGpgHandling(cmdObj *oscommands.CmdObj, useSubprocess bool, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
 	if useSubprocess {
 		success, err := self.c.RunSubprocess(cmdObj)
 		if success && onSuccess != nil {
@@ -50,9 +50,16 @@ type Helpers struct {
 	AppStatus         *AppStatusHelper
 	InlineStatus      *InlineStatusHelper
 	WindowArrangement *WindowArrangementHelper
+	PanelResize       *PanelResizeHelper
+	Bookmarks         *BookmarksHelper
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	SetupWizard       *SetupWizardHelper
+	Accessibility     *AccessibilityHelper
+	ImagePreview      *ImagePreviewHelper
+	OperationJournal  *OperationJournalHelper
+	ProtectedBranches *ProtectedBranchesHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -87,8 +94,15 @@ func NewStubHelpers() *Helpers {
 		AppStatus:         &AppStatusHelper{},
 		InlineStatus:      &InlineStatusHelper{},
 		WindowArrangement: &WindowArrangementHelper{},
+		PanelResize:       &PanelResizeHelper{},
+		Bookmarks:         &BookmarksHelper{},
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		SetupWizard:       &SetupWizardHelper{},
+		Accessibility:     &AccessibilityHelper{},
+		ImagePreview:      &ImagePreviewHelper{},
+		OperationJournal:  &OperationJournalHelper{},
+		ProtectedBranches: &ProtectedBranchesHelper{},
 	}
 }
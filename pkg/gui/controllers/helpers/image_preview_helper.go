@@ -0,0 +1,113 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/imagepreview"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Git's diff of an image only tells us that the binary files differ, so for
+// images we show a description of the old and new version instead, and draw
+// them as thumbnails if the terminal supports a graphics protocol.
+
+type ImagePreviewHelper struct {
+	c *HelperCommon
+}
+
+func NewImagePreviewHelper(c *HelperCommon) *ImagePreviewHelper {
+	return &ImagePreviewHelper{
+		c: c,
+	}
+}
+
+func (self *ImagePreviewHelper) Protocol() imagepreview.Protocol {
+	return imagepreview.DetectProtocol(self.c.UserConfig().Gui.ImagePreviewProtocol, os.Getenv)
+}
+
+// WorkingTreeTask returns a task comparing the version of the given file at
+// HEAD with the one in the working tree.
+func (self *ImagePreviewHelper) WorkingTreeTask(path string, previousPath string) types.UpdateTask {
+	if previousPath == "" {
+		previousPath = path
+	}
+
+	return types.NewRenderImagePreviewTask("working-tree:"+previousPath+":"+path, func() (string, []types.ImagePlacement) {
+		before := self.contentAtRef("HEAD", previousPath)
+		after, err := os.ReadFile(path)
+		if err != nil {
+			after = nil
+		}
+
+		return self.render(before, after)
+	})
+}
+
+// RefsTask returns a task comparing the version of the given file at the two
+// refs.
+func (self *ImagePreviewHelper) RefsTask(from string, to string, reverse bool, path string) types.UpdateTask {
+	key := fmt.Sprintf("refs:%s:%s:%t:%s", from, to, reverse, path)
+	return types.NewRenderImagePreviewTask(key, func() (string, []types.ImagePlacement) {
+		before := self.contentAtRef(from, path)
+		after := self.contentAtRef(to, path)
+		if reverse {
+			before, after = after, before
+		}
+
+		return self.render(before, after)
+	})
+}
+
+// Returns nil if the file doesn't exist at the given ref
+func (self *ImagePreviewHelper) contentAtRef(ref string, path string) []byte {
+	output, err := self.c.Git().Commit.ShowFileContentCmdObj(ref, path).RunWithOutput()
+	if err != nil {
+		return nil
+	}
+	return []byte(output)
+}
+
+// Returns the text describing the two versions, and where to draw them
+func (self *ImagePreviewHelper) render(before []byte, after []byte) (string, []types.ImagePlacement) {
+	versions := []struct {
+		label   string
+		content []byte
+	}{
+		{self.c.Tr.ImagePreviewBefore, before},
+		{self.c.Tr.ImagePreviewAfter, after},
+	}
+
+	drawImages := self.Protocol() != imagepreview.ProtocolNone
+	view := self.c.Views().Main
+	// Each version takes a line for its description and one for separating it
+	// from the next one; the images share the rest of the view
+	maxRows := (view.InnerHeight() - 2*len(versions)) / len(versions)
+
+	lines := []string{}
+	images := []types.ImagePlacement{}
+	for _, version := range versions {
+		if version.content == nil {
+			lines = append(lines, fmt.Sprintf("%s: %s", version.label, self.c.Tr.ImagePreviewFileDoesNotExist))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s: %s", version.label, imagepreview.Describe(version.content)))
+
+			if width, height, ok := imagepreview.Size(version.content); ok && drawImages {
+				cols, rows := imagepreview.FitToCells(width, height, view.InnerWidth(), maxRows)
+				if rows > 0 {
+					images = append(images, types.ImagePlacement{
+						Content: version.content,
+						Line:    len(lines),
+						Cols:    cols,
+						Rows:    rows,
+					})
+					lines = append(lines, make([]string, rows)...)
+				}
+			}
+		}
+		lines = append(lines, "")
+	}
+
+	return strings.Join(lines, "\n"), images
+}
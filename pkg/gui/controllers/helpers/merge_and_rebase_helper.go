@@ -17,14 +17,17 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c *HelperCommon
+	c                       *HelperCommon
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
 func NewMergeAndRebaseHelper(
 	c *HelperCommon,
+	protectedBranchesHelper *ProtectedBranchesHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c: c,
+		c:                       c,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
@@ -263,6 +266,12 @@ func (self *MergeAndRebaseHelper) PromptToContinueRebase() error {
 }
 
 func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
+	return self.protectedBranchesHelper.GuardCheckedOutBranch(self.c.Tr.ProtectedBranchActionRebase, func() error {
+		return self.rebaseOntoRef(ref)
+	})
+}
+
+func (self *MergeAndRebaseHelper) rebaseOntoRef(ref string) error {
 	checkedOutBranch := self.c.Model().Branches[0]
 	checkedOutBranchName := checkedOutBranch.Name
 	var disabledReason, baseBranchDisabledReason *types.DisabledReason
@@ -515,7 +524,7 @@ func (self *MergeAndRebaseHelper) SquashMergeCommitted(refName, checkedOutBranch
 			"selectedRef":   refName,
 			"currentBranch": checkedOutBranchName,
 		})
-		err = self.c.Git().Commit.CommitCmdObj(message, "", false).Run()
+		err = self.c.Git().Commit.CommitCmdObj(message, "", false, git_commands.CommitSigningDefault).Run()
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -52,10 +53,17 @@ func (self *MergeConflictsHelper) resetMergeState() {
 }
 
 func (self *MergeConflictsHelper) EscapeMerge() error {
+	path := self.context().GetState().GetPath()
 	self.resetMergeState()
 
 	// doing this in separate UI thread so that we're not still holding the lock by the time refresh the file
 	self.c.OnUIThread(func() error {
+		// If git started us as its mergetool, we're done once the file it gave
+		// us has no conflicts left
+		if self.c.Modes().Tool.IsMergetool() && path == self.c.Modes().Tool.GetMergedPath() {
+			return gocui.ErrQuit
+		}
+
 		// There is a race condition here: refreshing the files scope can trigger the
 		// confirmation context to be pushed if all conflicts are resolved (prompting
 		// to continue the merge/rebase. In that case, we don't want to then push the
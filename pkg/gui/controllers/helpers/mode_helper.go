@@ -2,8 +2,11 @@ package helpers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
@@ -47,6 +50,21 @@ type ModeStatus struct {
 
 func (self *ModeHelper) Statuses() []ModeStatus {
 	return []ModeStatus{
+		// This comes first so that escaping quits lazygit rather than e.g.
+		// aborting the merge that git's mergetool is resolving conflicts for
+		{
+			IsActive: self.c.Modes().Tool.Active,
+			InfoLabel: func() string {
+				label := lo.Ternary(self.c.Modes().Tool.IsDifftool(), self.c.Tr.RunningAsDifftool, self.c.Tr.RunningAsMergetool)
+				return self.withResetButton(label, style.FgMagenta)
+			},
+			CancelLabel: func() string {
+				return self.c.Tr.Quit
+			},
+			Reset: func() error {
+				return gocui.ErrQuit
+			},
+		},
 		{
 			IsActive: self.c.Modes().Diffing.Active,
 			InfoLabel: func() string {
@@ -77,15 +95,7 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 		{
 			IsActive: self.c.Modes().Filtering.Active,
 			InfoLabel: func() string {
-				filterContent := lo.Ternary(self.c.Modes().Filtering.GetPath() != "", self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor())
-				return self.withResetButton(
-					fmt.Sprintf(
-						"%s '%s'",
-						self.c.Tr.FilteringBy,
-						filterContent,
-					),
-					style.FgRed,
-				)
+				return self.withResetButton(self.filteringLabel(), style.FgRed)
 			},
 			CancelLabel: func() string {
 				return self.c.Tr.ExitFilterMode
@@ -109,17 +119,11 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 			IsActive: self.c.Modes().CherryPicking.Active,
 			InfoLabel: func() string {
 				copiedCount := len(self.c.Modes().CherryPicking.CherryPickedCommits)
-				text := self.c.Tr.CommitsCopied
-				if copiedCount == 1 {
-					text = self.c.Tr.CommitCopied
-				}
 
 				return self.withResetButton(
-					fmt.Sprintf(
-						"%d %s",
-						copiedCount,
-						text,
-					),
+					self.c.Tr.FormatMessage(self.c.Tr.NumCommitsCopied, map[string]string{
+						"count": strconv.Itoa(copiedCount),
+					}),
 					style.FgCyan,
 				)
 			},
@@ -155,6 +159,23 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 			},
 			Reset: self.bisectHelper.Reset,
 		},
+		{
+			IsActive: func() bool {
+				return self.c.Model().NextCommitSigning != git_commands.CommitSigningDefault
+			},
+			InfoLabel: func() string {
+				label := lo.Ternary(self.c.Model().NextCommitSigning == git_commands.CommitSigningOn,
+					self.c.Tr.NextCommitWillBeSigned, self.c.Tr.NextCommitWillNotBeSigned)
+				return self.withResetButton(label, style.FgGreen)
+			},
+			CancelLabel: func() string {
+				return self.c.Tr.ResetCommitSigning
+			},
+			Reset: func() error {
+				self.c.Model().NextCommitSigning = git_commands.CommitSigningDefault
+				return nil
+			},
+		},
 	}
 }
 
@@ -221,3 +242,23 @@ func ScopesToRefreshWhenFilteringModeChanges() []types.RefreshableView {
 func (self *ModeHelper) SetSuppressRebasingMode(value bool) {
 	self.suppressRebasingMode = value
 }
+
+func (self *ModeHelper) filteringLabel() string {
+	filtering := self.c.Modes().Filtering
+	filterContent := lo.Ternary(filtering.GetPath() != "", filtering.GetPath(), filtering.GetAuthor())
+	label := lo.Ternary(filterContent != "",
+		fmt.Sprintf("%s '%s'", self.c.Tr.FilteringBy, filterContent),
+		self.c.Tr.FilteringCommits)
+	if filterContent == "" && filtering.GetContent() != "" {
+		label = fmt.Sprintf("%s '%s'",
+			lo.Ternary(filtering.ContentIsRegex(), self.c.Tr.FilteringByRegex, self.c.Tr.FilteringByContent),
+			filtering.GetContent())
+	}
+	if filtering.GetSince() != "" {
+		label += fmt.Sprintf(" %s '%s'", self.c.Tr.FilteringSince, filtering.GetSince())
+	}
+	if filtering.GetUntil() != "" {
+		label += fmt.Sprintf(" %s '%s'", self.c.Tr.FilteringUntil, filtering.GetUntil())
+	}
+	return label
+}
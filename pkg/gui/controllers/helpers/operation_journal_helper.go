@@ -0,0 +1,243 @@
+package helpers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// OperationJournalHelper records actions that don't show up in the reflog so
+// that they can be undone and redone alongside the reflog-based actions.
+type OperationJournalHelper struct {
+	c *HelperCommon
+}
+
+func NewOperationJournalHelper(c *HelperCommon) *OperationJournalHelper {
+	return &OperationJournalHelper{
+		c: c,
+	}
+}
+
+func (self *OperationJournalHelper) journal() *types.OperationJournal {
+	return self.c.State().GetRepoState().GetOperationJournal()
+}
+
+func (self *OperationJournalHelper) record(description string, undo func() error, redo func() error) {
+	self.journal().Record(&types.JournalEntry{
+		Description: description,
+		Undo:        undo,
+		Redo:        redo,
+		ReflogTop:   self.ReflogTop(),
+	})
+}
+
+// ReflogTop returns an identifier for the most recent reflog entry
+func (self *OperationJournalHelper) ReflogTop() string {
+	reflogCommits := self.c.Model().ReflogCommits
+	if len(reflogCommits) == 0 {
+		return ""
+	}
+	return reflogEntryIdentity(reflogCommits[0])
+}
+
+// ReflogIndex returns the index in the reflog of the entry identified by the
+// given reflogTop, or the length of the reflog if it can't be found (e.g.
+// because the reflog was empty at the time).
+func (self *OperationJournalHelper) ReflogIndex(reflogTop string) int {
+	reflogCommits := self.c.Model().ReflogCommits
+	_, index, found := lo.FindIndexOf(reflogCommits, func(commit *models.Commit) bool {
+		return reflogEntryIdentity(commit) == reflogTop
+	})
+	if !found {
+		return len(reflogCommits)
+	}
+	return index
+}
+
+func reflogEntryIdentity(commit *models.Commit) string {
+	return fmt.Sprintf("%s %d %s", commit.Hash(), commit.UnixTimestamp, commit.Name)
+}
+
+// RecordBranchDeletion lets the deletion of the given local branches be
+// undone by recreating them at the commit they pointed to. Pass
+// restoreUpstream=false if the upstream branches were deleted too.
+func (self *OperationJournalHelper) RecordBranchDeletion(branches []*models.Branch, restoreUpstream bool) {
+	branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+
+	undo := func() error {
+		for _, branch := range branches {
+			if err := self.c.Git().Branch.NewWithoutCheckout(branch.Name, branch.CommitHash); err != nil {
+				return err
+			}
+			if restoreUpstream && branch.UpstreamRemote != "" && branch.UpstreamBranch != "" {
+				if err := self.c.Git().Branch.SetUpstream(branch.UpstreamRemote, branch.UpstreamBranch, branch.Name); err != nil {
+					return err
+				}
+			}
+		}
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+		return nil
+	}
+
+	redo := func() error {
+		if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
+			return err
+		}
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+		return nil
+	}
+
+	self.record(fmt.Sprintf(self.c.Tr.JournalDeleteBranch, strings.Join(branchNames, ", ")), undo, redo)
+}
+
+// RecordStashDrop lets the dropping of the given stash entries be undone by
+// storing their commits again. Restored entries end up at the top of the stash.
+func (self *OperationJournalHelper) RecordStashDrop(stashEntries []*models.StashEntry) {
+	if len(stashEntries) == 0 {
+		return
+	}
+
+	undo := func() error {
+		// Store the oldest entry first so that the entries keep their relative order
+		for i := len(stashEntries) - 1; i >= 0; i-- {
+			if err := self.c.Git().Stash.Store(stashEntries[i].Hash, stashEntries[i].Name); err != nil {
+				return err
+			}
+		}
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH}})
+		return nil
+	}
+
+	redo := func() error {
+		for _, stashEntry := range stashEntries {
+			index, found := self.stashIndexForHash(stashEntry.Hash)
+			if !found {
+				continue
+			}
+			if err := self.c.Git().Stash.Drop(index); err != nil {
+				return err
+			}
+		}
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH}})
+		return nil
+	}
+
+	self.record(fmt.Sprintf(self.c.Tr.JournalDropStash, stashEntries[0].Name), undo, redo)
+}
+
+func (self *OperationJournalHelper) stashIndexForHash(hash string) (int, bool) {
+	for i := range self.c.Model().StashEntries {
+		if entryHash, err := self.c.Git().Stash.Hash(i); err == nil && entryHash == hash {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// RecordIndexChange runs f, which is expected to only modify the index (e.g.
+// staging or unstaging files), and records it so that it can be undone by
+// restoring a copy of the index from before. Nothing is recorded while there
+// are merge conflicts, since a conflicted index can't be written to a tree,
+// which we need for checking that the index hasn't changed in the meantime.
+func (self *OperationJournalHelper) RecordIndexChange(description string, f func() error) error {
+	if lo.SomeBy(self.c.Model().Files, func(file *models.File) bool { return file.HasMergeConflicts }) {
+		return f()
+	}
+
+	before, beforeErr := self.indexCopy()
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	if beforeErr != nil {
+		self.c.Log.Error(beforeErr)
+		return nil
+	}
+	after, err := self.indexCopy()
+	if err != nil {
+		self.c.Log.Error(err)
+		return nil
+	}
+	if after == before {
+		return nil
+	}
+
+	restore := func(expected string, target string) func() error {
+		return func() error {
+			// Compare trees rather than index files, because git rewrites
+			// the index just to update the cached stat info of files, e.g.
+			// when we refresh the files panel
+			current, err := self.c.Git().WorkingTree.WriteTree()
+			if err != nil {
+				return err
+			}
+			expectedTree, err := self.c.Git().WorkingTree.WriteTreeOfIndexCopy(expected)
+			if err != nil {
+				return err
+			}
+			if current != expectedTree {
+				return fmt.Errorf(self.c.Tr.JournalIndexChanged, description)
+			}
+			if err := self.c.Git().WorkingTree.RestoreIndexCopy(target); err != nil {
+				return err
+			}
+			self.rememberIndexCopy(target)
+			self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+			return nil
+		}
+	}
+
+	self.record(description, restore(after, before), restore(before, after))
+	return nil
+}
+
+// Returns the path of a copy of the current index file. When staging several
+// things in a row, this is usually the copy that we saved after the previous
+// change, so we can save copying the file again.
+func (self *OperationJournalHelper) indexCopy() (string, error) {
+	if snapshot := self.journal().IndexSnapshot(); snapshot != nil {
+		if fileInfo, err := os.Stat(self.indexPath()); err == nil && isSameIndexFile(snapshot.FileInfo, fileInfo) {
+			return snapshot.CopyPath, nil
+		}
+	}
+
+	fileInfo, err := os.Stat(self.indexPath())
+	if err != nil {
+		return "", err
+	}
+	copyPath, err := self.c.Git().WorkingTree.SaveIndexCopy()
+	if err != nil {
+		return "", err
+	}
+
+	self.journal().SetIndexSnapshot(&types.IndexSnapshot{CopyPath: copyPath, FileInfo: fileInfo})
+	return copyPath, nil
+}
+
+// Must be called right after the index was restored from the given copy
+func (self *OperationJournalHelper) rememberIndexCopy(copyPath string) {
+	fileInfo, err := os.Stat(self.indexPath())
+	if err != nil {
+		self.journal().SetIndexSnapshot(nil)
+		return
+	}
+
+	self.journal().SetIndexSnapshot(&types.IndexSnapshot{CopyPath: copyPath, FileInfo: fileInfo})
+}
+
+func (self *OperationJournalHelper) indexPath() string {
+	return filepath.Join(self.c.Git().RepoPaths.WorktreeGitDirPath(), "index")
+}
+
+// git writes the index by replacing the file, so any change gives us a
+// different file; the modification time and size are for file systems where
+// we can't tell files apart.
+func isSameIndexFile(a os.FileInfo, b os.FileInfo) bool {
+	return os.SameFile(a, b) && a.ModTime().Equal(b.ModTime()) && a.Size() == b.Size()
+}
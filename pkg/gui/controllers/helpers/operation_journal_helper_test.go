@@ -0,0 +1,30 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSameIndexFile(t *testing.T) {
+	dir := t.TempDir()
+	indexPath := filepath.Join(dir, "index")
+	assert.NoError(t, os.WriteFile(indexPath, []byte("index"), 0o644))
+
+	before, err := os.Stat(indexPath)
+	assert.NoError(t, err)
+	unchanged, err := os.Stat(indexPath)
+	assert.NoError(t, err)
+	assert.True(t, isSameIndexFile(before, unchanged))
+
+	// Like git, replace the file with one with the same content
+	lockPath := filepath.Join(dir, "index.lock")
+	assert.NoError(t, os.WriteFile(lockPath, []byte("index"), 0o644))
+	assert.NoError(t, os.Rename(lockPath, indexPath))
+
+	replaced, err := os.Stat(indexPath)
+	assert.NoError(t, err)
+	assert.False(t, isSameIndexFile(before, replaced))
+}
@@ -0,0 +1,242 @@
+package helpers
+
+import (
+	"math"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Panels can be resized by dragging the border between the side panels and
+// the main view, or the border between the two halves of a split main view
+// (e.g. in the staging view), with the mouse. The sizes are stored per repo in
+// the app state; see WindowHelper.PanelSizes.
+
+const (
+	PanelSizeStep = 0.05
+	MinPanelSize  = 0.1
+	MaxPanelSize  = 0.9
+)
+
+type panelBorder int
+
+const (
+	noPanelBorder panelBorder = iota
+	sidePanelBorder
+	mainViewSplitBorder
+)
+
+type rect struct {
+	x0, y0, x1, y1 int
+}
+
+type PanelResizeHelper struct {
+	c            *HelperCommon
+	windowHelper *WindowHelper
+
+	// The border that is currently being dragged
+	draggedBorder panelBorder
+}
+
+func NewPanelResizeHelper(c *HelperCommon, windowHelper *WindowHelper) *PanelResizeHelper {
+	return &PanelResizeHelper{
+		c:            c,
+		windowHelper: windowHelper,
+	}
+}
+
+// HandleMouseEvent is called for all mouse events before they are dispatched
+// to views. Pressing the left mouse button on a panel border starts dragging
+// it, and subsequent motion events resize the panels until the button is
+// released.
+func (self *PanelResizeHelper) HandleMouseEvent(x int, y int, key gocui.Key, mod gocui.Modifier) (bool, error) {
+	if key == gocui.MouseRelease {
+		// gocui reports the first motion of a drag as a release, so we can't
+		// stop dragging here. This is fine because every drag starts with a
+		// press, which determines the border anew.
+		return self.draggedBorder != noPanelBorder, nil
+	}
+
+	if key != gocui.MouseLeft {
+		self.draggedBorder = noPanelBorder
+		return false, nil
+	}
+
+	if mod&gocui.ModMotion == 0 {
+		self.draggedBorder = self.borderAt(x, y)
+		return self.draggedBorder != noPanelBorder, nil
+	}
+
+	switch self.draggedBorder {
+	case sidePanelBorder:
+		width, _ := self.c.GocuiGui().Size()
+		self.SetSidePanelWidth(sidePanelWidthForDrag(x, width, self.mainSectionRect()))
+		return true, nil
+	case mainViewSplitBorder:
+		self.SetMainViewSplit(mainViewSplitForDrag(x, y, self.mainRect(), self.secondaryRect()))
+		return true, nil
+	case noPanelBorder:
+	}
+
+	return false, nil
+}
+
+func (self *PanelResizeHelper) borderAt(x, y int) panelBorder {
+	if kind := self.c.Context().Current().GetKind(); kind == types.PERSISTENT_POPUP || kind == types.TEMPORARY_POPUP {
+		return noPanelBorder
+	}
+
+	width, _ := self.c.GocuiGui().Size()
+	return panelBorderAt(x, y, width, self.c.State().GetRepoState().GetScreenMode(),
+		self.mainRect(), self.secondaryRect())
+}
+
+func (self *PanelResizeHelper) mainRect() rect {
+	return viewRect(self.c.Views().Main)
+}
+
+// Returns nil if the main view is not split
+func (self *PanelResizeHelper) secondaryRect() *rect {
+	if !self.c.Views().Secondary.Visible {
+		return nil
+	}
+
+	result := viewRect(self.c.Views().Secondary)
+	return &result
+}
+
+func (self *PanelResizeHelper) mainSectionRect() rect {
+	return mainSectionRect(self.mainRect(), self.secondaryRect())
+}
+
+func viewRect(view *gocui.View) rect {
+	x0, y0, x1, y1 := view.Dimensions()
+	return rect{x0: x0, y0: y0, x1: x1, y1: y1}
+}
+
+func mainSectionRect(main rect, secondary *rect) rect {
+	if secondary == nil {
+		return main
+	}
+
+	return rect{
+		x0: min(main.x0, secondary.x0),
+		y0: min(main.y0, secondary.y0),
+		x1: max(main.x1, secondary.x1),
+		y1: max(main.y1, secondary.y1),
+	}
+}
+
+func panelBorderAt(x, y int, screenWidth int, screenMode types.ScreenMode, main rect, secondary *rect) panelBorder {
+	if secondary != nil {
+		if secondary.x0 > main.x1 {
+			// side by side
+			if (x == main.x1 || x == secondary.x0) && y >= main.y0 && y <= main.y1 {
+				return mainViewSplitBorder
+			}
+		} else if (y == main.y1 || y == secondary.y0) && x >= main.x0 && x <= main.x1 {
+			return mainViewSplitBorder
+		}
+	}
+
+	// In portrait mode the side panels are above the main view; we only
+	// support resizing them horizontally. In half and full screen mode the
+	// side panel width doesn't apply.
+	section := mainSectionRect(main, secondary)
+	if screenMode != types.SCREEN_NORMAL || section.y0 != 0 {
+		return noPanelBorder
+	}
+
+	if y < section.y0 || y > section.y1 {
+		return noPanelBorder
+	}
+
+	if section.x0 > 0 && (x == section.x0 || x == section.x0-1) {
+		return sidePanelBorder
+	}
+
+	if section.x1 < screenWidth-1 && (x == section.x1 || x == section.x1+1) {
+		return sidePanelBorder
+	}
+
+	return noPanelBorder
+}
+
+func sidePanelWidthForDrag(x int, screenWidth int, mainSection rect) float64 {
+	if screenWidth <= 0 {
+		return 0
+	}
+
+	// If the main section is on the left, the side panels are on the right
+	if mainSection.x0 == 0 {
+		return float64(screenWidth-x-1) / float64(screenWidth)
+	}
+
+	return float64(x) / float64(screenWidth)
+}
+
+func mainViewSplitForDrag(x, y int, main rect, secondary *rect) float64 {
+	if secondary == nil {
+		return 0
+	}
+
+	section := mainSectionRect(main, secondary)
+	if secondary.x0 > main.x1 {
+		return float64(x-section.x0+1) / float64(section.x1-section.x0+1)
+	}
+
+	return float64(y-section.y0+1) / float64(section.y1-section.y0+1)
+}
+
+func clampPanelSize(size float64) float64 {
+	// Round to avoid accumulating floating point noise in the state file
+	return math.Round(min(max(size, MinPanelSize), MaxPanelSize)*100) / 100
+}
+
+// Returns the fraction of the screen currently taken up by the side panels,
+// converting a fixed width from the config into a fraction if necessary.
+func (self *PanelResizeHelper) SidePanelWidth() float64 {
+	appState := self.c.GetAppState()
+	userConfig := self.c.UserConfig()
+	panelSizes := self.windowHelper.PanelSizes()
+	if panelSizes.SidePanelWidth == 0 && appState.SidePanelWidth == 0 && userConfig.Gui.SidePanelFixedWidth > 0 {
+		screenWidth, _ := self.c.GocuiGui().Size()
+		if screenWidth > 0 {
+			return float64(userConfig.Gui.SidePanelFixedWidth) / float64(screenWidth)
+		}
+	}
+
+	return GetSidePanelWidth(userConfig, appState, panelSizes)
+}
+
+func (self *PanelResizeHelper) SetSidePanelWidth(width float64) {
+	panelSizes := self.windowHelper.PanelSizes()
+	width = clampPanelSize(width)
+	if panelSizes.SidePanelWidth == width {
+		return
+	}
+
+	panelSizes.SidePanelWidth = width
+	self.windowHelper.SetPanelSizes(panelSizes)
+}
+
+// Returns the fraction of the main section taken up by the main view when it
+// is split
+func (self *PanelResizeHelper) MainViewSplit() float64 {
+	if split := self.windowHelper.PanelSizes().MainViewSplit; split > 0 {
+		return split
+	}
+
+	return 0.5
+}
+
+func (self *PanelResizeHelper) SetMainViewSplit(split float64) {
+	panelSizes := self.windowHelper.PanelSizes()
+	split = clampPanelSize(split)
+	if panelSizes.MainViewSplit == split {
+		return
+	}
+
+	panelSizes.MainViewSplit = split
+	self.windowHelper.SetPanelSizes(panelSizes)
+}
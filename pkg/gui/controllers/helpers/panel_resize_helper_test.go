@@ -0,0 +1,132 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanelBorderAt(t *testing.T) {
+	// A 100x30 screen with the side panels taking up the first 30 columns
+	main := rect{x0: 30, y0: 0, x1: 99, y1: 28}
+	stackedMain := rect{x0: 30, y0: 0, x1: 99, y1: 9}
+	stackedSecondary := &rect{x0: 30, y0: 10, x1: 99, y1: 28}
+	sideBySideMain := rect{x0: 30, y0: 0, x1: 59, y1: 28}
+	sideBySideSecondary := &rect{x0: 60, y0: 0, x1: 99, y1: 28}
+
+	scenarios := []struct {
+		name       string
+		x, y       int
+		screenMode types.ScreenMode
+		main       rect
+		secondary  *rect
+		expected   panelBorder
+	}{
+		{
+			name:     "right edge of the side panels",
+			x:        29,
+			y:        5,
+			main:     main,
+			expected: sidePanelBorder,
+		},
+		{
+			name:     "left edge of the main view",
+			x:        30,
+			y:        5,
+			main:     main,
+			expected: sidePanelBorder,
+		},
+		{
+			name:     "inside the main view",
+			x:        31,
+			y:        5,
+			main:     main,
+			expected: noPanelBorder,
+		},
+		{
+			name:     "below the main view",
+			x:        30,
+			y:        29,
+			main:     main,
+			expected: noPanelBorder,
+		},
+		{
+			name:     "main view on the left",
+			x:        70,
+			y:        5,
+			main:     rect{x0: 0, y0: 0, x1: 69, y1: 28},
+			expected: sidePanelBorder,
+		},
+		{
+			name:       "half screen mode",
+			x:          30,
+			y:          5,
+			screenMode: types.SCREEN_HALF,
+			main:       main,
+			expected:   noPanelBorder,
+		},
+		{
+			name:     "portrait mode",
+			x:        0,
+			y:        15,
+			main:     rect{x0: 0, y0: 15, x1: 99, y1: 28},
+			expected: noPanelBorder,
+		},
+		{
+			name:      "bottom edge of the stacked main view",
+			x:         50,
+			y:         9,
+			main:      stackedMain,
+			secondary: stackedSecondary,
+			expected:  mainViewSplitBorder,
+		},
+		{
+			name:      "top edge of the stacked secondary view",
+			x:         50,
+			y:         10,
+			main:      stackedMain,
+			secondary: stackedSecondary,
+			expected:  mainViewSplitBorder,
+		},
+		{
+			name:      "border between side by side main views",
+			x:         60,
+			y:         5,
+			main:      sideBySideMain,
+			secondary: sideBySideSecondary,
+			expected:  mainViewSplitBorder,
+		},
+		{
+			name:      "side panel border with split main view",
+			x:         30,
+			y:         20,
+			main:      stackedMain,
+			secondary: stackedSecondary,
+			expected:  sidePanelBorder,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, panelBorderAt(s.x, s.y, 100, s.screenMode, s.main, s.secondary))
+		})
+	}
+}
+
+func TestPanelSizesForDrag(t *testing.T) {
+	assert.InDelta(t, 0.4, sidePanelWidthForDrag(40, 100, rect{x0: 30, y0: 0, x1: 99, y1: 28}), 0.001)
+	assert.InDelta(t, 0.4, sidePanelWidthForDrag(59, 100, rect{x0: 0, y0: 0, x1: 69, y1: 28}), 0.001)
+
+	stackedMain := rect{x0: 30, y0: 0, x1: 99, y1: 9}
+	stackedSecondary := &rect{x0: 30, y0: 10, x1: 99, y1: 19}
+	assert.InDelta(t, 0.75, mainViewSplitForDrag(50, 14, stackedMain, stackedSecondary), 0.001)
+
+	sideBySideMain := rect{x0: 20, y0: 0, x1: 59, y1: 28}
+	sideBySideSecondary := &rect{x0: 60, y0: 0, x1: 99, y1: 28}
+	assert.InDelta(t, 0.25, mainViewSplitForDrag(39, 5, sideBySideMain, sideBySideSecondary), 0.001)
+
+	assert.Equal(t, MinPanelSize, clampPanelSize(0.01))
+	assert.Equal(t, MaxPanelSize, clampPanelSize(1.5))
+	assert.Equal(t, 0.33, clampPanelSize(0.3333))
+}
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Guards the destructive actions (force-pushing, hard-resetting, deleting and
+// rebasing) on the branches that match git.protectedBranches.patterns. All
+// these actions go through Guard, so that the protection is the same no matter
+// where the action was triggered from.
+type ProtectedBranchesHelper struct {
+	c *HelperCommon
+}
+
+func NewProtectedBranchesHelper(c *HelperCommon) *ProtectedBranchesHelper {
+	return &ProtectedBranchesHelper{
+		c: c,
+	}
+}
+
+// Calls f, unless one of the given branches is protected. In that case we
+// either ask the user to confirm first, or refuse with an error, depending on
+// git.protectedBranches.mode. action describes what f does, for the prompt or
+// error message (e.g. "force-push").
+func (self *ProtectedBranchesHelper) Guard(branchNames []string, action string, f func() error) error {
+	config := self.c.UserConfig().Git.ProtectedBranches
+	protectedBranchNames := lo.Filter(branchNames, func(name string, _ int) bool {
+		return config.IsProtected(name)
+	})
+	if len(protectedBranchNames) == 0 {
+		return f()
+	}
+
+	arguments := map[string]string{
+		"count":    strconv.Itoa(len(protectedBranchNames)),
+		"branches": strings.Join(protectedBranchNames, ", "),
+		"action":   action,
+	}
+
+	if config.Mode == "block" {
+		return errors.New(self.c.Tr.FormatMessage(self.c.Tr.ProtectedBranchBlocked, arguments))
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title:         self.c.Tr.ProtectedBranchTitle,
+		Prompt:        self.c.Tr.FormatMessage(self.c.Tr.ProtectedBranchConfirm, arguments),
+		HandleConfirm: f,
+	})
+	return nil
+}
+
+// Like Guard, for the branch that is currently checked out. Does nothing
+// special if we're in detached head state.
+func (self *ProtectedBranchesHelper) GuardCheckedOutBranch(action string, f func() error) error {
+	checkedOutBranch := self.c.Model().CheckedOutBranch
+	if checkedOutBranch == "" {
+		return f()
+	}
+
+	return self.Guard([]string{checkedOutBranch}, action, f)
+}
@@ -1,7 +1,9 @@
 package helpers
 
 import (
+	goContext "context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
@@ -36,8 +39,25 @@ type RefreshHelper struct {
 	// Keyed by repo path so that switching to a different repo while lazygit is running
 	// still triggers the prompt there.
 	githubBaseRemotePromptDismissed map[string]bool
+
+	scopeRefreshers map[types.RefreshableView]*scopeRefresher
+
+	// Async refreshes requested within asyncRefreshDebounceWindow of each other
+	// are merged into this one; see debounceAsyncRefresh
+	pendingAsyncRefresh      *types.RefreshOptions
+	pendingAsyncRefreshMutex sync.Mutex
 }
 
+// Operations often trigger several async refreshes in quick succession (and
+// so do scripts that keep changing the repo while lazygit is running); waiting
+// this long before starting an async refresh lets us run them as one, rather
+// than loading and re-rendering the same views over and over again.
+const asyncRefreshDebounceWindow = 50 * time.Millisecond
+
+// If loading the branches (including their ahead/behind counts) takes longer
+// than this, we offer to write a commit-graph
+const slowBranchLoadThreshold = 2 * time.Second
+
 func NewRefreshHelper(
 	c *HelperCommon,
 	refsHelper *RefsHelper,
@@ -57,6 +77,20 @@ func NewRefreshHelper(
 		mergeConflictsHelper: mergeConflictsHelper,
 		worktreeHelper:       worktreeHelper,
 		searchHelper:         searchHelper,
+		scopeRefreshers: lo.SliceToMap(
+			[]types.RefreshableView{
+				types.COMMITS,
+				types.BRANCHES,
+				types.FILES,
+				types.STASH,
+				types.REFLOG,
+				types.TAGS,
+				types.REMOTES,
+				types.WORKTREES,
+			},
+			func(scope types.RefreshableView) (types.RefreshableView, *scopeRefresher) {
+				return scope, newScopeRefresher()
+			}),
 	}
 }
 
@@ -65,6 +99,60 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 		panic("RefreshOptions.Then doesn't work with mode ASYNC")
 	}
 
+	// if we're in a demo we don't want any async refreshes because everything
+	// happens fast and it's better to have everything update in the one frame
+	if options.Mode == types.ASYNC && !self.c.InDemo() {
+		self.debounceAsyncRefresh(options)
+		return
+	}
+
+	self.refreshNow(options)
+}
+
+func (self *RefreshHelper) debounceAsyncRefresh(options types.RefreshOptions) {
+	self.pendingAsyncRefreshMutex.Lock()
+	defer self.pendingAsyncRefreshMutex.Unlock()
+
+	if self.pendingAsyncRefresh != nil {
+		merged := mergeRefreshOptions(*self.pendingAsyncRefresh, options)
+		self.pendingAsyncRefresh = &merged
+		return
+	}
+
+	self.pendingAsyncRefresh = &options
+
+	// Waiting on a worker rather than using a timer, so that integration tests
+	// know that we're busy
+	self.c.OnWorker(func(_ gocui.Task) error {
+		time.Sleep(asyncRefreshDebounceWindow)
+
+		self.pendingAsyncRefreshMutex.Lock()
+		options := *self.pendingAsyncRefresh
+		self.pendingAsyncRefresh = nil
+		self.pendingAsyncRefreshMutex.Unlock()
+
+		self.refreshNow(options)
+		return nil
+	})
+}
+
+// Combines two async refreshes into one that refreshes everything that either
+// of them would have refreshed
+func mergeRefreshOptions(a types.RefreshOptions, b types.RefreshOptions) types.RefreshOptions {
+	var scope []types.RefreshableView
+	// An empty scope means refreshing everything
+	if len(a.Scope) > 0 && len(b.Scope) > 0 {
+		scope = lo.Uniq(append(slices.Clone(a.Scope), b.Scope...))
+	}
+
+	return types.RefreshOptions{
+		Scope:                    scope,
+		Mode:                     types.ASYNC,
+		KeepBranchSelectionIndex: a.KeepBranchSelectionIndex && b.KeepBranchSelectionIndex,
+	}
+}
+
+func (self *RefreshHelper) refreshNow(options types.RefreshOptions) {
 	t := time.Now()
 	defer func() {
 		self.c.Log.Infof("Refresh took %s", time.Since(t))
@@ -106,49 +194,81 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 			scopeSet = set.NewFromSlice(options.Scope)
 		}
 
+		// Branches, remotes and tags are all loaded with a single git call whose
+		// result they share, so we need to make sure it is loaded afresh for
+		// this refresh
+		if scopeSet.Includes(types.COMMITS) || scopeSet.Includes(types.BRANCHES) || scopeSet.Includes(types.REFLOG) ||
+			scopeSet.Includes(types.BISECT_INFO) || scopeSet.Includes(types.TAGS) || scopeSet.Includes(types.REMOTES) {
+			self.c.Git().Loaders.RefsLoader.Invalidate()
+		}
+
 		wg := sync.WaitGroup{}
+		timed := func(name string, f func()) {
+			t := time.Now()
+			f()
+			self.c.Log.Infof("refreshed %s in %s", name, time.Since(t))
+			self.c.Timings.Record(common.TimingRefresh, name, t)
+		}
+
 		refresh := func(name string, f func()) {
 			// if we're in a demo we don't want any async refreshes because
 			// everything happens fast and it's better to have everything update
 			// in the one frame
 			if !self.c.InDemo() && options.Mode == types.ASYNC {
 				self.c.OnWorker(func(t gocui.Task) error {
-					f()
+					timed(name, f)
 					return nil
 				})
 			} else {
 				wg.Add(1)
 				go utils.Safe(func() {
-					t := time.Now()
 					defer wg.Done()
-					f()
-					self.c.Log.Infof("refreshed %s in %s", name, time.Since(t))
+					timed(name, f)
 				})
 			}
 		}
 
+		// Like refresh, but if the scope is already being refreshed, we don't
+		// load it again for every request that comes in meanwhile; see
+		// scopeRefresher
+		refreshScope := func(scope types.RefreshableView, name string, f func(ctx goContext.Context) error) {
+			refresh(name, func() {
+				self.scopeRefreshers[scope].refresh(scopeRefreshArgs{}, func(ctx goContext.Context, _ scopeRefreshArgs) error {
+					return f(ctx)
+				})
+			})
+		}
+
+		branchesArgs := scopeRefreshArgs{
+			keepBranchSelectionIndex: options.KeepBranchSelectionIndex,
+			loadBehindCounts:         true,
+		}
+
 		branchesAndRemotesWg := sync.WaitGroup{}
-		includeWorktreesWithBranches := false
 		if scopeSet.Includes(types.COMMITS) || scopeSet.Includes(types.BRANCHES) || scopeSet.Includes(types.REFLOG) || scopeSet.Includes(types.BISECT_INFO) {
 			// whenever we change commits, we should update branches because the upstream/downstream
 			// counts can change. Whenever we change branches we should also change commits
 			// e.g. in the case of switching branches.
-			refresh("commits and commit files", self.refreshCommitsAndCommitFiles)
+			refreshScope(types.COMMITS, "commits and commit files", self.refreshCommitsAndCommitFiles)
 
-			includeWorktreesWithBranches = scopeSet.Includes(types.WORKTREES)
 			if self.c.UserConfig().Git.LocalBranchSortOrder == "recency" {
+				branchesArgs.loadBehindCounts = self.c.State().GetRepoState().GetStartupStage() == types.COMPLETE
 				branchesAndRemotesWg.Add(1)
 				refresh("reflog and branches", func() {
-					self.refreshReflogAndBranches(includeWorktreesWithBranches, options.KeepBranchSelectionIndex)
+					self.scopeRefreshers[types.BRANCHES].refresh(branchesArgs, func(ctx goContext.Context, args scopeRefreshArgs) error {
+						return self.refreshReflogAndBranches(ctx, args.keepBranchSelectionIndex, args.loadBehindCounts)
+					})
 					branchesAndRemotesWg.Done()
 				})
 			} else {
 				branchesAndRemotesWg.Add(1)
 				refresh("branches", func() {
-					self.refreshBranches(includeWorktreesWithBranches, options.KeepBranchSelectionIndex, true)
+					self.scopeRefreshers[types.BRANCHES].refresh(branchesArgs, func(ctx goContext.Context, args scopeRefreshArgs) error {
+						return self.refreshBranches(ctx, args.keepBranchSelectionIndex, args.loadBehindCounts)
+					})
 					branchesAndRemotesWg.Done()
 				})
-				refresh("reflog", func() { _ = self.refreshReflogCommits() })
+				refreshScope(types.REFLOG, "reflog", self.refreshReflogCommits)
 			}
 		} else if scopeSet.Includes(types.REBASE_COMMITS) {
 			// the above block handles rebase commits so we only need to call this one
@@ -169,23 +289,27 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 		if scopeSet.Includes(types.FILES) || scopeSet.Includes(types.SUBMODULES) {
 			fileWg.Add(1)
 			refresh("files", func() {
-				_ = self.refreshFilesAndSubmodules()
+				self.scopeRefreshers[types.FILES].refresh(scopeRefreshArgs{}, func(ctx goContext.Context, _ scopeRefreshArgs) error {
+					return self.refreshFilesAndSubmodules(ctx)
+				})
 				fileWg.Done()
 			})
 		}
 
 		if scopeSet.Includes(types.STASH) {
-			refresh("stash", func() { self.refreshStashEntries() })
+			refreshScope(types.STASH, "stash", self.refreshStashEntries)
 		}
 
 		if scopeSet.Includes(types.TAGS) {
-			refresh("tags", func() { _ = self.refreshTags() })
+			refreshScope(types.TAGS, "tags", self.refreshTags)
 		}
 
 		if scopeSet.Includes(types.REMOTES) {
 			branchesAndRemotesWg.Add(1)
 			refresh("remotes", func() {
-				_ = self.refreshRemotes()
+				self.scopeRefreshers[types.REMOTES].refresh(scopeRefreshArgs{}, func(ctx goContext.Context, _ scopeRefreshArgs) error {
+					return self.refreshRemotes(ctx)
+				})
 				branchesAndRemotesWg.Done()
 			})
 		}
@@ -193,12 +317,12 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 		if scopeSet.Includes(types.PULL_REQUESTS) {
 			refresh("pull requests", func() {
 				branchesAndRemotesWg.Wait()
-				self.refreshGithubPullRequests()
+				self.refreshPullRequests()
 			})
 		}
 
-		if scopeSet.Includes(types.WORKTREES) && !includeWorktreesWithBranches {
-			refresh("worktrees", func() { self.refreshWorktrees() })
+		if scopeSet.Includes(types.WORKTREES) {
+			refreshScope(types.WORKTREES, "worktrees", self.refreshWorktrees)
 		}
 
 		if scopeSet.Includes(types.STAGING) {
@@ -216,6 +340,11 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 			refresh("merge conflicts", func() { _ = self.mergeConflictsHelper.RefreshMergeState() })
 		}
 
+		if (scopeSet.Includes(types.STATUS) || scopeSet.Includes(types.COMMITS) || scopeSet.Includes(types.TAGS)) &&
+			self.c.UserConfig().Gui.StatusPanelView == "repoOverview" {
+			refresh("repo overview", self.refreshRepoOverview)
+		}
+
 		self.refreshStatus()
 
 		wg.Wait()
@@ -280,33 +409,38 @@ func getModeName(mode types.RefreshMode) string {
 // on startup to sort the branches by recency. So we have two phases: INITIAL, and COMPLETE.
 // In the initial phase we don't get any reflog commits, but we asynchronously get them
 // and refresh the branches after that
-func (self *RefreshHelper) refreshReflogCommitsConsideringStartup() {
+func (self *RefreshHelper) refreshReflogCommitsConsideringStartup(ctx goContext.Context) error {
 	switch self.c.State().GetRepoState().GetStartupStage() {
 	case types.INITIAL:
 		self.c.OnWorker(func(_ gocui.Task) error {
-			_ = self.refreshReflogCommits()
-			self.refreshBranches(false, true, true)
+			_ = self.refreshReflogCommits(goContext.Background())
+			_ = self.refreshBranches(goContext.Background(), true, true)
 			self.c.State().GetRepoState().SetStartupStage(types.COMPLETE)
 			return nil
 		})
 
 	case types.COMPLETE:
-		_ = self.refreshReflogCommits()
+		return self.refreshReflogCommits(ctx)
 	}
-}
 
-func (self *RefreshHelper) refreshReflogAndBranches(refreshWorktrees bool, keepBranchSelectionIndex bool) {
-	loadBehindCounts := self.c.State().GetRepoState().GetStartupStage() == types.COMPLETE
+	return nil
+}
 
-	self.refreshReflogCommitsConsideringStartup()
+func (self *RefreshHelper) refreshReflogAndBranches(ctx goContext.Context, keepBranchSelectionIndex bool, loadBehindCounts bool) error {
+	if err := self.refreshReflogCommitsConsideringStartup(ctx); err != nil && ctx.Err() != nil {
+		return err
+	}
 
-	self.refreshBranches(refreshWorktrees, keepBranchSelectionIndex, loadBehindCounts)
+	return self.refreshBranches(ctx, keepBranchSelectionIndex, loadBehindCounts)
 }
 
-func (self *RefreshHelper) refreshCommitsAndCommitFiles() {
-	_ = self.refreshCommitsWithLimit()
-	ctx := self.c.Contexts().CommitFiles.GetParentContext()
-	if ctx != nil && ctx.GetKey() == context.LOCAL_COMMITS_CONTEXT_KEY {
+func (self *RefreshHelper) refreshCommitsAndCommitFiles(ctx goContext.Context) error {
+	if err := self.refreshCommitsWithLimit(ctx); err != nil && ctx.Err() != nil {
+		return err
+	}
+
+	parentContext := self.c.Contexts().CommitFiles.GetParentContext()
+	if parentContext != nil && parentContext.GetKey() == context.LOCAL_COMMITS_CONTEXT_KEY {
 		// This makes sense when we've e.g. just amended a commit, meaning we get a new commit hash at the same position.
 		// However if we've just added a brand new commit, it pushes the list down by one and so we would end up
 		// showing the contents of a different commit than the one we initially entered.
@@ -320,6 +454,8 @@ func (self *RefreshHelper) refreshCommitsAndCommitFiles() {
 			_ = self.refreshCommitFilesContext()
 		}
 	}
+
+	return nil
 }
 
 func (self *RefreshHelper) determineCheckedOutRef() models.Ref {
@@ -348,28 +484,40 @@ func (self *RefreshHelper) determineCheckedOutRef() models.Ref {
 	return nil
 }
 
-func (self *RefreshHelper) refreshCommitsWithLimit() error {
+func (self *RefreshHelper) refreshCommitsWithLimit(ctx goContext.Context) error {
 	self.c.Mutexes().LocalCommitsMutex.Lock()
 	defer self.c.Mutexes().LocalCommitsMutex.Unlock()
 
 	checkedOutRef := self.determineCheckedOutRef()
+	limit := self.c.Contexts().LocalCommits.GetLimitCommits()
+	progress := &commitsProgress{}
 	commits, err := self.c.Git().Loaders.CommitLoader.GetCommits(
 		git_commands.GetCommitsOptions{
-			Limit:                self.c.Contexts().LocalCommits.GetLimitCommits(),
+			Limit:                limit,
 			FilterPath:           self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:         self.c.Modes().Filtering.GetAuthor(),
+			FilterSince:          self.c.Modes().Filtering.GetSince(),
+			FilterUntil:          self.c.Modes().Filtering.GetUntil(),
+			FilterContent:        self.c.Modes().Filtering.ContentFilter(),
 			IncludeRebaseCommits: true,
 			RefName:              self.refForLog(),
 			RefForPushedStatus:   checkedOutRef,
 			All:                  self.c.Contexts().LocalCommits.GetShowWholeGitGraph(),
 			MainBranches:         self.c.Model().MainBranches,
 			HashPool:             self.c.Model().HashPool,
+			OnProgress:           self.commitsProgressHandler(limit, progress, &self.c.Model().Commits, self.c.Contexts().LocalCommits),
+			Ctx:                  ctx,
 		},
 	)
+	progress.finish()
 	if err != nil {
 		return err
 	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 	self.c.Model().Commits = commits
+	self.c.Model().RefsVersion.Add(1)
 	self.RefreshAuthors(commits)
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
 	if checkedOutRef != nil {
@@ -382,6 +530,49 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	return nil
 }
 
+// When loading the whole history (i.e. after the user scrolled past the first
+// few hundred commits), show the commits as they stream in rather than waiting
+// for git log to finish, which can take a long time in huge repos. We only
+// ever grow the list this way, so that the selection doesn't jump around; the
+// final result of the load replaces it as usual.
+type commitsProgress struct {
+	mutex    sync.Mutex
+	finished bool
+}
+
+// The returned function is called from the loader's goroutine, so it hands the
+// commits over to the UI thread rather than touching the model itself.
+func (self *RefreshHelper) commitsProgressHandler(limit bool, progress *commitsProgress, modelCommits *[]*models.Commit, context types.Context) func([]*models.Commit) {
+	if limit {
+		return nil
+	}
+
+	return func(commits []*models.Commit) {
+		self.c.OnUIThread(func() error {
+			progress.mutex.Lock()
+			if progress.finished || len(commits) <= len(*modelCommits) {
+				progress.mutex.Unlock()
+				return nil
+			}
+			*modelCommits = commits
+			progress.mutex.Unlock()
+
+			self.refreshView(context)
+			return nil
+		})
+	}
+}
+
+// Must be called once the load is done (successfully or not), before
+// publishing its result, so that partial lists that are still queued on the UI
+// thread don't overwrite it.
+func (self *commitsProgress) finish() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.finished = true
+}
+
 func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 	if self.c.Contexts().SubCommits.GetRef() == nil {
 		return nil
@@ -390,19 +581,26 @@ func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 	self.c.Mutexes().SubCommitsMutex.Lock()
 	defer self.c.Mutexes().SubCommitsMutex.Unlock()
 
+	limit := self.c.Contexts().SubCommits.GetLimitCommits()
+	progress := &commitsProgress{}
 	commits, err := self.c.Git().Loaders.CommitLoader.GetCommits(
 		git_commands.GetCommitsOptions{
-			Limit:                   self.c.Contexts().SubCommits.GetLimitCommits(),
+			Limit:                   limit,
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterSince:             self.c.Modes().Filtering.GetSince(),
+			FilterUntil:             self.c.Modes().Filtering.GetUntil(),
+			FilterContent:           self.c.Modes().Filtering.ContentFilter(),
 			IncludeRebaseCommits:    false,
 			RefName:                 self.c.Contexts().SubCommits.GetRef().FullRefName(),
 			RefToShowDivergenceFrom: self.c.Contexts().SubCommits.GetRefToShowDivergenceFrom(),
 			RefForPushedStatus:      self.c.Contexts().SubCommits.GetRef(),
 			MainBranches:            self.c.Model().MainBranches,
 			HashPool:                self.c.Model().HashPool,
+			OnProgress:              self.commitsProgressHandler(limit, progress, &self.c.Model().SubCommits, self.c.Contexts().SubCommits),
 		},
 	)
+	progress.finish()
 	if err != nil {
 		return err
 	}
@@ -458,18 +656,59 @@ func (self *RefreshHelper) refreshRebaseCommits() error {
 	return nil
 }
 
-func (self *RefreshHelper) refreshTags() error {
+func (self *RefreshHelper) refreshTags(ctx goContext.Context) error {
 	tags, err := self.c.Git().Loaders.TagLoader.GetTags()
 	if err != nil {
 		return err
 	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 
 	self.c.Model().Tags = tags
+	self.c.Model().RefsVersion.Add(1)
 
 	self.refreshView(self.c.Contexts().Tags)
 	return nil
 }
 
+// Computing ahead/behind counts walks the history, which is slow in big repos
+// unless there's a commit-graph. If we notice that it's slow, we offer to write
+// one (once per repo and session). Must be called on the UI thread.
+func (self *RefreshHelper) offerToWriteCommitGraph(loadDuration time.Duration) {
+	if loadDuration < slowBranchLoadThreshold {
+		return
+	}
+
+	// The repo state survives switching to another repo and back, so this
+	// also keeps us from offering again after that
+	self.c.State().GetRepoState().GetCommitGraphOffer().Do(func() {
+		if !self.c.Git().Commit.HasCommitGraph() {
+			self.confirmWriteCommitGraph(loadDuration)
+		}
+	})
+}
+
+func (self *RefreshHelper) confirmWriteCommitGraph(loadDuration time.Duration) {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.WriteCommitGraphTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.WriteCommitGraphPrompt, map[string]string{
+			"duration": loadDuration.Round(100 * time.Millisecond).String(),
+		}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.WritingCommitGraphStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.WriteCommitGraph)
+				if err := self.c.Git().Commit.WriteCommitGraph(); err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+				return nil
+			})
+		},
+	})
+}
+
 func (self *RefreshHelper) refreshStateSubmoduleConfigs() error {
 	configs, err := self.c.Git().Submodule.GetConfigs(nil)
 	if err != nil {
@@ -483,10 +722,11 @@ func (self *RefreshHelper) refreshStateSubmoduleConfigs() error {
 
 // self.refreshStatus is called at the end of this because that's when we can
 // be sure there is a State.Model.Branches array to pick the current branch from
-func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSelectionIndex bool, loadBehindCounts bool) {
+func (self *RefreshHelper) refreshBranches(ctx goContext.Context, keepBranchSelectionIndex bool, loadBehindCounts bool) error {
 	self.c.Mutexes().RefreshingBranchesMutex.Lock()
 	defer self.c.Mutexes().RefreshingBranchesMutex.Unlock()
 
+	t := time.Now()
 	branches, err := self.c.Git().Loaders.BranchLoader.Load(
 		self.c.Model().ReflogCommits,
 		self.c.Model().MainBranches,
@@ -498,26 +738,35 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 			})
 		},
 		func() {
+			loadDuration := time.Since(t)
 			self.c.OnUIThread(func() error {
 				self.c.Contexts().Branches.HandleRender()
 				self.refreshStatus()
+				self.offerToWriteCommitGraph(loadDuration)
 				return nil
 			})
 		})
 	if err != nil {
 		self.c.Log.Error(err)
 	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if loadDuration := time.Since(t); loadDuration > slowBranchLoadThreshold {
+		self.c.OnUIThread(func() error {
+			self.offerToWriteCommitGraph(loadDuration)
+			return nil
+		})
+	}
 
 	prevSelectedBranch := self.c.Contexts().Branches.GetSelected()
 
 	self.c.Model().Branches = branches
+	self.c.Model().RemoteBranchDivergences = self.c.Git().Loaders.BranchLoader.GetRemoteBranchDivergences(branches)
+	self.c.Model().RefsVersion.Add(1)
 	self.rebuildPullRequestsMap()
 
-	if refreshWorktrees {
-		self.loadWorktrees()
-		self.refreshView(self.c.Contexts().Worktrees)
-	}
-
 	if !keepBranchSelectionIndex && prevSelectedBranch != nil {
 		self.searchHelper.ReApplyFilter(self.c.Contexts().Branches)
 
@@ -529,6 +778,8 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 	}
 
 	self.refreshView(self.c.Contexts().Branches)
+	// The remote branches view shows divergence from the tracking local branches
+	self.refreshView(self.c.Contexts().RemoteBranches)
 
 	// Need to re-render the commits view because the visualization of local
 	// branch heads might have changed
@@ -537,9 +788,10 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 	self.c.Mutexes().LocalCommitsMutex.Unlock()
 
 	self.refreshStatus()
+	return nil
 }
 
-func (self *RefreshHelper) refreshFilesAndSubmodules() error {
+func (self *RefreshHelper) refreshFilesAndSubmodules(ctx goContext.Context) error {
 	self.c.Mutexes().RefreshingFilesMutex.Lock()
 	self.c.State().SetIsRefreshingFiles(true)
 	defer func() {
@@ -547,11 +799,17 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 		self.c.Mutexes().RefreshingFilesMutex.Unlock()
 	}()
 
+	// If a newer refresh superseded us while we were waiting for the mutex,
+	// leave the loading to that one
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
 	if err := self.refreshStateSubmoduleConfigs(); err != nil {
 		return err
 	}
 
-	if err := self.refreshStateFiles(); err != nil {
+	if err := self.refreshStateFiles(ctx); err != nil {
 		return err
 	}
 
@@ -564,7 +822,7 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 	return nil
 }
 
-func (self *RefreshHelper) refreshStateFiles() error {
+func (self *RefreshHelper) refreshStateFiles(ctx goContext.Context) error {
 	fileTreeViewModel := self.c.Contexts().Files.FileTreeViewModel
 
 	prevConflictFileCount := 0
@@ -602,7 +860,12 @@ func (self *RefreshHelper) refreshStateFiles() error {
 	files := self.c.Git().Loaders.FileLoader.
 		GetStatusFiles(git_commands.GetStatusFileOptions{
 			ForceShowUntracked: self.c.Contexts().Files.ForceShowUntracked(),
+			SkipUntracked:      self.c.Contexts().Files.SkipUntrackedFiles(),
+			Ctx:                ctx,
 		})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 
 	conflictFileCount := 0
 	for _, file := range files {
@@ -611,7 +874,9 @@ func (self *RefreshHelper) refreshStateFiles() error {
 		}
 	}
 
-	if self.c.Git().Status.WorkingTreeState().Any() && conflictFileCount == 0 && prevConflictFileCount > 0 {
+	// When git runs us as its mergetool, it takes care of continuing itself
+	if self.c.Git().Status.WorkingTreeState().Any() && conflictFileCount == 0 && prevConflictFileCount > 0 &&
+		!self.c.Modes().Tool.IsMergetool() {
 		self.c.OnUIThread(func() error { return self.mergeAndRebaseHelper.PromptToContinueRebase() })
 	}
 
@@ -621,12 +886,11 @@ func (self *RefreshHelper) refreshStateFiles() error {
 	if conflictFileCount > 0 && prevConflictFileCount == 0 {
 		if fileTreeViewModel.GetStatusFilter() == filetree.DisplayAll {
 			fileTreeViewModel.SetStatusFilter(filetree.DisplayConflicted)
-			self.c.Contexts().Files.GetView().Subtitle = self.c.Tr.FilterLabelConflictingFiles
 		}
 	} else if conflictFileCount == 0 && fileTreeViewModel.GetStatusFilter() == filetree.DisplayConflicted {
 		fileTreeViewModel.SetStatusFilter(filetree.DisplayAll)
-		self.c.Contexts().Files.GetView().Subtitle = ""
 	}
+	self.c.Contexts().Files.UpdateSubtitle()
 
 	self.c.Model().Files = files
 	fileTreeViewModel.SetTree()
@@ -642,22 +906,25 @@ func (self *RefreshHelper) refreshStateFiles() error {
 // This method also manages two things: ReflogCommits and FilteredReflogCommits.
 // FilteredReflogCommits are rendered in the reflogs panel, and ReflogCommits
 // are used by the branches panel to obtain recency values for sorting.
-func (self *RefreshHelper) refreshReflogCommits() error {
+func (self *RefreshHelper) refreshReflogCommits(ctx goContext.Context) error {
 	// pulling state into its own variable in case it gets swapped out for another state
 	// and we get an out of bounds exception
 	model := self.c.Model()
 
-	refresh := func(stateCommits *[]*models.Commit, filterPath string, filterAuthor string) error {
+	refresh := func(stateCommits *[]*models.Commit, ref string, filterPath string, filterAuthor string) error {
 		var lastReflogCommit *models.Commit
-		if filterPath == "" && filterAuthor == "" && len(*stateCommits) > 0 {
+		if ref == "" && filterPath == "" && filterAuthor == "" && len(*stateCommits) > 0 {
 			lastReflogCommit = (*stateCommits)[0]
 		}
 
 		commits, onlyObtainedNewReflogCommits, err := self.c.Git().Loaders.ReflogCommitLoader.
-			GetReflogCommits(self.c.Model().HashPool, lastReflogCommit, filterPath, filterAuthor)
+			GetReflogCommits(ctx, self.c.Model().HashPool, lastReflogCommit, ref, filterPath, filterAuthor)
 		if err != nil {
 			return err
 		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 
 		if onlyObtainedNewReflogCommits {
 			*stateCommits = append(commits, *stateCommits...)
@@ -667,12 +934,13 @@ func (self *RefreshHelper) refreshReflogCommits() error {
 		return nil
 	}
 
-	if err := refresh(&model.ReflogCommits, "", ""); err != nil {
+	if err := refresh(&model.ReflogCommits, "", "", ""); err != nil {
 		return err
 	}
 
-	if self.c.Modes().Filtering.Active() {
-		if err := refresh(&model.FilteredReflogCommits, self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor()); err != nil {
+	ref := self.c.Contexts().ReflogCommits.GetRef()
+	if ref != "" || self.c.Modes().Filtering.Active() {
+		if err := refresh(&model.FilteredReflogCommits, ref, self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor()); err != nil {
 			return err
 		}
 	} else {
@@ -683,15 +951,19 @@ func (self *RefreshHelper) refreshReflogCommits() error {
 	return nil
 }
 
-func (self *RefreshHelper) refreshRemotes() error {
+func (self *RefreshHelper) refreshRemotes(ctx goContext.Context) error {
 	prevSelectedRemote := self.c.Contexts().Remotes.GetSelected()
 
 	remotes, err := self.c.Git().Loaders.RemoteLoader.GetRemotes()
 	if err != nil {
 		return err
 	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 
 	self.c.Model().Remotes = remotes
+	self.c.Model().RefsVersion.Add(1)
 
 	hadPrs := len(self.c.Model().PullRequestsMap) != 0
 	self.rebuildPullRequestsMap()
@@ -716,30 +988,42 @@ func (self *RefreshHelper) refreshRemotes() error {
 	return nil
 }
 
-func (self *RefreshHelper) loadWorktrees() {
+func (self *RefreshHelper) loadWorktrees(ctx goContext.Context) error {
 	worktrees, err := self.c.Git().Loaders.Worktrees.GetWorktrees()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 	if err != nil {
 		self.c.Log.Error(err)
 		self.c.Model().Worktrees = []*models.Worktree{}
 	}
 
 	self.c.Model().Worktrees = worktrees
+	return nil
 }
 
-func (self *RefreshHelper) refreshWorktrees() {
-	self.loadWorktrees()
+func (self *RefreshHelper) refreshWorktrees(ctx goContext.Context) error {
+	if err := self.loadWorktrees(ctx); err != nil {
+		return err
+	}
 
 	// need to refresh branches because the branches view shows worktrees against
 	// branches
 	self.refreshView(self.c.Contexts().Branches)
 	self.refreshView(self.c.Contexts().Worktrees)
+	return nil
 }
 
-func (self *RefreshHelper) refreshStashEntries() {
-	self.c.Model().StashEntries = self.c.Git().Loaders.StashLoader.
-		GetStashEntries(self.c.Modes().Filtering.GetPath())
+func (self *RefreshHelper) refreshStashEntries(ctx goContext.Context) error {
+	stashEntries := self.c.Git().Loaders.StashLoader.
+		GetStashEntries(ctx, self.c.Modes().Filtering.GetPath())
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 
+	self.c.Model().StashEntries = stashEntries
 	self.refreshView(self.c.Contexts().Stash)
+	return nil
 }
 
 // never call this on its own, it should only be called from within refreshCommits()
@@ -763,6 +1047,22 @@ func (self *RefreshHelper) refreshStatus() {
 	self.c.SetViewContent(self.c.Views().Status, status)
 }
 
+// Loads what the repo overview in the status panel's main view needs beyond
+// what the other scopes load
+func (self *RefreshHelper) refreshRepoOverview() {
+	self.c.Model().RepoOverview = types.RepoOverview{
+		LatestReachableTag: self.c.Git().Tag.LatestReachable(),
+		WorkingTreeState:   self.c.Git().Status.WorkingTreeState(),
+	}
+
+	self.c.OnUIThread(func() error {
+		if self.c.Context().IsCurrent(self.c.Contexts().Status) {
+			self.c.Contexts().Status.HandleRenderToMain()
+		}
+		return nil
+	})
+}
+
 func (self *RefreshHelper) refForLog() string {
 	bisectInfo := self.c.Git().Bisect.GetInfo()
 	self.c.Model().BisectInfo = bisectInfo
@@ -800,28 +1100,39 @@ func (self *RefreshHelper) refreshView(context types.Context) {
 	})
 }
 
-func (self *RefreshHelper) refreshGithubPullRequests() {
+func (self *RefreshHelper) refreshPullRequests() {
 	self.c.Mutexes().RefreshingPullRequestsMutex.Lock()
 	defer self.c.Mutexes().RefreshingPullRequestsMutex.Unlock()
 
-	if !self.c.Git().GitHub.InGithubRepo(self.c.Model().Remotes) {
-		self.c.Model().PullRequests = nil
-		self.c.Model().PullRequestsMap = nil
-		return
+	switch {
+	case self.c.Git().GitHub.InGithubRepo(self.c.Model().Remotes):
+		self.refreshGithubPullRequests()
+	case self.c.Git().GitLab.InGitlabRepo(self.c.Model().Remotes):
+		self.refreshGitlabMergeRequests()
+	case self.c.Git().Bitbucket.InBitbucketRepo(self.c.Model().Remotes):
+		self.refreshBitbucketPullRequests()
+	default:
+		self.clearPullRequests()
 	}
+}
 
+func (self *RefreshHelper) clearPullRequests() {
+	self.c.Model().PullRequests = nil
+	self.c.Model().PullRequestsMap = nil
+	self.c.Model().CommitStatuses = nil
+}
+
+func (self *RefreshHelper) refreshGithubPullRequests() {
 	authToken := self.c.Git().GitHub.GetAuthToken()
 	if authToken == "" {
-		self.c.Model().PullRequests = nil
-		self.c.Model().PullRequestsMap = nil
+		self.clearPullRequests()
 		return
 	}
 
 	githubRemotes := self.getGithubRemotes()
 	baseRemote := getGithubBaseRemote(githubRemotes, self.c.Git().GitHub.ConfiguredBaseRemoteName())
 	if baseRemote == nil {
-		self.c.Model().PullRequests = nil
-		self.c.Model().PullRequestsMap = nil
+		self.clearPullRequests()
 
 		if len(githubRemotes) > 0 && !self.githubBaseRemotePromptDismissed[self.c.Git().RepoPaths.RepoPath()] {
 			self.promptForBaseGithubRepo(authToken, githubRemotes)
@@ -832,6 +1143,50 @@ func (self *RefreshHelper) refreshGithubPullRequests() {
 	if err := self.setGithubPullRequests(authToken, baseRemote); err != nil {
 		self.c.LogAction(fmt.Sprintf("Error fetching pull requests from GitHub: %s", err.Error()))
 	}
+	if err := self.setGithubCommitStatuses(authToken, baseRemote); err != nil {
+		self.c.LogAction(fmt.Sprintf("Error fetching CI status from GitHub: %s", err.Error()))
+	}
+}
+
+func (self *RefreshHelper) refreshGitlabMergeRequests() {
+	authToken := self.c.Git().GitLab.GetAuthToken()
+	baseRemote := self.c.Git().GitLab.GetBaseRemote(self.c.Model().Remotes)
+	if authToken == "" || baseRemote == nil {
+		self.clearPullRequests()
+		return
+	}
+
+	err := self.setPullRequests(func(branchNames []string) ([]*models.GithubPullRequest, error) {
+		return self.c.Git().GitLab.FetchRecentMergeRequests(branchNames, baseRemote, authToken)
+	})
+	if err != nil {
+		self.c.LogAction(fmt.Sprintf("Error fetching merge requests from GitLab: %s", err.Error()))
+	}
+	err = self.setCommitStatuses(func(hashes []string) (map[string]*models.GithubCommitStatus, error) {
+		return self.c.Git().GitLab.FetchCommitStatuses(hashes, baseRemote, authToken)
+	})
+	if err != nil {
+		self.c.LogAction(fmt.Sprintf("Error fetching CI status from GitLab: %s", err.Error()))
+	}
+}
+
+func (self *RefreshHelper) refreshBitbucketPullRequests() {
+	// We don't support CI status for Bitbucket
+	self.c.Model().CommitStatuses = nil
+
+	authToken := self.c.Git().Bitbucket.GetAuthToken()
+	baseRemote := self.c.Git().Bitbucket.GetBaseRemote(self.c.Model().Remotes)
+	if authToken == "" || baseRemote == nil {
+		self.clearPullRequests()
+		return
+	}
+
+	err := self.setPullRequests(func(branchNames []string) ([]*models.GithubPullRequest, error) {
+		return self.c.Git().Bitbucket.FetchRecentPRs(branchNames, baseRemote, authToken)
+	})
+	if err != nil {
+		self.c.LogAction(fmt.Sprintf("Error fetching pull requests from Bitbucket: %s", err.Error()))
+	}
 }
 
 type githubRemoteInfo struct {
@@ -893,6 +1248,9 @@ func (self *RefreshHelper) promptForBaseGithubRepo(authToken string, githubRemot
 					if err := self.setGithubPullRequests(authToken, info.remote); err != nil {
 						self.c.LogAction(fmt.Sprintf("Error fetching pull requests from GitHub: %s", err.Error()))
 					}
+					if err := self.setGithubCommitStatuses(authToken, info.remote); err != nil {
+						self.c.LogAction(fmt.Sprintf("Error fetching CI status from GitHub: %s", err.Error()))
+					}
 					return nil
 				})
 			},
@@ -921,6 +1279,12 @@ func (self *RefreshHelper) rebuildPullRequestsMap() {
 }
 
 func (self *RefreshHelper) setGithubPullRequests(authToken string, baseRemote *models.Remote) error {
+	return self.setPullRequests(func(branchNames []string) ([]*models.GithubPullRequest, error) {
+		return self.c.Git().GitHub.FetchRecentPRs(branchNames, baseRemote, authToken)
+	})
+}
+
+func (self *RefreshHelper) setPullRequests(fetch func(branchNames []string) ([]*models.GithubPullRequest, error)) error {
 	if len(self.c.Model().Branches) == 0 {
 		return nil
 	}
@@ -932,7 +1296,7 @@ func (self *RefreshHelper) setGithubPullRequests(authToken string, baseRemote *m
 		return branch.UpstreamBranch
 	})
 
-	prs, err := self.c.Git().GitHub.FetchRecentPRs(branchNames, baseRemote, authToken)
+	prs, err := fetch(branchNames)
 	if err != nil {
 		return err
 	}
@@ -945,6 +1309,50 @@ func (self *RefreshHelper) setGithubPullRequests(authToken string, baseRemote *m
 	return nil
 }
 
+// The number of commits at the top of the commits panel whose CI status we
+// fetch, in addition to the ones at the heads of branches
+const recentCommitsWithCIStatus = 20
+
+func (self *RefreshHelper) setGithubCommitStatuses(authToken string, baseRemote *models.Remote) error {
+	return self.setCommitStatuses(func(hashes []string) (map[string]*models.GithubCommitStatus, error) {
+		return self.c.Git().GitHub.FetchCommitStatuses(hashes, baseRemote, authToken)
+	})
+}
+
+func (self *RefreshHelper) setCommitStatuses(fetch func(hashes []string) (map[string]*models.GithubCommitStatus, error)) error {
+	if !self.c.UserConfig().Gui.ShowCIStatus {
+		self.c.Model().CommitStatuses = nil
+		return nil
+	}
+
+	hashes := lo.FilterMap(self.c.Model().Branches, func(branch *models.Branch, _ int) (string, bool) {
+		return branch.CommitHash, branch.IsTrackingRemote() && branch.CommitHash != ""
+	})
+	commits := self.c.Model().Commits
+	for _, commit := range commits[:min(len(commits), recentCommitsWithCIStatus)] {
+		// CI can only have run on commits that have been pushed
+		if commit.Status == models.StatusPushed || commit.Status == models.StatusMerged {
+			hashes = append(hashes, commit.Hash())
+		}
+	}
+	hashes = lo.Uniq(hashes)
+	if len(hashes) == 0 {
+		self.c.Model().CommitStatuses = nil
+		return nil
+	}
+
+	statuses, err := fetch(hashes)
+	if err != nil {
+		return err
+	}
+
+	self.c.Model().CommitStatuses = statuses
+
+	self.c.PostRefreshUpdate(self.c.Contexts().Branches)
+	self.c.PostRefreshUpdate(self.c.Contexts().LocalCommits)
+	return nil
+}
+
 func (self *RefreshHelper) savePullRequestsToCache(prs []*models.GithubPullRequest) {
 	repoPath := self.c.Git().RepoPaths.RepoPath()
 	cached := lo.Map(prs, func(pr *models.GithubPullRequest, _ int) config.CachedPullRequest {
@@ -955,6 +1363,7 @@ func (self *RefreshHelper) savePullRequestsToCache(prs []*models.GithubPullReque
 			State:               pr.State,
 			Url:                 pr.Url,
 			HeadRepositoryOwner: pr.HeadRepositoryOwner.Login,
+			ReviewDecision:      pr.ReviewDecision,
 		}
 	})
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
@@ -71,3 +72,37 @@ func makeGithubRemoteInfoList(names ...string) []githubRemoteInfo {
 		return githubRemoteInfo{remote: &models.Remote{Name: name}, repoName: name}
 	})
 }
+
+func TestMergeRefreshOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        types.RefreshOptions
+		b        types.RefreshOptions
+		expected types.RefreshOptions
+	}{
+		{
+			name:     "combines scopes",
+			a:        types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.BRANCHES}},
+			b:        types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.TAGS}},
+			expected: types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.BRANCHES, types.TAGS}},
+		},
+		{
+			name:     "refreshes everything if either one does",
+			a:        types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}},
+			b:        types.RefreshOptions{Mode: types.ASYNC},
+			expected: types.RefreshOptions{Mode: types.ASYNC},
+		},
+		{
+			name:     "keeps the branch selection index only if both do",
+			a:        types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}, KeepBranchSelectionIndex: true},
+			b:        types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}},
+			expected: types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, mergeRefreshOptions(c.a, c.b))
+		})
+	}
+}
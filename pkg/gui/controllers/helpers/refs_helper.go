@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"text/template"
@@ -18,16 +19,19 @@ import (
 type RefsHelper struct {
 	c *HelperCommon
 
-	rebaseHelper *MergeAndRebaseHelper
+	rebaseHelper            *MergeAndRebaseHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
 func NewRefsHelper(
 	c *HelperCommon,
 	rebaseHelper *MergeAndRebaseHelper,
+	protectedBranchesHelper *ProtectedBranchesHelper,
 ) *RefsHelper {
 	return &RefsHelper{
-		c:            c,
-		rebaseHelper: rebaseHelper,
+		c:                       c,
+		rebaseHelper:            rebaseHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
@@ -182,6 +186,79 @@ func (self *RefsHelper) CheckoutRemoteBranch(fullBranchName string, localBranchN
 	})
 }
 
+// Checks out the branch of the pull request with the given number. If we don't
+// have a local branch for it yet, we fetch the pull request's head into a new
+// branch called pr/<number>.
+func (self *RefsHelper) CheckoutPullRequest(number int) error {
+	for branchName, pr := range self.c.Model().PullRequestsMap {
+		if pr.Number == number {
+			return self.CheckoutRef(branchName, types.CheckoutRefOptions{})
+		}
+	}
+
+	localBranchName := fmt.Sprintf("pr/%d", number)
+	if lo.ContainsBy(self.c.Model().Branches, func(branch *models.Branch) bool {
+		return branch.Name == localBranchName
+	}) {
+		return self.CheckoutRef(localBranchName, types.CheckoutRefOptions{})
+	}
+
+	fetchPullRequest := self.fetchPullRequestFn(number, localBranchName)
+
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
+		if err := fetchPullRequest(task); err != nil {
+			return err
+		}
+
+		// Do a sync refresh to make sure the new branch is visible, so that we
+		// see an inline status when checking it out
+		self.c.Refresh(types.RefreshOptions{
+			Mode:  types.SYNC,
+			Scope: []types.RefreshableView{types.BRANCHES},
+		})
+
+		self.c.OnUIThread(func() error {
+			return self.CheckoutRef(localBranchName, types.CheckoutRefOptions{RefreshPullRequests: true})
+		})
+		return nil
+	})
+}
+
+// Returns a function that fetches the head of the pull request with the given
+// number into a new local branch, in the way that the hosting service of the
+// repo supports
+func (self *RefsHelper) fetchPullRequestFn(number int, localBranchName string) func(gocui.Task) error {
+	remotes := self.c.Model().Remotes
+
+	if self.c.Git().GitLab.InGitlabRepo(remotes) {
+		remoteName := self.c.Git().GitLab.GetBaseRemote(remotes).Name
+		return func(task gocui.Task) error {
+			return self.c.Git().GitLab.FetchMergeRequest(task, remoteName, number, localBranchName)
+		}
+	}
+
+	if self.c.Git().Bitbucket.InBitbucketRepo(remotes) {
+		baseRemote := self.c.Git().Bitbucket.GetBaseRemote(remotes)
+		authToken := self.c.Git().Bitbucket.GetAuthToken()
+		return func(task gocui.Task) error {
+			if authToken == "" {
+				return errors.New(self.c.Tr.BitbucketTokenRequired)
+			}
+			return self.c.Git().Bitbucket.FetchPullRequest(task, baseRemote, remotes, number, localBranchName, authToken)
+		}
+	}
+
+	remoteName := self.c.Git().GitHub.ConfiguredBaseRemoteName()
+	if remoteName == "" {
+		remoteName = lo.Ternary(lo.ContainsBy(remotes, func(remote *models.Remote) bool {
+			return remote.Name == "upstream"
+		}), "upstream", "origin")
+	}
+	return func(task gocui.Task) error {
+		return self.c.Git().GitHub.FetchPullRequest(task, remoteName, number, localBranchName)
+	}
+}
+
 func (self *RefsHelper) CheckoutPreviousRef() error {
 	previousRef, err := self.c.Git().Branch.PreviousRef()
 	if err == nil && strings.HasPrefix(previousRef, "refs/heads/") {
@@ -277,15 +354,21 @@ func (self *RefsHelper) CreateGitResetMenu(name string, ref string) error {
 				style.FgRed.Sprintf("reset --%s %s", row.strength, name),
 			},
 			OnPress: func() error {
-				return self.c.ConfirmIf(row.strength == "hard" && IsWorkingTreeDirtyExceptSubmodules(self.c.Model().Files, self.c.Model().Submodules),
-					types.ConfirmOpts{
-						Title:  self.c.Tr.Actions.HardReset,
-						Prompt: self.c.Tr.ResetHardConfirmation,
-						HandleConfirm: func() error {
-							self.c.LogAction("Reset")
-							return self.ResetToRef(ref, row.strength, []string{})
-						},
-					})
+				reset := func() error {
+					return self.c.ConfirmIf(row.strength == "hard" && IsWorkingTreeDirtyExceptSubmodules(self.c.Model().Files, self.c.Model().Submodules),
+						types.ConfirmOpts{
+							Title:  self.c.Tr.Actions.HardReset,
+							Prompt: self.c.Tr.ResetHardConfirmation,
+							HandleConfirm: func() error {
+								self.c.LogAction("Reset")
+								return self.ResetToRef(ref, row.strength, []string{})
+							},
+						})
+				}
+				if row.strength != "hard" {
+					return reset()
+				}
+				return self.protectedBranchesHelper.GuardCheckedOutBranch(self.c.Tr.ProtectedBranchActionHardReset, reset)
 			},
 			Key:     row.key,
 			Tooltip: row.tooltip,
@@ -549,7 +632,7 @@ func (self *RefsHelper) moveCommitsToNewBranchOffOfMainBranch(newBranchName stri
 		return err
 	}
 
-	err := self.c.Git().Rebase.CherryPickCommits(commitsToCherryPick)
+	err := self.c.Git().Rebase.CherryPickCommits(commitsToCherryPick, git_commands.CherryPickOpts{})
 	err = self.rebaseHelper.CheckMergeOrRebaseWithRefreshOptions(err, types.RefreshOptions{Mode: types.SYNC})
 	if err != nil {
 		return err
@@ -1,16 +1,20 @@
 package helpers
 
 import (
+	goContext "context"
 	"errors"
-	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/env"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -19,6 +23,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
 )
 
 type onNewRepoFn func(startArgs appTypes.StartArgs, contextKey types.ContextKey) error
@@ -94,6 +99,94 @@ func (self *ReposHelper) getCurrentBranch(path string) string {
 	return self.c.Tr.BranchUnknown
 }
 
+// how many levels of directories below the repo search roots we look for repos
+const repoSearchDepth = 3
+
+// how long we wait for git status to tell whether a repo in the recent repos
+// menu is dirty, before giving up on it
+const repoStatusTimeout = 5 * time.Second
+
+// Whether the repo has changes to tracked files. Untracked files don't count,
+// because looking for them is what makes git status slow in big repos.
+func (self *ReposHelper) isDirty(path string) bool {
+	ctx, cancel := goContext.WithTimeout(goContext.Background(), repoStatusTimeout)
+	defer cancel()
+
+	cmdArgs := git_commands.NewGitCmd("status").Arg("--porcelain", "-uno").Dir(path).ToArgv()
+	output, err := self.c.OS().Cmd.NewWithContext(ctx, cmdArgs).DontLog().RunWithOutput()
+	return err == nil && strings.TrimSpace(output) != ""
+}
+
+// Marks the menu items of the dirty repos once we know which ones they are.
+// We don't wait for this before showing the menu, since running git status in
+// lots of repos can take a while.
+func (self *ReposHelper) markDirtyReposAsync(menuItemsByPath map[string]*types.MenuItem) {
+	self.c.OnWorker(func(gocui.Task) error {
+		errg := errgroup.Group{}
+		errg.SetLimit(runtime.NumCPU())
+		for path, menuItem := range menuItemsByPath {
+			errg.Go(func() error {
+				if !self.isDirty(path) {
+					return nil
+				}
+
+				self.c.OnUIThread(func() error {
+					menuItem.LabelColumns[1] += style.FgYellow.Sprint(" *")
+					if lo.Contains(self.c.Contexts().Menu.GetItems(), menuItem) {
+						self.c.Contexts().Menu.HandleRender()
+					}
+					return nil
+				})
+				return nil
+			})
+		}
+		return errg.Wait()
+	})
+}
+
+// findRepos returns the paths of the git repos in the given directories, up
+// to repoSearchDepth levels deep. We don't look inside repos or hidden
+// directories.
+func findRepos(roots []string) []string {
+	repos := []string{}
+
+	var walk func(dir string, depth int)
+	walk = func(dir string, depth int) {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			repos = append(repos, dir)
+			return
+		}
+
+		if depth == repoSearchDepth {
+			return
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+				walk(filepath.Join(dir, entry.Name()), depth+1)
+			}
+		}
+	}
+
+	for _, root := range roots {
+		if rest, ok := strings.CutPrefix(root, "~/"); ok {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				continue
+			}
+			root = filepath.Join(home, rest)
+		}
+		walk(filepath.Clean(root), 0)
+	}
+
+	return repos
+}
+
 func (self *ReposHelper) CreateRecentReposMenu() error {
 	// we'll show an empty panel if there are no recent repos
 	recentRepoPaths := []string{}
@@ -102,12 +195,22 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 		recentRepoPaths = self.c.GetAppState().RecentRepos[1:]
 	}
 
+	// repos in the search roots that we already list as recent repos (or that
+	// we're currently in) are left out
+	knownRepoPaths := lo.SliceToMap(self.c.GetAppState().RecentRepos, func(path string) (string, bool) {
+		return filepath.Clean(path), true
+	})
+	searchRepoPaths := lo.Filter(findRepos(self.c.UserConfig().RepoSearchRoots), func(path string, _ int) bool {
+		return !knownRepoPaths[path]
+	})
+
+	allRepoPaths := append(slices.Clone(recentRepoPaths), searchRepoPaths...)
 	currentBranches := sync.Map{}
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(recentRepoPaths))
+	wg.Add(len(allRepoPaths))
 
-	for _, path := range recentRepoPaths {
+	for _, path := range allRepoPaths {
 		go func(path string) {
 			defer wg.Done()
 			currentBranches.Store(path, self.getCurrentBranch(path))
@@ -116,13 +219,22 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 
 	wg.Wait()
 
-	menuItems := lo.Map(recentRepoPaths, func(path string, _ int) *types.MenuItem {
-		branchName, _ := currentBranches.Load(path)
+	// We only need section headers if there are repos from the search roots
+	var recentReposSection, searchReposSection *types.MenuSection
+	if len(searchRepoPaths) > 0 {
+		recentReposSection = &types.MenuSection{Title: self.c.Tr.RecentRepos, Column: 0}
+		searchReposSection = &types.MenuSection{Title: self.c.Tr.ReposInSearchRoots, Column: 0}
+	}
+
+	menuItemsByPath := make(map[string]*types.MenuItem, len(allRepoPaths))
+	toMenuItem := func(path string, section *types.MenuSection) *types.MenuItem {
+		value, _ := currentBranches.Load(path)
+		branchName := value.(string)
 		if icons.IsIconEnabled() {
-			branchName = icons.BRANCH_ICON + " " + fmt.Sprintf("%v", branchName)
+			branchName = icons.BRANCH_ICON + " " + branchName
 		}
 
-		return &types.MenuItem{
+		menuItem := &types.MenuItem{
 			LabelColumns: []string{
 				filepath.Base(path),
 				style.FgCyan.Sprint(branchName),
@@ -134,10 +246,95 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 				self.c.State().GetRepoPathStack().Clear()
 				return self.DispatchSwitchToRepo(path, context.NO_CONTEXT)
 			},
+			Section: section,
+		}
+		menuItemsByPath[path] = menuItem
+		return menuItem
+	}
+
+	menuItems := lo.Map(recentRepoPaths, func(path string, _ int) *types.MenuItem {
+		return toMenuItem(path, recentReposSection)
+	})
+	menuItems = append(menuItems, lo.Map(searchRepoPaths, func(path string, _ int) *types.MenuItem {
+		return toMenuItem(path, searchReposSection)
+	})...)
+
+	if err := self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems}); err != nil {
+		return err
+	}
+
+	self.markDirtyReposAsync(menuItemsByPath)
+	return nil
+}
+
+// SwitchToRepoTab switches to one of the repos that are already open in this
+// session
+func (self *ReposHelper) SwitchToRepoTab(path string) error {
+	if path == self.c.Git().RepoPaths.WorktreePath() {
+		return nil
+	}
+
+	self.c.State().GetRepoPathStack().Clear()
+	return self.DispatchSwitchToRepo(path, context.NO_CONTEXT)
+}
+
+func (self *ReposHelper) CreateRepoTabsMenu() error {
+	tabs := self.c.State().GetRepoTabs()
+	currentPath := self.c.Git().RepoPaths.WorktreePath()
+
+	tabsSection := &types.MenuSection{Title: self.c.Tr.OpenRepos, Column: 0}
+	menuItems := lo.Map(tabs, func(path string, i int) *types.MenuItem {
+		name := filepath.Base(path)
+		if path == currentPath {
+			name = style.FgGreen.Sprint(name)
+		}
+
+		var key types.Key
+		if i < 9 {
+			key = rune('1' + i)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{name, style.FgMagenta.Sprint(path)},
+			OnPress:      func() error { return self.SwitchToRepoTab(path) },
+			Key:          key,
+			Section:      tabsSection,
 		}
 	})
 
-	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems})
+	var closeDisabledReason *types.DisabledReason
+	if len(tabs) < 2 {
+		closeDisabledReason = &types.DisabledReason{Text: self.c.Tr.CannotCloseLastRepoTab}
+	}
+
+	actionItems := []*types.MenuItem{
+		{
+			Label:     self.c.Tr.OpenRepoInNewTab,
+			OnPress:   self.CreateRecentReposMenu,
+			OpensMenu: true,
+			Key:       'o',
+		},
+		{
+			Label:          self.c.Tr.CloseRepoTab,
+			Tooltip:        self.c.Tr.CloseRepoTabTooltip,
+			OnPress:        func() error { return self.closeRepoTab(currentPath) },
+			DisabledReason: closeDisabledReason,
+			Key:            'x',
+		},
+	}
+	menuItems = append(actionItems, menuItems...)
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RepoTabs, Items: menuItems})
+}
+
+// closes the tab of the current repo and switches to its neighbour
+func (self *ReposHelper) closeRepoTab(path string) error {
+	tabs := self.c.State().GetRepoTabs()
+	index := lo.IndexOf(tabs, path)
+	next := tabs[lo.Ternary(index > 0, index-1, index+1)]
+
+	self.c.State().CloseRepoTab(path)
+	return self.SwitchToRepoTab(next)
 }
 
 func (self *ReposHelper) DispatchSwitchToRepo(path string, contextKey types.ContextKey) error {
@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRepos(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{
+		"a/.git",
+		"b/nested/.git",
+		// we don't look inside repos
+		"a/sub/.git",
+		// nor inside hidden directories
+		".hidden/repo/.git",
+		// nor deeper than three levels
+		"c/d/e/f/.git",
+		"not-a-repo/src",
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(root, dir), 0o755))
+	}
+	// worktrees and submodules have a .git file rather than a directory
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "c/worktree"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "c/worktree/.git"), []byte("gitdir: ../a/.git"), 0o644))
+
+	assert.Equal(t,
+		[]string{
+			filepath.Join(root, "a"),
+			filepath.Join(root, "b/nested"),
+			filepath.Join(root, "c/worktree"),
+		},
+		findRepos([]string{root, filepath.Join(root, "does-not-exist")}),
+	)
+}
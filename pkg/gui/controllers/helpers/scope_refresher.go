@@ -0,0 +1,113 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+)
+
+// Coordinates concurrent refreshes of a single scope (e.g. branches). Only one
+// refresh of the scope loads at a time; if more refreshes are requested while
+// it's in progress, the current load is cancelled (since its result is about
+// to be outdated anyway), and all of them are served by a single new load that
+// starts when the current one has returned. All of them return once that load
+// has finished. This means that when several refreshes pile up after a series
+// of quick operations, we don't run the same git commands over and over again,
+// while callers still get to see data that is at least as new as their
+// request.
+type scopeRefresher struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+
+	// Incremented for each requested refresh
+	requested int
+	// The value of requested at the time the most recent successful load
+	// started
+	completed int
+	loading   bool
+	// Cancels the current load
+	cancel context.CancelFunc
+	// The merged args of the requests that no load has started for yet
+	pendingArgs *scopeRefreshArgs
+}
+
+// The options of a refresh that affect how a scope is loaded. When refreshes
+// are coalesced, their args are merged so that the load does what each of them
+// asked for.
+type scopeRefreshArgs struct {
+	// Only relevant for branches; see RefreshOptions.KeepBranchSelectionIndex
+	keepBranchSelectionIndex bool
+	// Only relevant for branches
+	loadBehindCounts bool
+}
+
+func mergeScopeRefreshArgs(a *scopeRefreshArgs, b scopeRefreshArgs) scopeRefreshArgs {
+	if a == nil {
+		return b
+	}
+
+	return scopeRefreshArgs{
+		keepBranchSelectionIndex: a.keepBranchSelectionIndex && b.keepBranchSelectionIndex,
+		loadBehindCounts:         a.loadBehindCounts || b.loadBehindCounts,
+	}
+}
+
+func newScopeRefresher() *scopeRefresher {
+	result := &scopeRefresher{}
+	result.cond = sync.NewCond(&result.mutex)
+	return result
+}
+
+// Calls load unless a load that started after this call is made (and thus
+// covers it) completes first, in which case we just wait for that one. load
+// should stop early without publishing anything once ctx is cancelled, and
+// return ctx.Err() in that case; the requests it was meant to serve are then
+// served by the next load.
+func (self *scopeRefresher) refresh(args scopeRefreshArgs, load func(ctx context.Context, args scopeRefreshArgs) error) {
+	self.mutex.Lock()
+	self.requested++
+	request := self.requested
+	merged := mergeScopeRefreshArgs(self.pendingArgs, args)
+	self.pendingArgs = &merged
+
+	if self.loading {
+		self.cancel()
+	}
+
+	for self.loading && self.completed < request {
+		self.cond.Wait()
+	}
+
+	if self.completed >= request {
+		self.mutex.Unlock()
+		return
+	}
+
+	// Any requests made up to now are waiting for us, so our load covers them
+	covers := self.requested
+	loadArgs := *self.pendingArgs
+	self.pendingArgs = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	self.cancel = cancel
+	self.loading = true
+	self.mutex.Unlock()
+
+	var err error
+	defer func() {
+		self.mutex.Lock()
+		defer self.mutex.Unlock()
+
+		self.loading = false
+		if err != nil && ctx.Err() != nil {
+			// The load was cancelled without publishing anything, so the next
+			// load needs to do what it would have done
+			merged := mergeScopeRefreshArgs(self.pendingArgs, loadArgs)
+			self.pendingArgs = &merged
+		} else {
+			self.completed = covers
+		}
+		cancel()
+		self.cond.Broadcast()
+	}()
+
+	err = load(ctx, loadArgs)
+}
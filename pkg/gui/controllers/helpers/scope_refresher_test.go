@@ -0,0 +1,168 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Waits until the given number of refreshes have been requested
+func waitForRequests(t *testing.T, refresher *scopeRefresher, count int) {
+	assert.Eventually(t, func() bool {
+		refresher.mutex.Lock()
+		defer refresher.mutex.Unlock()
+		return refresher.requested == count
+	}, time.Second, time.Millisecond)
+}
+
+func TestScopeRefresherCoalescesPendingRefreshes(t *testing.T) {
+	refresher := newScopeRefresher()
+
+	loads := atomic.Int32{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	load := func(ctx context.Context, _ scopeRefreshArgs) error {
+		if loads.Add(1) == 1 {
+			close(started)
+			<-release
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		refresher.refresh(scopeRefreshArgs{}, load)
+	}()
+	<-started
+
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			refresher.refresh(scopeRefreshArgs{}, load)
+		}()
+	}
+	waitForRequests(t, refresher, 4)
+
+	close(release)
+	wg.Wait()
+
+	// The three refreshes requested during the first load cancel it, and all
+	// four of them share a single new load
+	assert.Equal(t, int32(2), loads.Load())
+}
+
+func TestScopeRefresherMergesArgsOfCoalescedRefreshes(t *testing.T) {
+	refresher := newScopeRefresher()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	loadedArgs := []scopeRefreshArgs{}
+	mutex := sync.Mutex{}
+	load := func(ctx context.Context, args scopeRefreshArgs) error {
+		mutex.Lock()
+		loadedArgs = append(loadedArgs, args)
+		isFirst := len(loadedArgs) == 1
+		mutex.Unlock()
+
+		if isFirst {
+			close(started)
+			<-release
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		refresher.refresh(scopeRefreshArgs{keepBranchSelectionIndex: true, loadBehindCounts: false}, load)
+	}()
+	<-started
+
+	for _, args := range []scopeRefreshArgs{
+		{keepBranchSelectionIndex: true, loadBehindCounts: true},
+		{keepBranchSelectionIndex: false, loadBehindCounts: false},
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			refresher.refresh(args, load)
+		}()
+	}
+	waitForRequests(t, refresher, 3)
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, []scopeRefreshArgs{
+		{keepBranchSelectionIndex: true, loadBehindCounts: false},
+		// The cancelled load's args are merged in too, since it didn't get to
+		// do what it was asked to
+		{keepBranchSelectionIndex: false, loadBehindCounts: true},
+	}, loadedArgs)
+}
+
+func TestScopeRefresherDoesNotRepeatLoadThatIgnoredCancellation(t *testing.T) {
+	refresher := newScopeRefresher()
+
+	loads := atomic.Int32{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	cancelled := atomic.Bool{}
+	load := func(ctx context.Context, _ scopeRefreshArgs) error {
+		if loads.Add(1) == 1 {
+			close(started)
+			<-release
+			cancelled.Store(ctx.Err() != nil)
+		}
+		// A load that finishes despite being cancelled has published its
+		// result, so it counts as a completed load
+		return nil
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		refresher.refresh(scopeRefreshArgs{}, load)
+	}()
+	<-started
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		refresher.refresh(scopeRefreshArgs{}, load)
+	}()
+	waitForRequests(t, refresher, 2)
+
+	close(release)
+	wg.Wait()
+
+	assert.True(t, cancelled.Load())
+	// The second refresh was requested after the first load started, so it
+	// still needs its own load
+	assert.Equal(t, int32(2), loads.Load())
+}
+
+func TestScopeRefresherLoadsAgainAfterCompletion(t *testing.T) {
+	refresher := newScopeRefresher()
+
+	loads := 0
+	load := func(context.Context, scopeRefreshArgs) error {
+		loads++
+		return nil
+	}
+	refresher.refresh(scopeRefreshArgs{}, load)
+	refresher.refresh(scopeRefreshArgs{}, load)
+
+	assert.Equal(t, 2, loads)
+}
@@ -55,7 +55,15 @@ func (self *SearchHelper) OpenSearchPrompt(context types.ISearchableContext) err
 
 	state.Context = context
 
-	self.searchPrefixView().SetContent(self.c.Tr.SearchPrefix)
+	// Make the last search from a previous session available in the history
+	history := context.GetSearchHistory()
+	if _, err := history.PeekAt(0); err != nil {
+		if lastSearch, ok := self.c.GetAppState().LastSearches[string(context.GetKey())]; ok {
+			history.Push(lastSearch)
+		}
+	}
+
+	self.searchPrefixView().SetContent(searchPromptPrefix(self.c))
 	promptView := self.promptView()
 	promptView.ClearTextArea()
 	promptView.RenderTextArea()
@@ -83,9 +91,20 @@ func (self *SearchHelper) DisplaySearchStatus(context types.ISearchableContext)
 
 	state.Context = context
 
-	self.searchPrefixView().SetContent(self.c.Tr.SearchPrefix)
+	self.searchPrefixView().SetContent(searchPromptPrefix(self.c))
 	index, totalCount := context.GetView().GetSearchStatus()
-	context.RenderSearchStatus(index, totalCount)
+	context.RenderSearchStatus(index, totalCount, SearchMatchLineNumber(context))
+}
+
+// Returns the line number of the current search match to show in the search
+// status, or 0 if there is none
+func SearchMatchLineNumber(context types.ISearchableContext) int {
+	lineIdx := context.GetView().CurrentSearchMatchLineIdx()
+	if lineIdx < 0 {
+		return 0
+	}
+
+	return context.SearchMatchLineNumber(lineIdx)
 }
 
 func (self *SearchHelper) searchState() *types.SearchState {
@@ -107,7 +126,12 @@ func (self *SearchHelper) promptContent() string {
 func (self *SearchHelper) Confirm() error {
 	state := self.searchState()
 	if self.promptContent() == "" {
-		return self.CancelPrompt()
+		// Confirming an empty search repeats the last one, like in vim or less
+		lastSearch, ok := self.lastSearch()
+		if !ok {
+			return self.CancelPrompt()
+		}
+		self.promptView().TextArea.TypeString(lastSearch)
 	}
 
 	switch state.SearchType() {
@@ -151,29 +175,114 @@ func (self *SearchHelper) ConfirmSearch() {
 	}
 
 	searchString := self.promptContent()
+	options := self.SearchOptions()
+	if _, err := options.Compile(searchString); err != nil {
+		// Keep the prompt open so that the user can fix the regex
+		self.c.ErrorToast(fmt.Sprintf("%s: %s", self.c.Tr.InvalidRegex, err.Error()))
+		return
+	}
+
 	context.SetSearchString(searchString)
 	if searchString != "" {
 		context.GetSearchHistory().Push(searchString)
+
+		appState := self.c.GetAppState()
+		if appState.LastSearches == nil {
+			appState.LastSearches = map[string]string{}
+		}
+		appState.LastSearches[string(context.GetKey())] = searchString
+		self.c.SaveAppStateAndLogError()
 	}
 
 	self.c.Context().Pop()
 
-	context.GetView().Search(searchString, modelSearchResults(context))
+	view := context.GetView()
+	view.SetSearchOptions(options)
+	view.Search(searchString, modelSearchResults(context, options))
+}
+
+func (self *SearchHelper) lastSearch() (string, bool) {
+	state := self.searchState()
+	context, ok := state.Context.(types.ISearchableContext)
+	if !ok || state.SearchType() != types.SearchTypeSearch {
+		return "", false
+	}
+
+	lastSearch, err := context.GetSearchHistory().PeekAt(0)
+	return lastSearch, err == nil
 }
 
-func modelSearchResults(context types.ISearchableContext) []gocui.SearchPosition {
-	searchString := context.GetSearchString()
+func modelSearchResults(context types.ISearchableContext, options gocui.SearchOptions) []gocui.SearchPosition {
+	matches, err := options.Matcher(context.GetSearchString())
+	if err != nil {
+		matches = func(string) bool { return false }
+	}
+
+	return context.ModelSearchResults(matches)
+}
+
+// SearchOptions returns the options for new searches, as toggled in the
+// search prompt
+func (self *SearchHelper) SearchOptions() gocui.SearchOptions {
+	appState := self.c.GetAppState()
+	caseMode := gocui.SearchCaseSmart
+	switch appState.SearchCaseSensitivity {
+	case "sensitive":
+		caseMode = gocui.SearchCaseSensitive
+	case "insensitive":
+		caseMode = gocui.SearchCaseInsensitive
+	}
+
+	return gocui.SearchOptions{CaseMode: caseMode, Regex: appState.SearchRegex}
+}
+
+func (self *SearchHelper) ToggleRegex() {
+	appState := self.c.GetAppState()
+	appState.SearchRegex = !appState.SearchRegex
+	self.c.SaveAppStateAndLogError()
+
+	self.searchPrefixView().SetContent(searchPromptPrefix(self.c))
+}
+
+// CycleCaseSensitivity switches between smart case (case-sensitive only if
+// the search string contains uppercase characters), case-sensitive, and
+// case-insensitive
+func (self *SearchHelper) CycleCaseSensitivity() {
+	appState := self.c.GetAppState()
+	switch appState.SearchCaseSensitivity {
+	case "":
+		appState.SearchCaseSensitivity = "sensitive"
+	case "sensitive":
+		appState.SearchCaseSensitivity = "insensitive"
+	default:
+		appState.SearchCaseSensitivity = ""
+	}
+	self.c.SaveAppStateAndLogError()
+
+	self.searchPrefixView().SetContent(searchPromptPrefix(self.c))
+}
+
+// The prefix of the search prompt, including the search options if they
+// differ from the defaults, e.g. 'Search (regex, ignore case): '
+func searchPromptPrefix(c *HelperCommon) string {
+	appState := c.GetAppState()
+	options := []string{}
+	if appState.SearchRegex {
+		options = append(options, c.Tr.SearchOptionRegex)
+	}
+	switch appState.SearchCaseSensitivity {
+	case "sensitive":
+		options = append(options, c.Tr.SearchOptionCaseSensitive)
+	case "insensitive":
+		options = append(options, c.Tr.SearchOptionIgnoreCase)
+	}
 
-	var normalizedSearchStr string
-	// if we have any uppercase characters we'll do a case-sensitive search
-	caseSensitive := utils.ContainsUppercase(searchString)
-	if caseSensitive {
-		normalizedSearchStr = searchString
-	} else {
-		normalizedSearchStr = strings.ToLower(searchString)
+	if len(options) == 0 {
+		return c.Tr.SearchPrefix
 	}
 
-	return context.ModelSearchResults(normalizedSearchStr, caseSensitive)
+	return utils.ResolvePlaceholderString(c.Tr.SearchPrefixWithOptions,
+		map[string]string{"options": strings.Join(options, ", ")})
 }
 
 func (self *SearchHelper) CancelPrompt() error {
@@ -254,7 +363,8 @@ func (self *SearchHelper) ReApplySearch(ctx types.Context) {
 	// to the view.
 	searchableContext, ok := ctx.(types.ISearchableContext)
 	if ok {
-		ctx.GetView().UpdateSearchResults(searchableContext.GetSearchString(), modelSearchResults(searchableContext))
+		view := ctx.GetView()
+		view.UpdateSearchResults(searchableContext.GetSearchString(), modelSearchResults(searchableContext, view.GetSearchOptions()))
 
 		state := self.searchState()
 		if ctx == state.Context {
@@ -0,0 +1,154 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The setup wizard is offered on the very first start of lazygit, when the
+// user doesn't have a config file yet. It asks for a few settings that most
+// people want to change, and writes them to a commented config file so that
+// it's easy to find out where to change them later.
+
+type SetupWizardHelper struct {
+	c *HelperCommon
+}
+
+func NewSetupWizardHelper(c *HelperCommon) *SetupWizardHelper {
+	return &SetupWizardHelper{
+		c: c,
+	}
+}
+
+type setupWizardOption struct {
+	label string
+	value string
+}
+
+type setupWizardStep struct {
+	title   string
+	options []setupWizardOption
+	apply   func(choices *config.FirstRunChoices, value string)
+}
+
+// the editor presets we offer in the wizard; the others can still be
+// configured by editing the config file
+var setupWizardEditPresets = []string{"vim", "nvim", "emacs", "nano", "micro", "helix", "vscode", "sublime", "zed"}
+
+// ShouldShow returns true if this is the first start of lazygit and the user
+// hasn't created a config file themselves.
+func (self *SetupWizardHelper) ShouldShow() bool {
+	return self.c.GetAppState().StartupPopupVersion == 0 && self.c.GetConfig().IsGlobalUserConfigEmpty()
+}
+
+// Start shows the steps of the wizard one after the other, and writes the
+// config file after the last one. onDone is called when the wizard is either
+// finished or cancelled.
+func (self *SetupWizardHelper) Start(onDone func() error) error {
+	choices := &config.FirstRunChoices{PullMode: "auto"}
+	return self.showStep(self.steps(), 0, choices, onDone)
+}
+
+func (self *SetupWizardHelper) steps() []setupWizardStep {
+	tr := self.c.Tr
+
+	themeOptions := []setupWizardOption{{label: tr.SetupWizardDefaultTheme, value: ""}}
+	for _, theme := range config.BundledThemeNames() {
+		themeOptions = append(themeOptions, setupWizardOption{label: theme, value: theme})
+	}
+
+	nerdFontsOption := func(version string) setupWizardOption {
+		return setupWizardOption{
+			label: utils.ResolvePlaceholderString(tr.SetupWizardNerdFontsVersion, map[string]string{"version": version}),
+			value: version,
+		}
+	}
+
+	editorOptions := []setupWizardOption{{label: tr.SetupWizardEditorFromEnv, value: ""}}
+	for _, preset := range setupWizardEditPresets {
+		editorOptions = append(editorOptions, setupWizardOption{label: preset, value: preset})
+	}
+
+	return []setupWizardStep{
+		{
+			title:   tr.SetupWizardTheme,
+			options: themeOptions,
+			apply:   func(choices *config.FirstRunChoices, value string) { choices.ThemeFile = value },
+		},
+		{
+			title: tr.SetupWizardIcons,
+			options: []setupWizardOption{
+				{label: tr.SetupWizardNoIcons, value: ""},
+				nerdFontsOption("3"),
+				nerdFontsOption("2"),
+			},
+			apply: func(choices *config.FirstRunChoices, value string) { choices.NerdFontsVersion = value },
+		},
+		{
+			title:   tr.SetupWizardEditor,
+			options: editorOptions,
+			apply:   func(choices *config.FirstRunChoices, value string) { choices.EditPreset = value },
+		},
+		{
+			title: tr.SetupWizardPullMode,
+			options: []setupWizardOption{
+				{label: tr.SetupWizardPullModeAuto, value: "auto"},
+				{label: tr.SetupWizardPullModeMerge, value: "merge"},
+				{label: tr.SetupWizardPullModeRebase, value: "rebase"},
+				{label: tr.SetupWizardPullModeFastForwardOnly, value: "ff-only"},
+			},
+			apply: func(choices *config.FirstRunChoices, value string) { choices.PullMode = value },
+		},
+	}
+}
+
+func (self *SetupWizardHelper) showStep(steps []setupWizardStep, index int, choices *config.FirstRunChoices, onDone func() error) error {
+	if index == len(steps) {
+		writeErr := self.writeConfig(*choices)
+		if err := onDone(); err != nil {
+			return err
+		}
+		return writeErr
+	}
+
+	step := steps[index]
+	menuItems := lo.Map(step.options, func(option setupWizardOption, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: option.label,
+			OnPress: func() error {
+				step.apply(choices, option.value)
+				return self.showStep(steps, index+1, choices, onDone)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.SetupWizardTitle, map[string]string{
+			"step":  fmt.Sprint(index + 1),
+			"total": fmt.Sprint(len(steps)),
+			"title": step.title,
+		}),
+		Prompt:     lo.Ternary(index == 0, self.c.Tr.SetupWizardPrompt, ""),
+		Items:      menuItems,
+		HideCancel: true,
+		OnCancel:   onDone,
+	})
+}
+
+func (self *SetupWizardHelper) writeConfig(choices config.FirstRunChoices) error {
+	path, err := self.c.GetConfig().WriteGlobalUserConfig(config.GenerateFirstRunConfig(choices))
+	if err != nil {
+		return err
+	}
+
+	if err := self.c.ReloadChangedUserConfigFiles(); err != nil {
+		return err
+	}
+
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.SetupWizardConfigWritten, map[string]string{"path": path}))
+	return nil
+}
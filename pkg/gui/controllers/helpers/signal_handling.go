@@ -7,12 +7,15 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/jesseduffield/lazygit/pkg/app/session"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
 
+// In a session, stopping lazygit would leave the server and every client that's
+// attached to it hanging, and the terminal we'd return to isn't the user's
 func canSuspendApp() bool {
-	return true
+	return !session.InSession()
 }
 
 func sendStopSignal() error {
@@ -37,6 +37,9 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 			Limit:                   true,
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterSince:             self.c.Modes().Filtering.GetSince(),
+			FilterUntil:             self.c.Modes().Filtering.GetUntil(),
+			FilterContent:           self.c.Modes().Filtering.ContentFilter(),
 			IncludeRebaseCommits:    false,
 			RefName:                 opts.Ref.FullRefName(),
 			RefForPushedStatus:      opts.Ref,
@@ -2,6 +2,8 @@ package helpers
 
 import (
 	"fmt"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
@@ -11,6 +13,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"golang.org/x/exp/slices"
 	"gopkg.in/ozeidan/fuzzy-patricia.v3/patricia"
 )
@@ -149,6 +152,39 @@ func (self *SuggestionsHelper) GetFilePathSuggestionsFunc() func(string) []*type
 	}
 }
 
+// Unlike GetFilePathSuggestionsFunc, this completes paths anywhere on the file
+// system, not just in the repo, e.g. for patch files that were saved outside of
+// it. It works like completion in a shell: we suggest the entries of the
+// directory typed so far that start with the last path component. Directories
+// get a trailing slash so that you can carry on typing after picking one.
+func (self *SuggestionsHelper) GetFileSystemPathSuggestionsFunc() func(string) []*types.Suggestion {
+	return func(input string) []*types.Suggestion {
+		dir, prefix := filepath.Split(input)
+
+		entries, err := afero.ReadDir(self.c.Fs, lo.Ternary(dir == "", ".", dir))
+		if err != nil {
+			return nil
+		}
+
+		matchingPaths := []string{}
+		for _, entry := range entries {
+			name := entry.Name()
+			// only show hidden files if they've been asked for
+			if !strings.HasPrefix(name, prefix) || (strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".")) {
+				continue
+			}
+
+			path := dir + name
+			if entry.IsDir() {
+				path += "/"
+			}
+			matchingPaths = append(matchingPaths, path)
+		}
+
+		return matchesToSuggestions(matchingPaths)
+	}
+}
+
 func (self *SuggestionsHelper) getRemoteBranchNames(separator string) []string {
 	return lo.FlatMap(self.c.Model().Remotes, func(remote *models.Remote, _ int) []string {
 		return lo.Map(remote.Branches, func(branch *models.RemoteBranch, _ int) string {
@@ -210,6 +246,24 @@ func (self *SuggestionsHelper) GetAuthorsSuggestionsFunc() func(string) []*types
 	return FilterFunc(authors, self.c.UserConfig().Gui.UseFuzzySearch())
 }
 
+var conventionalCommitScopeRegexp = regexp.MustCompile(`^\w+\(([^)]+)\)!?: `)
+
+// Suggests the scopes of the conventional commits that are loaded in the
+// commits panel
+func (self *SuggestionsHelper) GetConventionalCommitScopesSuggestionsFunc() func(string) []*types.Suggestion {
+	scopes := lo.Uniq(lo.FilterMap(self.c.Model().Commits, func(commit *models.Commit, _ int) (string, bool) {
+		match := conventionalCommitScopeRegexp.FindStringSubmatch(commit.Name)
+		if match == nil {
+			return "", false
+		}
+		return match[1], true
+	}))
+
+	slices.Sort(scopes)
+
+	return FilterFunc(scopes, self.c.UserConfig().Gui.UseFuzzySearch())
+}
+
 func FilterFunc(options []string, useFuzzySearch bool) func(string) []*types.Suggestion {
 	return func(input string) []*types.Suggestion {
 		var matches []string
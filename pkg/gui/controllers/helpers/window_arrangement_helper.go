@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
 
@@ -44,6 +45,12 @@ type WindowArrangementArgs struct {
 	Height int
 	// User config
 	UserConfig *config.UserConfig
+	// App state, which holds any adjustments to the side panel layout made at
+	// runtime. May be nil.
+	AppState *config.AppState
+	// Panel sizes of the current repo as adjusted at runtime. Zero values mean
+	// that the defaults apply.
+	PanelSizes config.PanelSizes
 	// Name of the currently focused window. (It's actually the current static window, meaning
 	// popups are ignored)
 	CurrentWindow string
@@ -82,13 +89,15 @@ func (self *WindowArrangementHelper) GetWindowDimensions(informationStr string,
 	if filterableContext, ok := repoState.GetSearchState().Context.(types.IFilterableContext); ok {
 		searchPrefix = filterableContext.FilterPrefix(self.c.Tr)
 	} else {
-		searchPrefix = self.c.Tr.SearchPrefix
+		searchPrefix = searchPromptPrefix(self.c)
 	}
 
 	args := WindowArrangementArgs{
 		Width:             width,
 		Height:            height,
 		UserConfig:        self.c.UserConfig(),
+		AppState:          self.c.GetAppState(),
+		PanelSizes:        self.windowHelper.PanelSizes(),
 		CurrentWindow:     self.c.Context().CurrentStatic().GetWindowName(),
 		CurrentSideWindow: self.c.Context().CurrentSide().GetWindowName(),
 		SplitMainPanel:    repoState.GetSplitMainPanel(),
@@ -110,6 +119,13 @@ func shouldUsePortraitMode(args WindowArrangementArgs) bool {
 		return args.UserConfig.Gui.EnlargedSideViewLocation == "top"
 	}
 
+	switch args.UserConfig.Gui.MainPanelPosition {
+	case "bottom":
+		return true
+	case "left", "right":
+		return false
+	}
+
 	switch args.UserConfig.Gui.PortraitMode {
 	case "never":
 		return false
@@ -138,24 +154,35 @@ func GetWindowDimensions(args WindowArrangementArgs) map[string]boxlayout.Dimens
 		infoSectionSize = 1
 	}
 
+	sideSectionDirection := boxlayout.ROW
+	if args.UserConfig.Gui.SidePanelsStacking == "horizontal" {
+		sideSectionDirection = boxlayout.COLUMN
+	}
+
+	sideSectionBox := &boxlayout.Box{
+		Direction:           sideSectionDirection,
+		Weight:              sideSectionWeight,
+		ConditionalChildren: sidePanelChildren(args),
+	}
+	if fixedWidth := getSidePanelFixedWidth(args); fixedWidth > 0 &&
+		sidePanelsDirection == boxlayout.COLUMN && sideSectionWeight > 0 && mainSectionWeight > 0 {
+		sideSectionBox.Weight = 0
+		sideSectionBox.Size = fixedWidth
+	}
+
+	mainSectionBox := &boxlayout.Box{
+		Direction: boxlayout.ROW,
+		Weight:    mainSectionWeight,
+		Children:  mainPanelChildren(args),
+	}
+
 	root := &boxlayout.Box{
 		Direction: boxlayout.ROW,
 		Children: []*boxlayout.Box{
 			{
 				Direction: sidePanelsDirection,
 				Weight:    1,
-				Children: []*boxlayout.Box{
-					{
-						Direction:           boxlayout.ROW,
-						Weight:              sideSectionWeight,
-						ConditionalChildren: sidePanelChildren(args),
-					},
-					{
-						Direction: boxlayout.ROW,
-						Weight:    mainSectionWeight,
-						Children:  mainPanelChildren(args),
-					},
-				},
+				Children:  midSectionChildren(args, sideSectionBox, mainSectionBox),
 			},
 			{
 				Direction: boxlayout.COLUMN,
@@ -171,12 +198,30 @@ func GetWindowDimensions(args WindowArrangementArgs) map[string]boxlayout.Dimens
 	return MergeMaps(layerOneWindows, limitWindows)
 }
 
+func midSectionChildren(args WindowArrangementArgs, sideSectionBox *boxlayout.Box, mainSectionBox *boxlayout.Box) []*boxlayout.Box {
+	if args.UserConfig.Gui.MainPanelPosition == "left" {
+		return []*boxlayout.Box{mainSectionBox, sideSectionBox}
+	}
+
+	return []*boxlayout.Box{sideSectionBox, mainSectionBox}
+}
+
 func mainPanelChildren(args WindowArrangementArgs) []*boxlayout.Box {
 	mainPanelsDirection := boxlayout.ROW
 	if splitMainPanelSideBySide(args) {
 		mainPanelsDirection = boxlayout.COLUMN
 	}
 
+	// In full screen mode the focused command log takes up the whole section
+	if args.CurrentWindow == "extras" && args.ScreenMode == types.SCREEN_FULL {
+		return []*boxlayout.Box{
+			{
+				Window: "extras",
+				Weight: 1,
+			},
+		}
+	}
+
 	result := []*boxlayout.Box{
 		{
 			Direction: mainPanelsDirection,
@@ -223,20 +268,26 @@ func mainSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 		}
 	}
 
+	mainWeight, secondaryWeight := 1, 1
+	if split := args.PanelSizes.MainViewSplit; split > 0 && split < 1 {
+		mainWeight = int(math.Round(split * 100))
+		secondaryWeight = 100 - mainWeight
+	}
+
 	return []*boxlayout.Box{
 		{
 			Window: "main",
-			Weight: 1,
+			Weight: mainWeight,
 		},
 		{
 			Window: "secondary",
-			Weight: 1,
+			Weight: secondaryWeight,
 		},
 	}
 }
 
 func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
-	sidePanelWidthRatio := args.UserConfig.Gui.SidePanelWidth
+	sidePanelWidthRatio := GetSidePanelWidth(args.UserConfig, args.AppState, args.PanelSizes)
 	// Using 120 so that the default of 0.3333 will remain consistent with previous behavior
 	const maxColumnCount = 120
 	mainSectionWeight := int(math.Round(maxColumnCount * (1 - sidePanelWidthRatio)))
@@ -246,7 +297,7 @@ func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 		mainSectionWeight = sideSectionWeight * 5 // need to shrink side panel to make way for main panels if side-by-side
 	}
 
-	if args.CurrentWindow == "main" || args.CurrentWindow == "secondary" {
+	if args.CurrentWindow == "main" || args.CurrentWindow == "secondary" || args.CurrentWindow == "extras" {
 		if args.ScreenMode == types.SCREEN_HALF || args.ScreenMode == types.SCREEN_FULL {
 			sideSectionWeight = 0
 		}
@@ -265,6 +316,16 @@ func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 	return sideSectionWeight, mainSectionWeight
 }
 
+// A fixed width only applies if the side panel width hasn't been adjusted at
+// runtime; otherwise the adjustment would have no visible effect.
+func getSidePanelFixedWidth(args WindowArrangementArgs) int {
+	if args.PanelSizes.SidePanelWidth > 0 || (args.AppState != nil && args.AppState.SidePanelWidth > 0) {
+		return 0
+	}
+
+	return args.UserConfig.Gui.SidePanelFixedWidth
+}
+
 func infoSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 	if args.InSearchPrompt {
 		return []*boxlayout.Box{
@@ -420,6 +481,15 @@ func getDefaultStashWindowBox(args WindowArrangementArgs) *boxlayout.Box {
 }
 
 func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) []*boxlayout.Box {
+	windows := GetSideWindows(args.UserConfig, args.AppState)
+	// If the focused side window is one that the user has hidden (e.g. because
+	// some action switched to it), show it at the bottom for as long as it has
+	// focus so that the user can see what they're doing.
+	if args.CurrentSideWindow != "" && !lo.Contains(windows, args.CurrentSideWindow) &&
+		lo.Contains(config.SidePanelNames, args.CurrentSideWindow) {
+		windows = append(slices.Clone(windows), args.CurrentSideWindow)
+	}
+
 	return func(width int, height int) []*boxlayout.Box {
 		if args.ScreenMode == types.SCREEN_FULL || args.ScreenMode == types.SCREEN_HALF {
 			fullHeightBox := func(window string) *boxlayout.Box {
@@ -436,36 +506,45 @@ func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) [
 				}
 			}
 
-			return []*boxlayout.Box{
-				fullHeightBox("status"),
-				fullHeightBox("files"),
-				fullHeightBox("branches"),
-				fullHeightBox("commits"),
-				fullHeightBox("stash"),
+			return lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+				return fullHeightBox(window)
+			})
+		}
+
+		accordionMode := args.UserConfig.Gui.ExpandFocusedSidePanel
+		accordionBox := func(defaultBox *boxlayout.Box) *boxlayout.Box {
+			if accordionMode && defaultBox.Window == args.CurrentSideWindow {
+				return &boxlayout.Box{
+					Window: defaultBox.Window,
+					Weight: args.UserConfig.Gui.ExpandedSidePanelWeight,
+				}
 			}
-		} else if height >= 28 {
-			accordionMode := args.UserConfig.Gui.ExpandFocusedSidePanel
-			accordionBox := func(defaultBox *boxlayout.Box) *boxlayout.Box {
-				if accordionMode && defaultBox.Window == args.CurrentSideWindow {
+
+			return defaultBox
+		}
+
+		if args.UserConfig.Gui.SidePanelsStacking == "horizontal" {
+			// When the panels are side by side they all get the full height, so
+			// there's no need to squash any of them; just share the width equally.
+			return lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+				return accordionBox(&boxlayout.Box{Window: window, Weight: 1})
+			})
+		}
+
+		if height >= 28 {
+			return lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+				switch window {
+				case "status":
 					return &boxlayout.Box{
-						Window: defaultBox.Window,
-						Weight: args.UserConfig.Gui.ExpandedSidePanelWeight,
+						Window: "status",
+						Size:   3,
 					}
+				case "stash":
+					return accordionBox(getDefaultStashWindowBox(args))
+				default:
+					return accordionBox(&boxlayout.Box{Window: window, Weight: 1})
 				}
-
-				return defaultBox
-			}
-
-			return []*boxlayout.Box{
-				{
-					Window: "status",
-					Size:   3,
-				},
-				accordionBox(&boxlayout.Box{Window: "files", Weight: 1}),
-				accordionBox(&boxlayout.Box{Window: "branches", Weight: 1}),
-				accordionBox(&boxlayout.Box{Window: "commits", Weight: 1}),
-				accordionBox(getDefaultStashWindowBox(args)),
-			}
+			})
 		}
 
 		squashedHeight := 1
@@ -487,12 +566,8 @@ func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) [
 			}
 		}
 
-		return []*boxlayout.Box{
-			squashedSidePanelBox("status"),
-			squashedSidePanelBox("files"),
-			squashedSidePanelBox("branches"),
-			squashedSidePanelBox("commits"),
-			squashedSidePanelBox("stash"),
-		}
+		return lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+			return squashedSidePanelBox(window)
+		})
 	}
 }
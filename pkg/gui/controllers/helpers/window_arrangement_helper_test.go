@@ -282,6 +282,333 @@ func TestGetWindowDimensions(t *testing.T) {
 			B: information
 			`,
 		},
+		{
+			name: "custom side panels",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanels = []string{"branches", "files", "commits"}
+			},
+			expected: `
+			╭branches───────────────╮╭main────────────────────────────────────────────╮
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭files──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭commits────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯╰────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "hidden side window is shown while focused",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanels = []string{"status", "files", "branches", "commits"}
+				args.CurrentWindow = "stash"
+				args.CurrentSideWindow = "stash"
+			},
+			expected: `
+			╭status─────────────────╮╭main────────────────────────────────────────────╮
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭files──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭branches───────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭commits────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭stash──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯╰────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "side panels adjusted at runtime",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanels = []string{"branches", "files", "commits"}
+				args.AppState = &config.AppState{
+					SidePanels:     []string{"status", "commits", "files"},
+					SidePanelWidth: 0.5,
+				}
+			},
+			expected: `
+			╭status──────────────────────────────╮╭main───────────────────────────────╮
+			│                                    ││                                   │
+			╰────────────────────────────────────╯│                                   │
+			╭commits─────────────────────────────╮│                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯│                                   │
+			╭files───────────────────────────────╮│                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯╰───────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "panel sizes of the repo take precedence over the app state",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.SplitMainPanel = true
+				args.UserConfig.Gui.MainPanelSplitMode = "vertical"
+				args.AppState = &config.AppState{
+					SidePanelWidth: 0.5,
+				}
+				args.PanelSizes = config.PanelSizes{
+					SidePanelWidth: 0.25,
+					MainViewSplit:  0.75,
+				}
+			},
+			expected: `
+			╭status───────────╮╭main──────────────────────────────────────────────────╮
+			│                 ││                                                      │
+			╰─────────────────╯│                                                      │
+			╭files────────────╮│                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			╰─────────────────╯│                                                      │
+			╭branches─────────╮│                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			│                 ││                                                      │
+			╰─────────────────╯│                                                      │
+			╭commits──────────╮│                                                      │
+			│                 ││                                                      │
+			│                 │╰──────────────────────────────────────────────────────╯
+			│                 │╭secondary─────────────────────────────────────────────╮
+			│                 ││                                                      │
+			│                 ││                                                      │
+			╰─────────────────╯│                                                      │
+			╭stash────────────╮│                                                      │
+			│                 ││                                                      │
+			╰─────────────────╯╰──────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "fixed side panel width",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanelFixedWidth = 20
+			},
+			expected: `
+			╭status────────────╮╭main─────────────────────────────────────────────────╮
+			│                  ││                                                     │
+			╰──────────────────╯│                                                     │
+			╭files─────────────╮│                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			╰──────────────────╯│                                                     │
+			╭branches──────────╮│                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			╰──────────────────╯│                                                     │
+			╭commits───────────╮│                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			│                  ││                                                     │
+			╰──────────────────╯│                                                     │
+			╭stash─────────────╮│                                                     │
+			│                  ││                                                     │
+			╰──────────────────╯╰─────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "main panel on the left",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.MainPanelPosition = "left"
+			},
+			expected: `
+			╭main────────────────────────────────────────────╮╭status─────────────────╮
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭files──────────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭branches───────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭commits────────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭stash──────────────────╮
+			│                                                ││                       │
+			╰────────────────────────────────────────────────╯╰───────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "main panel at the bottom",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.MainPanelPosition = "bottom"
+			},
+			expected: `
+			<status───────────────────────────────────────────────────────────────────>
+			╭files────────────────────────────────────────────────────────────────────╮
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			╰─────────────────────────────────────────────────────────────────────────╯
+			<branches─────────────────────────────────────────────────────────────────>
+			<commits──────────────────────────────────────────────────────────────────>
+			<stash────────────────────────────────────────────────────────────────────>
+			╭main─────────────────────────────────────────────────────────────────────╮
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			╰─────────────────────────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "side panels stacked horizontally",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Width = 150
+				args.Height = 20
+				args.UserConfig.Gui.MainPanelPosition = "bottom"
+				args.UserConfig.Gui.SidePanelsStacking = "horizontal"
+			},
+			expected: `
+			╭status──────────────────────╮╭files───────────────────────╮╭branches────────────────────╮╭commits─────────────────────╮╭stash───────────────────────╮
+			│                            ││                            ││                            ││                            ││                            │
+			│                            ││                            ││                            ││                            ││                            │
+			│                            ││                            ││                            ││                            ││                            │
+			│                            ││                            ││                            ││                            ││                            │
+			│                            ││                            ││                            ││                            ││                            │
+			╰────────────────────────────╯╰────────────────────────────╯╰────────────────────────────╯╰────────────────────────────╯╰────────────────────────────╯
+			╭main────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────╮
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			│                                                                                                                                                    │
+			╰────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────╯
+			<options─────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
 		{
 			name: "half screen mode, enlargedSideViewLocation left",
 			mutateArgs: func(args *WindowArrangementArgs) {
@@ -346,6 +673,39 @@ func TestGetWindowDimensions(t *testing.T) {
 			B: information
 			`,
 		},
+		{
+			name: "full screen mode, command log focused",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 20 // smaller height because we don't need more here
+				args.ScreenMode = types.SCREEN_FULL
+				args.ShowExtrasWindow = true
+				args.CurrentWindow = "extras"
+			},
+			expected: `
+			╭extras───────────────────────────────────────────────────────────────────╮
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			╰─────────────────────────────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
 		{
 			name: "portrait auto mode, enabled",
 			mutateArgs: func(args *WindowArrangementArgs) {
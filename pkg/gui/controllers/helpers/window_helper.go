@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -134,6 +135,54 @@ func (self *WindowHelper) WindowForView(viewName string) string {
 	return context.GetWindowName()
 }
 
+// SideWindows returns the side windows that are currently shown, in
+// top-to-bottom order
 func (self *WindowHelper) SideWindows() []string {
-	return []string{"status", "files", "branches", "commits", "stash"}
+	return GetSideWindows(self.c.UserConfig(), self.c.GetAppState())
+}
+
+// GetSideWindows returns the side windows to show, taking into account any
+// adjustments made at runtime (which are stored in the app state) on top of the
+// user config
+func GetSideWindows(userConfig *config.UserConfig, appState *config.AppState) []string {
+	if appState != nil && len(appState.SidePanels) > 0 &&
+		config.ValidateSidePanels("sidePanels", appState.SidePanels) == nil {
+		return appState.SidePanels
+	}
+
+	return userConfig.Gui.SidePanels
+}
+
+// GetSidePanelWidth returns the fraction of the screen width to use for the
+// side section, taking into account any runtime adjustment
+func GetSidePanelWidth(userConfig *config.UserConfig, appState *config.AppState, panelSizes config.PanelSizes) float64 {
+	if panelSizes.SidePanelWidth > 0 && panelSizes.SidePanelWidth < 1 {
+		return panelSizes.SidePanelWidth
+	}
+
+	if appState != nil && appState.SidePanelWidth > 0 && appState.SidePanelWidth < 1 {
+		return appState.SidePanelWidth
+	}
+
+	return userConfig.Gui.SidePanelWidth
+}
+
+// PanelSizes returns the panel sizes of the current repo, as adjusted at
+// runtime
+func (self *WindowHelper) PanelSizes() config.PanelSizes {
+	return self.c.GetAppState().PanelSizes[self.c.Git().RepoPaths.WorktreePath()]
+}
+
+func (self *WindowHelper) SetPanelSizes(panelSizes config.PanelSizes) {
+	appState := self.c.GetAppState()
+	path := self.c.Git().RepoPaths.WorktreePath()
+	if panelSizes == (config.PanelSizes{}) {
+		delete(appState.PanelSizes, path)
+	} else {
+		if appState.PanelSizes == nil {
+			appState.PanelSizes = map[string]config.PanelSizes{}
+		}
+		appState.PanelSizes[path] = panelSizes
+	}
+	self.c.SaveAppStateAndLogError()
 }
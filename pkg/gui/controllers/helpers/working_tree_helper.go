@@ -145,11 +145,13 @@ func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage strin
 }
 
 func (self *WorkingTreeHelper) handleCommit(summary string, description string, forceSkipHooks bool) error {
-	cmdObj := self.c.Git().Commit.CommitCmdObj(summary, description, forceSkipHooks)
+	signing := self.c.Model().NextCommitSigning
+	cmdObj := self.c.Git().Commit.CommitCmdObj(summary, description, forceSkipHooks, signing)
 	self.c.LogAction(self.c.Tr.Actions.Commit)
-	return self.gpgHelper.WithGpgHandling(cmdObj, git_commands.CommitGpgSign, self.c.Tr.CommittingStatus,
+	return self.gpgHelper.WithNewCommitGpgHandling(cmdObj, signing, self.c.Tr.CommittingStatus,
 		func() error {
 			self.commitsHelper.ClearPreservedCommitMessage()
+			self.c.Model().NextCommitSigning = git_commands.CommitSigningDefault
 			return nil
 		}, nil)
 }
@@ -165,7 +167,7 @@ func (self *WorkingTreeHelper) switchFromCommitMessagePanelToEditor(filepath str
 
 	self.c.LogAction(self.c.Tr.Actions.Commit)
 	return self.c.RunSubprocessAndRefresh(
-		self.c.Git().Commit.CommitInEditorWithMessageFileCmdObj(filepath, forceSkipHooks),
+		self.c.Git().Commit.CommitInEditorWithMessageFileCmdObj(filepath, forceSkipHooks, self.consumeNextCommitSigning()),
 	)
 }
 
@@ -179,11 +181,38 @@ func (self *WorkingTreeHelper) HandleCommitEditorPress() error {
 
 		self.c.LogAction(self.c.Tr.Actions.Commit)
 		return self.c.RunSubprocessAndRefresh(
-			self.c.Git().Commit.CommitEditorCmdObj(),
+			self.c.Git().Commit.CommitEditorCmdObj(self.consumeNextCommitSigning()),
 		)
 	})
 }
 
+// Returns the signing option for the next commit and resets it. Used for
+// commits made in the editor; we can't tell whether these succeed, so (like
+// the preserved commit message) we reset it regardless.
+func (self *WorkingTreeHelper) consumeNextCommitSigning() git_commands.CommitSigning {
+	signing := self.c.Model().NextCommitSigning
+	self.c.Model().NextCommitSigning = git_commands.CommitSigningDefault
+	return signing
+}
+
+// Toggles whether the next commit will be signed. If that brings us back to
+// what commit.gpgSign says, we just go back to the default.
+func (self *WorkingTreeHelper) ToggleNextCommitSigning() error {
+	config := self.c.Git().Config
+	sign := !config.WillSignNewCommit(self.c.Model().NextCommitSigning)
+	switch {
+	case sign == config.WillSignNewCommit(git_commands.CommitSigningDefault):
+		self.c.Model().NextCommitSigning = git_commands.CommitSigningDefault
+	case sign:
+		self.c.Model().NextCommitSigning = git_commands.CommitSigningOn
+	default:
+		self.c.Model().NextCommitSigning = git_commands.CommitSigningOff
+	}
+
+	self.c.Toast(lo.Ternary(sign, self.c.Tr.NextCommitWillBeSigned, self.c.Tr.NextCommitWillNotBeSigned))
+	return nil
+}
+
 func (self *WorkingTreeHelper) HandleWIPCommitPress() error {
 	var initialMessage string
 	preservedMessage := self.c.Contexts().CommitMessage.GetPreservedMessageAndLogError()
@@ -216,11 +245,30 @@ func (self *WorkingTreeHelper) HandleCommitPress() error {
 				break
 			}
 		}
+
+		message, err := self.newCommitMessage(initialMessage)
+		if err != nil {
+			return err
+		}
+		initialMessage = message
 	}
 
 	return self.HandleCommitPressWithMessage(initialMessage, false)
 }
 
+// Puts the commit prefix (if any) in front of the commit message template, and
+// lets the prepare-commit-msg hook have its say, so that the commit message
+// panel starts out with what `git commit` would show in the editor
+func (self *WorkingTreeHelper) newCommitMessage(prefix string) (string, error) {
+	template, err := self.c.Git().Commit.GetCommitMessageTemplate()
+	if err != nil {
+		return "", err
+	}
+
+	source := lo.Ternary(template != "", "template", "")
+	return self.c.Git().Commit.RunPrepareCommitMsgHook(prefix+template, source)
+}
+
 func (self *WorkingTreeHelper) WithEnsureCommittableFiles(handler func() error) error {
 	if err := self.prepareFilesForCommit(); err != nil {
 		return err
@@ -262,7 +310,7 @@ func (self *WorkingTreeHelper) syncRefresh() {
 
 func (self *WorkingTreeHelper) prepareFilesForCommit() error {
 	noStagedFiles := !self.AnyStagedFiles()
-	if noStagedFiles && self.c.UserConfig().Gui.SkipNoStagedFilesWarning {
+	if noStagedFiles && !self.c.UserConfig().Confirmations.CommitWithoutStagedFiles {
 		self.c.LogAction(self.c.Tr.Actions.StageAllFiles)
 		err := self.c.Git().WorkingTree.StageAll(false)
 		if err != nil {
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -94,66 +95,82 @@ func (self *WorktreeHelper) NewWorktree() error {
 	})
 }
 
+// Asks for the name of the new branch (unless detaching), and then for the path
+// of the new worktree, which defaults to the worktreePathTemplate of the config
 func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase bool, detached bool, contextKey types.ContextKey) error {
-	opts := git_commands.NewWorktreeOpts{
+	opts := git_commands.AddWorktreeOpts{
 		Base:   base,
 		Detach: detached,
 	}
 
-	f := func() error {
-		return self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
-			self.c.LogAction(self.c.Tr.Actions.AddWorktree)
-			if err := self.c.Git().Worktree.New(opts); err != nil {
-				return err
-			}
+	promptForPath := func() error {
+		branchName := opts.Branch
+		if branchName == "" {
+			branchName = base
+		}
 
-			return self.reposHelper.DispatchSwitchTo(opts.Path, self.c.Tr.ErrWorktreeMovedOrRemoved, contextKey)
-		})
-	}
+		self.c.Prompt(types.PromptOpts{
+			Title:          self.c.Tr.NewWorktreePath,
+			InitialContent: self.defaultWorktreePath(branchName),
+			HandleConfirm: func(path string) error {
+				opts.Path = path
+
+				return self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.AddWorktree)
+					if err := self.c.Git().Worktree.AddWithOptions(opts); err != nil {
+						return err
+					}
 
-	self.c.Prompt(types.PromptOpts{
-		Title: self.c.Tr.NewWorktreePath,
-		HandleConfirm: func(path string) error {
-			opts.Path = path
-
-			if detached {
-				return f()
-			}
-
-			if canCheckoutBase {
-				title := utils.ResolvePlaceholderString(self.c.Tr.NewBranchNameLeaveBlank, map[string]string{"default": base})
-				// prompt for the new branch name where a blank means we just check out the branch
-				self.c.Prompt(types.PromptOpts{
-					Title: title,
-					HandleConfirm: func(branchName string) error {
-						opts.Branch = branchName
-
-						return f()
-					},
-					AllowEmptyInput: true,
+					return self.reposHelper.DispatchSwitchTo(opts.Path, self.c.Tr.ErrWorktreeMovedOrRemoved, contextKey)
 				})
+			},
+		})
 
-				return nil
-			}
+		return nil
+	}
 
-			// prompt for the new branch name
-			self.c.Prompt(types.PromptOpts{
-				Title: self.c.Tr.NewBranchName,
-				HandleConfirm: func(branchName string) error {
-					opts.Branch = branchName
+	if detached {
+		return promptForPath()
+	}
 
-					return f()
-				},
-				AllowEmptyInput: false,
-			})
+	title := self.c.Tr.NewBranchName
+	if canCheckoutBase {
+		// a blank name means we just check out the base
+		title = utils.ResolvePlaceholderString(self.c.Tr.NewBranchNameLeaveBlank, map[string]string{"default": base})
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: title,
+		HandleConfirm: func(branchName string) error {
+			opts.Branch = branchName
 
-			return nil
+			return promptForPath()
 		},
+		AllowEmptyInput: canCheckoutBase,
 	})
 
 	return nil
 }
 
+var fullCommitHashRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+func (self *WorktreeHelper) defaultWorktreePath(branchName string) string {
+	template := self.c.UserConfig().Git.WorktreePathTemplate
+	if template == "" {
+		return ""
+	}
+
+	if fullCommitHashRegexp.MatchString(branchName) {
+		branchName = utils.ShortHash(branchName)
+	}
+
+	return utils.ResolvePlaceholderString(template, map[string]string{
+		"repoName": self.c.Git().RepoPaths.RepoName(),
+		// slashes would give us nested directories
+		"branchName": strings.ReplaceAll(branchName, "/", "-"),
+	})
+}
+
 func (self *WorktreeHelper) Switch(worktree *models.Worktree, contextKey types.ContextKey) error {
 	if worktree.IsCurrent {
 		return errors.New(self.c.Tr.AlreadyInWorktree)
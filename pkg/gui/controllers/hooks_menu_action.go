@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Shows the hooks of the repo (taking core.hooksPath into account), and lets
+// the user choose which operations should skip them
+type HooksMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *HooksMenuAction) Call() error {
+	hooksDir, err := self.c.Git().Hook.HooksDir()
+	if err != nil {
+		return err
+	}
+
+	hooks, err := self.c.Git().Hook.GetHooks(hooksDir)
+	if err != nil {
+		return err
+	}
+
+	hooksSection := &types.MenuSection{
+		Title:  utils.ResolvePlaceholderString(self.c.Tr.HooksInDir, map[string]string{"dir": hooksDir}),
+		Column: 0,
+	}
+
+	menuItems := lo.Map(hooks, func(hook *models.Hook, _ int) *types.MenuItem {
+		status := style.FgRed.Sprint(self.c.Tr.HookDisabled)
+		if hook.Executable {
+			status = style.FgGreen.Sprint(self.c.Tr.HookEnabled)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{hook.Name, status},
+			OnPress:      func() error { return self.hookMenu(hook) },
+			OpensMenu:    true,
+			Section:      hooksSection,
+		}
+	})
+
+	if len(menuItems) == 0 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          self.c.Tr.NoHooks,
+			OnPress:        func() error { return nil },
+			DisabledReason: &types.DisabledReason{Text: self.c.Tr.NoHooks},
+			Section:        hooksSection,
+		})
+	}
+
+	skipHooksSection := &types.MenuSection{Title: self.c.Tr.SkipHooks, Column: 0}
+	skipHooksConfig := &self.c.UserConfig().Git.SkipHooks
+	skipHooksItem := func(label string, value *bool, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label:  label,
+			Widget: types.MakeMenuCheckBox(*value),
+			OnPress: func() error {
+				*value = !*value
+				return self.Call()
+			},
+			Key:     key,
+			Section: skipHooksSection,
+		}
+	}
+
+	menuItems = append(menuItems,
+		skipHooksItem(self.c.Tr.SkipHooksWhenPushing, &skipHooksConfig.Push, 'p'),
+		skipHooksItem(self.c.Tr.SkipHooksWhenMerging, &skipHooksConfig.Merge, 'm'),
+		skipHooksItem(self.c.Tr.SkipHooksWhenRebasing, &skipHooksConfig.Rebase, 'r'),
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.HooksTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *HooksMenuAction) hookMenu(hook *models.Hook) error {
+	toggleLabel := self.c.Tr.EnableHook
+	toggleAction := self.c.Tr.Actions.EnableHook
+	if hook.Executable {
+		toggleLabel = self.c.Tr.DisableHook
+		toggleAction = self.c.Tr.Actions.DisableHook
+	}
+
+	var runDisabledReason *types.DisabledReason
+	if !hook.Executable {
+		runDisabledReason = &types.DisabledReason{Text: self.c.Tr.HookDisabled}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: hook.Name,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.EditHook,
+				OnPress: func() error {
+					return self.c.Helpers().Files.EditFiles([]string{hook.Path})
+				},
+				Key: 'e',
+			},
+			{
+				Label: toggleLabel,
+				OnPress: func() error {
+					self.c.LogAction(toggleAction)
+					if err := self.c.Git().Hook.SetExecutable(hook, !hook.Executable); err != nil {
+						return err
+					}
+					return self.Call()
+				},
+				Key: 't',
+			},
+			{
+				Label: self.c.Tr.RunHook,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.RunHook)
+					return self.c.RunSubprocessAndRefresh(self.c.Git().Hook.RunCmdObj(hook))
+				},
+				Key:            'r',
+				Tooltip:        self.c.Tr.RunHookTooltip,
+				DisabledReason: runDisabledReason,
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -32,14 +33,15 @@ func (self *JumpToSideWindowController) Context() types.Context {
 func (self *JumpToSideWindowController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
 	windows := self.c.Helpers().Window.SideWindows()
 
-	if len(opts.Config.Universal.JumpToBlock) != len(windows) {
+	if len(opts.Config.Universal.JumpToBlock) != len(config.SidePanelNames) {
 		log.Fatal("Jump to block keybindings cannot be set. Exactly 5 keybindings must be supplied.")
 	}
 
 	return lo.Map(windows, func(window string, index int) *types.Binding {
 		return &types.Binding{
 			ViewName: "",
-			// by default the keys are 1, 2, 3, etc
+			// by default the keys are 1, 2, 3, etc, assigned to the visible
+			// side windows from top to bottom
 			Key:      opts.GetKey(opts.Config.Universal.JumpToBlock[index]),
 			Modifier: gocui.ModNone,
 			Handler:  opts.Guards.NoPopupPanel(self.goToSideWindow(window)),
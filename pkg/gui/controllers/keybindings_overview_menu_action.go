@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type KeybindingsOverviewMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *KeybindingsOverviewMenuAction) Call() error {
+	entries := config.GetKeybindingEntries(self.c.UserConfig().Keybinding, config.GetDefaultConfig().Keybinding)
+	conflicts := config.FindKeybindingConflicts(entries)
+
+	conflictingPaths := map[string][]string{}
+	for _, conflict := range conflicts {
+		overridePath, otherPath := conflict.Override.Path(), conflict.Other.Path()
+		conflictingPaths[overridePath] = append(conflictingPaths[overridePath], otherPath)
+		conflictingPaths[otherPath] = append(conflictingPaths[otherPath], overridePath)
+	}
+
+	menuItems := []*types.MenuItem{}
+
+	// Show the conflicts first so that they don't get lost among all the other
+	// keybindings. Selecting one disables the keybinding that the user's key
+	// clashes with.
+	conflictsSection := &types.MenuSection{Title: self.c.Tr.KeybindingConflicts, Column: 1}
+	for _, conflict := range conflicts {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				conflict.Other.Key,
+				conflict.Other.Path(),
+				style.FgRed.Sprint(self.conflictsWith([]string{conflict.Override.Path()})),
+			},
+			OnPress:        func() error { return self.disable(conflict.Other) },
+			DisabledReason: self.disableDisabledReason(conflict.Other),
+			Section:        conflictsSection,
+		})
+	}
+
+	var section *types.MenuSection
+	for _, entry := range entries {
+		if section == nil || section.Title != entry.Section {
+			section = &types.MenuSection{Title: entry.Section, Column: 1}
+		}
+
+		status := ""
+		if paths, ok := conflictingPaths[entry.Path()]; ok {
+			status = style.FgRed.Sprint(self.conflictsWith(paths))
+		} else if entry.IsOverride {
+			status = style.FgYellow.Sprint(self.c.Tr.KeybindingIsCustom)
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns:   []string{entry.Key, entry.Name, status},
+			OnPress:        func() error { return self.disable(entry) },
+			DisabledReason: self.disableDisabledReason(entry),
+			Section:        section,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.KeybindingsOverview,
+		Items: menuItems,
+	})
+}
+
+func (self *KeybindingsOverviewMenuAction) conflictsWith(paths []string) string {
+	return utils.ResolvePlaceholderString(self.c.Tr.KeybindingConflictsWith,
+		map[string]string{"other": strings.Join(lo.Uniq(paths), ", ")})
+}
+
+func (self *KeybindingsOverviewMenuAction) disableDisabledReason(entry config.KeybindingEntry) *types.DisabledReason {
+	if entry.Key == "<disabled>" {
+		return &types.DisabledReason{Text: self.c.Tr.KeybindingAlreadyDisabled}
+	}
+
+	if entry.IsListElement {
+		return &types.DisabledReason{Text: self.c.Tr.CannotDisableListKeybinding}
+	}
+
+	return nil
+}
+
+func (self *KeybindingsOverviewMenuAction) disable(entry config.KeybindingEntry) error {
+	keybinding := map[string]string{"keybinding": "keybinding." + entry.Path()}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DisableKeybinding,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.DisableKeybindingPrompt, keybinding),
+		HandleConfirm: func() error {
+			path, err := self.c.GetConfig().SetGlobalUserConfigValue(
+				[]string{"keybinding", entry.Section, entry.Name}, "<disabled>")
+			if err != nil {
+				return err
+			}
+
+			if err := self.c.ReloadChangedUserConfigFiles(); err != nil {
+				return err
+			}
+
+			keybinding["file"] = path
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.KeybindingDisabledInFile, keybinding))
+			return nil
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type LanguageMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *LanguageMenuAction) Call() error {
+	languages, err := i18n.SupportedLanguages()
+	if err != nil {
+		return err
+	}
+
+	currentLanguage := self.c.GetAppState().Language
+	configLanguage := self.c.UserConfig().Gui.Language
+
+	menuItems := []*types.MenuItem{
+		{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.LanguageFromConfig,
+				map[string]string{"language": self.languageLabel(configLanguage)}),
+			OnPress: func() error { return self.setLanguage("") },
+			Widget:  types.MakeMenuRadioButton(currentLanguage == ""),
+			Key:     'c',
+		},
+		{
+			LabelColumns: []string{self.c.Tr.DetectLanguage, "auto"},
+			OnPress:      func() error { return self.setLanguage("auto") },
+			Widget:       types.MakeMenuRadioButton(currentLanguage == "auto"),
+			Key:          'a',
+		},
+	}
+
+	menuItems = append(menuItems, lo.Map(languages, func(language string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{i18n.LanguageName(language), language},
+			OnPress:      func() error { return self.setLanguage(language) },
+			Widget:       types.MakeMenuRadioButton(currentLanguage == language),
+		}
+	})...)
+
+	// Only for translators, so we don't bother users with it
+	if self.c.Debug {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   self.c.Tr.ShowMissingTranslations,
+			OnPress: self.showMissingTranslations,
+			Key:     'm',
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.Language, Items: menuItems})
+}
+
+func (self *LanguageMenuAction) showMissingTranslations() error {
+	language := self.c.GetAppState().Language
+	if language == "" {
+		language = self.c.UserConfig().Gui.Language
+	}
+
+	report, err := i18n.NewMissingTranslationsReport(language)
+	if err != nil {
+		return err
+	}
+
+	if len(report.Missing) == 0 {
+		self.c.Toast(self.c.Tr.NoMissingTranslations)
+		return nil
+	}
+
+	languageNames := lo.Map(report.Languages, func(language string, _ int) string {
+		return i18n.LanguageName(language)
+	})
+	title := utils.ResolvePlaceholderString(self.c.Tr.MissingTranslationsTitle, map[string]string{
+		"language": strings.Join(languageNames, ", "),
+		"missing":  strconv.Itoa(len(report.Missing)),
+		"total":    strconv.Itoa(report.TotalCount),
+	})
+
+	// Also log them, so that they can be copied from the log
+	for _, missing := range report.Missing {
+		self.c.Log.Infof("Missing translation: %s: %q", missing.Key, missing.English)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: lo.Map(report.Missing, func(missing i18n.MissingTranslation, _ int) *types.MenuItem {
+			return &types.MenuItem{
+				LabelColumns: []string{missing.Key, utils.TruncateWithEllipsis(strings.ReplaceAll(missing.English, "\n", " "), 60)},
+				OnPress:      func() error { return nil },
+			}
+		}),
+	})
+}
+
+func (self *LanguageMenuAction) languageLabel(language string) string {
+	if language == "auto" {
+		return self.c.Tr.DetectLanguage
+	}
+	return i18n.LanguageName(language)
+}
+
+func (self *LanguageMenuAction) setLanguage(language string) error {
+	self.c.GetAppState().Language = language
+	self.c.SaveAppStateAndLogError()
+
+	return self.c.OnLanguageChanged()
+}
@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Shows the git-lfs locks of the repo, and lets the user lock or unlock the
+// selected file
+type LfsLocksMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *LfsLocksMenuAction) Call() error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingLfsLocksStatus, func(gocui.Task) error {
+		if err := self.loadLocks(); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(self.showMenu)
+		return nil
+	})
+}
+
+func (self *LfsLocksMenuAction) loadLocks() error {
+	locks, err := self.c.Git().Lfs.GetLocks()
+	if err != nil {
+		return err
+	}
+
+	self.c.OnUIThread(func() error {
+		self.c.Model().LfsLocks = locks
+		self.c.PostRefreshUpdate(self.c.Contexts().Files)
+		return nil
+	})
+	return nil
+}
+
+func (self *LfsLocksMenuAction) showMenu() error {
+	locks := self.c.Model().LfsLocks
+	file := self.c.Contexts().Files.GetSelectedFile()
+
+	var lockDisabledReason, unlockDisabledReason *types.DisabledReason
+	if file == nil {
+		lockDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoItemSelected}
+		unlockDisabledReason = lockDisabledReason
+	} else if lo.ContainsBy(locks, func(lock *models.LfsLock) bool { return lock.Path == file.Path }) {
+		lockDisabledReason = &types.DisabledReason{Text: self.c.Tr.LfsFileAlreadyLocked}
+	} else {
+		unlockDisabledReason = &types.DisabledReason{Text: self.c.Tr.LfsFileNotLocked}
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:          self.c.Tr.LfsLockFile,
+			OnPress:        func() error { return self.lock(file.Path) },
+			DisabledReason: lockDisabledReason,
+			Key:            'l',
+		},
+		{
+			Label:          self.c.Tr.LfsUnlockFile,
+			OnPress:        func() error { return self.unlock(file.Path, false) },
+			DisabledReason: unlockDisabledReason,
+			Key:            'u',
+		},
+		{
+			Label:          self.c.Tr.LfsForceUnlockFile,
+			OnPress:        func() error { return self.unlock(file.Path, true) },
+			DisabledReason: unlockDisabledReason,
+			Tooltip:        self.c.Tr.LfsForceUnlockFileTooltip,
+			Key:            'U',
+		},
+	}
+
+	locksSection := &types.MenuSection{Title: self.c.Tr.LfsLocksTitle, Column: 0}
+	for _, lock := range locks {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{lock.Path, lock.Owner},
+			OnPress:      func() error { return self.unlock(lock.Path, false) },
+			Tooltip:      self.c.Tr.LfsUnlockTooltip,
+			Section:      locksSection,
+		})
+	}
+	if len(locks) == 0 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          self.c.Tr.NoLfsLocks,
+			OnPress:        func() error { return nil },
+			DisabledReason: &types.DisabledReason{Text: self.c.Tr.NoLfsLocks},
+			Section:        locksSection,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LfsLocksTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *LfsLocksMenuAction) lock(path string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LockingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsLockFile)
+		if err := self.c.Git().Lfs.Lock(path); err != nil {
+			return err
+		}
+
+		return self.loadLocks()
+	})
+}
+
+func (self *LfsLocksMenuAction) unlock(path string, force bool) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UnlockingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsUnlockFile)
+		if err := self.c.Git().Lfs.Unlock(path, force); err != nil {
+			return err
+		}
+
+		return self.loadLocks()
+	})
+}
@@ -51,7 +51,7 @@ func (self *ListController) HandleScrollRight() error {
 }
 
 func (self *ListController) HandleScrollUp() error {
-	scrollHeight := self.c.UserConfig().Gui.ScrollHeight
+	scrollHeight := self.c.UserConfig().Gui.MouseWheelScrollHeight()
 	self.context.GetViewTrait().ScrollUp(scrollHeight)
 	if self.context.RenderOnlyVisibleLines() {
 		self.context.HandleRender()
@@ -61,7 +61,7 @@ func (self *ListController) HandleScrollUp() error {
 }
 
 func (self *ListController) HandleScrollDown() error {
-	scrollHeight := self.c.UserConfig().Gui.ScrollHeight
+	scrollHeight := self.c.UserConfig().Gui.MouseWheelScrollHeight()
 	self.context.GetViewTrait().ScrollDown(scrollHeight)
 	if self.context.RenderOnlyVisibleLines() {
 		self.context.HandleRender()
@@ -221,6 +221,24 @@ func (self *ListController) HandleToggleRangeSelect() error {
 	return nil
 }
 
+func (self *ListController) HandleToggleMark() error {
+	list := self.context.GetList()
+	if list.Len() == 0 {
+		return nil
+	}
+
+	if list.IsSelectingRange() {
+		list.ToggleMarkRange(list.GetSelectionRange())
+		list.CancelRangeSelect()
+	} else {
+		list.ToggleMark(list.GetSelectedLineIdx())
+	}
+
+	self.context.HandleRender()
+	self.context.HandleFocus(types.OnFocusOpts{})
+	return nil
+}
+
 func (self *ListController) HandleRangeSelectDown() error {
 	return self.HandleRangeSelectChange(1)
 }
@@ -289,6 +307,7 @@ func (self *ListController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 		bindings = append(bindings,
 			[]*types.Binding{
 				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.ToggleRangeSelect), Handler: self.HandleToggleRangeSelect, Description: self.c.Tr.ToggleRangeSelect},
+				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.ToggleMark), Handler: self.HandleToggleMark, Description: self.c.Tr.ToggleMark, Tooltip: self.c.Tr.ToggleMarkTooltip},
 				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.RangeSelectDown), Handler: self.HandleRangeSelectDown, Description: self.c.Tr.RangeSelectDown},
 				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.RangeSelectUp), Handler: self.HandleRangeSelectUp, Description: self.c.Tr.RangeSelectUp},
 			}...,
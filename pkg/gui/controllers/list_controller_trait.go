@@ -69,8 +69,32 @@ func (self *ListControllerTrait[T]) singleItemSelected(callbacks ...func(T) *typ
 	}
 }
 
-// Ensures that at least one item is selected.
+// Ensures that at least one item is selected, and that the selected items are
+// contiguous (which they aren't necessarily if the user has marked items).
 func (self *ListControllerTrait[T]) itemRangeSelected(callbacks ...func([]T, int, int) *types.DisabledReason) func() *types.DisabledReason {
+	return func() *types.DisabledReason {
+		items, startIdx, endIdx := self.getSelectedItems()
+		if len(items) == 0 {
+			return &types.DisabledReason{Text: self.c.Tr.NoItemSelected}
+		}
+
+		if len(items) != endIdx-startIdx+1 {
+			return &types.DisabledReason{Text: self.c.Tr.MarkedItemsNotContiguous}
+		}
+
+		for _, callback := range callbacks {
+			if reason := callback(items, startIdx, endIdx); reason != nil {
+				return reason
+			}
+		}
+
+		return nil
+	}
+}
+
+// Like itemRangeSelected, but also allows marked items that aren't contiguous,
+// in which case startIdx and endIdx are the bounds of the marked items.
+func (self *ListControllerTrait[T]) itemsSelectedWithBounds(callbacks ...func([]T, int, int) *types.DisabledReason) func() *types.DisabledReason {
 	return func() *types.DisabledReason {
 		items, startIdx, endIdx := self.getSelectedItems()
 		if len(items) == 0 {
@@ -130,6 +154,23 @@ func (self *ListControllerTrait[T]) withItems(callback func([]T) error) func() e
 
 // like withItems but also passes the start and end index of the selection
 func (self *ListControllerTrait[T]) withItemsRange(callback func([]T, int, int) error) func() error {
+	return func() error {
+		items, startIdx, endIdx := self.getSelectedItems()
+		if len(items) == 0 {
+			return errors.New(self.c.Tr.NoItemSelected)
+		}
+
+		if len(items) != endIdx-startIdx+1 {
+			return errors.New(self.c.Tr.MarkedItemsNotContiguous)
+		}
+
+		return callback(items, startIdx, endIdx)
+	}
+}
+
+// like withItemsRange but also allows marked items that aren't contiguous, in
+// which case startIdx and endIdx are the bounds of the marked items
+func (self *ListControllerTrait[T]) withItemsAndBounds(callback func([]T, int, int) error) func() error {
 	return func() error {
 		items, startIdx, endIdx := self.getSelectedItems()
 		if len(items) == 0 {
@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
@@ -178,8 +180,8 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MoveDownCommit),
-			Handler: opts.Guards.OutsideFilterMode(self.withItemsRange(self.moveDown)),
-			GetDisabledReason: self.require(self.itemRangeSelected(
+			Handler: opts.Guards.OutsideFilterMode(self.withItemsAndBounds(self.moveDown)),
+			GetDisabledReason: self.require(self.itemsSelectedWithBounds(
 				self.midRebaseMoveCommandEnabled,
 				self.canMoveDown,
 			)),
@@ -187,8 +189,8 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MoveUpCommit),
-			Handler: opts.Guards.OutsideFilterMode(self.withItemsRange(self.moveUp)),
-			GetDisabledReason: self.require(self.itemRangeSelected(
+			Handler: opts.Guards.OutsideFilterMode(self.withItemsAndBounds(self.moveUp)),
+			GetDisabledReason: self.require(self.itemsSelectedWithBounds(
 				self.midRebaseMoveCommandEnabled,
 				self.canMoveUp,
 			)),
@@ -201,6 +203,14 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description:       self.c.Tr.PasteCommits,
 			DisplayStyle:      &style.FgCyan,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.PasteCommitsWithOptions),
+			Handler:           opts.Guards.OutsideFilterMode(self.c.Helpers().CherryPick.PasteWithOptions),
+			GetDisabledReason: self.require(self.canPaste),
+			Description:       self.c.Tr.PasteCommitsWithOptions,
+			Tooltip:           self.c.Tr.PasteCommitsWithOptionsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.MarkCommitAsBaseForRebase),
 			Handler:           opts.Guards.OutsideFilterMode(self.withItem(self.markAsBaseCommit)),
@@ -239,6 +249,20 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description:       self.c.Tr.Revert,
 			Tooltip:           self.c.Tr.RevertCommitTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ExportPatches),
+			Handler:           self.withItemsRange(self.exportPatches),
+			GetDisabledReason: self.require(self.itemRangeSelected(self.canExportPatches)),
+			Description:       self.c.Tr.ExportPatches,
+			Tooltip:           self.c.Tr.ExportPatchesTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ApplyPatches),
+			Handler:           opts.Guards.OutsideFilterMode(self.applyPatches),
+			GetDisabledReason: self.require(self.notMidRebase(self.c.Tr.AlreadyRebasing)),
+			Description:       self.c.Tr.ApplyPatches,
+			Tooltip:           self.c.Tr.ApplyPatchesTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.CreateTag),
 			Handler:           self.withItem(self.createTag),
@@ -253,6 +277,19 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Tooltip:     self.c.Tr.OpenLogMenuTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.GoToCommit),
+			Handler:     self.goToCommitByRef,
+			Description: self.c.Tr.GoToCommit,
+			Tooltip:     self.c.Tr.GoToCommitTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.CollapsePushedCommits),
+			Handler:           self.toggleCollapsePushedCommits,
+			GetDisabledReason: self.canCollapsePushedCommits,
+			Description:       self.c.Tr.CollapsePushedCommits,
+			Tooltip:           self.c.Tr.CollapsePushedCommitsTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.OpenPullRequestInBrowser),
 			Handler:           self.openPRInBrowser,
@@ -289,6 +326,7 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() {
 	return func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
 			var task types.UpdateTask
+			var refRange *types.RefRange
 			commit := self.context().GetSelected()
 			if commit == nil {
 				task = types.NewRenderStringTask(self.c.Tr.NoCommitsThisBranch)
@@ -303,17 +341,23 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() {
 				task = types.NewRenderStringTask(
 					self.c.Tr.ExecCommandHere + "\n\n" + commit.Name)
 			} else {
-				refRange := self.context().GetSelectedRefRangeForDiffFiles()
+				refRange = self.context().GetSelectedRefRangeForDiffFiles()
 				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange)
+				self.c.Helpers().Diff.PrefetchAdjacentCommitDiffs(task, self.context().GetItems(), self.context().GetSelectedLineIdx())
+			}
+
+			mainOpts := &types.ViewUpdateOpts{
+				Title:    "Patch",
+				SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+				Task:     task,
+			}
+			if commit != nil && commit.Hash() != "" && refRange == nil {
+				self.c.Helpers().Diff.AddCommitDetailsTab(mainOpts, commit)
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
-				Pair: self.c.MainViewPairs().Normal,
-				Main: &types.ViewUpdateOpts{
-					Title:    "Patch",
-					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     task,
-				},
+				Pair:      self.c.MainViewPairs().Normal,
+				Main:      mainOpts,
 				Secondary: secondaryPatchPanelUpdateOpts(self.c),
 			})
 		})
@@ -338,18 +382,20 @@ func (self *LocalCommitsController) squashDown(selectedCommits []*models.Commit,
 		return self.updateTodos(todo.Squash, selectedCommits)
 	}
 
-	self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.Squash,
-		Prompt: self.c.Tr.SureSquashThisCommit,
-		HandleConfirm: func() error {
-			return self.c.WithWaitingStatus(self.c.Tr.SquashingStatus, func(gocui.Task) error {
-				self.c.LogAction(self.c.Tr.Actions.SquashCommitDown)
-				return self.interactiveRebase(todo.Squash, startIdx, endIdx)
-			})
-		},
-	})
+	return self.guardRewritingHistory(func() error {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.Squash,
+			Prompt: self.c.Tr.SureSquashThisCommit,
+			HandleConfirm: func() error {
+				return self.c.WithWaitingStatus(self.c.Tr.SquashingStatus, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.SquashCommitDown)
+					return self.interactiveRebase(todo.Squash, startIdx, endIdx)
+				})
+			},
+		})
 
-	return nil
+		return nil
+	})
 }
 
 func (self *LocalCommitsController) fixup(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
@@ -357,32 +403,34 @@ func (self *LocalCommitsController) fixup(selectedCommits []*models.Commit, star
 		return self.updateTodos(todo.Fixup, selectedCommits)
 	}
 
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: self.c.Tr.Fixup,
-		Items: []*types.MenuItem{
-			{
-				Label: self.c.Tr.Fixup,
-				Key:   'f',
-				OnPress: func() error {
-					return self.c.WithWaitingStatus(self.c.Tr.FixingStatus, func(gocui.Task) error {
-						self.c.LogAction(self.c.Tr.Actions.FixupCommit)
-						return self.interactiveRebase(todo.Fixup, startIdx, endIdx)
-					})
+	return self.guardRewritingHistory(func() error {
+		return self.c.Menu(types.CreateMenuOptions{
+			Title: self.c.Tr.Fixup,
+			Items: []*types.MenuItem{
+				{
+					Label: self.c.Tr.Fixup,
+					Key:   'f',
+					OnPress: func() error {
+						return self.c.WithWaitingStatus(self.c.Tr.FixingStatus, func(gocui.Task) error {
+							self.c.LogAction(self.c.Tr.Actions.FixupCommit)
+							return self.interactiveRebase(todo.Fixup, startIdx, endIdx)
+						})
+					},
+					Tooltip: self.c.Tr.FixupTooltip,
 				},
-				Tooltip: self.c.Tr.FixupTooltip,
-			},
-			{
-				Label: self.c.Tr.FixupKeepMessage,
-				Key:   'c',
-				OnPress: func() error {
-					return self.c.WithWaitingStatus(self.c.Tr.FixingStatus, func(gocui.Task) error {
-						self.c.LogAction(self.c.Tr.Actions.FixupCommitKeepMessage)
-						return self.interactiveRebaseWithFlag(todo.Fixup, startIdx, endIdx, "-C")
-					})
+				{
+					Label: self.c.Tr.FixupKeepMessage,
+					Key:   'c',
+					OnPress: func() error {
+						return self.c.WithWaitingStatus(self.c.Tr.FixingStatus, func(gocui.Task) error {
+							self.c.LogAction(self.c.Tr.Actions.FixupCommitKeepMessage)
+							return self.interactiveRebaseWithFlag(todo.Fixup, startIdx, endIdx, "-C")
+						})
+					},
+					Tooltip: self.c.Tr.FixupKeepMessageTooltip,
 				},
-				Tooltip: self.c.Tr.FixupKeepMessageTooltip,
 			},
-		},
+		})
 	})
 }
 
@@ -423,6 +471,12 @@ func (self *LocalCommitsController) setFixupMessage(commit *models.Commit) error
 }
 
 func (self *LocalCommitsController) reword(commit *models.Commit) error {
+	return self.guardRewritingHistory(func() error {
+		return self.openRewordPanel(commit)
+	})
+}
+
+func (self *LocalCommitsController) openRewordPanel(commit *models.Commit) error {
 	commitIdx := self.context().GetSelectedLineIdx()
 	if self.c.Git().Config.NeedsGpgSubprocessForCommit() && !self.isHeadCommit(commitIdx) {
 		return errors.New(self.c.Tr.DisabledForGPG)
@@ -515,12 +569,14 @@ func (self *LocalCommitsController) doRewordEditor() error {
 }
 
 func (self *LocalCommitsController) rewordEditor(commit *models.Commit) error {
-	return self.c.ConfirmIf(!self.c.UserConfig().Gui.SkipRewordInEditorWarning,
-		types.ConfirmOpts{
-			Title:         self.c.Tr.RewordInEditorTitle,
-			Prompt:        self.c.Tr.RewordInEditorPrompt,
-			HandleConfirm: self.doRewordEditor,
-		})
+	return self.guardRewritingHistory(func() error {
+		return self.c.ConfirmIf(self.c.UserConfig().Confirmations.RewordInEditor,
+			types.ConfirmOpts{
+				Title:         self.c.Tr.RewordInEditorTitle,
+				Prompt:        self.c.Tr.RewordInEditorPrompt,
+				HandleConfirm: self.doRewordEditor,
+			})
+	})
 }
 
 func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
@@ -562,21 +618,23 @@ func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, start
 
 	isMerge := selectedCommits[0].IsMerge()
 
-	self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.DropCommitTitle,
-		Prompt: lo.Ternary(isMerge, self.c.Tr.DropMergeCommitPrompt, self.c.Tr.DropCommitPrompt),
-		HandleConfirm: func() error {
-			return self.c.WithWaitingStatus(self.c.Tr.DroppingStatus, func(gocui.Task) error {
-				self.c.LogAction(self.c.Tr.Actions.DropCommit)
-				if isMerge {
-					return self.dropMergeCommit(startIdx)
-				}
-				return self.interactiveRebase(todo.Drop, startIdx, endIdx)
-			})
-		},
-	})
+	return self.guardRewritingHistory(func() error {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.DropCommitTitle,
+			Prompt: lo.Ternary(isMerge, self.c.Tr.DropMergeCommitPrompt, self.c.Tr.DropCommitPrompt),
+			HandleConfirm: func() error {
+				return self.c.WithWaitingStatus(self.c.Tr.DroppingStatus, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.DropCommit)
+					if isMerge {
+						return self.dropMergeCommit(startIdx)
+					}
+					return self.interactiveRebase(todo.Drop, startIdx, endIdx)
+				})
+			},
+		})
 
-	return nil
+		return nil
+	})
 }
 
 func (self *LocalCommitsController) dropMergeCommit(commitIdx int) error {
@@ -750,6 +808,12 @@ func (self *LocalCommitsController) rewordEnabled(commit *models.Commit) *types.
 	return nil
 }
 
+// Dropping, squashing, rewording or moving commits of a protected branch is
+// guarded like rebasing it, since publishing the result requires a force-push
+func (self *LocalCommitsController) guardRewritingHistory(f func() error) error {
+	return self.c.Helpers().ProtectedBranches.GuardCheckedOutBranch(self.c.Tr.ProtectedBranchActionRewriteHistory, f)
+}
+
 func (self *LocalCommitsController) isRebasing() bool {
 	return self.c.Model().WorkingTreeStateAtLastCommitRefresh.Any()
 }
@@ -773,15 +837,31 @@ func (self *LocalCommitsController) moveDown(selectedCommits []*models.Commit, s
 		return nil
 	}
 
+	indices := self.selectedCommitIndices(startIdx, endIdx)
+	hasMarks := self.context().HasMarks()
+
+	return self.guardRewritingHistory(func() error {
+		return self.moveCommitsDown(indices, hasMarks)
+	})
+}
+
+func (self *LocalCommitsController) moveCommitsDown(indices []int, hasMarks bool) error {
 	return self.c.WithWaitingStatusSync(self.c.Tr.MovingStatus, func() error {
 		self.c.LogAction(self.c.Tr.Actions.MoveCommitDown)
-		err := self.c.Git().Rebase.MoveCommitsDown(self.c.Model().Commits, startIdx, endIdx)
-		if err == nil {
+		moveErr := self.c.Git().Rebase.MoveCommitsDown(self.c.Model().Commits, indices)
+		if moveErr == nil {
 			self.context().MoveSelection(1)
 			self.context().HandleFocus(types.OnFocusOpts{ScrollSelectionIntoView: true})
 		}
-		return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
-			err, types.RefreshOptions{Mode: types.SYNC})
+		if err := self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
+			moveErr, types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		if moveErr == nil && hasMarks {
+			self.remarkMovedCommits(indices, 1)
+		}
+		return nil
 	})
 }
 
@@ -799,18 +879,54 @@ func (self *LocalCommitsController) moveUp(selectedCommits []*models.Commit, sta
 		return nil
 	}
 
+	indices := self.selectedCommitIndices(startIdx, endIdx)
+	hasMarks := self.context().HasMarks()
+
+	return self.guardRewritingHistory(func() error {
+		return self.moveCommitsUp(indices, hasMarks)
+	})
+}
+
+func (self *LocalCommitsController) moveCommitsUp(indices []int, hasMarks bool) error {
 	return self.c.WithWaitingStatusSync(self.c.Tr.MovingStatus, func() error {
 		self.c.LogAction(self.c.Tr.Actions.MoveCommitUp)
-		err := self.c.Git().Rebase.MoveCommitsUp(self.c.Model().Commits, startIdx, endIdx)
-		if err == nil {
+		moveErr := self.c.Git().Rebase.MoveCommitsUp(self.c.Model().Commits, indices)
+		if moveErr == nil {
 			self.context().MoveSelection(-1)
 			self.context().HandleFocus(types.OnFocusOpts{ScrollSelectionIntoView: true})
 		}
-		return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
-			err, types.RefreshOptions{Mode: types.SYNC})
+		if err := self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
+			moveErr, types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+
+		if moveErr == nil && hasMarks {
+			self.remarkMovedCommits(indices, -1)
+		}
+		return nil
 	})
 }
 
+// Returns the indices of the selected commits. If the user has marked commits,
+// these are the marked ones, which aren't necessarily contiguous.
+func (self *LocalCommitsController) selectedCommitIndices(startIdx int, endIdx int) []int {
+	if markedIndices := self.context().MarkedIndices(); len(markedIndices) > 0 {
+		return markedIndices
+	}
+
+	return lo.RangeFrom(startIdx, endIdx-startIdx+1)
+}
+
+// Moving commits outside of a rebase gives them new hashes, which makes us
+// forget their marks, so we mark them again at their new positions
+func (self *LocalCommitsController) remarkMovedCommits(indices []int, delta int) {
+	self.context().ClearMarks()
+	for _, idx := range indices {
+		self.context().ToggleMark(idx + delta)
+	}
+	self.context().HandleRender()
+}
+
 func (self *LocalCommitsController) amendTo(commit *models.Commit) error {
 	var handleCommit func() error
 
@@ -836,7 +952,7 @@ func (self *LocalCommitsController) amendTo(commit *models.Commit) error {
 		}
 	}
 
-	return self.c.ConfirmIf(!self.c.UserConfig().Gui.SkipAmendWarning,
+	return self.c.ConfirmIf(self.c.UserConfig().Confirmations.Amend,
 		types.ConfirmOpts{
 			Title:         self.c.Tr.AmendCommitTitle,
 			Prompt:        self.c.Tr.AmendCommitPrompt,
@@ -860,7 +976,7 @@ func (self *LocalCommitsController) canAmend(_ *models.Commit) *types.DisabledRe
 func (self *LocalCommitsController) amendAttribute(commits []*models.Commit, start, end int) error {
 	opts := self.c.KeybindingsOpts()
 	return self.c.Menu(types.CreateMenuOptions{
-		Title: "Amend commit attribute",
+		Title: self.c.Tr.AmendCommitAttribute,
 		Items: []*types.MenuItem{
 			{
 				Label:   self.c.Tr.ResetAuthor,
@@ -874,6 +990,12 @@ func (self *LocalCommitsController) amendAttribute(commits []*models.Commit, sta
 				Key:     opts.GetKey(opts.Config.AmendAttribute.SetAuthor),
 				Tooltip: self.c.Tr.SetAuthorTooltip,
 			},
+			{
+				Label:   self.c.Tr.SetAuthorDate,
+				OnPress: func() error { return self.setAuthorDate(commits, start, end) },
+				Key:     opts.GetKey(opts.Config.AmendAttribute.SetAuthorDate),
+				Tooltip: self.c.Tr.SetAuthorDateTooltip,
+			},
 			{
 				Label:   self.c.Tr.AddCoAuthor,
 				OnPress: func() error { return self.addCoAuthor(start, end) },
@@ -916,6 +1038,33 @@ func (self *LocalCommitsController) setAuthor(start, end int) error {
 	return nil
 }
 
+func (self *LocalCommitsController) setAuthorDate(commits []*models.Commit, start, end int) error {
+	// Prefill the prompt with the commit's current date, so that it's easy to
+	// adjust it; for a range, the dates differ, so we start from scratch
+	initialContent := ""
+	if len(commits) == 1 {
+		initialContent = time.Unix(commits[0].UnixTimestamp, 0).Format("2006-01-02 15:04:05 -0700")
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.SetAuthorDatePromptTitle,
+		InitialContent: initialContent,
+		HandleConfirm: func(value string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.SetCommitAuthorDate)
+				if err := self.c.Git().Rebase.SetCommitAuthorDate(self.c.Model().Commits, start, end, value); err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+				return nil
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *LocalCommitsController) addCoAuthor(start, end int) error {
 	self.c.Prompt(types.PromptOpts{
 		Title:               self.c.Tr.AddCoAuthorPromptTitle,
@@ -936,6 +1085,12 @@ func (self *LocalCommitsController) addCoAuthor(start, end int) error {
 }
 
 func (self *LocalCommitsController) revert(commits []*models.Commit, start, end int) error {
+	mergeCommits := lo.Filter(commits, func(c *models.Commit, _ int) bool { return c.IsMerge() })
+	if len(mergeCommits) > 0 {
+		// Choosing the parent is confirmation enough, so we don't ask again
+		return self.revertMergeMainlineMenu(commits, mergeCommits)
+	}
+
 	var promptText string
 	if len(commits) == 1 {
 		promptText = utils.ResolvePlaceholderString(
@@ -946,45 +1101,94 @@ func (self *LocalCommitsController) revert(commits []*models.Commit, start, end
 	} else {
 		promptText = self.c.Tr.ConfirmRevertCommitRange
 	}
-	hashes := lo.Map(commits, func(c *models.Commit, _ int) string { return c.Hash() })
-	isMerge := lo.SomeBy(commits, func(c *models.Commit) bool { return c.IsMerge() })
 
 	self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.Actions.RevertCommit,
 		Prompt: promptText,
 		HandleConfirm: func() error {
-			self.c.LogAction(self.c.Tr.Actions.RevertCommit)
-			return self.c.WithWaitingStatusSync(self.c.Tr.RevertingStatus, func() error {
-				mustStash := helpers.IsWorkingTreeDirtyExceptSubmodules(self.c.Model().Files, self.c.Model().Submodules)
+			return self.doRevert(commits, 0)
+		},
+	})
 
-				if mustStash {
-					if err := self.c.Git().Stash.Push(self.c.Tr.AutoStashForReverting); err != nil {
-						return err
-					}
-				}
+	return nil
+}
 
-				result := self.c.Git().Commit.Revert(hashes, isMerge)
-				if err := self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(result, types.RefreshOptions{Mode: types.SYNC}); err != nil {
-					return err
-				}
-				self.context().MoveSelection(len(commits))
-				self.context().HandleFocus(types.OnFocusOpts{ScrollSelectionIntoView: true})
+// Merge commits can only be reverted relative to one of their parents, so we
+// let the user choose which one. If a single merge commit is selected we show
+// its parents; for several of them we can only offer the parent numbers. Since
+// a single `git revert -m` is used for all of them, we only offer the numbers
+// that every one of them has a parent for.
+func (self *LocalCommitsController) revertMergeMainlineMenu(commits []*models.Commit, mergeCommits []*models.Commit) error {
+	numParents := lo.Min(lo.Map(mergeCommits, func(c *models.Commit, _ int) int { return len(c.Parents()) }))
 
-				if mustStash {
-					if err := self.c.Git().Stash.Pop(0); err != nil {
-						return err
-					}
-					self.c.Refresh(types.RefreshOptions{
-						Scope: []types.RefreshableView{types.STASH, types.FILES},
-					})
-				}
+	menuItems := make([]*types.MenuItem, 0, numParents)
+	for parent := 1; parent <= numParents; parent++ {
+		labelColumns := []string{
+			utils.ResolvePlaceholderString(self.c.Tr.RevertMainlineParent, map[string]string{
+				"number": strconv.Itoa(parent),
+			}),
+		}
+		if len(mergeCommits) == 1 {
+			parentHash := mergeCommits[0].Parents()[parent-1]
+			labelColumns = append(labelColumns, style.FgYellow.Sprint(utils.ShortHash(parentHash)))
+			if parentCommit, ok := lo.Find(self.c.Model().Commits, func(c *models.Commit) bool {
+				return c.Hash() == parentHash
+			}); ok {
+				labelColumns = append(labelColumns, parentCommit.Name)
+			}
+		}
 
-				return nil
-			})
-		},
+		var key types.Key
+		if parent <= 9 {
+			key = rune('0' + parent)
+		}
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: labelColumns,
+			OnPress: func() error {
+				return self.doRevert(commits, parent)
+			},
+			Key:     key,
+			Tooltip: self.c.Tr.RevertMainlineTooltip,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RevertMergeCommitSelectMainline,
+		Items: menuItems,
 	})
+}
 
-	return nil
+func (self *LocalCommitsController) doRevert(commits []*models.Commit, mainline int) error {
+	hashes := lo.Map(commits, func(c *models.Commit, _ int) string { return c.Hash() })
+
+	self.c.LogAction(self.c.Tr.Actions.RevertCommit)
+	return self.c.WithWaitingStatusSync(self.c.Tr.RevertingStatus, func() error {
+		mustStash := helpers.IsWorkingTreeDirtyExceptSubmodules(self.c.Model().Files, self.c.Model().Submodules)
+
+		if mustStash {
+			if err := self.c.Git().Stash.Push(self.c.Tr.AutoStashForReverting); err != nil {
+				return err
+			}
+		}
+
+		result := self.c.Git().Commit.Revert(hashes, mainline)
+		if err := self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(result, types.RefreshOptions{Mode: types.SYNC}); err != nil {
+			return err
+		}
+		self.context().MoveSelection(len(commits))
+		self.context().HandleFocus(types.OnFocusOpts{ScrollSelectionIntoView: true})
+
+		if mustStash {
+			if err := self.c.Git().Stash.Pop(0); err != nil {
+				return err
+			}
+			self.c.Refresh(types.RefreshOptions{
+				Scope: []types.RefreshableView{types.STASH, types.FILES},
+			})
+		}
+
+		return nil
+	})
 }
 
 func (self *LocalCommitsController) createFixupCommit(commit *models.Commit) error {
@@ -1208,6 +1412,60 @@ func countSquashableCommitsAbove(commits []*models.Commit, selectedIdx int, reba
 	return result
 }
 
+func (self *LocalCommitsController) exportPatches(commits []*models.Commit, start, end int) error {
+	return (&PatchSeriesMenuAction{c: self.c}).Call(commits)
+}
+
+func (self *LocalCommitsController) canExportPatches(commits []*models.Commit, start, end int) *types.DisabledReason {
+	if lo.SomeBy(commits, func(c *models.Commit) bool { return c.IsTODO() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CannotExportTodoCommits}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) goToCommitByRef() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.GoToCommitPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
+		HandleConfirm: func(ref string) error {
+			hash, err := self.c.Git().Commit.ResolveCommitHash(ref)
+			if err != nil {
+				return errors.New(utils.ResolvePlaceholderString(self.c.Tr.RefNotFound, map[string]string{"ref": ref}))
+			}
+
+			return goToCommitLoadingMoreIfNeeded(self.c, hash,
+				utils.ResolvePlaceholderString(self.c.Tr.CommitNotInCurrentBranch, map[string]string{"ref": ref}))
+		},
+	})
+
+	return nil
+}
+
+func (self *LocalCommitsController) applyPatches() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ApplyPatchesPrompt,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFileSystemPathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			paths, err := self.c.Git().PatchSeries.MailboxPaths(path)
+			if err != nil {
+				return err
+			}
+			if len(paths) == 0 {
+				return errors.New(utils.ResolvePlaceholderString(self.c.Tr.NoPatchesFound, map[string]string{"path": path}))
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.ApplyingPatchesStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.ApplyPatches)
+				err := self.c.Git().PatchSeries.ApplyMailbox(paths)
+				return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *LocalCommitsController) createTag(commit *models.Commit) error {
 	return self.c.Helpers().Tags.OpenCreateTagPrompt(commit.Hash(), func() {})
 }
@@ -1222,6 +1480,20 @@ func (self *LocalCommitsController) openSearch() error {
 	return self.c.Helpers().Search.OpenSearchPrompt(self.context())
 }
 
+func (self *LocalCommitsController) toggleCollapsePushedCommits() error {
+	self.context().SetPushedCommitsCollapsed(!self.context().GetPushedCommitsCollapsed())
+	self.c.PostRefreshUpdate(self.context())
+	return nil
+}
+
+func (self *LocalCommitsController) canCollapsePushedCommits() *types.DisabledReason {
+	if _, found := self.context().FirstPushedCommitIdx(); !found {
+		return &types.DisabledReason{Text: self.c.Tr.NoPushedCommits}
+	}
+
+	return nil
+}
+
 func (self *LocalCommitsController) handleOpenLogMenu() error {
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.LogMenuTitle,
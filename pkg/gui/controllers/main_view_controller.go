@@ -1,6 +1,10 @@
 package controllers
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -51,6 +55,13 @@ func (self *MainViewController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.StartSearch,
 			Tag:         "navigation",
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.GoToLine),
+			Handler:     self.goToLine,
+			Description: self.c.Tr.GoToLine,
+			Tooltip:     self.c.Tr.GoToLineTooltip,
+			Tag:         "navigation",
+		},
 	}
 }
 
@@ -116,3 +127,38 @@ func (self *MainViewController) openSearch() error {
 
 	return nil
 }
+
+func (self *MainViewController) goToLine() error {
+	// like with searching, we need to read all of the task's output first so
+	// that we can jump to lines that haven't been rendered yet
+	if manager := self.c.GetViewBufferManagerForView(self.context.GetView()); manager != nil {
+		manager.ReadToEnd(func() {
+			self.c.OnUIThread(func() error {
+				promptForLineNumber(self.c, func(lineNumber int) error {
+					view := self.context.GetView()
+					maxOriginY := max(0, view.ViewLinesHeight()-view.InnerHeight())
+					view.SetOriginY(min(view.ViewLineIdxOfLine(lineNumber-1), maxOriginY))
+					return nil
+				})
+				return nil
+			})
+		})
+	}
+
+	return nil
+}
+
+// Shared between the main views and the staging/patch building views
+func promptForLineNumber(c *ControllerCommon, onConfirm func(lineNumber int) error) {
+	c.Prompt(types.PromptOpts{
+		Title: c.Tr.GoToLinePrompt,
+		HandleConfirm: func(response string) error {
+			lineNumber, err := strconv.Atoi(strings.TrimSpace(response))
+			if err != nil || lineNumber < 1 {
+				return errors.New(c.Tr.InvalidLineNumber)
+			}
+
+			return onConfirm(lineNumber)
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Shows how healthy the object database of the repo is, and lets the user run
+// git's maintenance tasks
+type MaintenanceMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *MaintenanceMenuAction) Call() error {
+	health, err := self.c.Git().Maintenance.GetRepoHealth()
+	if err != nil {
+		return err
+	}
+
+	prompt := utils.ResolvePlaceholderString(self.c.Tr.MaintenanceHealth, map[string]string{
+		"packedObjects": strconv.Itoa(health.PackedObjects),
+		"packs":         strconv.Itoa(health.Packs),
+		"packSize":      health.PackSize,
+		"looseObjects":  strconv.Itoa(health.LooseObjects),
+		"looseSize":     health.LooseSize,
+		"commitGraph":   lo.Ternary(health.HasCommitGraph, self.c.Tr.CommitGraphPresent, self.c.Tr.CommitGraphMissing),
+		"scheduled":     lo.Ternary(health.IsRegistered, self.c.Tr.MaintenanceRegistered, self.c.Tr.MaintenanceNotRegistered),
+	})
+
+	scheduleItem := &types.MenuItem{
+		Label:   self.c.Tr.StartScheduledMaintenance,
+		Tooltip: self.c.Tr.StartScheduledMaintenanceTooltip,
+		OnPress: func() error {
+			return self.runInBackground(self.c.Tr.Actions.StartScheduledMaintenance, self.c.Git().Maintenance.Start)
+		},
+		Key: 's',
+	}
+	if health.IsRegistered {
+		scheduleItem = &types.MenuItem{
+			Label:   self.c.Tr.StopScheduledMaintenance,
+			Tooltip: self.c.Tr.StopScheduledMaintenanceTooltip,
+			OnPress: func() error {
+				return self.runInBackground(self.c.Tr.Actions.StopScheduledMaintenance, self.c.Git().Maintenance.Unregister)
+			},
+			Key: 's',
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:  self.c.Tr.MaintenanceTitle,
+		Prompt: prompt,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.RunMaintenance, style.FgYellow.Sprint("git maintenance run")},
+				Tooltip:      self.c.Tr.RunMaintenanceTooltip,
+				OnPress: func() error {
+					return self.runInBackground(self.c.Tr.Actions.RunMaintenance, self.c.Git().Maintenance.Run)
+				},
+				Key: 'm',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.GarbageCollect, style.FgYellow.Sprint("git gc")},
+				Tooltip:      self.c.Tr.GarbageCollectTooltip,
+				OnPress: func() error {
+					return self.runInBackground(self.c.Tr.Actions.GarbageCollect, self.c.Git().Maintenance.Gc)
+				},
+				Key: 'g',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.Repack, style.FgYellow.Sprint("git repack -a -d")},
+				Tooltip:      self.c.Tr.RepackTooltip,
+				OnPress: func() error {
+					return self.runInBackground(self.c.Tr.Actions.Repack, self.c.Git().Maintenance.Repack)
+				},
+				Key: 'r',
+			},
+			scheduleItem,
+		},
+	})
+}
+
+// Maintenance can take a while in big repos, so we let the user carry on
+// working and tell them when it's done
+func (self *MaintenanceMenuAction) runInBackground(action string, f func() error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.RunningMaintenanceStatus, func(gocui.Task) error {
+		self.c.LogAction(action)
+		if err := f(); err != nil {
+			return err
+		}
+
+		self.c.Toast(self.c.Tr.MaintenanceDone)
+		return nil
+	})
+}
@@ -162,20 +162,20 @@ func (self *MergeConflictsController) GetOnFocusLost() func(types.OnFocusLostOpt
 	return func(types.OnFocusLostOpts) {
 		self.context().SetUserScrolling(false)
 		self.context().GetState().ResetConflictSelection()
-		self.c.Views().MergeConflicts.Wrap = true
+		self.c.Views().MergeConflicts.Wrap = self.c.UserConfig().Gui.WrapLinesInMainView
 	}
 }
 
 func (self *MergeConflictsController) HandleScrollUp() error {
 	self.context().SetUserScrolling(true)
-	self.context().GetViewTrait().ScrollUp(self.c.UserConfig().Gui.ScrollHeight)
+	self.context().GetViewTrait().ScrollUp(self.c.UserConfig().Gui.MouseWheelScrollHeight())
 
 	return nil
 }
 
 func (self *MergeConflictsController) HandleScrollDown() error {
 	self.context().SetUserScrolling(true)
-	self.context().GetViewTrait().ScrollDown(self.c.UserConfig().Gui.ScrollHeight)
+	self.context().GetViewTrait().ScrollDown(self.c.UserConfig().Gui.MouseWheelScrollHeight())
 
 	return nil
 }
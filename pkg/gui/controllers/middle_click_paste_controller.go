@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// This controller lets you paste the clipboard into a text input by clicking
+// the middle mouse button, if enabled via the gui.mouse.middleClickPaste config.
+
+type MiddleClickPasteController struct {
+	baseController
+	c *ControllerCommon
+
+	context        types.Context
+	allowMultiline bool
+}
+
+var _ types.IController = &MiddleClickPasteController{}
+
+func NewMiddleClickPasteController(
+	c *ControllerCommon,
+	context types.Context,
+	allowMultiline bool,
+) *MiddleClickPasteController {
+	return &MiddleClickPasteController{
+		baseController: baseController{},
+		c:              c,
+		context:        context,
+		allowMultiline: allowMultiline,
+	}
+}
+
+func (self *MiddleClickPasteController) Context() types.Context {
+	return self.context
+}
+
+func (self *MiddleClickPasteController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
+	return []*gocui.ViewMouseBinding{
+		{
+			ViewName: self.context.GetViewName(),
+			Key:      gocui.MouseMiddle,
+			Handler:  self.onMiddleClick,
+		},
+	}
+}
+
+func (self *MiddleClickPasteController) onMiddleClick(gocui.ViewMouseBindingOpts) error {
+	if !self.c.UserConfig().Gui.Mouse.MiddleClickPaste {
+		return nil
+	}
+
+	// We only paste into the input that currently has focus; pasting into a
+	// view that the user can't type into would be surprising.
+	if self.c.Context().Current().GetKey() != self.context.GetKey() {
+		return nil
+	}
+
+	text, err := self.c.OS().PasteFromClipboard()
+	if err != nil {
+		return err
+	}
+
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if !self.allowMultiline {
+		text = strings.ReplaceAll(strings.TrimRight(text, "\n"), "\n", " ")
+	}
+
+	// Feed the text through the view's editor rather than writing to the text
+	// area directly, so that whatever the editor does on each keypress (e.g.
+	// updating suggestions or the commit message length) happens here too.
+	view := self.context.GetView()
+	for _, ch := range text {
+		if ch == '\n' {
+			view.Editor.Edit(view, gocui.KeyEnter, 0, gocui.ModNone)
+		} else {
+			view.Editor.Edit(view, 0, ch, gocui.ModNone)
+		}
+	}
+
+	return nil
+}
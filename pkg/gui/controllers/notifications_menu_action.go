@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/status"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Shows the toasts that were shown so far, so that users can read the ones
+// that disappeared too quickly, e.g. the result of a background fetch.
+type NotificationsMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *NotificationsMenuAction) Call() error {
+	history := self.c.Helpers().AppStatus.GetToastHistory()
+
+	menuItems := lo.Map(history, func(entry status.ToastHistoryEntry, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				style.FgBlue.Sprint(entry.Time.Format("15:04:05")),
+				self.styleForKind(entry.Kind).Sprint(entry.Message),
+			},
+			// This is only for reading, so selecting an item just closes the
+			// menu
+			OnPress: func() error { return nil },
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:  self.c.Tr.Notifications,
+		Prompt: lo.Ternary(len(history) == 0, self.c.Tr.NoNotifications, ""),
+		Items:  menuItems,
+	})
+}
+
+func (self *NotificationsMenuAction) styleForKind(kind types.ToastKind) style.TextStyle {
+	switch kind {
+	case types.ToastKindWarning:
+		return style.FgYellow
+	case types.ToastKindError:
+		return style.FgRed
+	default:
+		return style.FgDefault
+	}
+}
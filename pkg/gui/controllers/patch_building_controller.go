@@ -88,7 +88,7 @@ func (self *PatchBuildingController) GetOnFocusLost() func(types.OnFocusLostOpts
 	return func(opts types.OnFocusLostOpts) {
 		self.context().SetState(nil)
 
-		self.c.Views().PatchBuilding.Wrap = true
+		self.c.Views().PatchBuilding.Wrap = self.c.UserConfig().Gui.WrapLinesInMainView
 
 		if self.c.Git().Patch.PatchBuilder.IsEmpty() {
 			self.c.Git().Patch.PatchBuilder.Reset()
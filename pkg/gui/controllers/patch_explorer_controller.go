@@ -151,6 +151,13 @@ func (self *PatchExplorerController) GetKeybindings(opts types.KeybindingsOpts)
 			Key:     opts.GetKey(opts.Config.Universal.ScrollRight),
 			Handler: self.withRenderAndFocus(self.HandleScrollRight),
 		},
+		{
+			Tag:         "navigation",
+			Key:         opts.GetKey(opts.Config.Main.GoToLine),
+			Handler:     self.withLock(self.HandleGoToLine),
+			Description: self.c.Tr.GoToLine,
+			Tooltip:     self.c.Tr.GoToLineTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.CopyToClipboard),
 			Handler:     self.withLock(self.CopySelectedToClipboard),
@@ -288,6 +295,17 @@ func (self *PatchExplorerController) HandleGotoBottom() error {
 	return nil
 }
 
+func (self *PatchExplorerController) HandleGoToLine() error {
+	promptForLineNumber(self.c, func(lineNumber int) error {
+		return self.withRenderAndFocus(func() error {
+			self.context.GetState().SelectLineNumber(lineNumber)
+			return nil
+		})()
+	})
+
+	return nil
+}
+
 func (self *PatchExplorerController) HandleMouseDown() error {
 	self.context.GetState().SelectNewLineForRange(self.context.GetViewTrait().SelectedLineIdx())
 
@@ -295,6 +313,10 @@ func (self *PatchExplorerController) HandleMouseDown() error {
 }
 
 func (self *PatchExplorerController) HandleMouseDrag() error {
+	if !self.c.UserConfig().Gui.Mouse.DragToSelectInStagingView {
+		return nil
+	}
+
 	self.context.GetState().DragSelectLine(self.context.GetViewTrait().SelectedLineIdx())
 
 	return nil
@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Exports a range of commits as patch files, for projects that take
+// contributions by mail, and optionally sends them with git send-email
+type PatchSeriesMenuAction struct {
+	c *ControllerCommon
+}
+
+// The commits are ordered newest first, like in the commits view
+func (self *PatchSeriesMenuAction) Call(commits []*models.Commit) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ExportPatches,
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.ExportPatchFiles, style.FgYellow.Sprint("git format-patch")},
+				Tooltip:      self.c.Tr.ExportPatchFilesTooltip,
+				OnPress: func() error {
+					self.c.Prompt(types.PromptOpts{
+						Title:          self.c.Tr.PatchesOutputDirectory,
+						InitialContent: "patches",
+						HandleConfirm: func(dir string) error {
+							self.promptForCoverLetter(func(subject string, blurb string) error {
+								return self.export(commits, dir, subject, blurb)
+							})
+							return nil
+						},
+					})
+					return nil
+				},
+				Key: 'e',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.SendPatchesWithEmail, style.FgYellow.Sprint("git send-email")},
+				Tooltip:      self.c.Tr.SendPatchesWithEmailTooltip,
+				OnPress: func() error {
+					self.promptForCoverLetter(func(subject string, blurb string) error {
+						return self.send(commits, subject, blurb)
+					})
+					return nil
+				},
+				Key: 's',
+			},
+		},
+	})
+}
+
+// Kernel-style series usually come with a cover letter that explains the
+// series as a whole, but for a single patch it's common to go without one
+func (self *PatchSeriesMenuAction) promptForCoverLetter(then func(subject string, blurb string) error) {
+	self.c.Prompt(types.PromptOpts{
+		Title:           self.c.Tr.CoverLetterSubject,
+		AllowEmptyInput: true,
+		HandleConfirm: func(subject string) error {
+			if subject == "" {
+				return then("", "")
+			}
+
+			self.c.Prompt(types.PromptOpts{
+				Title:           self.c.Tr.CoverLetterBlurb,
+				AllowEmptyInput: true,
+				HandleConfirm: func(blurb string) error {
+					return then(subject, blurb)
+				},
+			})
+			return nil
+		},
+	})
+}
+
+func (self *PatchSeriesMenuAction) export(commits []*models.Commit, dir string, subject string, blurb string) error {
+	return self.c.WithWaitingStatusSync(self.c.Tr.ExportingPatchesStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.ExportPatches)
+		paths, err := self.c.Git().PatchSeries.FormatPatch(self.formatPatchOpts(commits, dir, subject, blurb))
+		if err != nil {
+			return err
+		}
+
+		self.c.Toast(self.c.Tr.FormatMessage(self.c.Tr.ExportedPatches, map[string]string{
+			"count": strconv.Itoa(len(paths)),
+			"dir":   dir,
+		}))
+		return nil
+	})
+}
+
+func (self *PatchSeriesMenuAction) send(commits []*models.Commit, subject string, blurb string) error {
+	self.c.LogAction(self.c.Tr.Actions.SendPatches)
+	dir := self.c.Git().PatchSeries.TemporaryOutputDir()
+	paths, err := self.c.Git().PatchSeries.FormatPatch(self.formatPatchOpts(commits, dir, subject, blurb))
+	if err != nil {
+		return err
+	}
+
+	return self.c.RunSubprocessAndRefresh(self.c.Git().PatchSeries.SendEmailCmdObj(paths))
+}
+
+func (self *PatchSeriesMenuAction) formatPatchOpts(commits []*models.Commit, dir string, subject string, blurb string) git_commands.FormatPatchOpts {
+	oldest := commits[len(commits)-1]
+	return git_commands.FormatPatchOpts{
+		OldestHash:         oldest.Hash(),
+		NewestHash:         commits[0].Hash(),
+		OldestIsRoot:       oldest.IsFirstCommit(),
+		OutputDir:          dir,
+		CoverLetterSubject: subject,
+		CoverLetterBlurb:   blurb,
+	}
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/app/session"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -25,23 +26,29 @@ func (self *QuitActions) quitAux() error {
 		return self.confirmQuitDuringUpdate()
 	}
 
-	return self.c.ConfirmIf(self.c.UserConfig().ConfirmOnQuit,
+	return self.c.ConfirmIf(self.c.UserConfig().Confirmations.Quit,
 		types.ConfirmOpts{
-			Title:  "",
-			Prompt: self.c.Tr.ConfirmQuit,
-			HandleConfirm: func() error {
-				return gocui.ErrQuit
-			},
+			Title:         "",
+			Prompt:        self.c.Tr.ConfirmQuit,
+			HandleConfirm: self.quit,
 		})
 }
 
+// In a session (see `lazygit --attach`), quitting detaches the terminal rather
+// than ending lazygit, so that its state is still there when reattaching
+func (self *QuitActions) quit() error {
+	if session.InSession() {
+		return session.RequestDetach()
+	}
+
+	return gocui.ErrQuit
+}
+
 func (self *QuitActions) confirmQuitDuringUpdate() error {
 	self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.ConfirmQuitDuringUpdateTitle,
-		Prompt: self.c.Tr.ConfirmQuitDuringUpdate,
-		HandleConfirm: func() error {
-			return gocui.ErrQuit
-		},
+		Title:         self.c.Tr.ConfirmQuitDuringUpdateTitle,
+		Prompt:        self.c.Tr.ConfirmQuitDuringUpdate,
+		HandleConfirm: self.quit,
 	})
 
 	return nil
@@ -58,6 +65,12 @@ func (self *QuitActions) Escape() error {
 			self.c.PostRefreshUpdate(listContext)
 			return nil
 		}
+
+		if listContext.GetList().HasMarks() {
+			listContext.GetList().ClearMarks()
+			self.c.PostRefreshUpdate(listContext)
+			return nil
+		}
 	}
 
 	// Cancelling searching (as opposed to filtering) is handled by gocui
@@ -97,7 +110,7 @@ func (self *QuitActions) EscapeEnabled() bool {
 	currentContext := self.c.Context().Current()
 
 	if listContext, ok := currentContext.(types.IListContext); ok {
-		if listContext.GetList().IsSelectingRange() {
+		if listContext.GetList().IsSelectingRange() || listContext.GetList().HasMarks() {
 			return true
 		}
 	}
@@ -138,6 +151,10 @@ func (self *QuitActions) EscapeDescription() string {
 		if listContext.GetList().IsSelectingRange() {
 			return self.c.Tr.DismissRangeSelect
 		}
+
+		if listContext.GetList().HasMarks() {
+			return self.c.Tr.ClearMarks
+		}
 	}
 
 	if ctx, ok := currentContext.(types.IFilterableContext); ok {
@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"errors"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type ReflogCommitsController struct {
@@ -29,6 +32,17 @@ func NewReflogCommitsController(
 	}
 }
 
+func (self *ReflogCommitsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ShowReflogOfRef),
+			Handler:     self.showReflogOfRef,
+			Description: self.c.Tr.ShowReflogOfRef,
+			Tooltip:     self.c.Tr.ShowReflogOfRefTooltip,
+		},
+	}
+}
+
 func (self *ReflogCommitsController) Context() types.Context {
 	return self.context()
 }
@@ -45,18 +59,50 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() {
 			if commit == nil {
 				task = types.NewRenderStringTask("No reflog history")
 			} else {
-				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.c.Helpers().Diff.FilterPathsForCommit(commit))
+				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.c.Helpers().Diff.FilterPathsForCommit(commit), self.c.Modes().Filtering.ContentFilter())
 
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				task = types.NewRunPtyTask(cmdObj.GetCmd()).AsDiff()
+			}
+
+			mainOpts := &types.ViewUpdateOpts{
+				Title: "Reflog Entry",
+				Task:  task,
+			}
+			if commit != nil {
+				self.c.Helpers().Diff.AddCommitDetailsTab(mainOpts, commit)
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
 				Pair: self.c.MainViewPairs().Normal,
-				Main: &types.ViewUpdateOpts{
-					Title: "Reflog Entry",
-					Task:  task,
-				},
+				Main: mainOpts,
 			})
 		})
 	}
 }
+
+func (self *ReflogCommitsController) showReflogOfRef() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ShowReflogOfRefPrompt,
+		InitialContent:      self.context().GetRef(),
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
+		AllowEmptyInput:     true,
+		HandleConfirm: func(ref string) error {
+			if ref == "HEAD" {
+				ref = ""
+			}
+
+			if ref != "" {
+				if _, err := self.c.Git().Commit.ResolveCommitHash(ref); err != nil {
+					return errors.New(utils.ResolvePlaceholderString(self.c.Tr.RefNotFound, map[string]string{"ref": ref}))
+				}
+			}
+
+			self.context().SetRef(ref)
+			self.context().SetSelection(0)
+			self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.REFLOG}})
+			return nil
+		},
+	})
+
+	return nil
+}
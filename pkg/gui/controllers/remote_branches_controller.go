@@ -67,7 +67,7 @@ func (self *RemoteBranchesController) GetKeybindings(opts types.KeybindingsOpts)
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItems(self.delete),
-			GetDisabledReason: self.require(self.itemRangeSelected()),
+			GetDisabledReason: self.require(self.itemsSelected()),
 			Description:       self.c.Tr.Delete,
 			Tooltip:           self.c.Tr.DeleteRemoteBranchTooltip,
 			DisplayOnScreen:   true,
@@ -33,6 +33,21 @@ func (self *ScreenModeActions) Prev() error {
 	return nil
 }
 
+// ToggleZoom makes the focused window take up the whole screen, or goes back
+// to the screen mode that was active before if it already does
+func (self *ScreenModeActions) ToggleZoom() error {
+	repoState := self.c.State().GetRepoState()
+	if repoState.GetScreenMode() == types.SCREEN_FULL {
+		repoState.SetScreenMode(repoState.GetScreenModeBeforeZoom())
+	} else {
+		repoState.SetScreenModeBeforeZoom(repoState.GetScreenMode())
+		repoState.SetScreenMode(types.SCREEN_FULL)
+	}
+
+	self.rerenderViewsWithScreenModeDependentContent()
+	return nil
+}
+
 // these views need to be re-rendered when the screen mode changes. The commits view,
 // for example, will show authorship information in half and full screen mode.
 func (self *ScreenModeActions) rerenderViewsWithScreenModeDependentContent() {
@@ -43,6 +43,18 @@ func (self *SearchPromptController) GetKeybindings(opts types.KeybindingsOpts) [
 			Modifier: gocui.ModNone,
 			Handler:  self.nextHistory,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.ToggleSearchRegex),
+			Handler:           self.toggleRegex,
+			GetDisabledReason: self.requireSearching,
+			Description:       self.c.Tr.ToggleSearchRegex,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.ToggleSearchCaseSensitivity),
+			Handler:           self.cycleCaseSensitivity,
+			GetDisabledReason: self.requireSearching,
+			Description:       self.c.Tr.ToggleSearchCaseSensitivity,
+		},
 	}
 }
 
@@ -71,3 +83,22 @@ func (self *SearchPromptController) nextHistory() error {
 	self.c.Helpers().Search.ScrollHistory(-1)
 	return nil
 }
+
+func (self *SearchPromptController) toggleRegex() error {
+	self.c.Helpers().Search.ToggleRegex()
+	return nil
+}
+
+func (self *SearchPromptController) cycleCaseSensitivity() error {
+	self.c.Helpers().Search.CycleCaseSensitivity()
+	return nil
+}
+
+// The search options don't apply to filtering
+func (self *SearchPromptController) requireSearching() *types.DisabledReason {
+	if self.c.State().GetRepoState().GetSearchState().SearchType() != types.SearchTypeSearch {
+		return &types.DisabledReason{Text: self.c.Tr.OnlyAvailableWhenSearching}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type SidePanelLayoutMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *SidePanelLayoutMenuAction) Call() error {
+	windows := self.c.Helpers().Window.SideWindows()
+	currentWindow := self.c.Context().CurrentSide().GetWindowName()
+	index := slices.Index(windows, currentWindow)
+	panelName := map[string]string{"panel": currentWindow}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.MoveSidePanelUp, panelName),
+			OnPress: func() error {
+				return self.setSideWindows(utils.MoveElement(windows, index, index-1))
+			},
+			Key: 'u',
+			DisabledReason: self.moveDisabledReason(index, func() bool { return index == 0 },
+				self.c.Tr.SidePanelAlreadyAtTop),
+		},
+		{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.MoveSidePanelDown, panelName),
+			OnPress: func() error {
+				return self.setSideWindows(utils.MoveElement(windows, index, index+1))
+			},
+			Key: 'd',
+			DisabledReason: self.moveDisabledReason(index, func() bool { return index == len(windows)-1 },
+				self.c.Tr.SidePanelAlreadyAtBottom),
+		},
+		{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.HideSidePanel, panelName),
+			OnPress: func() error {
+				return self.setSideWindows(slices.Delete(slices.Clone(windows), index, index+1))
+			},
+			Key: 'h',
+			DisabledReason: self.moveDisabledReason(index, func() bool { return len(windows) == 1 },
+				self.c.Tr.CannotHideLastSidePanel),
+		},
+	}
+
+	hiddenWindows := lo.Without(config.SidePanelNames, windows...)
+	for _, window := range hiddenWindows {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.ShowSidePanel, map[string]string{"panel": window}),
+			OnPress: func() error {
+				return self.setSideWindows(append(slices.Clone(windows), window))
+			},
+		})
+	}
+
+	panelResizeHelper := self.c.Helpers().PanelResize
+	currentWidth := panelResizeHelper.SidePanelWidth()
+	currentSplit := panelResizeHelper.MainViewSplit()
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label: self.c.Tr.WidenSidePanels,
+			OnPress: func() error {
+				panelResizeHelper.SetSidePanelWidth(currentWidth + helpers.PanelSizeStep)
+				return nil
+			},
+			Key: 'w',
+			DisabledReason: lo.Ternary(currentWidth+helpers.PanelSizeStep > helpers.MaxPanelSize,
+				&types.DisabledReason{Text: self.c.Tr.SidePanelsAlreadyAtMaximumWidth}, nil),
+		},
+		&types.MenuItem{
+			Label: self.c.Tr.NarrowSidePanels,
+			OnPress: func() error {
+				panelResizeHelper.SetSidePanelWidth(currentWidth - helpers.PanelSizeStep)
+				return nil
+			},
+			Key: 'n',
+			DisabledReason: lo.Ternary(currentWidth-helpers.PanelSizeStep < helpers.MinPanelSize,
+				&types.DisabledReason{Text: self.c.Tr.SidePanelsAlreadyAtMinimumWidth}, nil),
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.GrowMainView,
+			Tooltip: self.c.Tr.ResizeMainViewTooltip,
+			OnPress: func() error {
+				panelResizeHelper.SetMainViewSplit(currentSplit + helpers.PanelSizeStep)
+				return nil
+			},
+			Key: 'g',
+			DisabledReason: self.resizeMainViewDisabledReason(currentSplit+helpers.PanelSizeStep > helpers.MaxPanelSize,
+				self.c.Tr.MainViewAlreadyAtMaximumSize),
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.ShrinkMainView,
+			Tooltip: self.c.Tr.ResizeMainViewTooltip,
+			OnPress: func() error {
+				panelResizeHelper.SetMainViewSplit(currentSplit - helpers.PanelSizeStep)
+				return nil
+			},
+			Key: 's',
+			DisabledReason: self.resizeMainViewDisabledReason(currentSplit-helpers.PanelSizeStep < helpers.MinPanelSize,
+				self.c.Tr.MainViewAlreadyAtMinimumSize),
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.ResetSidePanelLayout,
+			Tooltip: self.c.Tr.ResetSidePanelLayoutTooltip,
+			OnPress: self.reset,
+			Key:     'r',
+			DisabledReason: lo.Ternary(!self.isLayoutChanged(),
+				&types.DisabledReason{Text: self.c.Tr.SidePanelLayoutNotChanged}, nil),
+		},
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.SidePanelLayoutMenuTitle, Items: menuItems})
+}
+
+// The current side window can be one that is hidden (e.g. if some action
+// switched to it), in which case it can't be moved or hidden.
+func (self *SidePanelLayoutMenuAction) moveDisabledReason(index int, isDisabled func() bool, text string) *types.DisabledReason {
+	if index == -1 {
+		return &types.DisabledReason{Text: self.c.Tr.SidePanelIsHidden}
+	}
+
+	if isDisabled() {
+		return &types.DisabledReason{Text: text}
+	}
+
+	return nil
+}
+
+func (self *SidePanelLayoutMenuAction) resizeMainViewDisabledReason(isAtLimit bool, text string) *types.DisabledReason {
+	if !self.c.State().GetRepoState().GetSplitMainPanel() {
+		return &types.DisabledReason{Text: self.c.Tr.MainViewNotSplit}
+	}
+
+	if isAtLimit {
+		return &types.DisabledReason{Text: text}
+	}
+
+	return nil
+}
+
+func (self *SidePanelLayoutMenuAction) isLayoutChanged() bool {
+	appState := self.c.GetAppState()
+	return len(appState.SidePanels) > 0 || appState.SidePanelWidth > 0 ||
+		self.c.Helpers().Window.PanelSizes() != (config.PanelSizes{})
+}
+
+func (self *SidePanelLayoutMenuAction) setSideWindows(windows []string) error {
+	self.c.GetAppState().SidePanels = windows
+	self.c.SaveAppStateAndLogError()
+
+	return self.onLayoutChanged()
+}
+
+func (self *SidePanelLayoutMenuAction) reset() error {
+	appState := self.c.GetAppState()
+	appState.SidePanels = nil
+	appState.SidePanelWidth = 0
+	self.c.Helpers().Window.SetPanelSizes(config.PanelSizes{})
+
+	return self.onLayoutChanged()
+}
+
+func (self *SidePanelLayoutMenuAction) onLayoutChanged() error {
+	// If the focused side window is no longer visible, move focus to the first
+	// one that is
+	windows := self.c.Helpers().Window.SideWindows()
+	if !lo.Contains(windows, self.c.Context().CurrentSide().GetWindowName()) {
+		self.c.Context().Push(self.c.Helpers().Window.GetContextForWindow(windows[0]), types.OnFocusOpts{})
+	}
+
+	return self.c.OnSidePanelLayoutChanged()
+}
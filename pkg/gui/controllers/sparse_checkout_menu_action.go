@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Shows the sparse-checkout definition of the repo, and lets the user add or
+// remove directories (or patterns, when not in cone mode), switch between the
+// two modes, or check out the whole repo again
+type SparseCheckoutMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *SparseCheckoutMenuAction) Call() error {
+	info, err := self.c.Git().SparseCheckout.GetInfo()
+	if err != nil {
+		return err
+	}
+
+	var notEnabledReason *types.DisabledReason
+	if !info.Enabled {
+		notEnabledReason = &types.DisabledReason{Text: self.c.Tr.NotASparseCheckout}
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:   lo.Ternary(info.Enabled && !info.ConeMode, self.c.Tr.SparseCheckoutAddPattern, self.c.Tr.SparseCheckoutAddDirectory),
+			OnPress: func() error { return self.add(info) },
+			Tooltip: self.c.Tr.SparseCheckoutAddTooltip,
+			Key:     'a',
+		},
+		{
+			Label: lo.Ternary(info.ConeMode, self.c.Tr.SparseCheckoutDisableConeMode, self.c.Tr.SparseCheckoutEnableConeMode),
+			OnPress: func() error {
+				return self.update(self.c.Tr.Actions.ToggleSparseCheckoutConeMode, func() error {
+					return self.c.Git().SparseCheckout.SetConeMode(!info.ConeMode)
+				})
+			},
+			Tooltip:        self.c.Tr.SparseCheckoutConeModeTooltip,
+			DisabledReason: notEnabledReason,
+			Key:            'c',
+		},
+		{
+			Label:          self.c.Tr.DisableSparseCheckout,
+			OnPress:        self.disable,
+			Tooltip:        self.c.Tr.DisableSparseCheckoutTooltip,
+			DisabledReason: notEnabledReason,
+			Key:            'd',
+		},
+	}
+
+	patternsSection := &types.MenuSection{
+		Title:  lo.Ternary(info.ConeMode || !info.Enabled, self.c.Tr.SparseCheckoutDirectories, self.c.Tr.SparseCheckoutPatterns),
+		Column: 0,
+	}
+	for _, pattern := range info.Patterns {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   pattern,
+			OnPress: func() error { return self.remove(info, pattern) },
+			Tooltip: self.c.Tr.SparseCheckoutRemoveTooltip,
+			Section: patternsSection,
+		})
+	}
+	if !info.Enabled {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          self.c.Tr.NotASparseCheckout,
+			OnPress:        func() error { return nil },
+			DisabledReason: notEnabledReason,
+			Section:        patternsSection,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SparseCheckoutTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *SparseCheckoutMenuAction) add(info *git_commands.SparseCheckoutInfo) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          lo.Ternary(info.Enabled && !info.ConeMode, self.c.Tr.SparseCheckoutAddPattern, self.c.Tr.SparseCheckoutAddDirectory),
+		InitialContent: self.selectedDirectory(),
+		HandleConfirm: func(pattern string) error {
+			return self.update(self.c.Tr.Actions.AddToSparseCheckout, func() error {
+				// `sparse-checkout add` only works in a sparse checkout, so we
+				// start a new one (in cone mode) if there isn't one yet
+				if !info.Enabled {
+					return self.c.Git().SparseCheckout.Set([]string{pattern}, true)
+				}
+				return self.c.Git().SparseCheckout.Add(pattern)
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *SparseCheckoutMenuAction) remove(info *git_commands.SparseCheckoutInfo, pattern string) error {
+	return self.update(self.c.Tr.Actions.RemoveFromSparseCheckout, func() error {
+		remaining := lo.Without(info.Patterns, pattern)
+		return self.c.Git().SparseCheckout.Set(remaining, info.ConeMode)
+	})
+}
+
+func (self *SparseCheckoutMenuAction) disable() error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DisableSparseCheckout,
+		Prompt: self.c.Tr.DisableSparseCheckoutPrompt,
+		HandleConfirm: func() error {
+			return self.update(self.c.Tr.Actions.DisableSparseCheckout, self.c.Git().SparseCheckout.Disable)
+		},
+	})
+
+	return nil
+}
+
+// Changing the sparse-checkout definition adds or removes files in the working
+// tree, which can take a while in a big repo
+func (self *SparseCheckoutMenuAction) update(action string, f func() error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+		self.c.LogAction(action)
+		err := f()
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+		return err
+	})
+}
+
+// The directory of the selected file, or the selected directory, in the files
+// panel. Most directories that one would want to add aren't in the working tree
+// of course, but this is handy when adding a subdirectory of one that is.
+func (self *SparseCheckoutMenuAction) selectedDirectory() string {
+	node := self.c.Contexts().Files.GetSelected()
+	if node == nil {
+		return ""
+	}
+
+	dir := node.GetPath()
+	if node.IsFile() {
+		dir = filepath.Dir(dir)
+	}
+	if dir == "." {
+		return ""
+	}
+	return filepath.ToSlash(dir)
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -55,6 +57,12 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Tooltip:         self.c.Tr.DiscardSelectionTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleMark),
+			Handler:     self.ToggleMark,
+			Description: self.c.Tr.ToggleMark,
+			Tooltip:     self.c.Tr.StagingToggleMarkTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.OpenFile),
 			Handler:     self.OpenFile,
@@ -87,6 +95,12 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Description: self.c.Tr.EditHunk,
 			Tooltip:     self.c.Tr.EditHunkTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.StageWords),
+			Handler:     self.StageWords,
+			Description: self.c.Tr.StageWords,
+			Tooltip:     self.c.Tr.StageWordsTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.CommitChanges),
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitPress,
@@ -135,8 +149,9 @@ func (self *StagingController) GetOnFocusLost() func(types.OnFocusLostOpts) {
 		self.context.SetState(nil)
 
 		if opts.NewContextKey != self.otherContext.GetKey() {
-			self.c.Views().Staging.Wrap = true
-			self.c.Views().StagingSecondary.Wrap = true
+			wrap := self.c.UserConfig().Gui.WrapLinesInMainView
+			self.c.Views().Staging.Wrap = wrap
+			self.c.Views().StagingSecondary.Wrap = wrap
 		}
 	}
 }
@@ -170,6 +185,12 @@ func (self *StagingController) EditFile() error {
 }
 
 func (self *StagingController) Escape() error {
+	if self.context.GetState().HasMarks() {
+		self.context.GetState().ClearMarks()
+		self.c.PostRefreshUpdate(self.context)
+		return nil
+	}
+
 	if self.context.GetState().SelectingRange() || self.context.GetState().SelectingHunkEnabledByUser() {
 		self.context.GetState().SetLineSelectMode()
 		self.c.PostRefreshUpdate(self.context)
@@ -182,6 +203,10 @@ func (self *StagingController) Escape() error {
 
 func (self *StagingController) EscapeDescription() string {
 	if state := self.context.GetState(); state != nil {
+		if state.HasMarks() {
+			return self.c.Tr.ClearMarks
+		}
+
 		if state.SelectingRange() {
 			return self.c.Tr.DismissRangeSelect
 		}
@@ -202,6 +227,17 @@ func (self *StagingController) TogglePanel() error {
 	return nil
 }
 
+func (self *StagingController) ToggleMark() error {
+	state := self.context.GetState()
+	if state == nil {
+		return nil
+	}
+
+	state.ToggleMarkSelection()
+	self.c.PostRefreshUpdate(self.context)
+	return nil
+}
+
 func (self *StagingController) ToggleStaged() error {
 	if self.c.UserConfig().Git.DiffContextSize == 0 {
 		return fmt.Errorf(self.c.Tr.Actions.NotEnoughContextToStage,
@@ -217,7 +253,7 @@ func (self *StagingController) DiscardSelection() error {
 			keybindings.Label(self.c.UserConfig().Keybinding.Universal.IncreaseContextInDiffView))
 	}
 
-	return self.c.ConfirmIf(!self.staged && !self.c.UserConfig().Gui.SkipDiscardChangeWarning,
+	return self.c.ConfirmIf(!self.staged && self.c.UserConfig().Confirmations.DiscardChange,
 		types.ConfirmOpts{
 			Title:         self.c.Tr.DiscardChangeTitle,
 			Prompt:        self.c.Tr.DiscardChangePrompt,
@@ -244,12 +280,21 @@ func (self *StagingController) applySelection(reverse bool) error {
 		return nil
 	}
 
-	firstLineIdx, lastLineIdx := state.SelectedPatchRange()
+	// If any lines are marked, we apply all of them in one go instead of the
+	// selection
+	var includedLineIndices []int
+	if state.HasMarks() {
+		includedLineIndices = state.MarkedPatchLineIndices()
+	} else {
+		firstLineIdx, lastLineIdx := state.SelectedPatchRange()
+		includedLineIndices = patch.ExpandRange(firstLineIdx, lastLineIdx)
+	}
+
 	patchToApply := patch.
 		Parse(state.GetDiff()).
 		Transform(patch.TransformOpts{
 			Reverse:             reverse,
-			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+			IncludedLineIndices: includedLineIndices,
 			FileNameOverride:    path,
 		}).
 		FormatPlain()
@@ -272,6 +317,8 @@ func (self *StagingController) applySelection(reverse bool) error {
 		return err
 	}
 
+	state.ClearMarks()
+
 	if state.SelectingRange() {
 		firstLine, _ := state.SelectedViewRange()
 		state.SelectLine(firstLine)
@@ -280,6 +327,81 @@ func (self *StagingController) applySelection(reverse bool) error {
 	return nil
 }
 
+// StageWords shows a menu of the word-level changes between the selected line
+// and its counterpart on the other side of the diff, so that a single one of
+// them can be staged (or unstaged, in the staged view).
+func (self *StagingController) StageWords() error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	path := self.FilePath()
+	if state == nil || path == "" {
+		return nil
+	}
+
+	parsedPatch := patch.Parse(state.GetDiff())
+	deletionIdx := state.GetSelectedPatchLineIdx()
+	additionIdx, ok := parsedPatch.PairedChangeLine(deletionIdx)
+	if !ok {
+		return errors.New(self.c.Tr.NoCounterpartLineToStageWords)
+	}
+	lines := parsedPatch.Lines()
+	if lines[deletionIdx].IsAddition() {
+		deletionIdx, additionIdx = additionIdx, deletionIdx
+	}
+
+	segments := patch.WordDiff(lines[deletionIdx].Content[1:], lines[additionIdx].Content[1:])
+
+	menuItems := []*types.MenuItem{}
+	for i, segment := range segments {
+		if !segment.IsChange() {
+			continue
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{style.FgRed.Sprint(segment.Old), style.FgGreen.Sprint(segment.New)},
+			OnPress: func() error {
+				if err := self.applyWordChange(parsedPatch, segments, i, deletionIdx, additionIdx); err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING}})
+				return nil
+			},
+		})
+	}
+
+	title := self.c.Tr.StageWords
+	if self.staged {
+		title = self.c.Tr.UnstageWords
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
+}
+
+// Stages (or unstages) the change at the given segment index by replacing the
+// line in the index with a version that has only that change toggled
+func (self *StagingController) applyWordChange(parsedPatch *patch.Patch, segments []patch.WordSegment, segmentIdx int, deletionIdx int, additionIdx int) error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	// In the unstaged view the index holds the old line, so we apply just the
+	// chosen change to it. In the staged view the index holds the new line, so
+	// we apply all changes except the chosen one to the old line.
+	var patchToApply string
+	if self.staged {
+		replacement := patch.ApplyWordChanges(segments, func(i int) bool { return i != segmentIdx })
+		patchToApply = parsedPatch.ReplaceLine(additionIdx, replacement, self.FilePath()).FormatPlain()
+	} else {
+		replacement := patch.ApplyWordChanges(segments, func(i int) bool { return i == segmentIdx })
+		patchToApply = parsedPatch.ReplaceLine(deletionIdx, replacement, self.FilePath()).FormatPlain()
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyPatch)
+	return self.c.Git().Patch.ApplyPatch(patchToApply, git_commands.ApplyPatchOpts{Cached: true})
+}
+
 func (self *StagingController) EditHunkAndRefresh() error {
 	if err := self.editHunk(); err != nil {
 		return err
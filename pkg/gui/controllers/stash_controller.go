@@ -54,7 +54,7 @@ func (self *StashController) GetKeybindings(opts types.KeybindingsOpts) []*types
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItems(self.handleStashDrop),
-			GetDisabledReason: self.require(self.itemRangeSelected()),
+			GetDisabledReason: self.require(self.itemsSelected()),
 			Description:       self.c.Tr.Drop,
 			Tooltip:           self.c.Tr.StashDropTooltip,
 			DisplayOnScreen:   true,
@@ -89,7 +89,7 @@ func (self *StashController) GetOnRenderToMain() func() {
 				task = types.NewRunPtyTaskWithPrefix(
 					self.c.Git().Stash.ShowStashEntryCmdObj(stashEntry.Index).GetCmd(),
 					prefix,
-				)
+				).AsDiff()
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -109,7 +109,7 @@ func (self *StashController) context() *context.StashContext {
 }
 
 func (self *StashController) handleStashApply(stashEntry *models.StashEntry) error {
-	return self.c.ConfirmIf(!self.c.UserConfig().Gui.SkipStashWarning,
+	return self.c.ConfirmIf(self.c.UserConfig().Confirmations.ApplyStash,
 		types.ConfirmOpts{
 			Title:  self.c.Tr.StashApply,
 			Prompt: self.c.Tr.SureApplyStashEntry,
@@ -143,7 +143,7 @@ func (self *StashController) handleStashPop(stashEntry *models.StashEntry) error
 		return nil
 	}
 
-	if self.c.UserConfig().Gui.SkipStashWarning {
+	if !self.c.UserConfig().Confirmations.ApplyStash {
 		return pop()
 	}
 
@@ -163,7 +163,14 @@ func (self *StashController) handleStashDrop(stashEntries []*models.StashEntry)
 		Title:  self.c.Tr.StashDrop,
 		Prompt: self.c.Tr.SureDropStashEntry,
 		HandleConfirm: func() error {
+			// Stash entries are identified by their index, so the marks would
+			// end up on the wrong entries after dropping some of them
+			self.context().ClearMarks()
 			self.c.LogAction(self.c.Tr.Actions.DropStash)
+			droppedEntries := []*models.StashEntry{}
+			defer func() {
+				self.c.Helpers().OperationJournal.RecordStashDrop(droppedEntries)
+			}()
 			for i := len(stashEntries) - 1; i >= 0; i-- {
 				self.c.LogCommand(fmt.Sprintf(self.c.Tr.Log.DroppingStash, stashEntries[i].Hash), false)
 				err := self.c.Git().Stash.Drop(stashEntries[i].Index)
@@ -171,6 +178,7 @@ func (self *StashController) handleStashDrop(stashEntries []*models.StashEntry)
 				if err != nil {
 					return err
 				}
+				droppedEntries = append([]*models.StashEntry{stashEntries[i]}, droppedEntries...)
 			}
 			self.context().CollapseRangeSelectionToTop()
 			return nil
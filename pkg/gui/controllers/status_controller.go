@@ -1,13 +1,17 @@
 package controllers
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -58,6 +62,20 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Description:     self.c.Tr.SwitchRepo,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.ViewHooks),
+			Handler:     (&HooksMenuAction{c: self.c}).Call,
+			Description: self.c.Tr.ViewHooks,
+			Tooltip:     self.c.Tr.ViewHooksTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.ViewMaintenanceOptions),
+			Handler:     (&MaintenanceMenuAction{c: self.c}).Call,
+			Description: self.c.Tr.ViewMaintenanceOptions,
+			Tooltip:     self.c.Tr.ViewMaintenanceOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Status.AllBranchesLogGraph),
 			Handler:     func() error { self.switchToOrRotateAllBranchesLogs(); return nil },
@@ -85,11 +103,18 @@ func (self *StatusController) GetMouseKeybindings(opts types.KeybindingsOpts) []
 
 func (self *StatusController) GetOnRenderToMain() func() {
 	return func() {
+		if self.c.Modes().Tool.IsDifftool() {
+			self.showDifftoolDiff()
+			return
+		}
+
 		switch self.c.UserConfig().Gui.StatusPanelView {
 		case "dashboard":
 			self.showDashboard()
 		case "allBranchesLog":
 			self.showAllBranchLogs()
+		case "repoOverview":
+			self.showRepoOverview()
 		default:
 			self.showDashboard()
 		}
@@ -223,6 +248,22 @@ func (self *StatusController) switchToOrRotateAllBranchesLogsBackward() {
 	self.showAllBranchLogs()
 }
 
+// Shows the diff of the two files that git gave us when it started us as its
+// difftool
+func (self *StatusController) showDifftoolDiff() {
+	left, right := self.c.Modes().Tool.GetDiffPaths()
+	cmdObj := self.c.Git().Diff.DiffCmdObj([]string{"--no-index", "--", left, right})
+	task := types.NewRunPtyTask(cmdObj.GetCmd())
+
+	self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: self.c.Tr.DiffTitle,
+			Task:  task,
+		},
+	})
+}
+
 func (self *StatusController) showDashboard() {
 	versionStr := "master"
 	version, err := types.ParseVersionNumber(self.c.GetConfig().GetVersion())
@@ -254,6 +295,112 @@ func (self *StatusController) showDashboard() {
 	})
 }
 
+// The number of branches listed under 'Recent branches' in the repo overview
+const repoOverviewRecentBranchCount = 5
+
+func (self *StatusController) showRepoOverview() {
+	tr := self.c.Tr
+	model := self.c.Model()
+
+	rows := [][]string{}
+	addRow := func(label string, value string, window string) {
+		rows = append(rows, []string{style.FgCyan.Sprint(label), value, self.jumpKeyHint(window)})
+	}
+
+	if currentBranch := self.c.Helpers().Refs.GetCheckedOutRef(); currentBranch != nil {
+		branchStatus := presentation.BranchStatus(currentBranch, types.ItemOperationNone, tr, time.Now(), self.c.UserConfig())
+		addRow(tr.RepoOverviewBranch, strings.TrimSpace(currentBranch.Name+" "+branchStatus), "branches")
+
+		upstream := tr.RepoOverviewNoUpstream
+		if currentBranch.IsTrackingRemote() {
+			upstream = currentBranch.ShortUpstreamRefName()
+		}
+		addRow(tr.RepoOverviewUpstream, upstream, "")
+	}
+
+	changes := style.FgGreen.Sprint(tr.RepoOverviewWorkingTreeClean)
+	if len(model.Files) > 0 {
+		staged := lo.CountBy(model.Files, func(file *models.File) bool { return file.HasStagedChanges })
+		untracked := lo.CountBy(model.Files, func(file *models.File) bool { return file.ShortStatus == "??" })
+		changes = style.FgYellow.Sprint(utils.ResolvePlaceholderString(tr.RepoOverviewChangesSummary, map[string]string{
+			"files":     fmt.Sprint(len(model.Files)),
+			"staged":    fmt.Sprint(staged),
+			"untracked": fmt.Sprint(untracked),
+		}))
+	}
+	addRow(tr.RepoOverviewChanges, changes, "files")
+
+	addRow(tr.RepoOverviewStashes, fmt.Sprint(len(model.StashEntries)), "stash")
+
+	if workingTreeState := model.RepoOverview.WorkingTreeState; workingTreeState.Any() {
+		rows = append(rows, []string{
+			style.FgCyan.Sprint(tr.RepoOverviewInProgress),
+			style.FgYellow.Sprint(workingTreeState.Title(tr)),
+			self.keyHint(self.c.UserConfig().Keybinding.Universal.CreateRebaseOptionsMenu),
+		})
+	}
+
+	latestTag := model.RepoOverview.LatestReachableTag
+	if latestTag == "" {
+		latestTag = tr.RepoOverviewNoTags
+	}
+	addRow(tr.RepoOverviewLatestTag, latestTag, "")
+
+	lines := []string{
+		style.FgGreen.SetBold().Sprint(self.c.Git().RepoPaths.RepoName()),
+		"",
+	}
+	rowLines, _ := utils.RenderDisplayStrings(rows, nil)
+	lines = append(lines, rowLines...)
+
+	if recentBranches := self.recentBranches(); len(recentBranches) > 0 {
+		lines = append(lines, "", style.FgCyan.Sprint(tr.RepoOverviewRecentBranches)+"  "+self.jumpKeyHint("branches"))
+		branchRows := lo.Map(recentBranches, func(branch *models.Branch, _ int) []string {
+			return []string{"  " + branch.Recency, branch.Name}
+		})
+		branchLines, _ := utils.RenderDisplayStrings(branchRows, nil)
+		lines = append(lines, branchLines...)
+	}
+
+	self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: tr.StatusTitle,
+			Task:  types.NewRenderStringTask(strings.Join(lines, "\n") + "\n"),
+		},
+	})
+}
+
+// Returns the most recently checked out branches other than the current one.
+// If we don't know when branches were checked out (e.g. because they are
+// sorted alphabetically), we keep the order of the branches panel.
+func (self *StatusController) recentBranches() []*models.Branch {
+	branches := lo.Filter(self.c.Model().Branches, func(branch *models.Branch, _ int) bool {
+		return !branch.Head
+	})
+	slices.SortStableFunc(branches, func(a, b *models.Branch) int {
+		return cmp.Compare(b.RecencyTimestamp, a.RecencyTimestamp)
+	})
+	return branches[:min(len(branches), repoOverviewRecentBranchCount)]
+}
+
+// Returns a hint for the key that jumps to the given side window, or an empty
+// string if the window isn't shown
+func (self *StatusController) jumpKeyHint(window string) string {
+	index := lo.IndexOf(self.c.Helpers().Window.SideWindows(), window)
+	jumpKeys := self.c.UserConfig().Keybinding.Universal.JumpToBlock
+	if index == -1 || index >= len(jumpKeys) {
+		return ""
+	}
+	return self.keyHint(jumpKeys[index])
+}
+
+func (self *StatusController) keyHint(key string) string {
+	return style.FgDefault.SetBold().Sprint(utils.ResolvePlaceholderString(self.c.Tr.RepoOverviewKeyHint, map[string]string{
+		"key": keybindings.Label(key),
+	}))
+}
+
 func (self *StatusController) handleCheckForUpdate() error {
 	return self.c.Helpers().Update.CheckForUpdateInForeground()
 }
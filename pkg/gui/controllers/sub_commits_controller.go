@@ -42,20 +42,27 @@ func (self *SubCommitsController) GetOnRenderToMain() func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
 			commit := self.context().GetSelected()
 			var task types.UpdateTask
+			var refRange *types.RefRange
 			if commit == nil {
 				task = types.NewRenderStringTask("No commits")
 			} else {
-				refRange := self.context().GetSelectedRefRangeForDiffFiles()
+				refRange = self.context().GetSelectedRefRangeForDiffFiles()
 				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange)
+				self.c.Helpers().Diff.PrefetchAdjacentCommitDiffs(task, self.context().GetItems(), self.context().GetSelectedLineIdx())
+			}
+
+			mainOpts := &types.ViewUpdateOpts{
+				Title:    "Commit",
+				SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+				Task:     task,
+			}
+			if commit != nil && refRange == nil {
+				self.c.Helpers().Diff.AddCommitDetailsTab(mainOpts, commit)
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
 				Pair: self.c.MainViewPairs().Normal,
-				Main: &types.ViewUpdateOpts{
-					Title:    "Commit",
-					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     task,
-				},
+				Main: mainOpts,
 			})
 		})
 	}
@@ -9,8 +9,10 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SyncController struct {
@@ -45,6 +47,22 @@ func (self *SyncController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Description:       self.c.Tr.Pull,
 			Tooltip:           self.c.Tr.PullTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.PushWithOptions),
+			Handler:           opts.Guards.NoPopupPanel(self.HandlePushWithOptions),
+			GetDisabledReason: self.getDisabledReasonForPushOrPull,
+			Description:       self.c.Tr.PushWithOptions,
+			Tooltip:           self.c.Tr.PushWithOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.PullWithStrategy),
+			Handler:           opts.Guards.NoPopupPanel(self.HandlePullWithStrategy),
+			GetDisabledReason: self.getDisabledReasonForPushOrPull,
+			Description:       self.c.Tr.PullWithStrategy,
+			Tooltip:           self.c.Tr.PullWithStrategyTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -55,13 +73,25 @@ func (self *SyncController) Context() types.Context {
 }
 
 func (self *SyncController) HandlePush() error {
-	return self.branchCheckedOut(self.push)()
+	return self.branchCheckedOut(func(currentBranch *models.Branch) error {
+		return self.push(currentBranch, pushOpts{})
+	})()
+}
+
+func (self *SyncController) HandlePushWithOptions() error {
+	return self.branchCheckedOut(func(currentBranch *models.Branch) error {
+		return self.pushOptionsMenu(currentBranch, pushOpts{})
+	})()
 }
 
 func (self *SyncController) HandlePull() error {
 	return self.branchCheckedOut(self.pull)()
 }
 
+func (self *SyncController) HandlePullWithStrategy() error {
+	return self.branchCheckedOut(self.pullStrategyMenu)()
+}
+
 func (self *SyncController) getDisabledReasonForPushOrPull() *types.DisabledReason {
 	currentBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 	if currentBranch != nil {
@@ -86,11 +116,13 @@ func (self *SyncController) branchCheckedOut(f func(*models.Branch) error) func(
 	}
 }
 
-func (self *SyncController) push(currentBranch *models.Branch) error {
+// The options that the user chose in the push options menu are passed in opts;
+// for a plain push they are all empty.
+func (self *SyncController) push(currentBranch *models.Branch, opts pushOpts) error {
 	// if we are behind our upstream branch we'll ask if the user wants to force push
 	if currentBranch.IsTrackingRemote() {
-		opts := pushOpts{remoteBranchStoredLocally: currentBranch.RemoteBranchStoredLocally()}
-		if currentBranch.IsBehindForPush() {
+		opts.remoteBranchStoredLocally = currentBranch.RemoteBranchStoredLocally()
+		if currentBranch.IsBehindForPush() && !opts.forceWithLease {
 			return self.requestToForcePush(currentBranch, opts)
 		}
 
@@ -98,7 +130,8 @@ func (self *SyncController) push(currentBranch *models.Branch) error {
 	}
 
 	if self.c.Git().Config.GetPushToCurrent() {
-		return self.pushAux(currentBranch, pushOpts{setUpstream: true})
+		opts.setUpstream = true
+		return self.pushAux(currentBranch, opts)
 	}
 
 	return self.c.Helpers().Upstream.PromptForUpstreamWithInitialContent(currentBranch, func(upstream string) error {
@@ -107,14 +140,126 @@ func (self *SyncController) push(currentBranch *models.Branch) error {
 			return err
 		}
 
-		return self.pushAux(currentBranch, pushOpts{
-			setUpstream:    true,
-			upstreamRemote: upstreamRemote,
-			upstreamBranch: upstreamBranch,
-		})
+		opts.setUpstream = true
+		opts.upstreamRemote = upstreamRemote
+		opts.upstreamBranch = upstreamBranch
+		return self.pushAux(currentBranch, opts)
+	})
+}
+
+func (self *SyncController) pushOptionsMenu(currentBranch *models.Branch, opts pushOpts) error {
+	reopenWith := func(change func(opts *pushOpts)) func() error {
+		return func() error {
+			newOpts := opts
+			change(&newOpts)
+			return self.pushOptionsMenu(currentBranch, newOpts)
+		}
+	}
+
+	var noVerifyDisabledReason *types.DisabledReason
+	skipHooksAlways := self.c.UserConfig().Git.SkipHooks.Push
+	if skipHooksAlways {
+		noVerifyDisabledReason = &types.DisabledReason{Text: self.c.Tr.PushNoVerifyAlwaysOn}
+	}
+
+	refspec := opts.refspec
+	if refspec == "" {
+		refspec = self.c.Tr.PushRefspecCurrentBranch
+	}
+
+	optionsSection := &types.MenuSection{Title: self.c.Tr.PushOptions, Column: 0}
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PushOptionsTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.Push,
+				OnPress: func() error {
+					return self.pushWithOptions(currentBranch, opts)
+				},
+				Key: 'p',
+			},
+			{
+				Label:   self.c.Tr.PushForceWithLease,
+				Widget:  types.MakeMenuCheckBox(opts.forceWithLease),
+				OnPress: reopenWith(func(opts *pushOpts) { opts.forceWithLease = !opts.forceWithLease }),
+				Key:     'f',
+				Tooltip: self.c.Tr.PushForceWithLeaseTooltip,
+				Section: optionsSection,
+			},
+			{
+				Label:   self.c.Tr.PushForceIfIncludes,
+				Widget:  types.MakeMenuCheckBox(opts.forceIfIncludes),
+				OnPress: reopenWith(func(opts *pushOpts) { opts.forceIfIncludes = !opts.forceIfIncludes }),
+				Key:     'i',
+				Tooltip: self.c.Tr.PushForceIfIncludesTooltip,
+				Section: optionsSection,
+			},
+			{
+				Label:          self.c.Tr.PushNoVerify,
+				Widget:         types.MakeMenuCheckBox(opts.noVerify || skipHooksAlways),
+				OnPress:        reopenWith(func(opts *pushOpts) { opts.noVerify = !opts.noVerify }),
+				Key:            'n',
+				Tooltip:        self.c.Tr.PushNoVerifyTooltip,
+				DisabledReason: noVerifyDisabledReason,
+				Section:        optionsSection,
+			},
+			{
+				Label:   self.c.Tr.PushFollowTags,
+				Widget:  types.MakeMenuCheckBox(opts.followTags),
+				OnPress: reopenWith(func(opts *pushOpts) { opts.followTags = !opts.followTags }),
+				Key:     't',
+				Tooltip: self.c.Tr.PushFollowTagsTooltip,
+				Section: optionsSection,
+			},
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.PushRefspec, map[string]string{
+					"refspec": refspec,
+				}),
+				OnPress: func() error {
+					self.c.Prompt(types.PromptOpts{
+						Title:           self.c.Tr.PushRefspecPrompt,
+						InitialContent:  opts.refspec,
+						AllowEmptyInput: true,
+						HandleConfirm: func(refspec string) error {
+							return reopenWith(func(opts *pushOpts) { opts.refspec = strings.TrimSpace(refspec) })()
+						},
+					})
+					return nil
+				},
+				Key:     'r',
+				Tooltip: self.c.Tr.PushRefspecTooltip,
+				Section: optionsSection,
+			},
+		},
 	})
 }
 
+func (self *SyncController) pushWithOptions(currentBranch *models.Branch, opts pushOpts) error {
+	pushAux := func() error {
+		// A custom refspec is pushed to the remote of the current branch's
+		// upstream, regardless of whether the current branch is ahead or behind
+		if opts.refspec != "" {
+			if !currentBranch.IsTrackingRemote() {
+				return errors.New(self.c.Tr.PushRefspecNeedsUpstream)
+			}
+			opts.upstreamRemote = currentBranch.UpstreamRemote
+			return self.pushAux(currentBranch, opts)
+		}
+
+		return self.push(currentBranch, opts)
+	}
+
+	// A refspec starting with a '+' is force-pushed, too
+	if opts.forceWithLease || strings.HasPrefix(opts.refspec, "+") {
+		if self.c.UserConfig().Git.DisableForcePushing {
+			return errors.New(self.c.Tr.ForcePushDisabled)
+		}
+		return self.guardForcePush(currentBranch, opts, pushAux)
+	}
+
+	return pushAux()
+}
+
 func (self *SyncController) pull(currentBranch *models.Branch) error {
 	action := self.c.Tr.Actions.Pull
 
@@ -132,6 +277,90 @@ func (self *SyncController) pull(currentBranch *models.Branch) error {
 	return self.PullAux(currentBranch, PullFilesOptions{Action: action})
 }
 
+func (self *SyncController) pullStrategyMenu(currentBranch *models.Branch) error {
+	gitConfigStrategy := self.c.Tr.PullStrategyNotConfigured
+	if strategy := self.c.Git().Config.GetPullStrategy(); strategy != "" {
+		gitConfigStrategy = self.pullStrategyName(strategy)
+	}
+
+	type strategyOption struct {
+		strategy    string
+		label       string
+		description string
+		key         types.Key
+	}
+	options := []strategyOption{
+		{strategy: "auto", label: self.c.Tr.PullStrategyAuto, description: gitConfigStrategy, key: 'a'},
+		{strategy: "merge", label: self.c.Tr.PullStrategyMerge, description: "--no-rebase", key: 'm'},
+		{strategy: "rebase", label: self.c.Tr.PullStrategyRebase, description: "--rebase", key: 'r'},
+		{strategy: "ff-only", label: self.c.Tr.PullStrategyFastForwardOnly, description: "--ff-only", key: 'f'},
+	}
+
+	menuItems := lo.Map(options, func(option strategyOption, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{option.label, style.FgYellow.Sprint(option.description)},
+			Widget:       types.MakeMenuRadioButton(option.strategy == self.c.UserConfig().Git.PullMode),
+			OnPress: func() error {
+				return self.pullWithStrategy(currentBranch, option.strategy)
+			},
+			Key: option.key,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PullStrategyTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *SyncController) pullWithStrategy(currentBranch *models.Branch, strategy string) error {
+	opts := PullFilesOptions{Action: self.c.Tr.Actions.Pull, Strategy: strategy}
+
+	// Choosing the upstream is confirmation enough
+	if !currentBranch.IsTrackingRemote() {
+		return self.c.Helpers().Upstream.PromptForUpstreamWithInitialContent(currentBranch, func(upstream string) error {
+			if err := self.setCurrentBranchUpstream(upstream); err != nil {
+				return err
+			}
+
+			return self.PullAux(currentBranch, opts)
+		})
+	}
+
+	strategyName := self.pullStrategyName(strategy)
+	if strategy == "auto" {
+		strategyName = self.pullStrategyName(self.c.Git().Config.GetPullStrategy())
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.Pull,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.ConfirmPullWithStrategy, map[string]string{
+			"upstream": currentBranch.ShortUpstreamRefName(),
+			"branch":   currentBranch.Name,
+			"strategy": strategyName,
+		}),
+		HandleConfirm: func() error {
+			return self.PullAux(currentBranch, opts)
+		},
+	})
+	return nil
+}
+
+// Returns how the given strategy is described in the pull confirmation; "" is
+// what git does if nothing is configured
+func (self *SyncController) pullStrategyName(strategy string) string {
+	switch strategy {
+	case "merge":
+		return self.c.Tr.PullStrategyNameMerge
+	case "rebase":
+		return self.c.Tr.PullStrategyNameRebase
+	case "ff-only":
+		return self.c.Tr.PullStrategyNameFastForwardOnly
+	default:
+		return self.c.Tr.PullStrategyNameGitDefault
+	}
+}
+
 func (self *SyncController) setCurrentBranchUpstream(upstream string) error {
 	upstreamRemote, upstreamBranch, err := self.c.Helpers().Upstream.ParseUpstream(upstream)
 	if err != nil {
@@ -154,6 +383,7 @@ type PullFilesOptions struct {
 	UpstreamRemote  string
 	UpstreamBranch  string
 	FastForwardOnly bool
+	Strategy        string
 	Action          string
 }
 
@@ -172,6 +402,7 @@ func (self *SyncController) pullWithLock(task gocui.Task, opts PullFilesOptions)
 			RemoteName:      opts.UpstreamRemote,
 			BranchName:      opts.UpstreamBranch,
 			FastForwardOnly: opts.FastForwardOnly,
+			Strategy:        opts.Strategy,
 		},
 	)
 
@@ -185,6 +416,12 @@ type pushOpts struct {
 	upstreamBranch string
 	setUpstream    bool
 
+	// Set from the push options menu
+	forceIfIncludes bool
+	noVerify        bool
+	followTags      bool
+	refspec         string
+
 	// If this is false, we can't tell ahead of time whether a force-push will
 	// be necessary, so we start with a normal push and offer to force-push if
 	// the server rejected. If this is true, we don't offer to force-push if the
@@ -198,12 +435,16 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 		err := self.c.Git().Sync.Push(
 			task,
 			git_commands.PushOpts{
-				Force:          opts.force,
-				ForceWithLease: opts.forceWithLease,
-				CurrentBranch:  currentBranch.Name,
-				UpstreamRemote: opts.upstreamRemote,
-				UpstreamBranch: opts.upstreamBranch,
-				SetUpstream:    opts.setUpstream,
+				Force:           opts.force,
+				ForceWithLease:  opts.forceWithLease,
+				ForceIfIncludes: opts.forceIfIncludes,
+				CurrentBranch:   currentBranch.Name,
+				UpstreamRemote:  opts.upstreamRemote,
+				UpstreamBranch:  opts.upstreamBranch,
+				SetUpstream:     opts.setUpstream,
+				NoVerify:        opts.noVerify,
+				FollowTags:      opts.followTags,
+				Refspec:         opts.refspec,
 			})
 		if err != nil {
 			if !opts.force && !opts.forceWithLease && strings.Contains(err.Error(), "Updates were rejected") {
@@ -215,17 +456,19 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 				if forcePushDisabled {
 					return errors.New(self.c.Tr.UpdatesRejectedAndForcePushDisabled)
 				}
-				self.c.Confirm(types.ConfirmOpts{
-					Title:  self.c.Tr.ForcePush,
-					Prompt: self.forcePushPrompt(),
-					HandleConfirm: func() error {
-						newOpts := opts
-						newOpts.force = true
-
-						return self.pushAux(currentBranch, newOpts)
-					},
+				return self.guardForcePush(currentBranch, opts, func() error {
+					self.c.Confirm(types.ConfirmOpts{
+						Title:  self.c.Tr.ForcePush,
+						Prompt: self.forcePushPrompt(),
+						HandleConfirm: func() error {
+							newOpts := opts
+							newOpts.force = true
+
+							return self.pushAux(currentBranch, newOpts)
+						},
+					})
+					return nil
 				})
-				return nil
 			}
 			return err
 		}
@@ -240,16 +483,64 @@ func (self *SyncController) requestToForcePush(currentBranch *models.Branch, opt
 		return errors.New(self.c.Tr.ForcePushDisabled)
 	}
 
-	self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.ForcePush,
-		Prompt: self.forcePushPrompt(),
-		HandleConfirm: func() error {
-			opts.forceWithLease = true
-			return self.pushAux(currentBranch, opts)
-		},
+	return self.guardForcePush(currentBranch, opts, func() error {
+		return self.c.ConfirmIf(self.c.UserConfig().Confirmations.ForcePush, types.ConfirmOpts{
+			Title:  self.c.Tr.ForcePush,
+			Prompt: self.forcePushPrompt(),
+			HandleConfirm: func() error {
+				opts.forceWithLease = true
+				return self.pushAux(currentBranch, opts)
+			},
+		})
 	})
+}
 
-	return nil
+// Force-pushing is guarded by the name of the remote branch that gets
+// overwritten, which isn't necessarily the name of the local branch
+func (self *SyncController) guardForcePush(currentBranch *models.Branch, opts pushOpts, f func() error) error {
+	return self.c.Helpers().ProtectedBranches.Guard(
+		self.pushDestinationBranchNames(currentBranch, opts), self.c.Tr.ProtectedBranchActionForcePush, f)
+}
+
+// Returns the names of the remote branches that pushing the current branch
+// with the given options updates
+func (self *SyncController) pushDestinationBranchNames(currentBranch *models.Branch, opts pushOpts) []string {
+	if opts.refspec != "" {
+		return []string{refspecDestinationBranchName(opts.refspec, currentBranch.Name)}
+	}
+
+	if opts.upstreamBranch != "" {
+		return []string{opts.upstreamBranch}
+	}
+
+	// No need to ask git where it pushes to if nothing is protected
+	if len(self.c.UserConfig().Git.ProtectedBranches.Patterns) == 0 {
+		return nil
+	}
+
+	branchName, err := self.c.Git().Branch.PushDestinationBranchName(currentBranch.Name)
+	if err == nil && branchName != "" {
+		return []string{branchName}
+	}
+
+	// We can't tell where git pushes to, so we err on the side of caution
+	return lo.Uniq(lo.Compact([]string{currentBranch.Name, currentBranch.UpstreamBranch}))
+}
+
+// Returns the name of the remote branch that the given refspec pushes to, e.g.
+// "main" for "+HEAD:refs/heads/main". Without a destination, a branch is
+// pushed to the remote branch of the same name; for HEAD, that's the name of
+// the current branch.
+func refspecDestinationBranchName(refspec string, currentBranchName string) string {
+	source, destination, found := strings.Cut(strings.TrimPrefix(refspec, "+"), ":")
+	if !found {
+		destination = source
+		if destination == "HEAD" {
+			destination = currentBranchName
+		}
+	}
+
+	return strings.TrimPrefix(destination, "refs/heads/")
 }
 
 func (self *SyncController) forcePushPrompt() string {
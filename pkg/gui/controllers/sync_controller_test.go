@@ -0,0 +1,29 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefspecDestinationBranchName(t *testing.T) {
+	scenarios := []struct {
+		refspec  string
+		expected string
+	}{
+		{refspec: "feature:main", expected: "main"},
+		{refspec: "+HEAD:main", expected: "main"},
+		{refspec: "HEAD:refs/heads/release/1.0", expected: "release/1.0"},
+		{refspec: "+refs/heads/feature:refs/heads/main", expected: "main"},
+		{refspec: "main", expected: "main"},
+		{refspec: "+main", expected: "main"},
+		{refspec: "HEAD", expected: "feature"},
+		{refspec: "+HEAD", expected: "feature"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.refspec, func(t *testing.T) {
+			assert.Equal(t, s.expected, refspecDestinationBranchName(s.refspec, "feature"))
+		})
+	}
+}
@@ -55,9 +55,9 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
-			Handler:           self.withItem(self.delete),
+			Handler:           self.withItems(self.deleteTags),
 			Description:       self.c.Tr.Delete,
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			GetDisabledReason: self.require(self.itemsSelected()),
 			Tooltip:           self.c.Tr.TagDeleteTooltip,
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
@@ -87,11 +87,33 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.VerifySignature),
+			Handler:           self.withItem(self.verifySignature),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.VerifySignature,
+			Tooltip:           self.c.Tr.VerifyTagSignatureTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.CreateArchive),
+			Handler:           self.withItem(self.createArchive),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CreateArchive,
+			Tooltip:           self.c.Tr.CreateArchiveTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
 }
 
+func (self *TagsController) verifySignature(tag *models.Tag) error {
+	showSignatureVerification(self.c, func() (string, error) {
+		return self.c.Git().Tag.VerifySignature(tag.Name)
+	})
+	return nil
+}
+
 func (self *TagsController) GetOnRenderToMain() func() {
 	return func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
@@ -167,6 +189,35 @@ func (self *TagsController) localDelete(tag *models.Tag) error {
 	})
 }
 
+func (self *TagsController) deleteTags(tags []*models.Tag) error {
+	if len(tags) == 1 {
+		return self.delete(tags[0])
+	}
+
+	// Deleting multiple tags from a remote isn't supported yet, so we only
+	// offer to delete them locally
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DeleteTagsTitle,
+		Prompt: self.c.Tr.DeleteLocalTagsPrompt,
+		HandleConfirm: func() error {
+			self.context().ClearMarks()
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
+				for _, tag := range tags {
+					if err := self.c.Git().Tag.LocalDelete(tag.Name); err != nil {
+						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+						return err
+					}
+				}
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+				return nil
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *TagsController) remoteDelete(tag *models.Tag) error {
 	title := utils.ResolvePlaceholderString(
 		self.c.Tr.SelectRemoteTagUpstream,
@@ -342,6 +393,10 @@ func (self *TagsController) push(tag *models.Tag) error {
 	return nil
 }
 
+func (self *TagsController) createArchive(tag *models.Tag) error {
+	return (&ArchiveMenuAction{c: self.c}).Call(tag.FullRefName(), tag.Name)
+}
+
 func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(tag.Name, tag.FullRefName())
 }
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type ToggleDiffModeAction struct {
+	c *ControllerCommon
+}
+
+func (self *ToggleDiffModeAction) Call() error {
+	guiConfig := &self.c.UserConfig().Gui
+	if guiConfig.DiffMode == "side-by-side" {
+		guiConfig.DiffMode = "unified"
+	} else {
+		guiConfig.DiffMode = "side-by-side"
+	}
+
+	self.c.Context().CurrentSide().HandleFocus(types.OnFocusOpts{})
+	return nil
+}
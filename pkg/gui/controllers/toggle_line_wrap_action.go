@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Toggles wrapping of long lines in the staging and patch building views if
+// one of them is focused, and in the main views otherwise
+type ToggleLineWrapAction struct {
+	c *ControllerCommon
+}
+
+func (self *ToggleLineWrapAction) Call() error {
+	guiConfig := &self.c.UserConfig().Gui
+	currentContext := self.c.Context().Current()
+
+	if _, ok := currentContext.(types.IPatchExplorerContext); ok {
+		guiConfig.WrapLinesInStagingView = !guiConfig.WrapLinesInStagingView
+
+		// The focus handler applies the new setting and re-wraps the patch, so
+		// that the selection stays on the same patch line
+		currentContext.HandleFocus(types.OnFocusOpts{})
+		return nil
+	}
+
+	guiConfig.WrapLinesInMainView = !guiConfig.WrapLinesInMainView
+
+	views := self.c.Views()
+	for _, view := range []*gocui.View{views.Main, views.Secondary, views.Staging, views.StagingSecondary, views.PatchBuilding, views.PatchBuildingSecondary, views.MergeConflicts} {
+		// The merge conflicts view never wraps while it's focused
+		if view.Name() == currentContext.GetViewName() && view == views.MergeConflicts {
+			continue
+		}
+		view.SetWrap(guiConfig.WrapLinesInMainView)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type ToggleShowWhitespaceAction struct {
+	c *ControllerCommon
+}
+
+func (self *ToggleShowWhitespaceAction) Call() error {
+	guiConfig := &self.c.UserConfig().Gui
+	guiConfig.ShowWhitespaceInDiffView = !guiConfig.ShowWhitespaceInDiffView
+
+	views := self.c.Views()
+	for _, view := range []*gocui.View{views.Main, views.Secondary, views.Staging, views.StagingSecondary, views.PatchBuilding, views.PatchBuildingSecondary, views.MergeConflicts} {
+		view.ShowWhitespace = guiConfig.ShowWhitespaceInDiffView
+	}
+
+	// The whitespace is rendered when the content is written to the view, so
+	// we need to re-render it. The staging, patch building and merge conflicts
+	// views render their own content; the others are rendered by the side panel.
+	currentContext := self.c.Context().Current()
+	if _, ok := currentContext.(types.IPatchExplorerContext); ok || currentContext.GetKey() == context.MERGE_CONFLICTS_CONTEXT_KEY {
+		currentContext.HandleFocus(types.OnFocusOpts{})
+	} else {
+		self.c.Context().CurrentSide().HandleFocus(types.OnFocusOpts{})
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -18,6 +19,12 @@ import (
 // actions we can skip. E.g. if I do three things, A, B, and C, and hit undo twice,
 // the reflog will read UUCBA, and when I read the first two undos, I know to skip the following
 // two user actions, meaning we end up undoing reflog entry C. Redoing works in a similar way.
+//
+// Some actions (deleting a branch, dropping a stash entry, staging files) don't
+// show up in the reflog, so we record them in an operation journal instead,
+// along with the top reflog entry at the time. That lets us work out whether
+// the latest journal entry or the latest reflog action is more recent, and
+// undo/redo them in the order they happened.
 
 type UndoController struct {
 	baseController
@@ -48,6 +55,8 @@ type reflogAction struct {
 	kind ReflogActionKind
 	from string
 	to   string
+	// index of the reflog entry the action was parsed from
+	idx int
 }
 
 func (self *UndoController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
@@ -81,11 +90,23 @@ func (self *UndoController) reflogUndo() error {
 		return errors.New(self.c.Tr.CantUndoWhileRebasing)
 	}
 
-	return self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
+	journalEntry := self.c.State().GetRepoState().GetOperationJournal().PeekUndo()
+	foundReflogAction := false
+
+	err := self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
 		if counter != 0 {
 			return false, nil
 		}
 
+		foundReflogAction = true
+
+		// If the journal entry was recorded after this reflog action happened,
+		// it's the one we need to undo first
+		if journalEntry != nil && self.c.Helpers().OperationJournal.ReflogIndex(journalEntry.ReflogTop) <= action.idx {
+			self.undoJournalEntry(journalEntry)
+			return true, nil
+		}
+
 		switch action.kind {
 		case COMMIT:
 			self.c.Confirm(types.ConfirmOpts{
@@ -135,6 +156,15 @@ func (self *UndoController) reflogUndo() error {
 		self.c.Log.Error("didn't match on the user action when trying to undo")
 		return true, nil
 	})
+	if err != nil {
+		return err
+	}
+
+	if !foundReflogAction && journalEntry != nil {
+		self.undoJournalEntry(journalEntry)
+	}
+
+	return nil
 }
 
 func (self *UndoController) reflogRedo() error {
@@ -145,6 +175,14 @@ func (self *UndoController) reflogRedo() error {
 		return errors.New(self.c.Tr.CantRedoWhileRebasing)
 	}
 
+	// If no reflog-based undo has happened since the journal entry was undone,
+	// the journal entry is the most recently undone action
+	journalEntry := self.c.State().GetRepoState().GetOperationJournal().PeekRedo()
+	if journalEntry != nil && self.netUndoCount(self.c.Helpers().OperationJournal.ReflogIndex(journalEntry.ReflogTop)) <= 0 {
+		self.redoJournalEntry(journalEntry)
+		return nil
+	}
+
 	return self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
 		// if we're redoing and the counter is zero, we just return
 		if counter == 0 {
@@ -191,6 +229,59 @@ func (self *UndoController) reflogRedo() error {
 	})
 }
 
+func (self *UndoController) undoJournalEntry(entry *types.JournalEntry) {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.Actions.Undo,
+		Prompt: fmt.Sprintf(self.c.Tr.UndoJournalPrompt, entry.Description),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.Undo)
+			return self.c.WithWaitingStatus(self.c.Tr.UndoingStatus, func(gocui.Task) error {
+				journal := self.c.State().GetRepoState().GetOperationJournal()
+				if err := entry.Undo(); err != nil {
+					// Don't let a stale entry get in the way of undoing older actions
+					journal.Discard(entry)
+					return err
+				}
+				journal.MarkUndone(entry, self.c.Helpers().OperationJournal.ReflogTop())
+				return nil
+			})
+		},
+	})
+}
+
+func (self *UndoController) redoJournalEntry(entry *types.JournalEntry) {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.Actions.Redo,
+		Prompt: fmt.Sprintf(self.c.Tr.RedoJournalPrompt, entry.Description),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.Redo)
+			return self.c.WithWaitingStatus(self.c.Tr.RedoingStatus, func(gocui.Task) error {
+				journal := self.c.State().GetRepoState().GetOperationJournal()
+				if err := entry.Redo(); err != nil {
+					journal.Discard(entry)
+					return err
+				}
+				journal.MarkRedone(entry, self.c.Helpers().OperationJournal.ReflogTop())
+				return nil
+			})
+		},
+	})
+}
+
+// netUndoCount returns how many more undos than redos appear in the reflog
+// above the given index
+func (self *UndoController) netUndoCount(reflogIdx int) int {
+	count := 0
+	for _, reflogCommit := range self.c.Model().ReflogCommits[:reflogIdx] {
+		if strings.HasPrefix(reflogCommit.Name, "[lazygit undo]") {
+			count++
+		} else if strings.HasPrefix(reflogCommit.Name, "[lazygit redo]") {
+			count--
+		}
+	}
+	return count
+}
+
 // Here we're going through the reflog and maintaining a counter that represents how many
 // undos/redos/user actions we've seen. when we hit a user action we call the callback specifying
 // what the counter is up to and the nature of the action.
@@ -231,6 +322,7 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 		}
 
 		if action != nil {
+			action.idx = reflogCommitIdx
 			if action.kind != CURRENT_REBASE && action.from == action.to {
 				// if we're going from one place to the same place we'll ignore the action.
 				continue
@@ -60,13 +60,13 @@ func (self *VerticalScrollController) GetMouseKeybindings(opts types.Keybindings
 }
 
 func (self *VerticalScrollController) HandleScrollUp() error {
-	self.context.GetViewTrait().ScrollUp(self.c.UserConfig().Gui.ScrollHeight)
+	self.context.GetViewTrait().ScrollUp(self.c.UserConfig().Gui.MouseWheelScrollHeight())
 
 	return nil
 }
 
 func (self *VerticalScrollController) HandleScrollDown() error {
-	scrollHeight := self.c.UserConfig().Gui.ScrollHeight
+	scrollHeight := self.c.UserConfig().Gui.MouseWheelScrollHeight()
 	self.context.GetViewTrait().ScrollDown(scrollHeight)
 
 	if manager := self.c.GetViewBufferManagerForView(self.context.GetView()); manager != nil {
@@ -88,6 +88,10 @@ func (self *CommitFileTree) Len() int {
 	return self.tree.Size(self.collapsedPaths) - 1 // ignoring root
 }
 
+func (self *CommitFileTree) GetItemId(index int) string {
+	return self.Get(index).ID()
+}
+
 func (self *CommitFileTree) GetItem(index int) types.HasUrn {
 	// Unimplemented because we don't yet need to show inlines statuses in commit file views
 	return nil
@@ -16,6 +16,7 @@ import (
 type ICommitFileTreeViewModel interface {
 	ICommitFileTree
 	types.IListCursor
+	types.IListMarks
 
 	GetRef() models.Ref
 	SetRef(models.Ref)
@@ -28,6 +29,7 @@ type ICommitFileTreeViewModel interface {
 type CommitFileTreeViewModel struct {
 	sync.RWMutex
 	types.IListCursor
+	types.IListMarks
 	ICommitFileTree
 
 	// this is e.g. the commit for which we're viewing the files, if there is no
@@ -53,6 +55,7 @@ func NewCommitFileTreeViewModel(getFiles func() []*models.CommitFile, common *co
 	return &CommitFileTreeViewModel{
 		ICommitFileTree: fileTree,
 		IListCursor:     listCursor,
+		IListMarks:      traits.NewListMarks(fileTree.Len, fileTree.GetItemId),
 		ref:             nil,
 		refRange:        nil,
 		canRebase:       false,
@@ -106,6 +109,13 @@ func (self *CommitFileTreeViewModel) GetSelectedItems() ([]*CommitFileNode, int,
 		return nil, 0, 0
 	}
 
+	if markedIndices := self.MarkedIndices(); len(markedIndices) > 0 {
+		nodes := lo.Map(markedIndices, func(idx int, _ int) *CommitFileNode {
+			return self.Get(idx)
+		})
+		return nodes, markedIndices[0], markedIndices[len(markedIndices)-1]
+	}
+
 	startIdx, endIdx := self.GetSelectionRange()
 
 	nodes := []*CommitFileNode{}
@@ -116,6 +126,14 @@ func (self *CommitFileTreeViewModel) GetSelectedItems() ([]*CommitFileNode, int,
 	return nodes, startIdx, endIdx
 }
 
+func (self *CommitFileTreeViewModel) AreMultipleItemsSelected() bool {
+	if markedIndices := self.MarkedIndices(); len(markedIndices) > 0 {
+		return len(markedIndices) > 1 || markedIndices[0] != self.GetSelectedLineIdx()
+	}
+
+	return self.IListCursor.AreMultipleItemsSelected()
+}
+
 func (self *CommitFileTreeViewModel) GetSelectedItemIds() ([]string, int, int) {
 	selectedItems, startIdx, endIdx := self.GetSelectedItems()
 
@@ -28,6 +28,7 @@ type ITree[T any] interface {
 	GetIndexForPath(path string) (int, bool)
 	Len() int
 	GetItem(index int) types.HasUrn
+	GetItemId(index int) string
 	SetTree()
 	IsCollapsed(path string) bool
 	ToggleCollapsed(path string)
@@ -169,6 +170,10 @@ func (self *FileTree) Len() int {
 	return max(self.tree.Size(self.collapsedPaths)-1, 0)
 }
 
+func (self *FileTree) GetItemId(index int) string {
+	return self.Get(index).ID()
+}
+
 func (self *FileTree) GetItem(index int) types.HasUrn {
 	// Unimplemented because we don't yet need to show inlines statuses in commit file views
 	return nil
@@ -16,6 +16,7 @@ import (
 type IFileTreeViewModel interface {
 	IFileTree
 	types.IListCursor
+	types.IListMarks
 }
 
 // This combines our FileTree struct with a cursor that retains information about
@@ -24,6 +25,7 @@ type IFileTreeViewModel interface {
 type FileTreeViewModel struct {
 	sync.RWMutex
 	types.IListCursor
+	types.IListMarks
 	IFileTree
 	searchHistory *utils.HistoryBuffer[string]
 }
@@ -36,6 +38,7 @@ func NewFileTreeViewModel(getFiles func() []*models.File, common *common.Common,
 	return &FileTreeViewModel{
 		IFileTree:     fileTree,
 		IListCursor:   listCursor,
+		IListMarks:    traits.NewListMarks(fileTree.Len, fileTree.GetItemId),
 		searchHistory: utils.NewHistoryBuffer[string](1000),
 	}
 }
@@ -62,6 +65,13 @@ func (self *FileTreeViewModel) GetSelectedItems() ([]*FileNode, int, int) {
 		return nil, 0, 0
 	}
 
+	if markedIndices := self.MarkedIndices(); len(markedIndices) > 0 {
+		nodes := lo.Map(markedIndices, func(idx int, _ int) *FileNode {
+			return self.Get(idx)
+		})
+		return nodes, markedIndices[0], markedIndices[len(markedIndices)-1]
+	}
+
 	startIdx, endIdx := self.GetSelectionRange()
 
 	nodes := []*FileNode{}
@@ -72,6 +82,14 @@ func (self *FileTreeViewModel) GetSelectedItems() ([]*FileNode, int, int) {
 	return nodes, startIdx, endIdx
 }
 
+func (self *FileTreeViewModel) AreMultipleItemsSelected() bool {
+	if markedIndices := self.MarkedIndices(); len(markedIndices) > 0 {
+		return len(markedIndices) > 1 || markedIndices[0] != self.GetSelectedLineIdx()
+	}
+
+	return self.IListCursor.AreMultipleItemsSelected()
+}
+
 func (self *FileTreeViewModel) GetSelectedItemIds() ([]string, int, int) {
 	selectedItems, startIdx, endIdx := self.GetSelectedItems()
 
@@ -224,6 +242,21 @@ func (self *FileTreeViewModel) ExpandAll() {
 	}
 }
 
+// Select the given file, expanding its parent directories if necessary. If the
+// file isn't shown (e.g. because of a status filter), do nothing.
+// Note that filepath is an actual file path, not an internal tree path; see
+// CommitFileTreeViewModel.SelectPath.
+func (self *FileTreeViewModel) SelectPath(filepath string, showRootItem bool) {
+	self.ExpandToPath(InternalTreePathForFilePath(filepath, showRootItem))
+
+	_, index, found := lo.FindIndexOf(self.GetAllItems(), func(node *FileNode) bool {
+		return node.File != nil && node.File.Path == filepath
+	})
+	if found {
+		self.SetSelection(index)
+	}
+}
+
 // IFilterableContext methods
 
 func (self *FileTreeViewModel) SetFilter(filter string, useFuzzySearch bool) {
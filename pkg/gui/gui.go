@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jesseduffield/gocui"
@@ -31,6 +32,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/marked_base_commit"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/tool"
 	"github.com/jesseduffield/lazygit/pkg/gui/popup"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
@@ -83,11 +85,16 @@ type Gui struct {
 	statusManager        *status.StatusManager
 	waitForIntro         sync.WaitGroup
 	viewBufferManagerMap map[string]*tasks.ViewBufferManager
+	mainViewCache        *mainViewCache
 	// holds a mapping of view names to ptmx's. This is for rendering command outputs
 	// from within a pty. The point of keeping track of them is so that if we re-size
 	// the window, we can tell the pty it needs to resize accordingly.
 	viewPtmxMap map[string]*os.File
-	stopChan    chan struct{}
+	// holds a mapping of view names to the side-by-side diffs rendered to them,
+	// so that we can render them again with the new width when the view is
+	// resized
+	sideBySideDiffs map[string]*sideBySideDiff
+	stopChan        chan struct{}
 
 	// when lazygit is opened outside a git directory we want to open to the most
 	// recent repo with the recent repos popup showing
@@ -99,6 +106,12 @@ type Gui struct {
 	// so that you can return to the superproject
 	RepoPathStack *utils.StringStack
 
+	// the worktree paths of the repos that were opened in this session, in the
+	// order in which they were opened. Their state is kept in RepoStateMap so
+	// that switching back to one of them doesn't start from scratch. They are
+	// shown as tabs of the status view.
+	RepoTabs []string
+
 	// this tells us whether our views have been initially set up
 	ViewsSetup bool
 
@@ -110,6 +123,47 @@ type Gui struct {
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
+	// when true, an overlay shows how long recent git commands and refreshes
+	// took
+	ShowPerformanceHud bool
+	// set while a redraw of the performance HUD is pending, so that we don't
+	// queue one per recorded timing
+	performanceHudRedrawPending atomic.Bool
+
+	// set when git started us as its difftool or mergetool; unlike
+	// State.Modes.Tool, this doesn't change when switching repos
+	toolMode tool.Tool
+
+	// the commit or file to select once the repo has been loaded for the first
+	// time (see the --commit and --file args); cleared after that
+	startCommit string
+	startFile   string
+
+	// set when we were started with e.g. `lazygit commit` to only perform a
+	// single action and quit
+	singleAction *singleAction
+	handlePull   func() error
+
+	// the commands that we've told the user expanded the sparse index, so that
+	// we only do it once per command
+	sparseIndexExpansionsReported      map[string]bool
+	sparseIndexExpansionsReportedMutex deadlock.Mutex
+
+	// Repos for which we've warned that rendering diffs may download missing
+	// blobs, and commands whose missing blobs are being downloaded in the
+	// background (see partial_clone.go)
+	lazyFetchingWarningShown map[string]bool
+	missingBlobFetches       map[string]bool
+	partialCloneMutex        deadlock.Mutex
+
+	// when true, panels that show relative dates show absolute ones instead,
+	// and vice versa
+	DateFormatToggled bool
+
+	// when true, the main view shows the details of the selected commit
+	// rather than its patch
+	ShowCommitDetails bool
+
 	PopupHandler types.IPopupHandler
 
 	IsRefreshingFiles bool
@@ -143,11 +197,13 @@ type Gui struct {
 	c       *helpers.HelperCommon
 	helpers *helpers.Helpers
 
-	previousLanguageConfig string
+	previousLanguage string
 
 	integrationTest integrationTypes.IntegrationTest
 
 	afterLayoutFuncs chan func() error
+
+	imagePreviews imagePreviewState
 }
 
 type StateAccessor struct {
@@ -160,6 +216,17 @@ func (self *StateAccessor) GetRepoPathStack() *utils.StringStack {
 	return self.gui.RepoPathStack
 }
 
+func (self *StateAccessor) GetRepoTabs() []string {
+	return self.gui.RepoTabs
+}
+
+// CloseRepoTab forgets the state of the given repo, so that it's loaded from
+// scratch the next time we switch to it
+func (self *StateAccessor) CloseRepoTab(path string) {
+	delete(self.gui.RepoStateMap, Repo(path))
+	self.gui.RepoTabs = lo.Without(self.gui.RepoTabs, path)
+}
+
 func (self *StateAccessor) GetUpdating() bool {
 	return self.gui.Updating
 }
@@ -192,6 +259,22 @@ func (self *StateAccessor) SetShowExtrasWindow(value bool) {
 	self.gui.ShowExtrasWindow = value
 }
 
+func (self *StateAccessor) GetDateFormatToggled() bool {
+	return self.gui.DateFormatToggled
+}
+
+func (self *StateAccessor) SetDateFormatToggled(value bool) {
+	self.gui.DateFormatToggled = value
+}
+
+func (self *StateAccessor) GetShowCommitDetails() bool {
+	return self.gui.ShowCommitDetails
+}
+
+func (self *StateAccessor) SetShowCommitDetails(value bool) {
+	self.gui.ShowCommitDetails = value
+}
+
 func (self *StateAccessor) GetRetainOriginalDir() bool {
 	return self.gui.RetainOriginalDir
 }
@@ -252,10 +335,18 @@ type GuiRepoState struct {
 	ViewsSetup bool
 
 	ScreenMode types.ScreenMode
+	// the screen mode to go back to when zooming out again
+	ScreenModeBeforeZoom types.ScreenMode
 
 	CurrentPopupOpts *types.CreatePopupPanelOpts
 
 	LastBackgroundFetchTime time.Time
+
+	// Actions that can be undone/redone in addition to the reflog-based ones
+	OperationJournal *types.OperationJournal
+
+	// Makes sure we offer to write a commit-graph at most once
+	CommitGraphOffer sync.Once
 }
 
 var _ types.IRepoStateAccessor = new(GuiRepoState)
@@ -292,6 +383,14 @@ func (self *GuiRepoState) SetScreenMode(value types.ScreenMode) {
 	self.ScreenMode = value
 }
 
+func (self *GuiRepoState) GetScreenModeBeforeZoom() types.ScreenMode {
+	return self.ScreenModeBeforeZoom
+}
+
+func (self *GuiRepoState) SetScreenModeBeforeZoom(value types.ScreenMode) {
+	self.ScreenModeBeforeZoom = value
+}
+
 func (self *GuiRepoState) InSearchPrompt() bool {
 	return self.SearchState.SearchType() != types.SearchTypeNone
 }
@@ -308,6 +407,14 @@ func (self *GuiRepoState) GetSplitMainPanel() bool {
 	return self.SplitMainPanel
 }
 
+func (self *GuiRepoState) GetOperationJournal() *types.OperationJournal {
+	return self.OperationJournal
+}
+
+func (self *GuiRepoState) GetCommitGraphOffer() *sync.Once {
+	return &self.CommitGraphOffer
+}
+
 func (gui *Gui) onSwitchToNewRepo(startArgs appTypes.StartArgs, contextKey types.ContextKey) error {
 	err := gui.onNewRepo(startArgs, contextKey)
 	if err == nil && gui.UserConfig().Git.AutoFetch && gui.UserConfig().Refresher.FetchInterval > 0 {
@@ -342,6 +449,7 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 	}
 
 	contextToPush := gui.resetState(startArgs)
+	gui.setRepoTabs()
 
 	gui.resetHelpersAndControllers()
 
@@ -353,18 +461,9 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 		if Focused {
 			gui.git.Config.DropConfigCache()
 
-			oldConfig := gui.Config.GetUserConfig()
-			reloadErr, didChange := gui.Config.ReloadChangedUserConfigFiles()
-			if didChange && reloadErr == nil {
-				gui.c.Log.Info("User config changed - reloading")
-				reloadErr = gui.onUserConfigLoaded()
-				if err := gui.resetKeybindings(); err != nil {
-					return err
-				}
-
-				if err := gui.checkForChangedConfigsThatDontAutoReload(oldConfig, gui.Config.GetUserConfig()); err != nil {
-					return err
-				}
+			reloadErr, err := gui.reloadChangedUserConfigFiles()
+			if err != nil {
+				return err
 			}
 
 			gui.c.Log.Info("Receiving focus - refreshing")
@@ -411,7 +510,7 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 		ctx, ok := gui.helpers.View.ContextForView(v.Name())
 		if ok {
 			if searchableContext, ok := ctx.(types.ISearchableContext); ok {
-				searchableContext.RenderSearchStatus(index, total)
+				searchableContext.RenderSearchStatus(index, total, helpers.SearchMatchLineNumber(searchableContext))
 			}
 		}
 	})
@@ -429,7 +528,7 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 
 	gui.c.Context().Push(contextToPush, types.OnFocusOpts{})
 
-	return nil
+	return gui.enterToolMode(startArgs)
 }
 
 func (gui *Gui) getPerRepoConfigFiles() []*config.ConfigFile {
@@ -455,17 +554,62 @@ func (gui *Gui) getPerRepoConfigFiles() []*config.ConfigFile {
 	return repoConfigFiles
 }
 
+// The language chosen in the language menu takes precedence over the one from
+// the config
+func (gui *Gui) language() string {
+	if language := gui.c.GetAppState().Language; language != "" {
+		return language
+	}
+	return gui.c.UserConfig().Gui.Language
+}
+
+func (gui *Gui) loadLanguage() error {
+	language := gui.language()
+	if gui.previousLanguage == language {
+		return nil
+	}
+
+	tr, err := i18n.NewTranslationSetFromConfig(gui.Log, language)
+	if err != nil {
+		return err
+	}
+	gui.c.Tr = tr
+	gui.previousLanguage = language
+	return nil
+}
+
+func (gui *Gui) onLanguageChanged() error {
+	if err := gui.loadLanguage(); err != nil {
+		return err
+	}
+
+	gui.configureViewProperties()
+	if err := gui.resetKeybindings(); err != nil {
+		return err
+	}
+
+	// Lists contain translated text too, e.g. section headers and statuses
+	gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return nil
+}
+
+func (gui *Gui) shouldReorderRightToLeft() bool {
+	switch gui.c.UserConfig().Gui.RightToLeftReordering {
+	case "on":
+		return true
+	case "off":
+		return false
+	default:
+		return i18n.IsRightToLeftLanguage(gui.language())
+	}
+}
+
 func (gui *Gui) onUserConfigLoaded() error {
 	userConfig := gui.Config.GetUserConfig()
 	gui.Common.SetUserConfig(userConfig)
 
-	if gui.previousLanguageConfig != userConfig.Gui.Language {
-		tr, err := i18n.NewTranslationSetFromConfig(gui.Log, userConfig.Gui.Language)
-		if err != nil {
-			return err
-		}
-		gui.c.Tr = tr
-		gui.previousLanguageConfig = userConfig.Gui.Language
+	if err := gui.loadLanguage(); err != nil {
+		return err
 	}
 
 	gui.setColorScheme()
@@ -477,7 +621,12 @@ func (gui *Gui) onUserConfigLoaded() error {
 
 	gui.g.ShowListFooter = userConfig.Gui.ShowListFooter
 
+	gui.g.ScrollbarRune = lo.Ternary(userConfig.Gui.Accessibility.Enabled, '#', '▐')
+
 	gui.g.Mouse = userConfig.Gui.MouseEvents
+	// A middle click only moves the cursor if it pastes there; no gesture uses
+	// the right button, so it never does
+	gui.g.CursorMovingMouseKeys = lo.Ternary(userConfig.Gui.Mouse.MiddleClickPaste, []gocui.Key{gocui.MouseMiddle}, nil)
 
 	// originally we could only hide the command log permanently via the config
 	// but now we do it via state. So we need to still support the config for the
@@ -485,13 +634,7 @@ func (gui *Gui) onUserConfigLoaded() error {
 	gui.ShowExtrasWindow = userConfig.Gui.ShowCommandLog && !gui.c.GetAppState().HideCommandLog
 
 	authors.SetCustomAuthors(userConfig.Gui.AuthorColors)
-	if userConfig.Gui.NerdFontsVersion != "" {
-		icons.SetNerdFontsVersion(userConfig.Gui.NerdFontsVersion)
-	} else if userConfig.Gui.ShowIcons {
-		icons.SetNerdFontsVersion("2")
-	} else {
-		icons.SetNerdFontsVersion("")
-	}
+	icons.SetIconSet(userConfig.Gui.GetIconSet(), userConfig.Gui.CustomIcons.Icons)
 
 	if len(userConfig.Gui.BranchColorPatterns) > 0 {
 		presentation.SetCustomBranches(userConfig.Gui.BranchColorPatterns, true)
@@ -503,6 +646,27 @@ func (gui *Gui) onUserConfigLoaded() error {
 	return nil
 }
 
+// Reloads the user config if any of its files changed on disk. The first
+// return value is an error with the new config, which we want to show to the
+// user without quitting; the second one is an error that should be propagated.
+func (gui *Gui) reloadChangedUserConfigFiles() (error, error) {
+	oldConfig := gui.Config.GetUserConfig()
+	reloadErr, didChange := gui.Config.ReloadChangedUserConfigFiles()
+	if didChange && reloadErr == nil {
+		gui.c.Log.Info("User config changed - reloading")
+		reloadErr = gui.onUserConfigLoaded()
+		if err := gui.resetKeybindings(); err != nil {
+			return nil, err
+		}
+
+		if err := gui.checkForChangedConfigsThatDontAutoReload(oldConfig, gui.Config.GetUserConfig()); err != nil {
+			return nil, err
+		}
+	}
+
+	return reloadErr, nil
+}
+
 func (gui *Gui) checkForChangedConfigsThatDontAutoReload(oldConfig *config.UserConfig, newConfig *config.UserConfig) error {
 	configsThatDontAutoReload := []string{
 		"Git.AutoFetch",
@@ -590,25 +754,28 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 	gui.State = &GuiRepoState{
 		ViewsSetup: false,
 		Model: &types.Model{
-			CommitFiles:           nil,
-			Files:                 make([]*models.File, 0),
-			Commits:               make([]*models.Commit, 0),
-			StashEntries:          make([]*models.StashEntry, 0),
-			FilteredReflogCommits: make([]*models.Commit, 0),
-			ReflogCommits:         make([]*models.Commit, 0),
-			BisectInfo:            git_commands.NewNullBisectInfo(),
-			FilesTrie:             patricia.NewTrie(),
-			Authors:               map[string]*models.Author{},
-			MainBranches:          git_commands.NewMainBranches(gui.c.Common, gui.os.Cmd),
-			HashPool:              &utils.StringPool{},
-			PullRequests:          gui.loadCachedPullRequests(),
-			PullRequestsMap:       make(map[string]*models.GithubPullRequest),
+			CommitFiles:             nil,
+			Files:                   make([]*models.File, 0),
+			Commits:                 make([]*models.Commit, 0),
+			StashEntries:            make([]*models.StashEntry, 0),
+			FilteredReflogCommits:   make([]*models.Commit, 0),
+			ReflogCommits:           make([]*models.Commit, 0),
+			BisectInfo:              git_commands.NewNullBisectInfo(),
+			FilesTrie:               patricia.NewTrie(),
+			Authors:                 map[string]*models.Author{},
+			MainBranches:            git_commands.NewMainBranches(gui.c.Common, gui.os.Cmd),
+			HashPool:                &utils.StringPool{},
+			PullRequests:            gui.loadCachedPullRequests(),
+			PullRequestsMap:         make(map[string]*models.GithubPullRequest),
+			CommitStatuses:          make(map[string]*models.GithubCommitStatus),
+			RemoteBranchDivergences: make(map[string]*models.RemoteBranchDivergence),
 		},
 		Modes: &types.Modes{
-			Filtering:        filtering.New(startArgs.FilterPath, ""),
+			Filtering:        filtering.New(startArgs.FilterPath, startArgs.FilterAuthor, startArgs.FilterSince, startArgs.FilterUntil),
 			CherryPicking:    cherrypicking.New(),
 			Diffing:          diffing.New(),
 			MarkedBaseCommit: marked_base_commit.New(),
+			Tool:             toolMode(startArgs),
 		},
 		ScreenMode: initialScreenMode,
 		// TODO: only use contexts from context manager
@@ -616,9 +783,22 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 		Contexts:          contextTree,
 		WindowViewNameMap: initialWindowViewNameMap(contextTree),
 		SearchState:       types.NewSearchState(),
+		OperationJournal:  types.NewOperationJournal(),
 	}
 
 	gui.RepoStateMap[Repo(worktreePath)] = gui.State
+	gui.RepoTabs = append(gui.RepoTabs, worktreePath)
+
+	// When git runs us as its difftool or mergetool, or we only perform a
+	// single action, we don't want to restore the user's session, and we don't
+	// save it when quitting either
+	if !gui.State.Modes.Tool.Active() && !startArgs.GitArg.IsSingleAction() {
+		noStartArgs := !startArgs.IsFiltering() && startArgs.GitArg == appTypes.GitArgNone &&
+			startArgs.Panel == appTypes.PanelNone && startArgs.Commit == "" && startArgs.File == ""
+		if contextToFocus := gui.restoreSession(gui.State, noStartArgs, !startArgs.IsFiltering()); contextToFocus != nil {
+			return contextToFocus
+		}
+	}
 
 	return initialContext(contextTree, startArgs)
 }
@@ -637,6 +817,7 @@ func (gui *Gui) loadCachedPullRequests() []*models.GithubPullRequest {
 			HeadRepositoryOwner: models.GithubRepositoryOwner{
 				Login: cached.HeadRepositoryOwner,
 			},
+			ReviewDecision: cached.ReviewDecision,
 		}
 	})
 }
@@ -663,7 +844,9 @@ func initialWindowViewNameMap(contextTree *context.ContextTree) *utils.ThreadSaf
 func initialScreenMode(startArgs appTypes.StartArgs, config config.AppConfigurer) types.ScreenMode {
 	if startArgs.ScreenMode != "" {
 		return parseScreenModeArg(startArgs.ScreenMode)
-	} else if startArgs.FilterPath != "" || startArgs.GitArg != appTypes.GitArgNone {
+	} else if startArgs.GitArg == appTypes.GitArgDifftool || startArgs.GitArg == appTypes.GitArgMergetool {
+		return types.SCREEN_FULL
+	} else if startArgs.IsFiltering() || startArgs.GitArg != appTypes.GitArgNone {
 		return types.SCREEN_HALF
 	}
 
@@ -681,11 +864,17 @@ func parseScreenModeArg(screenModeArg string) types.ScreenMode {
 	}
 }
 
-func initialContext(contextTree *context.ContextTree, startArgs appTypes.StartArgs) types.IListContext {
-	var initialContext types.IListContext = contextTree.Files
+func initialContext(contextTree *context.ContextTree, startArgs appTypes.StartArgs) types.Context {
+	var initialContext types.Context = contextTree.Files
 
-	if startArgs.FilterPath != "" {
+	if startArgs.IsFiltering() {
+		initialContext = contextTree.LocalCommits
+	} else if startArgs.Commit != "" {
 		initialContext = contextTree.LocalCommits
+	} else if startArgs.File != "" {
+		initialContext = contextTree.Files
+	} else if startArgs.Panel != appTypes.PanelNone {
+		initialContext = contextForPanel(contextTree, startArgs.Panel)
 	} else if startArgs.GitArg != appTypes.GitArgNone {
 		switch startArgs.GitArg {
 		case appTypes.GitArgStatus:
@@ -696,6 +885,14 @@ func initialContext(contextTree *context.ContextTree, startArgs appTypes.StartAr
 			initialContext = contextTree.LocalCommits
 		case appTypes.GitArgStash:
 			initialContext = contextTree.Stash
+		// The status panel renders the diff to the main view in difftool mode;
+		// enterToolMode focuses the main view on top of it
+		case appTypes.GitArgDifftool:
+			initialContext = contextTree.Status
+		case appTypes.GitArgMergetool, appTypes.GitArgCommit, appTypes.GitArgPull:
+			initialContext = contextTree.Files
+		case appTypes.GitArgBranchPicker:
+			initialContext = contextTree.Branches
 		default:
 			panic("unhandled git arg")
 		}
@@ -704,6 +901,33 @@ func initialContext(contextTree *context.ContextTree, startArgs appTypes.StartAr
 	return initialContext
 }
 
+func contextForPanel(contextTree *context.ContextTree, panel appTypes.Panel) types.Context {
+	switch panel {
+	case appTypes.PanelStatus:
+		return contextTree.Status
+	case appTypes.PanelFiles:
+		return contextTree.Files
+	case appTypes.PanelWorktrees:
+		return contextTree.Worktrees
+	case appTypes.PanelSubmodules:
+		return contextTree.Submodules
+	case appTypes.PanelBranches:
+		return contextTree.Branches
+	case appTypes.PanelRemotes:
+		return contextTree.Remotes
+	case appTypes.PanelTags:
+		return contextTree.Tags
+	case appTypes.PanelCommits:
+		return contextTree.LocalCommits
+	case appTypes.PanelReflog:
+		return contextTree.ReflogCommits
+	case appTypes.PanelStash:
+		return contextTree.Stash
+	}
+
+	panic("unhandled panel")
+}
+
 func (gui *Gui) Contexts() *context.ContextTree {
 	return gui.State.Contexts
 }
@@ -720,17 +944,22 @@ func NewGui(
 	test integrationTypes.IntegrationTest,
 ) (*Gui, error) {
 	gui := &Gui{
-		Common:               cmn,
-		gitVersion:           gitVersion,
-		Config:               configurer,
-		Updater:              updater,
-		statusManager:        status.NewStatusManager(),
-		viewBufferManagerMap: map[string]*tasks.ViewBufferManager{},
-		viewPtmxMap:          map[string]*os.File{},
-		showRecentRepos:      showRecentRepos,
-		RepoPathStack:        &utils.StringStack{},
-		RepoStateMap:         map[Repo]*GuiRepoState{},
-		GuiLog:               []string{},
+		Common:                   cmn,
+		gitVersion:               gitVersion,
+		Config:                   configurer,
+		Updater:                  updater,
+		statusManager:            status.NewStatusManager(),
+		viewBufferManagerMap:     map[string]*tasks.ViewBufferManager{},
+		mainViewCache:            newMainViewCache(),
+		lazyFetchingWarningShown: map[string]bool{},
+		missingBlobFetches:       map[string]bool{},
+		viewPtmxMap:              map[string]*os.File{},
+		sideBySideDiffs:          map[string]*sideBySideDiff{},
+		showRecentRepos:          showRecentRepos,
+		RepoPathStack:            &utils.StringStack{},
+		RepoTabs:                 []string{},
+		RepoStateMap:             map[Repo]*GuiRepoState{},
+		GuiLog:                   []string{},
 
 		// initializing this to true for the time being; it will be reset to the
 		// real value after loading the user config:
@@ -770,6 +999,8 @@ func NewGui(
 		gui.LogCommand,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
+		func() oscommands.ProgressReporter { return gui.helpers.AppStatus.NewProgressReporter() },
+		gui.onSparseIndexExpanded,
 	)
 
 	osCommand := oscommands.NewOSCommand(cmn, configurer, oscommands.GetPlatform(), guiIO)
@@ -780,7 +1011,11 @@ func NewGui(
 	// TODO: reset these controllers upon changing repos due to state changing
 	gui.c = helperCommon
 
-	gui.BackgroundRoutineMgr = &BackgroundRoutineMgr{gui: gui}
+	gui.BackgroundRoutineMgr = &BackgroundRoutineMgr{
+		gui:                  gui,
+		lastFetchErrors:      map[string]string{},
+		lastRemoteFetchTimes: map[remoteFetchKey]time.Time{},
+	}
 	gui.stateAccessor = &StateAccessor{gui: gui}
 
 	gui.pagerConfig = config.NewPagerConfig(func() *config.UserConfig { return gui.UserConfig() })
@@ -901,6 +1136,10 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 		return true
 	}
 
+	gui.g.InterceptMouseEvent = func(x int, y int, key gocui.Key, mod gocui.Modifier) (bool, error) {
+		return gui.helpers.PanelResize.HandleMouseEvent(x, y, key, mod)
+	}
+
 	// if the deadlock package wants to report a deadlock, we first need to
 	// close the gui so that we can actually read what it prints.
 	deadlock.Opts.LogBuf = utils.NewOnceWriter(os.Stderr, func() {
@@ -913,12 +1152,19 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 
 	gui.g.OnSearchEscape = func() error { gui.helpers.Search.Cancel(); return nil }
 
+	gui.g.AfterFlush = gui.drawImagePreviews
+
 	gui.g.SetManager(gocui.ManagerFunc(gui.layout))
 
 	if err := gui.createAllViews(); err != nil {
 		return err
 	}
 
+	gui.toolMode = toolMode(startArgs)
+	gui.startCommit = startArgs.Commit
+	gui.startFile = startArgs.File
+	gui.singleAction = newSingleAction(startArgs.GitArg)
+
 	// onNewRepo must be called after g.SetManager because SetManager deletes keybindings
 	if err := gui.onNewRepo(startArgs, context.NO_CONTEXT); err != nil {
 		return err
@@ -930,6 +1176,8 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 
 	gui.Helpers().SuspendResume.InstallResumeSignalHandler()
 
+	gui.setupSingleAction()
+
 	gui.c.Log.Info("starting main loop")
 
 	// setting here so we can use it in layout.go
@@ -939,6 +1187,7 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 	if errors.Is(err, gocui.ErrQuit) {
 		// Give the focused context a chance to clean up before we tear down the app.
 		gui.c.Context().Current().HandleQuit()
+		gui.saveRepoSessions()
 	}
 	return err
 }
@@ -954,6 +1203,12 @@ func (gui *Gui) RunAndHandleError(startArgs appTypes.StartArgs) error {
 			close(gui.stopChan)
 
 			if errors.Is(err, gocui.ErrQuit) {
+				if startArgs.OutputSelection != "" {
+					if err := gui.outputSelection(startArgs.OutputSelection); err != nil {
+						return err
+					}
+				}
+
 				if gui.c.State().GetRetainOriginalDir() {
 					if err := gui.helpers.RecordDirectory.RecordDirectory(gui.InitialDir); err != nil {
 						return err
@@ -1000,6 +1255,8 @@ func (gui *Gui) resume() error {
 		return err
 	}
 
+	gui.forgetDrawnImagePreviews()
+
 	gui.BackgroundRoutineMgr.PauseBackgroundRefreshes(false)
 	return nil
 }
@@ -1058,7 +1315,13 @@ func (gui *Gui) loadNewRepo() error {
 		return err
 	}
 
-	gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	if gui.singleAction != nil && !gui.singleAction.started.Load() {
+		gui.refreshAndStartSingleAction()
+	} else if gui.startCommit != "" || gui.startFile != "" {
+		gui.refreshAndSelectStartItem()
+	} else {
+		gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	}
 
 	if err := gui.os.UpdateWindowTitle(); err != nil {
 		return err
@@ -1071,9 +1334,22 @@ func (gui *Gui) showIntroPopupMessage() {
 	gui.waitForIntro.Add(1)
 
 	gui.c.OnUIThread(func() error {
+		// Check this before saving the app state, which marks the intro as seen
+		showSetupWizard := gui.helpers.SetupWizard.ShouldShow()
+
 		onConfirm := func() error {
 			gui.c.GetAppState().StartupPopupVersion = StartupPopupVersion
 			err := gui.c.SaveAppState()
+			if showSetupWizard {
+				if err != nil {
+					gui.waitForIntro.Done()
+					return err
+				}
+				return gui.helpers.SetupWizard.Start(func() error {
+					gui.waitForIntro.Done()
+					return nil
+				})
+			}
 			gui.waitForIntro.Done()
 			return err
 		}
@@ -1174,6 +1450,23 @@ func (gui *Gui) setColorScheme() {
 	gui.g.SelFrameColor = theme.ActiveBorderColor
 }
 
+// Called from whichever goroutine ran a git command that expanded the sparse
+// index to a full one, so that users of huge repos know why it was slow
+func (gui *Gui) onSparseIndexExpanded(cmdStr string) {
+	gui.sparseIndexExpansionsReportedMutex.Lock()
+	defer gui.sparseIndexExpansionsReportedMutex.Unlock()
+
+	if gui.sparseIndexExpansionsReported == nil {
+		gui.sparseIndexExpansionsReported = map[string]bool{}
+	}
+	if gui.sparseIndexExpansionsReported[cmdStr] {
+		return
+	}
+	gui.sparseIndexExpansionsReported[cmdStr] = true
+
+	gui.c.WarningToast(utils.ResolvePlaceholderString(gui.c.Tr.SparseIndexExpanded, map[string]string{"command": cmdStr}))
+}
+
 func (gui *Gui) onUIThread(f func() error) {
 	gui.g.Update(func(*gocui.Gui) error {
 		return f()
@@ -157,6 +157,23 @@ func (self *guiCommon) ResetKeybindings() error {
 	return self.gui.resetKeybindings()
 }
 
+func (self *guiCommon) OnSidePanelLayoutChanged() error {
+	self.gui.setPanelJumpTitlePrefixes()
+	return self.gui.resetKeybindings()
+}
+
+func (self *guiCommon) OnLanguageChanged() error {
+	return self.gui.onLanguageChanged()
+}
+
+func (self *guiCommon) ReloadChangedUserConfigFiles() error {
+	reloadErr, err := self.gui.reloadChangedUserConfigFiles()
+	if err != nil {
+		return err
+	}
+	return reloadErr
+}
+
 func (self *guiCommon) IsAnyModeActive() bool {
 	return self.gui.helpers.Mode.IsAnyModeActive()
 }
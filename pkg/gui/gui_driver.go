@@ -64,6 +64,22 @@ func (self *GuiDriver) Click(x, y int) {
 	self.waitTillIdle()
 }
 
+func (self *GuiDriver) Drag(fromX, fromY, toX, toY int) {
+	self.CheckAllToastsAcknowledged()
+
+	// gocui only reports motion events as such once the mouse has moved
+	// while the button is pressed, so we need to send two of them
+	for _, ev := range []*tcell.EventMouse{
+		tcell.NewEventMouse(fromX, fromY, tcell.ButtonPrimary, 0),
+		tcell.NewEventMouse(toX, toY, tcell.ButtonPrimary, 0),
+		tcell.NewEventMouse(toX, toY, tcell.ButtonPrimary, 0),
+		tcell.NewEventMouse(toX, toY, tcell.ButtonNone, 0),
+	} {
+		self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(ev, 0)
+		self.waitTillIdle()
+	}
+}
+
 // wait until lazygit is idle (i.e. all processing is done) before continuing
 func (self *GuiDriver) waitTillIdle() {
 	<-self.isIdleChan
@@ -149,6 +165,10 @@ func (self *GuiDriver) View(viewName string) *gocui.View {
 	return view
 }
 
+func (self *GuiDriver) Snapshot() string {
+	return self.gui.g.Snapshot()
+}
+
 func (self *GuiDriver) SetCaption(caption string) {
 	self.gui.setCaption(caption)
 	self.waitTillIdle()
@@ -171,3 +191,7 @@ func (self *GuiDriver) NextToast() *string {
 func (self *GuiDriver) Headless() bool {
 	return self.headless
 }
+
+func (self *GuiDriver) SideWindows() []string {
+	return self.gui.helpers.Window.SideWindows()
+}
@@ -0,0 +1,166 @@
+package gui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/imagepreview"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Images can't be drawn through gocui, so views that show an image preview
+// reserve empty lines for them, and after each redraw we draw the images on
+// top of those lines by writing the escape sequences straight to the tty.
+type imagePreviewState struct {
+	mutex sync.Mutex
+
+	// the images that views want to show, keyed by view name
+	placements map[string][]imagePlacement
+	nextId     int
+
+	// what we've drawn on the screen last time; we only redraw if this changes,
+	// since sending images to the terminal is expensive
+	drawn    []imageOnScreen
+	drawnKey string
+	protocol imagepreview.Protocol
+}
+
+type imagePlacement struct {
+	types.ImagePlacement
+	id int
+}
+
+type imageOnScreen struct {
+	imagePlacement
+	x, y int
+}
+
+func (gui *Gui) setImagePreviews(view *gocui.View, images []types.ImagePlacement) {
+	state := &gui.imagePreviews
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+
+	if len(images) == 0 {
+		delete(state.placements, view.Name())
+		return
+	}
+
+	if state.placements == nil {
+		state.placements = map[string][]imagePlacement{}
+	}
+	placements := make([]imagePlacement, 0, len(images))
+	for _, image := range images {
+		state.nextId++
+		placements = append(placements, imagePlacement{ImagePlacement: image, id: state.nextId})
+	}
+	state.placements[view.Name()] = placements
+}
+
+// After resuming from a subprocess the screen has been cleared, so we need to
+// draw the images again
+func (gui *Gui) forgetDrawnImagePreviews() {
+	state := &gui.imagePreviews
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+
+	state.drawn = nil
+	state.drawnKey = ""
+}
+
+// Called after each redraw of the screen
+func (gui *Gui) drawImagePreviews(tty io.Writer) {
+	state := &gui.imagePreviews
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+
+	protocol := imagepreview.DetectProtocol(gui.c.UserConfig().Gui.ImagePreviewProtocol, os.Getenv)
+	images := gui.visibleImagePreviews(state)
+
+	keyParts := []string{fmt.Sprint(protocol)}
+	for _, image := range images {
+		keyParts = append(keyParts, fmt.Sprintf("%d:%d,%d", image.id, image.x, image.y))
+	}
+	key := strings.Join(keyParts, ";")
+	if key == state.drawnKey {
+		return
+	}
+
+	var sb strings.Builder
+	if len(state.drawn) > 0 {
+		if state.protocol == imagepreview.ProtocolKitty {
+			for _, image := range state.drawn {
+				sb.WriteString(imagepreview.Delete(state.protocol, image.id))
+			}
+		} else {
+			// iTerm2 draws images into the cells, so we need to repaint them.
+			// Sync does that right away, so it's fine to draw the new images
+			// afterwards.
+			gocui.Screen.Sync()
+		}
+	}
+
+	drawn := []imageOnScreen{}
+	for _, image := range images {
+		encoded, err := imagepreview.Encode(protocol, image.Content, image.id, image.Cols, image.Rows)
+		if err != nil {
+			gui.c.Log.Errorf("Failed to encode image preview: %v", err)
+			continue
+		}
+		// save the cursor position before moving it to where the image goes,
+		// and restore it afterwards, so that our terminal library doesn't get
+		// confused about where the cursor is
+		fmt.Fprintf(&sb, "\x1b7\x1b[%d;%dH%s\x1b8", image.y+1, image.x+1, encoded)
+		drawn = append(drawn, image)
+	}
+
+	if sb.Len() > 0 {
+		if _, err := io.WriteString(tty, sb.String()); err != nil {
+			gui.c.Log.Errorf("Failed to draw image preview: %v", err)
+		}
+	}
+
+	state.drawn = drawn
+	state.drawnKey = key
+	state.protocol = protocol
+}
+
+// Returns the images that are fully visible right now, with their position on
+// the screen. Images are hidden while a popup is open, since they would be
+// drawn on top of it.
+func (gui *Gui) visibleImagePreviews(state *imagePreviewState) []imageOnScreen {
+	if len(state.placements) == 0 || len(gui.State.ContextMgr.CurrentPopup()) > 0 {
+		return nil
+	}
+
+	result := []imageOnScreen{}
+	for viewName, placements := range state.placements {
+		view, err := gui.g.View(viewName)
+		if err != nil || !view.Visible {
+			continue
+		}
+		if gui.helpers.Window.TopViewInWindow(gui.helpers.Window.WindowForView(viewName), false) != view {
+			continue
+		}
+
+		x0, y0, _, _ := view.Dimensions()
+		originY := view.OriginY()
+		for _, placement := range placements {
+			firstLine := placement.Line - originY
+			if firstLine < 0 || firstLine+placement.Rows > view.InnerHeight() || placement.Cols > view.InnerWidth() {
+				continue
+			}
+			result = append(result, imageOnScreen{
+				imagePlacement: placement,
+				x:              x0 + 1,
+				y:              y0 + 1 + firstLine,
+			})
+		}
+	}
+	slices.SortFunc(result, func(a, b imageOnScreen) int { return a.id - b.id })
+	return result
+}
@@ -0,0 +1,188 @@
+package imagepreview
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Renders images in the terminal using one of the terminal graphics protocols,
+// i.e. the kitty graphics protocol or iTerm2's inline images protocol. Neither
+// of these fit into the cell model of our terminal library, so the escape
+// sequences produced here are written straight to the tty after each redraw.
+
+type Protocol int
+
+const (
+	ProtocolNone Protocol = iota
+	ProtocolKitty
+	ProtocolITerm2
+)
+
+// Terminal cells are roughly twice as high as they are wide. We don't know the
+// actual cell size in pixels, so we assume a common one; it's only used to
+// keep the aspect ratio of images and to avoid scaling small images up.
+const (
+	cellWidthInPixels  = 8
+	cellHeightInPixels = 16
+)
+
+// Kitty requires the base64 payload to be sent in chunks of at most this size
+const kittyChunkSize = 4096
+
+var imageExtensions = []string{".png", ".jpg", ".jpeg", ".gif"}
+
+// IsImagePath returns true if the file at the given path is an image that we
+// know how to preview, judging by its extension.
+func IsImagePath(path string) bool {
+	return slices.Contains(imageExtensions, strings.ToLower(filepath.Ext(path)))
+}
+
+// DetectProtocol returns the graphics protocol to use for the given setting
+// (one of 'auto', 'kitty', 'iterm2' or 'none'). For 'auto', we look at the
+// environment variables that the supporting terminals set.
+func DetectProtocol(setting string, getenv func(string) string) Protocol {
+	switch setting {
+	case "kitty":
+		return ProtocolKitty
+	case "iterm2":
+		return ProtocolITerm2
+	case "auto":
+		if getenv("KITTY_WINDOW_ID") != "" || getenv("TERM") == "xterm-kitty" {
+			return ProtocolKitty
+		}
+		if termProgram := getenv("TERM_PROGRAM"); termProgram == "iTerm.app" || termProgram == "WezTerm" {
+			return ProtocolITerm2
+		}
+	}
+	return ProtocolNone
+}
+
+// Describe returns a one-line description of the given image, e.g.
+// 'PNG, 640x480 pixels, 12.3 KB'. If the image can't be decoded, we only
+// report its size.
+func Describe(content []byte) string {
+	size := formatSize(len(content))
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return size
+	}
+	return fmt.Sprintf("%s, %dx%d pixels, %s", strings.ToUpper(format), config.Width, config.Height, size)
+}
+
+func formatSize(size int) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	default:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+	}
+}
+
+// FitToCells returns the number of columns and rows that an image of the given
+// size in pixels should occupy so that it fits into maxCols x maxRows cells,
+// keeping its aspect ratio and without scaling it up.
+func FitToCells(width int, height int, maxCols int, maxRows int) (int, int) {
+	if width <= 0 || height <= 0 || maxCols <= 0 || maxRows <= 0 {
+		return 0, 0
+	}
+
+	cols := min(maxCols, (width+cellWidthInPixels-1)/cellWidthInPixels)
+	rows := max(1, (cols*cellWidthInPixels*height/width+cellHeightInPixels/2)/cellHeightInPixels)
+	if rows > maxRows {
+		rows = maxRows
+		cols = max(1, (rows*cellHeightInPixels*width/height+cellWidthInPixels/2)/cellWidthInPixels)
+	}
+	return cols, rows
+}
+
+// Size returns the size in pixels of the given image, or false if it can't be
+// decoded.
+func Size(content []byte) (int, int, bool) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, false
+	}
+	return config.Width, config.Height, true
+}
+
+// Encode returns the escape sequence that draws the given image at the cursor
+// position, scaled to the given number of columns and rows. For kitty, the id
+// identifies the image so that it can be deleted again with Delete.
+func Encode(protocol Protocol, content []byte, id int, cols int, rows int) (string, error) {
+	switch protocol {
+	case ProtocolKitty:
+		return encodeKitty(content, id, cols, rows)
+	case ProtocolITerm2:
+		return encodeITerm2(content, cols, rows), nil
+	}
+	return "", nil
+}
+
+func encodeKitty(content []byte, id int, cols int, rows int) (string, error) {
+	// Kitty only understands PNG (besides raw pixel data), so anything else
+	// needs to be converted first
+	if _, format, err := image.DecodeConfig(bytes.NewReader(content)); err != nil {
+		return "", err
+	} else if format != "png" {
+		content, err = toPNG(content)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	payload := base64.StdEncoding.EncodeToString(content)
+
+	var sb strings.Builder
+	for i := 0; i < len(payload); i += kittyChunkSize {
+		chunk := payload[i:min(i+kittyChunkSize, len(payload))]
+		more := 0
+		if i+kittyChunkSize < len(payload) {
+			more = 1
+		}
+		if i == 0 {
+			// q=2 suppresses responses, which would otherwise end up in our
+			// input stream; C=1 keeps the cursor where it is
+			fmt.Fprintf(&sb, "\x1b_Gf=100,a=T,i=%d,c=%d,r=%d,q=2,C=1,m=%d;%s\x1b\\", id, cols, rows, more, chunk)
+		} else {
+			fmt.Fprintf(&sb, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	return sb.String(), nil
+}
+
+func toPNG(content []byte) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeITerm2(content []byte, cols int, rows int) string {
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=%d;preserveAspectRatio=1:%s\a",
+		len(content), cols, rows, base64.StdEncoding.EncodeToString(content))
+}
+
+// Delete returns the escape sequence that removes a previously drawn image.
+// iTerm2 draws images into the cells themselves, so for that protocol the
+// caller needs to redraw the affected cells instead.
+func Delete(protocol Protocol, id int) string {
+	if protocol == ProtocolKitty {
+		return fmt.Sprintf("\x1b_Ga=d,d=I,i=%d,q=2\x1b\\", id)
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package imagepreview
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodedImage(t *testing.T, width int, height int, encode func(*bytes.Buffer, image.Image) error) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func pngImage(t *testing.T, width int, height int) []byte {
+	return encodedImage(t, width, height, func(buf *bytes.Buffer, img image.Image) error {
+		return png.Encode(buf, img)
+	})
+}
+
+func jpegImage(t *testing.T, width int, height int) []byte {
+	return encodedImage(t, width, height, func(buf *bytes.Buffer, img image.Image) error {
+		return jpeg.Encode(buf, img, nil)
+	})
+}
+
+func TestIsImagePath(t *testing.T) {
+	assert.True(t, IsImagePath("logo.png"))
+	assert.True(t, IsImagePath("docs/photo.JPG"))
+	assert.True(t, IsImagePath("a.jpeg"))
+	assert.True(t, IsImagePath("anim.gif"))
+	assert.False(t, IsImagePath("main.go"))
+	assert.False(t, IsImagePath("png"))
+	assert.False(t, IsImagePath("icon.svg"))
+}
+
+func TestDetectProtocol(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setting  string
+		env      map[string]string
+		expected Protocol
+	}{
+		{name: "none", setting: "none", env: map[string]string{"KITTY_WINDOW_ID": "1"}, expected: ProtocolNone},
+		{name: "forced kitty", setting: "kitty", env: map[string]string{}, expected: ProtocolKitty},
+		{name: "forced iterm2", setting: "iterm2", env: map[string]string{}, expected: ProtocolITerm2},
+		{name: "auto in kitty", setting: "auto", env: map[string]string{"KITTY_WINDOW_ID": "1"}, expected: ProtocolKitty},
+		{name: "auto with kitty TERM", setting: "auto", env: map[string]string{"TERM": "xterm-kitty"}, expected: ProtocolKitty},
+		{name: "auto in iTerm2", setting: "auto", env: map[string]string{"TERM_PROGRAM": "iTerm.app"}, expected: ProtocolITerm2},
+		{name: "auto in WezTerm", setting: "auto", env: map[string]string{"TERM_PROGRAM": "WezTerm"}, expected: ProtocolITerm2},
+		{name: "auto elsewhere", setting: "auto", env: map[string]string{"TERM": "xterm-256color"}, expected: ProtocolNone},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			getenv := func(key string) string { return s.env[key] }
+			assert.Equal(t, s.expected, DetectProtocol(s.setting, getenv))
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	content := pngImage(t, 64, 32)
+	assert.Regexp(t, `^PNG, 64x32 pixels, \d+ B$`, Describe(content))
+
+	assert.Equal(t, "5 B", Describe([]byte("hello")))
+	assert.Equal(t, "2.0 KB", Describe(make([]byte, 2048)))
+	assert.Equal(t, "1.5 MB", Describe(make([]byte, 3*1024*1024/2)))
+}
+
+func TestFitToCells(t *testing.T) {
+	scenarios := []struct {
+		name         string
+		width        int
+		height       int
+		maxCols      int
+		maxRows      int
+		expectedCols int
+		expectedRows int
+	}{
+		{name: "small image is not scaled up", width: 32, height: 32, maxCols: 80, maxRows: 40, expectedCols: 4, expectedRows: 2},
+		{name: "wide image is limited by columns", width: 1600, height: 400, maxCols: 80, maxRows: 40, expectedCols: 80, expectedRows: 10},
+		{name: "tall image is limited by rows", width: 400, height: 1600, maxCols: 80, maxRows: 20, expectedCols: 10, expectedRows: 20},
+		{name: "no space", width: 400, height: 400, maxCols: 0, maxRows: 20, expectedCols: 0, expectedRows: 0},
+		{name: "invalid image size", width: 0, height: 400, maxCols: 80, maxRows: 20, expectedCols: 0, expectedRows: 0},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			cols, rows := FitToCells(s.width, s.height, s.maxCols, s.maxRows)
+			assert.Equal(t, s.expectedCols, cols)
+			assert.Equal(t, s.expectedRows, rows)
+		})
+	}
+}
+
+func TestEncodeKitty(t *testing.T) {
+	content := pngImage(t, 10, 10)
+	encoded, err := Encode(ProtocolKitty, content, 7, 4, 2)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"\x1b_Gf=100,a=T,i=7,c=4,r=2,q=2,C=1,m=0;"+base64.StdEncoding.EncodeToString(content)+"\x1b\\",
+		encoded)
+}
+
+func TestEncodeKittyChunksLargePayloads(t *testing.T) {
+	content := make([]byte, 7000)
+	copy(content, pngImage(t, 1, 1))
+	// Not a valid PNG anymore after the header, but DecodeConfig only looks
+	// at the header, and the payload is passed through unchanged
+	encoded, err := Encode(ProtocolKitty, content, 1, 1, 1)
+	assert.NoError(t, err)
+
+	payload := base64.StdEncoding.EncodeToString(content)
+	assert.Equal(t,
+		"\x1b_Gf=100,a=T,i=1,c=1,r=1,q=2,C=1,m=1;"+payload[:4096]+"\x1b\\"+
+			"\x1b_Gm=1;"+payload[4096:8192]+"\x1b\\"+
+			"\x1b_Gm=0;"+payload[8192:]+"\x1b\\",
+		encoded)
+}
+
+func TestEncodeKittyConvertsToPNG(t *testing.T) {
+	encoded, err := Encode(ProtocolKitty, jpegImage(t, 10, 10), 1, 1, 1)
+	assert.NoError(t, err)
+
+	payload := strings.TrimSuffix(encoded[strings.Index(encoded, ";")+1:], "\x1b\\")
+	decoded, err := base64.StdEncoding.DecodeString(payload)
+	assert.NoError(t, err)
+	_, format, err := image.DecodeConfig(bytes.NewReader(decoded))
+	assert.NoError(t, err)
+	assert.Equal(t, "png", format)
+}
+
+func TestEncodeKittyRejectsInvalidImages(t *testing.T) {
+	_, err := Encode(ProtocolKitty, []byte("not an image"), 1, 1, 1)
+	assert.Error(t, err)
+}
+
+func TestEncodeITerm2(t *testing.T) {
+	content := []byte("image data")
+	encoded, err := Encode(ProtocolITerm2, content, 1, 20, 10)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"\x1b]1337;File=inline=1;size=10;width=20;height=10;preserveAspectRatio=1:aW1hZ2UgZGF0YQ==\a",
+		encoded)
+}
+
+func TestDelete(t *testing.T) {
+	assert.Equal(t, "\x1b_Ga=d,d=I,i=3,q=2\x1b\\", Delete(ProtocolKitty, 3))
+	assert.Equal(t, "", Delete(ProtocolITerm2, 3))
+}
@@ -3,6 +3,7 @@ package gui
 import (
 	"fmt"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -13,13 +14,18 @@ func (gui *Gui) informationStr() string {
 		return activeMode.InfoLabel()
 	}
 
+	version := gui.Config.GetVersion()
+	if gui.git.Config.WillSignNewCommit(git_commands.CommitSigningDefault) {
+		version = fmt.Sprintf("%s %s", style.FgGreen.Sprint(gui.c.Tr.SigningCommits), version)
+	}
+
 	if gui.g.Mouse {
 		donate := style.FgMagenta.Sprint(style.PrintHyperlink(gui.c.Tr.Donate, constants.Links.Donate))
 		askQuestion := style.FgYellow.Sprint(style.PrintHyperlink(gui.c.Tr.AskQuestion, constants.Links.Discussions))
-		return fmt.Sprintf("%s %s %s", donate, askQuestion, gui.Config.GetVersion())
+		return fmt.Sprintf("%s %s %s", donate, askQuestion, version)
 	}
 
-	return gui.Config.GetVersion()
+	return version
 }
 
 func (gui *Gui) handleInfoClick() error {
@@ -85,6 +85,21 @@ func (gui *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBin
 			Handler:     opts.Guards.NoPopupPanel(gui.helpers.Repos.CreateRecentReposMenu),
 			Description: gui.c.Tr.SwitchRepo,
 		},
+		{
+			ViewName:    "",
+			Key:         opts.GetKey(opts.Config.Universal.OpenRepoTabs),
+			Handler:     opts.Guards.NoPopupPanel(gui.helpers.Repos.CreateRepoTabsMenu),
+			Description: gui.c.Tr.SwitchRepoTab,
+			Tooltip:     gui.c.Tr.SwitchRepoTabTooltip,
+			OpensMenu:   true,
+		},
+		{
+			ViewName:    "",
+			Key:         opts.GetKey(opts.Config.Universal.TogglePerformanceHud),
+			Handler:     gui.togglePerformanceHud,
+			Description: gui.c.Tr.TogglePerformanceHud,
+			Tooltip:     gui.c.Tr.TogglePerformanceHudTooltip,
+		},
 		{
 			ViewName:    "",
 			Key:         opts.GetKey(opts.Config.Universal.ScrollUpMain),
@@ -470,6 +485,16 @@ func (gui *Gui) resetKeybindings() error {
 		}
 	}
 
+	// The main view only has tabs when showing a commit, where they switch
+	// between its patch and its details
+	if err := gui.g.SetTabClickBinding("main", func(tabIndex int) error {
+		gui.ShowCommitDetails = tabIndex == 1
+		gui.c.Context().CurrentSide().HandleRenderToMain()
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,129 @@
+package keybindings
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/generics/maps"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
+)
+
+// BindingSection is a group of keybindings as shown in the cheatsheet, e.g.
+// all the keybindings of the files view
+type BindingSection struct {
+	Title    string
+	Bindings []*types.Binding
+}
+
+type header struct {
+	// priority decides the order of the headers in the cheatsheet (lower means higher)
+	priority int
+	title    string
+}
+
+type headerWithBindings struct {
+	header   header
+	bindings []*types.Binding
+}
+
+func localisedTitle(tr *i18n.TranslationSet, str string) string {
+	contextTitleMap := map[string]string{
+		"global":            tr.GlobalTitle,
+		"navigation":        tr.NavigationTitle,
+		"branches":          tr.BranchesTitle,
+		"localBranches":     tr.LocalBranchesTitle,
+		"files":             tr.FilesTitle,
+		"status":            tr.StatusTitle,
+		"submodules":        tr.SubmodulesTitle,
+		"subCommits":        tr.SubCommitsTitle,
+		"remoteBranches":    tr.RemoteBranchesTitle,
+		"remotes":           tr.RemotesTitle,
+		"reflogCommits":     tr.ReflogCommitsTitle,
+		"tags":              tr.TagsTitle,
+		"commitFiles":       tr.CommitFilesTitle,
+		"fileHistory":       tr.FileHistoryTitle,
+		"commitMessage":     tr.CommitSummaryTitle,
+		"commitDescription": tr.CommitDescriptionTitle,
+		"commits":           tr.CommitsTitle,
+		"confirmation":      tr.ConfirmationTitle,
+		"prompt":            tr.PromptTitle,
+		"information":       tr.InformationTitle,
+		"main":              tr.NormalTitle,
+		"patchBuilding":     tr.PatchBuildingTitle,
+		"mergeConflicts":    tr.MergingTitle,
+		"blame":             tr.BlameTitle,
+		"staging":           tr.StagingTitle,
+		"menu":              tr.MenuTitle,
+		"search":            tr.SearchTitle,
+		"secondary":         tr.SecondaryTitle,
+		"stash":             tr.StashTitle,
+		"suggestions":       tr.SuggestionsCheatsheetTitle,
+		"extras":            tr.ExtrasTitle,
+		"worktrees":         tr.WorktreesTitle,
+	}
+
+	// Custom commands can be bound to views that don't have a title here; use
+	// the view name for those
+	return lo.ValueOr(contextTitleMap, str, str)
+}
+
+// GetBindingSections groups the given keybindings by the view they apply to,
+// with the navigation and global keybindings last. Keybindings without a
+// description are left out.
+func GetBindingSections(bindings []*types.Binding, tr *i18n.TranslationSet) []*BindingSection {
+	excludedViews := []string{"stagingSecondary", "patchBuildingSecondary"}
+	bindingsToDisplay := lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
+		if lo.Contains(excludedViews, binding.ViewName) {
+			return false
+		}
+
+		return (binding.Description != "" || binding.Alternative != "") && binding.Key != nil
+	})
+
+	bindingsByHeader := lo.GroupBy(bindingsToDisplay, func(binding *types.Binding) header {
+		return getHeader(binding, tr)
+	})
+
+	bindingGroups := maps.MapToSlice(
+		bindingsByHeader,
+		func(header header, hBindings []*types.Binding) headerWithBindings {
+			uniqBindings := lo.UniqBy(hBindings, func(binding *types.Binding) string {
+				return binding.Description + LabelFromKey(binding.Key)
+			})
+
+			return headerWithBindings{
+				header:   header,
+				bindings: uniqBindings,
+			}
+		},
+	)
+
+	slices.SortFunc(bindingGroups, func(a, b headerWithBindings) int {
+		if a.header.priority != b.header.priority {
+			return cmp.Compare(b.header.priority, a.header.priority)
+		}
+		return strings.Compare(a.header.title, b.header.title)
+	})
+
+	return lo.Map(bindingGroups, func(hb headerWithBindings, _ int) *BindingSection {
+		return &BindingSection{
+			Title:    hb.header.title,
+			Bindings: hb.bindings,
+		}
+	})
+}
+
+func getHeader(binding *types.Binding, tr *i18n.TranslationSet) header {
+	if binding.Tag == "navigation" {
+		return header{priority: 2, title: localisedTitle(tr, "navigation")}
+	}
+
+	if binding.ViewName == "" {
+		return header{priority: 3, title: localisedTitle(tr, "global")}
+	}
+
+	return header{priority: 1, title: localisedTitle(tr, binding.ViewName)}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
@@ -134,6 +135,10 @@ func (gui *Gui) layout(g *gocui.Gui) error {
 
 	gui.Views.Tooltip.Visible = gui.Views.Menu.Visible && gui.Views.Tooltip.Buffer() != ""
 
+	if err := gui.layoutPerformanceHud(width, height); err != nil {
+		return err
+	}
+
 	for _, context := range gui.transientContexts() {
 		view, err := gui.g.View(context.GetViewName())
 		if err != nil && !errors.Is(err, gocui.ErrUnknownView) {
@@ -178,6 +183,11 @@ func (gui *Gui) layout(g *gocui.Gui) error {
 		context.HandleRender()
 	}
 
+	gui.rerenderSideBySideDiffsIfResized()
+
+	gui.updateScrollPositionFooters()
+	gui.helpers.Accessibility.AnnounceFocus()
+
 	// here is a good place log some stuff
 	// if you run `lazygit --logs`
 	// this will let you see these branches as prettified json
@@ -279,3 +289,21 @@ func (gui *Gui) transientContexts() []types.Context {
 		return context.IsTransient()
 	})
 }
+
+// The content of the main views can be much longer than the view (e.g. a big
+// diff), so we show how far the user has scrolled through it in the footer,
+// similar to the '5 of 20' footer of list views.
+func (gui *Gui) updateScrollPositionFooters() {
+	for _, context := range gui.State.Contexts.Flatten() {
+		if context.GetKind() != types.MAIN_CONTEXT {
+			continue
+		}
+
+		view := context.GetView()
+		if !view.Visible {
+			continue
+		}
+
+		view.Footer = presentation.ScrollPosition(view.OriginY(), view.InnerHeight(), view.ViewLinesHeight())
+	}
+}
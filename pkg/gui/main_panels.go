@@ -1,15 +1,25 @@
 package gui
 
 import (
+	"fmt"
+	"os/exec"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
 func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
+	gui.setImagePreviews(view, nil)
+	gui.setStickyDiffHeaders(view, task)
+	gui.forgetSideBySideDiff(view)
+
 	switch v := task.(type) {
 	case *types.RenderStringTask:
 		return gui.newStringTask(view, v.Str)
 
+	case *types.RenderImagePreviewTask:
+		return gui.newImagePreviewTask(view, v)
+
 	case *types.RenderStringWithoutScrollTask:
 		return gui.newStringTaskWithoutScroll(view, v.Str)
 
@@ -20,12 +30,51 @@ func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
 		return gui.newCmdTask(view, v.Cmd, v.Prefix)
 
 	case *types.RunPtyTask:
+		gui.handleMissingBlobs(v, view)
+		if v.IsDiff && gui.c.UserConfig().Gui.DiffMode == "side-by-side" && gui.canCacheMainViewOutput() {
+			return gui.newSideBySideDiffTask(view, v.Cmd, v.Prefix)
+		}
+		if v.CacheKey != "" && gui.canCacheMainViewOutput() {
+			// Cached outputs are rendered without a pty, so we need to tell
+			// git the width of the view; see setCmdOutputWidth. The live
+			// command and the prefetched ones must see the same width, so
+			// that the cached outputs look like what we would render live.
+			width := view.InnerWidth()
+			cacheKey := fmt.Sprintf("%s-width-%d", v.CacheKey, width)
+			for _, cmd := range append([]*exec.Cmd{v.Cmd}, v.Prefetch...) {
+				setCmdOutputWidth(cmd, width)
+			}
+			gui.prefetchMainViewOutputs(v.Prefetch, cacheKey)
+			return gui.newCachedCmdTask(view, v.Cmd, v.Prefix, cacheKey)
+		}
 		return gui.newPtyTask(view, v.Cmd, v.Prefix)
 	}
 
 	return nil
 }
 
+// Only diffs have headers that make sense to pin to the top of the view; in
+// other output (e.g. a commit message), a line starting with "@@" is just text
+func (gui *Gui) setStickyDiffHeaders(view *gocui.View, task types.UpdateTask) {
+	ptyTask, ok := task.(*types.RunPtyTask)
+	if ok && ptyTask.IsDiff && gui.c.UserConfig().Gui.StickyDiffHeaders &&
+		(view == gui.Views.Main || view == gui.Views.Secondary) {
+		view.SetStickyHeaderLevel(diffStickyHeaderLevel)
+	} else {
+		view.SetStickyHeaderLevel(nil)
+	}
+}
+
+// The output of a pager or external diff command may depend on the size of
+// the view, so we only cache git's own output. For the same reason, this is
+// also when we can render diffs side by side ourselves.
+func (gui *Gui) canCacheMainViewOutput() bool {
+	pagerConfig := gui.stateAccessor.GetPagerConfig()
+	return pagerConfig.GetPagerCommand(0) == "" &&
+		pagerConfig.GetExternalDiffCommand() == "" &&
+		!pagerConfig.GetUseExternalDiffGitConfig()
+}
+
 func (gui *Gui) moveMainContextPairToTop(pair types.MainContextPair) {
 	gui.moveMainContextToTop(pair.Main)
 	if pair.Secondary != nil {
@@ -63,6 +112,8 @@ func (gui *Gui) RefreshMainView(opts *types.ViewUpdateOpts, context types.Contex
 	}
 
 	view.Subtitle = opts.SubTitle
+	view.Tabs = opts.Tabs
+	view.TabIndex = opts.TabIndex
 
 	if err := gui.runTaskForView(view, opts.Task); err != nil {
 		gui.c.Log.Error(err)
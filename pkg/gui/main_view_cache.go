@@ -0,0 +1,204 @@
+package gui
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// Don't cache outputs larger than this; they take long to render anyway, and
+// we don't want to hold on to huge diffs
+const maxCachedMainViewOutputSize = 1024 * 1024
+
+// When the outputs in the cache take up more than this, we evict the least
+// recently used ones
+const maxMainViewCacheSize = 32 * 1024 * 1024
+
+// Caches the output of commands rendered to the main views (see
+// types.RunPtyTask.CacheKey), so that moving the selection up and down a list
+// doesn't re-run `git diff` or `git show` for content that hasn't changed.
+type mainViewCache struct {
+	mutex deadlock.Mutex
+	// Elements are *mainViewCacheEntry, most recently used first
+	entries *list.List
+	byKey   map[string]*list.Element
+	size    int
+
+	// Incremented whenever a new prefetch starts, so that older ones can stop
+	// early
+	prefetchGeneration int
+}
+
+type mainViewCacheEntry struct {
+	key    string
+	output string
+}
+
+func newMainViewCache() *mainViewCache {
+	return &mainViewCache{
+		entries: list.New(),
+		byKey:   map[string]*list.Element{},
+	}
+}
+
+func mainViewCacheKey(cacheKey string, cmd *exec.Cmd) string {
+	return cacheKey + "\x00" + strings.Join(cmd.Args, " ")
+}
+
+func (self *mainViewCache) get(key string) (string, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	element, ok := self.byKey[key]
+	if !ok {
+		return "", false
+	}
+
+	self.entries.MoveToFront(element)
+	return element.Value.(*mainViewCacheEntry).output, true
+}
+
+func (self *mainViewCache) contains(key string) bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	_, ok := self.byKey[key]
+	return ok
+}
+
+func (self *mainViewCache) set(key string, output string) {
+	if len(output) > maxCachedMainViewOutputSize {
+		return
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if element, ok := self.byKey[key]; ok {
+		entry := element.Value.(*mainViewCacheEntry)
+		self.size += len(output) - len(entry.output)
+		entry.output = output
+		self.entries.MoveToFront(element)
+	} else {
+		self.byKey[key] = self.entries.PushFront(&mainViewCacheEntry{key: key, output: output})
+		self.size += len(output)
+	}
+
+	for self.size > maxMainViewCacheSize {
+		oldest := self.entries.Back()
+		entry := oldest.Value.(*mainViewCacheEntry)
+		self.entries.Remove(oldest)
+		delete(self.byKey, entry.key)
+		self.size -= len(entry.output)
+	}
+}
+
+// Removes the outputs of the given command, no matter which cache key they
+// were stored with
+func (self *mainViewCache) removeCommand(args []string) {
+	suffix := "\x00" + strings.Join(args, " ")
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for key, element := range self.byKey {
+		if strings.HasSuffix(key, suffix) {
+			self.entries.Remove(element)
+			delete(self.byKey, key)
+			self.size -= len(element.Value.(*mainViewCacheEntry).output)
+		}
+	}
+}
+
+func (self *mainViewCache) startPrefetch() int {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.prefetchGeneration++
+	return self.prefetchGeneration
+}
+
+func (self *mainViewCache) isPrefetchStale(generation int) bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return generation != self.prefetchGeneration
+}
+
+// Runs the given commands in the background and adds their output to the main
+// view cache, so that rendering them later is instant. A new prefetch cancels
+// the remaining commands of the previous one, because when the user moves the
+// selection quickly, we only care about the items around the latest one.
+func (gui *Gui) prefetchMainViewOutputs(cmds []*exec.Cmd, cacheKey string) {
+	generation := gui.mainViewCache.startPrefetch()
+	if len(cmds) == 0 {
+		return
+	}
+
+	gui.c.OnWorker(func(gocui.Task) error {
+		gui.mainViewCache.prefetch(generation, cmds, cacheKey, func(cmd *exec.Cmd) ([]byte, error) {
+			output, err := cmd.Output()
+			if err != nil {
+				gui.c.Log.Warnf("Error prefetching main view output of %v: %v", cmd.Args, err)
+			}
+			return output, err
+		})
+		return nil
+	})
+}
+
+// Runs the commands of the prefetch with the given generation (as returned by
+// startPrefetch) one after another, and caches their outputs. Stops as soon as
+// a newer prefetch has started.
+func (self *mainViewCache) prefetch(generation int, cmds []*exec.Cmd, cacheKey string, run func(*exec.Cmd) ([]byte, error)) {
+	for _, cmd := range cmds {
+		if self.isPrefetchStale(generation) {
+			return
+		}
+
+		key := mainViewCacheKey(cacheKey, cmd)
+		if self.contains(key) {
+			continue
+		}
+
+		output, err := run(cmd)
+		if err != nil {
+			continue
+		}
+
+		self.set(key, normalizeCmdOutput(output))
+	}
+}
+
+// Without a terminal, git lays out things like the diffstat for 80 columns
+// (or whatever $COLUMNS says); we want them to fit the view instead. Since the
+// output then depends on the width, so does the cache key.
+func setCmdOutputWidth(cmd *exec.Cmd, width int) {
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("COLUMNS=%d", width))
+}
+
+// Splits the output into lines the same way that cmd tasks do when streaming
+// it into a view, so that cached outputs look the same no matter whether they
+// were prefetched or captured while rendering
+func normalizeCmdOutput(output []byte) string {
+	var result strings.Builder
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Split(utils.ScanLinesAndTruncateWhenLongerThanBuffer(bufio.MaxScanTokenSize))
+	for scanner.Scan() {
+		result.Write(scanner.Bytes())
+		result.WriteByte('\n')
+	}
+
+	return result.String()
+}
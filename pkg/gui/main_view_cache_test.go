@@ -0,0 +1,123 @@
+package gui
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMainViewCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newMainViewCache()
+	output := strings.Repeat("x", maxCachedMainViewOutputSize)
+	entryCount := maxMainViewCacheSize / maxCachedMainViewOutputSize
+
+	for i := range entryCount {
+		cache.set(string(rune('a'+i)), output)
+	}
+
+	// Using the oldest entry makes it the most recently used one
+	_, ok := cache.get("a")
+	assert.True(t, ok)
+
+	cache.set("new", output)
+
+	assert.True(t, cache.contains("a"))
+	assert.False(t, cache.contains("b"))
+	assert.True(t, cache.contains("c"))
+	assert.True(t, cache.contains("new"))
+	assert.Equal(t, maxMainViewCacheSize, cache.size)
+}
+
+func TestMainViewCacheDoesNotCacheHugeOutputs(t *testing.T) {
+	cache := newMainViewCache()
+	cache.set("key", strings.Repeat("x", maxCachedMainViewOutputSize+1))
+
+	assert.False(t, cache.contains("key"))
+	assert.Equal(t, 0, cache.size)
+}
+
+func TestMainViewCacheInvalidation(t *testing.T) {
+	cache := newMainViewCache()
+	show := exec.Command("git", "show", "abc")
+	diff := exec.Command("git", "diff", "abc")
+
+	cache.set(mainViewCacheKey("refs-1", show), "show output")
+	cache.set(mainViewCacheKey("refs-1", diff), "diff output")
+
+	// A refresh of the refs changes the cache key, so the old outputs are
+	// not used anymore
+	_, ok := cache.get(mainViewCacheKey("refs-2", show))
+	assert.False(t, ok)
+	output, ok := cache.get(mainViewCacheKey("refs-1", show))
+	assert.True(t, ok)
+	assert.Equal(t, "show output", output)
+
+	// Removing a command removes its outputs for all cache keys
+	cache.set(mainViewCacheKey("refs-2", show), "newer show output")
+	cache.removeCommand(show.Args)
+	assert.False(t, cache.contains(mainViewCacheKey("refs-1", show)))
+	assert.False(t, cache.contains(mainViewCacheKey("refs-2", show)))
+	assert.True(t, cache.contains(mainViewCacheKey("refs-1", diff)))
+	assert.Equal(t, len("diff output"), cache.size)
+}
+
+func TestMainViewCachePrefetch(t *testing.T) {
+	cache := newMainViewCache()
+	cmds := []*exec.Cmd{
+		exec.Command("git", "show", "a"),
+		exec.Command("git", "show", "b"),
+		exec.Command("git", "show", "c"),
+	}
+	cache.set(mainViewCacheKey("key", cmds[1]), "cached b\n")
+
+	ran := []string{}
+	run := func(cmd *exec.Cmd) ([]byte, error) {
+		hash := cmd.Args[2]
+		ran = append(ran, hash)
+		if hash == "c" {
+			return nil, errors.New("error")
+		}
+		return []byte("output " + hash + "\r\nline 2"), nil
+	}
+
+	cache.prefetch(cache.startPrefetch(), cmds, "key", run)
+
+	// The already cached command isn't run again
+	assert.Equal(t, []string{"a", "c"}, ran)
+	output, _ := cache.get(mainViewCacheKey("key", cmds[0]))
+	assert.Equal(t, "output a\nline 2\n", output)
+	assert.False(t, cache.contains(mainViewCacheKey("key", cmds[2])))
+}
+
+func TestMainViewCacheStopsStalePrefetch(t *testing.T) {
+	cache := newMainViewCache()
+	cmds := []*exec.Cmd{
+		exec.Command("git", "show", "a"),
+		exec.Command("git", "show", "b"),
+	}
+
+	generation := cache.startPrefetch()
+	ran := []string{}
+	cache.prefetch(generation, cmds, "key", func(cmd *exec.Cmd) ([]byte, error) {
+		ran = append(ran, cmd.Args[2])
+		// The user selected another item while we were running the first
+		// command
+		cache.startPrefetch()
+		return []byte("output"), nil
+	})
+
+	assert.Equal(t, []string{"a"}, ran)
+	assert.False(t, cache.contains(mainViewCacheKey("key", cmds[1])))
+}
+
+func TestSetCmdOutputWidth(t *testing.T) {
+	cmd := exec.Command("git", "show")
+	setCmdOutputWidth(cmd, 120)
+
+	assert.Equal(t, "COLUMNS=120", cmd.Env[len(cmd.Env)-1])
+	// The rest of the environment is kept
+	assert.Greater(t, len(cmd.Env), 1)
+}
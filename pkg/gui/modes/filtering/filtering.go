@@ -1,22 +1,32 @@
 package filtering
 
+import "github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+
 type Filtering struct {
 	path               string // the filename that gets passed to git log
 	author             string // the author that gets passed to git log
+	since              string // the date passed to git log's --since
+	until              string // the date passed to git log's --until
+	content            string // the string passed to git log's -S, or the regex passed to -G
+	contentIsRegex     bool
 	selectedCommitHash string // the commit that was selected before we entered filtering mode
 }
 
-func New(path string, author string) Filtering {
-	return Filtering{path: path, author: author}
+func New(path string, author string, since string, until string) Filtering {
+	return Filtering{path: path, author: author, since: since, until: until}
 }
 
 func (m *Filtering) Active() bool {
-	return m.path != "" || m.author != ""
+	return m.path != "" || m.author != "" || m.since != "" || m.until != "" || m.content != ""
 }
 
 func (m *Filtering) Reset() {
 	m.path = ""
 	m.author = ""
+	m.since = ""
+	m.until = ""
+	m.content = ""
+	m.contentIsRegex = false
 }
 
 func (m *Filtering) SetPath(path string) {
@@ -35,6 +45,39 @@ func (m *Filtering) GetAuthor() string {
 	return m.author
 }
 
+func (m *Filtering) SetSince(since string) {
+	m.since = since
+}
+
+func (m *Filtering) GetSince() string {
+	return m.since
+}
+
+func (m *Filtering) SetUntil(until string) {
+	m.until = until
+}
+
+func (m *Filtering) GetUntil() string {
+	return m.until
+}
+
+func (m *Filtering) SetContent(content string, isRegex bool) {
+	m.content = content
+	m.contentIsRegex = isRegex
+}
+
+func (m *Filtering) GetContent() string {
+	return m.content
+}
+
+func (m *Filtering) ContentIsRegex() bool {
+	return m.contentIsRegex
+}
+
+func (m *Filtering) ContentFilter() git_commands.ContentFilter {
+	return git_commands.ContentFilter{Content: m.content, IsRegex: m.contentIsRegex}
+}
+
 func (m *Filtering) SetSelectedCommitHash(hash string) {
 	m.selectedCommitHash = hash
 }
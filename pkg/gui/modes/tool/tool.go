@@ -0,0 +1,40 @@
+package tool
+
+// Set when git has started lazygit as its difftool or mergetool (see `lazygit
+// difftool` and `lazygit mergetool`), in which case we show the files that git
+// gave us and quit once the user is done with them.
+type Tool struct {
+	// the two files to compare when we're the difftool
+	left  string
+	right string
+	// the file whose conflicts to resolve when we're the mergetool
+	merged string
+}
+
+func NewDifftool(left string, right string) Tool {
+	return Tool{left: left, right: right}
+}
+
+func NewMergetool(merged string) Tool {
+	return Tool{merged: merged}
+}
+
+func (m *Tool) Active() bool {
+	return m.IsDifftool() || m.IsMergetool()
+}
+
+func (m *Tool) IsDifftool() bool {
+	return m.left != ""
+}
+
+func (m *Tool) IsMergetool() bool {
+	return m.merged != ""
+}
+
+func (m *Tool) GetDiffPaths() (string, string) {
+	return m.left, m.right
+}
+
+func (m *Tool) GetMergedPath() string {
+	return m.merged
+}
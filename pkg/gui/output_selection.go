@@ -0,0 +1,38 @@
+package gui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// When lazygit is run with --output-selection, we write the selected items of
+// the focused side panel (e.g. branch names, commit hashes or file paths) to
+// the given file, or to stdout if it's "-", on exit. That way, scripts can use
+// lazygit to let the user pick something.
+func (gui *Gui) outputSelection(path string) error {
+	content := ""
+	if ids := gui.selectedItemIds(); len(ids) > 0 {
+		content = strings.Join(ids, "\n") + "\n"
+	}
+
+	if path == "-" {
+		_, err := fmt.Fprint(os.Stdout, content)
+		return err
+	}
+
+	return gui.os.CreateFileWithContent(path, content)
+}
+
+// With a range selection, these are the IDs of all selected items
+func (gui *Gui) selectedItemIds() []string {
+	listContext, ok := gui.c.Context().CurrentSide().(types.IListContext)
+	if !ok {
+		return nil
+	}
+
+	ids, _, _ := listContext.GetSelectedItemIds()
+	return ids
+}
@@ -0,0 +1,156 @@
+package gui
+
+import (
+	"io"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Tells git not to download missing objects from the promisor remote of a
+// partial clone
+const noLazyFetchEnvVar = "GIT_NO_LAZY_FETCH=1"
+
+// Git prints this warning when it would have downloaded missing objects, but
+// GIT_NO_LAZY_FETCH told it not to
+const lazyFetchDisabledWarning = "lazy fetching disabled"
+
+// Prepares a task that renders to a main view for running in a partial clone,
+// according to the git.fetchMissingBlobs config. Without this, viewing an old
+// file or a big diff can block the main view while git downloads the blobs it
+// needs one after another.
+func (gui *Gui) handleMissingBlobs(task *types.RunPtyTask, view *gocui.View) {
+	if !gui.git.Config.IsPartialClone() {
+		return
+	}
+
+	mode := gui.c.UserConfig().Git.FetchMissingBlobs
+	if mode == "onDemand" {
+		gui.warnAboutLazyFetching()
+		return
+	}
+
+	env := task.Cmd.Env
+	task.Cmd.Env = append(slices.Clip(env), noLazyFetchEnvVar)
+
+	switch mode {
+	case "background":
+		// Prefetching the outputs of neighbouring items may still download
+		// missing blobs, since that happens in the background anyway
+		gui.fetchMissingBlobsInBackground(task.Cmd.Args, task.Cmd.Dir, env, view)
+	case "never":
+		for _, cmd := range task.Prefetch {
+			cmd.Env = append(slices.Clip(cmd.Env), noLazyFetchEnvVar)
+		}
+	}
+}
+
+func (gui *Gui) warnAboutLazyFetching() {
+	repoPath := gui.git.RepoPaths.RepoPath()
+
+	gui.partialCloneMutex.Lock()
+	defer gui.partialCloneMutex.Unlock()
+
+	if gui.lazyFetchingWarningShown[repoPath] {
+		return
+	}
+	gui.lazyFetchingWarningShown[repoPath] = true
+
+	gui.c.WarningToast(gui.c.Tr.PartialCloneLazyFetchWarning)
+}
+
+// Runs the command without downloading missing blobs to see whether there are
+// any, and if so, runs it again letting git download them. Once they're there,
+// the main view is rendered again if it still shows the same command.
+func (gui *Gui) fetchMissingBlobsInBackground(args []string, dir string, env []string, view *gocui.View) {
+	cmdStr := strings.Join(args, " ")
+
+	gui.partialCloneMutex.Lock()
+	if gui.missingBlobFetches[cmdStr] {
+		gui.partialCloneMutex.Unlock()
+		return
+	}
+	gui.missingBlobFetches[cmdStr] = true
+	gui.partialCloneMutex.Unlock()
+
+	newCmd := func(env []string) *exec.Cmd {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = dir
+		cmd.Env = env
+		return cmd
+	}
+
+	gui.c.OnWorker(func(task gocui.Task) error {
+		defer func() {
+			gui.partialCloneMutex.Lock()
+			delete(gui.missingBlobFetches, cmdStr)
+			gui.partialCloneMutex.Unlock()
+		}()
+
+		fetched, err := fetchMissingBlobs(newCmd, env, runForMissingBlobs, func(f func() error) error {
+			return gui.helpers.AppStatus.WithWaitingStatusImpl(gui.c.Tr.FetchingMissingBlobsStatus, func(gocui.Task) error {
+				return f()
+			}, task)
+		})
+		if err != nil {
+			gui.c.Log.Warnf("Error fetching missing blobs for %s: %v", cmdStr, err)
+			return nil
+		}
+		if !fetched {
+			return nil
+		}
+
+		// The output that we rendered may have been cached, and it lacks
+		// the content that was missing
+		gui.mainViewCache.removeCommand(args)
+
+		gui.onUIThread(func() error {
+			if gui.getManager(view).GetTaskKey() == cmdStr {
+				gui.c.PostRefreshUpdate(gui.c.Context().CurrentSide())
+			}
+			return nil
+		})
+		return nil
+	})
+}
+
+// Runs the command with lazy fetching disabled to find out whether it needs
+// blobs that are missing locally, and if so, runs it again inside withStatus so
+// that git downloads them. Returns whether it downloaded any.
+func fetchMissingBlobs(
+	newCmd func(env []string) *exec.Cmd,
+	env []string,
+	run func(cmd *exec.Cmd, discardOutput bool) (string, error),
+	withStatus func(f func() error) error,
+) (bool, error) {
+	output, _ := run(newCmd(append(slices.Clip(env), noLazyFetchEnvVar)), false)
+	if !isMissingBlobsOutput(output) {
+		return false, nil
+	}
+
+	err := withStatus(func() error {
+		_, err := run(newCmd(env), true)
+		return err
+	})
+	return err == nil, err
+}
+
+func runForMissingBlobs(cmd *exec.Cmd, discardOutput bool) (string, error) {
+	if discardOutput {
+		cmd.Stdout = io.Discard
+		return "", cmd.Run()
+	}
+
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+// Whether the output of a command that was run with lazy fetching disabled
+// says that the command needed blobs that are missing locally. Such output is
+// incomplete, so we must not cache it.
+func isMissingBlobsOutput(output string) bool {
+	return strings.Contains(output, lazyFetchDisabledWarning)
+}
@@ -0,0 +1,119 @@
+package gui
+
+import (
+	"errors"
+	"os/exec"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMissingBlobsOutput(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		output   string
+		expected bool
+	}{
+		{
+			name:     "complete output",
+			output:   "commit 123\n\ndiff --git a/file b/file\n+content\n",
+			expected: false,
+		},
+		{
+			name:     "missing blobs",
+			output:   "warning: lazy fetching disabled; some objects may not be available\nfatal: unable to read 456\n",
+			expected: true,
+		},
+		{
+			name:     "empty output",
+			output:   "",
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, isMissingBlobsOutput(s.output))
+		})
+	}
+}
+
+func TestFetchMissingBlobs(t *testing.T) {
+	type run struct {
+		lazyFetch     bool
+		discardOutput bool
+	}
+
+	scenarios := []struct {
+		name            string
+		missingBlobs    bool
+		fetchErr        error
+		expectedRuns    []run
+		expectedFetched bool
+		expectedErr     error
+	}{
+		{
+			name:         "no missing blobs",
+			missingBlobs: false,
+			expectedRuns: []run{
+				{lazyFetch: false, discardOutput: false},
+			},
+			expectedFetched: false,
+		},
+		{
+			name:         "missing blobs",
+			missingBlobs: true,
+			expectedRuns: []run{
+				{lazyFetch: false, discardOutput: false},
+				{lazyFetch: true, discardOutput: true},
+			},
+			expectedFetched: true,
+		},
+		{
+			name:         "fetching fails",
+			missingBlobs: true,
+			fetchErr:     errors.New("error"),
+			expectedRuns: []run{
+				{lazyFetch: false, discardOutput: false},
+				{lazyFetch: true, discardOutput: true},
+			},
+			expectedFetched: false,
+			expectedErr:     errors.New("error"),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			env := []string{"FOO=bar"}
+			newCmd := func(env []string) *exec.Cmd {
+				cmd := exec.Command("git", "show", "abc")
+				cmd.Env = env
+				return cmd
+			}
+
+			runs := []run{}
+			statusShown := false
+			fetched, err := fetchMissingBlobs(newCmd, env, func(cmd *exec.Cmd, discardOutput bool) (string, error) {
+				lazyFetch := !slices.Contains(cmd.Env, noLazyFetchEnvVar)
+				runs = append(runs, run{lazyFetch: lazyFetch, discardOutput: discardOutput})
+				if lazyFetch {
+					assert.True(t, statusShown)
+					return "", s.fetchErr
+				}
+				if s.missingBlobs {
+					return "warning: lazy fetching disabled; some objects may not be available\n", errors.New("exit status 128")
+				}
+				return "output\n", nil
+			}, func(f func() error) error {
+				statusShown = true
+				defer func() { statusShown = false }()
+				return f()
+			})
+
+			assert.Equal(t, s.expectedRuns, runs)
+			assert.Equal(t, s.expectedFetched, fetched)
+			assert.Equal(t, s.expectedErr, err)
+		})
+	}
+}
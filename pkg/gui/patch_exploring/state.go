@@ -1,6 +1,7 @@
 package patch_exploring
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
@@ -28,12 +29,18 @@ type State struct {
 	viewLineIndices []int
 	// Array of indices of the original patch lines indexed by a wrapped view line index
 	patchLineIndices []int
+	// Whether the view was wrapping lines when the above indices were computed
+	wrapped bool
 
 	// whether the user has switched to hunk mode manually; if hunk mode is on
 	// but this is false, then hunk mode was enabled because the config makes it
 	// on by default.
 	// this makes a difference for whether we want to escape out of hunk mode
 	userEnabledHunkMode bool
+
+	// Patch line indices of changed lines that the user has marked so that they
+	// can be acted upon together even if they aren't next to each other
+	markedLineIndices *set.Set[int]
 }
 
 // these represent what select mode we're in
@@ -49,7 +56,11 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 {
 		// if we're here then we can return the old state. If selectedLineIdx was not -1
 		// then that would mean we were trying to click and potentially drag a range, which
-		// is why in that case we continue below
+		// is why in that case we continue below.
+		// The view's wrapping might have been toggled in the meantime though.
+		if oldState.wrapped != view.Wrap {
+			oldState.UpdateWrapping(view)
+		}
 		return oldState
 	}
 
@@ -76,6 +87,12 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		userEnabledHunkMode = oldState.userEnabledHunkMode
 	}
 
+	// Marks refer to lines of the diff, so they only survive if it's unchanged
+	markedLineIndices := set.New[int]()
+	if oldState != nil && diff == oldState.diff {
+		markedLineIndices = oldState.markedLineIndices
+	}
+
 	// if we have clicked from the outside to focus the main view we'll pass in a non-negative line index so that we can instantly select that line
 	if selectedLineIdx >= 0 {
 		// Clamp to the number of wrapped view lines; index might be out of
@@ -120,12 +137,16 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		diff:                diff,
 		viewLineIndices:     viewLineIndices,
 		patchLineIndices:    patchLineIndices,
+		wrapped:             view.Wrap,
 		userEnabledHunkMode: userEnabledHunkMode,
+		markedLineIndices:   markedLineIndices,
 	}
 }
 
-func (s *State) OnViewWidthChanged(view *gocui.View) {
-	if !view.Wrap {
+// Needs to be called when the width of the view or its Wrap setting has
+// changed
+func (s *State) UpdateWrapping(view *gocui.View) {
+	if !view.Wrap && !s.wrapped {
 		return
 	}
 
@@ -135,6 +156,7 @@ func (s *State) OnViewWidthChanged(view *gocui.View) {
 		rangeStartPatchLineIdx = s.patchLineIndices[s.rangeStartLineIdx]
 	}
 	s.viewLineIndices, s.patchLineIndices = wrapPatchLines(s.diff, view)
+	s.wrapped = view.Wrap
 	s.selectedLineIdx = s.viewLineIndices[selectedPatchLineIdx]
 	if s.selectMode == RANGE {
 		s.rangeStartLineIdx = s.viewLineIndices[rangeStartPatchLineIdx]
@@ -386,10 +408,57 @@ func (s *State) LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange() []int {
 	return indices
 }
 
+// ToggleMarkSelection marks the changed lines in the current selection, or
+// unmarks them if they are all marked already
+func (s *State) ToggleMarkSelection() {
+	indices := s.LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange()
+	if len(indices) == 0 {
+		return
+	}
+
+	allMarked := lo.EveryBy(indices, s.markedLineIndices.Includes)
+	if allMarked {
+		s.markedLineIndices.RemoveSlice(indices)
+	} else {
+		s.markedLineIndices.Add(indices...)
+	}
+
+	if s.SelectingRange() {
+		s.SetLineSelectMode()
+	}
+}
+
+func (s *State) HasMarks() bool {
+	return s.markedLineIndices.Len() > 0
+}
+
+func (s *State) ClearMarks() {
+	s.markedLineIndices = set.New[int]()
+}
+
+// Returns the patch line indices of the marked lines in ascending order
+func (s *State) MarkedPatchLineIndices() []int {
+	indices := s.markedLineIndices.ToSlice()
+	slices.Sort(indices)
+	return indices
+}
+
 func (s *State) CurrentLineNumber() int {
 	return s.patch.LineNumberOfLine(s.patchLineIndices[s.selectedLineIdx])
 }
 
+// Takes a patch line index and returns the line number in the new file
+func (s *State) LineNumberOfPatchLine(patchLineIdx int) int {
+	return s.patch.LineNumberOfLine(patchLineIdx)
+}
+
+// Selects the line showing the given line number of the new file, or the next
+// line after it that is part of the diff
+func (s *State) SelectLineNumber(lineNumber int) {
+	s.SetLineSelectMode()
+	s.SelectLine(s.viewLineIndices[s.patch.LineIdxOfLineNumber(lineNumber)])
+}
+
 func (s *State) AdjustSelectedLineIdx(change int) {
 	s.DismissHunkSelectMode()
 	s.SelectLine(s.selectedLineIdx + change)
@@ -398,7 +467,8 @@ func (s *State) AdjustSelectedLineIdx(change int) {
 func (s *State) RenderForLineIndices(includedLineIndices []int) string {
 	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
 	return s.patch.FormatView(patch.FormatViewOpts{
-		IncLineIndices: includedLineIndicesSet,
+		IncLineIndices:    includedLineIndicesSet,
+		MarkedLineIndices: s.markedLineIndices,
 	})
 }
 
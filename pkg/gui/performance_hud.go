@@ -0,0 +1,73 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+const (
+	performanceHudWidth = 70
+	// Timings at least this long are highlighted in the HUD
+	slowTimingThreshold = 500 * time.Millisecond
+)
+
+func (gui *Gui) togglePerformanceHud() error {
+	gui.ShowPerformanceHud = !gui.ShowPerformanceHud
+
+	// Only redraw on new timings while the HUD is shown; there's no point in
+	// triggering layouts for it otherwise
+	if gui.ShowPerformanceHud {
+		gui.c.Timings.SetOnRecord(gui.schedulePerformanceHudRedraw)
+	} else {
+		gui.c.Timings.SetOnRecord(nil)
+	}
+
+	return nil
+}
+
+// Called from whichever goroutine recorded a timing
+func (gui *Gui) schedulePerformanceHudRedraw() {
+	if gui.performanceHudRedrawPending.Swap(true) {
+		return
+	}
+
+	gui.onUIThread(func() error {
+		gui.performanceHudRedrawPending.Store(false)
+		return nil
+	})
+}
+
+func (gui *Gui) layoutPerformanceHud(screenWidth int, screenHeight int) error {
+	view := gui.Views.PerformanceHud
+	if !gui.ShowPerformanceHud {
+		view.Visible = false
+		return nil
+	}
+
+	timings := gui.c.Timings.Recent()
+
+	width := min(performanceHudWidth, screenWidth-2)
+	height := min(max(len(timings), 1), screenHeight/2)
+	x0 := screenWidth - width - 2
+	if _, err := gui.g.SetView(view.Name(), x0, 0, x0+width+1, height+1, 0); err != nil {
+		return err
+	}
+	view.Visible = true
+
+	lines := make([]string, 0, len(timings))
+	for _, timing := range timings {
+		duration := fmt.Sprintf("%6dms", timing.Duration.Milliseconds())
+		if timing.Duration >= slowTimingThreshold {
+			duration = style.FgRed.Sprint(duration)
+		}
+		name := strings.ReplaceAll(timing.Name, "\n", " ")
+		lines = append(lines, fmt.Sprintf("%s %-7s %s", duration, timing.Category, utils.TruncateWithEllipsis(name, width-16)))
+	}
+	gui.c.SetViewContent(view, strings.Join(lines, "\n"))
+
+	return nil
+}
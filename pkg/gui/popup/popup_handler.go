@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -67,6 +68,10 @@ func (self *PopupHandler) ErrorToast(message string) {
 	self.toastFn(message, types.ToastKindError)
 }
 
+func (self *PopupHandler) WarningToast(message string) {
+	self.toastFn(message, types.ToastKindWarning)
+}
+
 func (self *PopupHandler) SetToastFunc(f func(string, types.ToastKind)) {
 	self.toastFn = f
 }
@@ -91,6 +96,11 @@ func (self *PopupHandler) ErrorHandler(err error) error {
 		}
 	}
 
+	if errors.Is(err, oscommands.ErrCommandCancelled) {
+		self.Toast(self.Tr.OperationCancelled)
+		return nil
+	}
+
 	// Need to set bold here explicitly; otherwise it gets cancelled by the red colouring.
 	coloredMessage := style.FgRed.SetBold().Sprint(strings.TrimSpace(err.Error()))
 	if err := self.onErrorFn(); err != nil {
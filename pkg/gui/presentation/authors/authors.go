@@ -90,6 +90,13 @@ func AuthorStyle(authorName string) *style.TextStyle {
 	return &value
 }
 
+// AuthorStyleFromPalette picks one of the given styles for the author, so that
+// a given author always gets the same one
+func AuthorStyleFromPalette(authorName string, palette []style.TextStyle) *style.TextStyle {
+	hash := md5.Sum([]byte(authorName))
+	return &palette[randInt(hash[:], len(palette))]
+}
+
 func trueColorStyle(str string) style.TextStyle {
 	hash := md5.Sum([]byte(str))
 	c := colorful.Hsl(randFloat(hash[0:4])*360.0, 0.6+0.4*randFloat(hash[4:8]), 0.4+randFloat(hash[8:12])*0.2)
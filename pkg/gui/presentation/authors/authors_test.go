@@ -3,6 +3,7 @@ package authors
 import (
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +42,14 @@ func TestAuthorWithLength(t *testing.T) {
 		assert.Equal(t, s.expectedOutput, utils.Decolorise(AuthorWithLength(s.authorName, s.length)))
 	}
 }
+
+func TestAuthorStyleFromPalette(t *testing.T) {
+	palette := []style.TextStyle{style.FgRed, style.FgGreen, style.FgBlue}
+
+	for _, authorName := range []string{"Jesse Duffield", "Stefan Haller", ""} {
+		s := AuthorStyleFromPalette(authorName, palette)
+		assert.Contains(t, palette, *s)
+		// the same author always gets the same style
+		assert.Equal(t, s, AuthorStyleFromPalette(authorName, palette))
+	}
+}
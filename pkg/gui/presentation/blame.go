@@ -0,0 +1,43 @@
+package presentation
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetBlameLineListDisplayStrings(blameLines []*models.BlameLine) [][]string {
+	return lo.Map(blameLines, func(blameLine *models.BlameLine, i int) []string {
+		// Like tig, we only show the commit for the first line of a block of
+		// lines that were changed by the same commit, so that it's easier to
+		// see where the blocks are
+		firstOfBlock := i == 0 || blameLines[i-1].Hash != blameLine.Hash
+		return getBlameLineDisplayStrings(blameLine, firstOfBlock)
+	})
+}
+
+func getBlameLineDisplayStrings(b *models.BlameLine, firstOfBlock bool) []string {
+	hashStr, authorStr, ageStr := "", "", ""
+	if firstOfBlock {
+		hashColor := style.FgBlue
+		if b.IsUncommitted() {
+			hashColor = style.FgYellow
+		}
+		hashStr = hashColor.Sprint(b.ShortHash())
+		authorStr = authors.LongAuthor(b.Author, 17)
+		ageStr = style.FgCyan.Sprint(utils.UnixToTimeAgo(b.AuthorTime))
+	}
+
+	return []string{
+		hashStr,
+		authorStr,
+		ageStr,
+		style.FgMagenta.Sprint(strconv.Itoa(b.LineNumber)),
+		theme.DefaultTextColor.Sprint(b.Content),
+	}
+}
@@ -30,22 +30,48 @@ func GetBranchListDisplayStrings(
 	branches []*models.Branch,
 	getItemOperation func(item types.HasUrn) types.ItemOperation,
 	prs map[string]*models.GithubPullRequest,
+	commitStatuses map[string]*models.GithubCommitStatus,
 	fullDescription bool,
 	diffName string,
 	viewWidth int,
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
+	timeFormat string,
+	shortTimeFormat string,
 ) [][]string {
-	return lo.Map(branches, func(branch *models.Branch, _ int) []string {
+	now := time.Now()
+	recencies := lo.Map(branches, func(branch *models.Branch, _ int) string {
+		return branchRecency(branch, now, timeFormat, shortTimeFormat)
+	})
+	// Relative recencies are at most three characters, but absolute dates can
+	// be longer, and the column is as wide as the longest one
+	recencyWidth := max(3, lo.Max(lo.Map(recencies, func(recency string, _ int) int {
+		return utils.StringWidth(recency)
+	})))
+
+	return lo.Map(branches, func(branch *models.Branch, i int) []string {
 		diffed := branch.Name == diffName
-		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, time.Now(), prs)
+		return getBranchDisplayStrings(branch, recencies[i], recencyWidth, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, now, prs, commitStatuses[branch.CommitHash])
 	})
 }
 
+// branchRecency returns the text to show in the recency column of a branch.
+// The branch loader stores the recency in relative form (e.g. '2d'), so we
+// only need to format it ourselves if an absolute date was asked for.
+func branchRecency(b *models.Branch, now time.Time, timeFormat string, shortTimeFormat string) string {
+	if timeFormat == utils.RELATIVE_TIME_FORMAT || b.Head || b.RecencyTimestamp == 0 {
+		return b.Recency
+	}
+
+	return utils.UnixToDateSmart(now, b.RecencyTimestamp, timeFormat, shortTimeFormat)
+}
+
 // getBranchDisplayStrings returns the display string of branch
 func getBranchDisplayStrings(
 	b *models.Branch,
+	recency string,
+	recencyWidth int,
 	itemOperation types.ItemOperation,
 	fullDescription bool,
 	diffed bool,
@@ -55,14 +81,14 @@ func getBranchDisplayStrings(
 	worktrees []*models.Worktree,
 	now time.Time,
 	prs map[string]*models.GithubPullRequest,
+	commitStatus *models.GithubCommitStatus,
 ) []string {
 	checkedOutByWorkTree := git_commands.CheckedOutByOtherWorktree(b, worktrees)
 	showCommitHash := fullDescription || userConfig.Gui.ShowBranchCommitHash
 	branchStatus := BranchStatus(b, itemOperation, tr, now, userConfig)
 	divergence := divergenceStr(b, itemOperation, tr, userConfig)
 
-	// Recency is always three characters, plus one for the space
-	availableWidth := viewWidth - 4
+	availableWidth := viewWidth - recencyWidth - 1
 	if len(divergence) > 0 {
 		availableWidth -= utils.StringWidth(divergence) + 1
 	}
@@ -73,6 +99,13 @@ func getBranchDisplayStrings(
 		// if we have PRs then we assume that at least one branch in the list has one
 		availableWidth -= 2
 	}
+	pr, hasPr := prs[b.Name]
+	showPr := hasPr && ShouldShowPrForBranch(pr, b.Name, userConfig)
+	prNumber := ""
+	if showPr && pr.IsOpen() {
+		prNumber = fmt.Sprintf("#%d", pr.Number)
+		availableWidth -= utils.StringWidth(prNumber) + 1
+	}
 	paddingNeededForDivergence := availableWidth
 
 	displayName := b.Name
@@ -84,6 +117,11 @@ func getBranchDisplayStrings(
 		availableWidth -= utils.StringWidth(utils.Decolorise(branchStatus)) + 1
 	}
 
+	ciStatusIcon := CIStatusIcon(commitStatus)
+	if ciStatusIcon != "" {
+		availableWidth -= 2
+	}
+
 	worktreeIcon := ""
 	if checkedOutByWorkTree {
 		if wt, ok := git_commands.WorktreeForBranch(b, worktrees); ok && wt.Name != b.Name {
@@ -125,12 +163,18 @@ func getBranchDisplayStrings(
 		displayName = utils.TruncateWithEllipsis(displayName, len)
 	}
 	coloredName := nameTextStyle.Sprint(displayName)
+	if prNumber != "" {
+		coloredName = fmt.Sprintf("%s %s", coloredName, WithPrColor(pr.State, prNumber, false))
+	}
 	if checkedOutByWorkTree {
 		coloredName = fmt.Sprintf("%s %s", coloredName, style.FgDefault.Sprint(worktreeIcon))
 	}
 	if len(branchStatus) > 0 {
 		coloredName = fmt.Sprintf("%s %s", coloredName, branchStatus)
 	}
+	if ciStatusIcon != "" {
+		coloredName = fmt.Sprintf("%s %s", coloredName, ciStatusIcon)
+	}
 
 	recencyColor := style.FgCyan
 	if b.Recency == "  *" {
@@ -138,11 +182,10 @@ func getBranchDisplayStrings(
 	}
 
 	res := make([]string, 0, 6)
-	res = append(res, recencyColor.Sprint(b.Recency))
+	res = append(res, recencyColor.Sprint(recency))
 
 	var coloredPrIcon string
-	pr, hasPr := prs[b.Name]
-	if hasPr && ShouldShowPrForBranch(pr, b.Name, userConfig) {
+	if showPr {
 		var prIcon string
 		if icons.IsIconEnabled() {
 			prIcon = icons.IconForRemoteUrl(pr.Url)
@@ -181,10 +224,36 @@ func getBranchDisplayStrings(
 			),
 			utils.TruncateWithEllipsis(b.Subject, 60),
 		)
+
+		if len(prs) > 0 {
+			prInfo := ""
+			if showPr && pr.IsOpen() {
+				prInfo = utils.TruncateWithEllipsis(pr.Title, 50)
+				if reviewText := PullRequestReviewText(pr.ReviewDecision, tr); reviewText != "" {
+					prInfo += " " + reviewText
+				}
+			}
+			res = append(res, prInfo)
+		}
 	}
 	return res
 }
 
+// PullRequestReviewText returns the colored review state of a pull request, or
+// an empty string if the repo doesn't require reviews
+func PullRequestReviewText(reviewDecision string, tr *i18n.TranslationSet) string {
+	switch reviewDecision {
+	case "APPROVED":
+		return style.FgGreen.Sprintf("(%s)", tr.PullRequestApproved)
+	case "CHANGES_REQUESTED":
+		return style.FgRed.Sprintf("(%s)", tr.PullRequestChangesRequested)
+	case "REVIEW_REQUIRED":
+		return style.FgYellow.Sprintf("(%s)", tr.PullRequestReviewRequired)
+	default:
+		return ""
+	}
+}
+
 // GetBranchTextStyle branch color
 func GetBranchTextStyle(name string) style.TextStyle {
 	if style, ok := colorPatterns.match(name); ok {
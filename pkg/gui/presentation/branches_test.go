@@ -31,6 +31,8 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		useIcons             bool
 		checkedOutByWorktree bool
 		showDivergenceCfg    string
+		commitStatus         *models.GithubCommitStatus
+		pr                   *models.GithubPullRequest
 		expected             []string
 	}{
 		// First some tests for when the view is wide enough so that everything fits:
@@ -90,6 +92,56 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			showDivergenceCfg:    "none",
 			expected:             []string{"1m", "", "branch_name ✓"},
 		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pr:                   &models.GithubPullRequest{Number: 12, Title: "Add feature", State: "OPEN"},
+			expected:             []string{"1m", "●", "branch_name #12"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pr:                   &models.GithubPullRequest{Number: 12, Title: "Add feature", State: "MERGED"},
+			expected:             []string{"1m", "●", "branch_name"},
+		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m", CommitHash: "1234567890", UpstreamRemote: "origin", UpstreamBranch: "branch_name", AheadForPull: "0", BehindForPull: "0", Subject: "commit title"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      true,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pr:                   &models.GithubPullRequest{Number: 12, Title: "Add feature", State: "OPEN", ReviewDecision: "CHANGES_REQUESTED"},
+			expected:             []string{"1m", "●", "12345678", "branch_name #12 ✓", "origin branch_name", "commit title", "Add feature (changes requested)"},
+		},
+		{
+			branch: &models.Branch{
+				Name:           "branch_name",
+				Recency:        "1m",
+				UpstreamRemote: "origin",
+				AheadForPull:   "0",
+				BehindForPull:  "0",
+			},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			commitStatus:         &models.GithubCommitStatus{State: models.CIStateSuccess},
+			expected:             []string{"1m", "", "branch_name ✓ ✔"},
+		},
 		{
 			branch: &models.Branch{
 				Name:           "branch_name",
@@ -195,6 +247,17 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			showDivergenceCfg:    "none",
 			expected:             []string{"1m", "", "branch_na…"},
 		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            14,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			commitStatus:         &models.GithubCommitStatus{State: models.CIStateFailure},
+			expected:             []string{"1m", "", "branch_… ✘"},
+		},
 		{
 			branch:               &models.Branch{Name: "🍉_special_char", Recency: "1m"},
 			itemOperation:        types.ItemOperationNone,
@@ -350,9 +413,70 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			worktrees = append(worktrees, &models.Worktree{Branch: s.branch.Name, Name: "other-worktree"})
 		}
 
+		prs := map[string]*models.GithubPullRequest{}
+		if s.pr != nil {
+			prs[s.branch.Name] = s.pr
+		}
+
 		t.Run(fmt.Sprintf("getBranchDisplayStrings_%d", i), func(t *testing.T) {
-			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, time.Time{}, map[string]*models.GithubPullRequest{})
+			strings := getBranchDisplayStrings(s.branch, s.branch.Recency, 3, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, time.Time{}, prs, s.commitStatus)
 			assert.Equal(t, s.expected, strings)
 		})
 	}
 }
+
+func Test_branchRecency(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.Local)
+	earlierToday := time.Date(2024, 3, 15, 9, 5, 0, 0, time.Local).Unix()
+	lastWeek := time.Date(2024, 3, 8, 9, 5, 0, 0, time.Local).Unix()
+
+	scenarios := []struct {
+		name            string
+		branch          *models.Branch
+		timeFormat      string
+		shortTimeFormat string
+		expected        string
+	}{
+		{
+			name:            "relative",
+			branch:          &models.Branch{Name: "a", Recency: "1w", RecencyTimestamp: lastWeek},
+			timeFormat:      "relative",
+			shortTimeFormat: "relative",
+			expected:        "1w",
+		},
+		{
+			name:            "absolute",
+			branch:          &models.Branch{Name: "a", Recency: "1w", RecencyTimestamp: lastWeek},
+			timeFormat:      "2006-01-02",
+			shortTimeFormat: "15:04",
+			expected:        "2024-03-08",
+		},
+		{
+			name:            "absolute, earlier today",
+			branch:          &models.Branch{Name: "a", Recency: "5h", RecencyTimestamp: earlierToday},
+			timeFormat:      "2006-01-02",
+			shortTimeFormat: "15:04",
+			expected:        "09:05",
+		},
+		{
+			name:            "head branch",
+			branch:          &models.Branch{Name: "a", Recency: "  *", Head: true, RecencyTimestamp: lastWeek},
+			timeFormat:      "2006-01-02",
+			shortTimeFormat: "15:04",
+			expected:        "  *",
+		},
+		{
+			name:            "unknown timestamp",
+			branch:          &models.Branch{Name: "a"},
+			timeFormat:      "2006-01-02",
+			shortTimeFormat: "15:04",
+			expected:        "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, branchRecency(s.branch, now, s.timeFormat, s.shortTimeFormat))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// CIStatusIcon returns a colored icon for the combined state of the CI checks
+// of a commit, or an empty string if we don't know of any checks
+func CIStatusIcon(status *models.GithubCommitStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	switch status.State {
+	case models.CIStateSuccess:
+		return style.FgGreen.Sprint("✔")
+	case models.CIStateFailure:
+		return style.FgRed.Sprint("✘")
+	default:
+		return style.FgYellow.Sprint("•")
+	}
+}
@@ -56,6 +56,8 @@ func GetCommitListDisplayStrings(
 	endIdx int,
 	showGraph bool,
 	bisectInfo *git_commands.BisectInfo,
+	commitStatuses map[string]*models.GithubCommitStatus,
+	onGraphProgress func(),
 ) [][]string {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -78,12 +80,30 @@ func GetCommitListDisplayStrings(
 	// function expects to be passed the index of the commit in terms of the `commits` slice
 	var getGraphLine func(int) string
 	if showGraph {
+		// The pipe sets of long histories are computed in the background, so
+		// some of them may not be available yet; commits beyond the ones we
+		// have pipe sets for are shown without a graph line for now.
+		graphLines := make([]string, endIdx-startIdx)
+		renderGraph := func(sectionStart int, pipeSets [][]graph.Pipe) {
+			// indices into `commits` of the visible commits that we have pipe sets for
+			from := max(startIdx, sectionStart)
+			to := min(endIdx, sectionStart+len(pipeSets))
+			if from >= to {
+				return
+			}
+			lines := graph.RenderAux(
+				pipeSets[from-sectionStart:to-sectionStart],
+				commits[from:to],
+				selectedCommitHashPtr,
+				graph.ParseStyle(common.UserConfig().Gui.CommitGraphStyle),
+			)
+			copy(graphLines[from-startIdx:], lines)
+		}
+
 		if len(commits) > 0 && commits[0].Divergence != models.DivergenceNone {
 			// Showing a divergence log; we know we don't have any rebasing
 			// commits in this case. But we need to render separate graphs for
 			// the Local and Remote sections.
-			allGraphLines := []string{}
-
 			_, localSectionStart, found := lo.FindIndexOf(
 				commits, func(c *models.Commit) bool { return c.Divergence == models.DivergenceLeft })
 			if !found {
@@ -92,62 +112,20 @@ func GetCommitListDisplayStrings(
 
 			if localSectionStart > 0 {
 				// we have some remote commits
-				pipeSets := loadPipesets(commits[:localSectionStart])
-				if startIdx < localSectionStart {
-					// some of the remote commits are visible
-					start := startIdx
-					end := min(endIdx, localSectionStart)
-					graphPipeSets := pipeSets[start:end]
-					graphCommits := commits[start:end]
-					graphLines := graph.RenderAux(
-						graphPipeSets,
-						graphCommits,
-						selectedCommitHashPtr,
-					)
-					allGraphLines = append(allGraphLines, graphLines...)
-				}
+				renderGraph(0, loadPipesets(commits[:localSectionStart], onGraphProgress))
 			}
 			if localSectionStart < len(commits) {
 				// we have some local commits
-				pipeSets := loadPipesets(commits[localSectionStart:])
-				if localSectionStart < endIdx {
-					// some of the local commits are visible
-					graphOffset := max(startIdx, localSectionStart)
-					pipeSetOffset := max(startIdx-localSectionStart, 0)
-					graphPipeSets := pipeSets[pipeSetOffset : endIdx-localSectionStart]
-					graphCommits := commits[graphOffset:endIdx]
-					graphLines := graph.RenderAux(
-						graphPipeSets,
-						graphCommits,
-						selectedCommitHashPtr,
-					)
-					allGraphLines = append(allGraphLines, graphLines...)
-				}
-			}
-
-			getGraphLine = func(idx int) string {
-				return allGraphLines[idx-startIdx]
+				renderGraph(localSectionStart, loadPipesets(commits[localSectionStart:], onGraphProgress))
 			}
 		} else {
 			// this is where the graph begins (may be beyond the TODO commits depending on startIdx,
 			// but we'll never include TODO commits as part of the graph because it'll be messy)
-			graphOffset := max(startIdx, rebaseOffset)
-
-			pipeSets := loadPipesets(commits[rebaseOffset:])
-			pipeSetOffset := max(startIdx-rebaseOffset, 0)
-			graphPipeSets := pipeSets[pipeSetOffset:max(endIdx-rebaseOffset, 0)]
-			graphCommits := commits[graphOffset:endIdx]
-			graphLines := graph.RenderAux(
-				graphPipeSets,
-				graphCommits,
-				selectedCommitHashPtr,
-			)
-			getGraphLine = func(idx int) string {
-				if idx >= graphOffset {
-					return graphLines[idx-graphOffset]
-				}
-				return ""
-			}
+			renderGraph(rebaseOffset, loadPipesets(commits[rebaseOffset:], onGraphProgress))
+		}
+
+		getGraphLine = func(idx int) string {
+			return graphLines[idx-startIdx]
 		}
 	} else {
 		getGraphLine = func(int) string { return "" }
@@ -204,6 +182,7 @@ func GetCommitListDisplayStrings(
 			fullDescription,
 			bisectStatus,
 			bisectInfo,
+			commitStatuses[commit.Hash()],
 		))
 	}
 	return lines
@@ -244,7 +223,17 @@ func indexOfFirstNonTODOCommit(commits []*models.Commit) int {
 	return 0
 }
 
-func loadPipesets(commits []*models.Commit) [][]graph.Pipe {
+// Histories with more commits than this get their graph computed in the
+// background, in chunks of this size, so that the commits panel doesn't have to
+// wait for it
+const graphChunkSize = 1000
+
+// Returns the pipe sets for the given commits. If onProgress is non-nil and
+// there are many commits, the pipe sets are computed in the background and this
+// returns the ones computed so far (possibly none); onProgress is called
+// whenever more of them become available.
+// Must be called with the mutex held.
+func loadPipesets(commits []*models.Commit, onProgress func()) [][]graph.Pipe {
 	// given that our cache key is a commit hash and a commit count, it's very important that we don't actually try to render pipes
 	// when dealing with things like filtered commits.
 	cacheKey := pipeSetCacheKey{
@@ -253,18 +242,47 @@ func loadPipesets(commits []*models.Commit) [][]graph.Pipe {
 		divergence:  commits[0].Divergence,
 	}
 
-	pipeSets, ok := pipeSetCache[cacheKey]
-	if !ok {
-		// pipe sets are unique to a commit head. and a commit count. Sometimes we haven't loaded everything for that.
-		// so let's just cache it based on that.
-		getStyle := func(commit *models.Commit) *style.TextStyle {
-			return authors.AuthorStyle(commit.AuthorName)
+	if pipeSets, ok := pipeSetCache[cacheKey]; ok {
+		return pipeSets
+	}
+
+	// pipe sets are unique to a commit head. and a commit count. Sometimes we haven't loaded everything for that.
+	// so let's just cache it based on that.
+	getStyle := func(commit *models.Commit) *style.TextStyle {
+		if len(theme.GraphColors) > 0 {
+			return authors.AuthorStyleFromPalette(commit.AuthorName, theme.GraphColors)
 		}
-		pipeSets = graph.GetPipeSets(commits, getStyle)
+		return authors.AuthorStyle(commit.AuthorName)
+	}
+
+	if onProgress == nil || len(commits) <= graphChunkSize {
+		pipeSets := graph.GetPipeSets(commits, getStyle)
 		pipeSetCache[cacheKey] = pipeSets
+		return pipeSets
 	}
 
-	return pipeSets
+	pipeSetCache[cacheKey] = [][]graph.Pipe{}
+	go utils.Safe(func() {
+		for start := 0; start < len(commits); start += graphChunkSize {
+			end := min(start+graphChunkSize, len(commits))
+
+			// We hold the mutex while computing a chunk because the author
+			// style cache isn't thread-safe; a chunk is quick to compute, so
+			// this doesn't hold up rendering for long.
+			mutex.Lock()
+			pipeSets := pipeSetCache[cacheKey]
+			var prevPipes []graph.Pipe
+			if start > 0 {
+				prevPipes = pipeSets[start-1]
+			}
+			pipeSetCache[cacheKey] = append(pipeSets, graph.ContinuePipeSets(prevPipes, commits[start:end], getStyle)...)
+			mutex.Unlock()
+
+			onProgress()
+		}
+	})
+
+	return nil
 }
 
 // similar to the git_commands.BisectStatus but more gui-focused
@@ -355,6 +373,7 @@ func displayCommit(
 	fullDescription bool,
 	bisectStatus BisectStatus,
 	bisectInfo *git_commands.BisectInfo,
+	commitStatus *models.GithubCommitStatus,
 ) []string {
 	bisectString := getBisectStatusText(bisectStatus, bisectInfo)
 
@@ -421,9 +440,14 @@ func displayCommit(
 		name = emoji.Sprint(name)
 	}
 
+	ciStatusString := ""
+	if icon := CIStatusIcon(commitStatus); icon != "" {
+		ciStatusString = icon + " "
+	}
+
 	mark := ""
 	if commit.Status == models.StatusConflicted {
-		youAreHere := style.FgRed.Sprintf("<-- %s ---", common.Tr.ConflictLabel)
+		youAreHere := theme.ConflictColor.Sprintf("<-- %s ---", common.Tr.ConflictLabel)
 		mark = fmt.Sprintf("%s ", youAreHere)
 	} else if isMarkedBaseCommit {
 		rebaseFromHere := style.FgYellow.Sprint(common.Tr.MarkedCommitMarker)
@@ -448,7 +472,7 @@ func displayCommit(
 		descriptionString,
 		actionString,
 		author,
-		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
+		graphLine+mark+ciStatusString+tagString+theme.DefaultTextColor.Sprint(name),
 	)
 
 	return cols
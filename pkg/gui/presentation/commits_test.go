@@ -1,6 +1,7 @@
 package presentation
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -42,6 +43,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		endIdx                    int
 		showGraph                 bool
 		bisectInfo                *git_commands.BisectInfo
+		commitStatuses            map[string]*models.GithubCommitStatus
 		expected                  string
 		focus                     bool
 	}{
@@ -90,6 +92,32 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 commit2
 						`),
 		},
+		{
+			testName: "commits with CI status",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", Tags: []string{"tag1"}},
+				{Name: "commit2", Hash: "hash2"},
+				{Name: "commit3", Hash: "hash3"},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			commitStatuses: map[string]*models.GithubCommitStatus{
+				"hash1": {State: models.CIStateSuccess},
+				"hash2": {State: models.CIStateFailure},
+				"hash3": {State: models.CIStatePending},
+			},
+			now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 ✔ tag1 commit1
+		hash2 ✘ commit2
+		hash3 • commit3
+		hash4 commit4
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commitOpts: []models.NewCommitOpts{
@@ -569,6 +597,8 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.endIdx,
 					s.showGraph,
 					s.bisectInfo,
+					s.commitStatuses,
+					nil,
 				)
 
 				renderedLines, _ := utils.RenderDisplayStrings(result, nil)
@@ -580,3 +610,64 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestGetCommitListDisplayStringsLoadsLongGraphInBackground(t *testing.T) {
+	common := common.NewDummyCommon()
+	hashPool := &utils.StringPool{}
+
+	commitCount := graphChunkSize + graphChunkSize/2
+	commits := make([]*models.Commit, 0, commitCount)
+	for i := range commitCount {
+		commits = append(commits, models.NewCommit(hashPool, models.NewCommitOpts{
+			Hash:    fmt.Sprintf("background-graph-%d", i),
+			Name:    fmt.Sprintf("commit %d", i),
+			Parents: []string{fmt.Sprintf("background-graph-%d", i+1)},
+		}))
+	}
+
+	progress := make(chan struct{}, 2)
+	getDisplayStrings := func() string {
+		result := GetCommitListDisplayStrings(
+			common,
+			commits,
+			nil,
+			"",
+			false,
+			false,
+			set.New[string](),
+			"",
+			"",
+			"",
+			"",
+			time.Now(),
+			false,
+			nil,
+			0,
+			2,
+			true,
+			git_commands.NewNullBisectInfo(),
+			nil,
+			func() { progress <- struct{}{} },
+		)
+		return utils.Decolorise(strings.Join(lo.Map(result, func(columns []string, _ int) string {
+			return strings.Join(columns, " ")
+		}), "\n"))
+	}
+
+	// The commits are shown right away, before the graph has been computed
+	result := getDisplayStrings()
+	assert.Contains(t, result, "commit 0")
+	assert.NotContains(t, result, "◯")
+
+	for range 2 {
+		select {
+		case <-progress:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the commit graph")
+		}
+	}
+
+	result = getDisplayStrings()
+	assert.Contains(t, result, "◯ commit 0")
+	assert.Contains(t, result, "◯ commit 1")
+}
@@ -0,0 +1,29 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// pathInCommit returns the path that the file had in the given commit; we show
+// it for the commits from before the file was renamed to its current path
+func GetFileHistoryListDisplayStrings(commits []*models.Commit, path string, pathInCommit func(*models.Commit) string) [][]string {
+	return lo.Map(commits, func(commit *models.Commit, _ int) []string {
+		oldPathStr := ""
+		if oldPath := pathInCommit(commit); oldPath != path {
+			oldPathStr = style.FgMagenta.Sprint(oldPath)
+		}
+
+		return []string{
+			style.FgBlue.Sprint(commit.ShortHash()),
+			style.FgCyan.Sprint(utils.UnixToTimeAgo(commit.UnixTimestamp)),
+			authors.ShortAuthor(commit.AuthorName),
+			theme.DefaultTextColor.Sprint(commit.Name),
+			oldPathStr,
+		}
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 const (
@@ -22,6 +23,7 @@ const (
 func RenderFileTree(
 	tree filetree.IFileTree,
 	submoduleConfigs []*models.SubmoduleConfig,
+	lfsLocks []*models.LfsLock,
 	showFileIcons bool,
 	showNumstat bool,
 	customIconsConfig *config.CustomIconsConfig,
@@ -31,7 +33,7 @@ func RenderFileTree(
 	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, func(node *filetree.Node[models.File], treeDepth int, visualDepth int, isCollapsed bool) string {
 		fileNode := filetree.NewFileNode(node)
 
-		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, node, customIconsConfig, showRootItem)
+		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, lfsLocks, node, customIconsConfig, showRootItem)
 	})
 }
 
@@ -118,6 +120,7 @@ func getFileLine(
 	showNumstat,
 	showFileIcons bool,
 	submoduleConfigs []*models.SubmoduleConfig,
+	lfsLocks []*models.LfsLock,
 	node *filetree.Node[models.File],
 	customIconsConfig *config.CustomIconsConfig,
 	showRootItem bool,
@@ -159,9 +162,10 @@ func getFileLine(
 	isSubmodule := file != nil && file.IsSubmodule(submoduleConfigs)
 	isLinkedWorktree := file != nil && file.IsWorktree
 	isDirectory := file == nil
+	isUntracked := file != nil && !file.Tracked
 
 	if showFileIcons {
-		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory, customIconsConfig)
+		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory, isUntracked, customIconsConfig)
 		paint := color.HEX(icon.Color, false)
 		output += paint.Sprint(icon.Icon) + nameColor.Sprint(" ")
 	}
@@ -172,6 +176,16 @@ func getFileLine(
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil && file.IsLfsPointer {
+		output += theme.DefaultTextColor.Sprint(" (LFS pointer)")
+	} else if file != nil && file.IsLfs {
+		output += theme.DefaultTextColor.Sprint(" (LFS)")
+	}
+
+	if lock, ok := lo.Find(lfsLocks, func(lock *models.LfsLock) bool { return file != nil && lock.Path == file.Path }); ok {
+		output += style.FgMagenta.Sprintf(" (locked by %s)", lock.Owner)
+	}
+
 	if file != nil && showNumstat {
 		if lineChanges := formatLineChanges(file.LinesAdded, file.LinesDeleted); lineChanges != "" {
 			output += " " + lineChanges
@@ -204,14 +218,14 @@ func formatLineChanges(linesAdded, linesDeleted int) string {
 	output := ""
 
 	if linesAdded != 0 {
-		output += style.FgGreen.Sprintf("+%d", linesAdded)
+		output += theme.DiffAddedColor.Sprintf("+%d", linesAdded)
 	}
 
 	if linesDeleted != 0 {
 		if output != "" {
 			output += " "
 		}
-		output += style.FgRed.Sprintf("-%d", linesDeleted)
+		output += theme.DiffRemovedColor.Sprintf("-%d", linesDeleted)
 	}
 
 	return output
@@ -271,9 +285,10 @@ func getCommitFileLine(
 	name = utils.EscapeSpecialChars(name)
 	isSubmodule := false
 	isLinkedWorktree := false
+	isUntracked := false
 
 	if showFileIcons {
-		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory, customIconsConfig)
+		icon := icons.IconForFile(name, isSubmodule, isLinkedWorktree, isDirectory, isUntracked, customIconsConfig)
 		paint := color.HEX(icon.Color, false)
 		output += paint.Sprint(icon.Icon) + " "
 	}
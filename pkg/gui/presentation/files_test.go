@@ -25,6 +25,7 @@ func TestRenderFileTree(t *testing.T) {
 		root            *filetree.FileNode
 		files           []*models.File
 		collapsedPaths  []string
+		lfsLocks        []*models.LfsLock
 		showLineChanges bool
 		showRootItem    bool
 		expected        []string
@@ -60,6 +61,24 @@ func TestRenderFileTree(t *testing.T) {
 				"   M test4",
 			},
 		},
+		{
+			name: "lfs files and locks",
+			files: []*models.File{
+				{Path: "image.psd", ShortStatus: " M", HasUnstagedChanges: true, IsLfs: true},
+				{Path: "model.fbx", ShortStatus: " M", HasUnstagedChanges: true, IsLfs: true, IsLfsPointer: true},
+				{Path: "notes.txt", ShortStatus: " M", HasUnstagedChanges: true},
+				{Path: "video.mp4", ShortStatus: " M", HasUnstagedChanges: true, IsLfs: true},
+			},
+			lfsLocks:     []*models.LfsLock{{Id: "1", Path: "video.mp4", Owner: "Jesse"}},
+			showRootItem: true,
+			expected: []string{
+				"▼ /",
+				"   M image.psd (LFS)",
+				"   M model.fbx (LFS pointer)",
+				"   M notes.txt",
+				"   M video.mp4 (LFS) (locked by Jesse)",
+			},
+		},
 		{
 			name: "big example",
 			files: []*models.File{
@@ -123,7 +142,7 @@ M  file1
 			for _, path := range s.collapsedPaths {
 				viewModel.ToggleCollapsed(path)
 			}
-			result := RenderFileTree(viewModel, nil, false, s.showLineChanges, &config.CustomIconsConfig{}, s.showRootItem)
+			result := RenderFileTree(viewModel, nil, s.lfsLocks, false, s.showLineChanges, &config.CustomIconsConfig{}, s.showRootItem)
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
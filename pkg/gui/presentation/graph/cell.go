@@ -6,13 +6,57 @@ import (
 
 	"github.com/gookit/color"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/samber/lo"
 )
 
 const (
 	MergeSymbol  = '⏣'
 	CommitSymbol = '◯'
+
+	AsciiMergeSymbol  = 'M'
+	AsciiCommitSymbol = '*'
 )
 
+// Style determines which characters the graph is drawn with
+type Style int
+
+const (
+	// Box-drawing characters with rounded corners, two columns per lane
+	StyleRounded Style = iota
+	// Like StyleRounded, but with only one column per lane, which saves space
+	// when there are many lanes
+	StyleCompact
+	// Only ASCII characters, for fonts that don't have box-drawing characters
+	StyleAscii
+)
+
+// ParseStyle converts the value of the `gui.commitGraphStyle` config to a
+// Style, falling back to StyleRounded for unknown values
+func ParseStyle(str string) Style {
+	switch str {
+	case "compact":
+		return StyleCompact
+	case "ascii":
+		return StyleAscii
+	default:
+		return StyleRounded
+	}
+}
+
+var asciiChars = map[string]string{
+	"│": "|",
+	"─": "-",
+	"┴": "+",
+	"┬": "+",
+	"╯": "'",
+	"╰": "'",
+	"╮": ".",
+	"╭": ".",
+	"╵": "|",
+	"╷": "|",
+	"╶": "-",
+}
+
 type cellType int
 
 const (
@@ -28,18 +72,30 @@ type Cell struct {
 	style                 *style.TextStyle
 }
 
-func (cell *Cell) render(writer io.StringWriter) {
+func (cell *Cell) render(writer io.StringWriter, graphStyle Style) {
 	up, down, left, right := cell.up, cell.down, cell.left, cell.right
 
 	first, second := getBoxDrawingChars(up, down, left, right)
+	if graphStyle == StyleAscii {
+		first = lo.ValueOr(asciiChars, first, first)
+		second = lo.ValueOr(asciiChars, second, second)
+	}
+
 	var adjustedFirst string
 	switch cell.cellType {
 	case CONNECTION:
 		adjustedFirst = first
 	case COMMIT:
-		adjustedFirst = string(CommitSymbol)
+		adjustedFirst = string(lo.Ternary(graphStyle == StyleAscii, AsciiCommitSymbol, CommitSymbol))
 	case MERGE:
-		adjustedFirst = string(MergeSymbol)
+		adjustedFirst = string(lo.Ternary(graphStyle == StyleAscii, AsciiMergeSymbol, MergeSymbol))
+	}
+
+	if graphStyle == StyleCompact {
+		// The lanes are right next to each other, so there's no room for the
+		// connecting character
+		_, _ = writer.WriteString(cachedSprint(*cell.style, adjustedFirst))
+		return
 	}
 
 	var rightStyle *style.TextStyle
@@ -224,7 +224,7 @@ func TestRenderCommitGraph(t *testing.T) {
 			getStyle := func(c *models.Commit) *style.TextStyle { return &style.FgDefault }
 			commits := lo.Map(test.commitOpts,
 				func(opts models.NewCommitOpts, _ int) *models.Commit { return models.NewCommit(hashPool, opts) })
-			lines := RenderCommitGraph(commits, hashPool.Add("blah"), getStyle)
+			lines := RenderCommitGraph(commits, hashPool.Add("blah"), getStyle, StyleRounded)
 
 			trimmedExpectedOutput := ""
 			for line := range strings.SplitSeq(strings.TrimPrefix(test.expectedOutput, "\n"), "\n") {
@@ -247,6 +247,67 @@ func TestRenderCommitGraph(t *testing.T) {
 	}
 }
 
+func TestRenderCommitGraphStyles(t *testing.T) {
+	commitOpts := []models.NewCommitOpts{
+		{Hash: "1", Parents: []string{"2", "3"}},
+		{Hash: "3", Parents: []string{"2"}},
+		{Hash: "2", Parents: []string{"4"}},
+	}
+
+	tests := []struct {
+		name           string
+		graphStyle     Style
+		expectedOutput []string
+	}{
+		{
+			name:       "rounded",
+			graphStyle: StyleRounded,
+			expectedOutput: []string{
+				"⏣─╮",
+				"│ ◯",
+				"◯─╯",
+			},
+		},
+		{
+			name:       "compact",
+			graphStyle: StyleCompact,
+			expectedOutput: []string{
+				"⏣╮",
+				"│◯",
+				"◯╯",
+			},
+		},
+		{
+			name:       "ascii",
+			graphStyle: StyleAscii,
+			expectedOutput: []string{
+				"M-.",
+				"| *",
+				"*-'",
+			},
+		},
+	}
+
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hashPool := &utils.StringPool{}
+
+			getStyle := func(c *models.Commit) *style.TextStyle { return &style.FgDefault }
+			commits := lo.Map(commitOpts,
+				func(opts models.NewCommitOpts, _ int) *models.Commit { return models.NewCommit(hashPool, opts) })
+			lines := RenderCommitGraph(commits, hashPool.Add("blah"), getStyle, test.graphStyle)
+
+			output := lo.Map(lines, func(line string, _ int) string {
+				return strings.TrimSpace(utils.Decolorise(line))
+			})
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
+
 func TestRenderPipeSet(t *testing.T) {
 	cyan := style.FgCyan
 	red := style.FgRed
@@ -462,7 +523,7 @@ func TestRenderPipeSet(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			actualStr := renderPipeSet(test.pipes, pool("selected"), test.prevCommit)
+			actualStr := renderPipeSet(test.pipes, pool("selected"), test.prevCommit, StyleRounded)
 			t.Log("actual cells:")
 			t.Log(actualStr)
 			expectedStr := ""
@@ -540,8 +601,8 @@ func TestGetNextPipes(t *testing.T) {
 		getStyle := func(c *models.Commit) *style.TextStyle { return &style.FgDefault }
 		pipes := getNextPipes(test.prevPipes, test.commit, getStyle)
 		// rendering cells so that it's easier to see what went wrong
-		actualStr := renderPipeSet(pipes, pool("selected"), nil)
-		expectedStr := renderPipeSet(test.expected, pool("selected"), nil)
+		actualStr := renderPipeSet(pipes, pool("selected"), nil, StyleRounded)
+		expectedStr := renderPipeSet(test.expected, pool("selected"), nil, StyleRounded)
 		t.Log("expected cells:")
 		t.Log(expectedStr)
 		t.Log("actual cells:")
@@ -550,6 +611,24 @@ func TestGetNextPipes(t *testing.T) {
 	}
 }
 
+func TestContinuePipeSets(t *testing.T) {
+	hashPool := &utils.StringPool{}
+	commits := generateCommits(hashPool, 50)
+	getStyle := func(commit *models.Commit) *style.TextStyle { return &style.FgDefault }
+
+	expected := GetPipeSets(commits, getStyle)
+
+	// computing the pipe sets in chunks gives the same result as computing them
+	// all at once
+	pipeSets := ContinuePipeSets(nil, commits[:7], getStyle)
+	for start := 7; start < len(commits); start += 7 {
+		end := min(start+7, len(commits))
+		pipeSets = append(pipeSets, ContinuePipeSets(pipeSets[start-1], commits[start:end], getStyle)...)
+	}
+
+	assert.Equal(t, expected, pipeSets)
+}
+
 func BenchmarkRenderCommitGraph(b *testing.B) {
 	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelMillions)
 	defer color.ForceSetColorLevel(oldColorLevel)
@@ -562,7 +641,7 @@ func BenchmarkRenderCommitGraph(b *testing.B) {
 	}
 	b.ResetTimer()
 	for b.Loop() {
-		RenderCommitGraph(commits, hashPool.Add("selected"), getStyle)
+		RenderCommitGraph(commits, hashPool.Add("selected"), getStyle, StyleRounded)
 	}
 }
 
@@ -1,10 +1,12 @@
 package icons
 
 import (
+	"maps"
 	"path/filepath"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
 )
 
 // NOTE: Visit next links for inspiration:
@@ -17,6 +19,10 @@ var (
 	DEFAULT_DIRECTORY_ICON = IconProperties{Icon: "\uf07b", Color: "#878787"}     // 
 )
 
+// Icon to use for untracked files instead of their file type icon; only set
+// if the user configured one
+var untrackedFileIcon = ""
+
 // NOTE: The filename map is case sensitive.
 var nameIconMap = map[string]IconProperties{
 	".atom":                      {Icon: "\ue764", Color: "#EED9B7"},     // 
@@ -756,22 +762,44 @@ var extIconMap = map[string]IconProperties{
 	".zst":            {Icon: "\uf410", Color: "#ECA517"},     // 
 }
 
-func patchFileIconsForNerdFontsV2() {
-	extIconMap[".cs"] = IconProperties{Icon: "\uf81a", Color: "#FEDECA"}      // 
-	extIconMap[".csproj"] = IconProperties{Icon: "\uf81a", Color: "#AB48BC"}  // 
-	extIconMap[".csx"] = IconProperties{Icon: "\uf81a", Color: "#0188D1"}     // 
-	extIconMap[".license"] = IconProperties{Icon: "\uf718", Color: "#626262"} // 
-	extIconMap[".node"] = IconProperties{Icon: "\uf898", Color: "#E8274B"}    // 
-	extIconMap[".rtf"] = IconProperties{Icon: "\uf718", Color: "#626262"}     // 
-	extIconMap[".vue"] = IconProperties{Icon: "\ufd42", Color: "#89e051"}     // ﵂
+// The nerd fonts v2 versions of icons that changed in v3
+var nerdFontsV2ExtIconMap = map[string]IconProperties{
+	".cs":      {Icon: "\uf81a", Color: "#FEDECA"}, // 
+	".csproj":  {Icon: "\uf81a", Color: "#AB48BC"}, // 
+	".csx":     {Icon: "\uf81a", Color: "#0188D1"}, // 
+	".license": {Icon: "\uf718", Color: "#626262"}, // 
+	".node":    {Icon: "\uf898", Color: "#E8274B"}, // 
+	".rtf":     {Icon: "\uf718", Color: "#626262"}, // 
+	".vue":     {Icon: "\ufd42", Color: "#89e051"}, // ﵂
+}
+
+// The nerd fonts v3 versions of the icons in nerdFontsV2ExtIconMap, so that we
+// can switch back to them when the icon set changes
+var nerdFontsV3ExtIconMap = lo.PickByKeys(extIconMap, lo.Keys(nerdFontsV2ExtIconMap))
+
+func setFileIcons(icons map[string]string) {
+	DEFAULT_FILE_ICON.Icon = icons["file"]
+	DEFAULT_DIRECTORY_ICON.Icon = icons["directory"]
+	DEFAULT_SUBMODULE_ICON.Icon = icons["submodule"]
+	untrackedFileIcon = icons["untrackedFile"]
+
+	if currentIconSet == "nerdFontsV2" {
+		maps.Copy(extIconMap, nerdFontsV2ExtIconMap)
+	} else {
+		maps.Copy(extIconMap, nerdFontsV3ExtIconMap)
+	}
 }
 
-func IconForFile(name string, isSubmodule bool, isLinkedWorktree bool, isDirectory bool, customIconsConfig *config.CustomIconsConfig) IconProperties {
+func IconForFile(name string, isSubmodule bool, isLinkedWorktree bool, isDirectory bool, isUntracked bool, customIconsConfig *config.CustomIconsConfig) IconProperties {
+	if isUntracked && untrackedFileIcon != "" {
+		return IconProperties{Icon: untrackedFileIcon, Color: DEFAULT_FILE_ICON.Color}
+	}
+
 	base := filepath.Base(name)
 	if icon, ok := customIconsConfig.Filenames[base]; ok {
 		return IconProperties{Color: icon.Color, Icon: icon.Icon}
 	}
-	if icon, ok := nameIconMap[base]; ok {
+	if icon, ok := nameIconMap[base]; ok && usesNerdFonts() {
 		return icon
 	}
 
@@ -779,7 +807,7 @@ func IconForFile(name string, isSubmodule bool, isLinkedWorktree bool, isDirecto
 	if icon, ok := customIconsConfig.Extensions[ext]; ok {
 		return IconProperties{Color: icon.Color, Icon: icon.Icon}
 	}
-	if icon, ok := extIconMap[ext]; ok {
+	if icon, ok := extIconMap[ext]; ok && usesNerdFonts() {
 		return icon
 	}
 
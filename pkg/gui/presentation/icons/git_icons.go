@@ -35,15 +35,22 @@ var remoteIcons = map[string]string{
 	"sr.ht":                  "\uf1db",     // 
 }
 
-func patchGitIconsForNerdFontsV2() {
-	BRANCH_ICON = "\ufb2b"                  // שׂ
-	COMMIT_ICON = "\ufc16"                  // ﰖ
-	MERGE_COMMIT_ICON = "\ufb2c"            // שּׁ
-	DEFAULT_REMOTE_ICON = "\uf7a1"          // 
-	LINKED_WORKTREE_ICON = "\uf838"         // 
-	MISSING_LINKED_WORKTREE_ICON = "\uf839" // 
-
-	remoteIcons["dev.azure.com"] = "\ufd03" // ﴃ
+func setGitIcons(icons map[string]string) {
+	BRANCH_ICON = icons["branch"]
+	DETACHED_HEAD_ICON = icons["detachedHead"]
+	TAG_ICON = icons["tag"]
+	COMMIT_ICON = icons["commit"]
+	MERGE_COMMIT_ICON = icons["mergeCommit"]
+	DEFAULT_REMOTE_ICON = icons["remote"]
+	STASH_ICON = icons["stash"]
+	LINKED_WORKTREE_ICON = icons["linkedWorktree"]
+	MISSING_LINKED_WORKTREE_ICON = icons["missingLinkedWorktree"]
+
+	if currentIconSet == "nerdFontsV2" {
+		remoteIcons["dev.azure.com"] = "\ufd03" // ﴃ
+	} else {
+		remoteIcons["dev.azure.com"] = "\U000f0805" // 󰠅
+	}
 }
 
 func IconForBranch(branch *models.Branch) string {
@@ -69,6 +76,10 @@ func IconForCommit(commit *models.Commit) string {
 }
 
 func IconForRemote(remote *models.Remote) string {
+	if !usesNerdFonts() {
+		return DEFAULT_REMOTE_ICON
+	}
+
 	for domain, icon := range remoteIcons {
 		for _, url := range remote.Urls {
 			if strings.Contains(url, domain) {
@@ -80,6 +91,10 @@ func IconForRemote(remote *models.Remote) string {
 }
 
 func IconForRemoteUrl(url string) string {
+	if !usesNerdFonts() {
+		return DEFAULT_REMOTE_ICON
+	}
+
 	for domain, icon := range remoteIcons {
 		if strings.Contains(url, domain) {
 			return icon
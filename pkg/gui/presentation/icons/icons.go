@@ -2,8 +2,7 @@ package icons
 
 import (
 	"log"
-
-	"github.com/samber/lo"
+	"maps"
 )
 
 type IconProperties struct {
@@ -13,23 +12,109 @@ type IconProperties struct {
 
 var isIconEnabled = false
 
+// the icon set currently in use; empty if icons are disabled
+var currentIconSet = ""
+
 func IsIconEnabled() bool {
 	return isIconEnabled
 }
 
-func SetNerdFontsVersion(version string) {
-	if version == "" {
+// usesNerdFonts tells us whether we can use the nerd fonts glyphs for
+// individual file types and remote hosts, which the other icon sets don't have
+// an equivalent for
+func usesNerdFonts() bool {
+	return currentIconSet == "nerdFontsV2" || currentIconSet == "nerdFontsV3"
+}
+
+// The icons that can be customised per icon set and overridden individually
+// via gui.customIcons.icons. File icons for known file names and extensions
+// are only available in the nerd fonts sets.
+var iconSets = map[string]map[string]string{
+	"nerdFontsV3": {
+		"branch":                "\U000f062c", // 󰘬
+		"detachedHead":          "\ue729",     // 
+		"tag":                   "\uf02b",     // 
+		"commit":                "\U000f0718", // 󰜘
+		"mergeCommit":           "\U000f062d", // 󰘭
+		"remote":                "\U000f02a2", // 󰊢
+		"stash":                 "\uf01c",     // 
+		"linkedWorktree":        "\U000f0339", // 󰌹
+		"missingLinkedWorktree": "\U000f033a", // 󰌺
+		"file":                  "\uf15b",     // 
+		"directory":             "\uf07b",     // 
+		"submodule":             "\U000f02a2", // 󰊢
+	},
+	"nerdFontsV2": {
+		"branch":                "\ufb2b",     // שׂ
+		"detachedHead":          "\ue729",     // 
+		"tag":                   "\uf02b",     // 
+		"commit":                "\ufc16",     // ﰖ
+		"mergeCommit":           "\ufb2c",     // שּׁ
+		"remote":                "\uf7a1",     // 
+		"stash":                 "\uf01c",     // 
+		"linkedWorktree":        "\uf838",     // 
+		"missingLinkedWorktree": "\uf839",     // 
+		"file":                  "\uf15b",     // 
+		"directory":             "\uf07b",     // 
+		"submodule":             "\U000f02a2", // 󰊢
+	},
+	"ascii": {
+		"branch":                "b",
+		"detachedHead":          "@",
+		"tag":                   "#",
+		"commit":                "o",
+		"mergeCommit":           "m",
+		"remote":                "r",
+		"stash":                 "$",
+		"linkedWorktree":        "w",
+		"missingLinkedWorktree": "!",
+		"file":                  "-",
+		"directory":             "+",
+		"submodule":             "s",
+	},
+	"emoji": {
+		"branch":                "🌿",
+		"detachedHead":          "📍",
+		"tag":                   "🔖",
+		"commit":                "🔸",
+		"mergeCommit":           "🔀",
+		"remote":                "🌐",
+		"stash":                 "📦",
+		"linkedWorktree":        "🌳",
+		"missingLinkedWorktree": "🍂",
+		"file":                  "📄",
+		"directory":             "📁",
+		"submodule":             "🧩",
+	},
+}
+
+// SetIconSet switches to the given icon set (one of the keys of iconSets, or
+// empty to disable icons), applying the given overrides for individual icons
+// on top of it.
+func SetIconSet(iconSet string, overrides map[string]string) {
+	currentIconSet = iconSet
+	if iconSet == "" {
 		isIconEnabled = false
-	} else {
-		if !lo.Contains([]string{"2", "3"}, version) {
-			log.Fatalf("Unsupported nerdFontVersion %s", version)
-		}
+		return
+	}
+
+	icons, ok := iconSets[iconSet]
+	if !ok {
+		log.Fatalf("Unsupported icon set %s", iconSet)
+	}
+
+	icons = maps.Clone(icons)
+	maps.Copy(icons, overrides)
+	setGitIcons(icons)
+	setFileIcons(icons)
 
-		if version == "2" {
-			patchGitIconsForNerdFontsV2()
-			patchFileIconsForNerdFontsV2()
-		}
+	isIconEnabled = true
+}
 
-		isIconEnabled = true
+func SetNerdFontsVersion(version string) {
+	if version == "" {
+		SetIconSet("", nil)
+	} else {
+		SetIconSet("nerdFontsV"+version, nil)
 	}
 }
@@ -0,0 +1,44 @@
+package icons
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIconSet(t *testing.T) {
+	defer SetIconSet("", nil)
+
+	noCustomIcons := &config.CustomIconsConfig{}
+	githubRemote := &models.Remote{Urls: []string{"git@github.com:jesseduffield/lazygit.git"}}
+
+	SetIconSet("nerdFontsV2", nil)
+	assert.True(t, IsIconEnabled())
+	assert.Equal(t, "\ufb2b", BRANCH_ICON)
+	assert.Equal(t, "\uf81a", IconForFile("main.cs", false, false, false, false, noCustomIcons).Icon)
+
+	// Switching sets restores icons that the previous set changed
+	SetIconSet("nerdFontsV3", nil)
+	assert.Equal(t, "\U000f062c", BRANCH_ICON)
+	assert.Equal(t, "\U000f031b", IconForFile("main.cs", false, false, false, false, noCustomIcons).Icon)
+	assert.Equal(t, "\ue709", IconForRemote(githubRemote))
+
+	// Sets without nerd fonts don't use file type or remote host icons
+	SetIconSet("ascii", nil)
+	assert.Equal(t, "b", BRANCH_ICON)
+	assert.Equal(t, "-", IconForFile("main.cs", false, false, false, false, noCustomIcons).Icon)
+	assert.Equal(t, "+", IconForFile("pkg", false, false, true, false, noCustomIcons).Icon)
+	assert.Equal(t, "r", IconForRemote(githubRemote))
+
+	// Overrides take precedence over the set's own icons
+	SetIconSet("emoji", map[string]string{"branch": "B", "untrackedFile": "?"})
+	assert.Equal(t, "B", BRANCH_ICON)
+	assert.Equal(t, "🔖", TAG_ICON)
+	assert.Equal(t, "?", IconForFile("main.go", false, false, false, true, noCustomIcons).Icon)
+	assert.Equal(t, "📄", IconForFile("main.go", false, false, false, false, noCustomIcons).Icon)
+
+	SetIconSet("", nil)
+	assert.False(t, IsIconEnabled())
+}
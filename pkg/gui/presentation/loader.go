@@ -1,6 +1,7 @@
 package presentation
 
 import (
+	"strings"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/config"
@@ -12,3 +13,10 @@ func Loader(now time.Time, config config.SpinnerConfig) string {
 	index := milliseconds / int64(config.Rate) % int64(len(config.Frames))
 	return config.Frames[index]
 }
+
+// ProgressBar returns a bar of the given width that is filled to the given
+// percentage
+func ProgressBar(percent int, width int) string {
+	filled := min(max(percent, 0), 100) * width / 100
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
@@ -3,19 +3,24 @@ package presentation
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 )
 
-func GetRemoteBranchListDisplayStrings(branches []*models.RemoteBranch, diffName string) [][]string {
+func GetRemoteBranchListDisplayStrings(
+	branches []*models.RemoteBranch,
+	diffName string,
+	divergences map[string]*models.RemoteBranchDivergence,
+) [][]string {
 	return lo.Map(branches, func(branch *models.RemoteBranch, _ int) []string {
 		diffed := branch.FullName() == diffName
-		return getRemoteBranchDisplayStrings(branch, diffed)
+		return getRemoteBranchDisplayStrings(branch, diffed, divergences[branch.FullName()])
 	})
 }
 
 // getRemoteBranchDisplayStrings returns the display string of branch
-func getRemoteBranchDisplayStrings(b *models.RemoteBranch, diffed bool) []string {
+func getRemoteBranchDisplayStrings(b *models.RemoteBranch, diffed bool, divergence *models.RemoteBranchDivergence) []string {
 	textStyle := GetBranchTextStyle(b.Name)
 	if diffed {
 		textStyle = theme.DiffTerminalColor
@@ -25,6 +30,33 @@ func getRemoteBranchDisplayStrings(b *models.RemoteBranch, diffed bool) []string
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForRemoteBranch(b)))
 	}
-	res = append(res, textStyle.Sprint(b.Name))
+	coloredName := textStyle.Sprint(b.Name)
+	if status := remoteBranchStatus(divergence); status != "" {
+		coloredName += " " + status
+	}
+	res = append(res, coloredName)
 	return res
 }
+
+// remoteBranchStatus shows how far the remote branch has diverged from the
+// local branch tracking it, from the point of view of the remote branch: ↑
+// means the remote has commits the local branch doesn't, ↓ means it's missing
+// commits from the local branch.
+func remoteBranchStatus(divergence *models.RemoteBranchDivergence) string {
+	if divergence == nil {
+		return ""
+	}
+
+	isAhead := divergence.Ahead != "0"
+	isBehind := divergence.Behind != "0"
+	switch {
+	case divergence.InSync():
+		return style.FgGreen.Sprint("✓")
+	case isAhead && isBehind:
+		return style.FgYellow.Sprintf("↓%s↑%s", divergence.Behind, divergence.Ahead)
+	case isBehind:
+		return style.FgYellow.Sprintf("↓%s", divergence.Behind)
+	default:
+		return style.FgYellow.Sprintf("↑%s", divergence.Ahead)
+	}
+}
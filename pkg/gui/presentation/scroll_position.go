@@ -0,0 +1,16 @@
+package presentation
+
+import "fmt"
+
+// ScrollPosition returns something like 'line 21/400 (10%)' for a view
+// scrolled down by originY lines, where the percentage refers to the last
+// visible line. Returns an empty string if all of the content fits into the
+// view, since there's nothing to scroll then.
+func ScrollPosition(originY int, viewHeight int, contentHeight int) string {
+	if contentHeight <= viewHeight || viewHeight <= 0 {
+		return ""
+	}
+
+	lastVisibleLine := min(originY+viewHeight, contentHeight)
+	return fmt.Sprintf("line %d/%d (%d%%)", originY+1, contentHeight, lastVisibleLine*100/contentHeight)
+}
@@ -0,0 +1,59 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrollPosition(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		originY       int
+		viewHeight    int
+		contentHeight int
+		expected      string
+	}{
+		{
+			name:          "content fits into view",
+			originY:       0,
+			viewHeight:    10,
+			contentHeight: 10,
+			expected:      "",
+		},
+		{
+			name:          "at the top",
+			originY:       0,
+			viewHeight:    10,
+			contentHeight: 100,
+			expected:      "line 1/100 (10%)",
+		},
+		{
+			name:          "in the middle",
+			originY:       45,
+			viewHeight:    10,
+			contentHeight: 100,
+			expected:      "line 46/100 (55%)",
+		},
+		{
+			name:          "at the bottom",
+			originY:       90,
+			viewHeight:    10,
+			contentHeight: 100,
+			expected:      "line 91/100 (100%)",
+		},
+		{
+			name:          "scrolled past the end",
+			originY:       95,
+			viewHeight:    10,
+			contentHeight: 100,
+			expected:      "line 96/100 (100%)",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, ScrollPosition(s.originY, s.viewHeight, s.contentHeight))
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package presentation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/rivo/uniseg"
+)
+
+// Renders a diff (as output by git diff or git show, without colors) with the
+// old version of each hunk on the left and the new one on the right, so that
+// removed lines appear next to the lines that replaced them. Lines that don't
+// belong to a hunk (like the commit header or the file headers) are rendered
+// as they are. Lines that don't fit into their half of the given width are
+// wrapped.
+func RenderSideBySideDiff(diff string, width int) string {
+	renderer := &sideBySideDiffRenderer{columnWidth: max((width-len(sideBySideSeparator))/2, minSideBySideColumnWidth)}
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		renderer.addLine(line)
+	}
+	renderer.flushChanges()
+
+	return strings.Join(renderer.output, "\n")
+}
+
+const (
+	sideBySideSeparator      = "│"
+	minSideBySideColumnWidth = 20
+	sideBySideTabWidth       = 4
+)
+
+// Combined diffs of merge commits start with "@@@" and don't match this, so
+// they are rendered as they are
+var sideBySideHunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+type sideBySideLine struct {
+	number int
+	text   string
+}
+
+type sideBySideDiffRenderer struct {
+	columnWidth int
+	output      []string
+
+	// The remaining number of old and new lines of the hunk that we're in;
+	// both are zero outside of hunks
+	oldRemaining int
+	newRemaining int
+	oldNumber    int
+	newNumber    int
+	numberWidth  int
+
+	// Removed and added lines that haven't been rendered yet, so that we can
+	// put them next to each other
+	removed []sideBySideLine
+	added   []sideBySideLine
+}
+
+func (self *sideBySideDiffRenderer) addLine(line string) {
+	if self.oldRemaining == 0 && self.newRemaining == 0 {
+		self.addLineOutsideHunk(line)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(line, "-"):
+		self.removed = append(self.removed, sideBySideLine{number: self.oldNumber, text: line[1:]})
+		self.oldNumber++
+		self.oldRemaining--
+	case strings.HasPrefix(line, "+"):
+		self.added = append(self.added, sideBySideLine{number: self.newNumber, text: line[1:]})
+		self.newNumber++
+		self.newRemaining--
+	case strings.HasPrefix(line, `\`):
+		// "\ No newline at end of file"; there's no good place for it in
+		// either column, so we leave it out
+	default:
+		self.flushChanges()
+		text := strings.TrimPrefix(line, " ")
+		self.addRow(
+			&sideBySideLine{number: self.oldNumber, text: text},
+			&sideBySideLine{number: self.newNumber, text: text},
+			style.FgDefault,
+			style.FgDefault,
+		)
+		self.oldNumber++
+		self.newNumber++
+		self.oldRemaining--
+		self.newRemaining--
+	}
+
+	// A hunk can end with removed lines only, e.g. at the end of a file
+	if self.oldRemaining <= 0 && self.newRemaining <= 0 {
+		self.oldRemaining, self.newRemaining = 0, 0
+		self.flushChanges()
+	}
+}
+
+func (self *sideBySideDiffRenderer) addLineOutsideHunk(line string) {
+	if strings.HasPrefix(line, `\`) {
+		// the "\ No newline at end of file" of the last line of a hunk
+		return
+	}
+
+	match := sideBySideHunkHeaderRegexp.FindStringSubmatch(line)
+	if match == nil {
+		self.output = append(self.output, sideBySideHeaderStyle(line).Sprint(line))
+		return
+	}
+
+	self.oldNumber = atoiOr(match[1], 0)
+	self.oldRemaining = atoiOr(match[2], 1)
+	self.newNumber = atoiOr(match[3], 0)
+	self.newRemaining = atoiOr(match[4], 1)
+	self.numberWidth = len(strconv.Itoa(max(self.oldNumber+self.oldRemaining, self.newNumber+self.newRemaining)))
+	self.output = append(self.output, style.FgCyan.Sprint(line))
+}
+
+func sideBySideHeaderStyle(line string) style.TextStyle {
+	for _, prefix := range []string{"diff ", "index ", "--- ", "+++ ", "new file mode", "deleted file mode", "similarity index", "rename from", "rename to"} {
+		if strings.HasPrefix(line, prefix) {
+			return style.AttrBold
+		}
+	}
+	if strings.HasPrefix(line, "commit ") {
+		return style.FgYellow
+	}
+	return style.FgDefault
+}
+
+func atoiOr(str string, fallback int) int {
+	if str == "" {
+		return fallback
+	}
+	number, err := strconv.Atoi(str)
+	if err != nil {
+		return fallback
+	}
+	return number
+}
+
+// Renders the pending removed and added lines next to each other
+func (self *sideBySideDiffRenderer) flushChanges() {
+	for i := range max(len(self.removed), len(self.added)) {
+		var left, right *sideBySideLine
+		if i < len(self.removed) {
+			left = &self.removed[i]
+		}
+		if i < len(self.added) {
+			right = &self.added[i]
+		}
+		self.addRow(left, right, style.FgRed, style.FgGreen)
+	}
+
+	self.removed = nil
+	self.added = nil
+}
+
+// Adds a row for the given old and new line (either of which can be nil),
+// wrapping the text of the lines over as many rows as needed
+func (self *sideBySideDiffRenderer) addRow(left *sideBySideLine, right *sideBySideLine, leftStyle style.TextStyle, rightStyle style.TextStyle) {
+	textWidth := max(self.columnWidth-self.numberWidth-1, 1)
+	leftChunks := self.wrap(left, textWidth)
+	rightChunks := self.wrap(right, textWidth)
+
+	for i := range max(len(leftChunks), len(rightChunks)) {
+		leftCell := self.cell(left, leftChunks, i, leftStyle)
+		rightCell := self.cell(right, rightChunks, i, rightStyle)
+		row := utils.WithPadding(leftCell, self.columnWidth, utils.AlignLeft) + sideBySideSeparator + rightCell
+		self.output = append(self.output, strings.TrimRight(row, " "))
+	}
+}
+
+func (self *sideBySideDiffRenderer) wrap(line *sideBySideLine, width int) []string {
+	if line == nil {
+		return nil
+	}
+
+	return wrapToWidth(expandTabs(line.text), width)
+}
+
+// The cell of the given line in the row with the given index; only the first
+// row of a line shows its number
+func (self *sideBySideDiffRenderer) cell(line *sideBySideLine, chunks []string, idx int, textStyle style.TextStyle) string {
+	if line == nil || idx >= len(chunks) {
+		return ""
+	}
+
+	number := strings.Repeat(" ", self.numberWidth)
+	if idx == 0 {
+		number = utils.WithPadding(strconv.Itoa(line.number), self.numberWidth, utils.AlignRight)
+	}
+	return style.FgBlackLighter.Sprint(number) + " " + textStyle.Sprint(chunks[idx])
+}
+
+// We need to know how wide each line is, so we can't leave tabs to the view
+func expandTabs(text string) string {
+	if !strings.Contains(text, "\t") {
+		return text
+	}
+
+	var result strings.Builder
+	column := 0
+	for _, r := range text {
+		if r == '\t' {
+			spaces := sideBySideTabWidth - column%sideBySideTabWidth
+			result.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+			continue
+		}
+		result.WriteRune(r)
+		column += uniseg.StringWidth(string(r))
+	}
+	return result.String()
+}
+
+// Splits the text into chunks that are at most the given width wide. Empty text
+// gives a single empty chunk, so that empty lines still get a row.
+func wrapToWidth(text string, width int) []string {
+	chunks := []string{}
+	var current strings.Builder
+	currentWidth := 0
+	for _, r := range text {
+		runeWidth := uniseg.StringWidth(string(r))
+		if currentWidth+runeWidth > width && currentWidth > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+			currentWidth = 0
+		}
+		current.WriteRune(r)
+		currentWidth += runeWidth
+	}
+	return append(chunks, current.String())
+}
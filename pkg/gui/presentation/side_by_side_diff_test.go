@@ -0,0 +1,113 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestRenderSideBySideDiff(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		diff     string
+		width    int
+		expected []string
+	}{
+		{
+			name: "changed lines are shown next to each other",
+			diff: `diff --git a/file b/file
+index 1234567..89abcde 100644
+--- a/file
++++ b/file
+@@ -1,4 +1,5 @@
+ first
+-second
+-third
++2nd
++3rd
++3.5th
+ fourth
+`,
+			width: 41,
+			expected: []string{
+				"diff --git a/file b/file",
+				"index 1234567..89abcde 100644",
+				"--- a/file",
+				"+++ b/file",
+				"@@ -1,4 +1,5 @@",
+				"1 first             │1 first",
+				"2 second            │2 2nd",
+				"3 third             │3 3rd",
+				"                    │4 3.5th",
+				"4 fourth            │5 fourth",
+			},
+		},
+		{
+			name: "long lines are wrapped",
+			diff: `@@ -9,2 +9,2 @@
+-a line that is too long for its column
++short
+ end
+`,
+			width: 41,
+			expected: []string{
+				"@@ -9,2 +9,2 @@",
+				" 9 a line that is to│ 9 short",
+				"   o long for its co│",
+				"   lumn             │",
+				"10 end              │10 end",
+			},
+		},
+		{
+			name: "lines that look like headers inside a hunk are still part of it",
+			diff: `@@ -1,2 +1,2 @@
+--- removed
++++ added
+ end
+commit 1234567
+`,
+			width: 41,
+			expected: []string{
+				"@@ -1,2 +1,2 @@",
+				"1 -- removed        │1 ++ added",
+				"2 end               │2 end",
+				"commit 1234567",
+			},
+		},
+		{
+			name: "hunk with only removed lines at the end of the file",
+			diff: `@@ -1,2 +1 @@
+ keep
+-remove
+\ No newline at end of file
+`,
+			width: 41,
+			expected: []string{
+				"@@ -1,2 +1 @@",
+				"1 keep              │1 keep",
+				"2 remove            │",
+			},
+		},
+		{
+			name:  "tabs are expanded",
+			diff:  "@@ -1 +1 @@\n-\tx\n+\t\ty\n",
+			width: 41,
+			expected: []string{
+				"@@ -1 +1 @@",
+				"1     x             │1         y",
+			},
+		},
+	}
+
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, strings.Join(s.expected, "\n"), RenderSideBySideDiff(s.diff, s.width))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
@@ -16,6 +17,9 @@ import (
 	"github.com/samber/lo"
 )
 
+// custom commands that take longer than this show a toast when they are done
+const longRunningCommandThreshold = 5 * time.Second
+
 // takes a custom command and returns a function that will be called when the corresponding user-defined keybinding is pressed
 type HandlerCreator struct {
 	c                    *helpers.HelperCommon
@@ -313,10 +317,19 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 		if customCommand.Output == "logWithPty" {
 			cmdObj.UsePty()
 		}
+		start := time.Now()
 		output, err := cmdObj.RunWithOutput()
 
 		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 
+		// The waiting status disappears silently when the command is done;
+		// for commands that take a while the user has probably moved on in
+		// the meantime, so let them know.
+		if err == nil && customCommand.Output != "popup" && time.Since(start) > longRunningCommandThreshold {
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.CustomCommandFinished,
+				map[string]string{"command": customCommand.GetDescription()}))
+		}
+
 		if err != nil {
 			if customCommand.After != nil && customCommand.After.CheckForConflicts {
 				return self.mergeAndRebaseHelper.CheckForConflicts(err)
@@ -0,0 +1,151 @@
+package gui
+
+import (
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// When quitting, we remember where the user was in each open repo (focused
+// panel, selections, filters and diffing mode), and restore it the next time
+// the repo is opened; see gui.restoreSession.
+
+// The contexts whose state we remember. Other side contexts (e.g. the sub
+// commits of a branch) only make sense together with the state of their
+// parent context, so for those we remember the context of their window.
+func sessionContexts(contextTree *context.ContextTree) []types.IListContext {
+	return []types.IListContext{
+		contextTree.Files,
+		contextTree.Worktrees,
+		contextTree.Submodules,
+		contextTree.Branches,
+		contextTree.Remotes,
+		contextTree.Tags,
+		contextTree.LocalCommits,
+		contextTree.ReflogCommits,
+		contextTree.Stash,
+	}
+}
+
+func (gui *Gui) saveRepoSessions() {
+	if !gui.c.UserConfig().Gui.RestoreSession || gui.toolMode.Active() || gui.singleAction != nil {
+		return
+	}
+
+	appState := gui.c.GetAppState()
+	if appState.RepoSessions == nil {
+		appState.RepoSessions = map[string]config.RepoSession{}
+	}
+
+	for repo, state := range gui.RepoStateMap {
+		appState.RepoSessions[string(repo)] = repoSession(state)
+	}
+
+	// Forget about repos that are no longer in the recent repos list, so that
+	// the state file doesn't keep growing
+	for path := range appState.RepoSessions {
+		if !slices.Contains(appState.RecentRepos, path) && gui.RepoStateMap[Repo(path)] == nil {
+			delete(appState.RepoSessions, path)
+		}
+	}
+
+	gui.c.SaveAppStateAndLogError()
+}
+
+func repoSession(state *GuiRepoState) config.RepoSession {
+	session := config.RepoSession{
+		Lists:          map[string]config.ListSession{},
+		FilterPath:     state.Modes.Filtering.GetPath(),
+		FilterAuthor:   state.Modes.Filtering.GetAuthor(),
+		FilterSince:    state.Modes.Filtering.GetSince(),
+		FilterUntil:    state.Modes.Filtering.GetUntil(),
+		FilterContent:  state.Modes.Filtering.GetContent(),
+		FilterRegex:    state.Modes.Filtering.ContentIsRegex(),
+		DiffingRef:     state.Modes.Diffing.Ref,
+		DiffingReverse: state.Modes.Diffing.Reverse,
+	}
+
+	contexts := sessionContexts(state.Contexts)
+
+	focused := state.ContextMgr.CurrentSide()
+	if focused == state.Contexts.Status {
+		session.FocusedContext = string(focused.GetKey())
+	} else if ctx, ok := lo.Find(contexts, func(ctx types.IListContext) bool {
+		return ctx.GetWindowName() == focused.GetWindowName()
+	}); ok {
+		session.FocusedContext = string(ctx.GetKey())
+	}
+
+	for _, ctx := range contexts {
+		list := ctx.GetList()
+		listSession := config.ListSession{}
+		if list.Len() > 0 {
+			selectedIdx := list.GetSelectedLineIdx()
+			listSession.SelectedItemId = ctx.GetSelectedItemId()
+			listSession.SelectedIdx = selectedIdx
+			listSession.ScrollOffset = max(0, ctx.ModelIndexToViewIndex(selectedIdx)-ctx.GetView().OriginY())
+		}
+		if filterableContext, ok := ctx.(types.IFilterableContext); ok && filterableContext.IsFiltering() {
+			listSession.Filter = filterableContext.GetFilter()
+		}
+		if listSession != (config.ListSession{}) {
+			session.Lists[string(ctx.GetKey())] = listSession
+		}
+	}
+
+	return session
+}
+
+// Restores the session of the current repo into the given (new) repo state.
+// Returns the context to focus, or nil if there is nothing to restore. The
+// filtering mode and the focused context are only restored if they weren't
+// given on the command line.
+func (gui *Gui) restoreSession(state *GuiRepoState, restoreFocus bool, restoreFiltering bool) types.Context {
+	if !gui.c.UserConfig().Gui.RestoreSession {
+		return nil
+	}
+
+	session, ok := gui.c.GetAppState().RepoSessions[gui.git.RepoPaths.WorktreePath()]
+	if !ok {
+		return nil
+	}
+
+	if restoreFiltering {
+		state.Modes.Filtering.SetPath(session.FilterPath)
+		state.Modes.Filtering.SetAuthor(session.FilterAuthor)
+		state.Modes.Filtering.SetSince(session.FilterSince)
+		state.Modes.Filtering.SetUntil(session.FilterUntil)
+		state.Modes.Filtering.SetContent(session.FilterContent, session.FilterRegex)
+	}
+	state.Modes.Diffing.Ref = session.DiffingRef
+	state.Modes.Diffing.Reverse = session.DiffingReverse
+
+	var contextToFocus types.Context
+	if session.FocusedContext == string(state.Contexts.Status.GetKey()) {
+		contextToFocus = state.Contexts.Status
+	}
+
+	for _, ctx := range sessionContexts(state.Contexts) {
+		key := string(ctx.GetKey())
+		if key == session.FocusedContext {
+			contextToFocus = ctx
+		}
+
+		listSession, ok := session.Lists[key]
+		if !ok {
+			continue
+		}
+		ctx.RestoreSelectionWhenLoaded(listSession.SelectedItemId, listSession.SelectedIdx, listSession.ScrollOffset)
+		if filterableContext, ok := ctx.(types.IFilterableContext); ok && listSession.Filter != "" {
+			filterableContext.SetFilter(listSession.Filter, gui.c.UserConfig().Gui.UseFuzzySearch())
+		}
+	}
+
+	if !restoreFocus {
+		return nil
+	}
+	return contextToFocus
+}
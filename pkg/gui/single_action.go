@@ -0,0 +1,162 @@
+package gui
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/jesseduffield/gocui"
+	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The action that lazygit was started for with e.g. `lazygit commit`. Once it
+// has been started, we quit as soon as lazygit is idle again without a popup
+// open, i.e. when the action is done or the user cancelled it.
+type singleAction struct {
+	gitArg appTypes.GitArg
+
+	started  atomic.Bool
+	quitting atomic.Bool
+	// set when the action was logged, i.e. the user confirmed it
+	performed atomic.Bool
+	// set when an error was shown to the user after that
+	failed atomic.Bool
+}
+
+func newSingleAction(gitArg appTypes.GitArg) *singleAction {
+	if !gitArg.IsSingleAction() {
+		return nil
+	}
+
+	return &singleAction{gitArg: gitArg}
+}
+
+// The exit code tells the shell whether the action succeeded, so that e.g.
+// `lazygit commit && git push` only pushes if something was committed
+func (self *singleAction) exitCode() int {
+	if self.performed.Load() && !self.failed.Load() {
+		return 0
+	}
+
+	return 1
+}
+
+// Must be called before the main loop starts, because adding an idle listener
+// isn't thread-safe
+func (gui *Gui) setupSingleAction() {
+	action := gui.singleAction
+	if action == nil {
+		return
+	}
+
+	handleError := gui.g.ErrorHandler
+	gui.g.ErrorHandler = func(err error) error {
+		if action.performed.Load() {
+			action.failed.Store(true)
+		}
+		return handleError(err)
+	}
+
+	idleChan := make(chan struct{})
+	gui.g.AddIdleListener(idleChan)
+
+	go utils.Safe(func() {
+		// We need to keep receiving from the channel, otherwise gocui blocks
+		// when notifying us
+		for range idleChan {
+			if !action.started.Load() || gui.helpers.Confirmation.IsPopupPanelFocused() {
+				continue
+			}
+
+			if action.quitting.CompareAndSwap(false, true) {
+				// Creating tasks needs the lock that gocui holds while
+				// notifying us, so we can't do that here
+				go gui.quitAfterSingleAction()
+			}
+		}
+	})
+}
+
+func (gui *Gui) quitAfterSingleAction() {
+	// We never finish this task, so that we stay busy until we've quit.
+	// Otherwise, whoever waits for us to become idle (e.g. an integration test)
+	// would be notified again in between.
+	gui.g.NewTask()
+
+	gui.g.Update(func(*gocui.Gui) error {
+		return gocui.ErrQuit
+	})
+}
+
+// Called for every logged action, from whichever goroutine performs it
+func (gui *Gui) onSingleActionLogged(actionName string) {
+	if gui.singleAction != nil && actionName == gui.singleActionLogName() {
+		gui.singleAction.performed.Store(true)
+	}
+}
+
+func (gui *Gui) singleActionLogName() string {
+	switch gui.singleAction.gitArg {
+	case appTypes.GitArgCommit:
+		return gui.c.Tr.Actions.Commit
+	case appTypes.GitArgPull:
+		return gui.c.Tr.Actions.Pull
+	case appTypes.GitArgBranchPicker:
+		return gui.c.Tr.Actions.CheckoutBranch
+	}
+
+	return ""
+}
+
+// The actions need the branches and files, so we start them after the
+// initial refresh
+func (gui *Gui) refreshAndStartSingleAction() {
+	gui.c.OnWorker(func(gocui.Task) error {
+		gui.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+
+		gui.c.OnUIThread(func() error {
+			gui.singleAction.started.Store(true)
+
+			switch gui.singleAction.gitArg {
+			case appTypes.GitArgCommit:
+				return gui.helpers.WorkingTree.HandleCommitPress()
+			case appTypes.GitArgPull:
+				return gui.handlePull()
+			case appTypes.GitArgBranchPicker:
+				return gui.openBranchPicker()
+			}
+
+			return nil
+		})
+		return nil
+	})
+}
+
+func (gui *Gui) openBranchPicker() error {
+	// The checked-out branch (or detached head) is the one we'd switch away
+	// from, so there's no point in offering it
+	branches := lo.Reject(gui.State.Model.Branches, func(branch *models.Branch, _ int) bool {
+		return branch.Head
+	})
+	if len(branches) == 0 {
+		return errors.New(gui.c.Tr.NoBranchesThisRepo)
+	}
+
+	menuItems := lo.Map(branches, func(branch *models.Branch, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: branch.Name,
+			OnPress: func() error {
+				gui.c.LogAction(gui.c.Tr.Actions.CheckoutBranch)
+				return gui.helpers.Refs.CheckoutRef(branch.Name, types.CheckoutRefOptions{})
+			},
+		}
+	})
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: gui.c.Tr.Actions.CheckoutBranch,
+		Items: menuItems,
+	})
+}
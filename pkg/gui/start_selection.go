@@ -0,0 +1,80 @@
+package gui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Selects the commit or file that was given with the --commit or --file arg.
+// This has to wait for the initial refresh, so unlike usual we do that
+// synchronously on a worker.
+func (gui *Gui) refreshAndSelectStartItem() {
+	commit, file := gui.startCommit, gui.startFile
+	gui.startCommit, gui.startFile = "", ""
+
+	gui.c.OnWorker(func(gocui.Task) error {
+		gui.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+
+		if commit != "" {
+			return gui.selectStartCommit(commit)
+		}
+		return gui.selectStartFile(file)
+	})
+}
+
+func (gui *Gui) selectStartCommit(revision string) error {
+	hash, err := gui.git.Commit.ResolveCommitHash(revision)
+	if err != nil {
+		return fmt.Errorf(gui.c.Tr.StartCommitNotFound, revision)
+	}
+
+	commitsContext := gui.State.Contexts.LocalCommits
+	isLoaded := func() bool {
+		return lo.ContainsBy(gui.State.Model.Commits, func(commit *models.Commit) bool {
+			return commit.Hash() == hash
+		})
+	}
+
+	// We only load the first few hundred commits initially
+	if !isLoaded() && commitsContext.GetLimitCommits() {
+		commitsContext.SetLimitCommits(false)
+		gui.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}})
+	}
+
+	gui.c.OnUIThread(func() error {
+		if !commitsContext.SelectCommitByHash(hash) {
+			return fmt.Errorf(gui.c.Tr.StartCommitNotInCurrentBranch, revision)
+		}
+
+		gui.c.PostRefreshUpdate(commitsContext)
+		return nil
+	})
+
+	return nil
+}
+
+func (gui *Gui) selectStartFile(absPath string) error {
+	path, err := filepath.Rel(gui.git.RepoPaths.WorktreePath(), absPath)
+	if err != nil {
+		return err
+	}
+	path = filepath.ToSlash(path)
+
+	gui.c.OnUIThread(func() error {
+		filesContext := gui.State.Contexts.Files
+		if !lo.ContainsBy(gui.State.Model.Files, func(file *models.File) bool { return file.Path == path }) {
+			return fmt.Errorf(gui.c.Tr.StartFileNotFound, path)
+		}
+
+		filesContext.FileTreeViewModel.SelectPath(path, gui.c.UserConfig().Gui.ShowRootItemInFileTree)
+		gui.c.PostRefreshUpdate(filesContext)
+		return nil
+	})
+
+	return nil
+}
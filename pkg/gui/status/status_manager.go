@@ -1,9 +1,12 @@
 package status
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -14,11 +17,25 @@ import (
 // StatusManager's job is to handle queuing of loading states and toast notifications
 // that you see at the bottom left of the screen.
 type StatusManager struct {
-	statuses []appStatus
-	nextId   int
-	mutex    deadlock.Mutex
+	statuses     []appStatus
+	toastHistory []ToastHistoryEntry
+	nextId       int
+	mutex        deadlock.Mutex
 }
 
+// A toast that was shown at some point; we keep these around so that users
+// can look up a toast that disappeared before they had a chance to read it.
+type ToastHistoryEntry struct {
+	Message string
+	Kind    types.ToastKind
+	Time    time.Time
+}
+
+// The number of toasts that we remember; older ones are dropped.
+const maxToastHistory = 100
+
+const progressBarWidth = 10
+
 // Can be used to manipulate a waiting status while it is running (e.g. pause
 // and resume it)
 type WaitingStatusHandle struct {
@@ -37,11 +54,49 @@ func (self *WaitingStatusHandle) Hide() {
 	self.statusManager.removeStatus(self.id)
 }
 
+// Shows the progress of a command as part of the waiting status of the
+// operation that runs it. It belongs to the waiting status that was shown most
+// recently when it was created, preferring one that doesn't have a handle yet,
+// so that when several operations run at the same time, each one shows the
+// progress of its own command.
+type ProgressHandle struct {
+	statusManager *StatusManager
+	// -1 if there was no waiting status to show the progress in
+	statusId int
+}
+
+var _ oscommands.ProgressReporter = &ProgressHandle{}
+
+func (self *ProgressHandle) SetProgress(progress *oscommands.Progress) {
+	self.statusManager.mutex.Lock()
+	defer self.statusManager.mutex.Unlock()
+
+	if status := self.statusManager.findStatus(self.statusId); status != nil {
+		status.progress = progress
+		status.progressHandle = self
+	}
+}
+
+// Removes the progress from the waiting status, unless another handle has set
+// its progress since
+func (self *ProgressHandle) Done() {
+	self.statusManager.mutex.Lock()
+	defer self.statusManager.mutex.Unlock()
+
+	if status := self.statusManager.findStatus(self.statusId); status != nil && status.progressHandle == self {
+		status.progress = nil
+		status.progressHandle = nil
+	}
+}
+
 type appStatus struct {
 	message    string
 	statusType string
 	color      gocui.Attribute
 	id         int
+	// only set for waiting statuses whose command reports its progress
+	progress       *oscommands.Progress
+	progressHandle *ProgressHandle
 }
 
 func NewStatusManager() *StatusManager {
@@ -58,9 +113,10 @@ func (self *StatusManager) WithWaitingStatus(message string, renderFunc func(),
 
 func (self *StatusManager) AddToastStatus(message string, kind types.ToastKind) int {
 	id := self.addStatus(message, "toast", kind)
+	self.addToHistory(message, kind)
 
 	go func() {
-		delay := lo.Ternary(kind == types.ToastKindError, time.Second*4, time.Second*2)
+		delay := lo.Ternary(kind == types.ToastKindStatus, time.Second*2, time.Second*4)
 		time.Sleep(delay)
 
 		self.removeStatus(id)
@@ -69,17 +125,93 @@ func (self *StatusManager) AddToastStatus(message string, kind types.ToastKind)
 	return id
 }
 
+// GetToastHistory returns the toasts that were shown so far, newest first.
+func (self *StatusManager) GetToastHistory() []ToastHistoryEntry {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return slices.Clone(self.toastHistory)
+}
+
+func (self *StatusManager) addToHistory(message string, kind types.ToastKind) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry := ToastHistoryEntry{Message: message, Kind: kind, Time: time.Now()}
+	self.toastHistory = append([]ToastHistoryEntry{entry}, self.toastHistory...)
+	if len(self.toastHistory) > maxToastHistory {
+		self.toastHistory = self.toastHistory[:maxToastHistory]
+	}
+}
+
 func (self *StatusManager) GetStatusString(userConfig *config.UserConfig) (string, gocui.Attribute) {
 	if len(self.statuses) == 0 {
 		return "", gocui.ColorDefault
 	}
 	topStatus := self.statuses[0]
 	if topStatus.statusType == "waiting" {
+		if topStatus.progress != nil {
+			return fmt.Sprintf("%s %s %3d%% %s", topStatus.message,
+				presentation.ProgressBar(topStatus.progress.Percent, progressBarWidth),
+				topStatus.progress.Percent, topStatus.progress.Phase), topStatus.color
+		}
 		return topStatus.message + " " + presentation.Loader(time.Now(), userConfig.Gui.Spinner), topStatus.color
 	}
 	return topStatus.message, topStatus.color
 }
 
+// NewProgressHandle returns a handle for showing the progress of a command
+// that is about to run; see ProgressHandle.
+func (self *StatusManager) NewProgressHandle() *ProgressHandle {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	handle := &ProgressHandle{statusManager: self, statusId: -1}
+
+	waitingStatuses := lo.Filter(self.statuses, func(status appStatus, _ int) bool {
+		return status.statusType == "waiting"
+	})
+	if status, ok := lo.Find(waitingStatuses, func(status appStatus) bool {
+		return status.progressHandle == nil
+	}); ok {
+		handle.statusId = status.id
+	} else if len(waitingStatuses) > 0 {
+		handle.statusId = waitingStatuses[0].id
+	}
+
+	if status := self.findStatus(handle.statusId); status != nil {
+		status.progressHandle = handle
+	}
+
+	return handle
+}
+
+// must be called with the mutex locked
+func (self *StatusManager) findStatus(id int) *appStatus {
+	for i := range self.statuses {
+		if self.statuses[i].id == id {
+			return &self.statuses[i]
+		}
+	}
+
+	return nil
+}
+
+// GetCancellableProgress returns the progress of the most recent operation
+// that can be cancelled, or nil if there is none.
+func (self *StatusManager) GetCancellableProgress() *oscommands.Progress {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, status := range self.statuses {
+		if status.progress != nil && status.progress.Cancel != nil {
+			return status.progress
+		}
+	}
+
+	return nil
+}
+
 func (self *StatusManager) HasStatus() bool {
 	return len(self.statuses) > 0
 }
@@ -92,7 +224,10 @@ func (self *StatusManager) addStatus(message string, statusType string, kind typ
 	id := self.nextId
 
 	color := gocui.ColorCyan
-	if kind == types.ToastKindError {
+	switch kind {
+	case types.ToastKindWarning:
+		color = gocui.ColorYellow
+	case types.ToastKindError:
 		color = gocui.ColorRed
 	}
 
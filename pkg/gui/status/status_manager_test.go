@@ -0,0 +1,96 @@
+package status
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToastHistory(t *testing.T) {
+	statusManager := NewStatusManager()
+	assert.Empty(t, statusManager.GetToastHistory())
+
+	statusManager.AddToastStatus("first", types.ToastKindStatus)
+	statusManager.AddToastStatus("second", types.ToastKindWarning)
+	statusManager.AddToastStatus("third", types.ToastKindError)
+
+	history := statusManager.GetToastHistory()
+	assert.Equal(t, []string{"third", "second", "first"},
+		lo.Map(history, func(entry ToastHistoryEntry, _ int) string { return entry.Message }))
+	assert.Equal(t, []types.ToastKind{types.ToastKindError, types.ToastKindWarning, types.ToastKindStatus},
+		lo.Map(history, func(entry ToastHistoryEntry, _ int) types.ToastKind { return entry.Kind }))
+
+	// Waiting statuses are not toasts, so they don't end up in the history
+	_ = statusManager.WithWaitingStatus("waiting", func() {}, func(*WaitingStatusHandle) error { return nil })
+	assert.Len(t, statusManager.GetToastHistory(), 3)
+}
+
+func TestToastHistoryIsLimited(t *testing.T) {
+	statusManager := NewStatusManager()
+	for i := range maxToastHistory + 10 {
+		statusManager.AddToastStatus(fmt.Sprintf("toast %d", i), types.ToastKindStatus)
+	}
+
+	history := statusManager.GetToastHistory()
+	assert.Len(t, history, maxToastHistory)
+	assert.Equal(t, fmt.Sprintf("toast %d", maxToastHistory+9), history[0].Message)
+	assert.Equal(t, "toast 10", history[len(history)-1].Message)
+}
+
+func TestProgress(t *testing.T) {
+	statusManager := NewStatusManager()
+	userConfig := config.GetDefaultConfig()
+
+	// Without a waiting status there's nothing to attach the progress to
+	statusManager.NewProgressHandle().SetProgress(&oscommands.Progress{Phase: "Receiving objects", Percent: 40, Cancel: func() {}})
+	assert.Nil(t, statusManager.GetCancellableProgress())
+
+	_ = statusManager.WithWaitingStatus("Fetching...", func() {}, func(*WaitingStatusHandle) error {
+		cancelled := false
+		handle := statusManager.NewProgressHandle()
+		handle.SetProgress(&oscommands.Progress{Phase: "Receiving objects", Percent: 40, Cancel: func() { cancelled = true }})
+
+		statusString, _ := statusManager.GetStatusString(userConfig)
+		assert.Equal(t, "Fetching... ████░░░░░░  40% Receiving objects", statusString)
+
+		statusManager.GetCancellableProgress().Cancel()
+		assert.True(t, cancelled)
+
+		handle.Done()
+		assert.Nil(t, statusManager.GetCancellableProgress())
+		return nil
+	})
+}
+
+func TestProgressOfConcurrentOperations(t *testing.T) {
+	statusManager := NewStatusManager()
+	userConfig := config.GetDefaultConfig()
+
+	_ = statusManager.WithWaitingStatus("Fetching...", func() {}, func(*WaitingStatusHandle) error {
+		fetchHandle := statusManager.NewProgressHandle()
+		fetchHandle.SetProgress(&oscommands.Progress{Phase: "Receiving objects", Percent: 40})
+
+		return statusManager.WithWaitingStatus("Pushing...", func() {}, func(*WaitingStatusHandle) error {
+			pushHandle := statusManager.NewProgressHandle()
+			pushHandle.SetProgress(&oscommands.Progress{Phase: "Writing objects", Percent: 50})
+
+			statusString, _ := statusManager.GetStatusString(userConfig)
+			assert.Equal(t, "Pushing... █████░░░░░  50% Writing objects", statusString)
+
+			// The fetch finishing doesn't remove the progress of the push
+			fetchHandle.Done()
+			statusString, _ = statusManager.GetStatusString(userConfig)
+			assert.Equal(t, "Pushing... █████░░░░░  50% Writing objects", statusString)
+
+			pushHandle.Done()
+			statusString, _ = statusManager.GetStatusString(userConfig)
+			assert.NotContains(t, statusString, "Writing objects")
+			return nil
+		})
+	})
+}
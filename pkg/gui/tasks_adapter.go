@@ -1,15 +1,177 @@
 package gui
 
 import (
+	"bytes"
 	"io"
 	"os/exec"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	return gui.runCmdTask(view, cmd, prefix, nil)
+}
+
+// Like newCmdTask, but if we have already run the same command with the same
+// cache key, renders its output from the main view cache instead of running it
+// again. Otherwise, the output is added to the cache once it has been read
+// completely.
+func (gui *Gui) newCachedCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string, cacheKey string) error {
+	cmdStr := strings.Join(cmd.Args, " ")
+	key := mainViewCacheKey(cacheKey, cmd)
+
+	if output, ok := gui.mainViewCache.get(key); ok {
+		manager := gui.getManager(view)
+
+		f := func(tasks.TaskOpts) error {
+			// Not using setViewContent, because we want the content to be
+			// exactly the same as when streaming the command's output
+			view.SetContent(prefix + output)
+			gui.render()
+			return nil
+		}
+
+		// Using the command string as the task key like runCmdTask does, so
+		// that the origin is only reset when the command changes
+		return manager.NewTask(f, cmdStr)
+	}
+
+	return gui.runCmdTask(view, cmd, prefix, func(output string) {
+		if !isMissingBlobsOutput(output) {
+			gui.mainViewCache.set(key, output)
+		}
+	})
+}
+
+// Renders the diff that the command outputs with the old and new versions of
+// each hunk next to each other. Unlike newCmdTask, this needs to read the whole
+// output before it can show anything, because the hunks need to be aligned.
+func (gui *Gui) newSideBySideDiffTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	cmdStr := strings.Join(cmd.Args, " ")
+	gui.c.Log.WithField(
+		"command",
+		cmdStr,
+	).Debug("RunCommand")
+
+	manager := gui.getManager(view)
+
+	diff := &sideBySideDiff{key: cmdStr, prefix: prefix}
+	gui.Mutexes.SideBySideDiffsMutex.Lock()
+	gui.sideBySideDiffs[view.Name()] = diff
+	gui.Mutexes.SideBySideDiffsMutex.Unlock()
+
+	f := func(opts tasks.TaskOpts) error {
+		var output bytes.Buffer
+		cmd.Stdout = &output
+		cmd.Stderr = &output
+
+		if err := cmd.Start(); err != nil {
+			gui.c.Log.Error(err)
+			view.SetContent(prefix + err.Error())
+			gui.render()
+			return nil
+		}
+
+		done := make(chan error, 1)
+		go utils.Safe(func() {
+			done <- cmd.Wait()
+		})
+
+		select {
+		case <-opts.Stop:
+			// Kill the command so that we don't keep calculating a diff that
+			// nobody is going to look at, like the cmd tasks do
+			if err := oscommands.TerminateProcessGracefully(cmd); err != nil {
+				gui.c.Log.Errorf("error when trying to terminate side-by-side diff task: %v; Command: %v", err, cmdStr)
+			}
+			return nil
+		case err := <-done:
+			if err != nil {
+				// git exits with an error for e.g. a diff of a file that has
+				// been deleted in the meantime; we show whatever it printed,
+				// or the error if it didn't print anything
+				gui.c.Log.Errorf("Unexpected error when running side-by-side diff task: %v; Failed command: %v", err, cmdStr)
+				if output.Len() == 0 {
+					output.WriteString(err.Error())
+				}
+			}
+		}
+
+		diff.output = utils.Decolorise(output.String())
+		gui.renderSideBySideDiff(view, diff)
+		return nil
+	}
+
+	// Using the command string as the task key like runCmdTask does, so that
+	// toggling the diff mode doesn't reset the origin
+	return manager.NewTask(f, cmdStr)
+}
+
+// The output of a side-by-side diff task, which we keep so that we can render
+// it again when the width of the view changes. The width is zero until the
+// output has been rendered for the first time.
+type sideBySideDiff struct {
+	key    string
+	prefix string
+	output string
+	width  int
+}
+
+func (gui *Gui) forgetSideBySideDiff(view *gocui.View) {
+	gui.Mutexes.SideBySideDiffsMutex.Lock()
+	defer gui.Mutexes.SideBySideDiffsMutex.Unlock()
+
+	delete(gui.sideBySideDiffs, view.Name())
+}
+
+func (gui *Gui) renderSideBySideDiff(view *gocui.View, diff *sideBySideDiff) {
+	width := view.InnerWidth()
+	view.SetContent(diff.prefix + presentation.RenderSideBySideDiff(diff.output, width))
+	gui.render()
+
+	gui.Mutexes.SideBySideDiffsMutex.Lock()
+	diff.width = width
+	gui.Mutexes.SideBySideDiffsMutex.Unlock()
+}
+
+// Called on every layout; renders side-by-side diffs again (without running
+// their commands again) if their view's width has changed since they were
+// rendered, so that the columns fill the view.
+func (gui *Gui) rerenderSideBySideDiffsIfResized() {
+	gui.Mutexes.SideBySideDiffsMutex.Lock()
+	defer gui.Mutexes.SideBySideDiffsMutex.Unlock()
+
+	for viewName, diff := range gui.sideBySideDiffs {
+		if diff.width == 0 {
+			continue
+		}
+
+		view, err := gui.g.View(viewName)
+		if err != nil || view.InnerWidth() == diff.width {
+			continue
+		}
+
+		manager := gui.getManager(view)
+
+		// Setting the width here already so that we don't start another task
+		// on the next layout before this one has run
+		diff.width = view.InnerWidth()
+		if err := manager.NewTask(func(tasks.TaskOpts) error {
+			gui.renderSideBySideDiff(view, diff)
+			return nil
+		}, diff.key); err != nil {
+			gui.c.Log.Error(err)
+		}
+	}
+}
+
+func (gui *Gui) runCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string, onComplete func(string)) error {
 	cmdStr := strings.Join(cmd.Args, " ")
 	gui.c.Log.WithField(
 		"command",
@@ -43,7 +205,7 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 	}
 
 	linesToRead := gui.linesToReadFromCmdTask(view)
-	if err := manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, onClose), cmdStr); err != nil {
+	if err := manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, onClose, onComplete), cmdStr); err != nil {
 		gui.c.Log.Error(err)
 	}
 
@@ -102,6 +264,28 @@ func (gui *Gui) newStringTaskWithKey(view *gocui.View, str string, key string) e
 	return nil
 }
 
+func (gui *Gui) newImagePreviewTask(view *gocui.View, task *types.RenderImagePreviewTask) error {
+	manager := gui.getManager(view)
+
+	f := func(opts tasks.TaskOpts) error {
+		str, images := task.Load()
+
+		select {
+		case <-opts.Stop:
+			// another task has been started while we were loading the images
+			return nil
+		default:
+		}
+
+		gui.setImagePreviews(view, images)
+		gui.c.ResetViewOrigin(view)
+		gui.c.SetViewContent(view, str)
+		return nil
+	}
+
+	return manager.NewTask(f, "image-preview:"+task.Key)
+}
+
 func (gui *Gui) getManager(view *gocui.View) *tasks.ViewBufferManager {
 	manager, ok := gui.viewBufferManagerMap[view.Name()]
 	if !ok {
@@ -0,0 +1,57 @@
+package gui
+
+import (
+	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
+	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/tool"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+func toolMode(startArgs appTypes.StartArgs) tool.Tool {
+	switch startArgs.GitArg {
+	case appTypes.GitArgDifftool:
+		return tool.NewDifftool(startArgs.ToolPaths[0], startArgs.ToolPaths[1])
+	case appTypes.GitArgMergetool:
+		return tool.NewMergetool(startArgs.ToolPaths[0])
+	}
+
+	return tool.Tool{}
+}
+
+// Focuses the diff or the conflicts of the files that git gave us, if it
+// started us as its difftool or mergetool
+func (gui *Gui) enterToolMode(startArgs appTypes.StartArgs) error {
+	switch startArgs.GitArg {
+	case appTypes.GitArgDifftool:
+		gui.c.Context().Push(gui.State.Contexts.Normal, types.OnFocusOpts{})
+	case appTypes.GitArgMergetool:
+		return gui.helpers.MergeConflicts.SwitchToMerge(gui.State.Modes.Tool.GetMergedPath())
+	}
+
+	return nil
+}
+
+// Git only considers the conflicts of a file resolved by its mergetool if the
+// tool exits with 0 (when mergetool.<tool>.trustExitCode is set), so we only do
+// that if there are no conflict markers left in the file. For single actions
+// (e.g. `lazygit commit`), it tells whether the action succeeded.
+func (gui *Gui) ExitCode() int {
+	if gui.singleAction != nil {
+		return gui.singleAction.exitCode()
+	}
+
+	if !gui.toolMode.IsMergetool() {
+		return 0
+	}
+
+	hasConflicts, err := mergeconflicts.FileHasConflictMarkers(gui.toolMode.GetMergedPath())
+	if err != nil {
+		gui.c.Log.Error(err)
+		return 1
+	}
+	if hasConflicts {
+		return 1
+	}
+
+	return 0
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"sync"
+	"sync/atomic"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
@@ -106,6 +109,18 @@ type IGuiCommon interface {
 
 	ResetKeybindings() error
 
+	// To be called after the order or visibility of the side panels has been
+	// changed at runtime; updates the jump-to-panel keybindings and titles.
+	OnSidePanelLayoutChanged() error
+
+	// To be called after the language has been changed at runtime; reloads the
+	// translations and redraws everything in the new language.
+	OnLanguageChanged() error
+
+	// To be called after changing the user config files on disk; reloads the
+	// config if any of them changed.
+	ReloadChangedUserConfigFiles() error
+
 	// hopefully we can remove this once we've moved all our keybinding stuff out of the gui god struct.
 	GetInitialKeybindingsWithCustomCommands() ([]*Binding, []*gocui.ViewMouseBinding)
 
@@ -138,6 +153,7 @@ type IPopupHandler interface {
 	Menu(opts CreateMenuOptions) error
 	Toast(message string)
 	ErrorToast(message string)
+	WarningToast(message string)
 	SetToastFunc(func(string, ToastKind))
 	GetPromptInput() string
 }
@@ -146,6 +162,7 @@ type ToastKind int
 
 const (
 	ToastKindStatus ToastKind = iota
+	ToastKindWarning
 	ToastKindError
 )
 
@@ -287,6 +304,12 @@ func (self *MenuItem) ID() string {
 	return self.Label
 }
 
+type RepoOverview struct {
+	// The most recent tag reachable from HEAD; empty if there is none
+	LatestReachableTag string
+	WorkingTreeState   models.WorkingTreeState
+}
+
 type Model struct {
 	CommitFiles     []*models.CommitFile
 	Files           []*models.File
@@ -299,9 +322,16 @@ type Model struct {
 	Worktrees       []*models.Worktree
 	PullRequests    []*models.GithubPullRequest
 	PullRequestsMap map[string]*models.GithubPullRequest
+	// CI status of branch heads and recent commits, keyed by commit hash
+	CommitStatuses map[string]*models.GithubCommitStatus
+	// Not loaded as part of a regular refresh, because that needs the lfs
+	// server; only when the user asks for them, or after a background fetch
+	LfsLocks []*models.LfsLock
 
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
-	// When in filtering mode we only include the ones that match the given path
+	// When in filtering mode we only include the ones that match the given path,
+	// and when the panel shows the reflog of a ref other than HEAD, these are
+	// the entries of that ref's reflog
 	FilteredReflogCommits []*models.Commit
 	// ReflogCommits are the ones used by the branches panel to obtain recency values,
 	// and for the undo functionality.
@@ -311,8 +341,17 @@ type Model struct {
 
 	BisectInfo                          *git_commands.BisectInfo
 	WorkingTreeStateAtLastCommitRefresh models.WorkingTreeState
-	RemoteBranches                      []*models.RemoteBranch
-	Tags                                []*models.Tag
+
+	// What the repo overview in the main view of the status panel shows
+	// beyond the other models. Only loaded if the status panel shows the repo
+	// overview.
+	RepoOverview   RepoOverview
+	RemoteBranches []*models.RemoteBranch
+	Tags           []*models.Tag
+
+	// How far remote branches have diverged from the local branches tracking
+	// them, keyed by the remote branch's full name
+	RemoteBranchDivergences map[string]*models.RemoteBranchDivergence
 
 	// Name of the currently checked out branch. This will be set even when
 	// we're on a detached head because we're rebasing or bisecting.
@@ -326,6 +365,15 @@ type Model struct {
 	Authors map[string]*models.Author
 
 	HashPool *utils.StringPool
+
+	// Whether to sign the next commit, regardless of commit.gpgSign. Goes
+	// back to the default once the commit has been made.
+	NextCommitSigning git_commands.CommitSigning
+
+	// Incremented whenever branches, remotes, tags or commits are reloaded.
+	// Used as part of the cache key of commit diffs, since these show the refs
+	// pointing at the commit.
+	RefsVersion atomic.Int64
 }
 
 type Mutexes struct {
@@ -339,6 +387,7 @@ type Mutexes struct {
 	SubprocessMutex             deadlock.Mutex
 	PopupMutex                  deadlock.Mutex
 	PtyMutex                    deadlock.Mutex
+	SideBySideDiffsMutex        deadlock.Mutex
 }
 
 // A long-running operation associated with an item. For example, we'll show
@@ -363,6 +412,8 @@ type HasUrn interface {
 
 type IStateAccessor interface {
 	GetRepoPathStack() *utils.StringStack
+	GetRepoTabs() []string
+	CloseRepoTab(path string)
 	GetRepoState() IRepoStateAccessor
 	GetPagerConfig() *config.PagerConfig
 	// tells us whether we're currently updating lazygit
@@ -372,6 +423,10 @@ type IStateAccessor interface {
 	GetIsRefreshingFiles() bool
 	GetShowExtrasWindow() bool
 	SetShowExtrasWindow(bool)
+	GetDateFormatToggled() bool
+	SetDateFormatToggled(bool)
+	GetShowCommitDetails() bool
+	SetShowCommitDetails(bool)
 	GetRetainOriginalDir() bool
 	SetRetainOriginalDir(bool)
 	GetItemOperation(item HasUrn) ItemOperation
@@ -388,10 +443,14 @@ type IRepoStateAccessor interface {
 	SetCurrentPopupOpts(*CreatePopupPanelOpts)
 	GetScreenMode() ScreenMode
 	SetScreenMode(ScreenMode)
+	GetScreenModeBeforeZoom() ScreenMode
+	SetScreenModeBeforeZoom(ScreenMode)
 	InSearchPrompt() bool
 	GetSearchState() *SearchState
 	SetSplitMainPanel(bool)
 	GetSplitMainPanel() bool
+	GetOperationJournal() *OperationJournal
+	GetCommitGraphOffer() *sync.Once
 }
 
 // startup stages so we don't need to load everything at once
@@ -149,10 +149,16 @@ type ISearchableContext interface {
 	ClearSearchString()
 	IsSearching() bool
 	IsSearchableContext()
-	RenderSearchStatus(int, int)
+	RenderSearchStatus(index int, total int, lineNumber int)
+	// Returns the line number to show in the search status for a match in
+	// the given line of the view's content, or 0 to not show one. SearchTrait
+	// returns 0; contexts with meaningful line numbers override it.
+	SearchMatchLineNumber(lineIdx int) int
 
 	// This must be implemented by each concrete context. Return nil if not searching the model.
-	ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition
+	// The matches func tells whether a string matches the search, taking the
+	// search options (regex, case sensitivity) into account.
+	ModelSearchResults(matches func(string) bool) []gocui.SearchPosition
 	OnSearchSelect(selectedLineIdx int)
 }
 
@@ -191,6 +197,8 @@ type IListContext interface {
 	SetNeedRerenderVisibleLines()
 
 	IndexForGotoBottom() int
+
+	RestoreSelectionWhenLoaded(itemId string, idx int, scrollOffset int)
 }
 
 type IPatchExplorerContext interface {
@@ -283,8 +291,10 @@ type IController interface {
 
 type IList interface {
 	IListCursor
+	IListMarks
 	Len() int
 	GetItem(index int) HasUrn
+	GetItemId(index int) string
 }
 
 type IListCursor interface {
@@ -302,6 +312,17 @@ type IListCursor interface {
 	ExpandNonStickyRange(int)
 }
 
+// Marks allow selecting an arbitrary set of items in a list, as opposed to the
+// contiguous range of a range selection
+type IListMarks interface {
+	ToggleMark(index int)
+	ToggleMarkRange(startIdx int, endIdx int)
+	IsMarked(index int) bool
+	MarkedIndices() []int
+	HasMarks() bool
+	ClearMarks()
+}
+
 type IListPanelState interface {
 	SetSelectedLineIdx(int)
 	SetSelection(int)
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"os"
+	"sync"
+)
+
+// A JournalEntry describes a mutating action that isn't recorded in the reflog
+// (e.g. deleting a branch or dropping a stash entry), together with the
+// operations needed to reverse it and to perform it again.
+type JournalEntry struct {
+	// Shown to the user when asking whether to undo/redo the entry
+	Description string
+	Undo        func() error
+	Redo        func() error
+
+	// Identifies the top reflog entry at the time the action was performed (or
+	// undone), so that we can interleave journal entries with reflog-based
+	// undo/redo in the right order
+	ReflogTop string
+}
+
+// OperationJournal keeps track of the actions that can be undone and redone
+// on top of what the reflog gives us.
+type OperationJournal struct {
+	mutex     sync.Mutex
+	undoStack []*JournalEntry
+	redoStack []*JournalEntry
+
+	indexSnapshot *IndexSnapshot
+}
+
+// IndexSnapshot remembers the copy of the index file that we saved most
+// recently, together with what the index file looked like at that time. As
+// long as the file hasn't changed since, the copy still matches the index, so
+// we don't need to copy it again.
+type IndexSnapshot struct {
+	CopyPath string
+	FileInfo os.FileInfo
+}
+
+func NewOperationJournal() *OperationJournal {
+	return &OperationJournal{}
+}
+
+// Record adds an entry that can be undone. Recording a new action discards
+// anything that could previously be redone.
+func (self *OperationJournal) Record(entry *JournalEntry) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.undoStack = append(self.undoStack, entry)
+	self.redoStack = nil
+}
+
+func (self *OperationJournal) PeekUndo() *JournalEntry {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if len(self.undoStack) == 0 {
+		return nil
+	}
+	return self.undoStack[len(self.undoStack)-1]
+}
+
+func (self *OperationJournal) PeekRedo() *JournalEntry {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if len(self.redoStack) == 0 {
+		return nil
+	}
+	return self.redoStack[len(self.redoStack)-1]
+}
+
+// MarkUndone moves the given entry from the undo stack to the redo stack. It
+// is a no-op if the entry is no longer at the top of the undo stack.
+func (self *OperationJournal) MarkUndone(entry *JournalEntry, reflogTop string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if len(self.undoStack) == 0 || self.undoStack[len(self.undoStack)-1] != entry {
+		return
+	}
+	self.undoStack = self.undoStack[:len(self.undoStack)-1]
+	entry.ReflogTop = reflogTop
+	self.redoStack = append(self.redoStack, entry)
+}
+
+// MarkRedone moves the given entry from the redo stack back to the undo
+// stack. It is a no-op if the entry is no longer at the top of the redo stack.
+func (self *OperationJournal) MarkRedone(entry *JournalEntry, reflogTop string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if len(self.redoStack) == 0 || self.redoStack[len(self.redoStack)-1] != entry {
+		return
+	}
+	self.redoStack = self.redoStack[:len(self.redoStack)-1]
+	entry.ReflogTop = reflogTop
+	self.undoStack = append(self.undoStack, entry)
+}
+
+// Discard removes the given entry from both stacks, e.g. because undoing it
+// failed and it can no longer be applied.
+func (self *OperationJournal) Discard(entry *JournalEntry) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	remove := func(stack []*JournalEntry) []*JournalEntry {
+		result := make([]*JournalEntry, 0, len(stack))
+		for _, e := range stack {
+			if e != entry {
+				result = append(result, e)
+			}
+		}
+		return result
+	}
+	self.undoStack = remove(self.undoStack)
+	self.redoStack = remove(self.redoStack)
+}
+
+func (self *OperationJournal) IndexSnapshot() *IndexSnapshot {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return self.indexSnapshot
+}
+
+func (self *OperationJournal) SetIndexSnapshot(snapshot *IndexSnapshot) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.indexSnapshot = snapshot
+}
@@ -24,6 +24,10 @@ type ViewUpdateOpts struct {
 	Title    string
 	SubTitle string
 
+	// If set, these are shown instead of the title
+	Tabs     []string
+	TabIndex int
+
 	Task UpdateTask
 }
 
@@ -87,10 +91,30 @@ func NewRunCommandTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunCommandTask {
 type RunPtyTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// If non-empty, the output of the command is cached, and rendered from the
+	// cache the next time the same command is run with the same cache key. The
+	// key must describe the state that the output depends on, other than the
+	// command's arguments (e.g. the modification times of the diffed files).
+	CacheKey string
+	// Commands whose output is likely to be rendered next (e.g. the diffs of
+	// the neighbouring commits); if the output is cacheable, they are run in
+	// the background and cached with the same cache key.
+	Prefetch []*exec.Cmd
+	// True if the command outputs a diff. We then pin its file and hunk
+	// headers to the top of the view when scrolling (if gui.stickyDiffHeaders
+	// is on), and render it side by side instead of as it is if the
+	// gui.diffMode config is set to 'side-by-side'
+	IsDiff bool
 }
 
 func (t *RunPtyTask) IsUpdateTask() {}
 
+// Marks the task as outputting a diff; see IsDiff
+func (t *RunPtyTask) AsDiff() *RunPtyTask {
+	t.IsDiff = true
+	return t
+}
+
 func NewRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
 	return &RunPtyTask{Cmd: cmd}
 }
@@ -98,3 +122,32 @@ func NewRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
 func NewRunPtyTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunPtyTask {
 	return &RunPtyTask{Cmd: cmd, Prefix: prefix}
 }
+
+func NewCachedRunPtyTask(cmd *exec.Cmd, cacheKey string) *RunPtyTask {
+	return &RunPtyTask{Cmd: cmd, CacheKey: cacheKey}
+}
+
+// Renders the string returned by Load like RenderStringTask, and additionally
+// draws the images it returns on top of it if the terminal supports a graphics
+// protocol. Load reads the images, so it is called in the background.
+type RenderImagePreviewTask struct {
+	// Identifies what is previewed; rendering the same preview again keeps the
+	// scroll position
+	Key  string
+	Load func() (string, []ImagePlacement)
+}
+
+func (t *RenderImagePreviewTask) IsUpdateTask() {}
+
+func NewRenderImagePreviewTask(key string, load func() (string, []ImagePlacement)) *RenderImagePreviewTask {
+	return &RenderImagePreviewTask{Key: key, Load: load}
+}
+
+// An image to draw in a view. The string of the task is expected to contain
+// Rows empty lines starting at line index Line, which the image is drawn over.
+type ImagePlacement struct {
+	Content []byte
+	Line    int
+	Cols    int
+	Rows    int
+}
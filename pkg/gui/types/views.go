@@ -22,6 +22,7 @@ type Views struct {
 	PatchBuilding          *gocui.View
 	PatchBuildingSecondary *gocui.View
 	MergeConflicts         *gocui.View
+	Blame                  *gocui.View
 
 	Options           *gocui.View
 	Confirmation      *gocui.View
@@ -31,6 +32,7 @@ type Views struct {
 	CommitDescription *gocui.View
 	CommitFiles       *gocui.View
 	SubCommits        *gocui.View
+	FileHistory       *gocui.View
 	Information       *gocui.View
 	AppStatus         *gocui.View
 	Search            *gocui.View
@@ -41,6 +43,7 @@ type Views struct {
 	Suggestions       *gocui.View
 	Tooltip           *gocui.View
 	Extras            *gocui.View
+	PerformanceHud    *gocui.View
 
 	// for playing the easter egg snake game
 	Snake *gocui.View
@@ -58,6 +58,14 @@ func (gui *Gui) currentViewName() string {
 }
 
 func (gui *Gui) onViewTabClick(windowName string, tabIndex int) error {
+	// the tabs of the status window are the open repos
+	if windowName == "status" {
+		if tabIndex >= len(gui.RepoTabs) {
+			return nil
+		}
+		return gui.helpers.Repos.SwitchToRepoTab(gui.RepoTabs[tabIndex])
+	}
+
 	tabs := gui.viewTabMap()[windowName]
 	if len(tabs) == 0 {
 		return nil
@@ -3,9 +3,12 @@ package gui
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
@@ -39,6 +42,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Commits, name: "commits"},
 		{viewPtr: &gui.Views.Stash, name: "stash"},
 		{viewPtr: &gui.Views.SubCommits, name: "subCommits"},
+		{viewPtr: &gui.Views.FileHistory, name: "fileHistory"},
 		{viewPtr: &gui.Views.CommitFiles, name: "commitFiles"},
 
 		{viewPtr: &gui.Views.Staging, name: "staging"},
@@ -46,6 +50,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.PatchBuilding, name: "patchBuilding"},
 		{viewPtr: &gui.Views.PatchBuildingSecondary, name: "patchBuildingSecondary"},
 		{viewPtr: &gui.Views.MergeConflicts, name: "mergeConflicts"},
+		{viewPtr: &gui.Views.Blame, name: "blame"},
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
@@ -71,6 +76,9 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Prompt, name: "prompt"},
 		{viewPtr: &gui.Views.Tooltip, name: "tooltip"},
 
+		// shows how long recent git commands and refreshes took
+		{viewPtr: &gui.Views.PerformanceHud, name: "performanceHud"},
+
 		// this guy will cover everything else when it appears
 		{viewPtr: &gui.Views.Limit, name: "limit"},
 	}
@@ -101,20 +109,18 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.Search.Editor = gocui.EditorFunc(gui.searchEditor)
 
 	for _, view := range []*gocui.View{gui.Views.Main, gui.Views.Secondary, gui.Views.Staging, gui.Views.StagingSecondary, gui.Views.PatchBuilding, gui.Views.PatchBuildingSecondary, gui.Views.MergeConflicts} {
-		view.Wrap = true
+		// the staging and merge conflicts views override this while they are focused
+		view.Wrap = gui.c.UserConfig().Gui.WrapLinesInMainView
+		view.ShowWhitespace = gui.c.UserConfig().Gui.ShowWhitespaceInDiffView
 		view.UnderlineHyperLinksOnlyOnHover = true
 		view.AutoRenderHyperLinks = true
 	}
 
-	gui.Views.Staging.Wrap = true
-	gui.Views.StagingSecondary.Wrap = true
-	gui.Views.PatchBuilding.Wrap = true
-	gui.Views.PatchBuildingSecondary.Wrap = true
-	gui.Views.MergeConflicts.Wrap = false
 	gui.Views.Limit.Wrap = true
 
+	gui.Views.PerformanceHud.Visible = false
+
 	gui.Views.AppStatus.BgColor = gocui.ColorDefault
-	gui.Views.AppStatus.FgColor = gocui.ColorCyan
 	gui.Views.AppStatus.Visible = false
 	gui.Views.AppStatus.Frame = false
 
@@ -143,7 +149,6 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.Tooltip.AutoRenderHyperLinks = true
 
 	gui.Views.Information.BgColor = gocui.ColorDefault
-	gui.Views.Information.FgColor = gocui.ColorGreen
 	gui.Views.Information.Frame = false
 
 	gui.Views.Extras.Autoscroll = true
@@ -167,9 +172,17 @@ func (gui *Gui) configureViewProperties() {
 	case "bold":
 		frameRunes = []rune{'━', '┃', '┏', '┓', '┗', '┛'}
 	}
+	if gui.c.UserConfig().Gui.Accessibility.Enabled {
+		// Screen readers read box-drawing characters aloud, so we use only
+		// ASCII; we need all eleven runes so that corners of overlapping
+		// frames don't fall back to box-drawing characters
+		frameRunes = []rune{'-', '|', '+', '+', '+', '+', '+', '+', '+', '+', '+'}
+	}
 
+	reorderRightToLeft := gui.shouldReorderRightToLeft()
 	for _, mapping := range gui.orderedViewNameMappings() {
 		(*mapping.viewPtr).FrameRunes = frameRunes
+		(*mapping.viewPtr).ReorderRightToLeft = reorderRightToLeft
 		(*mapping.viewPtr).BgColor = gui.g.BgColor
 		(*mapping.viewPtr).FgColor = theme.GocuiDefaultTextColor
 		(*mapping.viewPtr).SelBgColor = theme.GocuiSelectedLineBgColor
@@ -177,11 +190,20 @@ func (gui *Gui) configureViewProperties() {
 		(*mapping.viewPtr).InactiveViewSelBgColor = theme.GocuiInactiveViewSelectedLineBgColor
 	}
 
+	for _, view := range []*gocui.View{gui.Views.Main, gui.Views.Secondary, gui.Views.Staging, gui.Views.StagingSecondary, gui.Views.PatchBuilding, gui.Views.PatchBuildingSecondary} {
+		if gui.c.UserConfig().Gui.ShowDiffMinimap {
+			view.MinimapLineColor = diffMinimapLineColor
+		} else {
+			view.MinimapLineColor = nil
+		}
+	}
+
 	gui.c.SetViewContent(gui.Views.SearchPrefix, gui.c.Tr.SearchPrefix)
 
 	gui.Views.Stash.Title = gui.c.Tr.StashTitle
 	gui.Views.Commits.Title = gui.c.Tr.CommitsTitle
 	gui.Views.CommitFiles.Title = gui.c.Tr.CommitFiles
+	gui.Views.FileHistory.Title = gui.c.Tr.FileHistoryTitle
 	gui.Views.Branches.Title = gui.c.Tr.BranchesTitle
 	gui.Views.Remotes.Title = gui.c.Tr.RemotesTitle
 	gui.Views.Worktrees.Title = gui.c.Tr.WorktreesTitle
@@ -190,7 +212,10 @@ func (gui *Gui) configureViewProperties() {
 	gui.Views.PatchBuilding.Title = gui.c.Tr.Patch
 	gui.Views.PatchBuildingSecondary.Title = gui.c.Tr.CustomPatch
 	gui.Views.MergeConflicts.Title = gui.c.Tr.MergeConflictsTitle
+	gui.Views.Blame.Title = gui.c.Tr.BlameTitle
+	gui.Views.Blame.TabWidth = gui.c.UserConfig().Gui.TabWidth
 	gui.Views.Limit.Title = gui.c.Tr.NotEnoughSpace
+	gui.Views.PerformanceHud.Title = gui.c.Tr.PerformanceHudTitle
 	gui.Views.Status.Title = gui.c.Tr.StatusTitle
 	gui.Views.Staging.Title = gui.c.Tr.UnstagedChanges
 	gui.Views.StagingSecondary.Title = gui.c.Tr.StagedChanges
@@ -206,55 +231,12 @@ func (gui *Gui) configureViewProperties() {
 	}
 
 	gui.Views.CommitDescription.FgColor = theme.GocuiDefaultTextColor
+	gui.Views.AppStatus.FgColor = theme.AppStatusColor
+	gui.Views.Information.FgColor = theme.InformationColor
 	gui.Views.CommitDescription.TextArea.AutoWrap = gui.c.UserConfig().Git.Commit.AutoWrapCommitMessage
 	gui.Views.CommitDescription.TextArea.AutoWrapWidth = gui.c.UserConfig().Git.Commit.AutoWrapWidth
 
-	if gui.c.UserConfig().Gui.ShowPanelJumps {
-		keyToTitlePrefix := func(key string) string {
-			if key == "<disabled>" {
-				return ""
-			}
-			return fmt.Sprintf("[%s]", key)
-		}
-		jumpBindings := gui.c.UserConfig().Keybinding.Universal.JumpToBlock
-		jumpLabels := lo.Map(jumpBindings, func(binding string, _ int) string {
-			return keyToTitlePrefix(binding)
-		})
-
-		gui.Views.Status.TitlePrefix = jumpLabels[0]
-
-		gui.Views.Files.TitlePrefix = jumpLabels[1]
-		gui.Views.Worktrees.TitlePrefix = jumpLabels[1]
-		gui.Views.Submodules.TitlePrefix = jumpLabels[1]
-
-		gui.Views.Branches.TitlePrefix = jumpLabels[2]
-		gui.Views.Remotes.TitlePrefix = jumpLabels[2]
-		gui.Views.Tags.TitlePrefix = jumpLabels[2]
-
-		gui.Views.Commits.TitlePrefix = jumpLabels[3]
-		gui.Views.ReflogCommits.TitlePrefix = jumpLabels[3]
-
-		gui.Views.Stash.TitlePrefix = jumpLabels[4]
-
-		gui.Views.Main.TitlePrefix = keyToTitlePrefix(gui.c.UserConfig().Keybinding.Universal.FocusMainView)
-	} else {
-		gui.Views.Status.TitlePrefix = ""
-
-		gui.Views.Files.TitlePrefix = ""
-		gui.Views.Worktrees.TitlePrefix = ""
-		gui.Views.Submodules.TitlePrefix = ""
-
-		gui.Views.Branches.TitlePrefix = ""
-		gui.Views.Remotes.TitlePrefix = ""
-		gui.Views.Tags.TitlePrefix = ""
-
-		gui.Views.Commits.TitlePrefix = ""
-		gui.Views.ReflogCommits.TitlePrefix = ""
-
-		gui.Views.Stash.TitlePrefix = ""
-
-		gui.Views.Main.TitlePrefix = ""
-	}
+	gui.setPanelJumpTitlePrefixes()
 
 	for _, view := range gui.g.Views() {
 		// if the view is in our mapping, we'll set the tabs and the tab index
@@ -272,3 +254,85 @@ func (gui *Gui) configureViewProperties() {
 		}
 	}
 }
+
+// Shows the repos that are open in this session as tabs of the status view,
+// but only if there's more than one
+func (gui *Gui) setRepoTabs() {
+	if len(gui.RepoTabs) < 2 {
+		gui.Views.Status.Tabs = nil
+		return
+	}
+
+	gui.Views.Status.Tabs = lo.Map(gui.RepoTabs, func(path string, _ int) string {
+		return filepath.Base(path)
+	})
+	gui.Views.Status.TabIndex = lo.IndexOf(gui.RepoTabs, gui.git.RepoPaths.WorktreePath())
+}
+
+// Shows the jump-to-panel key in the title of each side view. The keys are
+// assigned to the visible side windows from top to bottom, so these need to be
+// updated whenever the side panel layout changes.
+func (gui *Gui) setPanelJumpTitlePrefixes() {
+	viewsByWindow := map[string][]*gocui.View{
+		"status":   {gui.Views.Status},
+		"files":    {gui.Views.Files, gui.Views.Worktrees, gui.Views.Submodules},
+		"branches": {gui.Views.Branches, gui.Views.Remotes, gui.Views.Tags},
+		"commits":  {gui.Views.Commits, gui.Views.ReflogCommits},
+		"stash":    {gui.Views.Stash},
+	}
+
+	for _, views := range viewsByWindow {
+		for _, view := range views {
+			view.TitlePrefix = ""
+		}
+	}
+	gui.Views.Main.TitlePrefix = ""
+
+	if !gui.c.UserConfig().Gui.ShowPanelJumps {
+		return
+	}
+
+	keyToTitlePrefix := func(key string) string {
+		if key == "<disabled>" {
+			return ""
+		}
+		return fmt.Sprintf("[%s]", key)
+	}
+	jumpBindings := gui.c.UserConfig().Keybinding.Universal.JumpToBlock
+	sideWindows := helpers.GetSideWindows(gui.c.UserConfig(), gui.c.GetAppState())
+	for i, window := range sideWindows {
+		if i >= len(jumpBindings) {
+			break
+		}
+		for _, view := range viewsByWindow[window] {
+			view.TitlePrefix = keyToTitlePrefix(jumpBindings[i])
+		}
+	}
+
+	gui.Views.Main.TitlePrefix = keyToTitlePrefix(gui.c.UserConfig().Keybinding.Universal.FocusMainView)
+}
+
+// Returns 1 for the header line of a file in a diff, 2 for a hunk header, and 0
+// for all other lines
+func diffStickyHeaderLevel(line string) int {
+	switch {
+	case strings.HasPrefix(line, "diff --git ") || strings.HasPrefix(line, "diff --cc ") || strings.HasPrefix(line, "diff --combined "):
+		return 1
+	case strings.HasPrefix(line, "@@"):
+		return 2
+	default:
+		return 0
+	}
+}
+
+// Colors the added and deleted lines of a diff in the minimap
+func diffMinimapLineColor(line string) gocui.Attribute {
+	switch {
+	case strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "+++ "):
+		return gocui.ColorGreen
+	case strings.HasPrefix(line, "-") && !strings.HasPrefix(line, "--- "):
+		return gocui.ColorRed
+	default:
+		return gocui.ColorDefault
+	}
+}
@@ -11,6 +11,10 @@ Todo list when making a new translation
 package i18n
 
 type TranslationSet struct {
+	// The language code, for choosing plural forms in FormatMessage; empty
+	// means English
+	language string
+
 	NotEnoughSpace                        string
 	DiffTitle                             string
 	FilesTitle                            string
@@ -40,6 +44,12 @@ type TranslationSet struct {
 	SureToAmend                           string
 	NoCommitToAmend                       string
 	CommitChangesWithEditor               string
+	ConventionalCommit                    string
+	ConventionalCommitTooltip             string
+	ConventionalCommitType                string
+	ConventionalCommitScope               string
+	ConventionalCommitSubject             string
+	NoConventionalCommitTypes             string
 	FindBaseCommitForFixup                string
 	FindBaseCommitForFixupTooltip         string
 	NoBaseCommitsFound                    string
@@ -48,6 +58,13 @@ type TranslationSet struct {
 	BaseCommitIsAlreadyOnMainBranch       string
 	BaseCommitIsNotInCurrentView          string
 	HunksWithOnlyAddedLinesWarning        string
+	AbsorbStagedChanges                   string
+	AbsorbStagedChangesTooltip            string
+	AbsorbStagedChangesPrompt             string
+	AbsorbUnassignedHunks                 string
+	NoAbsorbTargetsFound                  string
+	FindingAbsorbTargetsStatus            string
+	CreatingFixupCommitsStatus            string
 	StatusTitle                           string
 	GlobalTitle                           string
 	Execute                               string
@@ -65,7 +82,93 @@ type TranslationSet struct {
 	Pull                                  string
 	PushTooltip                           string
 	PullTooltip                           string
+	PushWithOptions                       string
+	PushWithOptionsTooltip                string
+	PushOptionsTitle                      string
+	PushOptions                           string
+	PushForceWithLease                    string
+	PushForceWithLeaseTooltip             string
+	PushForceIfIncludes                   string
+	PushForceIfIncludesTooltip            string
+	PushNoVerify                          string
+	PushNoVerifyTooltip                   string
+	PushNoVerifyAlwaysOn                  string
+	PushFollowTags                        string
+	PushFollowTagsTooltip                 string
+	PushRefspec                           string
+	PushRefspecCurrentBranch              string
+	PushRefspecTooltip                    string
+	PushRefspecPrompt                     string
+	PushRefspecNeedsUpstream              string
+	PullWithStrategy                      string
+	PullWithStrategyTooltip               string
+	PullStrategyTitle                     string
+	PullStrategyAuto                      string
+	PullStrategyMerge                     string
+	PullStrategyRebase                    string
+	PullStrategyFastForwardOnly           string
+	PullStrategyNotConfigured             string
+	PullStrategyNameMerge                 string
+	PullStrategyNameRebase                string
+	PullStrategyNameFastForwardOnly       string
+	PullStrategyNameGitDefault            string
+	ConfirmPullWithStrategy               string
 	FileFilter                            string
+	LfsLocks                              string
+	LfsLocksTooltip                       string
+	LfsLocksTitle                         string
+	LfsLockFile                           string
+	LfsUnlockFile                         string
+	LfsForceUnlockFile                    string
+	LfsForceUnlockFileTooltip             string
+	LfsUnlockTooltip                      string
+	LfsFileAlreadyLocked                  string
+	LfsFileNotLocked                      string
+	NoLfsLocks                            string
+	LoadingLfsLocksStatus                 string
+	Blame                                 string
+	BlameTooltip                          string
+	BlameTitle                            string
+	LoadingBlame                          string
+	CanOnlyBlameFiles                     string
+	CannotBlameFileNotInHead              string
+	OpenFileHistory                       string
+	OpenFileHistoryTooltip                string
+	FileHistoryTitle                      string
+	LoadingFileHistory                    string
+	FileHistoryOnlyForFiles               string
+	FileHistoryFileNotTracked             string
+	CheckoutFileVersionTooltip            string
+	GoToBlamedCommit                      string
+	GoToBlamedCommitTooltip               string
+	ExitBlame                             string
+	BlameLineNotCommittedYet              string
+	BlamedCommitNotFound                  string
+	GoToCommit                            string
+	GoToCommitTooltip                     string
+	GoToCommitPrompt                      string
+	CommitNotInCurrentBranch              string
+	LockingStatus                         string
+	UnlockingStatus                       string
+	LfsNotSetUpTitle                      string
+	LfsNotSetUpPrompt                     string
+	SparseCheckout                        string
+	SparseCheckoutTooltip                 string
+	SparseCheckoutTitle                   string
+	SparseCheckoutAddDirectory            string
+	SparseCheckoutAddPattern              string
+	SparseCheckoutAddTooltip              string
+	SparseCheckoutEnableConeMode          string
+	SparseCheckoutDisableConeMode         string
+	SparseCheckoutConeModeTooltip         string
+	DisableSparseCheckout                 string
+	DisableSparseCheckoutTooltip          string
+	DisableSparseCheckoutPrompt           string
+	SparseCheckoutDirectories             string
+	SparseCheckoutPatterns                string
+	SparseCheckoutRemoveTooltip           string
+	NotASparseCheckout                    string
+	UpdatingSparseCheckoutStatus          string
 	CopyToClipboardMenu                   string
 	CopyFileName                          string
 	CopyRelativeFilePath                  string
@@ -121,6 +224,8 @@ type TranslationSet struct {
 	DeleteLocalBranches                   string
 	DeleteRemoteBranchPrompt              string
 	DeleteRemoteBranchesPrompt            string
+	DeleteLocalBranchPrompt               string
+	DeleteLocalBranchesPrompt             string
 	DeleteLocalAndRemoteBranchPrompt      string
 	DeleteLocalAndRemoteBranchesPrompt    string
 	ForceDeleteBranchTitle                string
@@ -193,6 +298,9 @@ type TranslationSet struct {
 	ResetAuthorTooltip                    string
 	SetAuthor                             string
 	SetAuthorTooltip                      string
+	SetAuthorDate                         string
+	SetAuthorDateTooltip                  string
+	SetAuthorDatePromptTitle              string
 	AddCoAuthor                           string
 	AmendCommitAttribute                  string
 	AmendCommitAttributeTooltip           string
@@ -213,6 +321,10 @@ type TranslationSet struct {
 	DiscardAllTooltip                     string
 	DiscardUnstagedTooltip                string
 	DiscardUnstagedDisabled               string
+	DiscardHunk                           string
+	DiscardHunkTooltip                    string
+	DiscardHunkDisabled                   string
+	DiscardHunkTitle                      string
 	Pop                                   string
 	StashPopTooltip                       string
 	Drop                                  string
@@ -238,7 +350,51 @@ type TranslationSet struct {
 	ForcePushDisabled                     string
 	UpdatesRejected                       string
 	UpdatesRejectedAndForcePushDisabled   string
+	ProtectedBranchTitle                  string
+	ProtectedBranchConfirm                string
+	ProtectedBranchBlocked                string
+	ProtectedBranchActionForcePush        string
+	ProtectedBranchActionHardReset        string
+	ProtectedBranchActionDelete           string
+	ProtectedBranchActionRebase           string
+	ProtectedBranchActionRewriteHistory   string
 	CheckForUpdate                        string
+	ViewHooks                             string
+	ViewHooksTooltip                      string
+	HooksTitle                            string
+	ViewMaintenanceOptions                string
+	ViewMaintenanceOptionsTooltip         string
+	MaintenanceTitle                      string
+	MaintenanceHealth                     string
+	CommitGraphPresent                    string
+	CommitGraphMissing                    string
+	MaintenanceRegistered                 string
+	MaintenanceNotRegistered              string
+	RunMaintenance                        string
+	RunMaintenanceTooltip                 string
+	GarbageCollect                        string
+	GarbageCollectTooltip                 string
+	Repack                                string
+	RepackTooltip                         string
+	StartScheduledMaintenance             string
+	StartScheduledMaintenanceTooltip      string
+	StopScheduledMaintenance              string
+	StopScheduledMaintenanceTooltip       string
+	RunningMaintenanceStatus              string
+	MaintenanceDone                       string
+	HooksInDir                            string
+	NoHooks                               string
+	HookEnabled                           string
+	HookDisabled                          string
+	EditHook                              string
+	EnableHook                            string
+	DisableHook                           string
+	RunHook                               string
+	RunHookTooltip                        string
+	SkipHooks                             string
+	SkipHooksWhenPushing                  string
+	SkipHooksWhenMerging                  string
+	SkipHooksWhenRebasing                 string
 	CheckingForUpdates                    string
 	UpdateAvailableTitle                  string
 	UpdateAvailable                       string
@@ -253,6 +409,21 @@ type TranslationSet struct {
 	ConfirmQuitDuringUpdateTitle          string
 	ConfirmQuitDuringUpdate               string
 	IntroPopupMessage                     string
+	SetupWizardTitle                      string
+	SetupWizardPrompt                     string
+	SetupWizardTheme                      string
+	SetupWizardDefaultTheme               string
+	SetupWizardIcons                      string
+	SetupWizardNoIcons                    string
+	SetupWizardNerdFontsVersion           string
+	SetupWizardEditor                     string
+	SetupWizardEditorFromEnv              string
+	SetupWizardPullMode                   string
+	SetupWizardPullModeAuto               string
+	SetupWizardPullModeMerge              string
+	SetupWizardPullModeRebase             string
+	SetupWizardPullModeFastForwardOnly    string
+	SetupWizardConfigWritten              string
 	NonReloadableConfigWarningTitle       string
 	NonReloadableConfigWarning            string
 	GitconfigParseErr                     string
@@ -280,10 +451,69 @@ type TranslationSet struct {
 	SwitchRepo                            string
 	AllBranchesLogGraph                   string
 	AllBranchesLogGraphReverse            string
+	RepoOverviewBranch                    string
+	RepoOverviewUpstream                  string
+	RepoOverviewNoUpstream                string
+	RepoOverviewChanges                   string
+	RepoOverviewChangesSummary            string
+	RepoOverviewWorkingTreeClean          string
+	RepoOverviewStashes                   string
+	RepoOverviewInProgress                string
+	RepoOverviewLatestTag                 string
+	RepoOverviewNoTags                    string
+	RepoOverviewRecentBranches            string
+	RepoOverviewKeyHint                   string
 	UnsupportedGitService                 string
+	UnsupportedPullRequestsForGitService  string
 	CopyPullRequestURL                    string
 	OpenPullRequestInBrowser              string
 	NoPullRequestForBranch                string
+	PullRequestApproved                   string
+	PullRequestChangesRequested           string
+	PullRequestReviewRequired             string
+	OpenFailingCheckInBrowser             string
+	OpenFailingCheckInBrowserTooltip      string
+	CommitDetailsTab                      string
+	ToggleCommitDetails                   string
+	ToggleCommitDetailsTooltip            string
+	GoToParentCommit                      string
+	GoToParentCommitTooltip               string
+	VerifySignature                       string
+	VerifySignatureTooltip                string
+	VerifyTagSignatureTooltip             string
+	GoodSignatureTitle                    string
+	BadSignatureTitle                     string
+	NoSignatureFound                      string
+	ExportPatches                         string
+	ExportPatchesTooltip                  string
+	ExportPatchFiles                      string
+	ExportPatchFilesTooltip               string
+	SendPatchesWithEmail                  string
+	SendPatchesWithEmailTooltip           string
+	CannotExportTodoCommits               string
+	PatchesOutputDirectory                string
+	CoverLetterSubject                    string
+	CoverLetterBlurb                      string
+	ExportingPatchesStatus                string
+	ExportedPatches                       string
+	ApplyPatches                          string
+	ApplyPatchesTooltip                   string
+	ApplyPatchesPrompt                    string
+	NoPatchesFound                        string
+	ApplyPatchFile                        string
+	ApplyPatchFileTooltip                 string
+	ApplyPatchFilePrompt                  string
+	CreateArchive                         string
+	CreateArchiveTooltip                  string
+	CreateArchiveTitle                    string
+	ArchivePrefixPrompt                   string
+	ArchiveOutputPathPrompt               string
+	CreatingArchiveStatus                 string
+	CreatedArchive                        string
+	SelectParentCommit                    string
+	CommitHasNoParents                    string
+	ParentCommitNotInList                 string
+	NoFailingCheckForCommit               string
 	NoBranchOnRemote                      string
 	Fetch                                 string
 	FetchTooltip                          string
@@ -291,6 +521,16 @@ type TranslationSet struct {
 	CollapseAllTooltip                    string
 	ExpandAll                             string
 	ExpandAllTooltip                      string
+	ToggleSkipUntrackedFiles              string
+	ToggleSkipUntrackedFilesTooltip       string
+	ToggleCommitSigning                   string
+	ToggleCommitSigningTooltip            string
+	NextCommitWillBeSigned                string
+	NextCommitWillNotBeSigned             string
+	SigningCommits                        string
+	ResetCommitSigning                    string
+	UntrackedFilesHidden                  string
+	SparseCheckoutLabel                   string
 	DisabledInFlatView                    string
 	FileEnter                             string
 	FileEnterTooltip                      string
@@ -324,15 +564,26 @@ type TranslationSet struct {
 	ViewRebaseOptions                     string
 	ViewCherryPickOptions                 string
 	ViewRevertOptions                     string
+	ViewApplyPatchesOptions               string
 	NotMergingOrRebasing                  string
 	AlreadyRebasing                       string
 	NotMidRebase                          string
 	MustSelectFixupCommit                 string
 	RecentRepos                           string
+	ReposInSearchRoots                    string
+	RepoTabs                              string
+	SwitchRepoTab                         string
+	SwitchRepoTabTooltip                  string
+	OpenRepos                             string
+	OpenRepoInNewTab                      string
+	CloseRepoTab                          string
+	CloseRepoTabTooltip                   string
+	CannotCloseLastRepoTab                string
 	MergeOptionsTitle                     string
 	RebaseOptionsTitle                    string
 	CherryPickOptionsTitle                string
 	RevertOptionsTitle                    string
+	ApplyPatchesOptionsTitle              string
 	CommitSummaryTitle                    string
 	CommitDescriptionTitle                string
 	CommitDescriptionSubTitle             string
@@ -350,6 +601,10 @@ type TranslationSet struct {
 	InformationTitle                      string
 	SecondaryTitle                        string
 	ReflogCommitsTitle                    string
+	ShowReflogOfRef                       string
+	ShowReflogOfRefTooltip                string
+	ShowReflogOfRefPrompt                 string
+	RefNotFound                           string
 	ConflictsResolved                     string
 	Continue                              string
 	UnstagedFilesAfterConflictsResolved   string
@@ -371,6 +626,9 @@ type TranslationSet struct {
 	PendingCherryPicksSectionHeader       string
 	PendingRevertsSectionHeader           string
 	CommitsSectionHeader                  string
+	UnpushedCommitsSectionHeader          string
+	PushedCommitsSectionHeader            string
+	PushedCommitsCollapsedSectionHeader   string
 	YouDied                               string
 	RewordNotSupported                    string
 	ChangingThisActionIsNotAllowed        string
@@ -380,6 +638,17 @@ type TranslationSet struct {
 	CherryPickCopy                        string
 	CherryPickCopyTooltip                 string
 	PasteCommits                          string
+	PasteCommitsWithOptions               string
+	PasteCommitsWithOptionsTooltip        string
+	CherryPickOptions                     string
+	CherryPickCommits                     string
+	CherryPickNoCommit                    string
+	CherryPickNoCommitTooltip             string
+	CherryPickRecordOrigin                string
+	CherryPickRecordOriginTooltip         string
+	CherryPickMainline                    string
+	CherryPickMainlineParent              string
+	CherryPickMainlineTooltip             string
 	SureCherryPick                        string
 	CherryPick                            string
 	CannotCherryPickNonCommit             string
@@ -420,6 +689,7 @@ type TranslationSet struct {
 	LowercaseMergingStatus                string
 	LowercaseCherryPickingStatus          string
 	LowercaseRevertingStatus              string
+	LowercaseApplyingPatchesStatus        string
 	AmendingStatus                        string
 	CherryPickingStatus                   string
 	UndoingStatus                         string
@@ -428,6 +698,7 @@ type TranslationSet struct {
 	CommittingStatus                      string
 	RewordingStatus                       string
 	RevertingStatus                       string
+	ApplyingPatchesStatus                 string
 	CreatingFixupCommitStatus             string
 	MovingCommitsToNewBranchStatus        string
 	CommitFiles                           string
@@ -512,6 +783,9 @@ type TranslationSet struct {
 	StashAllChangesKeepIndex              string
 	StashUnstagedChanges                  string
 	StashIncludeUntrackedChanges          string
+	StashSelectedFiles                    string
+	StashFileHunks                        string
+	StashFileHunksTooltip                 string
 	StashOptions                          string
 	NotARepository                        string
 	WorkingDirectoryDoesNotExist          string
@@ -578,6 +852,8 @@ type TranslationSet struct {
 	LightweightTag                        string
 	AnnotatedTag                          string
 	DeleteTagTitle                        string
+	DeleteTagsTitle                       string
+	DeleteLocalTagsPrompt                 string
 	DeleteLocalTag                        string
 	DeleteRemoteTag                       string
 	DeleteLocalAndRemoteTag               string
@@ -606,6 +882,8 @@ type TranslationSet struct {
 	ViewResetToUpstreamOptions            string
 	NextScreenMode                        string
 	PrevScreenMode                        string
+	ToggleZoom                            string
+	ToggleZoomTooltip                     string
 	CyclePagers                           string
 	CyclePagersTooltip                    string
 	CyclePagersDisabledReason             string
@@ -613,6 +891,13 @@ type TranslationSet struct {
 	StartFilter                           string
 	SelectRemoteRepository                string
 	FetchingPullRequests                  string
+	BitbucketTokenRequired                string
+	WriteCommitGraphTitle                 string
+	WriteCommitGraphPrompt                string
+	SparseIndexExpanded                   string
+	PartialCloneLazyFetchWarning          string
+	FetchingMissingBlobsStatus            string
+	WritingCommitGraphStatus              string
 	Keybindings                           string
 	KeybindingsLegend                     string
 	KeybindingsMenuSectionLocal           string
@@ -643,7 +928,16 @@ type TranslationSet struct {
 	NextPage                              string
 	GotoTop                               string
 	GotoBottom                            string
+	GoToLine                              string
+	GoToLineTooltip                       string
+	GoToLinePrompt                        string
+	InvalidLineNumber                     string
 	FilteringBy                           string
+	FilteringCommits                      string
+	FilteringSince                        string
+	FilteringUntil                        string
+	FilteringByContent                    string
+	FilteringByRegex                      string
 	ResetInParentheses                    string
 	OpenFilteringMenu                     string
 	OpenFilteringMenuTooltip              string
@@ -651,8 +945,14 @@ type TranslationSet struct {
 	ExitFilterMode                        string
 	FilterPathOption                      string
 	FilterAuthorOption                    string
+	FilterContentOption                   string
+	FilterContentOptionTooltip            string
+	FilterRegexOption                     string
+	FilterRegexOptionTooltip              string
 	EnterFileName                         string
 	EnterAuthor                           string
+	EnterContent                          string
+	EnterRegex                            string
 	FilteringMenuTitle                    string
 	WillCancelExistingFilterTooltip       string
 	MustExitFilterModeTitle               string
@@ -665,10 +965,95 @@ type TranslationSet struct {
 	SwapDiff                              string
 	ViewDiffingOptions                    string
 	ViewDiffingOptionsTooltip             string
+	SidePanelLayoutMenuTitle              string
+	ViewSidePanelLayoutOptions            string
+	SidePanelLayoutOptionsTooltip         string
+	MoveSidePanelUp                       string
+	MoveSidePanelDown                     string
+	HideSidePanel                         string
+	ShowSidePanel                         string
+	WidenSidePanels                       string
+	NarrowSidePanels                      string
+	GrowMainView                          string
+	ShrinkMainView                        string
+	ResizeMainViewTooltip                 string
+	ResetSidePanelLayout                  string
+	ResetSidePanelLayoutTooltip           string
+	SidePanelIsHidden                     string
+	SidePanelAlreadyAtTop                 string
+	SidePanelAlreadyAtBottom              string
+	CannotHideLastSidePanel               string
+	SidePanelsAlreadyAtMaximumWidth       string
+	SidePanelsAlreadyAtMinimumWidth       string
+	SidePanelLayoutNotChanged             string
+	MainViewNotSplit                      string
+	MainViewAlreadyAtMaximumSize          string
+	MainViewAlreadyAtMinimumSize          string
+	KeybindingsOverview                   string
+	KeybindingsOverviewTooltip            string
+	KeybindingConflicts                   string
+	KeybindingConflictsWith               string
+	KeybindingIsCustom                    string
+	DisableKeybinding                     string
+	DisableKeybindingPrompt               string
+	KeybindingDisabledInFile              string
+	KeybindingAlreadyDisabled             string
+	CannotDisableListKeybinding           string
+	Cheatsheet                            string
+	CheatsheetTooltip                     string
+	ExportEffectiveConfig                 string
+	ExportEffectiveConfigTooltip          string
+	ExportEffectiveConfigPrompt           string
+	EffectiveConfigExported               string
+	Notifications                         string
+	NotificationsTooltip                  string
+	NoNotifications                       string
+	GoToAnything                          string
+	GoToAnythingTooltip                   string
+	GoToAnythingBranch                    string
+	GoToAnythingFile                      string
+	GoToAnythingCommit                    string
+	GoToAnythingStash                     string
+	Bookmarks                             string
+	BookmarksTooltip                      string
+	NoBookmarks                           string
+	ClearBookmarks                        string
+	BookmarkNotFound                      string
+	ToggleBookmark                        string
+	ToggleBookmarkTooltip                 string
+	BookmarkAdded                         string
+	Language                              string
+	LanguageTooltip                       string
+	LanguageFromConfig                    string
+	DetectLanguage                        string
+	ShowMissingTranslations               string
+	MissingTranslationsTitle              string
+	NoMissingTranslations                 string
+	BookmarkRemoved                       string
+	AccessibilityEmptyList                string
+	ImagePreviewBefore                    string
+	ImagePreviewAfter                     string
+	ImagePreviewFileDoesNotExist          string
+	BackgroundFetchFailed                 string
+	BackgroundFetchOfRemoteFailed         string
+	BackgroundFetchNewCommits             string
+	CustomCommandFinished                 string
+	CancelOperation                       string
+	CancelOperationTooltip                string
+	TogglePerformanceHud                  string
+	TogglePerformanceHudTooltip           string
+	PerformanceHudTitle                   string
+	NoOperationToCancel                   string
+	OperationCancelled                    string
 	CancelDiffingMode                     string
 	OpenCommandLogMenu                    string
 	OpenCommandLogMenuTooltip             string
 	ShowingGitDiff                        string
+	RunningAsDifftool                     string
+	StartCommitNotFound                   string
+	StartCommitNotInCurrentBranch         string
+	StartFileNotFound                     string
+	RunningAsMergetool                    string
 	ShowingDiffForRange                   string
 	CommitDiff                            string
 	CopyCommitHashToClipboard             string
@@ -768,6 +1153,14 @@ type TranslationSet struct {
 	RandomTip                                string
 	ToggleWhitespaceInDiffView               string
 	ToggleWhitespaceInDiffViewTooltip        string
+	ToggleShowWhitespaceInDiffView           string
+	ToggleShowWhitespaceInDiffViewTooltip    string
+	ToggleDiffMode                           string
+	ToggleDiffModeTooltip                    string
+	ToggleLineWrap                           string
+	ToggleLineWrapTooltip                    string
+	ToggleRelativeDates                      string
+	ToggleRelativeDatesTooltip               string
 	IgnoreWhitespaceDiffViewSubTitle         string
 	IgnoreWhitespaceNotSupportedHere         string
 	IncreaseContextInDiffView                string
@@ -816,11 +1209,20 @@ type TranslationSet struct {
 	ViewBisectOptions                        string
 	ConfirmRevertCommit                      string
 	ConfirmRevertCommitRange                 string
+	RevertMergeCommitSelectMainline          string
+	RevertMainlineParent                     string
+	RevertMainlineTooltip                    string
 	RewordInEditorTitle                      string
 	RewordInEditorPrompt                     string
 	CheckoutAutostashPrompt                  string
 	HardResetAutostashPrompt                 string
 	SoftResetPrompt                          string
+	UndoJournalPrompt                        string
+	RedoJournalPrompt                        string
+	JournalDeleteBranch                      string
+	JournalDropStash                         string
+	JournalStageFiles                        string
+	JournalIndexChanged                      string
 	UpstreamGone                             string
 	NukeDescription                          string
 	NukeTreeConfirmation                     string
@@ -828,8 +1230,7 @@ type TranslationSet struct {
 	EmptyOutput                              string
 	Patch                                    string
 	CustomPatch                              string
-	CommitsCopied                            string
-	CommitCopied                             string
+	NumCommitsCopied                         string
 	ResetPatch                               string
 	ResetPatchTooltip                        string
 	ApplyPatch                               string
@@ -851,8 +1252,18 @@ type TranslationSet struct {
 	MustStageFilesAffectedByPatchWarning     string
 	NoMatchesFor                             string
 	MatchesFor                               string
+	MatchXOfY                                string
+	MatchXOfYAtLine                          string
 	SearchKeybindings                        string
 	SearchPrefix                             string
+	SearchPrefixWithOptions                  string
+	SearchOptionRegex                        string
+	SearchOptionCaseSensitive                string
+	SearchOptionIgnoreCase                   string
+	InvalidRegex                             string
+	ToggleSearchRegex                        string
+	ToggleSearchCaseSensitivity              string
+	OnlyAvailableWhenSearching               string
 	FilterPrefix                             string
 	FilterPrefixMenu                         string
 	ExitSearchMode                           string
@@ -909,6 +1320,15 @@ type TranslationSet struct {
 	CannotQuickStartInteractiveRebase        string
 	ToggleRangeSelect                        string
 	DismissRangeSelect                       string
+	ToggleMark                               string
+	ToggleMarkTooltip                        string
+	StagingToggleMarkTooltip                 string
+	StageWords                               string
+	StageWordsTooltip                        string
+	UnstageWords                             string
+	NoCounterpartLineToStageWords            string
+	ClearMarks                               string
+	MarkedItemsNotContiguous                 string
 	RangeSelectUp                            string
 	RangeSelectDown                          string
 	RangeSelectNotSupported                  string
@@ -920,6 +1340,9 @@ type TranslationSet struct {
 	CustomCommands                           string
 	NoApplicableCommandsInThisContext        string
 	SelectCommitsOfCurrentBranch             string
+	CollapsePushedCommits                    string
+	CollapsePushedCommitsTooltip             string
+	NoPushedCommits                          string
 	Actions                                  Actions
 	Bisect                                   Bisect
 	Log                                      Log
@@ -951,6 +1374,10 @@ type Bisect struct {
 	CompletePrompt              string
 	CompletePromptIndeterminate string
 	Bisecting                   string
+	ViewLog                     string
+	ViewLogTooltip              string
+	LogTitle                    string
+	LogCommitNotInList          string
 }
 
 type Log struct {
@@ -995,9 +1422,16 @@ type Actions struct {
 	AmendCommit                      string
 	ResetCommitAuthor                string
 	SetCommitAuthor                  string
+	SetCommitAuthorDate              string
 	AddCommitCoAuthor                string
 	RevertCommit                     string
+	ExportPatches                    string
+	SendPatches                      string
+	ApplyPatches                     string
+	ApplyPatchFile                   string
+	CreateArchive                    string
 	CreateFixupCommit                string
+	AbsorbStagedChanges              string
 	SquashAllAboveFixupCommits       string
 	MoveCommitUp                     string
 	MoveCommitDown                   string
@@ -1014,6 +1448,7 @@ type Actions struct {
 	CustomCommand                    string
 	DiscardAllChangesInFile          string
 	DiscardAllUnstagedChangesInFile  string
+	DiscardFileHunk                  string
 	StageFile                        string
 	StageResolvedFiles               string
 	UnstageFile                      string
@@ -1038,6 +1473,8 @@ type Actions struct {
 	StashStagedChanges               string
 	StashUnstagedChanges             string
 	StashIncludeUntrackedChanges     string
+	StashSelectedFiles               string
+	StashFileHunks                   string
 	GitFlowFinish                    string
 	GitFlowStart                     string
 	CopyToClipboard                  string
@@ -1086,11 +1523,27 @@ type Actions struct {
 	OpenMergeTool                    string
 	OpenCommitInBrowser              string
 	OpenPullRequest                  string
+	OpenFailingCheck                 string
+	LfsLockFile                      string
+	LfsUnlockFile                    string
+	RunMaintenance                   string
+	GarbageCollect                   string
+	Repack                           string
+	StartScheduledMaintenance        string
+	StopScheduledMaintenance         string
 	StartBisect                      string
 	ResetBisect                      string
 	BisectSkip                       string
 	BisectMark                       string
 	AddWorktree                      string
+	RunHook                          string
+	WriteCommitGraph                 string
+	EnableHook                       string
+	DisableHook                      string
+	AddToSparseCheckout              string
+	RemoveFromSparseCheckout         string
+	ToggleSparseCheckoutConeMode     string
+	DisableSparseCheckout            string
 }
 
 const englishIntroPopupMessage = `
@@ -1159,6 +1612,12 @@ func EnglishTranslationSet() *TranslationSet {
 		SureToAmend:                          "Are you sure you want to amend last commit? Afterwards, you can change the commit message from the commits panel.",
 		NoCommitToAmend:                      "There's no commit to amend.",
 		CommitChangesWithEditor:              "Commit changes using git editor",
+		ConventionalCommit:                   "Commit changes using conventional commit message",
+		ConventionalCommitTooltip:            "Choose the type, scope and subject of the commit, and commit with a message header like 'feat(ui): add a button' following <https://www.conventionalcommits.org>. The types to choose from can be changed in the config file with the key 'git.commit.conventionalCommits.types'.",
+		ConventionalCommitType:               "Commit type",
+		ConventionalCommitScope:              "Scope (optional)",
+		ConventionalCommitSubject:            "Subject",
+		NoConventionalCommitTypes:            "No conventional commit types configured. Add them to the config file with the key 'git.commit.conventionalCommits.types'.",
 		FindBaseCommitForFixup:               "Find base commit for fixup",
 		FindBaseCommitForFixupTooltip:        "Find the commit that your current changes are building upon, for the sake of amending/fixing up the commit. This spares you from having to look through your branch's commits one-by-one to see which commit should be amended/fixed up. See docs: <https://github.com/jesseduffield/lazygit/tree/master/docs/Fixup_Commits.md>",
 		NoBaseCommitsFound:                   "No base commits found",
@@ -1167,6 +1626,13 @@ func EnglishTranslationSet() *TranslationSet {
 		BaseCommitIsAlreadyOnMainBranch:      "The base commit for this change is already on the main branch",
 		BaseCommitIsNotInCurrentView:         "Base commit is not in current view",
 		HunksWithOnlyAddedLinesWarning:       "There are ranges of only added lines in the diff; be careful to check that these belong in the found base commit.\n\nProceed?",
+		AbsorbStagedChanges:                  "Absorb staged changes into fixup commits",
+		AbsorbStagedChangesTooltip:           "For each staged hunk, find the commit of the current branch that last touched the lines it changes, and create a fixup commit for each of these commits, like git absorb does. Hunks that only add lines, or that change lines from several commits, stay staged. Afterwards, you can squash the fixup commits into their commits with `{{.squashAbove}}` in the commits panel.",
+		AbsorbStagedChangesPrompt:            "Create fixup commits for these commits?",
+		AbsorbUnassignedHunks:                "{count, plural, one {# hunk doesn't} other {# hunks don't}} belong to any single commit of the current branch and will stay staged.",
+		NoAbsorbTargetsFound:                 "None of the staged hunks belong to a single commit of the current branch",
+		FindingAbsorbTargetsStatus:           "Finding commits to absorb into",
+		CreatingFixupCommitsStatus:           "Creating fixup commits",
 		StatusTitle:                          "Status",
 		Execute:                              "Execute",
 		Stage:                                "Stage",
@@ -1183,6 +1649,37 @@ func EnglishTranslationSet() *TranslationSet {
 		PushTooltip:                          "Push the current branch to its upstream branch. If no upstream is configured, you will be prompted to configure an upstream branch.",
 		Pull:                                 "Pull",
 		PullTooltip:                          "Pull changes from the remote for the current branch. If no upstream is configured, you will be prompted to configure an upstream branch.",
+		PushWithOptions:                      "Push with options",
+		PushWithOptionsTooltip:               "Push the current branch with additional options, such as force-pushing, skipping the pre-push hook, pushing tags, or pushing a custom refspec.",
+		PushOptionsTitle:                     "Push options",
+		PushOptions:                          "Options",
+		PushForceWithLease:                   "Force push (--force-with-lease)",
+		PushForceWithLeaseTooltip:            "Overwrite the remote branch, unless it has commits that you haven't fetched yet.",
+		PushForceIfIncludes:                  "Only force push if remote changes are integrated (--force-if-includes)",
+		PushForceIfIncludesTooltip:           "When force pushing, also refuse if the remote branch has commits that you have fetched but haven't integrated into your branch, e.g. because they were fetched in the background.",
+		PushNoVerify:                         "Skip pre-push hook (--no-verify)",
+		PushNoVerifyTooltip:                  "Don't run the pre-push hook for this push.",
+		PushNoVerifyAlwaysOn:                 "The pre-push hook is skipped for all pushes (see git.skipHooks.push, or the hooks menu in the status panel)",
+		PushFollowTags:                       "Push tags (--follow-tags)",
+		PushFollowTagsTooltip:                "Also push the annotated tags that point at the pushed commits.",
+		PushRefspec:                          "Refspec: {{refspec}}",
+		PushRefspecCurrentBranch:             "current branch",
+		PushRefspecTooltip:                   "Push something other than the current branch to its upstream's remote, e.g. 'HEAD~1:refs/heads/review'. Leave empty to push the current branch.",
+		PushRefspecPrompt:                    "Refspec:",
+		PushRefspecNeedsUpstream:             "Can't push a custom refspec because the current branch has no upstream, so it's not clear which remote to push to",
+		PullWithStrategy:                     "Pull with strategy",
+		PullWithStrategyTooltip:              "Pull the current branch, choosing whether to merge, rebase, or only fast-forward this time, regardless of git.pullMode and your git config.",
+		PullStrategyTitle:                    "Pull strategy",
+		PullStrategyAuto:                     "Use git config",
+		PullStrategyMerge:                    "Merge",
+		PullStrategyRebase:                   "Rebase",
+		PullStrategyFastForwardOnly:          "Fast-forward only",
+		PullStrategyNotConfigured:            "pull.rebase and pull.ff not set",
+		PullStrategyNameMerge:                "merge",
+		PullStrategyNameRebase:               "rebase",
+		PullStrategyNameFastForwardOnly:      "fast-forward only",
+		PullStrategyNameGitDefault:           "git's default, which fails if the branches have diverged",
+		ConfirmPullWithStrategy:              "Pull {{upstream}} into {{branch}} using {{strategy}}?",
 		MergeConflictsTitle:                  "Merge conflicts",
 		MergeConflictDescription_DD:          "Conflict: this file was moved or renamed both in the current and the incoming changes, but to different destinations. I don't know which ones, but they should both show up as conflicts too (marked 'AU' and 'UA', respectively). The most likely resolution is to delete this file, and pick one of the destinations and delete the other.",
 		MergeConflictDescription_AU:          "Conflict: this file is the destination of a move or rename in the current changes, but was moved or renamed to a different destination in the incoming changes. That other destination should also show up as a conflict (marked 'UA'), as well as the file that both were renamed from (marked 'DD').",
@@ -1201,6 +1698,61 @@ func EnglishTranslationSet() *TranslationSet {
 		RemoteBranchCheckoutTooltip:          "Checkout a new local branch based on the selected remote branch, or the remote branch as a detached head.",
 		CantPullOrPushSameBranchTwice:        "You cannot push or pull a branch while it is already being pushed or pulled",
 		FileFilter:                           "Filter files by status",
+		LfsLocks:                             "View LFS locks",
+		LfsLocksTooltip:                      "View the git-lfs locks of the repo, and lock or unlock the selected file. Locks are loaded from the lfs server of the remote, so this needs network access and the git-lfs extension. They are also updated after each background fetch.",
+		LfsLocksTitle:                        "LFS locks",
+		LfsLockFile:                          "Lock selected file",
+		LfsUnlockFile:                        "Unlock selected file",
+		LfsForceUnlockFile:                   "Force unlock selected file",
+		LfsForceUnlockFileTooltip:            "Unlock the selected file even if someone else locked it.",
+		LfsUnlockTooltip:                     "Unlock this file.",
+		LfsFileAlreadyLocked:                 "The selected file is already locked",
+		LfsFileNotLocked:                     "The selected file isn't locked",
+		NoLfsLocks:                           "No files are locked",
+		LoadingLfsLocksStatus:                "Loading LFS locks",
+		Blame:                                "Blame",
+		BlameTooltip:                         "Show for each line of the selected file which commit last changed it, who authored that commit and when. From there you can jump to the commit in the commits panel.",
+		BlameTitle:                           "Blame",
+		LoadingBlame:                         "Loading blame",
+		CanOnlyBlameFiles:                    "Only files can be blamed, not directories",
+		CannotBlameFileNotInHead:             "Cannot blame a file that is new or deleted",
+		OpenFileHistory:                      "Show file history",
+		OpenFileHistoryTooltip:               "Show all commits that touched the selected file, following it across renames. From there you can view the diff of each commit, diff a range of commits, or check out an old version of the file.",
+		FileHistoryTitle:                     "File history",
+		LoadingFileHistory:                   "Loading file history",
+		FileHistoryOnlyForFiles:              "Only files have a history, not directories",
+		FileHistoryFileNotTracked:            "The file is not tracked yet",
+		CheckoutFileVersionTooltip:           "Restore the file as it was in the selected commit, under its current name, and stage it.",
+		GoToBlamedCommit:                     "Go to commit",
+		GoToBlamedCommitTooltip:              "Select the commit that last changed this line in the commits panel.",
+		ExitBlame:                            "Exit blame",
+		BlameLineNotCommittedYet:             "This line hasn't been committed yet",
+		BlamedCommitNotFound:                 "The commit is not part of the current branch's history",
+		GoToCommit:                           "Go to commit",
+		GoToCommitTooltip:                    "Enter a commit hash, tag or branch name and select that commit in the commits panel. If the commit is further down than what has been loaded so far, the rest of the history is loaded first.",
+		GoToCommitPrompt:                     "Go to commit (hash, tag or branch):",
+		CommitNotInCurrentBranch:             "'{{ref}}' is not part of the current branch's history",
+		LockingStatus:                        "Locking",
+		UnlockingStatus:                      "Unlocking",
+		LfsNotSetUpTitle:                     "Git LFS not set up",
+		SparseCheckout:                       "View sparse-checkout options",
+		SparseCheckoutTooltip:                "View the directories that are checked out in this sparse checkout, and add or remove directories. Can also be used to turn the repo into a sparse checkout.",
+		SparseCheckoutTitle:                  "Sparse checkout",
+		SparseCheckoutAddDirectory:           "Add directory",
+		SparseCheckoutAddPattern:             "Add pattern",
+		SparseCheckoutAddTooltip:             "Add a directory to the sparse-checkout definition, so that its files get checked out. If the repo isn't a sparse checkout yet, this turns it into one that only has the files at the top level and in the given directory.",
+		SparseCheckoutEnableConeMode:         "Switch to cone mode",
+		SparseCheckoutDisableConeMode:        "Switch to pattern mode",
+		SparseCheckoutConeModeTooltip:        "In cone mode, the sparse-checkout definition is a list of directories. In pattern mode, it's a list of patterns like in a .gitignore file, which is more flexible but slower in big repos.",
+		DisableSparseCheckout:                "Disable sparse checkout",
+		DisableSparseCheckoutTooltip:         "Check out all files of the repo again.",
+		DisableSparseCheckoutPrompt:          "This checks out all files of the repo, which can take a while in a big repo. Continue?",
+		SparseCheckoutDirectories:            "Directories",
+		SparseCheckoutPatterns:               "Patterns",
+		SparseCheckoutRemoveTooltip:          "Remove this from the sparse-checkout definition, which removes its files from the working tree.",
+		NotASparseCheckout:                   "This repo is not a sparse checkout",
+		UpdatingSparseCheckoutStatus:         "Updating sparse checkout",
+		LfsNotSetUpPrompt:                    "'{{path}}' matches a git-lfs pattern in .gitattributes, but git-lfs isn't set up for this repo (see `git lfs install`), so the file would be stored in git directly. Stage it anyway?",
 		CopyToClipboardMenu:                  "Copy to clipboard",
 		CopyFileName:                         "File name",
 		CopyRelativeFilePath:                 "Relative path",
@@ -1239,6 +1791,8 @@ func EnglishTranslationSet() *TranslationSet {
 		DeleteLocalBranches:                  "Delete local branches",
 		DeleteRemoteBranchPrompt:             "Are you sure you want to delete the remote branch '{{.selectedBranchName}}' from '{{.upstream}}'?",
 		DeleteRemoteBranchesPrompt:           "Are you sure you want to delete the remote branches of the selected branches from their respective remotes?",
+		DeleteLocalBranchPrompt:              "Are you sure you want to delete the branch '{{.selectedBranchName}}' from your machine?",
+		DeleteLocalBranchesPrompt:            "Are you sure you want to delete the selected branches from your machine?",
 		DeleteLocalAndRemoteBranchPrompt:     "Are you sure you want to delete both '{{.localBranchName}}' from your machine, and '{{.remoteBranchName}}' from '{{.remoteName}}'?",
 		DeleteLocalAndRemoteBranchesPrompt:   "Are you sure you want to delete both the selected branches from your machine, and their remote branches from their respective remotes?",
 		ForceDeleteBranchTitle:               "Force delete branch",
@@ -1251,7 +1805,7 @@ func EnglishTranslationSet() *TranslationSet {
 		ForceCheckout:                        "Force checkout",
 		ForceCheckoutTooltip:                 "Force checkout selected branch. This will discard all local changes in your working directory before checking out the selected branch.",
 		CheckoutByName:                       "Checkout by name",
-		CheckoutByNameTooltip:                "Checkout by name. In the input box you can enter '-' to switch to the previous branch.",
+		CheckoutByNameTooltip:                "Checkout by name. In the input box you can enter '-' to switch to the previous branch, or '#<number>' to check out a pull request (or GitLab merge request) by its number.",
 		CheckoutPreviousBranch:               "Checkout previous branch",
 		RemoteBranchCheckoutTitle:            "Checkout {{.branchName}}",
 		RemoteBranchCheckoutPrompt:           "How would you like to check out this branch?",
@@ -1313,9 +1867,12 @@ func EnglishTranslationSet() *TranslationSet {
 		ResetAuthorTooltip:                   "Reset the commit's author to the currently configured user. This will also renew the author timestamp",
 		SetAuthor:                            "Set author",
 		SetAuthorTooltip:                     "Set the author based on a prompt",
+		SetAuthorDate:                        "Set author date",
+		SetAuthorDateTooltip:                 "Set the author date based on a prompt. Any date format that git understands is accepted, e.g. '2024-01-31 12:00:00 +0100' or 'now'.",
+		SetAuthorDatePromptTitle:             "Set author date",
 		AddCoAuthor:                          "Add co-author",
 		AmendCommitAttribute:                 "Amend commit attribute",
-		AmendCommitAttributeTooltip:          "Set/Reset commit author or set co-author.",
+		AmendCommitAttributeTooltip:          "Set/Reset commit author or author date, or set co-author.",
 		SetAuthorPromptTitle:                 "Set author (must look like 'Name <Email>')",
 		AddCoAuthorPromptTitle:               "Add co-author (must look like 'Name <Email>')",
 		AddCoAuthorTooltip:                   "Add co-author using the Github/Gitlab metadata Co-authored-by.",
@@ -1326,12 +1883,16 @@ func EnglishTranslationSet() *TranslationSet {
 		Undo:                                 "Undo",
 		UndoReflog:                           "Undo",
 		RedoReflog:                           "Redo",
-		UndoTooltip:                          "The reflog will be used to determine what git command to run to undo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
-		RedoTooltip:                          "The reflog will be used to determine what git command to run to redo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
+		UndoTooltip:                          "The reflog will be used to determine what git command to run to undo the last git command. Deleting branches, dropping stash entries and staging/unstaging files from the files panel can be undone too. Other changes to the working tree are not taken into consideration.",
+		RedoTooltip:                          "The reflog will be used to determine what git command to run to redo the last git command. Deleting branches, dropping stash entries and staging/unstaging files from the files panel can be redone too. Other changes to the working tree are not taken into consideration.",
 		UndoMergeResolveTooltip:              "Undo last merge conflict resolution.",
 		DiscardAllTooltip:                    "Discard both staged and unstaged changes in '{{.path}}'.",
 		DiscardUnstagedTooltip:               "Discard unstaged changes in '{{.path}}'.",
 		DiscardUnstagedDisabled:              "The selected items don't have both staged and unstaged changes.",
+		DiscardHunk:                          "Discard hunk",
+		DiscardHunkTooltip:                   "Pick a hunk of the unstaged changes in '{{.path}}' and discard it, leaving the other changes alone.",
+		DiscardHunkDisabled:                  "Only available for a single tracked file with unstaged changes.",
+		DiscardHunkTitle:                     "Discard hunk",
 		Pop:                                  "Pop",
 		StashPopTooltip:                      "Apply the stash entry to your working directory and remove the stash entry.",
 		Drop:                                 "Drop",
@@ -1357,7 +1918,51 @@ func EnglishTranslationSet() *TranslationSet {
 		ForcePushDisabled:                    "Your branch has diverged from the remote branch and you've disabled force pushing",
 		UpdatesRejected:                      "Updates were rejected. Please fetch and examine the remote changes before pushing again.",
 		UpdatesRejectedAndForcePushDisabled:  "Updates were rejected and you have disabled force pushing",
+		ProtectedBranchTitle:                 "Protected branch",
+		ProtectedBranchConfirm:               "{count, plural, one {Branch {{branches}} is} other {Branches {{branches}} are}} protected (see git.protectedBranches in your config). Are you sure you want to {{action}} {count, plural, one {it} other {them}}?",
+		ProtectedBranchBlocked:               "Can't {{action}} protected {count, plural, one {branch {{branches}}} other {branches {{branches}}}} (see git.protectedBranches in your config)",
+		ProtectedBranchActionForcePush:       "force-push",
+		ProtectedBranchActionHardReset:       "hard-reset",
+		ProtectedBranchActionDelete:          "delete",
+		ProtectedBranchActionRebase:          "rebase",
+		ProtectedBranchActionRewriteHistory:  "rewrite the history of",
 		CheckForUpdate:                       "Check for update",
+		ViewHooks:                            "View git hooks",
+		ViewHooksTooltip:                     "View the git hooks of the repo, enable, disable, edit or run them, and choose which operations should skip hooks.",
+		HooksTitle:                           "Hooks",
+		ViewMaintenanceOptions:               "View repository maintenance options",
+		ViewMaintenanceOptionsTooltip:        "Show the size of the repository and whether it could do with some maintenance, and run git's maintenance tasks like gc and repack.",
+		MaintenanceTitle:                     "Repository maintenance",
+		MaintenanceHealth:                    "Packed objects: {{packedObjects}} in {{packs}} pack(s), {{packSize}}\nLoose objects: {{looseObjects}}, {{looseSize}}\nCommit-graph: {{commitGraph}}\nScheduled maintenance: {{scheduled}}",
+		CommitGraphPresent:                   "present",
+		CommitGraphMissing:                   "missing",
+		MaintenanceRegistered:                "registered",
+		MaintenanceNotRegistered:             "not registered",
+		RunMaintenance:                       "Run maintenance tasks",
+		RunMaintenanceTooltip:                "Run the maintenance tasks that are enabled for the repository (by default only gc), in the background.",
+		GarbageCollect:                       "Garbage collect",
+		GarbageCollectTooltip:                "Pack loose objects and remove unreachable ones, in the background.",
+		Repack:                               "Repack",
+		RepackTooltip:                        "Pack all objects into a single pack, in the background. This can take a while in big repositories.",
+		StartScheduledMaintenance:            "Register for scheduled maintenance",
+		StartScheduledMaintenanceTooltip:     "Let git run maintenance tasks for this repository in the background every hour, which sets up a cron job (or launchd/systemd/schtasks) if there isn't one yet.",
+		StopScheduledMaintenance:             "Unregister from scheduled maintenance",
+		StopScheduledMaintenanceTooltip:      "Stop running scheduled maintenance tasks for this repository.",
+		RunningMaintenanceStatus:             "Running maintenance",
+		MaintenanceDone:                      "Maintenance done",
+		HooksInDir:                           "Hooks in {{.dir}}",
+		NoHooks:                              "No hooks found",
+		HookEnabled:                          "enabled",
+		HookDisabled:                         "disabled",
+		EditHook:                             "Edit hook",
+		EnableHook:                           "Enable hook",
+		DisableHook:                          "Disable hook",
+		RunHook:                              "Run hook",
+		RunHookTooltip:                       "Run the hook manually, without any arguments and with nothing on stdin. Hooks that need arguments (such as commit-msg) may not work as expected.",
+		SkipHooks:                            "Skip hooks (--no-verify)",
+		SkipHooksWhenPushing:                 "Skip hooks when pushing",
+		SkipHooksWhenMerging:                 "Skip hooks when merging",
+		SkipHooksWhenRebasing:                "Skip hooks when rebasing",
 		CheckingForUpdates:                   "Checking for updates...",
 		UpdateAvailableTitle:                 "Update available!",
 		UpdateAvailable:                      "Download and install version {{.newVersion}}?",
@@ -1372,6 +1977,21 @@ func EnglishTranslationSet() *TranslationSet {
 		ConfirmQuitDuringUpdateTitle:         "Currently updating",
 		ConfirmQuitDuringUpdate:              "An update is in progress. Are you sure you want to quit?",
 		IntroPopupMessage:                    englishIntroPopupMessage,
+		SetupWizardTitle:                     "Setup ({{.step}}/{{.total}}): {{.title}}",
+		SetupWizardPrompt:                    "Pick a few settings to get started. They will be written to your config file, where you can change them later. Press escape to skip the setup.",
+		SetupWizardTheme:                     "Theme",
+		SetupWizardDefaultTheme:              "Default theme",
+		SetupWizardIcons:                     "Icons",
+		SetupWizardNoIcons:                   "No icons",
+		SetupWizardNerdFontsVersion:          "Nerd Fonts v{{.version}}",
+		SetupWizardEditor:                    "Editor",
+		SetupWizardEditorFromEnv:             "Use $GIT_EDITOR, $VISUAL or $EDITOR",
+		SetupWizardPullMode:                  "Pull mode",
+		SetupWizardPullModeAuto:              "Use my git config (pull.rebase, pull.ff)",
+		SetupWizardPullModeMerge:             "Merge",
+		SetupWizardPullModeRebase:            "Rebase",
+		SetupWizardPullModeFastForwardOnly:   "Fast-forward only",
+		SetupWizardConfigWritten:             "Config written to {{.path}}",
 		NonReloadableConfigWarningTitle:      "Config changed",
 		NonReloadableConfigWarning:           englishNonReloadableConfigWarning,
 		GitconfigParseErr:                    `Gogit failed to parse your gitconfig file due to the presence of unquoted '\' characters. Removing these should fix the issue.`,
@@ -1398,12 +2018,71 @@ func EnglishTranslationSet() *TranslationSet {
 		ConfirmQuit:                          `Are you sure you want to quit?`,
 		SwitchRepo:                           `Switch to a recent repo`,
 		AllBranchesLogGraph:                  `Show/cycle all branch logs`,
+		RepoOverviewBranch:                   "Branch",
+		RepoOverviewUpstream:                 "Upstream",
+		RepoOverviewNoUpstream:               "none",
+		RepoOverviewChanges:                  "Changes",
+		RepoOverviewChangesSummary:           "{{.files}} changed files ({{.staged}} staged, {{.untracked}} untracked)",
+		RepoOverviewWorkingTreeClean:         "working tree clean",
+		RepoOverviewStashes:                  "Stashes",
+		RepoOverviewInProgress:               "In progress",
+		RepoOverviewLatestTag:                "Latest tag",
+		RepoOverviewNoTags:                   "none",
+		RepoOverviewRecentBranches:           "Recent branches",
+		RepoOverviewKeyHint:                  "press {{.key}}",
 		AllBranchesLogGraphReverse:           `Show/cycle all branch logs (reverse)`,
 		UnsupportedGitService:                `Unsupported git service`,
+		UnsupportedPullRequestsForGitService: `Creating pull requests is not supported for this git service`,
 		CreatePullRequest:                    `Create pull request`,
 		CopyPullRequestURL:                   `Copy pull request URL to clipboard`,
 		OpenPullRequestInBrowser:             `Open pull request in browser`,
 		NoPullRequestForBranch:               `No pull request found for this branch`,
+		PullRequestApproved:                  "approved",
+		PullRequestChangesRequested:          "changes requested",
+		PullRequestReviewRequired:            "review required",
+		OpenFailingCheckInBrowser:            `Open failing CI check in browser`,
+		OpenFailingCheckInBrowserTooltip:     "Open the page of the first failed CI check of the selected commit, e.g. the log of a failed GitHub Actions or GitLab CI job. CI status is only shown for GitHub and GitLab repos.",
+		CommitDetailsTab:                     "Details",
+		ToggleCommitDetails:                  "Toggle commit details",
+		ToggleCommitDetailsTooltip:           "Switch the main view between the patch of the selected commit and its details: author and committer, dates, refs, signature, message and a summary of the changed files. You can also click the tabs in the title of the main view.",
+		GoToParentCommit:                     "Go to parent commit",
+		GoToParentCommitTooltip:              "Select the parent of the selected commit. For merge commits, choose which parent to go to.",
+		VerifySignature:                      "Verify signature",
+		VerifySignatureTooltip:               "Verify the signature of the selected commit with gpg (or ssh-keygen, depending on git's gpg.format config), and show the details, like the key that made it and how much it is trusted.",
+		VerifyTagSignatureTooltip:            "Verify the signature of the selected tag with gpg (or ssh-keygen, depending on git's gpg.format config), and show the details, like the key that made it and how much it is trusted. Only annotated tags can be signed.",
+		GoodSignatureTitle:                   "Good signature",
+		BadSignatureTitle:                    "Signature verification failed",
+		NoSignatureFound:                     "No signature found",
+		ExportPatches:                        "Export patches",
+		ExportPatchesTooltip:                 "Export the selected commits as a series of patch files with git format-patch, optionally with a cover letter, and optionally send them to a mailing list with git send-email.",
+		ExportPatchFiles:                     "Export patch files",
+		ExportPatchFilesTooltip:              "Write the patch files to a directory of your choice.",
+		SendPatchesWithEmail:                 "Send with git send-email",
+		SendPatchesWithEmailTooltip:          "Export the patch files to a temporary directory and hand them to git send-email, which asks for the recipients (unless sendemail.to is configured) and for confirmation before sending each mail. git send-email needs to be set up with your SMTP server first.",
+		CannotExportTodoCommits:              "Commits that haven't been rebased yet can't be exported",
+		PatchesOutputDirectory:               "Output directory:",
+		CoverLetterSubject:                   "Cover letter subject (leave empty for no cover letter):",
+		CoverLetterBlurb:                     "Cover letter text:",
+		ExportingPatchesStatus:               "Exporting patches",
+		ExportedPatches:                      "Exported {count, plural, one {# patch file} other {# patch files}} to {{dir}}",
+		ApplyPatches:                         "Apply patches",
+		ApplyPatchesTooltip:                  "Apply patches from a patch file, an mbox, or a directory of patch files (as exported with git format-patch) as commits on top of the current branch, using git am. If a patch doesn't apply cleanly, git falls back to a three-way merge, and you can resolve the conflicts and continue, skip the patch, or abort, like for a rebase.",
+		ApplyPatchesPrompt:                   "Patch file, mbox or directory of patches:",
+		NoPatchesFound:                       "No patch files found in {{path}}",
+		ApplyPatchFile:                       "Apply patch file",
+		ApplyPatchFileTooltip:                "Apply a patch file to the working tree and the index with git apply, without committing it. If the patch doesn't apply cleanly, git falls back to a three-way merge and leaves the conflicting files for you to resolve.",
+		ApplyPatchFilePrompt:                 "Patch file:",
+		CreateArchive:                        "Create archive",
+		CreateArchiveTooltip:                 "Create a tar or zip archive of the files of the selected ref with git archive, e.g. to share a snapshot of the source code. Only committed files are included.",
+		CreateArchiveTitle:                   "Create archive of {{name}}",
+		ArchivePrefixPrompt:                  "Directory to put the files in inside the archive (leave empty for none):",
+		ArchiveOutputPathPrompt:              "Archive file:",
+		CreatingArchiveStatus:                "Creating archive",
+		CreatedArchive:                       "Created archive {{path}}",
+		SelectParentCommit:                   "Select parent commit",
+		CommitHasNoParents:                   "The selected commit has no parents",
+		ParentCommitNotInList:                "The parent commit is not in the list. It may not have been loaded yet, or be hidden by a filter.",
+		NoFailingCheckForCommit:              `No failed CI checks found for this commit`,
 		NoBranchOnRemote:                     `This branch doesn't exist on remote. You need to push it to remote first.`,
 		Fetch:                                `Fetch`,
 		FetchTooltip:                         "Fetch changes from remote.",
@@ -1411,6 +2090,16 @@ func EnglishTranslationSet() *TranslationSet {
 		CollapseAllTooltip:                   "Collapse all directories in the files tree",
 		ExpandAll:                            "Expand all files",
 		ExpandAllTooltip:                     "Expand all directories in the file tree",
+		ToggleSkipUntrackedFiles:             "Toggle scanning for untracked files",
+		ToggleSkipUntrackedFilesTooltip:      "Temporarily show or hide untracked files. Not scanning for them can make refreshing much faster in huge repos. Set git.skipUntrackedFiles in your config to hide them by default.",
+		ToggleCommitSigning:                  "Toggle signing of next commit",
+		ToggleCommitSigningTooltip:           "Sign the next commit (-S) even though commit.gpgSign is off, or don't sign it (--no-gpg-sign) even though it's on. This only applies to the next commit that you make; after that, commit.gpgSign applies again.",
+		NextCommitWillBeSigned:               "Next commit will be signed",
+		NextCommitWillNotBeSigned:            "Next commit won't be signed",
+		SigningCommits:                       "Signing commits",
+		ResetCommitSigning:                   "Reset commit signing",
+		UntrackedFilesHidden:                 "(untracked files hidden)",
+		SparseCheckoutLabel:                  "(sparse checkout)",
 		DisabledInFlatView:                   "Not available in flat view",
 		FileEnter:                            `Stage lines / Collapse directory`,
 		FileEnterTooltip:                     "If the selected item is a file, focus the staging view so you can stage individual hunks/lines. If the selected item is a directory, collapse/expand it.",
@@ -1419,6 +2108,14 @@ func EnglishTranslationSet() *TranslationSet {
 		DiscardSelectionTooltip:              "When unstaged change is selected, discard the change using `git reset`. When staged change is selected, unstage the change.",
 		ToggleRangeSelect:                    "Toggle range select",
 		DismissRangeSelect:                   "Dismiss range select",
+		ToggleMark:                           "Toggle mark",
+		StagingToggleMarkTooltip:             "Mark or unmark the selected changes. While changes are marked, staging or discarding applies to all marked changes at once instead of the selection, so you can pick hunks that aren't next to each other. Press escape to clear all marks.",
+		StageWords:                           "Stage/unstage words",
+		StageWordsTooltip:                    "Stage or unstage only some of the changed words of the selected line, by picking a change from a menu. The selected line must be a changed line that has a counterpart on the other side of the diff, i.e. the n-th removed line of a block of changes is paired with the n-th added line.",
+		UnstageWords:                         "Unstage words",
+		NoCounterpartLineToStageWords:        "The selected line has no counterpart on the other side of the diff, so there are no individual words to stage.",
+		ToggleMarkTooltip:                    "Mark or unmark the selected item(s). While items are marked, actions that support multiple items operate on the marked items instead of the selection, so you can act on items that aren't next to each other. Press escape to clear all marks.",
+		ClearMarks:                           "Clear marks",
 		ToggleSelectHunk:                     "Toggle hunk selection",
 		SelectHunk:                           "Select hunks",
 		SelectLineByLine:                     "Select line-by-line",
@@ -1444,15 +2141,26 @@ func EnglishTranslationSet() *TranslationSet {
 		ViewRebaseOptions:                    "View rebase options",
 		ViewCherryPickOptions:                "View cherry-pick options",
 		ViewRevertOptions:                    "View revert options",
+		ViewApplyPatchesOptions:              "View apply patches options",
 		NotMergingOrRebasing:                 "You are currently neither rebasing nor merging",
 		AlreadyRebasing:                      "Can't perform this action during a rebase",
 		NotMidRebase:                         "This action only works during an interactive rebase",
 		MustSelectFixupCommit:                "This action only works on fixup commits",
 		RecentRepos:                          "Recent repositories",
+		ReposInSearchRoots:                   "Repositories in search roots",
+		RepoTabs:                             "Repository tabs",
+		SwitchRepoTab:                        "Switch repository tab",
+		SwitchRepoTabTooltip:                 "Switch between the repositories that you opened in this session. Lazygit keeps the state of each of them (selection, scroll position, modes like filtering or cherry-picking), so switching back is quick. When more than one repository is open, they are also shown as tabs of the status panel.",
+		OpenRepos:                            "Open repositories",
+		OpenRepoInNewTab:                     "Open repository in new tab",
+		CloseRepoTab:                         "Close current tab",
+		CloseRepoTabTooltip:                  "Close the tab of the current repository and switch to the previous one. The state of the closed repository is discarded, so it is loaded from scratch when you open it again.",
+		CannotCloseLastRepoTab:               "This is the only open repository",
 		MergeOptionsTitle:                    "Merge options",
 		RebaseOptionsTitle:                   "Rebase options",
 		CherryPickOptionsTitle:               "Cherry-pick options",
 		RevertOptionsTitle:                   "Revert options",
+		ApplyPatchesOptionsTitle:             "Apply patches options",
 		CommitSummaryTitle:                   "Commit summary",
 		CommitDescriptionTitle:               "Commit description",
 		CommitDescriptionSubTitle:            "Press {{.togglePanelKeyBinding}} to toggle focus, {{.commitMenuKeybinding}} to open menu",
@@ -1470,6 +2178,10 @@ func EnglishTranslationSet() *TranslationSet {
 		InformationTitle:                     "Information",
 		SecondaryTitle:                       "Secondary",
 		ReflogCommitsTitle:                   "Reflog",
+		ShowReflogOfRef:                      "Show reflog of branch",
+		ShowReflogOfRefTooltip:               "Show the reflog of a branch (or of any other ref that has one) instead of the one of HEAD, i.e. the history of the commits that the branch has pointed to. Enter HEAD or nothing to go back to the reflog of HEAD.",
+		ShowReflogOfRefPrompt:                "Show reflog of:",
+		RefNotFound:                          "Could not find ref '{{ref}}'",
 		GlobalTitle:                          "Global keybindings",
 		ConflictsResolved:                    "All merge conflicts resolved. Continue the %s?",
 		Continue:                             "Continue",
@@ -1496,6 +2208,9 @@ func EnglishTranslationSet() *TranslationSet {
 		PendingCherryPicksSectionHeader:      "Pending cherry-picks",
 		PendingRevertsSectionHeader:          "Pending reverts",
 		CommitsSectionHeader:                 "Commits",
+		UnpushedCommitsSectionHeader:         "Unpushed commits (%d)",
+		PushedCommitsSectionHeader:           "Pushed commits",
+		PushedCommitsCollapsedSectionHeader:  "Pushed commits (collapsed, press %s to expand)",
 		YouDied:                              "YOU DIED!",
 		RewordNotSupported:                   "Rewording commits while interactively rebasing is not currently supported",
 		ChangingThisActionIsNotAllowed:       "Changing this kind of rebase todo entry is not allowed",
@@ -1505,7 +2220,18 @@ func EnglishTranslationSet() *TranslationSet {
 		CherryPickCopy:                       "Copy (cherry-pick)",
 		CherryPickCopyTooltip:                "Mark commit as copied. Then, within the local commits view, you can press `{{.paste}}` to paste (cherry-pick) the copied commit(s) into your checked out branch. At any time you can press `{{.escape}}` to cancel the selection.",
 		PasteCommits:                         "Paste (cherry-pick)",
-		SureCherryPick:                       "Are you sure you want to cherry-pick the {{.numCommits}} copied commit(s) onto this branch?",
+		PasteCommitsWithOptions:              "Paste (cherry-pick) with options",
+		PasteCommitsWithOptionsTooltip:       "Cherry-pick the copied commits, choosing options for git cherry-pick first: whether to commit them, whether to record where they were picked from, and which parent to diff merge commits against.",
+		CherryPickOptions:                    "Options",
+		CherryPickCommits:                    "Cherry-pick {numCommits, plural, one {the copied commit} other {the # copied commits}}",
+		CherryPickNoCommit:                   "Don't commit (--no-commit)",
+		CherryPickNoCommitTooltip:            "Apply the changes of the copied commits to the index and the working tree without creating any commits, so that you can edit them or commit them together.",
+		CherryPickRecordOrigin:               "Record origin (-x)",
+		CherryPickRecordOriginTooltip:        "Append a line saying \"(cherry picked from commit ...)\" to the message of each new commit, so that you can tell where it came from.",
+		CherryPickMainline:                   "Mainline for merge commits",
+		CherryPickMainlineParent:             "Parent {{number}}",
+		CherryPickMainlineTooltip:            "Merge commits can only be cherry-picked relative to one of their parents (-m); the changes that the merge brought in compared to that parent are picked. Parent 1 is usually the branch that was merged into.",
+		SureCherryPick:                       "Are you sure you want to cherry-pick {numCommits, plural, one {the copied commit} other {the # copied commits}} onto this branch?",
 		CherryPick:                           "Cherry-pick",
 		CannotCherryPickNonCommit:            "Cannot cherry-pick this kind of todo item",
 		Donate:                               "Donate",
@@ -1541,10 +2267,11 @@ func EnglishTranslationSet() *TranslationSet {
 		MovingStatus:                         "Moving",
 		RebasingStatus:                       "Rebasing",
 		MergingStatus:                        "Merging",
-		LowercaseRebasingStatus:              "rebasing",       // lowercase because it shows up in parentheses
-		LowercaseMergingStatus:               "merging",        // lowercase because it shows up in parentheses
-		LowercaseCherryPickingStatus:         "cherry-picking", // lowercase because it shows up in parentheses
-		LowercaseRevertingStatus:             "reverting",      // lowercase because it shows up in parentheses
+		LowercaseRebasingStatus:              "rebasing",         // lowercase because it shows up in parentheses
+		LowercaseMergingStatus:               "merging",          // lowercase because it shows up in parentheses
+		LowercaseCherryPickingStatus:         "cherry-picking",   // lowercase because it shows up in parentheses
+		LowercaseRevertingStatus:             "reverting",        // lowercase because it shows up in parentheses
+		LowercaseApplyingPatchesStatus:       "applying patches", // lowercase because it shows up in parentheses
 		AmendingStatus:                       "Amending",
 		CherryPickingStatus:                  "Cherry-picking",
 		UndoingStatus:                        "Undoing",
@@ -1553,6 +2280,7 @@ func EnglishTranslationSet() *TranslationSet {
 		CommittingStatus:                     "Committing",
 		RewordingStatus:                      "Rewording",
 		RevertingStatus:                      "Reverting",
+		ApplyingPatchesStatus:                "Applying patches",
 		CreatingFixupCommitStatus:            "Creating fixup commit",
 		MovingCommitsToNewBranchStatus:       "Moving commits to new branch",
 		CommitFiles:                          "Commit files",
@@ -1638,6 +2366,9 @@ func EnglishTranslationSet() *TranslationSet {
 		StashAllChangesKeepIndex:             "Stash all changes and keep index",
 		StashUnstagedChanges:                 "Stash unstaged changes",
 		StashIncludeUntrackedChanges:         "Stash all changes including untracked files",
+		StashSelectedFiles:                   "Stash selected files",
+		StashFileHunks:                       "Stash hunks of selected files",
+		StashFileHunksTooltip:                "Pick the hunks of the selected files to stash, using `git stash push --patch` in a terminal. Untracked files can't be stashed this way.",
 		StashOptions:                         "Stash options",
 		NotARepository:                       "Error: must be run inside a git repository",
 		WorkingDirectoryDoesNotExist:         "Error: the current working directory does not exist",
@@ -1704,6 +2435,8 @@ func EnglishTranslationSet() *TranslationSet {
 		AnnotatedTag:                         "Annotated tag",
 		LightweightTag:                       "Lightweight tag",
 		DeleteTagTitle:                       "Delete tag '{{.tagName}}'?",
+		DeleteTagsTitle:                      "Delete selected tags?",
+		DeleteLocalTagsPrompt:                "Are you sure you want to delete the selected tags from your machine?",
 		DeleteLocalTag:                       "Delete local tag",
 		DeleteRemoteTag:                      "Delete remote tag",
 		DeleteLocalAndRemoteTag:              "Delete local and remote tag",
@@ -1734,6 +2467,8 @@ func EnglishTranslationSet() *TranslationSet {
 		ViewResetToUpstreamOptions:       "View upstream reset options",
 		NextScreenMode:                   "Next screen mode (normal/half/fullscreen)",
 		PrevScreenMode:                   "Prev screen mode",
+		ToggleZoom:                       "Toggle zoom",
+		ToggleZoomTooltip:                "Make the focused panel (including the main view and the command log) take up the whole screen, or restore the previous screen mode.",
 		CyclePagers:                      "Cycle pagers",
 		CyclePagersTooltip:               "Choose the next pager in the list of configured pagers",
 		CyclePagersDisabledReason:        "No other pagers configured",
@@ -1741,6 +2476,13 @@ func EnglishTranslationSet() *TranslationSet {
 		StartFilter:                      "Filter the current view by text",
 		SelectRemoteRepository:           "Select base repository for pull requests",
 		FetchingPullRequests:             "Fetching pull requests",
+		BitbucketTokenRequired:           "Checking out a Bitbucket pull request by its number requires an access token in the BITBUCKET_TOKEN environment variable.",
+		WriteCommitGraphTitle:            "Write commit-graph",
+		WriteCommitGraphPrompt:           "Loading the branches took {{.duration}}. This repo has no commit-graph file, which makes computing how far branches are ahead or behind much faster. Do you want to write one now (`git commit-graph write --reachable --changed-paths`)?\n\nTo keep it up to date, you can enable git's `fetch.writeCommitGraph` config.",
+		SparseIndexExpanded:              "Running '{{.command}}' expanded the sparse index to a full index, which is slow. Your working directory probably has contents outside of your sparse-checkout definition.",
+		PartialCloneLazyFetchWarning:     "This repo is a partial clone, so showing diffs may download missing file contents from the remote, which can be slow. Set git.fetchMissingBlobs to 'background' to avoid waiting for this.",
+		FetchingMissingBlobsStatus:       "Fetching missing file contents",
+		WritingCommitGraphStatus:         "Writing commit-graph",
 		KeybindingsLegend:                "Legend: `<c-b>` means ctrl+b, `<a-b>` means alt+b, `B` means shift+b",
 		RenameBranch:                     "Rename branch",
 		BranchUpstreamOptionsTitle:       "Upstream options",
@@ -1767,7 +2509,16 @@ func EnglishTranslationSet() *TranslationSet {
 		NextPage:                         "Next page",
 		GotoTop:                          "Scroll to top",
 		GotoBottom:                       "Scroll to bottom",
+		GoToLine:                         "Go to line",
+		GoToLineTooltip:                  "Jump to the given line number. In the staging and patch building views, this is the line number in the file, and the nearest line that is part of the diff is selected.",
+		GoToLinePrompt:                   "Go to line:",
+		InvalidLineNumber:                "Invalid line number",
 		FilteringBy:                      "Filtering by",
+		FilteringCommits:                 "Filtering commits",
+		FilteringSince:                   "since",
+		FilteringUntil:                   "until",
+		FilteringByContent:               "Filtering by commits adding or removing",
+		FilteringByRegex:                 "Filtering by changed lines matching",
 		ResetInParentheses:               "(Reset)",
 		OpenFilteringMenu:                "View filter options",
 		OpenFilteringMenuTooltip:         "View options for filtering the commit log, so that only commits matching the filter are shown.",
@@ -1775,8 +2526,14 @@ func EnglishTranslationSet() *TranslationSet {
 		ExitFilterMode:                   "Stop filtering",
 		FilterPathOption:                 "Enter path to filter by",
 		FilterAuthorOption:               "Enter author to filter by",
+		FilterContentOption:              "Enter text to filter by (git log -S)",
+		FilterContentOptionTooltip:       "Only show commits that add or remove the given text, i.e. that change the number of times it occurs. The diffs of these commits only show the files in which this happens.",
+		FilterRegexOption:                "Enter regex to filter by (git log -G)",
+		FilterRegexOptionTooltip:         "Only show commits with added or removed lines that match the given regex. The diffs of these commits only show the files that have such lines.",
 		EnterFileName:                    "Enter path:",
 		EnterAuthor:                      "Enter author:",
+		EnterContent:                     "Enter text:",
+		EnterRegex:                       "Enter regex:",
 		FilteringMenuTitle:               "Filtering",
 		WillCancelExistingFilterTooltip:  "Note: this will cancel the existing filter",
 		MustExitFilterModeTitle:          "Command not available",
@@ -1789,11 +2546,96 @@ func EnglishTranslationSet() *TranslationSet {
 		SwapDiff:                         "Reverse diff direction",
 		ViewDiffingOptions:               "View diffing options",
 		ViewDiffingOptionsTooltip:        "View options relating to diffing two refs e.g. diffing against selected ref, entering ref to diff against, and reversing the diff direction.",
+		SidePanelLayoutMenuTitle:         "Side panel layout",
+		ViewSidePanelLayoutOptions:       "View side panel layout options",
+		SidePanelLayoutOptionsTooltip:    "Reorder, hide, show, or resize the side panels. Changes are remembered across restarts until you reset them; to set the layout permanently, use the gui.sidePanels config. Panel sizes are remembered per repo, and can also be changed by dragging the border between panels with the mouse.",
+		MoveSidePanelUp:                  "Move '{{.panel}}' panel up",
+		MoveSidePanelDown:                "Move '{{.panel}}' panel down",
+		HideSidePanel:                    "Hide '{{.panel}}' panel",
+		ShowSidePanel:                    "Show '{{.panel}}' panel",
+		WidenSidePanels:                  "Widen side panels",
+		NarrowSidePanels:                 "Narrow side panels",
+		GrowMainView:                     "Grow main view",
+		ShrinkMainView:                   "Shrink main view",
+		ResizeMainViewTooltip:            "When the main view is split (e.g. when staging a file that has both staged and unstaged changes), give more or less of the space to the first of the two views.",
+		ResetSidePanelLayout:             "Reset side panel layout",
+		ResetSidePanelLayoutTooltip:      "Discard the changes made from this menu or by dragging panel borders, and go back to the layout from your config.",
+		SidePanelIsHidden:                "This panel is hidden",
+		SidePanelAlreadyAtTop:            "This panel is already at the top",
+		SidePanelAlreadyAtBottom:         "This panel is already at the bottom",
+		CannotHideLastSidePanel:          "Cannot hide the only visible panel",
+		SidePanelsAlreadyAtMaximumWidth:  "The side panels are already at their maximum width",
+		SidePanelsAlreadyAtMinimumWidth:  "The side panels are already at their minimum width",
+		SidePanelLayoutNotChanged:        "The side panel layout has not been changed",
+		MainViewNotSplit:                 "The main view is not split",
+		MainViewAlreadyAtMaximumSize:     "The main view is already at its maximum size",
+		MainViewAlreadyAtMinimumSize:     "The main view is already at its minimum size",
+		KeybindingsOverview:              "View keybindings overview",
+		KeybindingsOverviewTooltip:       "List the keys of all keybindings from the config, grouped by section, and flag the keys you changed that clash with other keybindings. Select a keybinding to disable it in your config file.",
+		KeybindingConflicts:              "Conflicts",
+		KeybindingConflictsWith:          "conflicts with {{.other}}",
+		KeybindingIsCustom:               "custom",
+		DisableKeybinding:                "Disable keybinding",
+		DisableKeybindingPrompt:          "Are you sure you want to disable '{{.keybinding}}'? This sets it to '<disabled>' in your global config file.",
+		KeybindingDisabledInFile:         "Disabled '{{.keybinding}}' in {{.file}}",
+		KeybindingAlreadyDisabled:        "This keybinding is already disabled",
+		CannotDisableListKeybinding:      "This key is part of a list; edit the list in your config file instead",
+		Cheatsheet:                       "View cheatsheet",
+		CheatsheetTooltip:                "Show the keybindings of all views for your current config, including custom commands. Press '/' to search. From here you can also export your effective config to a file.",
+		ExportEffectiveConfig:            "Export effective config",
+		ExportEffectiveConfigTooltip:     "Write the config that lazygit is currently using (the defaults merged with all your config files) to a file, e.g. for sharing it or attaching it to a bug report.",
+		ExportEffectiveConfigPrompt:      "Export config to:",
+		EffectiveConfigExported:          "Config exported to {{.path}}",
+		Notifications:                    "View notifications",
+		NotificationsTooltip:             "Show the notifications that were displayed at the bottom of the screen recently, e.g. results of background fetches or errors.",
+		NoNotifications:                  "No notifications yet.",
+		GoToAnything:                     "Go to anything",
+		GoToAnythingTooltip:              "Search branches, files, commits and stashes at once, and jump to the selected one. Matching is fuzzy if `gui.filterMode` is set to 'fuzzy'.",
+		GoToAnythingBranch:               "branch",
+		GoToAnythingFile:                 "file",
+		GoToAnythingCommit:               "commit",
+		GoToAnythingStash:                "stash",
+		Bookmarks:                        "Bookmarks",
+		BookmarksTooltip:                 "Show the bookmarked branches, files and commits of the repo, and jump to the selected one.",
+		NoBookmarks:                      "No bookmarks yet",
+		ClearBookmarks:                   "Clear all bookmarks",
+		BookmarkNotFound:                 "Bookmarked item is not in the list anymore",
+		ToggleBookmark:                   "Toggle bookmark",
+		ToggleBookmarkTooltip:            "Bookmark the selected item, or remove its bookmark. Bookmarks are remembered per repo and can be viewed in the bookmarks menu.",
+		BookmarkAdded:                    "Bookmark added",
+		Language:                         "Language",
+		LanguageTooltip:                  "Change the language of lazygit without restarting. The choice is remembered across restarts until you go back to the language from your config; to set the language permanently, use the gui.language config.",
+		LanguageFromConfig:               "Use language from config ({{language}})",
+		DetectLanguage:                   "Detect from system locale",
+		ShowMissingTranslations:          "Show missing translations",
+		MissingTranslationsTitle:         "Missing translations for {{language}} ({{missing}} of {{total}})",
+		NoMissingTranslations:            "All texts are translated",
+		BookmarkRemoved:                  "Bookmark removed",
+		AccessibilityEmptyList:           "empty",
+		ImagePreviewBefore:               "Before",
+		ImagePreviewAfter:                "After",
+		ImagePreviewFileDoesNotExist:     "file does not exist",
+		BackgroundFetchFailed:            "Background fetch failed: {{.error}}",
+		BackgroundFetchOfRemoteFailed:    "Background fetch of remote '{{.remote}}' failed: {{.error}}",
+		BackgroundFetchNewCommits:        "Fetched new commits for {{.branches}}",
+		CustomCommandFinished:            "Finished: {{.command}}",
+		CancelOperation:                  "Cancel running operation",
+		CancelOperationTooltip:           "Abort the fetch whose progress is shown at the bottom of the screen. Operations that change the working tree, like a pull or a checkout, can't be cancelled, because that could leave the repo in a broken state.",
+		TogglePerformanceHud:             "Toggle performance HUD",
+		TogglePerformanceHudTooltip:      "Show or hide an overlay listing how long the most recent git commands and panel refreshes took. Run lazygit with --profile to also write these timings to a trace file.",
+		PerformanceHudTitle:              "Timings",
+		NoOperationToCancel:              "There is no running operation that can be cancelled",
+		OperationCancelled:               "Operation cancelled",
 		CancelDiffingMode:                "Cancel diffing mode",
 		// the actual view is the extras view which I intend to give more tabs in future but for now we'll only mention the command log part
 		OpenCommandLogMenu:                       "View command log options",
 		OpenCommandLogMenuTooltip:                "View options for the command log e.g. show/hide the command log and focus the command log.",
 		ShowingGitDiff:                           "Showing output for:",
+		RunningAsDifftool:                        "Running as git difftool",
+		StartCommitNotFound:                      "Could not find commit '%s'",
+		StartCommitNotInCurrentBranch:            "Commit '%s' is not in the current branch",
+		StartFileNotFound:                        "'%s' is not in the files panel; only files with changes are shown there",
+		RunningAsMergetool:                       "Running as git mergetool",
 		ShowingDiffForRange:                      "Showing diff for range",
 		CommitDiff:                               "Commit diff",
 		CopyCommitHashToClipboard:                "Copy abbreviated commit hash to clipboard",
@@ -1892,6 +2734,14 @@ func EnglishTranslationSet() *TranslationSet {
 		RandomTip:                                "Random tip",
 		ToggleWhitespaceInDiffView:               "Toggle whitespace",
 		ToggleWhitespaceInDiffViewTooltip:        "Toggle whether or not whitespace changes are shown in the diff view.\n\nThe default can be changed in the config file with the key 'git.ignoreWhitespaceInDiffView'.",
+		ToggleShowWhitespaceInDiffView:           "Toggle showing whitespace",
+		ToggleShowWhitespaceInDiffViewTooltip:    "Toggle whether tabs, trailing spaces and carriage returns are made visible in the diff view.\n\nThe default can be changed in the config file with the key 'gui.showWhitespaceInDiffView'.",
+		ToggleDiffMode:                           "Toggle side-by-side diff",
+		ToggleDiffModeTooltip:                    "Switch the main view between showing diffs in the unified format, and showing the old and new version of each hunk next to each other.\n\nThe default can be changed in the config file with the key 'gui.diffMode'.",
+		ToggleLineWrap:                           "Toggle line wrapping",
+		ToggleLineWrapTooltip:                    "Toggle whether long lines are wrapped in the main view, or cut off so that you can scroll horizontally. When in the staging or patch building view, this toggles wrapping in those views instead.\n\nThe defaults can be changed in the config file with the keys 'gui.wrapLinesInMainView' and 'gui.wrapLinesInStagingView'.",
+		ToggleRelativeDates:                      "Toggle relative/absolute dates",
+		ToggleRelativeDatesTooltip:               "Switch the commits, reflog and branches panels between showing relative dates (e.g. '2d') and absolute ones.\n\nThe format used by each panel can be changed in the config file with the key 'gui.panelTimeFormats'.",
 		IgnoreWhitespaceDiffViewSubTitle:         "(ignoring whitespace)",
 		IgnoreWhitespaceNotSupportedHere:         "Ignoring whitespace is not supported in this view",
 		IncreaseContextInDiffView:                "Increase diff context size",
@@ -1938,10 +2788,19 @@ func EnglishTranslationSet() *TranslationSet {
 		ViewBisectOptions:                        "View bisect options",
 		ConfirmRevertCommit:                      "Are you sure you want to revert {{.selectedCommit}}?",
 		ConfirmRevertCommitRange:                 "Are you sure you want to revert the selected commits?",
+		RevertMergeCommitSelectMainline:          "Revert merge commit relative to parent",
+		RevertMainlineParent:                     "Parent {{number}}",
+		RevertMainlineTooltip:                    "Merge commits can only be reverted relative to one of their parents (-m); the changes that the merge brought in compared to that parent are reverted. Parent 1 is usually the branch that was merged into.",
 		RewordInEditorTitle:                      "Reword in editor",
 		RewordInEditorPrompt:                     "Are you sure you want to reword this commit in your editor?",
 		HardResetAutostashPrompt:                 "Are you sure you want to hard reset to '%s'? An auto-stash will be performed if necessary.",
 		SoftResetPrompt:                          "Are you sure you want to soft reset to '%s'?",
+		UndoJournalPrompt:                        "Are you sure you want to undo '%s'?",
+		RedoJournalPrompt:                        "Are you sure you want to redo '%s'?",
+		JournalDeleteBranch:                      "Delete branch %s",
+		JournalDropStash:                         "Drop stash entry %s",
+		JournalStageFiles:                        "Stage/unstage files",
+		JournalIndexChanged:                      "The index has changed since '%s', so it can't be reverted safely.",
 		CheckoutAutostashPrompt:                  "Are you sure you want to checkout '%s'? An auto-stash will be performed if necessary.",
 		UpstreamGone:                             "(upstream gone)",
 		NukeDescription:                          "If you want to make all the changes in the worktree go away, this is the way to do it. If there are dirty submodule changes this will stash those changes in the submodule(s).",
@@ -1950,8 +2809,7 @@ func EnglishTranslationSet() *TranslationSet {
 		EmptyOutput:                              "<Empty output>",
 		Patch:                                    "Patch",
 		CustomPatch:                              "Custom patch",
-		CommitsCopied:                            "commits copied", // lowercase because it's used in a sentence
-		CommitCopied:                             "commit copied",  // lowercase because it's used in a sentence
+		NumCommitsCopied:                         "{count, plural, one {# commit copied} other {# commits copied}}",
 		ResetPatch:                               "Reset patch",
 		ResetPatchTooltip:                        "Clear the current patch.",
 		ApplyPatch:                               "Apply patch",
@@ -1974,9 +2832,19 @@ func EnglishTranslationSet() *TranslationSet {
 		NoMatchesFor:                             "No matches for '%s' %s",
 		ExitSearchMode:                           "%s: Exit search mode",
 		ExitTextFilterMode:                       "%s: Exit filter mode",
-		MatchesFor:                               "matches for '%s' (%d of %d) %s", // lowercase because it's after other text
+		MatchesFor:                               "matches for '%s' (%s) %s", // lowercase because it's after other text
+		MatchXOfY:                                "%d of %d",
+		MatchXOfYAtLine:                          "%d of %d, line %d",
 		SearchKeybindings:                        "%s: Next match, %s: Previous match, %s: Exit search mode",
 		SearchPrefix:                             "Search: ",
+		SearchPrefixWithOptions:                  "Search ({{.options}}): ",
+		SearchOptionRegex:                        "regex",
+		SearchOptionCaseSensitive:                "case-sensitive",
+		SearchOptionIgnoreCase:                   "ignore case",
+		InvalidRegex:                             "Invalid regular expression",
+		ToggleSearchRegex:                        "Toggle regex search",
+		ToggleSearchCaseSensitivity:              "Cycle case sensitivity (smart case, case-sensitive, ignore case)",
+		OnlyAvailableWhenSearching:               "Only available when searching, not when filtering",
 		FilterPrefix:                             "Filter: ",
 		FilterPrefixMenu:                         "Filter (prepend '@' to filter keybindings): ",
 		WorktreesTitle:                           "Worktrees",
@@ -2032,6 +2900,7 @@ func EnglishTranslationSet() *TranslationSet {
 		RangeSelectUp:                            "Range select up",
 		RangeSelectDown:                          "Range select down",
 		RangeSelectNotSupported:                  "Action does not support range selection, please select a single item",
+		MarkedItemsNotContiguous:                 "Action only supports a contiguous range of items, but the marked items are not contiguous",
 		NoItemSelected:                           "No item selected",
 		SelectedItemIsNotABranch:                 "Selected item is not a branch",
 		SelectedItemDoesNotHaveFiles:             "Selected item does not have files to view",
@@ -2040,6 +2909,9 @@ func EnglishTranslationSet() *TranslationSet {
 		CustomCommands:                           "Custom commands",
 		NoApplicableCommandsInThisContext:        "(No applicable commands in this context)",
 		SelectCommitsOfCurrentBranch:             "Select commits of current branch",
+		CollapsePushedCommits:                    "Collapse/expand pushed commits",
+		CollapsePushedCommitsTooltip:             "Hide the commits that have already been pushed, so that only the ones that are safe to rewrite are shown.",
+		NoPushedCommits:                          "There are no pushed commits.",
 		ViewMergeConflictOptions:                 "View merge conflict options",
 		ViewMergeConflictOptionsTooltip:          "View options for resolving merge conflicts.",
 		NoFilesWithMergeConflicts:                "There are no files with merge conflicts.",
@@ -2074,9 +2946,16 @@ func EnglishTranslationSet() *TranslationSet {
 			AmendCommit:                      "Amend commit",
 			ResetCommitAuthor:                "Reset commit author",
 			SetCommitAuthor:                  "Set commit author",
+			SetCommitAuthorDate:              "Set commit author date",
 			AddCommitCoAuthor:                "Add commit co-author",
 			RevertCommit:                     "Revert commit",
+			ExportPatches:                    "Export patches",
+			SendPatches:                      "Send patches with git send-email",
+			ApplyPatches:                     "Apply patches",
+			ApplyPatchFile:                   "Apply patch file",
+			CreateArchive:                    "Create archive",
 			CreateFixupCommit:                "Create fixup commit",
+			AbsorbStagedChanges:              "Absorb staged changes",
 			SquashAllAboveFixupCommits:       "Squash all above fixup commits",
 			CreateLightweightTag:             "Create lightweight tag",
 			CreateAnnotatedTag:               "Create annotated tag",
@@ -2095,6 +2974,7 @@ func EnglishTranslationSet() *TranslationSet {
 			CustomCommand:                    "Custom command",
 			DiscardAllChangesInFile:          "Discard all changes in selected file(s)",
 			DiscardAllUnstagedChangesInFile:  "Discard all unstaged changes selected file(s)",
+			DiscardFileHunk:                  "Discard hunk of file",
 			StageFile:                        "Stage file",
 			StageResolvedFiles:               "Stage files whose merge conflicts were resolved",
 			UnstageFile:                      "Unstage file",
@@ -2119,6 +2999,8 @@ func EnglishTranslationSet() *TranslationSet {
 			StashStagedChanges:               "Stash staged changes",
 			StashUnstagedChanges:             "Stash unstaged changes",
 			StashIncludeUntrackedChanges:     "Stash all changes including untracked files",
+			StashSelectedFiles:               "Stash selected files",
+			StashFileHunks:                   "Stash hunks of selected files",
 			GitFlowFinish:                    "git flow finish",
 			GitFlowStart:                     "git flow start",
 			CopyToClipboard:                  "Copy to clipboard",
@@ -2167,11 +3049,27 @@ func EnglishTranslationSet() *TranslationSet {
 			OpenMergeTool:                    "Open merge tool",
 			OpenCommitInBrowser:              "Open commit in browser",
 			OpenPullRequest:                  "Open pull request in browser",
+			OpenFailingCheck:                 "Open failing CI check in browser",
+			LfsLockFile:                      "Lock file (LFS)",
+			LfsUnlockFile:                    "Unlock file (LFS)",
+			RunMaintenance:                   "Run maintenance",
+			GarbageCollect:                   "Garbage collect",
+			Repack:                           "Repack",
+			StartScheduledMaintenance:        "Register for scheduled maintenance",
+			StopScheduledMaintenance:         "Unregister from scheduled maintenance",
 			StartBisect:                      "Start bisect",
 			ResetBisect:                      "Reset bisect",
 			BisectSkip:                       "Bisect skip",
 			BisectMark:                       "Bisect mark",
 			AddWorktree:                      "Add worktree",
+			RunHook:                          "Run hook",
+			WriteCommitGraph:                 "Write commit-graph",
+			EnableHook:                       "Enable hook",
+			DisableHook:                      "Disable hook",
+			AddToSparseCheckout:              "Add to sparse checkout",
+			RemoveFromSparseCheckout:         "Remove from sparse checkout",
+			ToggleSparseCheckoutConeMode:     "Toggle sparse-checkout cone mode",
+			DisableSparseCheckout:            "Disable sparse checkout",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
@@ -2189,6 +3087,10 @@ func EnglishTranslationSet() *TranslationSet {
 			CompletePrompt:              "Bisect complete! The following commit introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			CompletePromptIndeterminate: "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			Bisecting:                   "Bisecting",
+			ViewLog:                     "View bisect log",
+			ViewLogTooltip:              "Show the commits that were marked so far in this bisect session, in the order they were marked. Pick one to select it in the commits panel.",
+			LogTitle:                    "Bisect log",
+			LogCommitNotInList:          "Commit %s is not in the list. It may not have been loaded yet, or be hidden by a filter.",
 		},
 		Log: Log{
 			EditRebase:               "Beginning interactive rebase at '{{.ref}}'",
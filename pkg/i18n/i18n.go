@@ -16,43 +16,80 @@ import (
 )
 
 func NewTranslationSetFromConfig(log *logrus.Entry, configLanguage string) (*TranslationSet, error) {
-	languageCodes, err := getSupportedLanguageCodes()
+	languages, err := resolveLanguages(configLanguage)
 	if err != nil {
 		return nil, err
 	}
 
-	if configLanguage == "auto" {
-		language := detectLanguage(jibber_jabber.DetectIETF)
-		for _, languageCode := range languageCodes {
-			if strings.HasPrefix(language, languageCode) {
-				return newTranslationSet(log, languageCode)
-			}
-		}
+	return newTranslationSet(log, languages...)
+}
+
+// Returns the translations to use for the given language (as in the
+// gui.language config), most specific first, e.g. [pt-BR pt] for pt-BR if we
+// had translations for both. Texts missing in one of them fall back to the
+// next one, and to English in the end. An empty result means English.
+func resolveLanguages(configLanguage string) ([]string, error) {
+	languageCodes, err := SupportedLanguages()
+	if err != nil {
+		return nil, err
+	}
 
+	if configLanguage == "auto" {
 		// Detecting a language that we don't have a translation for is not an
 		// error, we'll just use English.
-		return EnglishTranslationSet(), nil
+		return fallbackChain(detectLanguage(jibber_jabber.DetectIETF), languageCodes), nil
 	}
 
-	if configLanguage == "en" {
-		return EnglishTranslationSet(), nil
+	languages := fallbackChain(configLanguage, languageCodes)
+	if len(languages) == 0 && !isEnglish(configLanguage) {
+		// Configuring a language that we don't have a translation for *is* an
+		// error, though.
+		return nil, errors.New("Language not found: " + configLanguage)
 	}
 
-	if slices.Contains(languageCodes, configLanguage) {
-		return newTranslationSet(log, configLanguage)
+	return languages, nil
+}
+
+// Returns those of the language and its less specific versions (e.g. zh-Hant-TW,
+// zh-Hant, zh) that we have translations for, leaving out English because it's
+// the base for all of them anyway
+func fallbackChain(language string, languageCodes []string) []string {
+	// Locales from the environment can look like pt_BR.UTF-8 or sr_RS@latin
+	language, _, _ = strings.Cut(language, ".")
+	language, _, _ = strings.Cut(language, "@")
+	language = strings.ReplaceAll(language, "_", "-")
+
+	result := []string{}
+	for language != "" {
+		index := slices.IndexFunc(languageCodes, func(languageCode string) bool {
+			return strings.EqualFold(languageCode, language)
+		})
+		if index != -1 && !isEnglish(languageCodes[index]) {
+			result = append(result, languageCodes[index])
+		}
+
+		lastDash := strings.LastIndex(language, "-")
+		if lastDash == -1 {
+			break
+		}
+		language = language[:lastDash]
 	}
 
-	// Configuring a language that we don't have a translation for *is* an
-	// error, though.
-	return nil, errors.New("Language not found: " + configLanguage)
+	return result
 }
 
-func newTranslationSet(log *logrus.Entry, language string) (*TranslationSet, error) {
-	log.Info("language: " + language)
+func isEnglish(language string) bool {
+	return language == "en" || strings.HasPrefix(language, "en-") || strings.HasPrefix(language, "en_")
+}
+
+// Builds the translation set for the given languages, most specific first
+func newTranslationSet(log *logrus.Entry, languages ...string) (*TranslationSet, error) {
+	languages = lo.Without(languages, "en")
+	log.Info("language: " + strings.Join(append(slices.Clone(languages), "en"), " -> "))
 
 	baseSet := EnglishTranslationSet()
 
-	if language != "en" {
+	for _, language := range slices.Backward(languages) {
 		translationSet, err := readLanguageFile(language)
 		if err != nil {
 			return nil, err
@@ -63,6 +100,10 @@ func newTranslationSet(log *logrus.Entry, language string) (*TranslationSet, err
 		}
 	}
 
+	if len(languages) > 0 {
+		baseSet.language = languages[0]
+	}
+
 	return baseSet, nil
 }
 
@@ -124,3 +165,54 @@ func detectLanguage(langDetector func() (string, error)) string {
 
 	return "C"
 }
+
+// SupportedLanguages returns the codes of all languages that can be used for
+// the gui.language config or chosen from the language menu, including "en"
+func SupportedLanguages() ([]string, error) {
+	languageCodes, err := getSupportedLanguageCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string{"en"}, languageCodes...), nil
+}
+
+var languageNames = map[string]string{
+	"en":    "English",
+	"ja":    "日本語",
+	"ko":    "한국어",
+	"nl":    "Nederlands",
+	"pl":    "Polski",
+	"pt":    "Português",
+	"ru":    "Русский",
+	"zh-CN": "简体中文",
+	"zh-TW": "繁體中文",
+}
+
+// LanguageName returns the name of the language in the language itself, so
+// that users can find their language in the menu whatever the current one is
+func LanguageName(languageCode string) string {
+	if name, ok := languageNames[languageCode]; ok {
+		return name
+	}
+	return languageCode
+}
+
+var rightToLeftLanguageCodes = []string{"ar", "fa", "he", "ur"}
+
+// IsRightToLeftLanguage returns whether the given language (as in the
+// gui.language config, so it can be "auto") is written from right to left
+func IsRightToLeftLanguage(configLanguage string) bool {
+	return isRightToLeftLanguage(configLanguage, jibber_jabber.DetectIETF)
+}
+
+func isRightToLeftLanguage(configLanguage string, langDetector func() (string, error)) bool {
+	language := configLanguage
+	if language == "auto" {
+		language = detectLanguage(langDetector)
+	}
+
+	return lo.ContainsBy(rightToLeftLanguageCodes, func(languageCode string) bool {
+		return language == languageCode || strings.HasPrefix(language, languageCode+"-")
+	})
+}
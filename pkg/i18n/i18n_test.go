@@ -6,6 +6,7 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -92,6 +93,27 @@ func TestNewTranslationSetFromConfig(t *testing.T) {
 			expected:       "zh-CN",
 			expectedErr:    false,
 		},
+		{
+			name:           "configLanguage falls back to a less specific language",
+			configLanguage: "pt-BR",
+			envLanguage:    "en_US",
+			expected:       "pt",
+			expectedErr:    false,
+		},
+		{
+			name:           "configLanguage is a regional variant of English",
+			configLanguage: "en-GB",
+			envLanguage:    "nl_NL",
+			expected:       "en",
+			expectedErr:    false,
+		},
+		{
+			name:           "auto-detection with LANG set to a regional variant with encoding",
+			configLanguage: "auto",
+			envLanguage:    "pt_BR.UTF-8",
+			expected:       "pt",
+			expectedErr:    false,
+		},
 		{
 			name:           "auto-detection with LANG set to an unsupported language",
 			configLanguage: "auto",
@@ -117,3 +139,79 @@ func TestNewTranslationSetFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRightToLeftLanguage(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		configLanguage string
+		detected       string
+		expected       bool
+	}{
+		{name: "left-to-right language", configLanguage: "nl", expected: false},
+		{name: "right-to-left language", configLanguage: "he", expected: true},
+		{name: "right-to-left language with region", configLanguage: "ar-EG", expected: true},
+		{name: "language code that merely starts the same", configLanguage: "ark", expected: false},
+		{name: "auto with right-to-left locale", configLanguage: "auto", detected: "fa-IR", expected: true},
+		{name: "auto with left-to-right locale", configLanguage: "auto", detected: "en-US", expected: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			langDetector := func() (string, error) { return s.detected, nil }
+			assert.Equal(t, s.expected, isRightToLeftLanguage(s.configLanguage, langDetector))
+		})
+	}
+}
+
+func TestSupportedLanguages(t *testing.T) {
+	languages, err := SupportedLanguages()
+	assert.NoError(t, err)
+	assert.Equal(t, "en", languages[0])
+	assert.Contains(t, languages, "zh-TW")
+
+	for _, language := range languages {
+		assert.NotEqual(t, language, LanguageName(language), "missing name for language %s", language)
+	}
+}
+
+func TestFallbackChain(t *testing.T) {
+	languageCodes := []string{"en", "pt", "pt-BR", "zh-CN", "zh-TW"}
+
+	scenarios := []struct {
+		language string
+		expected []string
+	}{
+		{language: "pt-BR", expected: []string{"pt-BR", "pt"}},
+		{language: "pt-PT", expected: []string{"pt"}},
+		{language: "pt_BR.UTF-8", expected: []string{"pt-BR", "pt"}},
+		{language: "zh-cn", expected: []string{"zh-CN"}},
+		{language: "zh", expected: []string{}},
+		{language: "en-US", expected: []string{}},
+		{language: "C", expected: []string{}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.language, func(t *testing.T) {
+			assert.Equal(t, s.expected, fallbackChain(s.language, languageCodes))
+		})
+	}
+}
+
+func TestNewMissingTranslationsReport(t *testing.T) {
+	report, err := NewMissingTranslationsReport("en")
+	assert.NoError(t, err)
+	assert.Empty(t, report.Languages)
+	assert.Empty(t, report.Missing)
+
+	report, err = NewMissingTranslationsReport("pt-BR")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pt"}, report.Languages)
+	missingKeys := lo.Map(report.Missing, func(missing MissingTranslation, _ int) string { return missing.Key })
+	assert.NotContains(t, missingKeys, "FilesTitle")
+	// Texts are reported with the keys they have in the translation files
+	assert.Contains(t, report.Missing, MissingTranslation{Key: "Actions.CreateArchive", English: "Create archive"})
+	assert.Less(t, len(report.Missing), report.TotalCount)
+
+	_, err = NewMissingTranslationsReport("xy")
+	assert.Error(t, err)
+}
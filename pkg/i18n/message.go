@@ -0,0 +1,203 @@
+package i18n
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Texts that depend on a number use the plural syntax of ICU message format,
+// which Crowdin understands, so that translators get to fill in the forms that
+// their language needs. For example:
+//
+//	"{count, plural, one {# commit copied} other {# commits copied}}"
+//
+// The plural argument is looked up in the arguments passed to FormatMessage,
+// and # stands for its value. The categories are those of
+// https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html
+// ("zero", "one", "two", "few", "many", "other"), and "=N" matches the number N
+// exactly. Every plural needs an "other" form.
+//
+// Named placeholders like {{name}} or {{.name}} work like they do everywhere
+// else, both inside and outside of plurals.
+
+// FormatMessage fills in the placeholders of a message and picks the plural
+// forms that fit the given numbers in the language of the translation set. If
+// the message can't be parsed (e.g. because of a broken translation), the
+// placeholders are still filled in but plurals are left as they are.
+func (self *TranslationSet) FormatMessage(message string, arguments map[string]string) string {
+	parser := &messageParser{
+		message:   message,
+		arguments: arguments,
+		language:  self.language,
+	}
+	if result, ok := parser.parse(-1, false); ok {
+		return result
+	}
+
+	return utils.ResolvePlaceholderString(message, arguments)
+}
+
+type messageParser struct {
+	message   string
+	pos       int
+	arguments map[string]string
+	language  string
+}
+
+// Parses text up to the end of the message, or up to the closing brace of the
+// plural form that we're in (if inPlural is true). count is the value that #
+// stands for, or -1 outside of plurals.
+func (self *messageParser) parse(count int, inPlural bool) (string, bool) {
+	var result strings.Builder
+	for self.pos < len(self.message) {
+		rest := self.message[self.pos:]
+		switch {
+		case strings.HasPrefix(rest, "{{"):
+			end := strings.Index(rest, "}}")
+			if end == -1 {
+				return "", false
+			}
+			placeholder := rest[:end+2]
+			result.WriteString(utils.ResolvePlaceholderString(placeholder, self.arguments))
+			self.pos += end + 2
+		case rest[0] == '{':
+			plural, ok := self.parsePlural()
+			if !ok {
+				return "", false
+			}
+			result.WriteString(plural)
+		case rest[0] == '}':
+			if !inPlural {
+				return "", false
+			}
+			return result.String(), true
+		case rest[0] == '#' && count != -1:
+			result.WriteString(strconv.Itoa(count))
+			self.pos++
+		default:
+			result.WriteByte(rest[0])
+			self.pos++
+		}
+	}
+
+	if inPlural {
+		return "", false
+	}
+	return result.String(), true
+}
+
+// Parses "{name, plural, category {form} ...}" and returns the form that fits
+func (self *messageParser) parsePlural() (string, bool) {
+	self.pos++ // skip the opening brace
+	header, ok := self.readUntil('{')
+	if !ok {
+		return "", false
+	}
+	// e.g. "count, plural, one "
+	parts := strings.SplitN(header, ",", 3)
+	if len(parts) != 3 || strings.TrimSpace(parts[1]) != "plural" {
+		return "", false
+	}
+
+	count, err := strconv.Atoi(self.arguments[strings.TrimSpace(parts[0])])
+	if err != nil {
+		return "", false
+	}
+
+	forms := map[string]string{}
+	category := strings.TrimSpace(parts[2])
+	for {
+		if category == "" {
+			return "", false
+		}
+		self.pos++ // skip the opening brace of the form
+		form, ok := self.parse(count, true)
+		if !ok {
+			return "", false
+		}
+		self.pos++ // skip the closing brace of the form
+		forms[category] = form
+
+		next, ok := self.readUntil('{', '}')
+		if !ok {
+			return "", false
+		}
+		if self.message[self.pos] == '}' {
+			if strings.TrimSpace(next) != "" {
+				return "", false
+			}
+			self.pos++
+			break
+		}
+		category = strings.TrimSpace(next)
+	}
+
+	if form, ok := forms["="+strconv.Itoa(count)]; ok {
+		return form, true
+	}
+	if form, ok := forms[pluralCategory(self.language, count)]; ok {
+		return form, true
+	}
+	form, ok := forms["other"]
+	return form, ok
+}
+
+// Returns the text up to the first of the given delimiters, and leaves the
+// position at the delimiter
+func (self *messageParser) readUntil(delimiters ...byte) (string, bool) {
+	start := self.pos
+	for ; self.pos < len(self.message); self.pos++ {
+		if slices.Contains(delimiters, self.message[self.pos]) {
+			return self.message[start:self.pos], true
+		}
+	}
+	return "", false
+}
+
+// Returns the CLDR plural category of the given number in the given language.
+// We only need the rules for whole numbers, and only for the languages that we
+// have translations for; everything else uses the English rules.
+func pluralCategory(language string, n int) string {
+	if n < 0 {
+		n = -n
+	}
+
+	switch language {
+	case "ja", "ko", "zh-CN", "zh-TW":
+		return "other"
+	case "pt":
+		if n == 0 || n == 1 {
+			return "one"
+		}
+		return "other"
+	case "pl":
+		if n == 1 {
+			return "one"
+		}
+		if isSlavicFew(n) {
+			return "few"
+		}
+		return "many"
+	case "ru":
+		if n%10 == 1 && n%100 != 11 {
+			return "one"
+		}
+		if isSlavicFew(n) {
+			return "few"
+		}
+		return "many"
+	default:
+		if n == 1 {
+			return "one"
+		}
+		return "other"
+	}
+}
+
+// 2-4, 22-24, 32-34, ... but not 12-14
+func isSlavicFew(n int) bool {
+	return n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14)
+}
@@ -0,0 +1,126 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMessage(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		language  string
+		message   string
+		arguments map[string]string
+		expected  string
+	}{
+		{
+			name:      "placeholders only",
+			message:   "Create archive of {{name}} in {{.dir}}",
+			arguments: map[string]string{"name": "master", "dir": "out"},
+			expected:  "Create archive of master in out",
+		},
+		{
+			name:      "english singular",
+			message:   "{count, plural, one {# commit copied} other {# commits copied}}",
+			arguments: map[string]string{"count": "1"},
+			expected:  "1 commit copied",
+		},
+		{
+			name:      "english plural",
+			message:   "{count, plural, one {# commit copied} other {# commits copied}}",
+			arguments: map[string]string{"count": "3"},
+			expected:  "3 commits copied",
+		},
+		{
+			name:      "placeholders inside and around a plural",
+			message:   "Exported {count, plural, one {# file} other {# files}} to {{dir}}",
+			arguments: map[string]string{"count": "2", "dir": "out"},
+			expected:  "Exported 2 files to out",
+		},
+		{
+			name:      "exact match takes precedence",
+			message:   "{count, plural, =0 {nothing copied} one {# commit copied} other {# commits copied}}",
+			arguments: map[string]string{"count": "0"},
+			expected:  "nothing copied",
+		},
+		{
+			name:      "polish few",
+			language:  "pl",
+			message:   "{count, plural, one {# commit skopiowany} few {# commity skopiowane} many {# commitów skopiowanych} other {# commita skopiowanego}}",
+			arguments: map[string]string{"count": "23"},
+			expected:  "23 commity skopiowane",
+		},
+		{
+			name:      "polish many",
+			language:  "pl",
+			message:   "{count, plural, one {# commit skopiowany} few {# commity skopiowane} many {# commitów skopiowanych} other {# commita skopiowanego}}",
+			arguments: map[string]string{"count": "12"},
+			expected:  "12 commitów skopiowanych",
+		},
+		{
+			name:      "russian one for 21",
+			language:  "ru",
+			message:   "{count, plural, one {# коммит} few {# коммита} many {# коммитов} other {# коммита}}",
+			arguments: map[string]string{"count": "21"},
+			expected:  "21 коммит",
+		},
+		{
+			name:      "falls back to other if the form is missing",
+			language:  "pl",
+			message:   "{count, plural, one {# commit} other {# commits}}",
+			arguments: map[string]string{"count": "5"},
+			expected:  "5 commits",
+		},
+		{
+			name:      "malformed plural is left alone",
+			message:   "{count, plural, one {# commit} other {# commits}",
+			arguments: map[string]string{"count": "5"},
+			expected:  "{count, plural, one {# commit} other {# commits}",
+		},
+		{
+			name:      "missing plural argument is left alone",
+			message:   "{count, plural, one {# commit} other {# commits}} by {{author}}",
+			arguments: map[string]string{"author": "Jane"},
+			expected:  "{count, plural, one {# commit} other {# commits}} by Jane",
+		},
+		{
+			name:      "hash outside of plurals is kept",
+			message:   "Fixes #{{issue}}",
+			arguments: map[string]string{"issue": "12"},
+			expected:  "Fixes #12",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			tr := &TranslationSet{language: s.language}
+			assert.Equal(t, s.expected, tr.FormatMessage(s.message, s.arguments))
+		})
+	}
+}
+
+func TestPluralCategory(t *testing.T) {
+	scenarios := []struct {
+		language string
+		counts   []int
+		expected string
+	}{
+		{language: "en", counts: []int{1}, expected: "one"},
+		{language: "en", counts: []int{0, 2, 11, 21}, expected: "other"},
+		{language: "ja", counts: []int{0, 1, 2}, expected: "other"},
+		{language: "pt", counts: []int{0, 1}, expected: "one"},
+		{language: "pl", counts: []int{1}, expected: "one"},
+		{language: "pl", counts: []int{2, 3, 4, 22, 104}, expected: "few"},
+		{language: "pl", counts: []int{0, 5, 11, 12, 14, 21, 25, 112}, expected: "many"},
+		{language: "ru", counts: []int{1, 21, 101}, expected: "one"},
+		{language: "ru", counts: []int{2, 24, 53}, expected: "few"},
+		{language: "ru", counts: []int{0, 5, 11, 12, 111}, expected: "many"},
+	}
+
+	for _, s := range scenarios {
+		for _, count := range s.counts {
+			assert.Equal(t, s.expected, pluralCategory(s.language, count), "%s %d", s.language, count)
+		}
+	}
+}
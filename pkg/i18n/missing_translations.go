@@ -0,0 +1,78 @@
+package i18n
+
+import (
+	"reflect"
+	"slices"
+
+	"dario.cat/mergo"
+)
+
+type MissingTranslationsReport struct {
+	// The translations in use, most specific first; empty if English is used
+	Languages []string
+	// The texts that none of them translate, so they are shown in English
+	Missing    []MissingTranslation
+	TotalCount int
+}
+
+type MissingTranslation struct {
+	// The key like in the translation files, e.g. "Actions.CherryPick"
+	Key     string
+	English string
+}
+
+// NewMissingTranslationsReport lists the texts that aren't translated to the
+// given language (as in the gui.language config), so that translators know
+// what's left to do and users know why some texts are in English
+func NewMissingTranslationsReport(configLanguage string) (*MissingTranslationsReport, error) {
+	languages, err := resolveLanguages(configLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MissingTranslationsReport{Languages: languages}
+	if len(languages) == 0 {
+		// Nothing to translate for English
+		return report, nil
+	}
+
+	// Unlike in newTranslationSet we don't start from English, so that
+	// untranslated texts stay empty
+	translated := &TranslationSet{}
+	for _, language := range slices.Backward(languages) {
+		translationSet, err := readLanguageFile(language)
+		if err != nil {
+			return nil, err
+		}
+		if err := mergo.Merge(translated, *translationSet, mergo.WithOverride); err != nil {
+			return nil, err
+		}
+	}
+
+	collectMissingTranslations(reflect.ValueOf(*EnglishTranslationSet()), reflect.ValueOf(*translated), "", report)
+	return report, nil
+}
+
+func collectMissingTranslations(english reflect.Value, translated reflect.Value, prefix string, report *MissingTranslationsReport) {
+	for i := range english.NumField() {
+		field := english.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := prefix + field.Name
+		switch field.Type.Kind() {
+		case reflect.Struct:
+			collectMissingTranslations(english.Field(i), translated.Field(i), key+".", report)
+		case reflect.String:
+			// Texts that are empty in English don't need translating
+			if english.Field(i).String() == "" {
+				continue
+			}
+			report.TotalCount++
+			if translated.Field(i).String() == "" {
+				report.Missing = append(report.Missing, MissingTranslation{Key: key, English: english.Field(i).String()})
+			}
+		}
+	}
+}
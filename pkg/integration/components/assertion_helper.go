@@ -6,6 +6,8 @@ import (
 
 type assertionHelper struct {
 	gui integrationTypes.GuiDriver
+	// used instead of the gui for failing once lazygit has quit
+	failFunc func(message string)
 }
 
 func (self *assertionHelper) matchString(matcher *TextMatcher, context string, getValue func() string) {
@@ -25,5 +27,10 @@ func (self *assertionHelper) assertWithRetries(test func() (bool, string)) {
 }
 
 func (self *assertionHelper) fail(message string) {
+	if self.failFunc != nil {
+		self.failFunc(message)
+		return
+	}
+
 	self.gui.Fail(message)
 }
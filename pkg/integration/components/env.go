@@ -11,6 +11,9 @@ const (
 	SANDBOX_ENV_VAR           = "SANDBOX"
 	TEST_NAME_ENV_VAR         = "TEST_NAME"
 	WAIT_FOR_DEBUGGER_ENV_VAR = "WAIT_FOR_DEBUGGER"
+	// If set, snapshot tests write their snapshots instead of comparing
+	// against them
+	UPDATE_SNAPSHOTS_ENV_VAR = "UPDATE_SNAPSHOTS"
 
 	// These values will be passed to both lazygit and shell commands
 	GIT_CONFIG_GLOBAL_ENV_VAR = "GIT_CONFIG_GLOBAL"
@@ -3,16 +3,29 @@ package components
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
 type FileSystem struct {
 	*assertionHelper
+
+	// the directory that relative paths are relative to, if not the current
+	// one (see NewIntegrationTestArgs.AfterQuit)
+	dir string
+}
+
+func (self *FileSystem) path(path string) string {
+	if self.dir == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(self.dir, path)
 }
 
 // This does _not_ check the files panel, it actually checks the filesystem
 func (self *FileSystem) PathPresent(path string) *FileSystem {
 	self.assertWithRetries(func() (bool, string) {
-		_, err := os.Stat(path)
+		_, err := os.Stat(self.path(path))
 		return err == nil, fmt.Sprintf("Expected path '%s' to exist, but it does not", path)
 	})
 	return self
@@ -21,7 +34,7 @@ func (self *FileSystem) PathPresent(path string) *FileSystem {
 // This does _not_ check the files panel, it actually checks the filesystem
 func (self *FileSystem) PathNotPresent(path string) *FileSystem {
 	self.assertWithRetries(func() (bool, string) {
-		_, err := os.Stat(path)
+		_, err := os.Stat(self.path(path))
 		return os.IsNotExist(err), fmt.Sprintf("Expected path '%s' to not exist, but it does", path)
 	})
 	return self
@@ -30,12 +43,12 @@ func (self *FileSystem) PathNotPresent(path string) *FileSystem {
 // Asserts that the file at the given path has the given content
 func (self *FileSystem) FileContent(path string, matcher *TextMatcher) *FileSystem {
 	self.assertWithRetries(func() (bool, string) {
-		_, err := os.Stat(path)
+		_, err := os.Stat(self.path(path))
 		if os.IsNotExist(err) {
 			return false, fmt.Sprintf("Expected path '%s' to exist, but it does not", path)
 		}
 
-		output, err := os.ReadFile(path)
+		output, err := os.ReadFile(self.path(path))
 		if err != nil {
 			return false, fmt.Sprintf("Expected error when reading file content at path '%s': %s", path, err.Error())
 		}
@@ -109,7 +109,13 @@ func runTest(
 		}
 	}
 
-	return err
+	// In sandbox mode, the user did whatever they wanted instead of running
+	// the test
+	if err != nil || args.Sandbox {
+		return err
+	}
+
+	return test.runAfterQuit(workingDir)
 }
 
 func prepareTestDir(
@@ -241,6 +247,9 @@ func getLazygitCommand(
 	if args.WaitForDebugger {
 		cmdObj.AddEnvVars(fmt.Sprintf("%s=true", WAIT_FOR_DEBUGGER_ENV_VAR))
 	}
+	if os.Getenv(UPDATE_SNAPSHOTS_ENV_VAR) != "" {
+		cmdObj.AddEnvVars(fmt.Sprintf("%s=true", UPDATE_SNAPSHOTS_ENV_VAR))
+	}
 	// Set a race detector log path only to avoid spamming the terminal with the
 	// logs. We are not showing this anywhere yet.
 	cmdObj.AddEnvVars(fmt.Sprintf("GORACE=log_path=%s", raceDetectorLogsPath()))
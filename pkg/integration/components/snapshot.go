@@ -0,0 +1,110 @@
+package components
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Snapshots are golden files with the whole screen as text, so that tests can
+// check layout and presentation rather than individual lines. They live next
+// to the test, e.g. the snapshot "menu" of the test ui/layout is in
+// pkg/integration/tests/ui/snapshots/layout/menu.txt.
+//
+// Colors and other styling are not part of a snapshot. Commit hashes are
+// masked, because they change from run to run with the commit dates. The
+// bottom line of the screen is left out: it shows the keybindings of the
+// focused view and the version of lazygit, so every test's snapshots would
+// change whenever a keybinding is added, and tests that care about it can
+// check the Options or Information view instead.
+//
+// Run the tests with UPDATE_SNAPSHOTS=true to write the snapshots instead of
+// comparing against them, and review the changes to the files before
+// committing them.
+
+// Commit hashes are shown with at least 7 digits
+var hashRegexp = regexp.MustCompile(`\b[0-9a-f]{7,40}\b`)
+
+func (self *TestDriver) ExpectSnapshot(name string) *TestDriver {
+	if !self.test.RequiresHeadless() {
+		self.fail("Snapshot tests need to set Width and Height, so that the screen has the same size wherever they run")
+	}
+
+	actual := normalizeSnapshot(withoutBottomLine(self.gui.Snapshot()))
+	path := self.snapshotPath(name)
+
+	if os.Getenv(UPDATE_SNAPSHOTS_ENV_VAR) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			self.fail(err.Error())
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o644); err != nil {
+			self.fail(err.Error())
+		}
+		return self
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		self.fail(fmt.Sprintf("Could not read snapshot '%s': %v\nRun the test with %s=true to create it.", name, err, UPDATE_SNAPSHOTS_ENV_VAR))
+	}
+
+	if string(expected) != actual {
+		self.fail(fmt.Sprintf("Screen does not match snapshot '%s' (%s):\n%s\nIf the change is intended, run the test with %s=true to update the snapshot.",
+			name, path, snapshotDiff(string(expected), actual), UPDATE_SNAPSHOTS_ENV_VAR))
+	}
+
+	return self
+}
+
+func (self *TestDriver) snapshotPath(name string) string {
+	testDir, testFile := filepath.Split(self.test.Name())
+	return filepath.Join(os.Getenv(LAZYGIT_ROOT_DIR), "pkg", "integration", "tests", testDir, "snapshots", testFile, name+".txt")
+}
+
+func withoutBottomLine(snapshot string) string {
+	lines := strings.SplitAfter(snapshot, "\n")
+	// The screen ends with a newline, so the last element is empty
+	if len(lines) < 2 {
+		return ""
+	}
+	return strings.Join(lines[:len(lines)-2], "")
+}
+
+// Trailing whitespace depends on how the screen was cleared, and isn't visible
+// anyway, so we leave it out
+func normalizeSnapshot(snapshot string) string {
+	lines := strings.Split(snapshot, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " ")
+		lines[i] = hashRegexp.ReplaceAllStringFunc(line, func(hash string) string {
+			return strings.Repeat("#", len(hash))
+		})
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+// Shows the lines that differ, which is easier to read than the two screens
+func snapshotDiff(expected string, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+
+	builder := &strings.Builder{}
+	for i := range max(len(expectedLines), len(actualLines)) {
+		expectedLine := lineAt(expectedLines, i)
+		actualLine := lineAt(actualLines, i)
+		if expectedLine != actualLine {
+			fmt.Fprintf(builder, "line %d:\n- %s\n+ %s\n", i+1, expectedLine, actualLine)
+		}
+	}
+	return builder.String()
+}
+
+func lineAt(lines []string, index int) string {
+	if index < len(lines) {
+		return lines[index]
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSnapshot(t *testing.T) {
+	snapshot := "┌─Commits─┐   \n│ 1a2b3c4d one │\n│ master   │ \n\n\n"
+	expected := "┌─Commits─┐\n│ ######## one │\n│ master   │\n"
+
+	assert.Equal(t, expected, normalizeSnapshot(snapshot))
+}
+
+func TestWithoutBottomLine(t *testing.T) {
+	snapshot := "┌─Files─┐\n└───────┘\nCommit: c | Stash: s\n"
+
+	assert.Equal(t, "┌─Files─┐\n└───────┘\n", withoutBottomLine(snapshot))
+}
+
+func TestSnapshotDiff(t *testing.T) {
+	expected := "one\ntwo\nthree\n"
+	actual := "one\n2\nthree\nfour\n"
+
+	assert.Equal(t, "line 2:\n- two\n+ 2\nline 4:\n- \n+ four\n", snapshotDiff(expected, actual))
+	assert.Equal(t, "", snapshotDiff(expected, expected))
+}
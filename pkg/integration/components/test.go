@@ -1,6 +1,7 @@
 package components
 
 import (
+	"errors"
 	"os"
 	"strconv"
 	"strings"
@@ -35,6 +36,7 @@ type IntegrationTest struct {
 		testDriver *TestDriver,
 		keys config.KeybindingConfig,
 	)
+	afterQuit  func(fs *FileSystem)
 	gitVersion GitVersionRestriction
 	width      int
 	height     int
@@ -52,6 +54,11 @@ type NewIntegrationTestArgs struct {
 	SetupConfig func(config *config.AppConfig)
 	// runs the test
 	Run func(t *TestDriver, keys config.KeybindingConfig)
+	// runs after lazygit has quit (which it does when Run returns), to check
+	// things that it only does on exit, e.g. writing a file. Only the file
+	// system can be checked at that point; relative paths are relative to the
+	// directory that lazygit ran in.
+	AfterQuit func(fs *FileSystem)
 	// additional args passed to lazygit
 	ExtraCmdArgs []string
 	ExtraEnvVars map[string]string
@@ -61,7 +68,8 @@ type NewIntegrationTestArgs struct {
 	GitVersion GitVersionRestriction
 	// width and height when running in headless mode, for testing
 	// the UI in different sizes.
-	// If these are set, the test must be run in headless mode
+	// If these are set, the test must be run in headless mode.
+	// Tests that use ExpectSnapshot need to set these.
 	Width  int
 	Height int
 	// If true, this is not a test but a demo to be added to our docs
@@ -133,6 +141,7 @@ func NewIntegrationTest(args NewIntegrationTestArgs) *IntegrationTest {
 		setupRepo:    args.SetupRepo,
 		setupConfig:  args.SetupConfig,
 		run:          args.Run,
+		afterQuit:    args.AfterQuit,
 		gitVersion:   args.GitVersion,
 		width:        args.Width,
 		height:       args.Height,
@@ -190,7 +199,7 @@ func (self *IntegrationTest) Run(gui integrationTypes.GuiDriver) {
 		func(errorMsg string) { gui.Fail(errorMsg) },
 	)
 	keys := gui.Keys()
-	testDriver := NewTestDriver(gui, shell, keys, InputDelay())
+	testDriver := NewTestDriver(self, gui, shell, keys, InputDelay())
 
 	if InputDelay() > 0 {
 		// Setting caption to clear the options menu from whatever it starts with
@@ -211,6 +220,26 @@ func (self *IntegrationTest) Run(gui integrationTypes.GuiDriver) {
 	}
 }
 
+// Runs the AfterQuit checks of the test, if any, for lazygit having run in the
+// given directory, and returns the first failure
+func (self *IntegrationTest) runAfterQuit(dir string) error {
+	if self.afterQuit == nil {
+		return nil
+	}
+
+	var failure error
+	fs := &FileSystem{
+		assertionHelper: &assertionHelper{failFunc: func(message string) {
+			if failure == nil {
+				failure = errors.New(message)
+			}
+		}},
+		dir: dir,
+	}
+	self.afterQuit(fs)
+	return failure
+}
+
 func (self *IntegrationTest) HeadlessDimensions() (int, int) {
 	if self.width == 0 && self.height == 0 {
 		return defaultWidth, defaultHeight
@@ -10,6 +10,7 @@ import (
 )
 
 type TestDriver struct {
+	test       *IntegrationTest
 	gui        integrationTypes.GuiDriver
 	keys       config.KeybindingConfig
 	inputDelay int
@@ -17,8 +18,9 @@ type TestDriver struct {
 	shell *Shell
 }
 
-func NewTestDriver(gui integrationTypes.GuiDriver, shell *Shell, keys config.KeybindingConfig, inputDelay int) *TestDriver {
+func NewTestDriver(test *IntegrationTest, gui integrationTypes.GuiDriver, shell *Shell, keys config.KeybindingConfig, inputDelay int) *TestDriver {
 	return &TestDriver{
+		test:            test,
 		gui:             gui,
 		keys:            keys,
 		inputDelay:      inputDelay,
@@ -49,6 +51,12 @@ func (self *TestDriver) click(x, y int) {
 	self.Wait(self.inputDelay)
 }
 
+func (self *TestDriver) drag(fromX, fromY, toX, toY int) {
+	self.SetCaption(fmt.Sprintf("Dragging from %d, %d to %d, %d", fromX, fromY, toX, toY))
+	self.gui.Drag(fromX, fromY, toX, toY)
+	self.Wait(self.inputDelay)
+}
+
 // Should only be used in specific cases where you're doing something weird!
 // E.g. invoking a global keybinding from within a popup.
 // You probably shouldn't use this function, and should instead go through a view like t.Views().Commit().Focus().Press(...)
@@ -34,6 +34,9 @@ func (self *fakeGuiDriver) Click(x, y int) {
 	self.clickedCoordinates = append(self.clickedCoordinates, coordinate{x: x, y: y})
 }
 
+func (self *fakeGuiDriver) Drag(fromX, fromY, toX, toY int) {
+}
+
 func (self *fakeGuiDriver) Keys() config.KeybindingConfig {
 	return config.KeybindingConfig{}
 }
@@ -72,6 +75,10 @@ func (self *fakeGuiDriver) View(viewName string) *gocui.View {
 	return nil
 }
 
+func (self *fakeGuiDriver) Snapshot() string {
+	return ""
+}
+
 func (self *fakeGuiDriver) SetCaption(string) {
 }
 
@@ -86,6 +93,10 @@ func (self *fakeGuiDriver) CheckAllToastsAcknowledged() {}
 
 func (self *fakeGuiDriver) Headless() bool { return false }
 
+func (self *fakeGuiDriver) SideWindows() []string {
+	return []string{"status", "files", "branches", "commits", "stash"}
+}
+
 func TestManualFailure(t *testing.T) {
 	test := NewIntegrationTest(NewIntegrationTestArgs{
 		Description: unitTestDescription,
@@ -358,9 +358,14 @@ func (self *ViewDriver) Focus() *ViewDriver {
 		{name: "stash", viewNames: []string{"stash"}},
 	}
 
-	for windowIndex, window := range windows {
+	for _, window := range windows {
 		if lo.Contains(window.viewNames, viewName) {
 			tabIndex := lo.IndexOf(window.viewNames, viewName)
+			// the jump keys are assigned to the visible side windows from top to bottom
+			windowIndex := lo.IndexOf(self.t.gui.SideWindows(), window.name)
+			if windowIndex == -1 {
+				self.t.fail(fmt.Sprintf("Cannot focus view %s: its window is hidden", viewName))
+			}
 			// jump to the desired window
 			self.t.press(self.t.keys.Universal.JumpToBlock[windowIndex])
 
@@ -439,6 +444,16 @@ func (self *ViewDriver) Click(x, y int) *ViewDriver {
 	return self
 }
 
+// Like Click, the coordinates are relative to the view's content, so x = -1 is
+// the view's left border
+func (self *ViewDriver) Drag(fromX, fromY, toX, toY int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.drag(offsetX+1+fromX, offsetY+1+fromY, offsetX+1+toX, offsetY+1+toY)
+
+	return self
+}
+
 // i.e. pressing down arrow
 func (self *ViewDriver) SelectNextItem() *ViewDriver {
 	return self.PressFast(self.t.keys.Universal.NextItem)
@@ -42,6 +42,10 @@ func (self *Views) MergeConflicts() *ViewDriver {
 	return self.regularView("mergeConflicts")
 }
 
+func (self *Views) Blame() *ViewDriver {
+	return self.regularView("blame")
+}
+
 func (self *Views) Commits() *ViewDriver {
 	return self.regularView("commits")
 }
@@ -98,6 +102,10 @@ func (self *Views) CommitFiles() *ViewDriver {
 	return self.regularView("commitFiles")
 }
 
+func (self *Views) FileHistory() *ViewDriver {
+	return self.regularView("fileHistory")
+}
+
 func (self *Views) Stash() *ViewDriver {
 	return self.regularView("stash")
 }
@@ -148,6 +156,10 @@ func (self *Views) Search() *ViewDriver {
 	return self.regularView("search")
 }
 
+func (self *Views) SearchPrefix() *ViewDriver {
+	return self.regularView("searchPrefix")
+}
+
 func (self *Views) Tooltip() *ViewDriver {
 	return self.regularView("tooltip")
 }
@@ -0,0 +1,55 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewLog = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark commits during a bisect, view the bisect log, and jump to one of the marked commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(10)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Log.ShowGraph = "never"
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 10")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as bad`)).Confirm()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as good`)).Confirm()
+				t.Views().Information().Content(Contains("Bisecting"))
+			}).
+			SelectedLine(Contains("CI commit 05").Contains("<-- current")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("Skip current commit")).Confirm()
+			}).
+			SelectedLine(Contains("CI commit 06").Contains("<-- current")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(Contains("View bisect log")).Confirm()
+
+				t.ExpectPopup().Menu().Title(Equals("Bisect log")).
+					Lines(
+						Contains("bad").Contains("commit 10").IsSelected(),
+						Contains("good").Contains("commit 01"),
+						Contains("skip").Contains("commit 05"),
+						Contains("Cancel"),
+					).
+					Select(Contains("commit 01")).
+					Confirm()
+			}).
+			SelectedLine(Contains("CI commit 01").Contains("<-- good"))
+	},
+})
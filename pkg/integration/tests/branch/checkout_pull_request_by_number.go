@@ -0,0 +1,46 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CheckoutPullRequestByNumber = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out a pull request by entering its number in the checkout-by-name prompt",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoRemote("origin")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("pull request commit")
+		// This is where GitHub keeps the head of pull request #5
+		shell.RunCommand([]string{"git", "push", "origin", "feature:refs/pull/5/head"})
+		shell.Checkout("master")
+		shell.RunCommand([]string{"git", "branch", "-D", "feature"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			Press(keys.Branches.CheckoutBranchByName).
+			Tap(func() {
+				t.ExpectPopup().Prompt().Title(Equals("Branch name:")).Type("#5").Confirm()
+			}).
+			Lines(
+				Contains("pr/5").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Git().CurrentBranchName("pr/5")
+
+		t.Views().Commits().
+			Lines(
+				Contains("pull request commit"),
+				Contains("first commit"),
+			)
+	},
+})
@@ -0,0 +1,34 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CheckoutWithBranchPicker = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started with `lazygit branch-picker`, lazygit shows a menu of the other branches and quits after checking out the chosen one",
+	ExtraCmdArgs: []string{"branch-picker"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("first-branch")
+		shell.NewBranch("second-branch")
+		shell.NewBranch("current-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// Checking out the branch makes lazygit quit, so we can't check
+		// anything after that; lazygit's exit code tells the test that the
+		// checkout succeeded
+		t.ExpectPopup().Menu().
+			Title(Equals("Checkout branch")).
+			Lines(
+				Equals("first-branch").IsSelected(),
+				Equals("master"),
+				Equals("second-branch"),
+				Equals("Cancel"),
+			).
+			Select(Equals("second-branch")).
+			Confirm()
+	},
+})
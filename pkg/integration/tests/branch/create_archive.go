@@ -0,0 +1,74 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateArchive = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create archives of a branch and a tag",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content").Commit("one")
+		shell.NewBranch("feature/x")
+		shell.CreateLightweightTag("v1.0", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature/x").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Commits.CreateArchive)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create archive of feature/x")).
+			Select(Contains("zip")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to put the files in inside the archive (leave empty for none):")).
+			InitialText(Equals("repo-feature-x/")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Archive file:")).
+			InitialText(Equals("repo-feature-x.zip")).
+			Confirm()
+
+		t.ExpectToast(Equals("Created archive repo-feature-x.zip"))
+
+		t.FileSystem().PathPresent("repo-feature-x.zip")
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.0").IsSelected(),
+			).
+			Press(keys.Commits.CreateArchive)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create archive of v1.0")).
+			Select(Contains("tar.gz")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directory to put the files in inside the archive (leave empty for none):")).
+			Clear().
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Archive file:")).
+			Clear().
+			Type("../snapshot.tar.gz").
+			Confirm()
+
+		t.ExpectToast(Equals("Created archive ../snapshot.tar.gz"))
+
+		t.FileSystem().PathPresent("../snapshot.tar.gz")
+	},
+})
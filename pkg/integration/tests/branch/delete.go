@@ -154,9 +154,9 @@ var Delete = NewIntegrationTest(NewIntegrationTestArgs{
 			}).
 			Tap(func() {
 				checkRemoteBranches(t, keys, "origin", []string{
-					"branch-five",
+					"branch-five ✓",
 					"branch-four",
-					"branch-six",
+					"branch-six ↓1",
 					"branch-two",
 				})
 			}).
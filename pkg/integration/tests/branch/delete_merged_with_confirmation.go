@@ -0,0 +1,46 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DeleteMergedWithConfirmation = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Deleting a merged branch asks for confirmation when configured to",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Confirmations.DeleteMergedBranch = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("blah").
+			NewBranch("branch-one").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("branch-one"),
+			).
+			NavigateToLine(Contains("branch-one")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete branch 'branch-one'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+				t.ExpectPopup().
+					Confirmation().
+					Title(Equals("Delete branch 'branch-one'?")).
+					Content(Equals("Are you sure you want to delete the branch 'branch-one' from your machine?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master").IsSelected(),
+			)
+	},
+})
@@ -119,8 +119,8 @@ var DeleteMultiple = NewIntegrationTest(NewIntegrationTestArgs{
 			}).
 			Tap(func() {
 				checkRemoteBranches(t, keys, "origin", []string{
-					"branch-01",
-					"branch-02",
+					"branch-01 ↓1",
+					"branch-02 ✓",
 					"branch-06",
 				})
 				checkRemoteBranches(t, keys, "other-remote", []string{
@@ -0,0 +1,63 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DeleteProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Deleting a protected branch asks for confirmation once more",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"release/*"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("release/1.0").
+			NewBranch("release-notes").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("release-notes"),
+				Contains("release/1.0"),
+			).
+			// not protected, so we only get the usual menu
+			NavigateToLine(Contains("release-notes")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete branch 'release-notes'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("release/1.0").IsSelected(),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete branch 'release/1.0'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+
+				t.ExpectPopup().
+					Confirmation().
+					Title(Equals("Protected branch")).
+					Content(Equals("Branch release/1.0 is protected (see git.protectedBranches in your config). Are you sure you want to delete it?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master").IsSelected(),
+			)
+	},
+})
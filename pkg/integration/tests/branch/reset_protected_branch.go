@@ -0,0 +1,61 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ResetProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Hard-resetting a protected branch is refused when protected branches are blocked",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"main"}
+		config.GetUserConfig().Git.ProtectedBranches.Mode = "block"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("main")
+		shell.EmptyCommit("root commit")
+
+		shell.NewBranch("other-branch")
+		shell.EmptyCommit("other-branch commit")
+
+		shell.Checkout("main")
+		shell.EmptyCommit("main commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("main").IsSelected(),
+				Contains("other-branch"),
+			).
+			SelectNextItem().
+			Press(keys.Commits.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Reset to other-branch")).
+			Select(Contains("Hard reset")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Can't hard-reset protected branch main (see git.protectedBranches in your config)")).
+			Confirm()
+
+		// A soft reset is still allowed
+		t.Views().Branches().
+			Press(keys.Commits.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Reset to other-branch")).
+			Select(Contains("Soft reset")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("other-branch commit"),
+				Contains("root commit"),
+			)
+	},
+})
@@ -0,0 +1,48 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowRemoteBranchDivergence = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show how far remote branches have diverged from the local branches tracking them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.RemoteBranchSortOrder = "alphabetical"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("on feature")
+		shell.NewBranch("untracked")
+		shell.Checkout("master")
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+		shell.SetBranchUpstream("feature", "origin/feature")
+
+		shell.HardReset("HEAD^^")
+		shell.EmptyCommit("four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Equals("feature ✓").IsSelected(),
+				Equals("master ↓1↑2"),
+				Equals("untracked"),
+			)
+	},
+})
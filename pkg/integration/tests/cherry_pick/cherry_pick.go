@@ -68,7 +68,7 @@ var CherryPick = NewIntegrationTest(NewIntegrationTestArgs{
 			Tap(func() {
 				t.ExpectPopup().Alert().
 					Title(Equals("Cherry-pick")).
-					Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+					Content(Contains("Are you sure you want to cherry-pick the 2 copied commits onto this branch?")).
 					Confirm()
 			}).
 			Tap(func() {
@@ -97,7 +97,7 @@ var CherryPick = NewIntegrationTest(NewIntegrationTestArgs{
 			Tap(func() {
 				t.ExpectPopup().Alert().
 					Title(Equals("Cherry-pick")).
-					Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+					Content(Contains("Are you sure you want to cherry-pick the 2 copied commits onto this branch?")).
 					Confirm()
 			}).
 			Tap(func() {
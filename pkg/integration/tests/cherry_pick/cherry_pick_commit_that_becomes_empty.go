@@ -56,7 +56,7 @@ var CherryPickCommitThatBecomesEmpty = NewIntegrationTest(NewIntegrationTestArgs
 			Tap(func() {
 				t.ExpectPopup().Alert().
 					Title(Equals("Cherry-pick")).
-					Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+					Content(Contains("Are you sure you want to cherry-pick the 2 copied commits onto this branch?")).
 					Confirm()
 			})
 
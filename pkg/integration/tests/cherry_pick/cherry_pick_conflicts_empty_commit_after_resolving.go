@@ -47,7 +47,7 @@ var CherryPickConflictsEmptyCommitAfterResolving = NewIntegrationTest(NewIntegra
 
 		t.ExpectPopup().Alert().
 			Title(Equals("Cherry-pick")).
-			Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+			Content(Contains("Are you sure you want to cherry-pick the 2 copied commits onto this branch?")).
 			Confirm()
 
 		t.Common().AcknowledgeConflicts()
@@ -70,7 +70,7 @@ var CherryPickDuringRebase = NewIntegrationTest(NewIntegrationTestArgs{
 			Tap(func() {
 				t.ExpectPopup().Alert().
 					Title(Equals("Cherry-pick")).
-					Content(Contains("Are you sure you want to cherry-pick the 1 copied commit(s) onto this branch?")).
+					Content(Contains("Are you sure you want to cherry-pick the copied commit onto this branch?")).
 					Confirm()
 			}).
 			Tap(func() {
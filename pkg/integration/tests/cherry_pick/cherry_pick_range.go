@@ -65,7 +65,7 @@ var CherryPickRange = NewIntegrationTest(NewIntegrationTestArgs{
 			Tap(func() {
 				t.ExpectPopup().Alert().
 					Title(Equals("Cherry-pick")).
-					Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+					Content(Contains("Are you sure you want to cherry-pick the 2 copied commits onto this branch?")).
 					Confirm()
 			}).
 			Tap(func() {
@@ -0,0 +1,185 @@
+package cherry_pick
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CherryPickWithOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cherry pick commits with -x, merge commits with a mainline parent, and commits with --no-commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "recency"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("first-branch").
+			NewBranch("second-branch").
+			CreateFileAndAdd("file1.txt", "content").
+			Commit("one").
+			CreateFileAndAdd("file2.txt", "content").
+			Commit("two").
+			NewBranchFrom("side-b", "master").
+			CreateFileAndAdd("file-b.txt", "content").
+			Commit("b").
+			NewBranchFrom("side-c", "master").
+			CreateFileAndAdd("file-c.txt", "content").
+			Commit("c").
+			NewBranchFrom("merges", "master").
+			RunCommand([]string{"git", "merge", "--no-ff", "-m", "octopus merge", "side-b", "side-c"}).
+			NewBranchFrom("side-d", "master").
+			CreateFileAndAdd("file-d.txt", "content").
+			Commit("d").
+			Checkout("merges").
+			Merge("side-d").
+			Checkout("second-branch").
+			Checkout("first-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			TopLines(
+				Contains("first-branch"),
+				Contains("second-branch"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+				Contains("base"),
+			).
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.CherryPickCopy)
+
+		t.Views().Information().Content(Contains("1 commit copied"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("base").IsSelected(),
+			).
+			Press(keys.Commits.PasteCommitsWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Cherry-pick options")).
+			Select(Contains("Record origin (-x)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Cherry-pick options")).
+			ContainsLines(
+				Contains("[ ] Don't commit (--no-commit)"),
+				Contains("[✓] Record origin (-x)"),
+			).
+			Select(Contains("Cherry-pick the copied commit")).
+			Confirm()
+
+		t.Views().Information().Content(DoesNotContain("commit copied"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("one"),
+				Contains("base").IsSelected(),
+			).
+			SelectPreviousItem()
+
+		t.Views().Main().Content(Contains("(cherry picked from commit"))
+
+		// Both merge commits have a first and second parent, but only the
+		// octopus merge has a third, so that one isn't offered
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("merges")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			TopLines(
+				Contains("Merge branch 'side-d' into merges").IsSelected(),
+				Contains("d"),
+				Contains("octopus merge"),
+			).
+			Press(keys.Commits.CherryPickCopy).
+			NavigateToLine(Contains("octopus merge")).
+			Press(keys.Commits.CherryPickCopy)
+
+		t.Views().Information().Content(Contains("2 commits copied"))
+
+		t.Views().Commits().
+			Focus().
+			Press(keys.Commits.PasteCommitsWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Cherry-pick options")).
+			ContainsLines(
+				Contains("(•) Parent 1"),
+				Contains("( ) Parent 2"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Parent 2")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Cherry-pick options")).
+			ContainsLines(
+				Contains("( ) Parent 1"),
+				Contains("(•) Parent 2"),
+			).
+			Select(Contains("Cherry-pick the 2 copied commits")).
+			Confirm()
+
+		// Relative to their second parents, the octopus merge brings in
+		// file-c.txt, and the other merge file-b.txt and file-c.txt
+		t.Views().Commits().
+			Lines(
+				Contains("Merge branch 'side-d' into merges"),
+				Contains("octopus merge"),
+				Contains("one").IsSelected(),
+				Contains("base"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("second-branch")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.CherryPickCopy)
+
+		t.Views().Commits().
+			Focus().
+			Press(keys.Commits.PasteCommitsWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Cherry-pick options")).
+			Select(Contains("Don't commit (--no-commit)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Cherry-pick options")).
+			Select(Contains("Cherry-pick the copied commit")).
+			Confirm()
+
+		// No new commit was made; the changes are staged instead
+		t.Views().Commits().
+			Lines(
+				Contains("Merge branch 'side-d' into merges"),
+				Contains("octopus merge"),
+				Contains("one").IsSelected(),
+				Contains("base"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Equals("A  file2.txt"),
+			)
+	},
+})
@@ -0,0 +1,61 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AbsorbStagedChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorb the staged hunks of a file into fixup commits for the two commits that they belong to",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch").
+			CreateFileAndAdd("file", "one\ntwo\nthree\n").
+			Commit("add file").
+			UpdateFileAndAdd("file", "one\ntwo\nthree\nfour\nfive\n").
+			Commit("extend file").
+			// the first hunk adds a line, so the second one has to be shifted
+			// when we apply it on top of the first fixup commit
+			UpdateFileAndAdd("file", "one\nTWO\nTWO AND A HALF\nthree\nfour\nFIVE\n").
+			CreateFileAndAdd("new-file", "new\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Absorb staged changes into fixup commits")).
+			Content(
+				MatchesRegexp("Create fixup commits for these commits\\?\n\n" +
+					".*extend file\n" +
+					".*add file\n\n" +
+					"1 hunk doesn't belong to any single commit of the current branch and will stay staged."),
+			).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("fixup! extend file"),
+				Contains("fixup! add file"),
+				Contains("extend file"),
+				Contains("add file"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Equals("A  new-file"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("fixup! extend file"))
+
+		t.Views().Main().
+			Content(Contains("-five\n+FIVE"))
+
+		t.FileSystem().FileContent("file", Equals("one\nTWO\nTWO AND A HALF\nthree\nfour\nFIVE\n"))
+	},
+})
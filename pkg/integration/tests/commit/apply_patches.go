@@ -0,0 +1,65 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyPatches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a directory of patches with git am, resolving a conflict along the way",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n").Commit("base")
+		shell.CreateFileAndAdd("new-file", "new\n").Commit("add new file")
+		shell.UpdateFileAndAdd("file", "two\n").Commit("change file")
+		shell.RunCommand([]string{"git", "format-patch", "--cover-letter", "-o", "../incoming", "HEAD~2"})
+		shell.RunCommand([]string{"git", "reset", "--hard", "HEAD~2"})
+		shell.UpdateFileAndAdd("file", "three\n").Commit("conflicting change")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("conflicting change").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.ApplyPatches)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Patch file, mbox or directory of patches:")).
+			Type("../incoming").
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Status().Content(Contains("(applying patches)"))
+
+		t.Views().Files().
+			IsFocused().
+			SelectedLine(Contains("file")).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			// picking "two" from the patch
+			SelectNextItem().
+			PressPrimaryAction()
+
+		t.Common().ContinueOnConflictsResolved("am")
+
+		t.Views().Files().IsEmpty()
+
+		t.Views().Commits().
+			Lines(
+				Contains("change file"),
+				Contains("add new file"),
+				Contains("conflicting change"),
+				Contains("base"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("two\n"))
+	},
+})
@@ -0,0 +1,25 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitAsSingleAction = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started with `lazygit commit`, lazygit opens the commit message panel and quits after committing",
+	ExtraCmdArgs: []string{"commit"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// Confirming makes lazygit quit once the commit is made, so we can't
+		// check anything after that; lazygit's exit code tells the test that
+		// the commit succeeded
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Commit summary")).
+			Type("my commit message").
+			Confirm()
+	},
+})
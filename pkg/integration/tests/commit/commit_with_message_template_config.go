@@ -0,0 +1,40 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitWithMessageTemplateConfig = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with the git.commit.messageTemplate config, which takes precedence over git's commit.template",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.Commit.MessageTemplate = "feat: \n\nRefs: "
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile(".git/commit-template", "Summary from template\n")
+		shell.SetConfig("commit.template", ".git/commit-template")
+
+		shell.CreateFile("file.txt", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("feat: ")).
+			SwitchToDescription().
+			Content(Equals("Refs: ")).
+			SwitchToSummary().
+			Type("add something").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("feat: add something"),
+			)
+	},
+})
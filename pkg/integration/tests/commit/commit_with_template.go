@@ -0,0 +1,55 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// Only edits the message when it comes from a template, so that it doesn't
+// prefix it a second time when git runs the hook again when committing
+var prepareCommitMsgHook = `#!/bin/sh
+
+if [ "$2" = "template" ]; then
+	msg=$(cat "$1")
+	printf '[hooked] %s\n' "$msg" > "$1"
+fi
+`
+
+var CommitWithTemplate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with git's commit.template config and a prepare-commit-msg hook",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	// `git hook run` was added in 2.36
+	GitVersion:  AtLeast("2.36.0"),
+	SetupConfig: func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile(".git/commit-template", "Summary from template\n# This line is a comment\n\nDescription from template\n")
+		shell.SetConfig("commit.template", ".git/commit-template")
+		shell.CreateFile(".git/hooks/prepare-commit-msg", prepareCommitMsgHook)
+		shell.MakeExecutable(".git/hooks/prepare-commit-msg")
+
+		shell.CreateFile("file.txt", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("[hooked] Summary from template")).
+			SwitchToDescription().
+			Content(Equals("Description from template")).
+			SwitchToSummary().
+			Type(" and more").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("[hooked] Summary from template and more"),
+			).
+			Focus()
+
+		t.Views().Main().Content(Contains("Description from template"))
+	},
+})
@@ -0,0 +1,83 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ConventionalCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit with a conventional commit message header composed from its type, scope and subject",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("fix(parser): handle empty input")
+		shell.EmptyCommit("docs: update readme")
+		shell.EmptyCommit("feat(api)!: remove the old endpoint")
+		shell.CreateFile("file1", "content")
+		shell.CreateFile("file2", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("file1")).
+			PressPrimaryAction().
+			Press(keys.Files.ConventionalCommit)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit type")).
+			Select(Contains("feat").Contains("A new feature")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Scope (optional)")).
+			SuggestionLines(
+				Equals("api"),
+				Equals("parser"),
+			).
+			Type("ui").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Subject")).
+			Type("add a button").
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("feat(ui): add a button")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("file2")).
+			PressPrimaryAction().
+			Press(keys.Files.ConventionalCommit)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit type")).
+			Select(Contains("chore")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Scope (optional)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Subject")).
+			Type("tidy up").
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("chore: tidy up")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("chore: tidy up"),
+				Contains("feat(ui): add a button"),
+				Contains("feat(api)!: remove the old endpoint"),
+				Contains("docs: update readme"),
+				Contains("fix(parser): handle empty input"),
+			)
+	},
+})
@@ -0,0 +1,82 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExportPatches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export a range of commits as patch files with a cover letter, and the root commit without one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.ExportPatches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Export patches")).
+			Select(Contains("Export patch files")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Output directory:")).
+			Clear().
+			Type("outgoing").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Cover letter subject (leave empty for no cover letter):")).
+			Type("Add some files").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Cover letter text:")).
+			Type("This series adds two files.").
+			Confirm()
+
+		t.ExpectToast(Equals("Exported 3 patch files to outgoing"))
+
+		t.FileSystem().
+			FileContent("outgoing/0000-cover-letter.patch", Contains("Subject: [PATCH 0/2] Add some files").Contains("This series adds two files.")).
+			PathPresent("outgoing/0001-commit-02.patch").
+			PathPresent("outgoing/0002-commit-03.patch").
+			PathNotPresent("outgoing/0003-commit-01.patch")
+
+		t.Views().Commits().
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ExportPatches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Export patches")).
+			Select(Contains("Export patch files")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Output directory:")).
+			Clear().
+			Type("root").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Cover letter subject (leave empty for no cover letter):")).
+			Confirm()
+
+		t.ExpectToast(Equals("Exported 1 patch file to root"))
+
+		t.FileSystem().
+			FileContent("root/0001-commit-01.patch", Contains("Subject: [PATCH] commit 01")).
+			PathNotPresent("root/0000-cover-letter.patch")
+	},
+})
@@ -0,0 +1,83 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GoToCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Select a commit in the commits panel by entering its hash, a tag or a branch name",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(5)
+		shell.CreateLightweightTag("v1.0", "HEAD~3")
+		shell.NewBranchFrom("other-branch", "HEAD~1")
+		shell.EmptyCommit("commit on other branch")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 05").IsSelected(),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, tag or branch):")).
+			Type("v1.0").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("commit 02")).
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, tag or branch):")).
+			Type("HEAD~1").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("commit 04"))
+
+		t.Views().Main().Content(Contains("commit 04"))
+
+		t.Views().Commits().
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, tag or branch):")).
+			Type("nonexistent").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Could not find ref 'nonexistent'")).
+			Confirm()
+
+		t.Views().Commits().
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, tag or branch):")).
+			Type("other-branch").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("'other-branch' is not part of the current branch's history")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("commit 04"))
+	},
+})
@@ -21,9 +21,13 @@ var RevertMerge = NewIntegrationTest(NewIntegrationTestArgs{
 			).
 			Press(keys.Commits.RevertCommit)
 
-		t.ExpectPopup().Confirmation().
-			Title(Equals("Revert commit")).
-			Content(MatchesRegexp(`Are you sure you want to revert \w+?`)).
+		t.ExpectPopup().Menu().
+			Title(Equals("Revert merge commit relative to parent")).
+			Lines(
+				Contains("Parent 1").Contains("first change").IsSelected(),
+				Contains("Parent 2").Contains("second-change-branch unrelated change"),
+				Contains("Cancel"),
+			).
 			Confirm()
 
 		t.Views().Commits().IsFocused().
@@ -0,0 +1,49 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RevertMergesWithDifferentParentCounts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When reverting a range containing a regular merge and an octopus merge, only the parents that both of them have are offered",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("branch-b").
+			CreateFileAndAdd("file-b", "b").
+			Commit("commit b").
+			NewBranchFrom("branch-c", "master").
+			CreateFileAndAdd("file-c", "c").
+			Commit("commit c").
+			Checkout("master").
+			RunCommand([]string{"git", "merge", "--no-ff", "-m", "octopus merge", "branch-b", "branch-c"}).
+			NewBranch("branch-a").
+			CreateFileAndAdd("file-a", "a").
+			Commit("commit a").
+			Checkout("master").
+			Merge("branch-a")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().Focus().
+			TopLines(
+				Contains("Merge branch 'branch-a'").IsSelected(),
+				Contains("commit a"),
+				Contains("octopus merge"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.RevertCommit)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Revert merge commit relative to parent")).
+			Lines(
+				Contains("Parent 1").IsSelected(),
+				Contains("Parent 2"),
+				Contains("Cancel"),
+			)
+	},
+})
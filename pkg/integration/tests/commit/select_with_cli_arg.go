@@ -0,0 +1,29 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectWithCliArg = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open straight to a given commit using the --commit arg",
+	ExtraCmdArgs: []string{"--commit=HEAD~2"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(5)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("commit 05"),
+				Contains("commit 04"),
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Views().Main().Content(Contains("commit 03"))
+	},
+})
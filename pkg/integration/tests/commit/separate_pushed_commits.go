@@ -0,0 +1,54 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SeparatePushedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show section headers between unpushed and pushed commits, and collapse the pushed ones",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.SeparatePushedCommits = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("three")
+		shell.EmptyCommit("four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("--- Unpushed commits (2) ---"),
+				Contains("four").IsSelected(),
+				Contains("three"),
+				Contains("--- Pushed commits ---"),
+				Contains("two"),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.CollapsePushedCommits).
+			Lines(
+				Contains("--- Unpushed commits (2) ---"),
+				Contains("four"),
+				Contains("three").IsSelected(),
+				Contains("--- Pushed commits (collapsed, press - to expand) ---"),
+			).
+			Press(keys.Commits.CollapsePushedCommits).
+			Lines(
+				Contains("--- Unpushed commits (2) ---"),
+				Contains("four"),
+				Contains("three").IsSelected(),
+				Contains("--- Pushed commits ---"),
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
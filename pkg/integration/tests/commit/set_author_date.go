@@ -0,0 +1,49 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SetAuthorDate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Set the author date of a commit that is not the head commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("one", "2024-01-01T12:00:00 +0000")
+		shell.EmptyCommitWithDate("two", "2024-01-02T12:00:00 +0000")
+		shell.EmptyCommitWithDate("three", "2024-01-03T12:00:00 +0000")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("Set author date")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Set author date")).
+					Clear().
+					Type("2020-06-15 08:30:00 +0000").
+					Confirm()
+			}).
+			Lines(
+				Contains("three"),
+				Contains("two").IsSelected(),
+				Contains("one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Date:   Mon Jun 15 08:30:00 2020 +0000"))
+	},
+})
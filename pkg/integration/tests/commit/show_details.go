@@ -0,0 +1,74 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowDetails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the details of a commit in the main view and jump to its parents",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-one", "one\n")
+		shell.Commit("commit one")
+		shell.NewBranch("feature")
+		shell.CreateFileAndAdd("file-two", "two\n")
+		shell.Commit("commit two")
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("file-three", "three\n")
+		shell.Commit("commit three")
+		shell.Merge("feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("Merge branch 'feature'")).
+			Press(keys.Commits.ToggleCommitDetails)
+
+		t.Views().Main().
+			Content(Contains("Parents:")).
+			Content(Contains("Author:     CI <CI@example.com>")).
+			Content(Contains("    Merge branch 'feature'"))
+
+		t.Views().Commits().
+			// A merge commit has two parents, so we get to choose
+			Press(keys.Commits.GoToParentCommit).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Select parent commit")).
+					Lines(
+						Contains("commit three"),
+						Contains("commit two"),
+						Contains("Cancel"),
+					).
+					Select(Contains("commit two")).
+					Confirm()
+			}).
+			IsFocused().
+			SelectedLine(Contains("commit two"))
+
+		t.Views().Main().
+			Content(Contains("    commit two")).
+			Content(Contains("file-two | 1 +")).
+			Content(DoesNotContain("+two"))
+
+		t.Views().Commits().
+			Press(keys.Commits.GoToParentCommit).
+			SelectedLine(Contains("commit one")).
+			Press(keys.Commits.GoToParentCommit).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("The selected commit has no parents")).
+					Confirm()
+			}).
+			// Back to showing the patch
+			Press(keys.Commits.ToggleCommitDetails)
+
+		t.Views().Main().
+			Content(Contains("+one")).
+			Content(DoesNotContain("Parents:"))
+	},
+})
@@ -0,0 +1,55 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ToggleCommitSigning = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Turn off signing for the next commit when commit.gpgSign is on",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// There is no gpg key in the test environment, so if we tried to sign
+		// the commit, committing would fail
+		shell.SetConfig("commit.gpgSign", "true")
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Information().Content(Contains("Signing commits"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ToggleCommitSigning)
+
+		t.ExpectToast(Equals("Next commit won't be signed"))
+		t.Views().Information().Content(Contains("Next commit won't be signed (Reset)"))
+
+		t.Views().Files().
+			Press(keys.Files.ToggleCommitSigning)
+
+		t.ExpectToast(Equals("Next commit will be signed"))
+		t.Views().Information().Content(Contains("Signing commits"))
+
+		t.Views().Files().
+			Press(keys.Files.ToggleCommitSigning)
+
+		t.ExpectToast(Equals("Next commit won't be signed"))
+
+		t.Views().Files().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().Type("my commit message").Confirm()
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Commits().
+			Lines(
+				Contains("my commit message"),
+			)
+
+		t.Views().Information().Content(Contains("Signing commits"))
+	},
+})
@@ -0,0 +1,58 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var VerifySignature = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Verify the signatures of a commit and a tag, and try it for an unsigned commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.RunShellCommand(`ssh-keygen -q -t ed25519 -N "" -C "signing key" -f ../signing_key`)
+		shell.RunShellCommand(`echo "* namespaces=\"git\" $(cat ../signing_key.pub)" > ../allowed_signers`)
+		shell.RunShellCommand(`git config gpg.format ssh && git config user.signingkey "$PWD/../signing_key" && git config gpg.ssh.allowedSignersFile "$PWD/../allowed_signers"`)
+
+		shell.EmptyCommit("unsigned")
+		shell.RunCommand([]string{"git", "commit", "--allow-empty", "-S", "-m", "signed"})
+		shell.RunCommand([]string{"git", "tag", "-s", "v1.0", "-m", "signed tag"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("signed").IsSelected(),
+				Contains("unsigned"),
+			).
+			Press(keys.Commits.VerifySignature)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Good signature")).
+			Content(Contains(`Good "git" signature`).Contains("ED25519 key")).
+			Confirm()
+
+		t.Views().Commits().
+			NavigateToLine(Contains("unsigned")).
+			Press(keys.Commits.VerifySignature)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Signature verification failed")).
+			Content(Equals("No signature found")).
+			Confirm()
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.0").IsSelected(),
+			).
+			Press(keys.Commits.VerifySignature)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Good signature")).
+			Content(Contains(`Good "git" signature`)).
+			Confirm()
+	},
+})
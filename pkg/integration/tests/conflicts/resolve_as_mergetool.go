@@ -0,0 +1,32 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveAsMergetool = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started as git's mergetool, lazygit shows the conflicts of the given file and quits once they are resolved",
+	ExtraCmdArgs: []string{"mergetool", "file1"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFiles(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Information().Content(Contains("Running as git mergetool"))
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("======="),
+			)
+
+		// Picking the hunk resolves the last conflict of the file, which makes
+		// lazygit quit, so we can't check anything after that
+		t.Views().MergeConflicts().PressPrimaryAction()
+	},
+})
@@ -71,7 +71,7 @@ var CherryPick = NewIntegrationTest(NewIntegrationTestArgs{
 				t.Wait(1000)
 				t.ExpectPopup().Alert().
 					Title(Equals("Cherry-pick")).
-					Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+					Content(Contains("Are you sure you want to cherry-pick the 2 copied commits onto this branch?")).
 					Confirm()
 			}).
 			TopLines(
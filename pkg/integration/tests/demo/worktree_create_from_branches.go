@@ -44,13 +44,15 @@ var WorktreeCreateFromBranches = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New worktree path")).
-					Type("../hotfix").
+					Title(Contains("New branch name")).
+					Type("hotfix/db-on-fire").
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Contains("New branch name")).
-					Type("hotfix/db-on-fire").
+					Title(Equals("New worktree path")).
+					InitialText(Equals("../repo-hotfix-db-on-fire")).
+					Clear().
+					Type("../hotfix").
 					Confirm()
 			})
 	},
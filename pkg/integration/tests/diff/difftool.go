@@ -0,0 +1,32 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Difftool = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started as git's difftool, lazygit shows the diff of the two given files",
+	ExtraCmdArgs: []string{"difftool", "left", "right"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("left", "one\ntwo\n")
+		shell.CreateFile("right", "one\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Information().Content(Contains("Running as git difftool"))
+
+		t.Views().Main().
+			IsFocused().
+			Title(Equals("Diff")).
+			Content(
+				Contains(" one").
+					Contains("-two").
+					Contains("+three"),
+			).
+			PressEscape()
+
+		t.Views().Status().IsFocused()
+	},
+})
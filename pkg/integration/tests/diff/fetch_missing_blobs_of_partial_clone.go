@@ -0,0 +1,57 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchMissingBlobsOfPartialClone = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "In a partial clone, show a commit whose file contents are missing without downloading them, then download them in the background and show the commit again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	// GIT_NO_LAZY_FETCH was added in 2.44
+	GitVersion: AtLeast("2.44.0"),
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.FetchMissingBlobs = "background"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "old content\n")
+		shell.Commit("first")
+		shell.UpdateFileAndAdd("file", "new content\n")
+		shell.Commit("second")
+
+		shell.Clone("source")
+		shell.RunShellCommand(`git -C ../source config uploadpack.allowFilter true`)
+		// Only the blobs of the checked out commit are downloaded, so the
+		// old content of the file is missing
+		shell.RunShellCommand(`git clone --filter=blob:none "file://$(cd ../source && pwd)" ../partial`)
+		shell.Chdir("../partial")
+		// Make the remote unavailable so that the missing blobs can't be
+		// downloaded for now
+		shell.RunShellCommand(`mv ../source ../source-unavailable`)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("second").IsSelected(),
+				Contains("first"),
+			).
+			NavigateToLine(Contains("first"))
+
+		t.Views().Main().
+			Content(Contains("lazy fetching disabled")).
+			Content(DoesNotContain("+old content"))
+
+		t.Shell().RunShellCommand(`mv ../source-unavailable ../source`)
+
+		t.Views().Commits().
+			NavigateToLine(Contains("second"))
+
+		// Shown without the missing blobs at first, and again once they have
+		// been downloaded
+		t.Views().Main().
+			Content(Contains("-old content").Contains("+new content")).
+			Content(DoesNotContain("lazy fetching disabled"))
+	},
+})
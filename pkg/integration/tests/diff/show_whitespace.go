@@ -0,0 +1,48 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowWhitespace = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle showing tabs, trailing spaces and carriage returns in the diff",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first-line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first-line\ntab\there\ntrailing  \ncrlf\r\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().ContainsLines(
+			Contains(` first-line`),
+			Equals(`+tab    here`),
+			Equals(`+trailing  `),
+			Equals(`+crlf`),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.ToggleShowWhitespaceInDiffView)
+
+		t.Views().Main().ContainsLines(
+			Contains(` first-line`),
+			Equals(`+tab→   here`),
+			Equals(`+trailing··`),
+			Equals(`+crlf␍`),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.ToggleShowWhitespaceInDiffView)
+
+		t.Views().Main().ContainsLines(
+			Contains(` first-line`),
+			Equals(`+tab    here`),
+			Equals(`+trailing  `),
+			Equals(`+crlf`),
+		)
+	},
+})
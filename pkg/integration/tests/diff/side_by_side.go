@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SideBySide = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle between showing diffs unified and side by side",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first-line\nold-second-line\nthird-line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first-line\nnew-second-line\nthird-line\nfourth-line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().ContainsLines(
+			Contains(` first-line`),
+			Contains(`-old-second-line`),
+			Contains(`+new-second-line`),
+			Contains(` third-line`),
+			Contains(`+fourth-line`),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.ToggleDiffMode)
+
+		t.Views().Main().ContainsLines(
+			Contains(`@@ -1,3 +1,4 @@`),
+			MatchesRegexp(`^1 first-line\s+│1 first-line$`),
+			MatchesRegexp(`^2 old-second-line\s+│2 new-second-line$`),
+			MatchesRegexp(`^3 third-line\s+│3 third-line$`),
+			MatchesRegexp(`^\s+│4 fourth-line$`),
+		)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("initial commit").IsSelected(),
+			)
+
+		t.Views().Main().ContainsLines(
+			Contains(`@@ -0,0 +1,3 @@`),
+			MatchesRegexp(`^\s+│1 first-line$`),
+			MatchesRegexp(`^\s+│2 old-second-line$`),
+			MatchesRegexp(`^\s+│3 third-line$`),
+		)
+
+		t.Views().Commits().
+			Press(keys.Universal.ToggleDiffMode)
+
+		t.Views().Main().ContainsLines(
+			Contains(`+first-line`),
+			Contains(`+old-second-line`),
+			Contains(`+third-line`),
+		)
+	},
+})
@@ -0,0 +1,37 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SideBySideResize = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Render a side-by-side diff again when the main view gets narrower",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.DiffMode = "side-by-side"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first-line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first-line\nthis line is long enough to be wrapped\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().ContainsLines(
+			MatchesRegexp(`^1 first-line\s+│1 first-line$`),
+			MatchesRegexp(`^\s+│2 this line is long enough to be wrapped$`),
+		)
+
+		// Unlike changing the screen mode, this doesn't run the diff command
+		// again
+		t.Views().Main().
+			Drag(-1, 0, 25, 0)
+
+		t.Views().Main().ContainsLines(
+			MatchesRegexp(`^1 first-line\s+│1 first-line$`),
+			MatchesRegexp(`^\s+│2 this line is long enough to be w$`),
+			MatchesRegexp(`^\s+│  rapped$`),
+		)
+	},
+})
@@ -0,0 +1,58 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyPatchFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a patch file to the working tree, resolving the conflict that the three-way merge leaves behind",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n").Commit("base")
+		shell.UpdateFile("file", "two\n")
+		shell.RunShellCommand("git diff > ../fix.patch")
+		shell.RunCommand([]string{"git", "checkout", "file"})
+		shell.UpdateFileAndAdd("file", "three\n").Commit("conflicting change")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			Focus().
+			Press(keys.Files.ApplyPatchFile)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Patch file:")).
+			Type("../fix.patch").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Applied patch to 'file' with conflicts.")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			// picking "two" from the patch
+			SelectNextItem().
+			PressPrimaryAction()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("M  file"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("two\n"))
+	},
+})
@@ -0,0 +1,68 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Blame = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Blame a file and jump from a blamed line to its commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\n")
+		shell.Commit("first commit")
+		shell.SetAuthor("Other Author", "other@example.com")
+		shell.UpdateFileAndAdd("file", "one\ntwo\nthree\n")
+		shell.Commit("second commit")
+		shell.CreateFileAndAdd("other-file", "content")
+		shell.Commit("third commit")
+		shell.UpdateFile("file", "one\ntwo\nthree\nfour\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			Press(keys.Files.Blame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Equals("Blame: file")).
+			Lines(
+				Contains("CI").Contains("1").Contains("one").IsSelected(),
+				Contains("2").Contains("two").DoesNotContain("CI"),
+				Contains("Other Author").Contains("3").Contains("three"),
+				Contains("Not Committed Yet").Contains("4").Contains("four"),
+			).
+			SelectNextItem().
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("third commit"),
+				Contains("second commit").IsSelected(),
+				Contains("first commit"),
+			)
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.Blame)
+
+		t.Views().Blame().
+			IsFocused().
+			NavigateToLine(Contains("four")).
+			Press(keys.Universal.GoInto).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: This line hasn't been committed yet"))
+			}).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
@@ -0,0 +1,56 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiscardFileHunkAfterFileChanged = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discarding a hunk fails without discarding anything if the file changed after the hunks were shown",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file", "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M file").IsSelected(),
+			).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard changes")).
+			Select(Contains("Discard hunk")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard hunk")).
+			Lines(
+				Contains("@@ -1,4 +1,4 @@").Contains("-1").IsSelected(),
+				Contains("@@ -9,4 +9,4 @@").Contains("-12"),
+				Contains("Cancel"),
+			).
+			Select(Contains("@@ -9,4 +9,4 @@"))
+
+		// The shown hunk no longer exists, and the one that's now the second
+		// hunk of the file must not be discarded in its place
+		t.Shell().UpdateFile("file", "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\nTWELVE\n")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard hunk")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("patch does not apply")).
+			Confirm()
+
+		t.FileSystem().FileContent("file", Equals("one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\nTWELVE\n"))
+	},
+})
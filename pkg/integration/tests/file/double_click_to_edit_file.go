@@ -0,0 +1,38 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DoubleClickToEditFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Double-click a file to open it in the editor when configured to do so",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.Mouse.DoubleClickFileAction = "edit"
+		config.GetUserConfig().Gui.ShowFileTree = false
+		config.GetUserConfig().OS.Edit = "echo {{filename}} > ../edit-command"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("file-one", "content\n")
+		shell.CreateFile("file-two", "content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? file-one").IsSelected(),
+				Equals("?? file-two"),
+			).
+			Click(1, 1).
+			Click(1, 1).
+			Lines(
+				// The file is not staged, as it would be with the default action
+				Equals("?? file-one"),
+				Equals("?? file-two").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("../edit-command", Contains("file-two"))
+	},
+})
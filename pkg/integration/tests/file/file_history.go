@@ -0,0 +1,121 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FileHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the history of a file across a rename, diff its versions and check out an old version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("old.txt", "one\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("old.txt", "two\n")
+		shell.Commit("change file")
+		shell.RenameFileInGit("old.txt", "new.txt")
+		shell.Commit("rename file")
+		shell.UpdateFileAndAdd("new.txt", "three\n")
+		shell.Commit("change renamed file")
+		shell.CreateFileAndAdd("unrelated.txt", "content\n")
+		shell.Commit("unrelated change")
+		shell.UpdateFile("new.txt", "four\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("new.txt").IsSelected(),
+			).
+			Press(keys.Files.OpenFileHistory)
+
+		t.Views().FileHistory().
+			IsFocused().
+			Title(Equals("File history: new.txt")).
+			Lines(
+				Contains("change renamed file").DoesNotContain("old.txt").IsSelected(),
+				Contains("rename file").DoesNotContain("old.txt"),
+				Contains("change file").Contains("old.txt"),
+				Contains("add file").Contains("old.txt"),
+			).
+			NavigateToLine(Contains("change file"))
+
+		t.Views().Main().
+			Content(Contains("-one").Contains("+two"))
+
+		t.Views().FileHistory().
+			Press(keys.Universal.RangeSelectUp).
+			Press(keys.Universal.RangeSelectUp)
+
+		t.Views().Main().
+			Content(
+				Contains("Showing diff for range").
+					Contains("-one").
+					Contains("+three"),
+			)
+
+		// We can't check out an old version while the file has local changes
+		t.Views().FileHistory().
+			NavigateToLine(Contains("add file")).
+			Press(keys.CommitFiles.CheckoutCommitFile)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("You have local modifications for the file(s) you are trying to check out")).
+			Confirm()
+
+		t.Views().FileHistory().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard changes")).
+			Select(Contains("Discard all changes")).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty()
+
+		// The history can also be opened from the files of a commit
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("change renamed file")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("new.txt").IsSelected(),
+			).
+			Press(keys.Files.OpenFileHistory)
+
+		t.Views().FileHistory().
+			IsFocused().
+			Lines(
+				Contains("change renamed file").IsSelected(),
+				Contains("rename file"),
+				Contains("change file"),
+				Contains("add file"),
+			).
+			NavigateToLine(Contains("add file")).
+			Press(keys.CommitFiles.CheckoutCommitFile)
+
+		t.FileSystem().FileContent("new.txt", Equals("one\n"))
+
+		t.Views().Files().
+			Lines(
+				Equals("M  new.txt"),
+			)
+
+		t.Views().FileHistory().
+			PressEscape()
+
+		t.Views().CommitFiles().
+			IsFocused()
+	},
+})
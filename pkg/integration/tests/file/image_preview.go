@@ -0,0 +1,63 @@
+package file
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+func pngFileContent(width int, height int) string {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+var ImagePreview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a description of the old and new version of an image instead of a diff",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// Make sure we don't try to draw the images, whatever terminal the
+		// test is run from
+		config.GetUserConfig().Gui.ImagePreviewProtocol = "none"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("logo.png", pngFileContent(2, 2))
+		shell.Commit("add logo")
+		shell.CreateFile("logo.png", pngFileContent(4, 3))
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M logo.png").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Before: PNG, 2x2 pixels,")).
+			Content(Contains("After: PNG, 4x3 pixels,")).
+			Content(DoesNotContain("Binary files"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("add logo").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("A logo.png").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Before: file does not exist")).
+			Content(Contains("After: PNG, 2x2 pixels,"))
+	},
+})
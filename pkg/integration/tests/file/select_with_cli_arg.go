@@ -0,0 +1,31 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectWithCliArg = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open straight to a given file using the --file arg",
+	ExtraCmdArgs: []string{"--file=dir/file2"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("dir/file1", "one")
+		shell.CreateFile("dir/file2", "two")
+		shell.CreateFile("file3", "three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ dir"),
+				Equals("    ?? file1"),
+				Equals("    ?? file2").IsSelected(),
+				Equals("  ?? file3"),
+			)
+
+		t.Views().Main().Content(Contains("two"))
+	},
+})
@@ -0,0 +1,38 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SkipUntrackedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Hide untracked files with git.skipUntrackedFiles, and temporarily show them again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.SkipUntrackedFiles = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("tracked", "foo")
+		shell.Commit("first commit")
+		shell.UpdateFile("tracked", "bar")
+		shell.CreateFile("untracked", "baz")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M tracked").IsSelected(),
+			).
+			Press(keys.Files.ToggleSkipUntrackedFiles).
+			Lines(
+				Equals("▼ /"),
+				Equals("   M tracked").IsSelected(),
+				Equals("  ?? untracked"),
+			).
+			Press(keys.Files.ToggleSkipUntrackedFiles).
+			Lines(
+				Equals(" M tracked").IsSelected(),
+			)
+	},
+})
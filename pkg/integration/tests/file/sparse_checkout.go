@@ -0,0 +1,125 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SparseCheckout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Turn a repo into a sparse checkout, add and remove directories, switch to pattern mode, and disable it again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	// `git sparse-checkout set --cone` and `reapply --cone` were added in 2.35
+	GitVersion:  AtLeast("2.35.0"),
+	SetupConfig: func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("top-level", "top-level\n")
+		shell.CreateFileAndAdd("dir-a/file-a", "file-a\n")
+		shell.CreateFileAndAdd("dir-b/file-b", "file-b\n")
+		shell.CreateFileAndAdd("dir-c/file-c", "file-c\n")
+		shell.Commit("files")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.OpenSparseCheckoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Lines(
+				Contains("a Add directory").IsSelected(),
+				Contains("c Switch to cone mode"),
+				Contains("d Disable sparse checkout"),
+				Contains("Directories"),
+				Contains("This repo is not a sparse checkout"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Add directory")).
+			Type("dir-a").
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("top-level").
+			PathPresent("dir-a/file-a").
+			PathNotPresent("dir-b/file-b").
+			PathNotPresent("dir-c/file-c")
+
+		t.Views().Files().
+			Press(keys.Files.OpenSparseCheckoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Select(Contains("Add directory")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Add directory")).
+			Type("dir-b").
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("dir-a/file-a").
+			PathPresent("dir-b/file-b").
+			PathNotPresent("dir-c/file-c")
+
+		t.Views().Files().
+			Press(keys.Files.OpenSparseCheckoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Lines(
+				Contains("a Add directory").IsSelected(),
+				Contains("c Switch to pattern mode"),
+				Contains("d Disable sparse checkout"),
+				Contains("Directories"),
+				Contains("dir-a"),
+				Contains("dir-b"),
+				Contains("Cancel"),
+			).
+			Select(Contains("dir-a")).
+			Confirm()
+
+		t.FileSystem().
+			PathNotPresent("dir-a/file-a").
+			PathPresent("dir-b/file-b")
+
+		t.Views().Files().
+			Press(keys.Files.OpenSparseCheckoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Select(Contains("Switch to pattern mode")).
+			Confirm()
+
+		t.Views().Files().
+			Press(keys.Files.OpenSparseCheckoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout")).
+			Lines(
+				Contains("a Add pattern").IsSelected(),
+				Contains("c Switch to cone mode"),
+				Contains("d Disable sparse checkout"),
+				Contains("Patterns"),
+				Contains("/*").DoesNotContain("!"),
+				Contains("!/*/"),
+				Contains("/dir-b/"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Disable sparse checkout")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Disable sparse checkout")).
+			Content(Contains("This checks out all files of the repo")).
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("dir-a/file-a").
+			PathPresent("dir-b/file-b").
+			PathPresent("dir-c/file-c")
+	},
+})
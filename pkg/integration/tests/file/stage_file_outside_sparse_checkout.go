@@ -0,0 +1,35 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageFileOutsideSparseCheckout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage a file that the user created outside of the sparse-checkout definition",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	// `git add --sparse` was added in 2.35
+	GitVersion:  AtLeast("2.35.0"),
+	SetupConfig: func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir-a/file-a", "file-a\n")
+		shell.CreateFileAndAdd("dir-b/file-b", "file-b\n")
+		shell.Commit("files")
+		shell.RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "dir-a"})
+		shell.CreateFile("dir-b/new-file", "new-file\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ dir-b").IsSelected(),
+				Equals("  ?? new-file"),
+			).
+			PressPrimaryAction().
+			Lines(
+				Equals("▼ dir-b").IsSelected(),
+				Equals("  A  new-file"),
+			)
+	},
+})
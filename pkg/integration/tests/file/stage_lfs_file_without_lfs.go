@@ -0,0 +1,72 @@
+package file
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageLfsFileWithoutLfs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Staging a large binary file that matches an lfs pattern asks for confirmation when git-lfs isn't set up",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		// Make sure that git-lfs isn't set up, even if it is installed on
+		// the machine
+		shell.SetConfig("filter.lfs.process", "")
+		shell.SetConfig("filter.lfs.clean", "")
+		shell.SetConfig("filter.lfs.required", "false")
+
+		shell.CreateFileAndAdd(".gitattributes", "*.bin filter=lfs diff=lfs merge=lfs -text\n")
+		shell.Commit("track bin files in lfs")
+
+		shell.CreateFile("big.bin", strings.Repeat("\x00", 1024*1024))
+		shell.CreateFile("small.bin", "\x00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ?? big.bin (LFS)"),
+				Equals("  ?? small.bin (LFS)"),
+			).
+			NavigateToLine(Contains("small.bin")).
+			PressPrimaryAction().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? big.bin (LFS)"),
+				Equals("  A  small.bin (LFS)").IsSelected(),
+			).
+			NavigateToLine(Contains("big.bin")).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Git LFS not set up")).
+			Content(Contains("'big.bin' matches a git-lfs pattern in .gitattributes")).
+			Cancel()
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? big.bin (LFS)").IsSelected(),
+				Equals("  A  small.bin (LFS)"),
+			).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Git LFS not set up")).
+			Content(Contains("'big.bin'")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("  A  big.bin (LFS)").IsSelected(),
+				Equals("  A  small.bin (LFS)"),
+			)
+	},
+})
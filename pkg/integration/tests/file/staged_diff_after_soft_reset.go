@@ -0,0 +1,44 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StagedDiffAfterSoftReset = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "The staged diff of a file is shown relative to the new HEAD after a soft reset, even though the index didn't change",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "first\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file", "second\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("file", "third\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("M  file").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("-second").Contains("+third"))
+
+		t.Shell().RunCommand([]string{"git", "reset", "--soft", "HEAD^"})
+
+		t.Views().Files().
+			Press(keys.Universal.Refresh).
+			Lines(
+				Equals("M  file").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("-first").Contains("+third")).
+			Content(DoesNotContain("second"))
+	},
+})
@@ -11,6 +11,7 @@ var FilterMenuWithNoKeybindings = NewIntegrationTest(NewIntegrationTestArgs{
 	Skip:         false,
 	SetupConfig: func(config *config.AppConfig) {
 		config.GetUserConfig().Keybinding.Universal.ToggleWhitespaceInDiffView = "<disabled>"
+		config.GetUserConfig().Keybinding.Universal.ToggleShowWhitespaceInDiffView = "<disabled>"
 	},
 	SetupRepo: func(shell *Shell) {
 	},
@@ -23,10 +24,11 @@ var FilterMenuWithNoKeybindings = NewIntegrationTest(NewIntegrationTestArgs{
 			Title(Equals("Keybindings")).
 			Filter("whitespace").
 			Lines(
-				// menu has filtered down to the one item that matches the
-				// filter, and it doesn't have a keybinding
+				// menu has filtered down to the items that match the filter,
+				// and they don't have a keybinding
 				Equals("--- Global ---"),
 				Equals("Toggle whitespace").IsSelected(),
+				Equals("Toggle showing whitespace"),
 			)
 	},
 })
@@ -0,0 +1,66 @@
+package filter_and_search
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchWithOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search with regex and case sensitivity toggles, and repeat the last search",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("file1", "one\ntwo\nfour\nfive\nFour\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.Views().SearchPrefix().Content(Equals("Search: "))
+				t.Views().Search().Press(keys.Universal.ToggleSearchRegex)
+				t.Views().SearchPrefix().Content(Equals("Search (regex): "))
+
+				t.ExpectSearch().
+					Type("f(our|ive)").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'f(our|ive)' (1 of 3, line 3)"))
+			}).
+			SelectedLine(Contains("+four")).
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.Views().Search().Press(keys.Universal.ToggleSearchCaseSensitivity)
+				t.Views().SearchPrefix().Content(Equals("Search (regex, case-sensitive): "))
+
+				// Confirming an empty search repeats the last one
+				t.ExpectSearch().Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'f(our|ive)' (1 of 2, line 3)"))
+			}).
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("f(").
+					Confirm()
+
+				t.ExpectToast(Contains("Invalid regular expression"))
+
+				t.ExpectSearch().
+					Clear().
+					Type("F").
+					Confirm()
+
+				t.Views().Search().Content(Contains("matches for 'F' (1 of 1, line 5)"))
+			}).
+			SelectedLine(Contains("+Four"))
+	},
+})
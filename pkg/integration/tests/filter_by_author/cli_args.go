@@ -0,0 +1,46 @@
+package filter_by_author
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CliArgs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by author and date range, using CLI args",
+	ExtraCmdArgs: []string{"--author=Yang", "--since=2024-01-02 00:00", "--until=2024-01-04 00:00"},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("Yang Wen-li", "yang.wen-li@email.com")
+		shell.EmptyCommitWithDate("yang 1", "2024-01-01T12:00:00")
+		shell.EmptyCommitWithDate("yang 2", "2024-01-02T12:00:00")
+		shell.SetAuthor("Paul Oberstein", "paul.oberstein@email.com")
+		shell.EmptyCommitWithDate("paul 1", "2024-01-03T12:00:00")
+		shell.SetAuthor("Yang Wen-li", "yang.wen-li@email.com")
+		shell.EmptyCommitWithDate("yang 3", "2024-01-03T12:00:00")
+		shell.EmptyCommitWithDate("yang 4", "2024-01-05T12:00:00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("yang 3").IsSelected(),
+				Contains("yang 2"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by 'Yang' since '2024-01-02 00:00' until '2024-01-04 00:00'"))
+
+		t.Views().Commits().
+			Press(keys.Universal.Return)
+
+		t.Views().Commits().
+			Lines(
+				Contains("yang 4"),
+				Contains("yang 3").IsSelected(),
+				Contains("paul 1"),
+				Contains("yang 2"),
+				Contains("yang 1"),
+			)
+	},
+})
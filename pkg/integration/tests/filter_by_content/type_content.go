@@ -0,0 +1,79 @@
+package filter_by_content
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var TypeContent = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by content that they add or remove, using git log -S and -G",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "func foo() {}\n")
+		shell.Commit("add foo")
+		shell.CreateFileAndAdd("file2", "unrelated\n")
+		shell.Commit("unrelated change")
+		shell.UpdateFileAndAdd("file1", "func foo() {}\nfunc bar() { foo() }\n")
+		shell.UpdateFileAndAdd("file2", "still unrelated\n")
+		shell.Commit("call foo")
+		shell.UpdateFileAndAdd("file1", "func bar() {}\n")
+		shell.Commit("remove foo")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter text to filter by (git log -S)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter text:")).
+			Type("func foo").
+			Confirm()
+
+		// "call foo" doesn't change the number of occurrences of "func foo"
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("remove foo").IsSelected(),
+				Contains("add foo"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by commits adding or removing 'func foo'"))
+
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter regex to filter by (git log -G)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter regex:")).
+			Type("foo\\(\\)").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("remove foo").IsSelected(),
+				Contains("call foo"),
+				Contains("add foo"),
+			).
+			NavigateToLine(Contains("call foo"))
+
+		// The diff only shows the file with the matching lines
+		t.Views().Main().
+			Content(Contains("func bar() { foo() }")).
+			Content(DoesNotContain("still unrelated"))
+
+		t.Views().Information().Content(Contains("Filtering by changed lines matching 'foo\\(\\)'"))
+	},
+})
@@ -0,0 +1,64 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveMarkedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move a non-contiguous set of marked commits down and up as a group",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(6)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 05")).
+			Press(keys.Universal.ToggleMark).
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Universal.ToggleMark).
+			Lines(
+				Contains("commit 06"),
+				Contains("* ").Contains("commit 05"),
+				Contains("commit 04"),
+				Contains("* ").Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.MoveDownCommit).
+			Lines(
+				Contains("commit 06"),
+				Contains("commit 04"),
+				Contains("* ").Contains("commit 05"),
+				Contains("commit 02"),
+				Contains("* ").Contains("commit 03").IsSelected(),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.MoveDownCommit).
+			Lines(
+				Contains("commit 06"),
+				Contains("commit 04"),
+				Contains("commit 02"),
+				Contains("* ").Contains("commit 05"),
+				Contains("commit 01"),
+				Contains("* ").Contains("commit 03").IsSelected(),
+			).
+			// the lowest marked commit can't move any further, so none of them move
+			Press(keys.Commits.MoveDownCommit).
+			Tap(func() {
+				t.ExpectToast(Contains("Disabled: Cannot move any further"))
+			}).
+			Press(keys.Commits.MoveUpCommit).
+			Lines(
+				Contains("commit 06"),
+				Contains("commit 04"),
+				Contains("* ").Contains("commit 05"),
+				Contains("commit 02"),
+				Contains("* ").Contains("commit 03").IsSelected(),
+				Contains("commit 01"),
+			)
+	},
+})
@@ -0,0 +1,72 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RewriteProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rewriting the history of a protected branch asks for confirmation first",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"main"}
+		config.GetUserConfig().Git.ProtectedBranches.Mode = "confirm"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("main")
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.SquashDown)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Protected branch")).
+			Content(Equals("Branch main is protected (see git.protectedBranches in your config). Are you sure you want to rewrite the history of it?")).
+			Cancel()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.MoveDownCommit)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Protected branch")).
+			Content(Contains("rewrite the history of it")).
+			Cancel()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Protected branch")).
+			Content(Contains("rewrite the history of it")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Drop commit")).
+			Content(Equals("Are you sure you want to drop the selected commit(s)?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 02").IsSelected(),
+				Contains("commit 01"),
+			)
+	},
+})
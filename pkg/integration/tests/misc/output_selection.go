@@ -0,0 +1,44 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OutputSelection = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started with --output-selection, lazygit writes the selected items of the focused panel to the given file when quitting",
+	ExtraCmdArgs: []string{"--output-selection=../selection"},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+		shell.NewBranch("branch-c")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("branch-c").IsSelected(),
+				Contains("branch-a"),
+				Contains("branch-b"),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("branch-a")).
+			Press(keys.Universal.RangeSelectDown).
+			Lines(
+				Contains("branch-c"),
+				Contains("branch-a").IsSelected(),
+				Contains("branch-b").IsSelected(),
+				Contains("master"),
+			)
+
+		// lazygit quits when the test is done
+	},
+	AfterQuit: func(fs *FileSystem) {
+		fs.FileContent("../selection", Equals("branch-a\nbranch-b\n"))
+	},
+})
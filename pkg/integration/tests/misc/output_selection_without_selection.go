@@ -0,0 +1,26 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OutputSelectionWithoutSelection = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started with --output-selection, lazygit writes an empty file when quitting if nothing is selected",
+	ExtraCmdArgs: []string{"--output-selection=../selection"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			IsEmpty()
+
+		// lazygit quits when the test is done
+	},
+	AfterQuit: func(fs *FileSystem) {
+		fs.FileContent("../selection", Equals(""))
+	},
+})
@@ -0,0 +1,74 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowReflogOfBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the reflog of a branch other than HEAD, then go back to the reflog of HEAD",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("two")
+		shell.Checkout("master")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			Lines(
+				Contains("commit: three").IsSelected(),
+				Contains("checkout: moving from feature to master"),
+				Contains("commit: two"),
+				Contains("checkout: moving from master to feature"),
+				Contains("commit (initial): one"),
+			).
+			Press(keys.Commits.ShowReflogOfRef)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Show reflog of:")).
+			Type("nonexistent").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Could not find ref 'nonexistent'")).
+			Confirm()
+
+		t.Views().ReflogCommits().
+			Press(keys.Commits.ShowReflogOfRef)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Show reflog of:")).
+			Type("feature").
+			Confirm()
+
+		t.Views().ReflogCommits().
+			IsFocused().
+			Lines(
+				Contains("commit: two").IsSelected(),
+				Contains("branch: Created from HEAD"),
+			).
+			Press(keys.Commits.ShowReflogOfRef)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Show reflog of:")).
+			InitialText(Equals("feature")).
+			Clear().
+			Confirm()
+
+		t.Views().ReflogCommits().
+			IsFocused().
+			Lines(
+				Contains("commit: three").IsSelected(),
+				Contains("checkout: moving from feature to master"),
+				Contains("commit: two"),
+				Contains("checkout: moving from master to feature"),
+				Contains("commit (initial): one"),
+			)
+	},
+})
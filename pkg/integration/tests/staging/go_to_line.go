@@ -0,0 +1,69 @@
+package staging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GoToLine = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Jump to a line number in the staging panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		lines := make([]string, 30)
+		for i := range lines {
+			lines[i] = fmt.Sprintf("line %d", i+1)
+		}
+		shell.CreateFileAndAdd("file1", strings.Join(lines, "\n")+"\n")
+		shell.Commit("first commit")
+
+		lines[4] = "line 5 changed"
+		lines[24] = "line 25 changed"
+		shell.CreateFile("file1", strings.Join(lines, "\n")+"\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line:")).
+					Type("24").
+					Confirm()
+			}).
+			SelectedLine(Contains(" line 24")).
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				// line 15 is not part of the diff, so we select the next line that is
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line:")).
+					Type("15").
+					Confirm()
+			}).
+			SelectedLine(Contains(" line 22")).
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line:")).
+					Type("abc").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Invalid line number")).
+					Confirm()
+			}).
+			SelectedLine(Contains(" line 22"))
+	},
+})
@@ -0,0 +1,71 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageMarkedHunks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark hunks that aren't next to each other in the staging panel and stage them all at once",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "1a\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11a\n12a\n13a\n14a\n15a\n16a\n17a\n18a\n19a\n20a\n21a\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "1b\n2a\n3a\n4a\n5a\n6a\n7a\n8a\n9a\n10a\n11b\n12a\n13a\n14a\n15a\n16a\n17a\n18a\n19a\n20a\n21b\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-1a"),
+				Contains("+1b"),
+			).
+			Press(keys.Universal.ToggleMark).
+			SelectNextItem().
+			SelectNextItem().
+			SelectedLines(
+				Contains("-21a"),
+				Contains("+21b"),
+			).
+			Press(keys.Universal.ToggleMark).
+			// Unmark and re-mark the last hunk to check that toggling works
+			Press(keys.Universal.ToggleMark).
+			Press(keys.Universal.ToggleMark).
+			PressPrimaryAction().
+			ContainsLines(
+				Contains("-11a"),
+				Contains("+11b"),
+			).
+			Content(DoesNotContain("-1a").DoesNotContain("-21a")).
+			Tap(func() {
+				t.Views().StagingSecondary().
+					ContainsLines(
+						Contains("-1a"),
+						Contains("+1b"),
+					).
+					ContainsLines(
+						Contains("-21a"),
+						Contains("+21b"),
+					).
+					Content(DoesNotContain("-11a"))
+			}).
+			// Escape clears the marks before leaving the view
+			Press(keys.Universal.ToggleMark).
+			PressEscape().
+			IsFocused().
+			PressPrimaryAction().
+			IsEmpty()
+	},
+})
@@ -0,0 +1,87 @@
+package staging
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StageWords = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage and unstage individual changed words of a line",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\nlet a = foo(b)\nthree\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\nlet x = foo(c)\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-let a = foo(b)"),
+			).
+			Press(keys.Main.StageWords).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Stage/unstage words")).
+					Lines(
+						Contains("a").Contains("x").IsSelected(),
+						Contains("b").Contains("c"),
+						Contains("Cancel"),
+					).
+					Select(Contains("b").Contains("c")).
+					Confirm()
+			}).
+			ContainsLines(
+				Contains("-let a = foo(c)"),
+				Contains("+let x = foo(c)"),
+			)
+
+		t.Views().StagingSecondary().
+			ContainsLines(
+				Contains("-let a = foo(b)"),
+				Contains("+let a = foo(c)"),
+			)
+
+		t.Views().Staging().
+			Press(keys.Main.ToggleSelectHunk).
+			PressPrimaryAction().
+			IsEmpty()
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			ContainsLines(
+				Contains("-let a = foo(b)"),
+				Contains("+let x = foo(c)"),
+			).
+			NavigateToLine(Contains("+let x = foo(c)")).
+			Press(keys.Main.StageWords).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Unstage words")).
+					Select(Contains("b").Contains("c")).
+					Confirm()
+			}).
+			ContainsLines(
+				Contains("-let a = foo(b)"),
+				Contains("+let x = foo(b)"),
+			)
+
+		t.Views().Staging().
+			ContainsLines(
+				Contains("-let x = foo(b)"),
+				Contains("+let x = foo(c)"),
+			)
+	},
+})
@@ -0,0 +1,50 @@
+package staging
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var longLine = "long " + strings.Repeat("words ", 60) + "end"
+
+var ToggleLineWrap = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle line wrapping in the staging view and check that the selection stays on the same line",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.UseHunkModeInStagingView = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "a\nb\nc\n")
+		shell.Commit("first commit")
+		shell.UpdateFile("file1", "a\n"+longLine+"\nb\nc\nd\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			// Lines are wrapped by default; turn it off
+			Press(keys.Universal.ToggleLineWrap).
+			SelectedLine(Contains("+long words")).
+			NavigateToLine(Contains("+d")).
+			// Turn wrapping back on. Because of the wrapped long line, the
+			// selected line now has a different view index, but it should
+			// still be the same patch line
+			Press(keys.Universal.ToggleLineWrap).
+			PressPrimaryAction().
+			Content(Contains("+long words")).
+			Content(DoesNotContain("+d"))
+
+		t.Views().StagingSecondary().
+			Content(Contains("+d")).
+			Content(DoesNotContain("+long words"))
+	},
+})
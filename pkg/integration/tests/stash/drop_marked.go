@@ -0,0 +1,71 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DropMarked = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark stash entries that aren't next to each other and drop them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file1", "content1")
+		shell.Stash("stash one")
+		shell.CreateFileAndAdd("file2", "content2")
+		shell.Stash("stash two")
+		shell.CreateFileAndAdd("file3", "content3")
+		shell.Stash("stash three")
+		shell.CreateFileAndAdd("file4", "content4")
+		shell.Stash("stash four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash four").IsSelected(),
+				Contains("stash three"),
+				Contains("stash two"),
+				Contains("stash one"),
+			).
+			Press(keys.Universal.ToggleMark).
+			Lines(
+				Contains("* ").Contains("stash four").IsSelected(),
+				DoesNotContain("*").Contains("stash three"),
+				DoesNotContain("*").Contains("stash two"),
+				DoesNotContain("*").Contains("stash one"),
+			).
+			// Escape clears the marks
+			PressEscape().
+			Lines(
+				DoesNotContain("*").Contains("stash four").IsSelected(),
+				DoesNotContain("*").Contains("stash three"),
+				DoesNotContain("*").Contains("stash two"),
+				DoesNotContain("*").Contains("stash one"),
+			).
+			Press(keys.Universal.ToggleMark).
+			NavigateToLine(Contains("stash two")).
+			Press(keys.Universal.ToggleMark).
+			NavigateToLine(Contains("stash one")).
+			Lines(
+				Contains("* ").Contains("stash four"),
+				DoesNotContain("*").Contains("stash three"),
+				Contains("* ").Contains("stash two"),
+				DoesNotContain("*").Contains("stash one").IsSelected(),
+			).
+			// The marked entries are dropped, not the selected one
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stash drop")).
+					Content(Contains("Are you sure you want to drop the selected stash entry(ies)?")).
+					Confirm()
+			}).
+			Lines(
+				DoesNotContain("*").Contains("stash three"),
+				DoesNotContain("*").Contains("stash one"),
+			)
+	},
+})
@@ -0,0 +1,57 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stashing only the selected files, including an untracked one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file_a", "a\n")
+		shell.CreateFileAndAdd("file_c", "c\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file_a", "a changed\n")
+		shell.CreateFile("file_b", "b\n")
+		shell.UpdateFile("file_c", "c changed\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			IsEmpty()
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("   M file_a"),
+				Equals("  ?? file_b"),
+				Equals("   M file_c"),
+			).
+			NavigateToLine(Contains("file_a")).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Contains("file_b")).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Menu().Title(Equals("Stash options")).Select(Contains("Stash selected files")).Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("my stashed files").Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("my stashed files"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Equals(" M file_c"),
+			)
+
+		t.FileSystem().
+			FileContent("file_a", Equals("a\n")).
+			PathNotPresent("file_b").
+			FileContent("file_c", Equals("c changed\n"))
+	},
+})
@@ -0,0 +1,76 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var HooksMenu = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the git hooks of the repo from the status panel, enable, run and skip them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile(".git/hooks/pre-commit", "#!/bin/sh\necho pre-commit > pre-commit-ran\n")
+		shell.CreateFile(".git/hooks/pre-push", "#!/bin/sh\necho pre-push > pre-push-ran\n")
+		shell.MakeExecutable(".git/hooks/pre-push")
+		shell.CreateFile(".git/hooks/pre-rebase.sample", "#!/bin/sh\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.ViewHooks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Hooks")).
+			Lines(
+				Contains("Hooks in").Contains(".git/hooks"),
+				Contains("pre-commit").Contains("disabled").IsSelected(),
+				Contains("pre-push").Contains("enabled"),
+				MatchesRegexp(`^\s*$`),
+				Contains("--- Skip hooks (--no-verify) ---"),
+				Contains("[ ] Skip hooks when pushing"),
+				Contains("[ ] Skip hooks when merging"),
+				Contains("[ ] Skip hooks when rebasing"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("pre-commit")).
+			Select(Contains("Run hook")).
+			Tooltip(Contains("Disabled: disabled")).
+			Select(Contains("Enable hook")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Hooks")).
+			Select(Contains("pre-commit")).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("enabled"))
+			}).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("pre-commit")).
+			Select(Contains("Run hook")).
+			Confirm()
+
+		t.FileSystem().PathPresent("pre-commit-ran")
+
+		t.Views().Status().
+			Press(keys.Status.ViewHooks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Hooks")).
+			Select(Contains("Skip hooks when pushing")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Hooks")).
+			ContainsLines(
+				Contains("[✓] Skip hooks when pushing"),
+				Contains("[ ] Skip hooks when merging"),
+			)
+	},
+})
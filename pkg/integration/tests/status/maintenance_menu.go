@@ -0,0 +1,52 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MaintenanceMenu = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the health of the repo from the status panel, and garbage collect it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.ViewMaintenanceOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository maintenance")).
+			Lines(
+				Equals("Packed objects: 0 in 0 pack(s), 0 bytes"),
+				// The size depends on the block size of the file system
+				Contains("Loose objects: 9, "),
+				Equals("Commit-graph: missing"),
+				Equals("Scheduled maintenance: not registered"),
+				Equals(""),
+				Contains("Run maintenance tasks").Contains("git maintenance run").IsSelected(),
+				Contains("Garbage collect").Contains("git gc"),
+				Contains("Repack").Contains("git repack -a -d"),
+				Contains("Register for scheduled maintenance"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Garbage collect")).
+			Confirm()
+
+		t.ExpectToast(Equals("Maintenance done"))
+
+		t.Views().Status().
+			Press(keys.Status.ViewMaintenanceOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository maintenance")).
+			TopLines(
+				Contains("Packed objects: 9 in 1 pack(s)"),
+				Equals("Loose objects: 0, 0 bytes"),
+				Equals("Commit-graph: present"),
+			)
+	},
+})
@@ -0,0 +1,42 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoOverview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a summary of the repo in the main view when the status panel view is set to repoOverview",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.StatusPanelView = "repoOverview"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateLightweightTag("v1.0.0", "HEAD")
+		shell.EmptyCommit("two")
+		shell.NewBranch("feature")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("stashed-file", "content")
+		shell.Stash("stash one")
+		shell.CreateFileAndAdd("staged-file", "content")
+		shell.CreateFile("untracked-file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Content(Contains("Branch     master")).
+			Content(Contains("Upstream   none")).
+			Content(MatchesRegexp(`Changes    2 changed files \(1 staged, 1 untracked\) +press 2`)).
+			Content(MatchesRegexp(`Stashes    1 +press 5`)).
+			Content(Contains("Latest tag v1.0.0")).
+			Content(Contains("Recent branches  press 3")).
+			Content(Contains("feature")).
+			Content(Contains("other")).
+			Content(DoesNotContain("In progress"))
+	},
+})
@@ -0,0 +1,45 @@
+package status
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoSearchRoots = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show repos from the configured search roots in the recent repos menu, with their branch and dirty state",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		searchRoot, _ := filepath.Abs("..")
+		config.GetUserConfig().RepoSearchRoots = []string{searchRoot}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+		shell.Commit("initial commit")
+
+		shell.CloneNonBare("clean")
+		shell.CloneNonBare("dirty")
+		shell.UpdateFile("../dirty/file", "changed content")
+		// untracked files don't count, since looking for them can be slow
+		shell.CreateFile("../clean/untracked", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.GlobalPress(keys.Universal.OpenRecentRepos)
+
+		// The dirty state is filled in once the menu is open
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent repositories")).
+			Lines(
+				Equals("--- Repositories in search roots ---"),
+				Contains("clean").Contains("master").DoesNotContain("*"),
+				Contains("dirty").Contains("master *"),
+				Contains("Cancel"),
+			).
+			Select(Contains("dirty")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("dirty → master"))
+	},
+})
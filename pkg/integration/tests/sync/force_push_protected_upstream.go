@@ -0,0 +1,43 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ForcePushProtectedUpstream = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force-pushing is refused if the remote branch it would overwrite is protected, even if the local branch isn't",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"main"}
+		config.GetUserConfig().Git.ProtectedBranches.Mode = "block"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("push.default", "upstream")
+
+		shell.NewBranch("main")
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.NewBranch("feature")
+		shell.SetBranchUpstream("feature", "origin/main")
+		// diverge from the remote branch
+		shell.HardReset("HEAD^")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↓1↑1 repo → feature"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.Push)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Can't force-push protected branch main (see git.protectedBranches in your config)")).
+			Confirm()
+
+		t.Views().Status().Content(Equals("↓1↑1 repo → feature"))
+	},
+})
@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullAsSingleAction = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "When started with `lazygit pull`, lazygit pulls and quits",
+	ExtraCmdArgs: []string{"pull"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// The pull starts without any input from the user, and lazygit quits
+		// once it's done, so there's nothing to check here; lazygit's exit
+		// code tells the test that the pull succeeded
+	},
+})
@@ -0,0 +1,65 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullWithStrategy = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Choose to pull with rebase, although git is configured to merge",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "content2")
+		shell.Commit("two")
+		shell.CreateFileAndAdd("file3", "content3")
+		shell.Commit("three")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^^")
+		shell.CreateFileAndAdd("file4", "content4")
+		shell.Commit("four")
+
+		shell.SetConfig("pull.rebase", "false")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↓2↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PullWithStrategy)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pull strategy")).
+			Lines(
+				Contains("(•) Use git config").Contains("merge").IsSelected(),
+				Contains("( ) Merge").Contains("--no-rebase"),
+				Contains("( ) Rebase").Contains("--rebase"),
+				Contains("( ) Fast-forward only").Contains("--ff-only"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Rebase")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Pull")).
+			Content(Equals("Pull origin/master into master using rebase?")).
+			Confirm()
+
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("four"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushRefspecToProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force-pushing a custom refspec is refused if its destination is a protected branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"main"}
+		config.GetUserConfig().Git.ProtectedBranches.Mode = "block"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// A refspec starting with '+' is a force-push
+		t.Views().Files().IsFocused().Press(keys.Universal.PushWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Refspec: current branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Refspec:")).
+			Type("+HEAD:main").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(MatchesRegexp(`Push$`)).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Can't force-push protected branch main (see git.protectedBranches in your config)")).
+			Confirm()
+
+		// So is a refspec pushed with --force-with-lease
+		t.Views().Files().Press(keys.Universal.PushWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Force push (--force-with-lease)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Refspec: current branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Refspec:")).
+			Type("master:refs/heads/main").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(MatchesRegexp(`Push$`)).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Can't force-push protected branch main (see git.protectedBranches in your config)")).
+			Confirm()
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("master"),
+			)
+	},
+})
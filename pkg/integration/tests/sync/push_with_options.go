@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a custom refspec, and force push, from the push options menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// remove the 'two' commit so that we have something to force push
+		shell.HardReset("HEAD^")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↓1↑1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.PushWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Refspec: current branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Refspec:")).
+			Type("HEAD:refs/heads/review").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Lines(
+				MatchesRegexp(`Push$`).IsSelected(),
+				Contains("--- Options ---"),
+				Contains("[ ] Force push (--force-with-lease)"),
+				Contains("[ ] Only force push if remote changes are integrated (--force-if-includes)"),
+				Contains("[ ] Skip pre-push hook (--no-verify)"),
+				Contains("[ ] Push tags (--follow-tags)"),
+				Contains("Refspec: HEAD:refs/heads/review"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("review"),
+			)
+
+		// The current branch is still behind, so force push it without being
+		// asked again
+		t.Views().Files().Focus().Press(keys.Universal.PushWithOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Force push (--force-with-lease)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Only force push if remote changes are integrated")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			ContainsLines(
+				Contains("[✓] Force push (--force-with-lease)"),
+				Contains("[✓] Only force push if remote changes are integrated (--force-if-includes)"),
+			).
+			Select(MatchesRegexp(`Push$`)).
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		t.Views().Remotes().Focus().
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			NavigateToLine(Contains("master")).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(
+				Contains("three"),
+				Contains("one"),
+			)
+	},
+})
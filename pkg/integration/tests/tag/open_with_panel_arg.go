@@ -0,0 +1,24 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenWithPanelArg = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open straight to the tags panel using the --panel arg",
+	ExtraCmdArgs: []string{"--panel=tags"},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateLightweightTag("tag1", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			IsFocused().
+			Lines(
+				Contains("tag1").IsSelected(),
+			)
+	},
+})
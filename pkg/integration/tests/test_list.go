@@ -16,6 +16,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/file"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_and_search"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_author"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_content"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_path"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/interactive_rebase"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
@@ -39,12 +40,18 @@ var tests = []*components.IntegrationTest{
 	bisect.ChooseTerms,
 	bisect.FromOtherBranch,
 	bisect.Skip,
+	bisect.ViewLog,
 	branch.CheckoutAutostash,
 	branch.CheckoutByName,
 	branch.CheckoutPreviousBranch,
+	branch.CheckoutPullRequestByNumber,
+	branch.CheckoutWithBranchPicker,
+	branch.CreateArchive,
 	branch.CreateTag,
 	branch.Delete,
+	branch.DeleteMergedWithConfirmation,
 	branch.DeleteMultiple,
+	branch.DeleteProtectedBranch,
 	branch.DeleteRemoteBranchWhenTagWithSameNameExists,
 	branch.DeleteRemoteBranchWithCredentialPrompt,
 	branch.DeleteRemoteBranchWithDifferentName,
@@ -77,6 +84,7 @@ var tests = []*components.IntegrationTest{
 	branch.RebaseToUpstream,
 	branch.Rename,
 	branch.Reset,
+	branch.ResetProtectedBranch,
 	branch.ResetToDuplicateNamedTag,
 	branch.ResetToDuplicateNamedUpstream,
 	branch.ResetToUpstream,
@@ -85,6 +93,7 @@ var tests = []*components.IntegrationTest{
 	branch.ShowDivergenceFromBaseBranch,
 	branch.ShowDivergenceFromUpstream,
 	branch.ShowDivergenceFromUpstreamNoDivergence,
+	branch.ShowRemoteBranchDivergence,
 	branch.SortLocalBranches,
 	branch.SortRemoteBranches,
 	branch.SquashMerge,
@@ -97,6 +106,8 @@ var tests = []*components.IntegrationTest{
 	cherry_pick.CherryPickDuringRebase,
 	cherry_pick.CherryPickMerge,
 	cherry_pick.CherryPickRange,
+	cherry_pick.CherryPickWithOptions,
+	commit.AbsorbStagedChanges,
 	commit.AddCoAuthor,
 	commit.AddCoAuthorRange,
 	commit.AddCoAuthorWhileCommitting,
@@ -104,12 +115,14 @@ var tests = []*components.IntegrationTest{
 	commit.AmendWhenThereAreConflictsAndAmend,
 	commit.AmendWhenThereAreConflictsAndCancel,
 	commit.AmendWhenThereAreConflictsAndContinue,
+	commit.ApplyPatches,
 	commit.AutoWrapMessage,
 	commit.Checkout,
 	commit.CheckoutFileFromCommit,
 	commit.CheckoutFileFromRangeSelectionOfCommits,
 	commit.CheckoutFileWithLocalModifications,
 	commit.Commit,
+	commit.CommitAsSingleAction,
 	commit.CommitMultiline,
 	commit.CommitSkipHooks,
 	commit.CommitSwitchToEditor,
@@ -117,8 +130,11 @@ var tests = []*components.IntegrationTest{
 	commit.CommitWipWithPrefix,
 	commit.CommitWithFallthroughPrefix,
 	commit.CommitWithGlobalPrefix,
+	commit.CommitWithMessageTemplateConfig,
 	commit.CommitWithNonMatchingBranchName,
 	commit.CommitWithPrefix,
+	commit.CommitWithTemplate,
+	commit.ConventionalCommit,
 	commit.CopyAuthorToClipboard,
 	commit.CopyMessageBodyToClipboard,
 	commit.CopyTagToClipboard,
@@ -129,12 +145,14 @@ var tests = []*components.IntegrationTest{
 	commit.DiscardOldFileChanges,
 	commit.DiscardSubmoduleChanges,
 	commit.DoNotShowBranchMarkerForHeadCommit,
+	commit.ExportPatches,
 	commit.FailHooksThenCommitNoHooks,
 	commit.FindBaseCommitForFixup,
 	commit.FindBaseCommitForFixupDisregardFixupsForSameBaseCommit,
 	commit.FindBaseCommitForFixupDisregardMainBranch,
 	commit.FindBaseCommitForFixupOnlyAddedLines,
 	commit.FindBaseCommitForFixupWarningForAddedLines,
+	commit.GoToCommit,
 	commit.Highlight,
 	commit.History,
 	commit.HistoryComplex,
@@ -147,16 +165,23 @@ var tests = []*components.IntegrationTest{
 	commit.ResetAuthorRange,
 	commit.Revert,
 	commit.RevertMerge,
+	commit.RevertMergesWithDifferentParentCounts,
 	commit.RevertWithConflictMultipleCommits,
 	commit.RevertWithConflictSingleCommit,
 	commit.Reword,
 	commit.Search,
+	commit.SelectWithCliArg,
+	commit.SeparatePushedCommits,
 	commit.SetAuthor,
+	commit.SetAuthorDate,
 	commit.SetAuthorRange,
+	commit.ShowDetails,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
+	commit.ToggleCommitSigning,
 	commit.Unstaged,
+	commit.VerifySignature,
 	config.CustomCommandsInPerRepoConfig,
 	config.NegativeRefspec,
 	config.RemoteNamedStar,
@@ -164,6 +189,7 @@ var tests = []*components.IntegrationTest{
 	conflicts.MergeFileBoth,
 	conflicts.MergeFileCurrent,
 	conflicts.MergeFileIncoming,
+	conflicts.ResolveAsMergetool,
 	conflicts.ResolveExternally,
 	conflicts.ResolveMultipleFiles,
 	conflicts.ResolveNoAutoStage,
@@ -213,14 +239,23 @@ var tests = []*components.IntegrationTest{
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
 	diff.DiffNonStickyRange,
+	diff.Difftool,
+	diff.FetchMissingBlobsOfPartialClone,
 	diff.IgnoreWhitespace,
 	diff.RenameSimilarityThresholdChange,
+	diff.ShowWhitespace,
+	diff.SideBySide,
+	diff.SideBySideResize,
+	file.ApplyPatchFile,
+	file.Blame,
 	file.ClickArrowToCollapse,
 	file.CollapseExpand,
 	file.CopyMenu,
 	file.DirWithUntrackedFile,
 	file.DiscardAllDirChanges,
 	file.DiscardAllDirChangesWhenFiltering,
+	file.DiscardFileHunk,
+	file.DiscardFileHunkAfterFileChanged,
 	file.DiscardRangeSelect,
 	file.DiscardStagedChanges,
 	file.DiscardUnstagedDirChanges,
@@ -229,16 +264,25 @@ var tests = []*components.IntegrationTest{
 	file.DiscardUnstagedRangeSelect,
 	file.DiscardVariousChanges,
 	file.DiscardVariousChangesRangeSelect,
+	file.DoubleClickToEditFile,
 	file.ExcludeWithoutInfoDir,
+	file.FileHistory,
 	file.Gitignore,
 	file.GitignoreSpecialCharacters,
+	file.ImagePreview,
 	file.RememberCommitMessageAfterFail,
 	file.RenameSimilarityThresholdChange,
 	file.RenamedFiles,
 	file.RenamedFilesNoRootItem,
+	file.SelectWithCliArg,
+	file.SkipUntrackedFiles,
+	file.SparseCheckout,
 	file.StageChildrenRangeSelect,
 	file.StageDeletedRangeSelect,
+	file.StageFileOutsideSparseCheckout,
+	file.StageLfsFileWithoutLfs,
 	file.StageRangeSelect,
+	file.StagedDiffAfterSoftReset,
 	filter_and_search.FilterByFileStatus,
 	filter_and_search.FilterCommitFiles,
 	filter_and_search.FilterCommitFilesToggleDirectory,
@@ -258,10 +302,13 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.NestedFilter,
 	filter_and_search.NestedFilterTransient,
 	filter_and_search.NewSearch,
+	filter_and_search.SearchWithOptions,
 	filter_and_search.StageAllStagesOnlyTrackedFilesInTrackedOnlyFilter,
 	filter_and_search.StagingFolderStagesOnlyTrackedFilesInTrackedOnlyFilter,
+	filter_by_author.CliArgs,
 	filter_by_author.SelectAuthor,
 	filter_by_author.TypeAuthor,
+	filter_by_content.TypeContent,
 	filter_by_path.CliArg,
 	filter_by_path.DropCommitInFilteringMode,
 	filter_by_path.KeepSameCommitSelectedOnExit,
@@ -301,6 +348,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.Move,
 	interactive_rebase.MoveAcrossBranchBoundaryOutsideRebase,
 	interactive_rebase.MoveInRebase,
+	interactive_rebase.MoveMarkedCommits,
 	interactive_rebase.MoveUpdateRefTodo,
 	interactive_rebase.MoveWithCustomCommentChar,
 	interactive_rebase.OutsideRebaseRangeSelect,
@@ -320,6 +368,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.RewordMergeCommit,
 	interactive_rebase.RewordYouAreHereCommit,
 	interactive_rebase.RewordYouAreHereCommitWithEditor,
+	interactive_rebase.RewriteProtectedBranch,
 	interactive_rebase.ShowExecTodos,
 	interactive_rebase.SquashDownFirstCommit,
 	interactive_rebase.SquashDownSecondCommit,
@@ -335,6 +384,8 @@ var tests = []*components.IntegrationTest{
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
 	misc.InitialOpen,
+	misc.OutputSelection,
+	misc.OutputSelectionWithoutSelection,
 	misc.RecentReposOnLaunch,
 	patch_building.Apply,
 	patch_building.ApplyInReverse,
@@ -374,6 +425,7 @@ var tests = []*components.IntegrationTest{
 	reflog.DoNotShowBranchMarkersInReflogSubcommits,
 	reflog.Patch,
 	reflog.Reset,
+	reflog.ShowReflogOfBranch,
 	remote.AddForkRemote,
 	shell_commands.BasicShellCommand,
 	shell_commands.ComplexShellCommand,
@@ -384,19 +436,24 @@ var tests = []*components.IntegrationTest{
 	staging.DiffChangeScreenMode,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
+	staging.GoToLine,
 	staging.Search,
 	staging.SelectNextLineAfterStagingInTwoHunkDiff,
 	staging.SelectNextLineAfterStagingIsolatedAddedLine,
 	staging.StageHunks,
 	staging.StageLines,
+	staging.StageMarkedHunks,
 	staging.StagePartialBlockOfChangesFirstLines,
 	staging.StagePartialBlockOfChangesLastLines,
 	staging.StagePartialBlockOfChangesMiddleLines,
 	staging.StageRanges,
+	staging.StageWords,
+	staging.ToggleLineWrap,
 	stash.Apply,
 	stash.ApplyPatch,
 	stash.CreateBranch,
 	stash.Drop,
+	stash.DropMarked,
 	stash.DropMultiple,
 	stash.DropMultipleInFilteredMode,
 	stash.FilterByPath,
@@ -408,14 +465,19 @@ var tests = []*components.IntegrationTest{
 	stash.StashAll,
 	stash.StashAndKeepIndex,
 	stash.StashIncludingUntrackedFiles,
+	stash.StashSelectedFiles,
 	stash.StashStaged,
 	stash.StashStagedPartialFile,
 	stash.StashUnstaged,
 	status.ClickRepoNameToOpenReposMenu,
 	status.ClickToFocus,
 	status.ClickWorkingTreeStateToOpenRebaseOptionsMenu,
+	status.HooksMenu,
 	status.LogCmd,
 	status.LogCmdStatusPanelAllBranchesLog,
+	status.MaintenanceMenu,
+	status.RepoOverview,
+	status.RepoSearchRoots,
 	submodule.Add,
 	submodule.Enter,
 	submodule.EnterNested,
@@ -432,23 +494,28 @@ var tests = []*components.IntegrationTest{
 	sync.ForcePush,
 	sync.ForcePushMultipleMatching,
 	sync.ForcePushMultipleUpstream,
+	sync.ForcePushProtectedUpstream,
 	sync.ForcePushRemoteBranchNotStoredLocally,
 	sync.ForcePushTriangular,
 	sync.Pull,
 	sync.PullAndSetUpstream,
+	sync.PullAsSingleAction,
 	sync.PullMerge,
 	sync.PullMergeConflict,
 	sync.PullRebase,
 	sync.PullRebaseConflict,
 	sync.PullRebaseInteractiveConflict,
 	sync.PullRebaseInteractiveConflictDrop,
+	sync.PullWithStrategy,
 	sync.Push,
 	sync.PushAndAutoSetUpstream,
 	sync.PushAndSetUpstream,
 	sync.PushFollowTags,
 	sync.PushNoFollowTags,
+	sync.PushRefspecToProtectedBranch,
 	sync.PushTag,
 	sync.PushWithCredentialPrompt,
+	sync.PushWithOptions,
 	sync.RenameBranchAndPull,
 	tag.Checkout,
 	tag.CheckoutWhenBranchWithSameNameExists,
@@ -460,23 +527,41 @@ var tests = []*components.IntegrationTest{
 	tag.DeleteRemoteTagWhenBranchWithSameNameExists,
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
+	tag.OpenWithPanelArg,
 	tag.Reset,
 	tag.ResetToDuplicateNamedBranch,
+	ui.AccessibilityAnnouncements,
 	ui.Accordion,
+	ui.Bookmarks,
+	ui.Cheatsheet,
 	ui.DisableSwitchTabWithPanelJumpKeys,
+	ui.DragSidePanelBorder,
 	ui.EmptyMenu,
+	ui.GoToAnything,
 	ui.KeybindingSuggestionsWhenSwitchingRepos,
+	ui.KeybindingsOverview,
+	ui.LayoutSnapshot,
 	ui.ModeSpecificKeybindingSuggestions,
 	ui.OpenLinkFailure,
 	ui.RangeSelect,
+	ui.RepoTabs,
+	ui.RestoreSession,
+	ui.SidePanelLayout,
+	ui.SwitchLanguage,
 	ui.SwitchTabFromMenu,
 	ui.SwitchTabWithPanelJumpKeys,
 	undo.UndoCheckoutAndDrop,
 	undo.UndoCommit,
+	undo.UndoDeleteBranch,
 	undo.UndoDrop,
+	undo.UndoDropStash,
+	undo.UndoStage,
+	undo.UndoStageKeepsIntentToAdd,
+	undo.UndoStageSeveralTimes,
 	worktree.AddFromBranch,
 	worktree.AddFromBranchDetached,
 	worktree.AddFromCommit,
+	worktree.AddWithPathTemplate,
 	worktree.AssociateBranchBisect,
 	worktree.AssociateBranchRebase,
 	worktree.BareRepo,
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AccessibilityAnnouncements = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "In accessibility mode, focus and selection changes are announced in the announcements file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.Accessibility.Enabled = true
+		// Relative to the repo; we don't want the file to show up in the files panel
+		config.GetUserConfig().Gui.Accessibility.AnnouncementsFile = "../announcements.txt"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("feature")
+		shell.Checkout("master")
+		shell.CreateFile("myfile", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("myfile").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("../announcements.txt", Contains("Files: 1 of 1: ?? myfile\n"))
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("feature"))
+
+		t.FileSystem().FileContent("../announcements.txt",
+			Contains("Files: 1 of 1: ?? myfile\n").
+				Contains("Branches: 1 of 2: * master\n").
+				Contains("Branches: 2 of 2: ").
+				Contains(" feature\n").
+				// the selection isn't announced before the view is rendered
+				DoesNotContain("Branches: 2 of 2: * master"))
+	},
+})
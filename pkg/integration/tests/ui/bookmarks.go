@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Bookmarks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Bookmark a commit, a file and a branch, and jump to them from the bookmarks menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.EmptyCommit("second commit")
+		shell.NewBranch("feature-x")
+		shell.Checkout("master")
+		shell.CreateFile("file1", "content")
+		shell.CreateFile("file2", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenBookmarksMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bookmarks")).
+			Lines(
+				Contains("No bookmarks yet").IsSelected(),
+				Contains("Cancel"),
+			).
+			Cancel()
+
+		t.Views().Files().
+			NavigateToLine(Contains("file2")).
+			Press(keys.Universal.ToggleBookmark)
+
+		t.ExpectToast(Equals("Bookmark added"))
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("first commit")).
+			Press(keys.Universal.ToggleBookmark)
+
+		t.ExpectToast(Equals("Bookmark added"))
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("feature-x")).
+			Press(keys.Universal.ToggleBookmark)
+
+		t.ExpectToast(Equals("Bookmark added"))
+
+		t.Views().Branches().
+			NavigateToLine(Contains("master")).
+			Press(keys.Universal.OpenBookmarksMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bookmarks")).
+			Lines(
+				Contains("file").Contains("file2").IsSelected(),
+				Contains("commit").Contains("first commit"),
+				Contains("branch").Contains("feature-x"),
+				Contains("c Clear all bookmarks"),
+				Contains("Cancel"),
+			).
+			Select(Contains("first commit")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("second commit"),
+				Contains("first commit").IsSelected(),
+			).
+			Press(keys.Universal.OpenBookmarksMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bookmarks")).
+			Select(Contains("feature-x")).
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			SelectedLine(Contains("feature-x")).
+			Press(keys.Universal.ToggleBookmark)
+
+		t.ExpectToast(Equals("Bookmark removed"))
+
+		t.Views().Branches().
+			Press(keys.Universal.OpenBookmarksMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bookmarks")).
+			Lines(
+				Contains("file2").IsSelected(),
+				Contains("first commit"),
+				Contains("Clear all bookmarks"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			SelectedLine(Contains("file2"))
+	},
+})
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Cheatsheet = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the cheatsheet for the current config and export the effective config",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Keybinding.Files.CommitChanges = "C"
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:         "X",
+				Context:     "files",
+				Command:     "true",
+				Description: "My custom command",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenCheatsheet)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View cheatsheet")).
+			TopLines(
+				Contains("Export effective config"),
+			).
+			ContainsLines(
+				Contains("C").Contains("Commit"),
+			).
+			ContainsLines(
+				Contains("X").Contains("My custom command"),
+			).
+			Select(Contains("Export effective config")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Export config to:")).
+			Clear().
+			Type("exported-config.yml").
+			Confirm()
+
+		t.ExpectToast(Equals("Config exported to exported-config.yml"))
+
+		t.FileSystem().FileContent("exported-config.yml", Contains("commitChanges: C"))
+	},
+})
@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DragSidePanelBorder = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Make the side panels wider by dragging their border with the mouse",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("a-branch-whose-name-is-much-too-long-to-fit-into-the-side-panel")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("a-branch-whose-name-is-much-too-long-to-fit…").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Main().
+			Drag(-1, 0, 40, 0)
+
+		t.Views().Branches().
+			Lines(
+				Contains("a-branch-whose-name-is-much-too-long-to-fit-into-the-side-panel").IsSelected(),
+				Contains("master"),
+			)
+	},
+})
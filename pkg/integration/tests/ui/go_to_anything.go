@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GoToAnything = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Jump to branches, commits, files and stashes from the go-to-anything menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.EmptyCommit("second commit")
+		shell.NewBranch("feature-x")
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("stashed-file", "content")
+		shell.Stash("my stash")
+		shell.CreateFile("dir/notes.txt", "content")
+		shell.CreateFile("other", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.GoToAnything)
+
+		t.ExpectSearch().Type("feature-x").Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Go to anything")).
+			Lines(
+				Contains("branch").Contains("feature-x").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("feature-x").IsSelected(),
+			).
+			Press(keys.Universal.GoToAnything)
+
+		t.ExpectSearch().Type("first").Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Go to anything")).
+			Lines(
+				Contains("commit").Contains("first commit").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("second commit"),
+				Contains("first commit").IsSelected(),
+			).
+			Press(keys.Universal.GoToAnything)
+
+		t.ExpectSearch().Type("my stash").Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Go to anything")).
+			Lines(
+				Contains("stash").Contains("my stash").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Stash().
+			IsFocused().
+			Lines(
+				Contains("my stash").IsSelected(),
+			).
+			Press(keys.Universal.GoToAnything)
+
+		t.ExpectSearch().Type("notes").Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Go to anything")).
+			Lines(
+				Contains("file").Contains("dir/notes.txt").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ▼ dir"),
+				Equals("    ?? notes.txt").IsSelected(),
+				Equals("  ?? other"),
+			)
+	},
+})
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var KeybindingsOverview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a keybinding that clashes with a built-in one in the keybindings overview, and disable the built-in one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Keybinding.Files.CommitChanges = "a"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenKeybindingsOverview)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View keybindings overview")).
+			TopLines(
+				Contains("--- Conflicts ---"),
+				Contains("a").Contains("files.toggleStagedAll").Contains("conflicts with files.commitChanges"),
+				Equals(""),
+				Contains("--- universal ---"),
+			).
+			Select(Contains("files.toggleStagedAll").Contains("conflicts with files.commitChanges")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Disable keybinding")).
+			Content(Contains("Are you sure you want to disable 'keybinding.files.toggleStagedAll'?")).
+			Confirm()
+
+		t.ExpectToast(Contains("Disabled 'keybinding.files.toggleStagedAll'"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenKeybindingsOverview)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View keybindings overview")).
+			TopLines(
+				Contains("--- universal ---"),
+			).
+			ContainsLines(
+				Contains("<disabled>").Contains("toggleStagedAll"),
+			).
+			Cancel()
+
+		// With the built-in keybinding out of the way, our custom key works
+		t.Views().Files().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().CommitMessagePanel()
+	},
+})
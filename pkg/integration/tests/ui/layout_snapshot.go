@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LayoutSnapshot = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare the whole screen against snapshots, both with and without a popup",
+	ExtraCmdArgs: []string{},
+	Width:        100,
+	Height:       25,
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		// the tip is random, so it would make the snapshots flaky
+		config.GetUserConfig().Gui.ShowRandomTip = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+		shell.CreateFileAndAdd("file", "content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("A  file"),
+			)
+
+		t.ExpectSnapshot("files")
+
+		t.Views().Files().
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard changes"))
+
+		t.ExpectSnapshot("discard_menu")
+	},
+})
@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoTabs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch between open repos via the repo tabs menu, keeping their state, and close a tab",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		otherRepo, _ := filepath.Abs("../other")
+		config.GetAppState().RecentRepos = []string{otherRepo}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CloneNonBare("other")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("one"))
+
+		t.GlobalPress(keys.Universal.OpenRepoTabs)
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository tabs")).
+			Select(Contains("Open repository in new tab")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent repositories")).
+			Select(Contains("other")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("other → master"))
+
+		t.GlobalPress(keys.Universal.OpenRepoTabs)
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository tabs")).
+			Lines(
+				Contains("Open repository in new tab").IsSelected(),
+				Contains("Close current tab"),
+				Contains("--- Open repositories ---"),
+				Contains("1").Contains("repo"),
+				Contains("2").Contains("other"),
+				Contains("Cancel"),
+			).
+			Select(Contains("actual/repo")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("repo → master"))
+
+		// The state of the repo was kept, so the commits view is still focused
+		// with the same selection
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("one"))
+
+		t.GlobalPress(keys.Universal.OpenRepoTabs)
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository tabs")).
+			Select(Contains("Close current tab")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("other → master"))
+
+		t.GlobalPress(keys.Universal.OpenRepoTabs)
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository tabs")).
+			Lines(
+				Contains("Open repository in new tab").IsSelected(),
+				Contains("Close current tab"),
+				Contains("--- Open repositories ---"),
+				Contains("1").Contains("other"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Close current tab")).
+			Confirm()
+
+		t.ExpectToast(Contains("This is the only open repository"))
+	},
+})
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreSession = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore the focused panel, selection, filter and diffing mode from the previous session",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+
+		repo, _ := filepath.Abs(".")
+		cfg.GetAppState().RepoSessions = map[string]config.RepoSession{
+			repo: {
+				FocusedContext: "localBranches",
+				Lists: map[string]config.ListSession{
+					"localBranches": {SelectedItemId: "feature-b", SelectedIdx: 0, Filter: "feature"},
+				},
+				DiffingRef: "feature-a",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature-a")
+		shell.NewBranch("feature-b")
+		shell.NewBranch("feature-c")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("feature-a"),
+				Contains("feature-b").IsSelected(),
+				Contains("feature-c"),
+			)
+
+		t.Views().Search().IsVisible().Content(Contains("matches for 'feature'"))
+
+		t.Views().Information().Content(Contains("Showing output for: git diff --stat -p feature-a feature-b"))
+	},
+})
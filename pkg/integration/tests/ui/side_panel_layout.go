@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SidePanelLayout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reorder and hide side panels via the config and the side panel layout menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.SidePanels = []string{"branches", "files", "commits"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// The jump keys follow the configured order
+		t.GlobalPress(keys.Universal.JumpToBlock[0])
+		t.Views().Branches().IsFocused()
+
+		t.GlobalPress(keys.Universal.JumpToBlock[2])
+		t.Views().Commits().IsFocused()
+
+		t.GlobalPress(keys.Universal.JumpToBlock[0])
+		t.Views().Branches().IsFocused().
+			Press(keys.Universal.SidePanelLayoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Side panel layout")).
+			Select(Contains("Hide 'branches' panel")).
+			Confirm()
+
+		// Hiding the focused panel moves focus to the new top panel
+		t.Views().Files().IsFocused().
+			Press(keys.Universal.SidePanelLayoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Side panel layout")).
+			Select(Contains("Show 'stash' panel")).
+			Confirm()
+
+		t.GlobalPress(keys.Universal.JumpToBlock[2])
+		t.Views().Stash().IsFocused()
+
+		t.GlobalPress(keys.Universal.JumpToBlock[0])
+		t.Views().Files().IsFocused().
+			Press(keys.Universal.SidePanelLayoutMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Side panel layout")).
+			Select(Contains("Reset side panel layout")).
+			Confirm()
+
+		t.GlobalPress(keys.Universal.JumpToBlock[0])
+		t.Views().Branches().IsFocused()
+	},
+})
@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SwitchLanguage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch the language at runtime via the language menu, and go back to the one from the config",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Title(Equals("Files"))
+
+		t.GlobalPress(keys.Universal.LanguageMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Language")).
+			Select(Contains("Nederlands")).
+			Confirm()
+
+		t.Views().Files().
+			Title(Equals("Bestanden"))
+
+		t.GlobalPress(keys.Universal.LanguageMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Language")).
+			Select(Contains("Use language from config")).
+			Confirm()
+
+		t.Views().Files().
+			Title(Equals("Files"))
+	},
+})
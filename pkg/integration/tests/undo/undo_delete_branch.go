@@ -0,0 +1,109 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDeleteBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Drop a commit and delete a branch, then undo and redo both in the right order",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("on feature")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		confirmUndo := func(content *TextMatcher) func() {
+			return func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(content).
+					Confirm()
+			}
+		}
+
+		confirmRedo := func(content *TextMatcher) func() {
+			return func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Redo")).
+					Content(content).
+					Confirm()
+			}
+		}
+
+		t.Views().Commits().Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Drop commit")).
+					Content(Equals("Are you sure you want to drop the selected commit(s)?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("one").IsSelected(),
+			)
+
+		t.Views().Branches().Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete branch 'feature'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+				t.ExpectPopup().
+					Confirmation().
+					Title(Equals("Force delete branch")).
+					Content(Equals("'feature' is not fully merged. Are you sure you want to delete it?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+			).
+			// The branch deletion happened last, so it gets undone first
+			Press(keys.Universal.Undo).
+			Tap(confirmUndo(Equals("Are you sure you want to undo 'Delete branch feature'?"))).
+			Lines(
+				Contains("master"),
+				Contains("feature"),
+			)
+
+		t.Views().Commits().Focus().
+			Press(keys.Universal.Undo).
+			Tap(confirmUndo(Contains("Are you sure you want to hard reset"))).
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			).
+			Press(keys.Universal.Redo).
+			Tap(confirmRedo(Contains("Are you sure you want to hard reset"))).
+			Lines(
+				Contains("one"),
+			)
+
+		t.Views().Branches().Focus().
+			Lines(
+				Contains("master"),
+				Contains("feature"),
+			).
+			Press(keys.Universal.Redo).
+			Tap(confirmRedo(Equals("Are you sure you want to redo 'Delete branch feature'?"))).
+			Lines(
+				Contains("master"),
+			)
+	},
+})
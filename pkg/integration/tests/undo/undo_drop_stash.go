@@ -0,0 +1,52 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDropStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Drop a stash entry, then undo and redo the drop",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("file", "content")
+		shell.GitAddAll()
+		shell.Stash("stash one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Stash drop")).
+					Content(Contains("Are you sure you want to drop the selected stash entry(ies)?")).
+					Confirm()
+			}).
+			IsEmpty().
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Equals("Are you sure you want to undo 'Drop stash entry On master: stash one'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("stash one"),
+			).
+			Press(keys.Universal.Redo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Redo")).
+					Content(Equals("Are you sure you want to redo 'Drop stash entry On master: stash one'?")).
+					Confirm()
+			}).
+			IsEmpty()
+	},
+})
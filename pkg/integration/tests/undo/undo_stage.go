@@ -0,0 +1,48 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoStage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage a file, then undo and redo the staging",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("?? file").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Equals("A  file").IsSelected(),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Equals("Are you sure you want to undo 'Stage/unstage files'?")).
+					Confirm()
+			}).
+			Lines(
+				Equals("?? file").IsSelected(),
+			).
+			Press(keys.Universal.Redo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Redo")).
+					Content(Equals("Are you sure you want to redo 'Stage/unstage files'?")).
+					Confirm()
+			}).
+			Lines(
+				Equals("A  file").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,46 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoStageKeepsIntentToAdd = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Undoing staging a file keeps files that were added with --intent-to-add",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("intent-to-add", "content")
+		shell.RunCommand([]string{"git", "add", "--intent-to-add", "intent-to-add"})
+		shell.CreateFile("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals(" A intent-to-add").IsSelected(),
+				Equals("?? file"),
+			).
+			NavigateToLine(Equals("?? file")).
+			PressPrimaryAction().
+			Lines(
+				Equals(" A intent-to-add"),
+				Equals("A  file").IsSelected(),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Equals("Are you sure you want to undo 'Stage/unstage files'?")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" A intent-to-add"),
+				Equals("?? file").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,71 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoStageSeveralTimes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stage several files one after another, also outside of lazygit, and undo the staging",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("a", "a")
+		shell.CreateFile("b", "b")
+		shell.CreateFile("c", "c")
+		shell.CreateFile("d", "d")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		confirmUndo := func() {
+			t.ExpectPopup().Confirmation().
+				Title(Equals("Undo")).
+				Content(Equals("Are you sure you want to undo 'Stage/unstage files'?")).
+				Confirm()
+		}
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("?? a").IsSelected(),
+				Equals("?? b"),
+				Equals("?? c"),
+				Equals("?? d"),
+			).
+			PressPrimaryAction().
+			NavigateToLine(Contains("b")).
+			PressPrimaryAction().
+			Lines(
+				Equals("A  a"),
+				Equals("A  b").IsSelected(),
+				Equals("?? c"),
+				Equals("?? d"),
+			)
+
+		// Staging a file outside of lazygit in between must not get lost when
+		// undoing the next staging
+		t.Shell().GitAdd("c")
+		t.GlobalPress(keys.Files.RefreshFiles)
+
+		t.Views().Files().
+			NavigateToLine(Contains("d")).
+			PressPrimaryAction().
+			Lines(
+				Equals("A  a"),
+				Equals("A  b"),
+				Equals("A  c"),
+				Equals("A  d").IsSelected(),
+			).
+			Press(keys.Universal.Undo).
+			Tap(confirmUndo).
+			Lines(
+				Equals("A  a"),
+				Equals("A  b"),
+				Equals("A  c"),
+				Equals("?? d").IsSelected(),
+			)
+	},
+})
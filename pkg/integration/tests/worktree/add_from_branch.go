@@ -29,13 +29,15 @@ var AddFromBranch = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New worktree path")).
-					Type("../linked-worktree").
+					Title(Equals("New branch name")).
+					Type("newbranch").
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New branch name")).
-					Type("newbranch").
+					Title(Equals("New worktree path")).
+					InitialText(Equals("../repo-newbranch")).
+					Clear().
+					Type("../linked-worktree").
 					Confirm()
 			}).
 			// confirm we're still focused on the branches view
@@ -30,6 +30,8 @@ var AddFromBranchDetached = NewIntegrationTest(NewIntegrationTestArgs{
 
 				t.ExpectPopup().Prompt().
 					Title(Equals("New worktree path")).
+					InitialText(Equals("../repo-mybranch")).
+					Clear().
 					Type("../linked-worktree").
 					Confirm()
 			}).
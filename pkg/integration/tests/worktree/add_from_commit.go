@@ -32,13 +32,15 @@ var AddFromCommit = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New worktree path")).
-					Type("../linked-worktree").
+					Title(Equals("New branch name")).
+					Type("newbranch").
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New branch name")).
-					Type("newbranch").
+					Title(Equals("New worktree path")).
+					InitialText(Equals("../repo-newbranch")).
+					Clear().
+					Type("../linked-worktree").
 					Confirm()
 			}).
 			Lines(
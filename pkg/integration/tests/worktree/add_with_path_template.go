@@ -0,0 +1,59 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AddWithPathTemplate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add a worktree with a new branch from the worktrees view, accepting the path that the worktreePathTemplate config suggests",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.WorktreePathTemplate = "../worktrees/{{repoName}}/{{branchName}}"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("(main worktree)"),
+			).
+			Press(keys.Universal.New).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains(`Create worktree from ref`).DoesNotContain("detached")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New worktree base ref")).
+					InitialText(Equals("mybranch")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New branch name (leave blank to checkout mybranch)")).
+					Type("feature/worktrees").
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New worktree path")).
+					InitialText(Equals("../worktrees/repo/feature-worktrees")).
+					Confirm()
+			}).
+			Lines(
+				Contains("feature-worktrees").IsSelected(),
+				Contains("(main worktree)"),
+			)
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature/worktrees"),
+				Contains("mybranch (worktree repo)"),
+			)
+	},
+})
@@ -39,13 +39,15 @@ var WorktreeInRepo = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New worktree path")).
-					Type("linked-worktree").
+					Title(Equals("New branch name (leave blank to checkout mybranch)")).
+					Type("newbranch").
 					Confirm()
 
 				t.ExpectPopup().Prompt().
-					Title(Equals("New branch name (leave blank to checkout mybranch)")).
-					Type("newbranch").
+					Title(Equals("New worktree path")).
+					InitialText(Equals("../repo-newbranch")).
+					Clear().
+					Type("linked-worktree").
 					Confirm()
 			}).
 			Lines(
@@ -24,6 +24,8 @@ type IntegrationTest interface {
 type GuiDriver interface {
 	PressKey(string)
 	Click(int, int)
+	// drags with the left mouse button from the first to the second position
+	Drag(int, int, int, int)
 	Keys() config.KeybindingConfig
 	CurrentContext() types.Context
 	ContextForView(viewName string) types.Context
@@ -41,10 +43,14 @@ type GuiDriver interface {
 	// e.g. when we're showing both staged and unstaged changes
 	SecondaryView() *gocui.View
 	View(viewName string) *gocui.View
+	// the whole screen as plain text, one line per row
+	Snapshot() string
 	SetCaption(caption string)
 	SetCaptionPrefix(prefix string)
 	// Pop the next toast that was displayed; returns nil if there was none
 	NextToast() *string
 	CheckAllToastsAcknowledged()
 	Headless() bool
+	// the side windows that are currently shown, in top-to-bottom order
+	SideWindows() []string
 }
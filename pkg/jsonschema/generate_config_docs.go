@@ -251,6 +251,8 @@ func getZeroValue(val any, t string) any {
 		return ""
 	case "boolean":
 		return false
+	case "integer":
+		return 0
 	case "object":
 		return map[string]any{}
 	case "array":
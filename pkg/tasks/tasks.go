@@ -28,6 +28,12 @@ const THROTTLE_TIME = time.Millisecond * 30
 // we use this to check if the system is under stress right now. Hopefully this makes sense on other machines
 const COMMAND_START_THRESHOLD = time.Millisecond * 10
 
+// While we're waiting for more lines from a command that is slow to produce
+// them (e.g. a pager like delta or diff-so-fancy working through a large diff),
+// we refresh the view this often so that the lines we already have are shown
+// as they arrive, rather than only once we've read all the lines we asked for
+const STREAMING_REFRESH_INTERVAL = time.Millisecond * 100
+
 type ViewBufferManager struct {
 	// this blocks until the task has been properly stopped
 	stopCurrentTask func()
@@ -117,7 +123,10 @@ func (self *ViewBufferManager) ReadToEnd(then func()) {
 	}
 }
 
-func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead LinesToRead, onDoneFn func()) func(TaskOpts) error {
+// If onComplete is non-nil, it is called with the entire output of the command
+// (not including the prefix) if the command's output was read to the end and
+// the command succeeded, so that the output can be cached.
+func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead LinesToRead, onDoneFn func(), onComplete func(output string)) func(TaskOpts) error {
 	return func(opts TaskOpts) error {
 		var onDoneOnce sync.Once
 		var onFirstPageShownOnce sync.Once
@@ -232,7 +241,11 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 		})
 
 		go utils.Safe(func() {
+			var output []byte
+			reachedEndOfInput := false
 			isViewStale := true
+			refreshTicker := time.NewTicker(STREAMING_REFRESH_INTERVAL)
+			defer refreshTicker.Stop()
 			writeToView := func(content []byte) {
 				isViewStale = true
 				_, _ = self.writer.Write(content)
@@ -258,12 +271,22 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 					for i := 0; linesToRead.Total == -1 || i < linesToRead.Total; i++ {
 						var ok bool
 						var line []byte
-						select {
-						case <-opts.Stop:
-							callThen()
-							break outer
-						case line, ok = <-lineChan:
-							// process line below
+					waitForLine:
+						for {
+							select {
+							case <-opts.Stop:
+								callThen()
+								break outer
+							case line, ok = <-lineChan:
+								// process line below
+								break waitForLine
+							case <-refreshTicker.C:
+								loadingMutex.Lock()
+								if loaded {
+									refreshViewIfStale()
+								}
+								loadingMutex.Unlock()
+							}
 						}
 
 						loadingMutex.Lock()
@@ -280,10 +303,15 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 							// if we're here then there's nothing left to scan from the source
 							// so we're at the EOF and can flush the stale content
 							self.onEndOfInput()
+							reachedEndOfInput = true
 							callThen()
 							break outer
 						}
 						writeToView(append(line, '\n'))
+						if onComplete != nil {
+							output = append(output, line...)
+							output = append(output, '\n')
+						}
 						lineWrittenChan <- struct{}{}
 
 						if i+1 == linesToRead.InitialRefreshAfter {
@@ -313,6 +341,8 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 			default:
 				if err := cmd.Wait(); err != nil {
 					self.Log.Errorf("Unexpected error when running cmd task: %v; Failed command: %v %v", err, cmd.Path, cmd.Args)
+				} else if reachedEndOfInput && onComplete != nil {
+					onComplete(string(output))
 				}
 			}
 
@@ -377,19 +407,22 @@ func (self *ViewBufferManager) NewTask(f func(TaskOpts) error, key string) error
 		})
 	}
 
-	go utils.Safe(func() {
-		defer completeGocuiTask()
+	// Assigning the task ID before spawning the goroutine, so that when two
+	// tasks are created in quick succession, the one created last wins even if
+	// its goroutine happens to get scheduled first
+	self.taskIDMutex.Lock()
+	self.newTaskID++
+	taskID := self.newTaskID
 
-		self.taskIDMutex.Lock()
-		self.newTaskID++
-		taskID := self.newTaskID
+	if self.GetTaskKey() != key && self.onNewKey != nil {
+		self.onNewKey()
+	}
+	self.taskKey = key
 
-		if self.GetTaskKey() != key && self.onNewKey != nil {
-			self.onNewKey()
-		}
-		self.taskKey = key
+	self.taskIDMutex.Unlock()
 
-		self.taskIDMutex.Unlock()
+	go utils.Safe(func() {
+		defer completeGocuiTask()
 
 		self.waitingMutex.Lock()
 
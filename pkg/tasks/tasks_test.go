@@ -5,6 +5,7 @@ import (
 	"io"
 	"os/exec"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -52,7 +53,7 @@ func TestNewCmdTaskInstantStop(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone)
+	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone, nil)
 
 	_ = fn(TaskOpts{Stop: stop, InitialContentLoaded: func() { task.Done() }})
 
@@ -115,7 +116,7 @@ func TestNewCmdTask(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone)
+	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone, nil)
 	wg := sync.WaitGroup{}
 	wg.Go(func() {
 		time.Sleep(100 * time.Millisecond)
@@ -153,6 +154,68 @@ func TestNewCmdTask(t *testing.T) {
 	}
 }
 
+func TestNewCmdTaskOnComplete(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		linesToRead    int
+		expectedOutput []string
+	}{
+		{
+			name:           "output read to the end",
+			linesToRead:    20,
+			expectedOutput: []string{"line 1\nline 2\n"},
+		},
+		{
+			name:           "output not read to the end",
+			linesToRead:    1,
+			expectedOutput: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			task := gocui.NewFakeTask()
+			manager := NewViewBufferManager(
+				utils.NewDummyLog(),
+				bytes.NewBuffer(nil),
+				func() {},
+				func() {},
+				func() {},
+				func() {},
+				func() gocui.Task { return task },
+			)
+
+			stop := make(chan struct{})
+			start := func() (*exec.Cmd, io.Reader) {
+				cmd := exec.Command("git", "--version")
+				if err := cmd.Start(); err != nil {
+					t.Fatal(err)
+				}
+				return cmd, bytes.NewBufferString("line 1\nline 2\n")
+			}
+
+			var completedOutputs []string
+			fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{s.linesToRead, -1, nil}, func() {},
+				func(output string) { completedOutputs = append(completedOutputs, output) })
+
+			if s.linesToRead < 2 {
+				// The task keeps waiting for more lines to be requested until it is stopped
+				wg := sync.WaitGroup{}
+				wg.Go(func() {
+					time.Sleep(100 * time.Millisecond)
+					close(stop)
+				})
+				defer wg.Wait()
+			}
+			_ = fn(TaskOpts{Stop: stop, InitialContentLoaded: func() { task.Done() }})
+
+			if !slices.Equal(s.expectedOutput, completedOutputs) {
+				t.Errorf("expected onComplete to be called with %q, got %q", s.expectedOutput, completedOutputs)
+			}
+		})
+	}
+}
+
 // A dummy reader that simply yields as many blank lines as requested. The only
 // thing we want to do with the output is count the number of lines.
 type BlankLineReader struct {
@@ -248,7 +311,7 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 			return cmd, &reader
 		}
 
-		fn := manager.NewCmdTask(start, "", s.linesToRead, func() {})
+		fn := manager.NewCmdTask(start, "", s.linesToRead, func() {}, nil)
 		wg := sync.WaitGroup{}
 		wg.Go(func() {
 			time.Sleep(100 * time.Millisecond)
@@ -264,3 +327,69 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCmdTaskShowsSlowOutputAsItArrives(t *testing.T) {
+	writer := bytes.NewBuffer(nil)
+	// refreshView is called on the task's goroutine, which is also the one
+	// writing to the writer, so it's safe to read the content here
+	contentOnRefresh := make(chan string, 100)
+	refreshView := func() {
+		contentOnRefresh <- writer.String()
+	}
+
+	task := gocui.NewFakeTask()
+	newTask := func() gocui.Task {
+		return task
+	}
+
+	manager := NewViewBufferManager(
+		utils.NewDummyLog(),
+		writer,
+		func() {},
+		refreshView,
+		func() {},
+		func() {},
+		newTask,
+	)
+
+	// Like a pager that takes a while to produce the rest of its output
+	reader, pipeWriter := io.Pipe()
+	start := func() (*exec.Cmd, io.Reader) {
+		// not actually starting this because it's not necessary
+		cmd := exec.Command("blah")
+
+		return cmd, reader
+	}
+
+	stop := make(chan struct{})
+	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{-1, -1, nil}, func() {}, nil)
+	wg := sync.WaitGroup{}
+	wg.Go(func() {
+		_ = fn(TaskOpts{Stop: stop, InitialContentLoaded: func() { task.Done() }})
+	})
+
+	_, _ = pipeWriter.Write([]byte("first line\n"))
+
+	select {
+	case content := <-contentOnRefresh:
+		if content != "prefix\nfirst line\n" {
+			t.Errorf("expected the first line to be shown, got %q", content)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected the view to be refreshed before the command's output ended")
+	}
+
+	_, _ = pipeWriter.Write([]byte("second line\n"))
+	pipeWriter.Close()
+	wg.Wait()
+	close(stop)
+
+	close(contentOnRefresh)
+	lastContent := ""
+	for content := range contentOnRefresh {
+		lastContent = content
+	}
+	if lastContent != "prefix\nfirst line\nsecond line\n" {
+		t.Errorf("expected all lines to be shown at the end, got %q", lastContent)
+	}
+}
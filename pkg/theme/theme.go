@@ -4,6 +4,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/samber/lo"
 )
 
 var (
@@ -45,6 +46,21 @@ var (
 	DiffTerminalColor = style.FgMagenta
 
 	UnstagedChangesColor = style.New()
+
+	// DiffAddedColor and DiffRemovedColor are the styles of added and removed
+	// lines in the staging and patch building views
+	DiffAddedColor   = style.FgGreen
+	DiffRemovedColor = style.FgRed
+
+	// ConflictColor is the style of merge conflict markers and labels
+	ConflictColor = style.FgRed
+
+	// GraphColors is the palette for the commit graph; if empty, colors are
+	// derived from the author names instead
+	GraphColors []style.TextStyle
+
+	AppStatusColor   gocui.Attribute = gocui.ColorCyan
+	InformationColor gocui.Attribute = gocui.ColorGreen
 )
 
 // UpdateTheme updates all theme variables
@@ -73,4 +89,14 @@ func UpdateTheme(themeConfig config.ThemeConfig) {
 
 	DefaultTextColor = GetTextStyle(themeConfig.DefaultFgColor, false)
 	GocuiDefaultTextColor = GetGocuiStyle(themeConfig.DefaultFgColor)
+
+	DiffAddedColor = GetTextStyle(themeConfig.DiffAddedColor, false)
+	DiffRemovedColor = GetTextStyle(themeConfig.DiffRemovedColor, false)
+	ConflictColor = GetTextStyle(themeConfig.ConflictColor, false)
+	GraphColors = lo.Map(themeConfig.GraphColors, func(color string, _ int) style.TextStyle {
+		return GetTextStyle([]string{color}, false)
+	})
+
+	AppStatusColor = GetGocuiStyle(themeConfig.AppStatusColor)
+	InformationColor = GetGocuiStyle(themeConfig.InformationColor)
 }
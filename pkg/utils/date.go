@@ -55,8 +55,16 @@ func formatSecondsAgo(secondsAgo int64) string {
 	)
 }
 
+// Pass this as the time format to UnixToDateSmart to show how long ago the
+// timestamp was (e.g. '2d') rather than the date itself
+const RELATIVE_TIME_FORMAT = "relative"
+
 // formats the date in a smart way, if the date is today, it will show the time, otherwise it will show the date
 func UnixToDateSmart(now time.Time, timestamp int64, longTimeFormat string, shortTimeFormat string) string {
+	if longTimeFormat == RELATIVE_TIME_FORMAT {
+		return formatSecondsAgo(now.Unix() - timestamp)
+	}
+
 	date := time.Unix(timestamp, 0)
 
 	if date.Day() == now.Day() && date.Month() == now.Month() && date.Year() == now.Year() {
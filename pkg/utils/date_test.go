@@ -2,6 +2,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 )
 
 func TestFormatSecondsAgo(t *testing.T) {
@@ -95,3 +96,29 @@ func TestFormatSecondsAgo(t *testing.T) {
 		})
 	}
 }
+
+func TestUnixToDateSmart(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.Local)
+	today := time.Date(2024, 3, 15, 9, 5, 0, 0, time.Local).Unix()
+	lastYear := time.Date(2023, 12, 24, 9, 5, 0, 0, time.Local).Unix()
+
+	tests := []struct {
+		name            string
+		timestamp       int64
+		longTimeFormat  string
+		shortTimeFormat string
+		want            string
+	}{
+		{"today uses short format", today, "02 Jan 06", time.Kitchen, "9:05AM"},
+		{"earlier uses long format", lastYear, "02 Jan 06", time.Kitchen, "24 Dec 23"},
+		{"relative today", today, RELATIVE_TIME_FORMAT, RELATIVE_TIME_FORMAT, "5h"},
+		{"relative earlier", lastYear, RELATIVE_TIME_FORMAT, RELATIVE_TIME_FORMAT, "2M"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := UnixToDateSmart(now, test.timestamp, test.longTimeFormat, test.shortTimeFormat); got != test.want {
+				t.Errorf("UnixToDateSmart() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -359,6 +359,42 @@ func TestRebaseCommands_moveTodoUp(t *testing.T) {
 	}
 }
 
+func TestRebaseCommands_moveNonContiguousTodos(t *testing.T) {
+	// a function rather than a variable because moving modifies the slice
+	todos := func() []todo.Todo {
+		return []todo.Todo{
+			{Command: todo.Pick, Commit: "1111"},
+			{Command: todo.Pick, Commit: "2222"},
+			{Command: todo.Pick, Commit: "3333"},
+			{Command: todo.Pick, Commit: "4444"},
+			{Command: todo.Pick, Commit: "5555"},
+		}
+	}
+
+	// The todos to move are given in the order of lazygit's commits view,
+	// i.e. the newest first. Each of them swaps places with its neighbour, so
+	// the group keeps its gaps.
+	movedDown, err := moveTodosDown(todos(), []Todo{{Hash: "5555"}, {Hash: "3333"}}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []todo.Todo{
+		{Command: todo.Pick, Commit: "1111"},
+		{Command: todo.Pick, Commit: "3333"},
+		{Command: todo.Pick, Commit: "2222"},
+		{Command: todo.Pick, Commit: "5555"},
+		{Command: todo.Pick, Commit: "4444"},
+	}, movedDown)
+
+	movedUp, err := moveTodosUp(todos(), []Todo{{Hash: "3333"}, {Hash: "1111"}}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []todo.Todo{
+		{Command: todo.Pick, Commit: "2222"},
+		{Command: todo.Pick, Commit: "1111"},
+		{Command: todo.Pick, Commit: "4444"},
+		{Command: todo.Pick, Commit: "3333"},
+		{Command: todo.Pick, Commit: "5555"},
+	}, movedUp)
+}
+
 func TestRebaseCommands_moveFixupCommitDown(t *testing.T) {
 	scenarios := []struct {
 		name          string
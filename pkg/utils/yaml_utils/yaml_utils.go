@@ -63,6 +63,48 @@ func transformNode(node *yaml.Node, path []string, transform func(node *yaml.Nod
 	return transformNode(valueNode, path[1:], transform)
 }
 
+// Sets the key at the given path of a yaml document to the given string value,
+// creating the key (and any dictionaries leading up to it) if necessary.
+func SetStringValue(rootNode *yaml.Node, path []string, value string) error {
+	// Empty document: create the top-level dictionary
+	if len(rootNode.Content) == 0 {
+		rootNode.Kind = yaml.DocumentNode
+		rootNode.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+
+	return setStringValue(rootNode.Content[0], path, value)
+}
+
+// Recursive function to set the value. See SetStringValue for more details.
+func setStringValue(node *yaml.Node, path []string, value string) error {
+	if node.Kind != yaml.MappingNode {
+		return errors.New("yaml node in path is not a dictionary")
+	}
+
+	keyNode, valueNode := LookupKey(node, path[0])
+	if keyNode == nil {
+		keyNode = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: path[0]}
+		valueNode = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if len(path) == 1 {
+			valueNode = &yaml.Node{Kind: yaml.ScalarNode}
+		}
+		node.Content = append(node.Content, keyNode, valueNode)
+	}
+
+	if len(path) == 1 {
+		// Modify the existing node rather than replacing it, so that comments
+		// attached to it are kept
+		valueNode.Kind = yaml.ScalarNode
+		valueNode.Tag = "!!str"
+		valueNode.Style = 0
+		valueNode.Value = value
+		valueNode.Content = nil
+		return nil
+	}
+
+	return setStringValue(valueNode, path[1:], value)
+}
+
 // Takes the root node of a yaml document, a path to a key, and a new name for the key.
 // Will rename the key to the new name if it exists, and do nothing otherwise.
 func RenameYamlKey(rootNode *yaml.Node, path []string, newKey string) (error, bool) {
@@ -339,6 +339,67 @@ func TestRemoveKey(t *testing.T) {
 	}
 }
 
+func TestSetStringValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		path        []string
+		value       string
+		expectedOut string
+		expectedErr string
+	}{
+		{
+			name:        "empty document",
+			in:          "",
+			path:        []string{"foo", "bar"},
+			value:       "baz",
+			expectedOut: "foo:\n  bar: baz\n",
+		},
+		{
+			name:        "existing key",
+			in:          "foo:\n  bar: 1 # comment\n  qux: 2\n",
+			path:        []string{"foo", "bar"},
+			value:       "<disabled>",
+			expectedOut: "foo:\n  bar: <disabled> # comment\n  qux: 2\n",
+		},
+		{
+			name:        "missing key in existing dictionary",
+			in:          "foo:\n  qux: 2\n",
+			path:        []string{"foo", "bar"},
+			value:       "baz",
+			expectedOut: "foo:\n  qux: 2\n  bar: baz\n",
+		},
+		{
+			name:        "missing dictionary",
+			in:          "other: 1\n",
+			path:        []string{"foo", "bar"},
+			value:       "baz",
+			expectedOut: "other: 1\nfoo:\n  bar: baz\n",
+		},
+		{
+			name:        "value in path is not a dictionary",
+			in:          "foo: 1\n",
+			path:        []string{"foo", "bar"},
+			value:       "baz",
+			expectedErr: "yaml node in path is not a dictionary",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node := unmarshalForTest(t, test.in)
+			err := SetStringValue(&node, test.path, test.value)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOut, marshalForTest(t, &node))
+		})
+	}
+}
+
 func unmarshalForTest(t *testing.T, input string) yaml.Node {
 	t.Helper()
 	var node yaml.Node